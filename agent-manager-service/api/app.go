@@ -22,6 +22,7 @@ import (
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/orgcontext"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
 )
 
@@ -30,11 +31,14 @@ func MakeHTTPHandler(params *wiring.AppParams) http.Handler {
 	mux := http.NewServeMux()
 
 	// Register health check
-	registerHealthCheck(mux)
+	registerHealthCheck(mux, params.APIPlatformClient)
 
 	// Register JWKS endpoint at root level (no authentication required)
 	registerJWKSRoute(mux, params.AgentTokenController)
 
+	// Register A2A agent card discovery at root level (no authentication required)
+	registerWellKnownAgentCardRoute(mux, params.AgentCardController)
+
 	// Create a sub-mux for API v1 routes
 	apiMux := http.NewServeMux()
 	registerAgentRoutes(apiMux, params.AgentController)
@@ -44,16 +48,32 @@ func MakeHTTPHandler(params *wiring.AppParams) http.Handler {
 	registerRepositoryRoutes(apiMux, params.RepositoryController)
 	registerEnvironmentRoutes(apiMux, params.EnvironmentController)
 	RegisterGatewayRoutes(apiMux, params.GatewayController)
+	registerApplyRoutes(apiMux, params.ApplyController)
+	registerGatewayBulkOperationRoutes(apiMux, params.GatewayBulkOperationController)
+	registerSearchRoutes(apiMux, params.SearchController)
+	registerOrganizationRoutes(apiMux, params.OrganizationController)
+	registerScimRoutes(apiMux, params.ScimController)
+	registerAgentTemplateRoutes(apiMux, params.AgentTemplateController)
+	registerMCPServerRoutes(apiMux, params.MCPServerController)
+	registerAgentPublicationRoutes(apiMux, params.AgentPublicationController)
+	registerAgentCardRoutes(apiMux, params.AgentCardController)
+	registerAgentInvocationRoutes(apiMux, params.AgentInvocationController)
+	registerDeploymentApprovalRoutes(apiMux, params.DeploymentApprovalController)
+	registerChangeFreezeRoutes(apiMux, params.ChangeFreezeController)
 
 	// Apply middleware in reverse order (last middleware is applied first)
 	apiHandler := http.Handler(apiMux)
+	apiHandler = middleware.RejectSuspendedOrganizations()(apiHandler)
+	apiHandler = orgcontext.ResolveOrgContext(params.OrganizationService)(apiHandler)
 	apiHandler = params.AuthMiddleware(apiHandler)
-	apiHandler = middleware.AddCorrelationID()(apiHandler)
+	apiHandler = logger.BodyLogger(func() config.BodyLoggingConfig { return config.GetConfig().BodyLogging })(apiHandler)
 	apiHandler = logger.RequestLogger()(apiHandler)
+	apiHandler = middleware.AddCorrelationID()(apiHandler)
 	apiHandler = middleware.CORS(config.GetConfig().CORSAllowedOrigin)(apiHandler)
 	apiHandler = middleware.RecovererOnPanic()(apiHandler)
 
 	mux.Handle("/api/v1/", http.StripPrefix("/api/v1", apiHandler))
 
-	return mux
+	handler := middleware.CloseConnectionsWhenDraining()(mux)
+	return middleware.Tracing("agent-manager-service")(handler)
 }
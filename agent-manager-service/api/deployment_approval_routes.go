@@ -0,0 +1,35 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/controllers"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware"
+)
+
+func registerDeploymentApprovalRoutes(mux *http.ServeMux, ctrl controllers.DeploymentApprovalController) {
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/deployment-approvals/policy", ctrl.GetDeploymentApprovalPolicy)
+	middleware.HandleFuncWithValidation(mux, "PUT /orgs/{orgName}/deployment-approvals/policy", ctrl.SetDeploymentApprovalPolicy)
+	middleware.HandleFuncWithValidation(mux, "DELETE /orgs/{orgName}/deployment-approvals/policy", ctrl.DeleteDeploymentApprovalPolicy)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/deployment-approvals", ctrl.ListDeploymentApprovals)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/deployment-approvals/{approvalId}", ctrl.GetDeploymentApproval)
+	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/deployment-approvals/{approvalId}/approve", ctrl.ApproveDeployment)
+	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/deployment-approvals/{approvalId}/reject", ctrl.RejectDeployment)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/deployment-approvals/{approvalId}/events", ctrl.GetDeploymentApprovalEvents)
+}
@@ -30,4 +30,5 @@ func registerEnvironmentRoutes(mux *http.ServeMux, ctrl controllers.EnvironmentC
 	middleware.HandleFuncWithValidation(mux, "PUT /orgs/{orgName}/environments/{envID}", ctrl.UpdateEnvironment)
 	middleware.HandleFuncWithValidation(mux, "DELETE /orgs/{orgName}/environments/{envID}", ctrl.DeleteEnvironment)
 	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/environments/{envID}/gateways", ctrl.GetEnvironmentGateways)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/regions", ctrl.ListRegions)
 }
@@ -33,6 +33,8 @@ func RegisterGatewayRoutes(mux *http.ServeMux, ctrl controllers.GatewayControlle
 	middleware.HandleFuncWithValidation(mux, "DELETE /orgs/{orgName}/gateways/{gatewayID}/environments/{envID}", ctrl.RemoveGatewayFromEnvironment)
 	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/gateways/{gatewayID}/environments", ctrl.GetGatewayEnvironments)
 	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/gateways/{gatewayID}/health", ctrl.CheckGatewayHealth)
+	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/gateways/{gatewayID}/diagnose", ctrl.DiagnoseGateway)
 	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/gateways/{gatewayID}/tokens", ctrl.RotateGatewayToken)
 	middleware.HandleFuncWithValidation(mux, "DELETE /orgs/{orgName}/gateways/{gatewayID}/tokens/{tokenID}", ctrl.RevokeGatewayToken)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/gateways/{gatewayID}/events", ctrl.GetGatewayEvents)
 }
@@ -21,25 +21,132 @@ import (
 	"net/http"
 	"time"
 
+	apiplatformclient "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/apiplatformsvc/client"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
 )
 
-func registerHealthCheck(mux *http.ServeMux) {
+const (
+	dependencyStatusUp       = "up"
+	dependencyStatusDown     = "down"
+	dependencyStatusDisabled = "disabled"
+
+	readinessStatusReady    = "ready"
+	readinessStatusDegraded = "degraded"
+	readinessStatusNotReady = "not_ready"
+)
+
+// dependencyCheck probes a single dependency. Required dependencies fail readiness when down,
+// optional ones only degrade it.
+type dependencyCheck struct {
+	name     string
+	required bool
+	check    func(ctx context.Context) error
+}
+
+// dependencyStatus is the per-dependency entry of the readiness response
+type dependencyStatus struct {
+	Status    string `json:"status"`
+	Required  bool   `json:"required"`
+	LatencyMs int64  `json:"latencyMs"`
+	Error     string `json:"error,omitempty"`
+}
+
+func registerHealthCheck(mux *http.ServeMux, apiPlatformClient apiplatformclient.APIPlatformClient) {
+	// Liveness only reports that the process is serving requests; dependency failures must not
+	// cause the pod to be restarted.
 	mux.HandleFunc("GET /healthz", func(w http.ResponseWriter, r *http.Request) {
+		utils.WriteSuccessResponse(w, http.StatusOK, map[string]interface{}{
+			"status":    "alive",
+			"timestamp": time.Now(),
+		})
+	})
+
+	checks := []dependencyCheck{
+		{name: "database", required: true, check: checkDatabase},
+	}
+	if apiPlatformClient != nil {
+		checks = append(checks, dependencyCheck{
+			name: "apiPlatform",
+			check: func(ctx context.Context) error {
+				_, err := apiPlatformClient.GetOrganization(apiplatformclient.WithoutCache(ctx))
+				return err
+			},
+		})
+	}
+
+	mux.HandleFunc("GET /readyz", func(w http.ResponseWriter, r *http.Request) {
+		if middleware.IsDraining() {
+			utils.WriteErrorResponse(w, http.StatusServiceUnavailable, "agent-manager-service is draining")
+			return
+		}
+
 		ctx, cancel := context.WithTimeout(r.Context(), time.Duration(config.GetConfig().HealthCheckTimeoutSeconds)*time.Second)
 		defer cancel()
 
-		var dbRes *int
-		if result := db.DB(ctx).Raw("SELECT 1").Scan(&dbRes); result.Error != nil {
-			utils.WriteErrorResponse(w, http.StatusInternalServerError, "database connection error")
-			return
+		dependencies := runDependencyChecks(ctx, checks)
+		if apiPlatformClient == nil {
+			dependencies["apiPlatform"] = dependencyStatus{Status: dependencyStatusDisabled}
 		}
-		response := map[string]interface{}{
-			"message":   "agent-manager-service is healthy",
-			"timestamp": time.Now(),
+
+		status := readinessStatusReady
+		httpStatus := http.StatusOK
+		for _, dep := range dependencies {
+			if dep.Status != dependencyStatusDown {
+				continue
+			}
+			if dep.Required {
+				status = readinessStatusNotReady
+				httpStatus = http.StatusServiceUnavailable
+				break
+			}
+			status = readinessStatusDegraded
 		}
-		utils.WriteSuccessResponse(w, http.StatusOK, response)
+
+		utils.WriteSuccessResponse(w, httpStatus, map[string]interface{}{
+			"status":       status,
+			"dependencies": dependencies,
+			"timestamp":    time.Now(),
+		})
 	})
 }
+
+// runDependencyChecks probes all dependencies concurrently so that one slow dependency
+// does not consume the timeout budget of the others
+func runDependencyChecks(ctx context.Context, checks []dependencyCheck) map[string]dependencyStatus {
+	type result struct {
+		name   string
+		status dependencyStatus
+	}
+	results := make(chan result, len(checks))
+	for _, c := range checks {
+		go func(c dependencyCheck) {
+			start := time.Now()
+			err := c.check(ctx)
+			status := dependencyStatus{
+				Status:    dependencyStatusUp,
+				Required:  c.required,
+				LatencyMs: time.Since(start).Milliseconds(),
+			}
+			if err != nil {
+				status.Status = dependencyStatusDown
+				status.Error = err.Error()
+			}
+			results <- result{name: c.name, status: status}
+		}(c)
+	}
+
+	dependencies := make(map[string]dependencyStatus, len(checks))
+	for range checks {
+		res := <-results
+		dependencies[res.name] = res.status
+	}
+	return dependencies
+}
+
+func checkDatabase(ctx context.Context) error {
+	var dbRes *int
+	return db.DB(ctx).Raw("SELECT 1").Scan(&dbRes).Error
+}
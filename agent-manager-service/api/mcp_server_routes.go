@@ -0,0 +1,36 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/controllers"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware"
+)
+
+func registerMCPServerRoutes(mux *http.ServeMux, ctrl controllers.MCPServerController) {
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/mcp-servers", ctrl.ListMCPServers)
+	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/mcp-servers", ctrl.CreateMCPServer)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/mcp-servers/{mcpServerName}", ctrl.GetMCPServer)
+	middleware.HandleFuncWithValidation(mux, "PUT /orgs/{orgName}/mcp-servers/{mcpServerName}", ctrl.UpdateMCPServer)
+	middleware.HandleFuncWithValidation(mux, "DELETE /orgs/{orgName}/mcp-servers/{mcpServerName}", ctrl.DeleteMCPServer)
+	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/mcp-servers/{mcpServerName}/refresh", ctrl.RefreshMCPServerTools)
+
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/mcp-servers", ctrl.ListAgentMCPServers)
+	middleware.HandleFuncWithValidation(mux, "PUT /orgs/{orgName}/projects/{projName}/agents/{agentName}/mcp-servers", ctrl.SetAgentMCPServers)
+}
@@ -29,4 +29,72 @@ func registerObservabilityRoutes(mux *http.ServeMux, ctrl controllers.Observabil
 	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/traces", ctrl.ListTraces)
 	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/traces/export", ctrl.ExportTraces)
 	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/trace/{traceId}", ctrl.GetTrace)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/trace/{traceId}/logs", ctrl.GetTraceLogs)
+	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/projects/{projName}/agents/{agentName}/trace/{traceId}/replays", ctrl.ReplayTrace)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/trace/{traceId}/replays", ctrl.ListTraceReplays)
+	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/projects/{projName}/agents/{agentName}/golden-traces", ctrl.CreateGoldenTrace)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/golden-traces", ctrl.ListGoldenTraces)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/golden-traces/{goldenTraceId}", ctrl.GetGoldenTrace)
+	middleware.HandleFuncWithValidation(mux, "DELETE /orgs/{orgName}/projects/{projName}/agents/{agentName}/golden-traces/{goldenTraceId}", ctrl.DeleteGoldenTrace)
+	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/projects/{projName}/agents/{agentName}/golden-traces/{goldenTraceId}/runs", ctrl.RunGoldenTrace)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/golden-traces/{goldenTraceId}/runs", ctrl.ListGoldenTraceRuns)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/golden-traces/{goldenTraceId}/runs/{runId}", ctrl.GetGoldenTraceRun)
+	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/projects/{projName}/agents/{agentName}/trace/{traceId}/scores", ctrl.ScoreTrace)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/trace/{traceId}/scores", ctrl.GetTraceScores)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/trace-scoring", ctrl.GetTraceScoringPolicy)
+	middleware.HandleFuncWithValidation(mux, "PUT /orgs/{orgName}/projects/{projName}/agents/{agentName}/trace-scoring", ctrl.SetTraceScoringPolicy)
+	middleware.HandleFuncWithValidation(mux, "DELETE /orgs/{orgName}/projects/{projName}/agents/{agentName}/trace-scoring", ctrl.DeleteTraceScoringPolicy)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/trace-scoring/summary", ctrl.GetTraceScoreSummary)
+	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/projects/{projName}/agents/{agentName}/slos", ctrl.CreateAgentSLO)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/slos", ctrl.ListAgentSLOs)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/slos/{sloId}", ctrl.GetAgentSLO)
+	middleware.HandleFuncWithValidation(mux, "PUT /orgs/{orgName}/projects/{projName}/agents/{agentName}/slos/{sloId}", ctrl.UpdateAgentSLO)
+	middleware.HandleFuncWithValidation(mux, "DELETE /orgs/{orgName}/projects/{projName}/agents/{agentName}/slos/{sloId}", ctrl.DeleteAgentSLO)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/slos/{sloId}/status", ctrl.GetAgentSLOStatus)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/slos/{sloId}/history", ctrl.GetAgentSLOHistory)
+	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/projects/{projName}/agents/{agentName}/token-budgets", ctrl.CreateAgentTokenBudget)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/token-budgets", ctrl.ListAgentTokenBudgets)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/token-budgets/{budgetId}", ctrl.GetAgentTokenBudget)
+	middleware.HandleFuncWithValidation(mux, "PUT /orgs/{orgName}/projects/{projName}/agents/{agentName}/token-budgets/{budgetId}", ctrl.UpdateAgentTokenBudget)
+	middleware.HandleFuncWithValidation(mux, "DELETE /orgs/{orgName}/projects/{projName}/agents/{agentName}/token-budgets/{budgetId}", ctrl.DeleteAgentTokenBudget)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/token-budgets/{budgetId}/events", ctrl.ListAgentTokenBudgetEvents)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/trace-sampling", ctrl.GetTraceSamplingPolicy)
+	middleware.HandleFuncWithValidation(mux, "PUT /orgs/{orgName}/projects/{projName}/agents/{agentName}/trace-sampling", ctrl.SetTraceSamplingPolicy)
+	middleware.HandleFuncWithValidation(mux, "DELETE /orgs/{orgName}/projects/{projName}/agents/{agentName}/trace-sampling", ctrl.DeleteTraceSamplingPolicy)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/projects/{projName}/agents/{agentName}/trace-sampling/verdict", ctrl.GetTraceSamplingVerdict)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/analytics/models", ctrl.GetModelUsage)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/traces/retention", ctrl.GetTraceRetention)
+	middleware.HandleFuncWithValidation(mux, "PUT /orgs/{orgName}/traces/retention", ctrl.SetTraceRetention)
+	middleware.HandleFuncWithValidation(mux, "DELETE /orgs/{orgName}/traces/retention", ctrl.DeleteTraceRetention)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/traces/content-visibility", ctrl.GetTraceContentVisibility)
+	middleware.HandleFuncWithValidation(mux, "PUT /orgs/{orgName}/traces/content-visibility", ctrl.SetTraceContentVisibility)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/traces/sampling", ctrl.GetTraceSamplingPolicy)
+	middleware.HandleFuncWithValidation(mux, "PUT /orgs/{orgName}/traces/sampling", ctrl.SetTraceSamplingPolicy)
+	middleware.HandleFuncWithValidation(mux, "DELETE /orgs/{orgName}/traces/sampling", ctrl.DeleteTraceSamplingPolicy)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/traces/sampling/policies", ctrl.ListTraceSamplingPolicies)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/traces/storage", ctrl.GetTraceStorageUsage)
+	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/traces/erasures", ctrl.CreateTraceErasure)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/traces/erasures", ctrl.ListTraceErasures)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/traces/erasures/{erasureId}", ctrl.GetTraceErasure)
+	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/usage-reports", ctrl.GenerateUsageReport)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/usage-reports", ctrl.ListUsageReports)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/usage-reports/{period}", ctrl.GetUsageReport)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/usage-reports/{period}/download", ctrl.DownloadUsageReport)
+	middleware.HandleFuncWithValidation(mux, "PUT /orgs/{orgName}/cost-centers/mappings", ctrl.SetCostCenterMapping)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/cost-centers/mappings", ctrl.ListCostCenterMappings)
+	middleware.HandleFuncWithValidation(mux, "DELETE /orgs/{orgName}/cost-centers/mappings/{mappingId}", ctrl.DeleteCostCenterMapping)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/cost-centers/usage", ctrl.GetCostCenterUsage)
+	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/notification-digests", ctrl.CreateNotificationDigest)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/notification-digests", ctrl.ListNotificationDigests)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/notification-digests/{digestId}", ctrl.GetNotificationDigest)
+	middleware.HandleFuncWithValidation(mux, "PUT /orgs/{orgName}/notification-digests/{digestId}", ctrl.UpdateNotificationDigest)
+	middleware.HandleFuncWithValidation(mux, "DELETE /orgs/{orgName}/notification-digests/{digestId}", ctrl.DeleteNotificationDigest)
+	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/notification-digests/{digestId}/send", ctrl.SendNotificationDigest)
+	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/email-recipients", ctrl.CreateEmailRecipient)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/email-recipients", ctrl.ListEmailRecipients)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/email-recipients/{recipientId}", ctrl.GetEmailRecipient)
+	middleware.HandleFuncWithValidation(mux, "PUT /orgs/{orgName}/email-recipients/{recipientId}", ctrl.UpdateEmailRecipient)
+	middleware.HandleFuncWithValidation(mux, "DELETE /orgs/{orgName}/email-recipients/{recipientId}", ctrl.DeleteEmailRecipient)
+	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/email-recipients/{recipientId}/send-verification", ctrl.SendEmailRecipientVerification)
+	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/email-recipients/{recipientId}/verify", ctrl.VerifyEmailRecipient)
 }
@@ -0,0 +1,37 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/controllers"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware"
+)
+
+// registerOrganizationRoutes registers the organization lifecycle routes. Reads are served by the
+// infra resource routes.
+func registerOrganizationRoutes(mux *http.ServeMux, ctrl controllers.OrganizationController) {
+	middleware.HandleFuncWithValidation(mux, "POST /orgs", ctrl.CreateOrganization)
+	middleware.HandleFuncWithValidation(mux, "PUT /orgs/{orgName}", ctrl.UpdateOrganization)
+	middleware.HandleFuncWithValidation(mux, "DELETE /orgs/{orgName}", ctrl.DeleteOrganization)
+	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/suspend", ctrl.SuspendOrganization)
+	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/resume", ctrl.ResumeOrganization)
+	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/trial-sandbox", ctrl.ProvisionTrialSandbox)
+	middleware.HandleFuncWithValidation(mux, "GET /orgs/{orgName}/trial-sandbox", ctrl.GetTrialSandbox)
+	middleware.HandleFuncWithValidation(mux, "POST /orgs/{orgName}/trial-sandbox/end", ctrl.EndTrialSandbox)
+}
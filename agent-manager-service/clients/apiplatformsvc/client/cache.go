@@ -0,0 +1,285 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Cache keys of the read calls that are served from the response cache
+const (
+	cacheKeyGateways     = "gateways"
+	cacheKeyGateway      = "gateway:"
+	cacheKeyOrganization = "organization"
+	cacheKeyProjects     = "projects"
+	cacheKeyDevPortal    = "devportal"
+)
+
+// responseCache holds API Platform responses for a fixed time to live
+type responseCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	now     func() time.Time
+	entries map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	value     any
+	expiresAt time.Time
+}
+
+func newResponseCache(ttl time.Duration) *responseCache {
+	return &responseCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]cacheEntry),
+	}
+}
+
+func (c *responseCache) get(key string) (any, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !c.now().Before(entry.expiresAt) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.value, true
+}
+
+func (c *responseCache) set(key string, value any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = cacheEntry{value: value, expiresAt: c.now().Add(c.ttl)}
+}
+
+// invalidate removes the given keys
+func (c *responseCache) invalidate(keys ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, key := range keys {
+		delete(c.entries, key)
+	}
+}
+
+// invalidatePrefix removes all keys that start with the prefix
+func (c *responseCache) invalidatePrefix(prefix string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key := range c.entries {
+		if strings.HasPrefix(key, prefix) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// invalidateAll removes every cached response
+func (c *responseCache) invalidateAll() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	clear(c.entries)
+}
+
+type bypassCacheKey struct{}
+
+// WithoutCache returns a context whose lookups skip the response cache and read API Platform.
+// Precondition checks and health probes use it, as a response cached by this replica may be up
+// to the cache TTL old. The fresh response still replaces the cached one.
+func WithoutCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bypassCacheKey{}, true)
+}
+
+func cacheBypassed(ctx context.Context) bool {
+	bypass, _ := ctx.Value(bypassCacheKey{}).(bool)
+	return bypass
+}
+
+// cachingAPIPlatformClient serves the read-heavy lookups of an APIPlatformClient from a
+// response cache, so that the console polling gateways does not reach API Platform on every
+// request. Writes go to the wrapped client and drop the cached responses they change.
+// Cached values are copied on the way in and out, so callers may modify what they get.
+type cachingAPIPlatformClient struct {
+	APIPlatformClient
+	cache *responseCache
+}
+
+// newCachingAPIPlatformClient wraps a client with a response cache of the given time to live
+func newCachingAPIPlatformClient(client APIPlatformClient, ttl time.Duration) APIPlatformClient {
+	return &cachingAPIPlatformClient{
+		APIPlatformClient: client,
+		cache:             newResponseCache(ttl),
+	}
+}
+
+// lookup returns the cached response of a key, unless the context bypasses the cache
+func (c *cachingAPIPlatformClient) lookup(ctx context.Context, key string) (any, bool) {
+	if cacheBypassed(ctx) {
+		return nil, false
+	}
+	return c.cache.get(key)
+}
+
+func (c *cachingAPIPlatformClient) GetGateway(ctx context.Context, gatewayID string) (*GatewayResponse, error) {
+	key := cacheKeyGateway + gatewayID
+	if cached, ok := c.lookup(ctx, key); ok {
+		return copyGateway(cached.(*GatewayResponse)), nil
+	}
+
+	gateway, err := c.APIPlatformClient.GetGateway(ctx, gatewayID)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.set(key, copyGateway(gateway))
+	return gateway, nil
+}
+
+func (c *cachingAPIPlatformClient) ListGateways(ctx context.Context, filters GatewayFilters) (*GatewayListResponse, error) {
+	key := gatewaysCacheKey(filters)
+	if cached, ok := c.lookup(ctx, key); ok {
+		return copyGatewayList(cached.(*GatewayListResponse)), nil
+	}
+
+	gateways, err := c.APIPlatformClient.ListGateways(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.set(key, copyGatewayList(gateways))
+	return gateways, nil
+}
+
+func (c *cachingAPIPlatformClient) CreateGateway(ctx context.Context, req CreateGatewayRequest) (*GatewayResponse, error) {
+	defer c.cache.invalidatePrefix(cacheKeyGateways)
+	return c.APIPlatformClient.CreateGateway(ctx, req)
+}
+
+func (c *cachingAPIPlatformClient) UpdateGateway(ctx context.Context, gatewayID string, req UpdateGatewayRequest) (*GatewayResponse, error) {
+	defer c.invalidateGateway(gatewayID)
+	return c.APIPlatformClient.UpdateGateway(ctx, gatewayID, req)
+}
+
+func (c *cachingAPIPlatformClient) DeleteGateway(ctx context.Context, gatewayID string) error {
+	defer c.invalidateGateway(gatewayID)
+	return c.APIPlatformClient.DeleteGateway(ctx, gatewayID)
+}
+
+// invalidateGateway drops a cached gateway and every cached gateway list
+func (c *cachingAPIPlatformClient) invalidateGateway(gatewayID string) {
+	c.cache.invalidate(cacheKeyGateway + gatewayID)
+	c.cache.invalidatePrefix(cacheKeyGateways)
+}
+
+func (c *cachingAPIPlatformClient) GetOrganization(ctx context.Context) (*OrganizationResponse, error) {
+	if cached, ok := c.lookup(ctx, cacheKeyOrganization); ok {
+		org := *cached.(*OrganizationResponse)
+		return &org, nil
+	}
+
+	org, err := c.APIPlatformClient.GetOrganization(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cached := *org
+	c.cache.set(cacheKeyOrganization, &cached)
+	return org, nil
+}
+
+func (c *cachingAPIPlatformClient) RegisterOrganization(ctx context.Context, req RegisterOrganizationRequest) (*OrganizationResponse, error) {
+	// Registering changes which organization the client acts for, so nothing cached still holds
+	defer c.cache.invalidateAll()
+	return c.APIPlatformClient.RegisterOrganization(ctx, req)
+}
+
+func (c *cachingAPIPlatformClient) ListProjects(ctx context.Context) ([]*ProjectResponse, error) {
+	if cached, ok := c.lookup(ctx, cacheKeyProjects); ok {
+		return copyProjects(cached.([]*ProjectResponse)), nil
+	}
+
+	projects, err := c.APIPlatformClient.ListProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.set(cacheKeyProjects, copyProjects(projects))
+	return projects, nil
+}
+
+func (c *cachingAPIPlatformClient) CreateProject(ctx context.Context, req CreateProjectRequest) (*ProjectResponse, error) {
+	defer c.cache.invalidate(cacheKeyProjects)
+	return c.APIPlatformClient.CreateProject(ctx, req)
+}
+
+func (c *cachingAPIPlatformClient) GetDefaultDevPortal(ctx context.Context) (*DevPortalResponse, error) {
+	if cached, ok := c.lookup(ctx, cacheKeyDevPortal); ok {
+		devPortal := *cached.(*DevPortalResponse)
+		return &devPortal, nil
+	}
+
+	devPortal, err := c.APIPlatformClient.GetDefaultDevPortal(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cached := *devPortal
+	c.cache.set(cacheKeyDevPortal, &cached)
+	return devPortal, nil
+}
+
+// gatewaysCacheKey returns the cache key of a gateway list, which differs per filter and page
+func gatewaysCacheKey(filters GatewayFilters) string {
+	key := cacheKeyGateways
+	if filters.FunctionalityType != nil {
+		key += ":type=" + string(*filters.FunctionalityType)
+	}
+	if filters.IsActive != nil {
+		key += ":active=" + strconv.FormatBool(*filters.IsActive)
+	}
+	if filters.Limit != nil {
+		key += ":limit=" + strconv.Itoa(*filters.Limit)
+	}
+	if filters.Offset != nil {
+		key += ":offset=" + strconv.Itoa(*filters.Offset)
+	}
+	return key
+}
+
+func copyGatewayList(list *GatewayListResponse) *GatewayListResponse {
+	result := *list
+	result.Gateways = make([]*GatewayResponse, len(list.Gateways))
+	for i, gw := range list.Gateways {
+		result.Gateways[i] = copyGateway(gw)
+	}
+	return &result
+}
+
+func copyProjects(projects []*ProjectResponse) []*ProjectResponse {
+	result := make([]*ProjectResponse, len(projects))
+	for i, project := range projects {
+		p := *project
+		result[i] = &p
+	}
+	return result
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingAPIPlatformClient answers the gateway and organization calls of the cache and counts
+// the calls that reach it
+type countingAPIPlatformClient struct {
+	APIPlatformClient
+	gatewayCalls int
+	orgCalls     int
+	displayName  string
+}
+
+func (c *countingAPIPlatformClient) GetGateway(_ context.Context, gatewayID string) (*GatewayResponse, error) {
+	c.gatewayCalls++
+	return &GatewayResponse{ID: gatewayID, DisplayName: c.displayName}, nil
+}
+
+func (c *countingAPIPlatformClient) ListGateways(_ context.Context, _ GatewayFilters) (*GatewayListResponse, error) {
+	return &GatewayListResponse{Gateways: []*GatewayResponse{{ID: "gw-1", DisplayName: c.displayName}}, Total: 1}, nil
+}
+
+func (c *countingAPIPlatformClient) UpdateGateway(_ context.Context, gatewayID string, req UpdateGatewayRequest) (*GatewayResponse, error) {
+	c.displayName = *req.DisplayName
+	return &GatewayResponse{ID: gatewayID, DisplayName: c.displayName}, nil
+}
+
+func (c *countingAPIPlatformClient) GetOrganization(_ context.Context) (*OrganizationResponse, error) {
+	c.orgCalls++
+	return &OrganizationResponse{ID: "org-1"}, nil
+}
+
+func newTestCachingClient(ttl time.Duration) (*cachingAPIPlatformClient, *countingAPIPlatformClient, *time.Time) {
+	upstream := &countingAPIPlatformClient{displayName: "Gateway"}
+	caching := newCachingAPIPlatformClient(upstream, ttl).(*cachingAPIPlatformClient)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	caching.cache.now = func() time.Time { return now }
+	return caching, upstream, &now
+}
+
+func TestCachingClientTTL(t *testing.T) {
+	ctx := context.Background()
+	caching, upstream, now := newTestCachingClient(30 * time.Second)
+
+	_, err := caching.GetGateway(ctx, "gw-1")
+	require.NoError(t, err)
+	_, err = caching.GetGateway(ctx, "gw-1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, upstream.gatewayCalls, "a second lookup within the TTL should be served from the cache")
+
+	*now = now.Add(30 * time.Second)
+	_, err = caching.GetGateway(ctx, "gw-1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, upstream.gatewayCalls, "an expired response should be read again")
+}
+
+func TestCachingClientInvalidatesOnWrite(t *testing.T) {
+	ctx := context.Background()
+	caching, upstream, _ := newTestCachingClient(time.Minute)
+
+	_, err := caching.GetGateway(ctx, "gw-1")
+	require.NoError(t, err)
+	list, err := caching.ListGateways(ctx, GatewayFilters{})
+	require.NoError(t, err)
+	assert.Equal(t, "Gateway", list.Gateways[0].DisplayName)
+
+	displayName := "Renamed"
+	_, err = caching.UpdateGateway(ctx, "gw-1", UpdateGatewayRequest{DisplayName: &displayName})
+	require.NoError(t, err)
+
+	gateway, err := caching.GetGateway(ctx, "gw-1")
+	require.NoError(t, err)
+	assert.Equal(t, "Renamed", gateway.DisplayName)
+	assert.Equal(t, 2, upstream.gatewayCalls)
+
+	list, err = caching.ListGateways(ctx, GatewayFilters{})
+	require.NoError(t, err)
+	assert.Equal(t, "Renamed", list.Gateways[0].DisplayName)
+}
+
+func TestCachingClientWithoutCache(t *testing.T) {
+	ctx := context.Background()
+	caching, upstream, _ := newTestCachingClient(time.Minute)
+
+	_, err := caching.GetGateway(ctx, "gw-1")
+	require.NoError(t, err)
+
+	// Another replica renames the gateway
+	upstream.displayName = "Renamed elsewhere"
+
+	gateway, err := caching.GetGateway(WithoutCache(ctx), "gw-1")
+	require.NoError(t, err)
+	assert.Equal(t, "Renamed elsewhere", gateway.DisplayName)
+
+	// The fresh response replaces the cached one
+	gateway, err = caching.GetGateway(ctx, "gw-1")
+	require.NoError(t, err)
+	assert.Equal(t, "Renamed elsewhere", gateway.DisplayName)
+	assert.Equal(t, 2, upstream.gatewayCalls)
+
+	for range 2 {
+		_, err = caching.GetOrganization(WithoutCache(ctx))
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 2, upstream.orgCalls)
+}
@@ -25,9 +25,11 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"time"
 
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/apiplatformsvc/gen"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/requests"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
 )
 
 // Config contains configuration for the API Platform client
@@ -35,6 +37,11 @@ type Config struct {
 	BaseURL      string
 	AuthProvider AuthProvider
 	RetryConfig  requests.RequestRetryConfig
+	// InMemory selects the in-memory client for local development instead of a remote API Platform
+	InMemory bool
+	// CacheTTL is how long gateway, organization, project and DevPortal lookups are served
+	// from a response cache. Zero disables the cache.
+	CacheTTL time.Duration
 }
 
 // APIPlatformClient defines the interface for API Platform operations
@@ -42,9 +49,10 @@ type APIPlatformClient interface {
 	// Gateway Operations
 	CreateGateway(ctx context.Context, req CreateGatewayRequest) (*GatewayResponse, error)
 	GetGateway(ctx context.Context, gatewayID string) (*GatewayResponse, error)
-	ListGateways(ctx context.Context) ([]*GatewayResponse, error)
+	ListGateways(ctx context.Context, filters GatewayFilters) (*GatewayListResponse, error)
 	UpdateGateway(ctx context.Context, gatewayID string, req UpdateGatewayRequest) (*GatewayResponse, error)
 	DeleteGateway(ctx context.Context, gatewayID string) error
+	DiagnoseGateway(ctx context.Context, gatewayID string) (*GatewayDiagnostics, error)
 
 	// Gateway Token Operations
 	RotateGatewayToken(ctx context.Context, gatewayID string) (*GatewayTokenResponse, error)
@@ -53,11 +61,38 @@ type APIPlatformClient interface {
 	// Organization Operations
 	GetOrganization(ctx context.Context) (*OrganizationResponse, error)
 	RegisterOrganization(ctx context.Context, req RegisterOrganizationRequest) (*OrganizationResponse, error)
+
+	// LLM Provider Rate Limit Operations
+	ListLLMProviderRateLimits(ctx context.Context, providerID string) ([]RateLimitPolicy, error)
+	CreateLLMProviderRateLimit(ctx context.Context, providerID string, policy RateLimitPolicy) (*RateLimitPolicy, error)
+	UpdateLLMProviderRateLimit(ctx context.Context, providerID string, policy RateLimitPolicy) (*RateLimitPolicy, error)
+	DeleteLLMProviderRateLimit(ctx context.Context, providerID string, level RateLimitLevel, resource string) error
+
+	// LLM Proxy Policy Operations
+	SetLLMProxyPolicy(ctx context.Context, proxyID string, policy LLMProxyPolicy) error
+	RemoveLLMProxyPolicy(ctx context.Context, proxyID string, policyName string) error
+
+	// Project Operations
+	ListProjects(ctx context.Context) ([]*ProjectResponse, error)
+	CreateProject(ctx context.Context, req CreateProjectRequest) (*ProjectResponse, error)
+
+	// API Operations
+	CreateAPI(ctx context.Context, req CreateAPIRequest) (*APIResponse, error)
+	UpdateAPILifeCycleStatus(ctx context.Context, apiID string, status APILifeCycleStatus) (*APIResponse, error)
+	DeleteAPI(ctx context.Context, apiID string) error
+
+	// DevPortal Operations
+	GetDefaultDevPortal(ctx context.Context) (*DevPortalResponse, error)
+	PublishAPIToDevPortal(ctx context.Context, apiID string, req PublishAPIRequest) error
+	UnpublishAPIFromDevPortal(ctx context.Context, apiID string, devPortalID string) error
 }
 
 type apiPlatformClient struct {
-	baseURL   string
-	genClient *gen.ClientWithResponses
+	baseURL      string
+	genClient    *gen.ClientWithResponses
+	authProvider AuthProvider
+	// diagnosticHTTPClient calls the control plane directly, without retries or credentials
+	diagnosticHTTPClient *http.Client
 }
 
 // NewapiPlatformClient creates a new API Platform gateway client
@@ -99,10 +134,16 @@ func NewAPIPlatformClient(cfg *Config) (APIPlatformClient, error) {
 		return nil, fmt.Errorf("failed to create API Platform client: %w", err)
 	}
 
-	return &apiPlatformClient{
-		baseURL:   cfg.BaseURL,
-		genClient: genClient,
-	}, nil
+	var client APIPlatformClient = &apiPlatformClient{
+		baseURL:              cfg.BaseURL,
+		genClient:            genClient,
+		authProvider:         cfg.AuthProvider,
+		diagnosticHTTPClient: &http.Client{Transport: tr},
+	}
+	if cfg.CacheTTL > 0 {
+		client = newCachingAPIPlatformClient(client, cfg.CacheTTL)
+	}
+	return client, nil
 }
 
 // CreateGateway creates a new gateway in API Platform
@@ -162,11 +203,40 @@ func (c *apiPlatformClient) GetGateway(ctx context.Context, gatewayID string) (*
 	return convertFromGenGatewayResponse(resp.JSON200), nil
 }
 
-// ListGateways retrieves all gateways from API Platform
-func (c *apiPlatformClient) ListGateways(ctx context.Context) ([]*GatewayResponse, error) {
-	slog.Debug("Listing gateways via API Platform")
+// ListGateways retrieves a page of gateways matching the filters from API Platform. Without
+// a limit in the filters it follows the pages until every matching gateway is retrieved.
+func (c *apiPlatformClient) ListGateways(ctx context.Context, filters GatewayFilters) (*GatewayListResponse, error) {
+	slog.Debug("Listing gateways via API Platform", "filters", filters)
+
+	if filters.Limit != nil {
+		return c.listGatewaysPage(ctx, filters)
+	}
+
+	result := &GatewayListResponse{Gateways: []*GatewayResponse{}}
+	if filters.Offset != nil {
+		result.Offset = *filters.Offset
+	}
+	for {
+		pageFilters := filters
+		pageFilters.Limit = utils.IntAsIntPointer(gatewayPageSize)
+		pageFilters.Offset = utils.IntAsIntPointer(result.Offset + len(result.Gateways))
+
+		page, err := c.listGatewaysPage(ctx, pageFilters)
+		if err != nil {
+			return nil, err
+		}
+		result.Gateways = append(result.Gateways, page.Gateways...)
+		result.Total = page.Total
+		if len(page.Gateways) == 0 || len(page.Gateways) < gatewayPageSize || result.Offset+len(result.Gateways) >= page.Total {
+			break
+		}
+	}
+	result.Limit = len(result.Gateways)
+	return result, nil
+}
 
-	resp, err := c.genClient.ListGatewaysWithResponse(ctx)
+func (c *apiPlatformClient) listGatewaysPage(ctx context.Context, filters GatewayFilters) (*GatewayListResponse, error) {
+	resp, err := c.genClient.ListGatewaysWithResponse(ctx, withGatewayFilters(filters))
 	if err != nil {
 		return nil, fmt.Errorf("failed to list gateways: %w", err)
 	}
@@ -176,15 +246,10 @@ func (c *apiPlatformClient) ListGateways(ctx context.Context) ([]*GatewayRespons
 	}
 
 	if resp.JSON200 == nil {
-		return []*GatewayResponse{}, nil
+		return &GatewayListResponse{Gateways: []*GatewayResponse{}}, nil
 	}
 
-	gateways := make([]*GatewayResponse, len(resp.JSON200.List))
-	for i, gw := range resp.JSON200.List {
-		gateways[i] = convertFromGenGatewayResponse(&gw)
-	}
-
-	return gateways, nil
+	return convertFromGenGatewayListResponse(resp.JSON200), nil
 }
 
 // UpdateGateway updates an existing gateway in API Platform
@@ -351,3 +416,332 @@ func (c *apiPlatformClient) RegisterOrganization(ctx context.Context, req Regist
 
 	return convertFromGenOrganizationResponse(resp.JSON201), nil
 }
+
+// ListLLMProviderRateLimits retrieves the rate limit policies of an LLM provider from API Platform
+func (c *apiPlatformClient) ListLLMProviderRateLimits(ctx context.Context, providerID string) ([]RateLimitPolicy, error) {
+	slog.Debug("Listing LLM provider rate limits via API Platform", "providerID", providerID)
+
+	provider, err := c.getLLMProvider(ctx, providerID)
+	if err != nil {
+		return nil, err
+	}
+	return convertFromGenRateLimiting(provider.RateLimiting), nil
+}
+
+// CreateLLMProviderRateLimit adds a rate limit policy to an LLM provider in API Platform
+func (c *apiPlatformClient) CreateLLMProviderRateLimit(ctx context.Context, providerID string, policy RateLimitPolicy) (*RateLimitPolicy, error) {
+	slog.Debug("Creating LLM provider rate limit via API Platform", "providerID", providerID, "level", policy.Level, "resource", policy.Resource)
+
+	if err := c.updateLLMProviderRateLimits(ctx, providerID, func(policies []RateLimitPolicy) ([]RateLimitPolicy, error) {
+		return addRateLimitPolicy(policies, policy)
+	}); err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// UpdateLLMProviderRateLimit replaces the rate limit policy of the same level and resource of an LLM provider in API Platform
+func (c *apiPlatformClient) UpdateLLMProviderRateLimit(ctx context.Context, providerID string, policy RateLimitPolicy) (*RateLimitPolicy, error) {
+	slog.Debug("Updating LLM provider rate limit via API Platform", "providerID", providerID, "level", policy.Level, "resource", policy.Resource)
+
+	if err := c.updateLLMProviderRateLimits(ctx, providerID, func(policies []RateLimitPolicy) ([]RateLimitPolicy, error) {
+		return replaceRateLimitPolicy(policies, policy)
+	}); err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// DeleteLLMProviderRateLimit removes a rate limit policy from an LLM provider in API Platform
+func (c *apiPlatformClient) DeleteLLMProviderRateLimit(ctx context.Context, providerID string, level RateLimitLevel, resource string) error {
+	slog.Debug("Deleting LLM provider rate limit via API Platform", "providerID", providerID, "level", level, "resource", resource)
+
+	return c.updateLLMProviderRateLimits(ctx, providerID, func(policies []RateLimitPolicy) ([]RateLimitPolicy, error) {
+		return removeRateLimitPolicy(policies, level, resource)
+	})
+}
+
+func (c *apiPlatformClient) getLLMProvider(ctx context.Context, providerID string) (*gen.LLMProvider, error) {
+	resp, err := c.genClient.GetLLMProviderWithResponse(ctx, providerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get LLM provider: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, handleErrorResponse(resp.StatusCode(), resp.Body, ErrorContext{NotFoundErr: utils.ErrProviderNotFound})
+	}
+
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("empty response from get LLM provider")
+	}
+
+	return resp.JSON200, nil
+}
+
+// updateLLMProviderRateLimits applies a change to the rate limit policies of an LLM provider. API
+// Platform stores the policies as part of the provider, so the whole provider is written back.
+func (c *apiPlatformClient) updateLLMProviderRateLimits(ctx context.Context, providerID string, change func([]RateLimitPolicy) ([]RateLimitPolicy, error)) error {
+	provider, err := c.getLLMProvider(ctx, providerID)
+	if err != nil {
+		return err
+	}
+
+	policies, err := change(convertFromGenRateLimiting(provider.RateLimiting))
+	if err != nil {
+		return err
+	}
+	provider.RateLimiting = convertToGenRateLimiting(policies)
+
+	resp, err := c.genClient.UpdateLLMProviderWithResponse(ctx, providerID, *provider)
+	if err != nil {
+		return fmt.Errorf("failed to update LLM provider rate limits: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return handleErrorResponse(resp.StatusCode(), resp.Body, ErrorContext{NotFoundErr: utils.ErrProviderNotFound})
+	}
+
+	return nil
+}
+
+// SetLLMProxyPolicy attaches a policy to all routes of an LLM proxy in API Platform, replacing a policy of the same name
+func (c *apiPlatformClient) SetLLMProxyPolicy(ctx context.Context, proxyID string, policy LLMProxyPolicy) error {
+	slog.Debug("Setting LLM proxy policy via API Platform", "proxyID", proxyID, "policy", policy.Name)
+
+	return c.updateLLMProxyPolicies(ctx, proxyID, func(policies []gen.LLMPolicy) []gen.LLMPolicy {
+		return append(removeGenLLMPolicy(policies, policy.Name), convertToGenLLMPolicy(policy))
+	})
+}
+
+// RemoveLLMProxyPolicy detaches a policy from an LLM proxy in API Platform; a missing policy is not an error
+func (c *apiPlatformClient) RemoveLLMProxyPolicy(ctx context.Context, proxyID string, policyName string) error {
+	slog.Debug("Removing LLM proxy policy via API Platform", "proxyID", proxyID, "policy", policyName)
+
+	return c.updateLLMProxyPolicies(ctx, proxyID, func(policies []gen.LLMPolicy) []gen.LLMPolicy {
+		return removeGenLLMPolicy(policies, policyName)
+	})
+}
+
+// updateLLMProxyPolicies applies a change to the policies of an LLM proxy, writing the whole proxy back
+func (c *apiPlatformClient) updateLLMProxyPolicies(ctx context.Context, proxyID string, change func([]gen.LLMPolicy) []gen.LLMPolicy) error {
+	resp, err := c.genClient.GetLLMProxyWithResponse(ctx, proxyID)
+	if err != nil {
+		return fmt.Errorf("failed to get LLM proxy: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return handleErrorResponse(resp.StatusCode(), resp.Body, ErrorContext{NotFoundErr: utils.ErrLLMProxyNotFound})
+	}
+
+	if resp.JSON200 == nil {
+		return fmt.Errorf("empty response from get LLM proxy")
+	}
+
+	proxy := resp.JSON200
+	var policies []gen.LLMPolicy
+	if proxy.Policies != nil {
+		policies = *proxy.Policies
+	}
+	policies = change(policies)
+	proxy.Policies = &policies
+
+	updateResp, err := c.genClient.UpdateLLMProxyWithResponse(ctx, proxyID, *proxy)
+	if err != nil {
+		return fmt.Errorf("failed to update LLM proxy policies: %w", err)
+	}
+
+	if updateResp.StatusCode() != http.StatusOK {
+		return handleErrorResponse(updateResp.StatusCode(), updateResp.Body, ErrorContext{NotFoundErr: utils.ErrLLMProxyNotFound})
+	}
+
+	return nil
+}
+
+// ListProjects retrieves all projects of the organization from API Platform
+func (c *apiPlatformClient) ListProjects(ctx context.Context) ([]*ProjectResponse, error) {
+	slog.Debug("Listing projects via API Platform")
+
+	resp, err := c.genClient.ListProjectsWithResponse(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, handleErrorResponse(resp.StatusCode(), resp.Body, ErrorContext{})
+	}
+
+	if resp.JSON200 == nil {
+		return []*ProjectResponse{}, nil
+	}
+
+	projects := make([]*ProjectResponse, 0, len(resp.JSON200.List))
+	for _, project := range resp.JSON200.List {
+		projects = append(projects, &ProjectResponse{ID: uuidString(project.Id), Name: project.Name})
+	}
+
+	return projects, nil
+}
+
+// CreateProject creates a new project in API Platform
+func (c *apiPlatformClient) CreateProject(ctx context.Context, req CreateProjectRequest) (*ProjectResponse, error) {
+	slog.Debug("Creating project via API Platform", "name", req.Name)
+
+	resp, err := c.genClient.CreateProjectWithResponse(ctx, gen.CreateProjectJSONRequestBody{
+		Name:        req.Name,
+		Description: req.Description,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create project: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusCreated && resp.StatusCode() != http.StatusOK {
+		return nil, handleErrorResponse(resp.StatusCode(), resp.Body, ErrorContext{ConflictErr: utils.ErrProjectAlreadyExists})
+	}
+
+	if resp.JSON201 == nil {
+		return nil, fmt.Errorf("empty response from create project")
+	}
+
+	return &ProjectResponse{ID: uuidString(resp.JSON201.Id), Name: resp.JSON201.Name}, nil
+}
+
+// CreateAPI creates a new API in API Platform in the CREATED lifecycle status
+func (c *apiPlatformClient) CreateAPI(ctx context.Context, req CreateAPIRequest) (*APIResponse, error) {
+	slog.Debug("Creating API via API Platform", "name", req.Name, "version", req.Version)
+
+	apiReq, err := convertToGenCreateAPIRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.genClient.CreateAPIWithResponse(ctx, apiReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create API: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusCreated && resp.StatusCode() != http.StatusOK {
+		return nil, handleErrorResponse(resp.StatusCode(), resp.Body, ErrorContext{NotFoundErr: utils.ErrProjectNotFound})
+	}
+
+	if resp.JSON201 == nil {
+		return nil, fmt.Errorf("empty response from create API")
+	}
+
+	return convertFromGenAPI(resp.JSON201), nil
+}
+
+// UpdateAPILifeCycleStatus moves an API in API Platform to a lifecycle status, writing the whole API back
+func (c *apiPlatformClient) UpdateAPILifeCycleStatus(ctx context.Context, apiID string, status APILifeCycleStatus) (*APIResponse, error) {
+	slog.Debug("Updating API lifecycle status via API Platform", "apiID", apiID, "status", status)
+
+	resp, err := c.genClient.GetAPIWithResponse(ctx, apiID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, handleErrorResponse(resp.StatusCode(), resp.Body, ErrorContext{NotFoundErr: utils.ErrAPINotFound})
+	}
+
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("empty response from get API")
+	}
+
+	api := resp.JSON200
+	lifeCycleStatus := gen.APILifeCycleStatus(status)
+	api.LifeCycleStatus = &lifeCycleStatus
+
+	updateResp, err := c.genClient.UpdateAPIWithResponse(ctx, apiID, *api)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update API: %w", err)
+	}
+
+	if updateResp.StatusCode() != http.StatusOK {
+		return nil, handleErrorResponse(updateResp.StatusCode(), updateResp.Body, ErrorContext{NotFoundErr: utils.ErrAPINotFound})
+	}
+
+	if updateResp.JSON200 == nil {
+		return nil, fmt.Errorf("empty response from update API")
+	}
+
+	return convertFromGenAPI(updateResp.JSON200), nil
+}
+
+// DeleteAPI deletes an API from API Platform
+func (c *apiPlatformClient) DeleteAPI(ctx context.Context, apiID string) error {
+	slog.Debug("Deleting API via API Platform", "apiID", apiID)
+
+	resp, err := c.genClient.DeleteAPIWithResponse(ctx, apiID)
+	if err != nil {
+		return fmt.Errorf("failed to delete API: %w", err)
+	}
+
+	// API Platform returns 204 No Content on success
+	if resp.StatusCode() != http.StatusNoContent && resp.StatusCode() != http.StatusOK {
+		return handleErrorResponse(resp.StatusCode(), resp.Body, ErrorContext{NotFoundErr: utils.ErrAPINotFound})
+	}
+
+	return nil
+}
+
+// GetDefaultDevPortal retrieves the default DevPortal of the organization from API Platform
+func (c *apiPlatformClient) GetDefaultDevPortal(ctx context.Context) (*DevPortalResponse, error) {
+	slog.Debug("Getting default DevPortal via API Platform")
+
+	resp, err := c.genClient.GetDefaultDevPortalWithResponse(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get default DevPortal: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, handleErrorResponse(resp.StatusCode(), resp.Body, ErrorContext{NotFoundErr: utils.ErrDevPortalNotFound})
+	}
+
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("empty response from get default DevPortal")
+	}
+
+	return convertFromGenDevPortal(resp.JSON200), nil
+}
+
+// PublishAPIToDevPortal publishes an API to a DevPortal in API Platform, or updates its published metadata
+func (c *apiPlatformClient) PublishAPIToDevPortal(ctx context.Context, apiID string, req PublishAPIRequest) error {
+	slog.Debug("Publishing API to DevPortal via API Platform", "apiID", apiID, "devPortalID", req.DevPortalID)
+
+	publishReq, err := convertToGenPublishRequest(req)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.genClient.PublishAPIToDevPortalWithResponse(ctx, apiID, publishReq)
+	if err != nil {
+		return fmt.Errorf("failed to publish API: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return handleErrorResponse(resp.StatusCode(), resp.Body, ErrorContext{NotFoundErr: utils.ErrDevPortalNotFound})
+	}
+
+	return nil
+}
+
+// UnpublishAPIFromDevPortal removes an API from a DevPortal in API Platform
+func (c *apiPlatformClient) UnpublishAPIFromDevPortal(ctx context.Context, apiID string, devPortalID string) error {
+	slog.Debug("Unpublishing API from DevPortal via API Platform", "apiID", apiID, "devPortalID", devPortalID)
+
+	uuid, err := parseUUID(devPortalID)
+	if err != nil {
+		return fmt.Errorf("invalid DevPortal ID: %w", err)
+	}
+
+	resp, err := c.genClient.UnpublishAPIFromDevPortalWithResponse(ctx, apiID, gen.UnpublishAPIFromDevPortalJSONRequestBody{DevPortalUuid: uuid})
+	if err != nil {
+		return fmt.Errorf("failed to unpublish API: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return handleErrorResponse(resp.StatusCode(), resp.Body, ErrorContext{NotFoundErr: utils.ErrDevPortalNotFound})
+	}
+
+	return nil
+}
@@ -37,3 +37,10 @@ const (
 	DefaultMaxRetries   = 3
 	DefaultRetryBackoff = 2 // seconds
 )
+
+// -----------------------------------------------------------------------------
+// Pagination
+// -----------------------------------------------------------------------------
+
+// gatewayPageSize is the page size used when ListGateways pages through all gateways
+const gatewayPageSize = 100
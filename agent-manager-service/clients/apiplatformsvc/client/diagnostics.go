@@ -0,0 +1,281 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// Names of the checks of a gateway diagnosis, in the order they run
+const (
+	DiagnosticCheckDNS               = "dns"
+	DiagnosticCheckTLS               = "tls"
+	DiagnosticCheckAuth              = "auth"
+	DiagnosticCheckGatewayConnection = "gatewayConnection"
+	DiagnosticCheckHealth            = "health"
+	DiagnosticCheckClockSkew         = "clockSkew"
+	DiagnosticCheckWebSocket         = "websocket"
+)
+
+// Outcomes of a diagnostic check
+const (
+	DiagnosticStatusPass    = "pass"
+	DiagnosticStatusWarn    = "warn"
+	DiagnosticStatusFail    = "fail"
+	DiagnosticStatusSkipped = "skipped"
+)
+
+const (
+	// controlPlaneHealthPath is the health endpoint of the API Platform control plane
+	controlPlaneHealthPath = "/health"
+	// gatewayWebSocketPath is the endpoint gateway controllers keep their WebSocket connection to
+	gatewayWebSocketPath = "/api/internal/v1/ws/gateways/connect"
+	// diagnosticCheckTimeout bounds each network check, so that one unreachable endpoint does not
+	// hold up the report
+	diagnosticCheckTimeout = 5 * time.Second
+	// clockSkewWarnThreshold and clockSkewFailThreshold grade the difference between the clocks of
+	// this service and the control plane; tokens are rejected once it exceeds their leeway
+	clockSkewWarnThreshold = 30 * time.Second
+	clockSkewFailThreshold = 5 * time.Minute
+	// certificateExpiryWarning is how long before it expires the control plane certificate is reported
+	certificateExpiryWarning = 14 * 24 * time.Hour
+)
+
+// DiagnosticCheck is the outcome of one check of a gateway diagnosis
+type DiagnosticCheck struct {
+	Name     string
+	Status   string
+	Message  string
+	Duration time.Duration
+}
+
+// GatewayDiagnostics is the report of the checks run against a gateway and the control plane it
+// connects to
+type GatewayDiagnostics struct {
+	GatewayID string
+	// ControlPlaneURL is the URL of the control plane the checks were run against
+	ControlPlaneURL string
+	Checks          []DiagnosticCheck
+}
+
+// DiagnoseGateway checks the connectivity of a gateway to the API Platform control plane: DNS
+// resolution and the TLS handshake of the control plane host, the credentials of this service,
+// the connection of the gateway, the health endpoint, the clock skew and the reachability of the
+// WebSocket endpoint gateways connect to. Failed checks are reported rather than returned.
+func (c *apiPlatformClient) DiagnoseGateway(ctx context.Context, gatewayID string) (*GatewayDiagnostics, error) {
+	base, err := url.Parse(c.baseURL)
+	if err != nil || base.Host == "" {
+		return nil, fmt.Errorf("invalid API Platform base URL %q", c.baseURL)
+	}
+	origin := &url.URL{Scheme: base.Scheme, Host: base.Host}
+	report := &GatewayDiagnostics{GatewayID: gatewayID, ControlPlaneURL: origin.String()}
+
+	run := func(name string, check func(ctx context.Context) (string, string)) {
+		checkCtx, cancel := context.WithTimeout(ctx, diagnosticCheckTimeout)
+		defer cancel()
+		start := time.Now()
+		status, message := check(checkCtx)
+		report.Checks = append(report.Checks, DiagnosticCheck{Name: name, Status: status, Message: message, Duration: time.Since(start)})
+	}
+
+	run(DiagnosticCheckDNS, func(ctx context.Context) (string, string) {
+		return checkDNS(ctx, base.Hostname())
+	})
+	run(DiagnosticCheckTLS, func(ctx context.Context) (string, string) {
+		return checkTLS(ctx, base)
+	})
+
+	var gateway *GatewayResponse
+	run(DiagnosticCheckAuth, func(ctx context.Context) (string, string) {
+		if _, err := c.authProvider.GetToken(ctx); err != nil {
+			return DiagnosticStatusFail, "failed to obtain an access token: " + err.Error()
+		}
+		var err error
+		gateway, err = c.GetGateway(ctx, gatewayID)
+		switch {
+		case err == nil:
+			return DiagnosticStatusPass, "the control plane accepted the credentials of the agent manager"
+		case errors.Is(err, utils.ErrUnauthorized), errors.Is(err, utils.ErrForbidden):
+			return DiagnosticStatusFail, "the control plane rejected the credentials of the agent manager: " + err.Error()
+		default:
+			return DiagnosticStatusFail, "failed to read the gateway: " + err.Error()
+		}
+	})
+
+	run(DiagnosticCheckGatewayConnection, func(context.Context) (string, string) {
+		switch {
+		case gateway == nil:
+			return DiagnosticStatusSkipped, "the gateway could not be read"
+		case gateway.IsActive:
+			return DiagnosticStatusPass, "the gateway is connected to the control plane"
+		default:
+			return DiagnosticStatusFail, "the gateway is not connected to the control plane"
+		}
+	})
+
+	var serverDate time.Time
+	var requestTime time.Time
+	run(DiagnosticCheckHealth, func(ctx context.Context) (string, string) {
+		requestTime = time.Now()
+		status, message, date := c.checkHealth(ctx, origin.JoinPath(controlPlaneHealthPath).String())
+		serverDate = date
+		return status, message
+	})
+	run(DiagnosticCheckClockSkew, func(context.Context) (string, string) {
+		return checkClockSkew(serverDate, requestTime)
+	})
+	run(DiagnosticCheckWebSocket, func(ctx context.Context) (string, string) {
+		wsURL := *origin
+		wsURL.Scheme = "ws"
+		if origin.Scheme == "https" {
+			wsURL.Scheme = "wss"
+		}
+		return c.checkWebSocket(ctx, origin.JoinPath(gatewayWebSocketPath).String(), wsURL.JoinPath(gatewayWebSocketPath).String())
+	})
+
+	return report, nil
+}
+
+func checkDNS(ctx context.Context, host string) (string, string) {
+	if net.ParseIP(host) != nil {
+		return DiagnosticStatusSkipped, host + " is an IP address"
+	}
+	addresses, err := net.DefaultResolver.LookupHost(ctx, host)
+	if err != nil {
+		return DiagnosticStatusFail, "failed to resolve " + host + ": " + err.Error()
+	}
+	return DiagnosticStatusPass, host + " resolves to " + strings.Join(addresses, ", ")
+}
+
+// checkTLS completes a verified TLS handshake with the control plane. Gateways verify the
+// certificate, so an untrusted one is reported even though this service does not verify it.
+func checkTLS(ctx context.Context, base *url.URL) (string, string) {
+	if base.Scheme != "https" {
+		return DiagnosticStatusWarn, "the control plane URL does not use TLS"
+	}
+	address := base.Host
+	if base.Port() == "" {
+		address = net.JoinHostPort(base.Hostname(), "443")
+	}
+
+	dialer := &tls.Dialer{Config: &tls.Config{ServerName: base.Hostname(), MinVersion: tls.VersionTLS12}}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		var unknownAuthority x509.UnknownAuthorityError
+		var invalid x509.CertificateInvalidError
+		var hostname x509.HostnameError
+		if errors.As(err, &unknownAuthority) || errors.As(err, &invalid) || errors.As(err, &hostname) {
+			return DiagnosticStatusWarn, "the certificate of the control plane is not trusted: " + err.Error()
+		}
+		return DiagnosticStatusFail, "TLS handshake with " + address + " failed: " + err.Error()
+	}
+	defer func() { _ = conn.Close() }()
+
+	state := conn.(*tls.Conn).ConnectionState()
+	expiry := state.PeerCertificates[0].NotAfter
+	message := fmt.Sprintf("%s, certificate valid until %s", tls.VersionName(state.Version), expiry.UTC().Format(time.RFC3339))
+	if time.Until(expiry) < certificateExpiryWarning {
+		return DiagnosticStatusWarn, "the certificate of the control plane expires soon: " + message
+	}
+	return DiagnosticStatusPass, message
+}
+
+// checkHealth calls the health endpoint of the control plane and returns the Date of the response
+func (c *apiPlatformClient) checkHealth(ctx context.Context, healthURL string) (string, string, time.Time) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, healthURL, nil)
+	if err != nil {
+		return DiagnosticStatusFail, "failed to create the health request: " + err.Error(), time.Time{}
+	}
+	resp, err := c.diagnosticHTTPClient.Do(req)
+	if err != nil {
+		return DiagnosticStatusFail, "health endpoint unreachable: " + err.Error(), time.Time{}
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	date, _ := http.ParseTime(resp.Header.Get("Date"))
+	if resp.StatusCode >= http.StatusBadRequest {
+		return DiagnosticStatusFail, fmt.Sprintf("health endpoint returned %d", resp.StatusCode), date
+	}
+	return DiagnosticStatusPass, fmt.Sprintf("health endpoint returned %d", resp.StatusCode), date
+}
+
+// checkClockSkew compares the Date of the health response with the time it was requested. The
+// Date header has a resolution of a second, which the thresholds are well above.
+func checkClockSkew(serverDate, requestTime time.Time) (string, string) {
+	if serverDate.IsZero() {
+		return DiagnosticStatusSkipped, "the control plane did not return its time"
+	}
+	skew := serverDate.Sub(requestTime).Truncate(time.Second)
+	if skew < 0 {
+		skew = -skew
+	}
+	message := "the clocks of the agent manager and the control plane differ by " + skew.String()
+	switch {
+	case skew > clockSkewFailThreshold:
+		return DiagnosticStatusFail, message
+	case skew > clockSkewWarnThreshold:
+		return DiagnosticStatusWarn, message
+	default:
+		return DiagnosticStatusPass, message
+	}
+}
+
+// checkWebSocket opens a WebSocket handshake with the endpoint gateways connect to, without
+// credentials. The endpoint is reachable when it upgrades the connection or rejects the handshake
+// for its missing credentials.
+func (c *apiPlatformClient) checkWebSocket(ctx context.Context, httpURL, wsURL string) (string, string) {
+	key := make([]byte, 16)
+	if _, err := rand.Read(key); err != nil {
+		return DiagnosticStatusFail, "failed to create the handshake key: " + err.Error()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, httpURL, nil)
+	if err != nil {
+		return DiagnosticStatusFail, "failed to create the handshake request: " + err.Error()
+	}
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Sec-WebSocket-Version", "13")
+	req.Header.Set("Sec-WebSocket-Key", base64.StdEncoding.EncodeToString(key))
+
+	resp, err := c.diagnosticHTTPClient.Do(req)
+	if err != nil {
+		return DiagnosticStatusFail, wsURL + " is unreachable: " + err.Error()
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusSwitchingProtocols, http.StatusBadRequest, http.StatusUnauthorized, http.StatusForbidden, http.StatusUpgradeRequired:
+		return DiagnosticStatusPass, fmt.Sprintf("%s answered the handshake with %d", wsURL, resp.StatusCode)
+	default:
+		return DiagnosticStatusFail, fmt.Sprintf("%s answered the handshake with %d", wsURL, resp.StatusCode)
+	}
+}
@@ -60,6 +60,8 @@ func handleErrorResponse(statusCode int, body []byte, ctx ErrorContext) error {
 			return fmt.Errorf("%w: %s", ctx.ConflictErr, errMsg)
 		}
 		return fmt.Errorf("conflict: %s", errMsg)
+	case http.StatusTooManyRequests:
+		return fmt.Errorf("%w: rate limited by API Platform: %s", utils.ErrServiceUnavailable, errMsg)
 	case http.StatusInternalServerError:
 		return fmt.Errorf("%w: %s", utils.ErrServiceUnavailable, errMsg)
 	default:
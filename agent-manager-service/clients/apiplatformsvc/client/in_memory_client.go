@@ -0,0 +1,455 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// inMemoryAPIPlatformClient is an APIPlatformClient backed by process memory. It lets the
+// control plane run locally without an API Platform deployment; state is lost on restart.
+type inMemoryAPIPlatformClient struct {
+	mu           sync.RWMutex
+	gateways     map[string]*GatewayResponse
+	tokens       map[string]map[string]*GatewayTokenResponse
+	organization *OrganizationResponse
+	rateLimits   map[string][]RateLimitPolicy
+	// proxyPolicies holds the policies of LLM proxies by proxy ID and policy name
+	proxyPolicies map[string]map[string]LLMProxyPolicy
+	projects      map[string]*ProjectResponse
+	apis          map[string]*APIResponse
+	devPortal     *DevPortalResponse
+	// publications holds the publish requests of APIs by API ID and DevPortal ID
+	publications map[string]map[string]PublishAPIRequest
+}
+
+// NewInMemoryAPIPlatformClient creates an APIPlatformClient that keeps gateways in memory.
+// It is intended for local development only. A default organization is pre-registered so
+// that the client is usable without an onboarding step.
+func NewInMemoryAPIPlatformClient() APIPlatformClient {
+	return &inMemoryAPIPlatformClient{
+		gateways:      make(map[string]*GatewayResponse),
+		tokens:        make(map[string]map[string]*GatewayTokenResponse),
+		rateLimits:    make(map[string][]RateLimitPolicy),
+		proxyPolicies: make(map[string]map[string]LLMProxyPolicy),
+		projects:      make(map[string]*ProjectResponse),
+		apis:          make(map[string]*APIResponse),
+		publications:  make(map[string]map[string]PublishAPIRequest),
+		devPortal: &DevPortalResponse{
+			ID:         uuid.NewString(),
+			Name:       "Default",
+			Identifier: "default",
+			IsDefault:  true,
+			IsEnabled:  true,
+		},
+		organization: &OrganizationResponse{
+			ID:        uuid.NewString(),
+			Name:      "default",
+			Handle:    "default",
+			Region:    "local",
+			CreatedAt: time.Now().UTC(),
+		},
+	}
+}
+
+func (c *inMemoryAPIPlatformClient) CreateGateway(_ context.Context, req CreateGatewayRequest) (*GatewayResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, gw := range c.gateways {
+		if gw.Name == req.Name {
+			return nil, fmt.Errorf("%w: %s", utils.ErrGatewayAlreadyExists, req.Name)
+		}
+	}
+
+	now := time.Now().UTC()
+	gw := &GatewayResponse{
+		ID:                uuid.NewString(),
+		Name:              req.Name,
+		DisplayName:       req.DisplayName,
+		Description:       derefString(req.Description),
+		Vhost:             req.Vhost,
+		FunctionalityType: string(req.FunctionalityType),
+		IsCritical:        derefBool(req.IsCritical),
+		IsActive:          true,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+		Properties:        make(map[string]interface{}),
+	}
+	if gw.FunctionalityType == "" {
+		gw.FunctionalityType = string(FunctionalityTypeRegular)
+	}
+	if req.Properties != nil {
+		gw.Properties = *req.Properties
+	}
+	c.gateways[gw.ID] = gw
+	return copyGateway(gw), nil
+}
+
+func (c *inMemoryAPIPlatformClient) GetGateway(_ context.Context, gatewayID string) (*GatewayResponse, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	gw, ok := c.gateways[gatewayID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", utils.ErrGatewayNotFound, gatewayID)
+	}
+	return copyGateway(gw), nil
+}
+
+func (c *inMemoryAPIPlatformClient) ListGateways(_ context.Context, filters GatewayFilters) (*GatewayListResponse, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	gateways := make([]*GatewayResponse, 0, len(c.gateways))
+	for _, gw := range c.gateways {
+		if filters.FunctionalityType != nil && gw.FunctionalityType != string(*filters.FunctionalityType) {
+			continue
+		}
+		if filters.IsActive != nil && gw.IsActive != *filters.IsActive {
+			continue
+		}
+		gateways = append(gateways, copyGateway(gw))
+	}
+	sort.Slice(gateways, func(i, j int) bool {
+		return gateways[i].CreatedAt.Before(gateways[j].CreatedAt)
+	})
+
+	result := &GatewayListResponse{Total: len(gateways), Limit: len(gateways)}
+	if filters.Offset != nil {
+		result.Offset = min(max(*filters.Offset, 0), len(gateways))
+	}
+	if filters.Limit != nil {
+		result.Limit = max(*filters.Limit, 0)
+	}
+	result.Gateways = gateways[result.Offset:min(result.Offset+result.Limit, len(gateways))]
+	return result, nil
+}
+
+func (c *inMemoryAPIPlatformClient) UpdateGateway(_ context.Context, gatewayID string, req UpdateGatewayRequest) (*GatewayResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	gw, ok := c.gateways[gatewayID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", utils.ErrGatewayNotFound, gatewayID)
+	}
+	if req.DisplayName != nil {
+		gw.DisplayName = *req.DisplayName
+	}
+	if req.Description != nil {
+		gw.Description = *req.Description
+	}
+	if req.IsCritical != nil {
+		gw.IsCritical = *req.IsCritical
+	}
+	if req.Properties != nil {
+		gw.Properties = *req.Properties
+	}
+	gw.UpdatedAt = time.Now().UTC()
+	return copyGateway(gw), nil
+}
+
+func (c *inMemoryAPIPlatformClient) DeleteGateway(_ context.Context, gatewayID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.gateways[gatewayID]; !ok {
+		return fmt.Errorf("%w: %s", utils.ErrGatewayNotFound, gatewayID)
+	}
+	delete(c.gateways, gatewayID)
+	delete(c.tokens, gatewayID)
+	return nil
+}
+
+// DiagnoseGateway reports the gateway checks without network checks, as there is no control plane
+func (c *inMemoryAPIPlatformClient) DiagnoseGateway(_ context.Context, gatewayID string) (*GatewayDiagnostics, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	gw, ok := c.gateways[gatewayID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", utils.ErrGatewayNotFound, gatewayID)
+	}
+	report := &GatewayDiagnostics{GatewayID: gatewayID}
+	for _, name := range []string{DiagnosticCheckDNS, DiagnosticCheckTLS} {
+		report.Checks = append(report.Checks, DiagnosticCheck{Name: name, Status: DiagnosticStatusSkipped, Message: "in-memory API Platform"})
+	}
+	report.Checks = append(report.Checks, DiagnosticCheck{Name: DiagnosticCheckAuth, Status: DiagnosticStatusPass})
+	if gw.IsActive {
+		report.Checks = append(report.Checks, DiagnosticCheck{Name: DiagnosticCheckGatewayConnection, Status: DiagnosticStatusPass})
+	} else {
+		report.Checks = append(report.Checks, DiagnosticCheck{Name: DiagnosticCheckGatewayConnection, Status: DiagnosticStatusFail,
+			Message: "the gateway is not connected to the control plane"})
+	}
+	for _, name := range []string{DiagnosticCheckHealth, DiagnosticCheckClockSkew, DiagnosticCheckWebSocket} {
+		report.Checks = append(report.Checks, DiagnosticCheck{Name: name, Status: DiagnosticStatusSkipped, Message: "in-memory API Platform"})
+	}
+	return report, nil
+}
+
+func (c *inMemoryAPIPlatformClient) RotateGatewayToken(_ context.Context, gatewayID string) (*GatewayTokenResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.gateways[gatewayID]; !ok {
+		return nil, fmt.Errorf("%w: %s", utils.ErrGatewayNotFound, gatewayID)
+	}
+	token := &GatewayTokenResponse{
+		GatewayID: gatewayID,
+		Token:     uuid.NewString(),
+		TokenID:   uuid.NewString(),
+		CreatedAt: time.Now().UTC(),
+	}
+	if c.tokens[gatewayID] == nil {
+		c.tokens[gatewayID] = make(map[string]*GatewayTokenResponse)
+	}
+	c.tokens[gatewayID][token.TokenID] = token
+	result := *token
+	return &result, nil
+}
+
+func (c *inMemoryAPIPlatformClient) RevokeGatewayToken(_ context.Context, gatewayID string, tokenID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.tokens[gatewayID][tokenID]; !ok {
+		return fmt.Errorf("not found: token %s of gateway %s", tokenID, gatewayID)
+	}
+	delete(c.tokens[gatewayID], tokenID)
+	return nil
+}
+
+func (c *inMemoryAPIPlatformClient) GetOrganization(_ context.Context) (*OrganizationResponse, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	result := *c.organization
+	return &result, nil
+}
+
+func (c *inMemoryAPIPlatformClient) RegisterOrganization(_ context.Context, req RegisterOrganizationRequest) (*OrganizationResponse, error) {
+	if _, err := parseUUID(req.ID); err != nil {
+		return nil, fmt.Errorf("invalid organization ID: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.organization = &OrganizationResponse{
+		ID:        req.ID,
+		Name:      req.Name,
+		Handle:    req.Handle,
+		Region:    req.Region,
+		CreatedAt: time.Now().UTC(),
+	}
+	result := *c.organization
+	return &result, nil
+}
+
+// ListLLMProviderRateLimits returns the rate limit policies of a provider; the in-memory client
+// does not keep providers, so an unknown provider has no policies
+func (c *inMemoryAPIPlatformClient) ListLLMProviderRateLimits(_ context.Context, providerID string) ([]RateLimitPolicy, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	policies := append([]RateLimitPolicy{}, c.rateLimits[providerID]...)
+	sortRateLimitPolicies(policies)
+	return policies, nil
+}
+
+func (c *inMemoryAPIPlatformClient) CreateLLMProviderRateLimit(_ context.Context, providerID string, policy RateLimitPolicy) (*RateLimitPolicy, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	policies, err := addRateLimitPolicy(c.rateLimits[providerID], policy)
+	if err != nil {
+		return nil, err
+	}
+	c.rateLimits[providerID] = policies
+	return &policy, nil
+}
+
+func (c *inMemoryAPIPlatformClient) UpdateLLMProviderRateLimit(_ context.Context, providerID string, policy RateLimitPolicy) (*RateLimitPolicy, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	policies, err := replaceRateLimitPolicy(c.rateLimits[providerID], policy)
+	if err != nil {
+		return nil, err
+	}
+	c.rateLimits[providerID] = policies
+	return &policy, nil
+}
+
+func (c *inMemoryAPIPlatformClient) DeleteLLMProviderRateLimit(_ context.Context, providerID string, level RateLimitLevel, resource string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	policies, err := removeRateLimitPolicy(c.rateLimits[providerID], level, resource)
+	if err != nil {
+		return err
+	}
+	c.rateLimits[providerID] = policies
+	return nil
+}
+
+func (c *inMemoryAPIPlatformClient) SetLLMProxyPolicy(_ context.Context, proxyID string, policy LLMProxyPolicy) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.proxyPolicies[proxyID] == nil {
+		c.proxyPolicies[proxyID] = make(map[string]LLMProxyPolicy)
+	}
+	c.proxyPolicies[proxyID][policy.Name] = policy
+	return nil
+}
+
+func (c *inMemoryAPIPlatformClient) RemoveLLMProxyPolicy(_ context.Context, proxyID string, policyName string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.proxyPolicies[proxyID], policyName)
+	return nil
+}
+
+func (c *inMemoryAPIPlatformClient) ListProjects(_ context.Context) ([]*ProjectResponse, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	projects := make([]*ProjectResponse, 0, len(c.projects))
+	for _, project := range c.projects {
+		copied := *project
+		projects = append(projects, &copied)
+	}
+	sort.Slice(projects, func(i, j int) bool { return projects[i].Name < projects[j].Name })
+	return projects, nil
+}
+
+func (c *inMemoryAPIPlatformClient) CreateProject(_ context.Context, req CreateProjectRequest) (*ProjectResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, project := range c.projects {
+		if project.Name == req.Name {
+			return nil, fmt.Errorf("%w: %s", utils.ErrProjectAlreadyExists, req.Name)
+		}
+	}
+	project := &ProjectResponse{ID: uuid.NewString(), Name: req.Name}
+	c.projects[project.ID] = project
+	copied := *project
+	return &copied, nil
+}
+
+func (c *inMemoryAPIPlatformClient) CreateAPI(_ context.Context, req CreateAPIRequest) (*APIResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.projects[req.ProjectID]; !ok {
+		return nil, fmt.Errorf("%w: %s", utils.ErrProjectNotFound, req.ProjectID)
+	}
+	api := &APIResponse{
+		ID:              uuid.NewString(),
+		Name:            req.Name,
+		Version:         req.Version,
+		Context:         req.Context,
+		ProjectID:       req.ProjectID,
+		LifeCycleStatus: APILifeCycleStatusCreated,
+	}
+	c.apis[api.ID] = api
+	copied := *api
+	return &copied, nil
+}
+
+func (c *inMemoryAPIPlatformClient) UpdateAPILifeCycleStatus(_ context.Context, apiID string, status APILifeCycleStatus) (*APIResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	api, ok := c.apis[apiID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", utils.ErrAPINotFound, apiID)
+	}
+	api.LifeCycleStatus = status
+	copied := *api
+	return &copied, nil
+}
+
+func (c *inMemoryAPIPlatformClient) DeleteAPI(_ context.Context, apiID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.apis[apiID]; !ok {
+		return fmt.Errorf("%w: %s", utils.ErrAPINotFound, apiID)
+	}
+	delete(c.apis, apiID)
+	delete(c.publications, apiID)
+	return nil
+}
+
+func (c *inMemoryAPIPlatformClient) GetDefaultDevPortal(_ context.Context) (*DevPortalResponse, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	copied := *c.devPortal
+	return &copied, nil
+}
+
+func (c *inMemoryAPIPlatformClient) PublishAPIToDevPortal(_ context.Context, apiID string, req PublishAPIRequest) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.apis[apiID]; !ok {
+		return fmt.Errorf("%w: %s", utils.ErrAPINotFound, apiID)
+	}
+	if req.DevPortalID != c.devPortal.ID {
+		return fmt.Errorf("%w: %s", utils.ErrDevPortalNotFound, req.DevPortalID)
+	}
+	if c.publications[apiID] == nil {
+		c.publications[apiID] = make(map[string]PublishAPIRequest)
+	}
+	c.publications[apiID][req.DevPortalID] = req
+	return nil
+}
+
+func (c *inMemoryAPIPlatformClient) UnpublishAPIFromDevPortal(_ context.Context, apiID string, devPortalID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.apis[apiID]; !ok {
+		return fmt.Errorf("%w: %s", utils.ErrAPINotFound, apiID)
+	}
+	delete(c.publications[apiID], devPortalID)
+	return nil
+}
+
+// copyGateway returns a copy so that callers cannot mutate the stored gateway
+func copyGateway(gw *GatewayResponse) *GatewayResponse {
+	result := *gw
+	result.Properties = make(map[string]interface{}, len(gw.Properties))
+	for k, v := range gw.Properties {
+		result.Properties[k] = v
+	}
+	return &result
+}
@@ -0,0 +1,320 @@
+//
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package client
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/apiplatformsvc/gen"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// validateRateLimitPolicy checks a policy on its own, without regard to the other policies of the provider
+func validateRateLimitPolicy(policy RateLimitPolicy) error {
+	if policy.Level != RateLimitLevelProvider && policy.Level != RateLimitLevelConsumer {
+		return fmt.Errorf("%w: rate limit level must be %s or %s", utils.ErrInvalidInput, RateLimitLevelProvider, RateLimitLevelConsumer)
+	}
+	if policy.Resource != "" && policy.Resource[0] != '/' {
+		return fmt.Errorf("%w: rate limit resource must start with /", utils.ErrInvalidInput)
+	}
+	if policy.Requests == nil && policy.Tokens == nil && policy.Cost == nil {
+		return fmt.Errorf("%w: rate limit policy must limit requests, tokens or cost", utils.ErrInvalidInput)
+	}
+	if policy.Requests != nil {
+		if err := validateRateLimit("requests", float64(policy.Requests.Count), policy.Requests.Window); err != nil {
+			return err
+		}
+	}
+	if policy.Tokens != nil {
+		if err := validateRateLimit("tokens", float64(policy.Tokens.Count), policy.Tokens.Window); err != nil {
+			return err
+		}
+	}
+	if policy.Cost != nil {
+		if err := validateRateLimit("cost", policy.Cost.Amount, policy.Cost.Window); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateRateLimit(dimension string, limit float64, window RateLimitWindow) error {
+	if limit <= 0 {
+		return fmt.Errorf("%w: %s limit must be greater than 0", utils.ErrInvalidInput, dimension)
+	}
+	if window.Duration <= 0 {
+		return fmt.Errorf("%w: %s window duration must be greater than 0", utils.ErrInvalidInput, dimension)
+	}
+	switch window.Unit {
+	case RateLimitWindowMinute, RateLimitWindowHour, RateLimitWindowDay, RateLimitWindowWeek, RateLimitWindowMonth:
+		return nil
+	default:
+		return fmt.Errorf("%w: %s window unit %q is not supported", utils.ErrInvalidInput, dimension, window.Unit)
+	}
+}
+
+// checkRateLimitConflicts checks the policies of a provider against each other. A level can only
+// have one policy per resource, resource policies need a default policy of the same level, and a
+// consumer limit above the provider limit of the same window could never be reached.
+func checkRateLimitConflicts(policies []RateLimitPolicy) error {
+	byKey := make(map[RateLimitLevel]map[string]RateLimitPolicy)
+	for _, policy := range policies {
+		if byKey[policy.Level] == nil {
+			byKey[policy.Level] = make(map[string]RateLimitPolicy)
+		}
+		if _, ok := byKey[policy.Level][policy.Resource]; ok {
+			return fmt.Errorf("%w: %s", utils.ErrRateLimitConflict, describeRateLimitPolicy(policy))
+		}
+		byKey[policy.Level][policy.Resource] = policy
+	}
+
+	for level, levelPolicies := range byKey {
+		if _, ok := levelPolicies[""]; !ok {
+			return fmt.Errorf("%w: %s level has resource policies but no default policy", utils.ErrRateLimitConflict, level)
+		}
+	}
+
+	providerPolicies := byKey[RateLimitLevelProvider]
+	for resource, consumer := range byKey[RateLimitLevelConsumer] {
+		provider, ok := providerPolicies[resource]
+		if !ok {
+			provider, ok = providerPolicies[""]
+		}
+		if !ok {
+			continue
+		}
+		if exceedsRateLimitCount(consumer.Requests, provider.Requests) ||
+			exceedsRateLimitCount(consumer.Tokens, provider.Tokens) ||
+			(consumer.Cost != nil && provider.Cost != nil && consumer.Cost.Window == provider.Cost.Window && consumer.Cost.Amount > provider.Cost.Amount) {
+			return fmt.Errorf("%w: %s exceeds the provider limit and could never be reached", utils.ErrRateLimitConflict, describeRateLimitPolicy(consumer))
+		}
+	}
+	return nil
+}
+
+func exceedsRateLimitCount(consumer, provider *RateLimitCount) bool {
+	return consumer != nil && provider != nil && consumer.Window == provider.Window && consumer.Count > provider.Count
+}
+
+func describeRateLimitPolicy(policy RateLimitPolicy) string {
+	if policy.Resource == "" {
+		return fmt.Sprintf("default %s rate limit", policy.Level)
+	}
+	return fmt.Sprintf("%s rate limit of %s", policy.Level, policy.Resource)
+}
+
+// findRateLimitPolicy returns the index of the policy of a level and resource, or -1
+func findRateLimitPolicy(policies []RateLimitPolicy, level RateLimitLevel, resource string) int {
+	for i, policy := range policies {
+		if policy.Level == level && policy.Resource == resource {
+			return i
+		}
+	}
+	return -1
+}
+
+// addRateLimitPolicy returns the policies with a new policy added
+func addRateLimitPolicy(policies []RateLimitPolicy, policy RateLimitPolicy) ([]RateLimitPolicy, error) {
+	if err := validateRateLimitPolicy(policy); err != nil {
+		return nil, err
+	}
+	if findRateLimitPolicy(policies, policy.Level, policy.Resource) >= 0 {
+		return nil, fmt.Errorf("%w: %s already exists", utils.ErrRateLimitConflict, describeRateLimitPolicy(policy))
+	}
+	updated := append(append([]RateLimitPolicy{}, policies...), policy)
+	if err := checkRateLimitConflicts(updated); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// replaceRateLimitPolicy returns the policies with the policy of the same level and resource replaced
+func replaceRateLimitPolicy(policies []RateLimitPolicy, policy RateLimitPolicy) ([]RateLimitPolicy, error) {
+	if err := validateRateLimitPolicy(policy); err != nil {
+		return nil, err
+	}
+	i := findRateLimitPolicy(policies, policy.Level, policy.Resource)
+	if i < 0 {
+		return nil, fmt.Errorf("%w: %s", utils.ErrRateLimitNotFound, describeRateLimitPolicy(policy))
+	}
+	updated := append([]RateLimitPolicy{}, policies...)
+	updated[i] = policy
+	if err := checkRateLimitConflicts(updated); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// removeRateLimitPolicy returns the policies without the policy of a level and resource
+func removeRateLimitPolicy(policies []RateLimitPolicy, level RateLimitLevel, resource string) ([]RateLimitPolicy, error) {
+	i := findRateLimitPolicy(policies, level, resource)
+	if i < 0 {
+		return nil, fmt.Errorf("%w: %s", utils.ErrRateLimitNotFound, describeRateLimitPolicy(RateLimitPolicy{Level: level, Resource: resource}))
+	}
+	updated := append(append([]RateLimitPolicy{}, policies[:i]...), policies[i+1:]...)
+	if err := checkRateLimitConflicts(updated); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// sortRateLimitPolicies orders policies by level, with the default policy of a level first
+func sortRateLimitPolicies(policies []RateLimitPolicy) {
+	sort.Slice(policies, func(i, j int) bool {
+		if policies[i].Level != policies[j].Level {
+			return policies[i].Level == RateLimitLevelProvider
+		}
+		return policies[i].Resource < policies[j].Resource
+	})
+}
+
+// convertToGenRateLimiting converts rate limit policies to the generated provider and consumer level
+// configuration. A level without resource policies gets a global limit, otherwise resource-wise
+// limits with the default policy of the level.
+func convertToGenRateLimiting(policies []RateLimitPolicy) *gen.LLMRateLimitingConfig {
+	if len(policies) == 0 {
+		return nil
+	}
+	config := &gen.LLMRateLimitingConfig{}
+	for _, level := range []RateLimitLevel{RateLimitLevelProvider, RateLimitLevelConsumer} {
+		var scope *gen.RateLimitingScopeConfig
+		var resources []gen.RateLimitingResourceLimit
+		for _, policy := range policies {
+			if policy.Level != level {
+				continue
+			}
+			if scope == nil {
+				scope = &gen.RateLimitingScopeConfig{}
+			}
+			limit := convertToGenRateLimit(policy)
+			if policy.Resource == "" {
+				scope.Global = &limit
+			} else {
+				resources = append(resources, gen.RateLimitingResourceLimit{Resource: policy.Resource, Limit: limit})
+			}
+		}
+		if scope == nil {
+			continue
+		}
+		if len(resources) > 0 {
+			sort.Slice(resources, func(i, j int) bool { return resources[i].Resource < resources[j].Resource })
+			defaultLimit := gen.RateLimitingLimitConfig{}
+			if scope.Global != nil {
+				defaultLimit = *scope.Global
+			}
+			scope.Global = nil
+			scope.ResourceWise = &gen.ResourceWiseRateLimitingConfig{Default: defaultLimit, Resources: resources}
+		}
+		if level == RateLimitLevelProvider {
+			config.ProviderLevel = scope
+		} else {
+			config.ConsumerLevel = scope
+		}
+	}
+	return config
+}
+
+func convertToGenRateLimit(policy RateLimitPolicy) gen.RateLimitingLimitConfig {
+	limit := gen.RateLimitingLimitConfig{}
+	if policy.Requests != nil {
+		count := policy.Requests.Count
+		limit.Request = &gen.RequestRateLimitDimension{
+			Enabled: ptrBool(true),
+			Count:   &count,
+			Reset:   convertToGenRateLimitWindow(policy.Requests.Window),
+		}
+	}
+	if policy.Tokens != nil {
+		count := policy.Tokens.Count
+		limit.Token = &gen.TokenRateLimitDimension{
+			Enabled: ptrBool(true),
+			Count:   &count,
+			Reset:   convertToGenRateLimitWindow(policy.Tokens.Window),
+		}
+	}
+	if policy.Cost != nil {
+		amount := float32(policy.Cost.Amount)
+		limit.Cost = &gen.CostRateLimitDimension{
+			Enabled: ptrBool(true),
+			Amount:  &amount,
+			Reset:   convertToGenRateLimitWindow(policy.Cost.Window),
+		}
+	}
+	return limit
+}
+
+func convertToGenRateLimitWindow(window RateLimitWindow) *gen.RateLimitResetWindow {
+	return &gen.RateLimitResetWindow{Duration: window.Duration, Unit: gen.RateLimitResetWindowUnit(window.Unit)}
+}
+
+// convertFromGenRateLimiting converts the generated rate limiting configuration of a provider to
+// policies. Disabled dimensions are left out, so a limit without enabled dimensions has no policy.
+func convertFromGenRateLimiting(config *gen.LLMRateLimitingConfig) []RateLimitPolicy {
+	policies := []RateLimitPolicy{}
+	if config == nil {
+		return policies
+	}
+	for _, scope := range []struct {
+		level  RateLimitLevel
+		config *gen.RateLimitingScopeConfig
+	}{
+		{RateLimitLevelProvider, config.ProviderLevel},
+		{RateLimitLevelConsumer, config.ConsumerLevel},
+	} {
+		if scope.config == nil {
+			continue
+		}
+		if scope.config.Global != nil {
+			policies = appendGenRateLimit(policies, scope.level, "", *scope.config.Global)
+		}
+		if scope.config.ResourceWise != nil {
+			policies = appendGenRateLimit(policies, scope.level, "", scope.config.ResourceWise.Default)
+			for _, resource := range scope.config.ResourceWise.Resources {
+				policies = appendGenRateLimit(policies, scope.level, resource.Resource, resource.Limit)
+			}
+		}
+	}
+	sortRateLimitPolicies(policies)
+	return policies
+}
+
+func appendGenRateLimit(policies []RateLimitPolicy, level RateLimitLevel, resource string, limit gen.RateLimitingLimitConfig) []RateLimitPolicy {
+	policy := RateLimitPolicy{Level: level, Resource: resource}
+	if limit.Request != nil && derefBool(limit.Request.Enabled) && limit.Request.Count != nil {
+		policy.Requests = &RateLimitCount{Count: *limit.Request.Count, Window: convertFromGenRateLimitWindow(limit.Request.Reset)}
+	}
+	if limit.Token != nil && derefBool(limit.Token.Enabled) && limit.Token.Count != nil {
+		policy.Tokens = &RateLimitCount{Count: *limit.Token.Count, Window: convertFromGenRateLimitWindow(limit.Token.Reset)}
+	}
+	if limit.Cost != nil && derefBool(limit.Cost.Enabled) && limit.Cost.Amount != nil {
+		policy.Cost = &RateLimitAmount{Amount: float64(*limit.Cost.Amount), Window: convertFromGenRateLimitWindow(limit.Cost.Reset)}
+	}
+	if policy.Requests == nil && policy.Tokens == nil && policy.Cost == nil {
+		return policies
+	}
+	return append(policies, policy)
+}
+
+func convertFromGenRateLimitWindow(window *gen.RateLimitResetWindow) RateLimitWindow {
+	if window == nil {
+		return RateLimitWindow{}
+	}
+	return RateLimitWindow{Duration: window.Duration, Unit: RateLimitWindowUnit(window.Unit)}
+}
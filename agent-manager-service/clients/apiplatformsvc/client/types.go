@@ -75,6 +75,24 @@ type GatewayResponse struct {
 	Properties        map[string]interface{}
 }
 
+// GatewayFilters narrows down and pages the gateways returned by ListGateways
+type GatewayFilters struct {
+	FunctionalityType *FunctionalityType
+	IsActive          *bool
+	// Limit is the page size. Without a limit every matching gateway is returned.
+	Limit  *int
+	Offset *int
+}
+
+// GatewayListResponse represents a page of gateways from API Platform
+type GatewayListResponse struct {
+	Gateways []*GatewayResponse
+	// Total is the number of gateways matching the filters across all pages
+	Total  int
+	Limit  int
+	Offset int
+}
+
 // GatewayTokenResponse represents a gateway token rotation response
 type GatewayTokenResponse struct {
 	GatewayID string
@@ -104,3 +122,155 @@ type OrganizationResponse struct {
 	Region    string
 	CreatedAt time.Time
 }
+
+// -----------------------------------------------------------------------------
+// LLM Provider Rate Limit Types
+// -----------------------------------------------------------------------------
+
+// RateLimitLevel defines whose traffic a rate limit policy counts
+type RateLimitLevel string
+
+const (
+	// RateLimitLevelProvider counts all traffic to the LLM provider together
+	RateLimitLevelProvider RateLimitLevel = "provider"
+	// RateLimitLevelConsumer counts the traffic of each consumer (API key) separately
+	RateLimitLevelConsumer RateLimitLevel = "consumer"
+)
+
+// RateLimitWindowUnit defines the time unit of a rate limit window
+type RateLimitWindowUnit string
+
+const (
+	RateLimitWindowMinute RateLimitWindowUnit = "minute"
+	RateLimitWindowHour   RateLimitWindowUnit = "hour"
+	RateLimitWindowDay    RateLimitWindowUnit = "day"
+	RateLimitWindowWeek   RateLimitWindowUnit = "week"
+	RateLimitWindowMonth  RateLimitWindowUnit = "month"
+)
+
+// RateLimitWindow is the period after which a limit resets, e.g. 1 hour
+type RateLimitWindow struct {
+	Duration int
+	Unit     RateLimitWindowUnit
+}
+
+// RateLimitCount limits the number of requests or tokens within a window
+type RateLimitCount struct {
+	Count  int
+	Window RateLimitWindow
+}
+
+// RateLimitAmount limits the cost within a window
+type RateLimitAmount struct {
+	Amount float64
+	Window RateLimitWindow
+}
+
+// RateLimitPolicy is a rate limit of an LLM provider. A policy is identified by its level and
+// resource; a level with resource policies requires a policy without a resource as the default.
+type RateLimitPolicy struct {
+	Level RateLimitLevel
+	// Resource is the route the policy applies to, e.g. /chat/completions; empty applies to all routes
+	Resource string
+	Requests *RateLimitCount
+	Tokens   *RateLimitCount
+	Cost     *RateLimitAmount
+}
+
+// -----------------------------------------------------------------------------
+// LLM Proxy Policy Types
+// -----------------------------------------------------------------------------
+
+// LLMProxyPolicy is a gateway policy attached to all routes of an LLM proxy
+type LLMProxyPolicy struct {
+	Name    string
+	Version string
+	// Params are the parameters of the policy, as defined by the policy's JSON schema
+	Params map[string]interface{}
+}
+
+// -----------------------------------------------------------------------------
+// API Publishing Types
+// -----------------------------------------------------------------------------
+
+// APILifeCycleStatus is the lifecycle status of an API in API Platform
+type APILifeCycleStatus string
+
+const (
+	APILifeCycleStatusCreated    APILifeCycleStatus = "CREATED"
+	APILifeCycleStatusPublished  APILifeCycleStatus = "PUBLISHED"
+	APILifeCycleStatusDeprecated APILifeCycleStatus = "DEPRECATED"
+	APILifeCycleStatusRetired    APILifeCycleStatus = "RETIRED"
+)
+
+// APIVisibility controls who can see an API in a developer portal
+type APIVisibility string
+
+const (
+	APIVisibilityPublic     APIVisibility = "public"
+	APIVisibilityPrivate    APIVisibility = "private"
+	APIVisibilityRestricted APIVisibility = "restricted"
+)
+
+// CreateProjectRequest contains data for creating a project in API Platform
+type CreateProjectRequest struct {
+	Name        string
+	Description *string
+}
+
+// ProjectResponse is a project in API Platform, grouping its APIs
+type ProjectResponse struct {
+	ID   string
+	Name string
+}
+
+// APIOperation is an operation exposed by an API
+type APIOperation struct {
+	Method string
+	Path   string
+}
+
+// CreateAPIRequest contains data for creating an API that routes to a backend URL
+type CreateAPIRequest struct {
+	ProjectID   string
+	Name        string
+	Version     string
+	Context     string
+	Description *string
+	Kind        string
+	UpstreamURL string
+	Operations  []APIOperation
+}
+
+// APIResponse is an API in API Platform
+type APIResponse struct {
+	ID              string
+	Name            string
+	Version         string
+	Context         string
+	ProjectID       string
+	LifeCycleStatus APILifeCycleStatus
+}
+
+// DevPortalResponse is a developer portal APIs are published to
+type DevPortalResponse struct {
+	ID         string
+	Name       string
+	Identifier string
+	UIURL      string
+	IsDefault  bool
+	IsEnabled  bool
+}
+
+// PublishAPIRequest contains the metadata of an API shown in a developer portal
+type PublishAPIRequest struct {
+	DevPortalID   string
+	Name          string
+	Description   string
+	Type          string
+	Tags          []string
+	Visibility    APIVisibility
+	VisibleGroups []string
+	ProductionURL string
+	SandboxURL    string
+}
@@ -19,7 +19,10 @@
 package client
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
@@ -98,6 +101,42 @@ func convertFromGenGatewayResponse(gw *gen.GatewayResponse) *GatewayResponse {
 	return result
 }
 
+// convertFromGenGatewayListResponse converts a generated page of gateways to client type
+func convertFromGenGatewayListResponse(list *gen.GatewayListResponse) *GatewayListResponse {
+	gateways := make([]*GatewayResponse, len(list.List))
+	for i := range list.List {
+		gateways[i] = convertFromGenGatewayResponse(&list.List[i])
+	}
+	return &GatewayListResponse{
+		Gateways: gateways,
+		Total:    list.Pagination.Total,
+		Limit:    list.Pagination.Limit,
+		Offset:   list.Pagination.Offset,
+	}
+}
+
+// withGatewayFilters adds the gateway filters to the query of a list gateways request. The
+// generated client takes no parameters for the operation, so they are set on the request.
+func withGatewayFilters(filters GatewayFilters) gen.RequestEditorFn {
+	return func(_ context.Context, req *http.Request) error {
+		query := req.URL.Query()
+		if filters.FunctionalityType != nil {
+			query.Set("functionalityType", string(*filters.FunctionalityType))
+		}
+		if filters.IsActive != nil {
+			query.Set("isActive", strconv.FormatBool(*filters.IsActive))
+		}
+		if filters.Limit != nil {
+			query.Set("limit", strconv.Itoa(*filters.Limit))
+		}
+		if filters.Offset != nil {
+			query.Set("offset", strconv.Itoa(*filters.Offset))
+		}
+		req.URL.RawQuery = query.Encode()
+		return nil
+	}
+}
+
 // convertFromGenTokenRotationResponse converts generated TokenRotationResponse to client GatewayTokenResponse type
 func convertFromGenTokenRotationResponse(gtr *gen.TokenRotationResponse, gatewayID string) *GatewayTokenResponse {
 	if gtr == nil {
@@ -174,6 +213,14 @@ func parseUUID(s string) (openapi_types.UUID, error) {
 	return openapi_types.UUID(parsed), nil
 }
 
+// uuidString returns the string form of an optional UUID, or an empty string
+func uuidString(id *openapi_types.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
 // convertFromGenOrganizationResponse converts generated Organization to client OrganizationResponse type
 func convertFromGenOrganizationResponse(org *gen.Organization) *OrganizationResponse {
 	if org == nil {
@@ -198,3 +245,124 @@ func convertFromGenOrganizationResponse(org *gen.Organization) *OrganizationResp
 
 	return result
 }
+
+// convertToGenLLMPolicy converts an LLM proxy policy to a generated policy applied to all routes and methods
+func convertToGenLLMPolicy(policy LLMProxyPolicy) gen.LLMPolicy {
+	return gen.LLMPolicy{
+		Name:    policy.Name,
+		Version: policy.Version,
+		Paths: []gen.LLMPolicyPath{{
+			Path:    "/*",
+			Methods: []gen.LLMPolicyPathMethods{gen.LLMPolicyPathMethodsAsterisk},
+			Params:  policy.Params,
+		}},
+	}
+}
+
+// removeGenLLMPolicy returns the generated policies without the policies of a name
+func removeGenLLMPolicy(policies []gen.LLMPolicy, name string) []gen.LLMPolicy {
+	result := make([]gen.LLMPolicy, 0, len(policies))
+	for _, policy := range policies {
+		if policy.Name != name {
+			result = append(result, policy)
+		}
+	}
+	return result
+}
+
+// convertFromGenAPI converts a generated API to the client response type
+func convertFromGenAPI(api *gen.API) *APIResponse {
+	result := &APIResponse{
+		ID:        derefString(api.Id),
+		Name:      api.Name,
+		Version:   api.Version,
+		Context:   api.Context,
+		ProjectID: api.ProjectId.String(),
+	}
+	if api.LifeCycleStatus != nil {
+		result.LifeCycleStatus = APILifeCycleStatus(*api.LifeCycleStatus)
+	}
+	return result
+}
+
+// convertToGenCreateAPIRequest converts a create API request to the generated request type
+func convertToGenCreateAPIRequest(req CreateAPIRequest) (gen.CreateAPIRequest, error) {
+	projectID, err := parseUUID(req.ProjectID)
+	if err != nil {
+		return gen.CreateAPIRequest{}, fmt.Errorf("invalid project ID: %w", err)
+	}
+	status := gen.CreateAPIRequestLifeCycleStatus(APILifeCycleStatusCreated)
+	apiReq := gen.CreateAPIRequest{
+		ProjectId:       projectID,
+		Name:            req.Name,
+		Version:         req.Version,
+		Context:         req.Context,
+		Description:     req.Description,
+		LifeCycleStatus: &status,
+		Upstream:        gen.Upstream{Main: gen.UpstreamDefinition{Url: ptrString(req.UpstreamURL)}},
+	}
+	if req.Kind != "" {
+		apiReq.Kind = ptrString(req.Kind)
+	}
+	if len(req.Operations) > 0 {
+		operations := make([]gen.Operation, 0, len(req.Operations))
+		for _, op := range req.Operations {
+			operations = append(operations, gen.Operation{
+				Request: gen.OperationRequest{Method: gen.OperationRequestMethod(op.Method), Path: op.Path},
+			})
+		}
+		apiReq.Operations = &operations
+	}
+	return apiReq, nil
+}
+
+// convertToGenPublishRequest converts a publish API request to the generated request type
+func convertToGenPublishRequest(req PublishAPIRequest) (gen.PublishToDevPortalRequest, error) {
+	devPortalID, err := parseUUID(req.DevPortalID)
+	if err != nil {
+		return gen.PublishToDevPortalRequest{}, fmt.Errorf("invalid DevPortal ID: %w", err)
+	}
+	info := &gen.PublishAPIInfo{}
+	if req.Name != "" {
+		info.ApiName = ptrString(req.Name)
+	}
+	if req.Description != "" {
+		info.ApiDescription = ptrString(req.Description)
+	}
+	if req.Type != "" {
+		info.ApiType = ptrString(req.Type)
+	}
+	if len(req.Tags) > 0 {
+		info.Tags = &req.Tags
+	}
+	if req.Visibility != "" {
+		visibility := gen.PublishAPIInfoVisibility(req.Visibility)
+		info.Visibility = &visibility
+	}
+	if len(req.VisibleGroups) > 0 {
+		info.VisibleGroups = &req.VisibleGroups
+	}
+	publishReq := gen.PublishToDevPortalRequest{
+		DevPortalUuid: devPortalID,
+		ApiInfo:       info,
+	}
+	if req.ProductionURL != "" {
+		publishReq.EndPoints.ProductionURL = ptrString(req.ProductionURL)
+	}
+	if req.SandboxURL != "" {
+		publishReq.EndPoints.SandboxURL = ptrString(req.SandboxURL)
+	}
+	return publishReq, nil
+}
+
+// convertFromGenDevPortal converts a generated DevPortal to the client response type
+func convertFromGenDevPortal(portal *gen.DevPortalResponse) *DevPortalResponse {
+	return &DevPortalResponse{
+		ID:         portal.Uuid.String(),
+		Name:       portal.Name,
+		Identifier: portal.Identifier,
+		UIURL:      portal.UiUrl,
+		IsDefault:  portal.IsDefault,
+		IsEnabled:  portal.IsEnabled,
+	}
+}
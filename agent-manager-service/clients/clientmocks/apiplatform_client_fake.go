@@ -16,33 +16,78 @@ import (
 //
 //		// make and configure a mocked client.APIPlatformClient
 //		mockedAPIPlatformClient := &APIPlatformClientMock{
+//			CreateAPIFunc: func(ctx context.Context, req client.CreateAPIRequest) (*client.APIResponse, error) {
+//				panic("mock out the CreateAPI method")
+//			},
 //			CreateGatewayFunc: func(ctx context.Context, req client.CreateGatewayRequest) (*client.GatewayResponse, error) {
 //				panic("mock out the CreateGateway method")
 //			},
+//			CreateLLMProviderRateLimitFunc: func(ctx context.Context, providerID string, policy client.RateLimitPolicy) (*client.RateLimitPolicy, error) {
+//				panic("mock out the CreateLLMProviderRateLimit method")
+//			},
+//			CreateProjectFunc: func(ctx context.Context, req client.CreateProjectRequest) (*client.ProjectResponse, error) {
+//				panic("mock out the CreateProject method")
+//			},
+//			DeleteAPIFunc: func(ctx context.Context, apiID string) error {
+//				panic("mock out the DeleteAPI method")
+//			},
 //			DeleteGatewayFunc: func(ctx context.Context, gatewayID string) error {
 //				panic("mock out the DeleteGateway method")
 //			},
+//			DeleteLLMProviderRateLimitFunc: func(ctx context.Context, providerID string, level client.RateLimitLevel, resource string) error {
+//				panic("mock out the DeleteLLMProviderRateLimit method")
+//			},
+//			DiagnoseGatewayFunc: func(ctx context.Context, gatewayID string) (*client.GatewayDiagnostics, error) {
+//				panic("mock out the DiagnoseGateway method")
+//			},
+//			GetDefaultDevPortalFunc: func(ctx context.Context) (*client.DevPortalResponse, error) {
+//				panic("mock out the GetDefaultDevPortal method")
+//			},
 //			GetGatewayFunc: func(ctx context.Context, gatewayID string) (*client.GatewayResponse, error) {
 //				panic("mock out the GetGateway method")
 //			},
 //			GetOrganizationFunc: func(ctx context.Context) (*client.OrganizationResponse, error) {
 //				panic("mock out the GetOrganization method")
 //			},
-//			ListGatewaysFunc: func(ctx context.Context) ([]*client.GatewayResponse, error) {
+//			ListGatewaysFunc: func(ctx context.Context, filters client.GatewayFilters) (*client.GatewayListResponse, error) {
 //				panic("mock out the ListGateways method")
 //			},
+//			ListLLMProviderRateLimitsFunc: func(ctx context.Context, providerID string) ([]client.RateLimitPolicy, error) {
+//				panic("mock out the ListLLMProviderRateLimits method")
+//			},
+//			ListProjectsFunc: func(ctx context.Context) ([]*client.ProjectResponse, error) {
+//				panic("mock out the ListProjects method")
+//			},
+//			PublishAPIToDevPortalFunc: func(ctx context.Context, apiID string, req client.PublishAPIRequest) error {
+//				panic("mock out the PublishAPIToDevPortal method")
+//			},
 //			RegisterOrganizationFunc: func(ctx context.Context, req client.RegisterOrganizationRequest) (*client.OrganizationResponse, error) {
 //				panic("mock out the RegisterOrganization method")
 //			},
+//			RemoveLLMProxyPolicyFunc: func(ctx context.Context, proxyID string, policyName string) error {
+//				panic("mock out the RemoveLLMProxyPolicy method")
+//			},
 //			RevokeGatewayTokenFunc: func(ctx context.Context, gatewayID string, tokenID string) error {
 //				panic("mock out the RevokeGatewayToken method")
 //			},
 //			RotateGatewayTokenFunc: func(ctx context.Context, gatewayID string) (*client.GatewayTokenResponse, error) {
 //				panic("mock out the RotateGatewayToken method")
 //			},
+//			SetLLMProxyPolicyFunc: func(ctx context.Context, proxyID string, policy client.LLMProxyPolicy) error {
+//				panic("mock out the SetLLMProxyPolicy method")
+//			},
+//			UnpublishAPIFromDevPortalFunc: func(ctx context.Context, apiID string, devPortalID string) error {
+//				panic("mock out the UnpublishAPIFromDevPortal method")
+//			},
+//			UpdateAPILifeCycleStatusFunc: func(ctx context.Context, apiID string, status client.APILifeCycleStatus) (*client.APIResponse, error) {
+//				panic("mock out the UpdateAPILifeCycleStatus method")
+//			},
 //			UpdateGatewayFunc: func(ctx context.Context, gatewayID string, req client.UpdateGatewayRequest) (*client.GatewayResponse, error) {
 //				panic("mock out the UpdateGateway method")
 //			},
+//			UpdateLLMProviderRateLimitFunc: func(ctx context.Context, providerID string, policy client.RateLimitPolicy) (*client.RateLimitPolicy, error) {
+//				panic("mock out the UpdateLLMProviderRateLimit method")
+//			},
 //		}
 //
 //		// use mockedAPIPlatformClient in code that requires client.APIPlatformClient
@@ -50,12 +95,33 @@ import (
 //
 //	}
 type APIPlatformClientMock struct {
+	// CreateAPIFunc mocks the CreateAPI method.
+	CreateAPIFunc func(ctx context.Context, req client.CreateAPIRequest) (*client.APIResponse, error)
+
 	// CreateGatewayFunc mocks the CreateGateway method.
 	CreateGatewayFunc func(ctx context.Context, req client.CreateGatewayRequest) (*client.GatewayResponse, error)
 
+	// CreateLLMProviderRateLimitFunc mocks the CreateLLMProviderRateLimit method.
+	CreateLLMProviderRateLimitFunc func(ctx context.Context, providerID string, policy client.RateLimitPolicy) (*client.RateLimitPolicy, error)
+
+	// CreateProjectFunc mocks the CreateProject method.
+	CreateProjectFunc func(ctx context.Context, req client.CreateProjectRequest) (*client.ProjectResponse, error)
+
+	// DeleteAPIFunc mocks the DeleteAPI method.
+	DeleteAPIFunc func(ctx context.Context, apiID string) error
+
 	// DeleteGatewayFunc mocks the DeleteGateway method.
 	DeleteGatewayFunc func(ctx context.Context, gatewayID string) error
 
+	// DeleteLLMProviderRateLimitFunc mocks the DeleteLLMProviderRateLimit method.
+	DeleteLLMProviderRateLimitFunc func(ctx context.Context, providerID string, level client.RateLimitLevel, resource string) error
+
+	// DiagnoseGatewayFunc mocks the DiagnoseGateway method.
+	DiagnoseGatewayFunc func(ctx context.Context, gatewayID string) (*client.GatewayDiagnostics, error)
+
+	// GetDefaultDevPortalFunc mocks the GetDefaultDevPortal method.
+	GetDefaultDevPortalFunc func(ctx context.Context) (*client.DevPortalResponse, error)
+
 	// GetGatewayFunc mocks the GetGateway method.
 	GetGatewayFunc func(ctx context.Context, gatewayID string) (*client.GatewayResponse, error)
 
@@ -63,22 +129,53 @@ type APIPlatformClientMock struct {
 	GetOrganizationFunc func(ctx context.Context) (*client.OrganizationResponse, error)
 
 	// ListGatewaysFunc mocks the ListGateways method.
-	ListGatewaysFunc func(ctx context.Context) ([]*client.GatewayResponse, error)
+	ListGatewaysFunc func(ctx context.Context, filters client.GatewayFilters) (*client.GatewayListResponse, error)
+
+	// ListLLMProviderRateLimitsFunc mocks the ListLLMProviderRateLimits method.
+	ListLLMProviderRateLimitsFunc func(ctx context.Context, providerID string) ([]client.RateLimitPolicy, error)
+
+	// ListProjectsFunc mocks the ListProjects method.
+	ListProjectsFunc func(ctx context.Context) ([]*client.ProjectResponse, error)
+
+	// PublishAPIToDevPortalFunc mocks the PublishAPIToDevPortal method.
+	PublishAPIToDevPortalFunc func(ctx context.Context, apiID string, req client.PublishAPIRequest) error
 
 	// RegisterOrganizationFunc mocks the RegisterOrganization method.
 	RegisterOrganizationFunc func(ctx context.Context, req client.RegisterOrganizationRequest) (*client.OrganizationResponse, error)
 
+	// RemoveLLMProxyPolicyFunc mocks the RemoveLLMProxyPolicy method.
+	RemoveLLMProxyPolicyFunc func(ctx context.Context, proxyID string, policyName string) error
+
 	// RevokeGatewayTokenFunc mocks the RevokeGatewayToken method.
 	RevokeGatewayTokenFunc func(ctx context.Context, gatewayID string, tokenID string) error
 
 	// RotateGatewayTokenFunc mocks the RotateGatewayToken method.
 	RotateGatewayTokenFunc func(ctx context.Context, gatewayID string) (*client.GatewayTokenResponse, error)
 
+	// SetLLMProxyPolicyFunc mocks the SetLLMProxyPolicy method.
+	SetLLMProxyPolicyFunc func(ctx context.Context, proxyID string, policy client.LLMProxyPolicy) error
+
+	// UnpublishAPIFromDevPortalFunc mocks the UnpublishAPIFromDevPortal method.
+	UnpublishAPIFromDevPortalFunc func(ctx context.Context, apiID string, devPortalID string) error
+
+	// UpdateAPILifeCycleStatusFunc mocks the UpdateAPILifeCycleStatus method.
+	UpdateAPILifeCycleStatusFunc func(ctx context.Context, apiID string, status client.APILifeCycleStatus) (*client.APIResponse, error)
+
 	// UpdateGatewayFunc mocks the UpdateGateway method.
 	UpdateGatewayFunc func(ctx context.Context, gatewayID string, req client.UpdateGatewayRequest) (*client.GatewayResponse, error)
 
+	// UpdateLLMProviderRateLimitFunc mocks the UpdateLLMProviderRateLimit method.
+	UpdateLLMProviderRateLimitFunc func(ctx context.Context, providerID string, policy client.RateLimitPolicy) (*client.RateLimitPolicy, error)
+
 	// calls tracks calls to the methods.
 	calls struct {
+		// CreateAPI holds details about calls to the CreateAPI method.
+		CreateAPI []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req client.CreateAPIRequest
+		}
 		// CreateGateway holds details about calls to the CreateGateway method.
 		CreateGateway []struct {
 			// Ctx is the ctx argument value.
@@ -86,6 +183,29 @@ type APIPlatformClientMock struct {
 			// Req is the req argument value.
 			Req client.CreateGatewayRequest
 		}
+		// CreateLLMProviderRateLimit holds details about calls to the CreateLLMProviderRateLimit method.
+		CreateLLMProviderRateLimit []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ProviderID is the providerID argument value.
+			ProviderID string
+			// Policy is the policy argument value.
+			Policy client.RateLimitPolicy
+		}
+		// CreateProject holds details about calls to the CreateProject method.
+		CreateProject []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Req is the req argument value.
+			Req client.CreateProjectRequest
+		}
+		// DeleteAPI holds details about calls to the DeleteAPI method.
+		DeleteAPI []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ApiID is the apiID argument value.
+			ApiID string
+		}
 		// DeleteGateway holds details about calls to the DeleteGateway method.
 		DeleteGateway []struct {
 			// Ctx is the ctx argument value.
@@ -93,6 +213,29 @@ type APIPlatformClientMock struct {
 			// GatewayID is the gatewayID argument value.
 			GatewayID string
 		}
+		// DeleteLLMProviderRateLimit holds details about calls to the DeleteLLMProviderRateLimit method.
+		DeleteLLMProviderRateLimit []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ProviderID is the providerID argument value.
+			ProviderID string
+			// Level is the level argument value.
+			Level client.RateLimitLevel
+			// Resource is the resource argument value.
+			Resource string
+		}
+		// DiagnoseGateway holds details about calls to the DiagnoseGateway method.
+		DiagnoseGateway []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// GatewayID is the gatewayID argument value.
+			GatewayID string
+		}
+		// GetDefaultDevPortal holds details about calls to the GetDefaultDevPortal method.
+		GetDefaultDevPortal []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
 		// GetGateway holds details about calls to the GetGateway method.
 		GetGateway []struct {
 			// Ctx is the ctx argument value.
@@ -109,6 +252,29 @@ type APIPlatformClientMock struct {
 		ListGateways []struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
+			// Filters is the filters argument value.
+			Filters client.GatewayFilters
+		}
+		// ListLLMProviderRateLimits holds details about calls to the ListLLMProviderRateLimits method.
+		ListLLMProviderRateLimits []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ProviderID is the providerID argument value.
+			ProviderID string
+		}
+		// ListProjects holds details about calls to the ListProjects method.
+		ListProjects []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// PublishAPIToDevPortal holds details about calls to the PublishAPIToDevPortal method.
+		PublishAPIToDevPortal []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ApiID is the apiID argument value.
+			ApiID string
+			// Req is the req argument value.
+			Req client.PublishAPIRequest
 		}
 		// RegisterOrganization holds details about calls to the RegisterOrganization method.
 		RegisterOrganization []struct {
@@ -117,6 +283,15 @@ type APIPlatformClientMock struct {
 			// Req is the req argument value.
 			Req client.RegisterOrganizationRequest
 		}
+		// RemoveLLMProxyPolicy holds details about calls to the RemoveLLMProxyPolicy method.
+		RemoveLLMProxyPolicy []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ProxyID is the proxyID argument value.
+			ProxyID string
+			// PolicyName is the policyName argument value.
+			PolicyName string
+		}
 		// RevokeGatewayToken holds details about calls to the RevokeGatewayToken method.
 		RevokeGatewayToken []struct {
 			// Ctx is the ctx argument value.
@@ -133,6 +308,33 @@ type APIPlatformClientMock struct {
 			// GatewayID is the gatewayID argument value.
 			GatewayID string
 		}
+		// SetLLMProxyPolicy holds details about calls to the SetLLMProxyPolicy method.
+		SetLLMProxyPolicy []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ProxyID is the proxyID argument value.
+			ProxyID string
+			// Policy is the policy argument value.
+			Policy client.LLMProxyPolicy
+		}
+		// UnpublishAPIFromDevPortal holds details about calls to the UnpublishAPIFromDevPortal method.
+		UnpublishAPIFromDevPortal []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ApiID is the apiID argument value.
+			ApiID string
+			// DevPortalID is the devPortalID argument value.
+			DevPortalID string
+		}
+		// UpdateAPILifeCycleStatus holds details about calls to the UpdateAPILifeCycleStatus method.
+		UpdateAPILifeCycleStatus []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ApiID is the apiID argument value.
+			ApiID string
+			// Status is the status argument value.
+			Status client.APILifeCycleStatus
+		}
 		// UpdateGateway holds details about calls to the UpdateGateway method.
 		UpdateGateway []struct {
 			// Ctx is the ctx argument value.
@@ -142,16 +344,76 @@ type APIPlatformClientMock struct {
 			// Req is the req argument value.
 			Req client.UpdateGatewayRequest
 		}
+		// UpdateLLMProviderRateLimit holds details about calls to the UpdateLLMProviderRateLimit method.
+		UpdateLLMProviderRateLimit []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ProviderID is the providerID argument value.
+			ProviderID string
+			// Policy is the policy argument value.
+			Policy client.RateLimitPolicy
+		}
 	}
-	lockCreateGateway        sync.RWMutex
-	lockDeleteGateway        sync.RWMutex
-	lockGetGateway           sync.RWMutex
-	lockGetOrganization      sync.RWMutex
-	lockListGateways         sync.RWMutex
-	lockRegisterOrganization sync.RWMutex
-	lockRevokeGatewayToken   sync.RWMutex
-	lockRotateGatewayToken   sync.RWMutex
-	lockUpdateGateway        sync.RWMutex
+	lockCreateAPI                  sync.RWMutex
+	lockCreateGateway              sync.RWMutex
+	lockCreateLLMProviderRateLimit sync.RWMutex
+	lockCreateProject              sync.RWMutex
+	lockDeleteAPI                  sync.RWMutex
+	lockDeleteGateway              sync.RWMutex
+	lockDeleteLLMProviderRateLimit sync.RWMutex
+	lockDiagnoseGateway            sync.RWMutex
+	lockGetDefaultDevPortal        sync.RWMutex
+	lockGetGateway                 sync.RWMutex
+	lockGetOrganization            sync.RWMutex
+	lockListGateways               sync.RWMutex
+	lockListLLMProviderRateLimits  sync.RWMutex
+	lockListProjects               sync.RWMutex
+	lockPublishAPIToDevPortal      sync.RWMutex
+	lockRegisterOrganization       sync.RWMutex
+	lockRemoveLLMProxyPolicy       sync.RWMutex
+	lockRevokeGatewayToken         sync.RWMutex
+	lockRotateGatewayToken         sync.RWMutex
+	lockSetLLMProxyPolicy          sync.RWMutex
+	lockUnpublishAPIFromDevPortal  sync.RWMutex
+	lockUpdateAPILifeCycleStatus   sync.RWMutex
+	lockUpdateGateway              sync.RWMutex
+	lockUpdateLLMProviderRateLimit sync.RWMutex
+}
+
+// CreateAPI calls CreateAPIFunc.
+func (mock *APIPlatformClientMock) CreateAPI(ctx context.Context, req client.CreateAPIRequest) (*client.APIResponse, error) {
+	if mock.CreateAPIFunc == nil {
+		panic("APIPlatformClientMock.CreateAPIFunc: method is nil but APIPlatformClient.CreateAPI was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req client.CreateAPIRequest
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockCreateAPI.Lock()
+	mock.calls.CreateAPI = append(mock.calls.CreateAPI, callInfo)
+	mock.lockCreateAPI.Unlock()
+	return mock.CreateAPIFunc(ctx, req)
+}
+
+// CreateAPICalls gets all the calls that were made to CreateAPI.
+// Check the length with:
+//
+//	len(mockedAPIPlatformClient.CreateAPICalls())
+func (mock *APIPlatformClientMock) CreateAPICalls() []struct {
+	Ctx context.Context
+	Req client.CreateAPIRequest
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req client.CreateAPIRequest
+	}
+	mock.lockCreateAPI.RLock()
+	calls = mock.calls.CreateAPI
+	mock.lockCreateAPI.RUnlock()
+	return calls
 }
 
 // CreateGateway calls CreateGatewayFunc.
@@ -190,6 +452,118 @@ func (mock *APIPlatformClientMock) CreateGatewayCalls() []struct {
 	return calls
 }
 
+// CreateLLMProviderRateLimit calls CreateLLMProviderRateLimitFunc.
+func (mock *APIPlatformClientMock) CreateLLMProviderRateLimit(ctx context.Context, providerID string, policy client.RateLimitPolicy) (*client.RateLimitPolicy, error) {
+	if mock.CreateLLMProviderRateLimitFunc == nil {
+		panic("APIPlatformClientMock.CreateLLMProviderRateLimitFunc: method is nil but APIPlatformClient.CreateLLMProviderRateLimit was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ProviderID string
+		Policy     client.RateLimitPolicy
+	}{
+		Ctx:        ctx,
+		ProviderID: providerID,
+		Policy:     policy,
+	}
+	mock.lockCreateLLMProviderRateLimit.Lock()
+	mock.calls.CreateLLMProviderRateLimit = append(mock.calls.CreateLLMProviderRateLimit, callInfo)
+	mock.lockCreateLLMProviderRateLimit.Unlock()
+	return mock.CreateLLMProviderRateLimitFunc(ctx, providerID, policy)
+}
+
+// CreateLLMProviderRateLimitCalls gets all the calls that were made to CreateLLMProviderRateLimit.
+// Check the length with:
+//
+//	len(mockedAPIPlatformClient.CreateLLMProviderRateLimitCalls())
+func (mock *APIPlatformClientMock) CreateLLMProviderRateLimitCalls() []struct {
+	Ctx        context.Context
+	ProviderID string
+	Policy     client.RateLimitPolicy
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ProviderID string
+		Policy     client.RateLimitPolicy
+	}
+	mock.lockCreateLLMProviderRateLimit.RLock()
+	calls = mock.calls.CreateLLMProviderRateLimit
+	mock.lockCreateLLMProviderRateLimit.RUnlock()
+	return calls
+}
+
+// CreateProject calls CreateProjectFunc.
+func (mock *APIPlatformClientMock) CreateProject(ctx context.Context, req client.CreateProjectRequest) (*client.ProjectResponse, error) {
+	if mock.CreateProjectFunc == nil {
+		panic("APIPlatformClientMock.CreateProjectFunc: method is nil but APIPlatformClient.CreateProject was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Req client.CreateProjectRequest
+	}{
+		Ctx: ctx,
+		Req: req,
+	}
+	mock.lockCreateProject.Lock()
+	mock.calls.CreateProject = append(mock.calls.CreateProject, callInfo)
+	mock.lockCreateProject.Unlock()
+	return mock.CreateProjectFunc(ctx, req)
+}
+
+// CreateProjectCalls gets all the calls that were made to CreateProject.
+// Check the length with:
+//
+//	len(mockedAPIPlatformClient.CreateProjectCalls())
+func (mock *APIPlatformClientMock) CreateProjectCalls() []struct {
+	Ctx context.Context
+	Req client.CreateProjectRequest
+} {
+	var calls []struct {
+		Ctx context.Context
+		Req client.CreateProjectRequest
+	}
+	mock.lockCreateProject.RLock()
+	calls = mock.calls.CreateProject
+	mock.lockCreateProject.RUnlock()
+	return calls
+}
+
+// DeleteAPI calls DeleteAPIFunc.
+func (mock *APIPlatformClientMock) DeleteAPI(ctx context.Context, apiID string) error {
+	if mock.DeleteAPIFunc == nil {
+		panic("APIPlatformClientMock.DeleteAPIFunc: method is nil but APIPlatformClient.DeleteAPI was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		ApiID string
+	}{
+		Ctx:   ctx,
+		ApiID: apiID,
+	}
+	mock.lockDeleteAPI.Lock()
+	mock.calls.DeleteAPI = append(mock.calls.DeleteAPI, callInfo)
+	mock.lockDeleteAPI.Unlock()
+	return mock.DeleteAPIFunc(ctx, apiID)
+}
+
+// DeleteAPICalls gets all the calls that were made to DeleteAPI.
+// Check the length with:
+//
+//	len(mockedAPIPlatformClient.DeleteAPICalls())
+func (mock *APIPlatformClientMock) DeleteAPICalls() []struct {
+	Ctx   context.Context
+	ApiID string
+} {
+	var calls []struct {
+		Ctx   context.Context
+		ApiID string
+	}
+	mock.lockDeleteAPI.RLock()
+	calls = mock.calls.DeleteAPI
+	mock.lockDeleteAPI.RUnlock()
+	return calls
+}
+
 // DeleteGateway calls DeleteGatewayFunc.
 func (mock *APIPlatformClientMock) DeleteGateway(ctx context.Context, gatewayID string) error {
 	if mock.DeleteGatewayFunc == nil {
@@ -226,6 +600,118 @@ func (mock *APIPlatformClientMock) DeleteGatewayCalls() []struct {
 	return calls
 }
 
+// DeleteLLMProviderRateLimit calls DeleteLLMProviderRateLimitFunc.
+func (mock *APIPlatformClientMock) DeleteLLMProviderRateLimit(ctx context.Context, providerID string, level client.RateLimitLevel, resource string) error {
+	if mock.DeleteLLMProviderRateLimitFunc == nil {
+		panic("APIPlatformClientMock.DeleteLLMProviderRateLimitFunc: method is nil but APIPlatformClient.DeleteLLMProviderRateLimit was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ProviderID string
+		Level      client.RateLimitLevel
+		Resource   string
+	}{
+		Ctx:        ctx,
+		ProviderID: providerID,
+		Level:      level,
+		Resource:   resource,
+	}
+	mock.lockDeleteLLMProviderRateLimit.Lock()
+	mock.calls.DeleteLLMProviderRateLimit = append(mock.calls.DeleteLLMProviderRateLimit, callInfo)
+	mock.lockDeleteLLMProviderRateLimit.Unlock()
+	return mock.DeleteLLMProviderRateLimitFunc(ctx, providerID, level, resource)
+}
+
+// DeleteLLMProviderRateLimitCalls gets all the calls that were made to DeleteLLMProviderRateLimit.
+// Check the length with:
+//
+//	len(mockedAPIPlatformClient.DeleteLLMProviderRateLimitCalls())
+func (mock *APIPlatformClientMock) DeleteLLMProviderRateLimitCalls() []struct {
+	Ctx        context.Context
+	ProviderID string
+	Level      client.RateLimitLevel
+	Resource   string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ProviderID string
+		Level      client.RateLimitLevel
+		Resource   string
+	}
+	mock.lockDeleteLLMProviderRateLimit.RLock()
+	calls = mock.calls.DeleteLLMProviderRateLimit
+	mock.lockDeleteLLMProviderRateLimit.RUnlock()
+	return calls
+}
+
+// DiagnoseGateway calls DiagnoseGatewayFunc.
+func (mock *APIPlatformClientMock) DiagnoseGateway(ctx context.Context, gatewayID string) (*client.GatewayDiagnostics, error) {
+	if mock.DiagnoseGatewayFunc == nil {
+		panic("APIPlatformClientMock.DiagnoseGatewayFunc: method is nil but APIPlatformClient.DiagnoseGateway was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		GatewayID string
+	}{
+		Ctx:       ctx,
+		GatewayID: gatewayID,
+	}
+	mock.lockDiagnoseGateway.Lock()
+	mock.calls.DiagnoseGateway = append(mock.calls.DiagnoseGateway, callInfo)
+	mock.lockDiagnoseGateway.Unlock()
+	return mock.DiagnoseGatewayFunc(ctx, gatewayID)
+}
+
+// DiagnoseGatewayCalls gets all the calls that were made to DiagnoseGateway.
+// Check the length with:
+//
+//	len(mockedAPIPlatformClient.DiagnoseGatewayCalls())
+func (mock *APIPlatformClientMock) DiagnoseGatewayCalls() []struct {
+	Ctx       context.Context
+	GatewayID string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		GatewayID string
+	}
+	mock.lockDiagnoseGateway.RLock()
+	calls = mock.calls.DiagnoseGateway
+	mock.lockDiagnoseGateway.RUnlock()
+	return calls
+}
+
+// GetDefaultDevPortal calls GetDefaultDevPortalFunc.
+func (mock *APIPlatformClientMock) GetDefaultDevPortal(ctx context.Context) (*client.DevPortalResponse, error) {
+	if mock.GetDefaultDevPortalFunc == nil {
+		panic("APIPlatformClientMock.GetDefaultDevPortalFunc: method is nil but APIPlatformClient.GetDefaultDevPortal was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetDefaultDevPortal.Lock()
+	mock.calls.GetDefaultDevPortal = append(mock.calls.GetDefaultDevPortal, callInfo)
+	mock.lockGetDefaultDevPortal.Unlock()
+	return mock.GetDefaultDevPortalFunc(ctx)
+}
+
+// GetDefaultDevPortalCalls gets all the calls that were made to GetDefaultDevPortal.
+// Check the length with:
+//
+//	len(mockedAPIPlatformClient.GetDefaultDevPortalCalls())
+func (mock *APIPlatformClientMock) GetDefaultDevPortalCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockGetDefaultDevPortal.RLock()
+	calls = mock.calls.GetDefaultDevPortal
+	mock.lockGetDefaultDevPortal.RUnlock()
+	return calls
+}
+
 // GetGateway calls GetGatewayFunc.
 func (mock *APIPlatformClientMock) GetGateway(ctx context.Context, gatewayID string) (*client.GatewayResponse, error) {
 	if mock.GetGatewayFunc == nil {
@@ -295,19 +781,21 @@ func (mock *APIPlatformClientMock) GetOrganizationCalls() []struct {
 }
 
 // ListGateways calls ListGatewaysFunc.
-func (mock *APIPlatformClientMock) ListGateways(ctx context.Context) ([]*client.GatewayResponse, error) {
+func (mock *APIPlatformClientMock) ListGateways(ctx context.Context, filters client.GatewayFilters) (*client.GatewayListResponse, error) {
 	if mock.ListGatewaysFunc == nil {
 		panic("APIPlatformClientMock.ListGatewaysFunc: method is nil but APIPlatformClient.ListGateways was just called")
 	}
 	callInfo := struct {
-		Ctx context.Context
+		Ctx     context.Context
+		Filters client.GatewayFilters
 	}{
-		Ctx: ctx,
+		Ctx:     ctx,
+		Filters: filters,
 	}
 	mock.lockListGateways.Lock()
 	mock.calls.ListGateways = append(mock.calls.ListGateways, callInfo)
 	mock.lockListGateways.Unlock()
-	return mock.ListGatewaysFunc(ctx)
+	return mock.ListGatewaysFunc(ctx, filters)
 }
 
 // ListGatewaysCalls gets all the calls that were made to ListGateways.
@@ -315,10 +803,12 @@ func (mock *APIPlatformClientMock) ListGateways(ctx context.Context) ([]*client.
 //
 //	len(mockedAPIPlatformClient.ListGatewaysCalls())
 func (mock *APIPlatformClientMock) ListGatewaysCalls() []struct {
-	Ctx context.Context
+	Ctx     context.Context
+	Filters client.GatewayFilters
 } {
 	var calls []struct {
-		Ctx context.Context
+		Ctx     context.Context
+		Filters client.GatewayFilters
 	}
 	mock.lockListGateways.RLock()
 	calls = mock.calls.ListGateways
@@ -326,6 +816,114 @@ func (mock *APIPlatformClientMock) ListGatewaysCalls() []struct {
 	return calls
 }
 
+// ListLLMProviderRateLimits calls ListLLMProviderRateLimitsFunc.
+func (mock *APIPlatformClientMock) ListLLMProviderRateLimits(ctx context.Context, providerID string) ([]client.RateLimitPolicy, error) {
+	if mock.ListLLMProviderRateLimitsFunc == nil {
+		panic("APIPlatformClientMock.ListLLMProviderRateLimitsFunc: method is nil but APIPlatformClient.ListLLMProviderRateLimits was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ProviderID string
+	}{
+		Ctx:        ctx,
+		ProviderID: providerID,
+	}
+	mock.lockListLLMProviderRateLimits.Lock()
+	mock.calls.ListLLMProviderRateLimits = append(mock.calls.ListLLMProviderRateLimits, callInfo)
+	mock.lockListLLMProviderRateLimits.Unlock()
+	return mock.ListLLMProviderRateLimitsFunc(ctx, providerID)
+}
+
+// ListLLMProviderRateLimitsCalls gets all the calls that were made to ListLLMProviderRateLimits.
+// Check the length with:
+//
+//	len(mockedAPIPlatformClient.ListLLMProviderRateLimitsCalls())
+func (mock *APIPlatformClientMock) ListLLMProviderRateLimitsCalls() []struct {
+	Ctx        context.Context
+	ProviderID string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ProviderID string
+	}
+	mock.lockListLLMProviderRateLimits.RLock()
+	calls = mock.calls.ListLLMProviderRateLimits
+	mock.lockListLLMProviderRateLimits.RUnlock()
+	return calls
+}
+
+// ListProjects calls ListProjectsFunc.
+func (mock *APIPlatformClientMock) ListProjects(ctx context.Context) ([]*client.ProjectResponse, error) {
+	if mock.ListProjectsFunc == nil {
+		panic("APIPlatformClientMock.ListProjectsFunc: method is nil but APIPlatformClient.ListProjects was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListProjects.Lock()
+	mock.calls.ListProjects = append(mock.calls.ListProjects, callInfo)
+	mock.lockListProjects.Unlock()
+	return mock.ListProjectsFunc(ctx)
+}
+
+// ListProjectsCalls gets all the calls that were made to ListProjects.
+// Check the length with:
+//
+//	len(mockedAPIPlatformClient.ListProjectsCalls())
+func (mock *APIPlatformClientMock) ListProjectsCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockListProjects.RLock()
+	calls = mock.calls.ListProjects
+	mock.lockListProjects.RUnlock()
+	return calls
+}
+
+// PublishAPIToDevPortal calls PublishAPIToDevPortalFunc.
+func (mock *APIPlatformClientMock) PublishAPIToDevPortal(ctx context.Context, apiID string, req client.PublishAPIRequest) error {
+	if mock.PublishAPIToDevPortalFunc == nil {
+		panic("APIPlatformClientMock.PublishAPIToDevPortalFunc: method is nil but APIPlatformClient.PublishAPIToDevPortal was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		ApiID string
+		Req   client.PublishAPIRequest
+	}{
+		Ctx:   ctx,
+		ApiID: apiID,
+		Req:   req,
+	}
+	mock.lockPublishAPIToDevPortal.Lock()
+	mock.calls.PublishAPIToDevPortal = append(mock.calls.PublishAPIToDevPortal, callInfo)
+	mock.lockPublishAPIToDevPortal.Unlock()
+	return mock.PublishAPIToDevPortalFunc(ctx, apiID, req)
+}
+
+// PublishAPIToDevPortalCalls gets all the calls that were made to PublishAPIToDevPortal.
+// Check the length with:
+//
+//	len(mockedAPIPlatformClient.PublishAPIToDevPortalCalls())
+func (mock *APIPlatformClientMock) PublishAPIToDevPortalCalls() []struct {
+	Ctx   context.Context
+	ApiID string
+	Req   client.PublishAPIRequest
+} {
+	var calls []struct {
+		Ctx   context.Context
+		ApiID string
+		Req   client.PublishAPIRequest
+	}
+	mock.lockPublishAPIToDevPortal.RLock()
+	calls = mock.calls.PublishAPIToDevPortal
+	mock.lockPublishAPIToDevPortal.RUnlock()
+	return calls
+}
+
 // RegisterOrganization calls RegisterOrganizationFunc.
 func (mock *APIPlatformClientMock) RegisterOrganization(ctx context.Context, req client.RegisterOrganizationRequest) (*client.OrganizationResponse, error) {
 	if mock.RegisterOrganizationFunc == nil {
@@ -362,6 +960,46 @@ func (mock *APIPlatformClientMock) RegisterOrganizationCalls() []struct {
 	return calls
 }
 
+// RemoveLLMProxyPolicy calls RemoveLLMProxyPolicyFunc.
+func (mock *APIPlatformClientMock) RemoveLLMProxyPolicy(ctx context.Context, proxyID string, policyName string) error {
+	if mock.RemoveLLMProxyPolicyFunc == nil {
+		panic("APIPlatformClientMock.RemoveLLMProxyPolicyFunc: method is nil but APIPlatformClient.RemoveLLMProxyPolicy was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ProxyID    string
+		PolicyName string
+	}{
+		Ctx:        ctx,
+		ProxyID:    proxyID,
+		PolicyName: policyName,
+	}
+	mock.lockRemoveLLMProxyPolicy.Lock()
+	mock.calls.RemoveLLMProxyPolicy = append(mock.calls.RemoveLLMProxyPolicy, callInfo)
+	mock.lockRemoveLLMProxyPolicy.Unlock()
+	return mock.RemoveLLMProxyPolicyFunc(ctx, proxyID, policyName)
+}
+
+// RemoveLLMProxyPolicyCalls gets all the calls that were made to RemoveLLMProxyPolicy.
+// Check the length with:
+//
+//	len(mockedAPIPlatformClient.RemoveLLMProxyPolicyCalls())
+func (mock *APIPlatformClientMock) RemoveLLMProxyPolicyCalls() []struct {
+	Ctx        context.Context
+	ProxyID    string
+	PolicyName string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ProxyID    string
+		PolicyName string
+	}
+	mock.lockRemoveLLMProxyPolicy.RLock()
+	calls = mock.calls.RemoveLLMProxyPolicy
+	mock.lockRemoveLLMProxyPolicy.RUnlock()
+	return calls
+}
+
 // RevokeGatewayToken calls RevokeGatewayTokenFunc.
 func (mock *APIPlatformClientMock) RevokeGatewayToken(ctx context.Context, gatewayID string, tokenID string) error {
 	if mock.RevokeGatewayTokenFunc == nil {
@@ -438,6 +1076,126 @@ func (mock *APIPlatformClientMock) RotateGatewayTokenCalls() []struct {
 	return calls
 }
 
+// SetLLMProxyPolicy calls SetLLMProxyPolicyFunc.
+func (mock *APIPlatformClientMock) SetLLMProxyPolicy(ctx context.Context, proxyID string, policy client.LLMProxyPolicy) error {
+	if mock.SetLLMProxyPolicyFunc == nil {
+		panic("APIPlatformClientMock.SetLLMProxyPolicyFunc: method is nil but APIPlatformClient.SetLLMProxyPolicy was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		ProxyID string
+		Policy  client.LLMProxyPolicy
+	}{
+		Ctx:     ctx,
+		ProxyID: proxyID,
+		Policy:  policy,
+	}
+	mock.lockSetLLMProxyPolicy.Lock()
+	mock.calls.SetLLMProxyPolicy = append(mock.calls.SetLLMProxyPolicy, callInfo)
+	mock.lockSetLLMProxyPolicy.Unlock()
+	return mock.SetLLMProxyPolicyFunc(ctx, proxyID, policy)
+}
+
+// SetLLMProxyPolicyCalls gets all the calls that were made to SetLLMProxyPolicy.
+// Check the length with:
+//
+//	len(mockedAPIPlatformClient.SetLLMProxyPolicyCalls())
+func (mock *APIPlatformClientMock) SetLLMProxyPolicyCalls() []struct {
+	Ctx     context.Context
+	ProxyID string
+	Policy  client.LLMProxyPolicy
+} {
+	var calls []struct {
+		Ctx     context.Context
+		ProxyID string
+		Policy  client.LLMProxyPolicy
+	}
+	mock.lockSetLLMProxyPolicy.RLock()
+	calls = mock.calls.SetLLMProxyPolicy
+	mock.lockSetLLMProxyPolicy.RUnlock()
+	return calls
+}
+
+// UnpublishAPIFromDevPortal calls UnpublishAPIFromDevPortalFunc.
+func (mock *APIPlatformClientMock) UnpublishAPIFromDevPortal(ctx context.Context, apiID string, devPortalID string) error {
+	if mock.UnpublishAPIFromDevPortalFunc == nil {
+		panic("APIPlatformClientMock.UnpublishAPIFromDevPortalFunc: method is nil but APIPlatformClient.UnpublishAPIFromDevPortal was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		ApiID       string
+		DevPortalID string
+	}{
+		Ctx:         ctx,
+		ApiID:       apiID,
+		DevPortalID: devPortalID,
+	}
+	mock.lockUnpublishAPIFromDevPortal.Lock()
+	mock.calls.UnpublishAPIFromDevPortal = append(mock.calls.UnpublishAPIFromDevPortal, callInfo)
+	mock.lockUnpublishAPIFromDevPortal.Unlock()
+	return mock.UnpublishAPIFromDevPortalFunc(ctx, apiID, devPortalID)
+}
+
+// UnpublishAPIFromDevPortalCalls gets all the calls that were made to UnpublishAPIFromDevPortal.
+// Check the length with:
+//
+//	len(mockedAPIPlatformClient.UnpublishAPIFromDevPortalCalls())
+func (mock *APIPlatformClientMock) UnpublishAPIFromDevPortalCalls() []struct {
+	Ctx         context.Context
+	ApiID       string
+	DevPortalID string
+} {
+	var calls []struct {
+		Ctx         context.Context
+		ApiID       string
+		DevPortalID string
+	}
+	mock.lockUnpublishAPIFromDevPortal.RLock()
+	calls = mock.calls.UnpublishAPIFromDevPortal
+	mock.lockUnpublishAPIFromDevPortal.RUnlock()
+	return calls
+}
+
+// UpdateAPILifeCycleStatus calls UpdateAPILifeCycleStatusFunc.
+func (mock *APIPlatformClientMock) UpdateAPILifeCycleStatus(ctx context.Context, apiID string, status client.APILifeCycleStatus) (*client.APIResponse, error) {
+	if mock.UpdateAPILifeCycleStatusFunc == nil {
+		panic("APIPlatformClientMock.UpdateAPILifeCycleStatusFunc: method is nil but APIPlatformClient.UpdateAPILifeCycleStatus was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		ApiID  string
+		Status client.APILifeCycleStatus
+	}{
+		Ctx:    ctx,
+		ApiID:  apiID,
+		Status: status,
+	}
+	mock.lockUpdateAPILifeCycleStatus.Lock()
+	mock.calls.UpdateAPILifeCycleStatus = append(mock.calls.UpdateAPILifeCycleStatus, callInfo)
+	mock.lockUpdateAPILifeCycleStatus.Unlock()
+	return mock.UpdateAPILifeCycleStatusFunc(ctx, apiID, status)
+}
+
+// UpdateAPILifeCycleStatusCalls gets all the calls that were made to UpdateAPILifeCycleStatus.
+// Check the length with:
+//
+//	len(mockedAPIPlatformClient.UpdateAPILifeCycleStatusCalls())
+func (mock *APIPlatformClientMock) UpdateAPILifeCycleStatusCalls() []struct {
+	Ctx    context.Context
+	ApiID  string
+	Status client.APILifeCycleStatus
+} {
+	var calls []struct {
+		Ctx    context.Context
+		ApiID  string
+		Status client.APILifeCycleStatus
+	}
+	mock.lockUpdateAPILifeCycleStatus.RLock()
+	calls = mock.calls.UpdateAPILifeCycleStatus
+	mock.lockUpdateAPILifeCycleStatus.RUnlock()
+	return calls
+}
+
 // UpdateGateway calls UpdateGatewayFunc.
 func (mock *APIPlatformClientMock) UpdateGateway(ctx context.Context, gatewayID string, req client.UpdateGatewayRequest) (*client.GatewayResponse, error) {
 	if mock.UpdateGatewayFunc == nil {
@@ -477,3 +1235,43 @@ func (mock *APIPlatformClientMock) UpdateGatewayCalls() []struct {
 	mock.lockUpdateGateway.RUnlock()
 	return calls
 }
+
+// UpdateLLMProviderRateLimit calls UpdateLLMProviderRateLimitFunc.
+func (mock *APIPlatformClientMock) UpdateLLMProviderRateLimit(ctx context.Context, providerID string, policy client.RateLimitPolicy) (*client.RateLimitPolicy, error) {
+	if mock.UpdateLLMProviderRateLimitFunc == nil {
+		panic("APIPlatformClientMock.UpdateLLMProviderRateLimitFunc: method is nil but APIPlatformClient.UpdateLLMProviderRateLimit was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ProviderID string
+		Policy     client.RateLimitPolicy
+	}{
+		Ctx:        ctx,
+		ProviderID: providerID,
+		Policy:     policy,
+	}
+	mock.lockUpdateLLMProviderRateLimit.Lock()
+	mock.calls.UpdateLLMProviderRateLimit = append(mock.calls.UpdateLLMProviderRateLimit, callInfo)
+	mock.lockUpdateLLMProviderRateLimit.Unlock()
+	return mock.UpdateLLMProviderRateLimitFunc(ctx, providerID, policy)
+}
+
+// UpdateLLMProviderRateLimitCalls gets all the calls that were made to UpdateLLMProviderRateLimit.
+// Check the length with:
+//
+//	len(mockedAPIPlatformClient.UpdateLLMProviderRateLimitCalls())
+func (mock *APIPlatformClientMock) UpdateLLMProviderRateLimitCalls() []struct {
+	Ctx        context.Context
+	ProviderID string
+	Policy     client.RateLimitPolicy
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ProviderID string
+		Policy     client.RateLimitPolicy
+	}
+	mock.lockUpdateLLMProviderRateLimit.RLock()
+	calls = mock.calls.UpdateLLMProviderRateLimit
+	mock.lockUpdateLLMProviderRateLimit.RUnlock()
+	return calls
+}
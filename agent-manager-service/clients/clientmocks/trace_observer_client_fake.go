@@ -50,6 +50,78 @@ type TraceObserverClientMock struct {
 		Ctx    context.Context
 		Params traceobserversvc.ListTracesParams
 	}
+
+	// GetModelUsage
+	GetModelUsageFunc  func(ctx context.Context, params traceobserversvc.ModelUsageParams) (*traceobserversvc.ModelUsageResponse, error)
+	getModelUsageMutex sync.RWMutex
+	getModelUsageCalls []struct {
+		Ctx    context.Context
+		Params traceobserversvc.ModelUsageParams
+	}
+
+	// GetStorageUsage
+	GetStorageUsageFunc  func(ctx context.Context, componentUids []string) (*traceobserversvc.StorageUsageResponse, error)
+	getStorageUsageMutex sync.RWMutex
+	getStorageUsageCalls []struct {
+		Ctx           context.Context
+		ComponentUids []string
+	}
+
+	// DeleteSpans
+	DeleteSpansFunc  func(ctx context.Context, params traceobserversvc.DeleteSpansParams) (*traceobserversvc.DeleteSpansResponse, error)
+	deleteSpansMutex sync.RWMutex
+	deleteSpansCalls []struct {
+		Ctx    context.Context
+		Params traceobserversvc.DeleteSpansParams
+	}
+
+	// EraseSpans
+	EraseSpansFunc  func(ctx context.Context, params traceobserversvc.EraseSpansParams) (*traceobserversvc.DeleteSpansResponse, error)
+	eraseSpansMutex sync.RWMutex
+	eraseSpansCalls []struct {
+		Ctx    context.Context
+		Params traceobserversvc.EraseSpansParams
+	}
+
+	// GetTask
+	GetTaskFunc  func(ctx context.Context, taskID string) (*traceobserversvc.TaskStatus, error)
+	getTaskMutex sync.RWMutex
+	getTaskCalls []struct {
+		Ctx    context.Context
+		TaskID string
+	}
+
+	// GetTraceLogs
+	GetTraceLogsFunc  func(ctx context.Context, params traceobserversvc.TraceLogsParams) (*traceobserversvc.TraceLogsResponse, error)
+	getTraceLogsMutex sync.RWMutex
+	getTraceLogsCalls []struct {
+		Ctx    context.Context
+		Params traceobserversvc.TraceLogsParams
+	}
+
+	// GetSLOStatus
+	GetSLOStatusFunc  func(ctx context.Context, params traceobserversvc.SLOParams) (*traceobserversvc.SLOStatusResponse, error)
+	getSLOStatusMutex sync.RWMutex
+	getSLOStatusCalls []struct {
+		Ctx    context.Context
+		Params traceobserversvc.SLOParams
+	}
+
+	// GetSLOHistory
+	GetSLOHistoryFunc  func(ctx context.Context, params traceobserversvc.SLOParams) (*traceobserversvc.SLOHistoryResponse, error)
+	getSLOHistoryMutex sync.RWMutex
+	getSLOHistoryCalls []struct {
+		Ctx    context.Context
+		Params traceobserversvc.SLOParams
+	}
+
+	// ListIssues
+	ListIssuesFunc  func(ctx context.Context, params traceobserversvc.ListIssuesParams) (*traceobserversvc.IssueListResponse, error)
+	listIssuesMutex sync.RWMutex
+	listIssuesCalls []struct {
+		Ctx    context.Context
+		Params traceobserversvc.ListIssuesParams
+	}
 }
 
 func (m *TraceObserverClientMock) ListTraces(ctx context.Context, params traceobserversvc.ListTracesParams) (*traceobserversvc.TraceOverviewResponse, error) {
@@ -132,3 +204,246 @@ func (m *TraceObserverClientMock) ExportTracesCalls() []struct {
 	defer m.exportTracesMutex.RUnlock()
 	return m.exportTracesCalls
 }
+
+func (m *TraceObserverClientMock) GetModelUsage(ctx context.Context, params traceobserversvc.ModelUsageParams) (*traceobserversvc.ModelUsageResponse, error) {
+	m.getModelUsageMutex.Lock()
+	m.getModelUsageCalls = append(m.getModelUsageCalls, struct {
+		Ctx    context.Context
+		Params traceobserversvc.ModelUsageParams
+	}{
+		Ctx:    ctx,
+		Params: params,
+	})
+	m.getModelUsageMutex.Unlock()
+
+	if m.GetModelUsageFunc != nil {
+		return m.GetModelUsageFunc(ctx, params)
+	}
+
+	return &traceobserversvc.ModelUsageResponse{}, nil
+}
+
+func (m *TraceObserverClientMock) GetModelUsageCalls() []struct {
+	Ctx    context.Context
+	Params traceobserversvc.ModelUsageParams
+} {
+	m.getModelUsageMutex.RLock()
+	defer m.getModelUsageMutex.RUnlock()
+	return m.getModelUsageCalls
+}
+
+func (m *TraceObserverClientMock) GetStorageUsage(ctx context.Context, componentUids []string) (*traceobserversvc.StorageUsageResponse, error) {
+	m.getStorageUsageMutex.Lock()
+	m.getStorageUsageCalls = append(m.getStorageUsageCalls, struct {
+		Ctx           context.Context
+		ComponentUids []string
+	}{
+		Ctx:           ctx,
+		ComponentUids: componentUids,
+	})
+	m.getStorageUsageMutex.Unlock()
+
+	if m.GetStorageUsageFunc != nil {
+		return m.GetStorageUsageFunc(ctx, componentUids)
+	}
+
+	return &traceobserversvc.StorageUsageResponse{}, nil
+}
+
+func (m *TraceObserverClientMock) GetStorageUsageCalls() []struct {
+	Ctx           context.Context
+	ComponentUids []string
+} {
+	m.getStorageUsageMutex.RLock()
+	defer m.getStorageUsageMutex.RUnlock()
+	return m.getStorageUsageCalls
+}
+
+func (m *TraceObserverClientMock) DeleteSpans(ctx context.Context, params traceobserversvc.DeleteSpansParams) (*traceobserversvc.DeleteSpansResponse, error) {
+	m.deleteSpansMutex.Lock()
+	m.deleteSpansCalls = append(m.deleteSpansCalls, struct {
+		Ctx    context.Context
+		Params traceobserversvc.DeleteSpansParams
+	}{
+		Ctx:    ctx,
+		Params: params,
+	})
+	m.deleteSpansMutex.Unlock()
+
+	if m.DeleteSpansFunc != nil {
+		return m.DeleteSpansFunc(ctx, params)
+	}
+
+	return &traceobserversvc.DeleteSpansResponse{}, nil
+}
+
+func (m *TraceObserverClientMock) DeleteSpansCalls() []struct {
+	Ctx    context.Context
+	Params traceobserversvc.DeleteSpansParams
+} {
+	m.deleteSpansMutex.RLock()
+	defer m.deleteSpansMutex.RUnlock()
+	return m.deleteSpansCalls
+}
+
+func (m *TraceObserverClientMock) EraseSpans(ctx context.Context, params traceobserversvc.EraseSpansParams) (*traceobserversvc.DeleteSpansResponse, error) {
+	m.eraseSpansMutex.Lock()
+	m.eraseSpansCalls = append(m.eraseSpansCalls, struct {
+		Ctx    context.Context
+		Params traceobserversvc.EraseSpansParams
+	}{
+		Ctx:    ctx,
+		Params: params,
+	})
+	m.eraseSpansMutex.Unlock()
+
+	if m.EraseSpansFunc != nil {
+		return m.EraseSpansFunc(ctx, params)
+	}
+
+	return &traceobserversvc.DeleteSpansResponse{}, nil
+}
+
+func (m *TraceObserverClientMock) EraseSpansCalls() []struct {
+	Ctx    context.Context
+	Params traceobserversvc.EraseSpansParams
+} {
+	m.eraseSpansMutex.RLock()
+	defer m.eraseSpansMutex.RUnlock()
+	return m.eraseSpansCalls
+}
+
+func (m *TraceObserverClientMock) GetTask(ctx context.Context, taskID string) (*traceobserversvc.TaskStatus, error) {
+	m.getTaskMutex.Lock()
+	m.getTaskCalls = append(m.getTaskCalls, struct {
+		Ctx    context.Context
+		TaskID string
+	}{
+		Ctx:    ctx,
+		TaskID: taskID,
+	})
+	m.getTaskMutex.Unlock()
+
+	if m.GetTaskFunc != nil {
+		return m.GetTaskFunc(ctx, taskID)
+	}
+
+	return &traceobserversvc.TaskStatus{TaskID: taskID}, nil
+}
+
+func (m *TraceObserverClientMock) GetTaskCalls() []struct {
+	Ctx    context.Context
+	TaskID string
+} {
+	m.getTaskMutex.RLock()
+	defer m.getTaskMutex.RUnlock()
+	return m.getTaskCalls
+}
+
+func (m *TraceObserverClientMock) GetTraceLogs(ctx context.Context, params traceobserversvc.TraceLogsParams) (*traceobserversvc.TraceLogsResponse, error) {
+	m.getTraceLogsMutex.Lock()
+	m.getTraceLogsCalls = append(m.getTraceLogsCalls, struct {
+		Ctx    context.Context
+		Params traceobserversvc.TraceLogsParams
+	}{
+		Ctx:    ctx,
+		Params: params,
+	})
+	m.getTraceLogsMutex.Unlock()
+
+	if m.GetTraceLogsFunc != nil {
+		return m.GetTraceLogsFunc(ctx, params)
+	}
+
+	return &traceobserversvc.TraceLogsResponse{TraceID: params.TraceID, Logs: []traceobserversvc.LogRecord{}}, nil
+}
+
+func (m *TraceObserverClientMock) GetTraceLogsCalls() []struct {
+	Ctx    context.Context
+	Params traceobserversvc.TraceLogsParams
+} {
+	m.getTraceLogsMutex.RLock()
+	defer m.getTraceLogsMutex.RUnlock()
+	return m.getTraceLogsCalls
+}
+
+func (m *TraceObserverClientMock) GetSLOStatus(ctx context.Context, params traceobserversvc.SLOParams) (*traceobserversvc.SLOStatusResponse, error) {
+	m.getSLOStatusMutex.Lock()
+	m.getSLOStatusCalls = append(m.getSLOStatusCalls, struct {
+		Ctx    context.Context
+		Params traceobserversvc.SLOParams
+	}{
+		Ctx:    ctx,
+		Params: params,
+	})
+	m.getSLOStatusMutex.Unlock()
+
+	if m.GetSLOStatusFunc != nil {
+		return m.GetSLOStatusFunc(ctx, params)
+	}
+
+	return &traceobserversvc.SLOStatusResponse{BurnRates: []traceobserversvc.SLOBurnRate{}, Status: "no_data"}, nil
+}
+
+func (m *TraceObserverClientMock) GetSLOStatusCalls() []struct {
+	Ctx    context.Context
+	Params traceobserversvc.SLOParams
+} {
+	m.getSLOStatusMutex.RLock()
+	defer m.getSLOStatusMutex.RUnlock()
+	return m.getSLOStatusCalls
+}
+
+func (m *TraceObserverClientMock) GetSLOHistory(ctx context.Context, params traceobserversvc.SLOParams) (*traceobserversvc.SLOHistoryResponse, error) {
+	m.getSLOHistoryMutex.Lock()
+	m.getSLOHistoryCalls = append(m.getSLOHistoryCalls, struct {
+		Ctx    context.Context
+		Params traceobserversvc.SLOParams
+	}{
+		Ctx:    ctx,
+		Params: params,
+	})
+	m.getSLOHistoryMutex.Unlock()
+
+	if m.GetSLOHistoryFunc != nil {
+		return m.GetSLOHistoryFunc(ctx, params)
+	}
+
+	return &traceobserversvc.SLOHistoryResponse{Interval: params.Interval, Points: []traceobserversvc.SLOHistoryPoint{}}, nil
+}
+
+func (m *TraceObserverClientMock) GetSLOHistoryCalls() []struct {
+	Ctx    context.Context
+	Params traceobserversvc.SLOParams
+} {
+	m.getSLOHistoryMutex.RLock()
+	defer m.getSLOHistoryMutex.RUnlock()
+	return m.getSLOHistoryCalls
+}
+
+func (m *TraceObserverClientMock) ListIssues(ctx context.Context, params traceobserversvc.ListIssuesParams) (*traceobserversvc.IssueListResponse, error) {
+	m.listIssuesMutex.Lock()
+	m.listIssuesCalls = append(m.listIssuesCalls, struct {
+		Ctx    context.Context
+		Params traceobserversvc.ListIssuesParams
+	}{
+		Ctx:    ctx,
+		Params: params,
+	})
+	m.listIssuesMutex.Unlock()
+
+	if m.ListIssuesFunc != nil {
+		return m.ListIssuesFunc(ctx, params)
+	}
+
+	return &traceobserversvc.IssueListResponse{Issues: []traceobserversvc.Issue{}}, nil
+}
+
+func (m *TraceObserverClientMock) ListIssuesCalls() []struct {
+	Ctx    context.Context
+	Params traceobserversvc.ListIssuesParams
+} {
+	m.listIssuesMutex.RLock()
+	defer m.listIssuesMutex.RUnlock()
+	return m.listIssuesCalls
+}
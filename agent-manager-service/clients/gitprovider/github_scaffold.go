@@ -0,0 +1,184 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package gitprovider
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"golang.org/x/crypto/nacl/box"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/requests"
+)
+
+// CreateRepositoryFromTemplate creates a new repository from a template repository
+// Reference: https://docs.github.com/en/rest/repos/repos#create-a-repository-using-a-template
+func (g *GitHubProvider) CreateRepositoryFromTemplate(ctx context.Context, opts CreateRepositoryFromTemplateOptions) (*Repository, error) {
+	req := (&requests.HttpRequest{
+		Name:   "github.CreateRepositoryFromTemplate",
+		URL:    fmt.Sprintf("%s/repos/%s/%s/generate", g.baseURL, opts.TemplateOwner, opts.TemplateRepo),
+		Method: http.MethodPost,
+	}).
+		SetHeader("Accept", "application/vnd.github+json").
+		SetHeader("X-GitHub-Api-Version", GitHubAPIVersion).
+		SetJson(map[string]any{
+			"owner":       opts.Owner,
+			"name":        opts.Name,
+			"description": opts.Description,
+			"private":     opts.Private,
+		})
+
+	if g.token != "" {
+		req.SetHeader("Authorization", "Bearer "+g.token)
+	}
+
+	var ghRepo githubRepository
+	result := requests.SendRequest(ctx, g.httpClient, req)
+	if err := result.ScanResponse(&ghRepo, http.StatusCreated); err != nil {
+		return nil, fmt.Errorf("failed to create repository from template: %w", err)
+	}
+
+	return &Repository{
+		Owner:         ghRepo.Owner.Login,
+		Name:          ghRepo.Name,
+		URL:           ghRepo.HTMLURL,
+		CloneURL:      ghRepo.CloneURL,
+		DefaultBranch: ghRepo.DefaultBranch,
+	}, nil
+}
+
+// SetRepositorySecret creates or updates a GitHub Actions secret. The value is encrypted with the
+// repository's public key before it leaves the service.
+// Reference: https://docs.github.com/en/rest/actions/secrets#create-or-update-a-repository-secret
+func (g *GitHubProvider) SetRepositorySecret(ctx context.Context, owner, repo, name, value string) error {
+	publicKey, err := g.getSecretsPublicKey(ctx, owner, repo)
+	if err != nil {
+		return err
+	}
+	encrypted, err := encryptSecret(publicKey.Key, value)
+	if err != nil {
+		return err
+	}
+
+	req := (&requests.HttpRequest{
+		Name:   "github.SetRepositorySecret",
+		URL:    fmt.Sprintf("%s/repos/%s/%s/actions/secrets/%s", g.baseURL, owner, repo, url.PathEscape(name)),
+		Method: http.MethodPut,
+	}).
+		SetHeader("Accept", "application/vnd.github+json").
+		SetHeader("X-GitHub-Api-Version", GitHubAPIVersion).
+		SetJson(map[string]string{
+			"encrypted_value": encrypted,
+			"key_id":          publicKey.KeyID,
+		})
+
+	if g.token != "" {
+		req.SetHeader("Authorization", "Bearer "+g.token)
+	}
+
+	if err := requests.SendRequest(ctx, g.httpClient, req).CheckStatus(http.StatusCreated, http.StatusNoContent); err != nil {
+		return fmt.Errorf("failed to set repository secret %s: %w", name, err)
+	}
+	return nil
+}
+
+// getSecretsPublicKey fetches the key used to encrypt repository secrets
+func (g *GitHubProvider) getSecretsPublicKey(ctx context.Context, owner, repo string) (*githubPublicKey, error) {
+	req := (&requests.HttpRequest{
+		Name:   "github.GetRepositoryPublicKey",
+		URL:    fmt.Sprintf("%s/repos/%s/%s/actions/secrets/public-key", g.baseURL, owner, repo),
+		Method: http.MethodGet,
+	}).
+		SetHeader("Accept", "application/vnd.github+json").
+		SetHeader("X-GitHub-Api-Version", GitHubAPIVersion)
+
+	if g.token != "" {
+		req.SetHeader("Authorization", "Bearer "+g.token)
+	}
+
+	var publicKey githubPublicKey
+	result := requests.SendRequest(ctx, g.httpClient, req)
+	if err := result.ScanResponse(&publicKey, http.StatusOK); err != nil {
+		return nil, fmt.Errorf("failed to get repository public key: %w", err)
+	}
+	return &publicKey, nil
+}
+
+// CreateFile commits a new file to a repository
+// Reference: https://docs.github.com/en/rest/repos/contents#create-or-update-file-contents
+func (g *GitHubProvider) CreateFile(ctx context.Context, owner, repo string, opts CreateFileOptions) error {
+	body := map[string]string{
+		"message": opts.Message,
+		"content": base64.StdEncoding.EncodeToString(opts.Content),
+	}
+	if opts.Branch != "" {
+		body["branch"] = opts.Branch
+	}
+
+	req := (&requests.HttpRequest{
+		Name:   "github.CreateFile",
+		URL:    fmt.Sprintf("%s/repos/%s/%s/contents/%s", g.baseURL, owner, repo, opts.Path),
+		Method: http.MethodPut,
+	}).
+		SetHeader("Accept", "application/vnd.github+json").
+		SetHeader("X-GitHub-Api-Version", GitHubAPIVersion).
+		SetJson(body)
+
+	if g.token != "" {
+		req.SetHeader("Authorization", "Bearer "+g.token)
+	}
+
+	if err := requests.SendRequest(ctx, g.httpClient, req).CheckStatus(http.StatusCreated); err != nil {
+		return fmt.Errorf("failed to create file %s: %w", opts.Path, err)
+	}
+	return nil
+}
+
+// encryptSecret seals the value with the base64 encoded curve25519 public key of the repository
+func encryptSecret(publicKey, value string) (string, error) {
+	keyBytes, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil || len(keyBytes) != 32 {
+		return "", fmt.Errorf("invalid repository public key")
+	}
+	var key [32]byte
+	copy(key[:], keyBytes)
+
+	sealed, err := box.SealAnonymous(nil, []byte(value), &key, rand.Reader)
+	if err != nil {
+		return "", fmt.Errorf("failed to encrypt secret: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+type githubRepository struct {
+	Name          string `json:"name"`
+	HTMLURL       string `json:"html_url"`
+	CloneURL      string `json:"clone_url"`
+	DefaultBranch string `json:"default_branch"`
+	Owner         struct {
+		Login string `json:"login"`
+	} `json:"owner"`
+}
+
+type githubPublicKey struct {
+	KeyID string `json:"key_id"`
+	Key   string `json:"key"`
+}
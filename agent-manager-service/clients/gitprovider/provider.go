@@ -36,10 +36,50 @@ type Provider interface {
 	// ListCommits returns commits for a repository
 	ListCommits(ctx context.Context, owner, repo string, opts ListCommitsOptions) (*ListCommitsResponse, error)
 
+	// CreateRepositoryFromTemplate creates a new repository from a template repository
+	CreateRepositoryFromTemplate(ctx context.Context, opts CreateRepositoryFromTemplateOptions) (*Repository, error)
+
+	// SetRepositorySecret creates or updates a CI secret of a repository
+	SetRepositorySecret(ctx context.Context, owner, repo, name, value string) error
+
+	// CreateFile commits a new file to a repository
+	CreateFile(ctx context.Context, owner, repo string, opts CreateFileOptions) error
+
 	// GetProviderType returns the provider type
 	GetProviderType() ProviderType
 }
 
+// CreateRepositoryFromTemplateOptions contains options for creating a repository from a template
+type CreateRepositoryFromTemplateOptions struct {
+	// TemplateOwner and TemplateRepo identify the template repository
+	TemplateOwner string
+	TemplateRepo  string
+	// Owner is the user or organization that will own the new repository
+	Owner       string
+	Name        string
+	Description string
+	Private     bool
+}
+
+// CreateFileOptions contains options for committing a new file
+type CreateFileOptions struct {
+	Path    string
+	Content []byte
+	// Message is the commit message
+	Message string
+	// Branch defaults to the repository's default branch when empty
+	Branch string
+}
+
+// Repository represents a git repository
+type Repository struct {
+	Owner         string `json:"owner"`
+	Name          string `json:"name"`
+	URL           string `json:"url"`
+	CloneURL      string `json:"cloneUrl"`
+	DefaultBranch string `json:"defaultBranch"`
+}
+
 // ListBranchesOptions contains options for listing branches
 type ListBranchesOptions struct {
 	// PerPage is the number of results per page (max 100)
@@ -0,0 +1,245 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package mcpclient
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+const (
+	// protocolVersion is the MCP revision that introduced the Streamable HTTP transport
+	protocolVersion = "2025-03-26"
+	sessionHeader   = "Mcp-Session-Id"
+	// maxToolPages guards against servers that keep returning a next cursor
+	maxToolPages = 50
+)
+
+// Tool is a tool advertised by an MCP server
+type Tool struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description,omitempty"`
+	InputSchema json.RawMessage `json:"inputSchema,omitempty"`
+}
+
+// MCPClient lists the tools of MCP servers over the Streamable HTTP transport
+type MCPClient interface {
+	// ListTools connects to the server, performs the initialize handshake and returns all of its tools.
+	// The headers are sent with every request, e.g. for authentication.
+	ListTools(ctx context.Context, serverURL string, headers map[string]string) ([]Tool, error)
+}
+
+type mcpClient struct {
+	httpClient *http.Client
+}
+
+// NewMCPClient creates a new MCP client
+func NewMCPClient() MCPClient {
+	return &mcpClient{
+		httpClient: &http.Client{
+			Timeout:   30 * time.Second,
+			Transport: otelhttp.NewTransport(http.DefaultTransport),
+		},
+	}
+}
+
+type rpcRequest struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      *int   `json:"id,omitempty"`
+	Method  string `json:"method"`
+	Params  any    `json:"params,omitempty"`
+}
+
+type rpcResponse struct {
+	ID     *int            `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *rpcError       `json:"error"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type session struct {
+	client    *mcpClient
+	serverURL string
+	headers   map[string]string
+	id        string
+	nextID    int
+}
+
+func (c *mcpClient) ListTools(ctx context.Context, serverURL string, headers map[string]string) ([]Tool, error) {
+	s := &session{client: c, serverURL: serverURL, headers: headers}
+
+	if _, err := s.call(ctx, "initialize", map[string]any{
+		"protocolVersion": protocolVersion,
+		"capabilities":    map[string]any{},
+		"clientInfo": map[string]string{
+			"name":    "agent-manager-service",
+			"version": "1.0.0",
+		},
+	}); err != nil {
+		return nil, fmt.Errorf("failed to initialize MCP session: %w", err)
+	}
+	if err := s.notify(ctx, "notifications/initialized"); err != nil {
+		return nil, fmt.Errorf("failed to initialize MCP session: %w", err)
+	}
+
+	var tools []Tool
+	cursor := ""
+	for page := 0; page < maxToolPages; page++ {
+		params := map[string]any{}
+		if cursor != "" {
+			params["cursor"] = cursor
+		}
+		raw, err := s.call(ctx, "tools/list", params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list MCP tools: %w", err)
+		}
+		var result struct {
+			Tools      []Tool `json:"tools"`
+			NextCursor string `json:"nextCursor"`
+		}
+		if err := json.Unmarshal(raw, &result); err != nil {
+			return nil, fmt.Errorf("failed to decode MCP tools: %w", err)
+		}
+		tools = append(tools, result.Tools...)
+		if result.NextCursor == "" {
+			return tools, nil
+		}
+		cursor = result.NextCursor
+	}
+	return nil, fmt.Errorf("MCP server returned more than %d pages of tools", maxToolPages)
+}
+
+// call sends a JSON-RPC request and returns the result of the matching response
+func (s *session) call(ctx context.Context, method string, params any) (json.RawMessage, error) {
+	s.nextID++
+	id := s.nextID
+	resp, err := s.post(ctx, rpcRequest{JSONRPC: "2.0", ID: &id, Method: method, Params: params})
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("%s returned status %d: %s", method, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	if sessionID := resp.Header.Get(sessionHeader); sessionID != "" {
+		s.id = sessionID
+	}
+
+	rpcResp, err := readResponse(resp, id)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", method, err)
+	}
+	if rpcResp.Error != nil {
+		return nil, fmt.Errorf("%s failed with code %d: %s", method, rpcResp.Error.Code, rpcResp.Error.Message)
+	}
+	return rpcResp.Result, nil
+}
+
+// notify sends a JSON-RPC notification, which has no response
+func (s *session) notify(ctx context.Context, method string) error {
+	resp, err := s.post(ctx, rpcRequest{JSONRPC: "2.0", Method: method})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned status %d", method, resp.StatusCode)
+	}
+	return nil
+}
+
+func (s *session) post(ctx context.Context, msg rpcRequest) (*http.Response, error) {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.serverURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	for k, v := range s.headers {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json, text/event-stream")
+	if s.id != "" {
+		req.Header.Set(sessionHeader, s.id)
+	}
+	resp, err := s.client.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request to MCP server failed: %w", err)
+	}
+	return resp, nil
+}
+
+// readResponse reads the response with the given id from a JSON or an event stream body
+func readResponse(resp *http.Response, id int) (*rpcResponse, error) {
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if mediaType != "text/event-stream" {
+		var rpcResp rpcResponse
+		if err := json.NewDecoder(resp.Body).Decode(&rpcResp); err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+		return &rpcResp, nil
+	}
+
+	// The server may interleave notifications and requests with the response on the stream
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 64*1024), 4*1024*1024)
+	var data strings.Builder
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "data:") {
+			data.WriteString(strings.TrimSpace(strings.TrimPrefix(line, "data:")))
+			continue
+		}
+		if line != "" || data.Len() == 0 {
+			continue
+		}
+		var rpcResp rpcResponse
+		if err := json.Unmarshal([]byte(data.String()), &rpcResp); err == nil && rpcResp.ID != nil && *rpcResp.ID == id {
+			return &rpcResp, nil
+		}
+		data.Reset()
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read event stream: %w", err)
+	}
+	if data.Len() > 0 {
+		var rpcResp rpcResponse
+		if err := json.Unmarshal([]byte(data.String()), &rpcResp); err == nil && rpcResp.ID != nil && *rpcResp.ID == id {
+			return &rpcResp, nil
+		}
+	}
+	return nil, fmt.Errorf("event stream ended without a response")
+}
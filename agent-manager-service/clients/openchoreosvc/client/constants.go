@@ -98,6 +98,8 @@ const (
 const (
 	EnvVarOTELEndpoint = "AMP_OTEL_ENDPOINT"
 	EnvVarAgentAPIKey  = "AMP_AGENT_API_KEY"
+	// EnvVarMCPServers holds a JSON list of the MCP servers bound to the agent
+	EnvVarMCPServers = "AMP_MCP_SERVERS"
 )
 
 // SystemInjectedEnvVars is a set of environment variable names that are automatically
@@ -105,6 +107,7 @@ const (
 var SystemInjectedEnvVars = map[string]struct{}{
 	EnvVarOTELEndpoint: {},
 	EnvVarAgentAPIKey:  {},
+	EnvVarMCPServers:   {},
 }
 
 // -----------------------------------------------------------------------------
@@ -28,6 +28,7 @@ const (
 	DefaultRetryWaitMax     = 10 * time.Second
 	DefaultRetryAttemptsMax = 3
 	DefaultAttemptTimeout   = 30 * time.Second
+	DefaultRetryAfterMax    = 60 * time.Second
 )
 
 // TransientHTTPErrorCodes defines HTTP status codes that should trigger retries for non-idempotent operations
@@ -57,6 +58,9 @@ type RequestRetryConfig struct {
 	AttemptTimeout time.Duration
 	// RetryOnStatus is a function that returns true if the request should be retried based on the status code.
 	RetryOnStatus func(status int) bool
+	// RetryAfterMax is the longest Retry-After delay of a retryable response that is waited out.
+	// Responses asking for a longer delay are returned to the caller without retrying.
+	RetryAfterMax time.Duration
 }
 
 func (cfg RequestRetryConfig) getRetryConfig(req *HttpRequest) RequestRetryConfig {
@@ -72,6 +76,9 @@ func (cfg RequestRetryConfig) getRetryConfig(req *HttpRequest) RequestRetryConfi
 	if cfg.AttemptTimeout == 0 {
 		cfg.AttemptTimeout = DefaultAttemptTimeout
 	}
+	if cfg.RetryAfterMax == 0 {
+		cfg.RetryAfterMax = DefaultRetryAfterMax
+	}
 	if cfg.RetryOnStatus == nil {
 		cfg.RetryOnStatus = func(status int) bool {
 			if req.Method == http.MethodGet || req.Method == http.MethodDelete {
@@ -25,12 +25,31 @@ import (
 	"log/slog"
 	"math/rand/v2"
 	"net/http"
+	"strconv"
 	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
 )
 
 // errRetry is a sentinel error used internally to signal retry attempts.
 var errRetry = errors.New("retry")
 
+// retryAfterError signals a retry that must not start before the delay the server asked
+// for in a Retry-After header.
+type retryAfterError struct {
+	wait time.Duration
+}
+
+func (e *retryAfterError) Error() string {
+	return fmt.Sprintf("retry after %s", e.wait)
+}
+
+func (e *retryAfterError) Is(target error) bool {
+	return target == errRetry
+}
+
 // RetryableHTTPClient wraps an HttpClient with retry logic.
 // It implements HttpClient interface and can be used with oapi-codegen generated clients.
 type RetryableHTTPClient struct {
@@ -40,10 +59,15 @@ type RetryableHTTPClient struct {
 
 // NewRetryableHTTPClient creates a new RetryableHTTPClient.
 // Config is optional - defaults will be used if not provided.
+// The transport of an *http.Client is instrumented so that each attempt is traced and
+// the trace context of the request is propagated to the remote service.
 func NewRetryableHTTPClient(client HttpClient, config ...RequestRetryConfig) *RetryableHTTPClient {
 	if client == nil {
 		client = &http.Client{}
 	}
+	if httpClient, ok := client.(*http.Client); ok {
+		instrumentTransport(httpClient)
+	}
 	var cfg RequestRetryConfig
 	if len(config) > 0 {
 		cfg = config[0]
@@ -54,9 +78,23 @@ func NewRetryableHTTPClient(client HttpClient, config ...RequestRetryConfig) *Re
 	}
 }
 
+func instrumentTransport(client *http.Client) {
+	if _, ok := client.Transport.(*otelhttp.Transport); ok {
+		return
+	}
+	transport := client.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	client.Transport = otelhttp.NewTransport(transport)
+}
+
 // Do executes the HTTP request with retry logic.
+// The correlation ID of the request context is sent along so that every attempt can be traced
+// back to the operation that caused it.
 func (c *RetryableHTTPClient) Do(req *http.Request) (*http.Response, error) {
 	ctx := req.Context()
+	utils.SetCorrelationIDHeader(req)
 	cfg := c.config.getRetryConfig(&HttpRequest{Method: req.Method})
 	log := slog.Default().With(
 		slog.String("method", req.Method),
@@ -92,6 +130,10 @@ func (c *RetryableHTTPClient) Do(req *http.Request) (*http.Response, error) {
 		// errRetry means retry - wait before next attempt
 		if !isLastAttempt {
 			waitDuration := calculateBackoff(cfg.RetryWaitMin, cfg.RetryWaitMax, attempt)
+			var retryAfter *retryAfterError
+			if errors.As(err, &retryAfter) && retryAfter.wait > waitDuration {
+				waitDuration = retryAfter.wait
+			}
 			select {
 			case <-time.After(waitDuration):
 				// Continue to next attempt
@@ -150,6 +192,14 @@ func (c *RetryableHTTPClient) doAttempt(ctx context.Context, req *http.Request,
 			slog.Duration("duration", elapsed),
 			slog.Int("status", resp.StatusCode),
 		}
+		// A server asking to back off for longer than we are willing to wait gets its
+		// response passed through instead of being retried.
+		retryAfter, hasRetryAfter := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+		if hasRetryAfter && retryAfter > cfg.RetryAfterMax {
+			log.Warn("HTTP request rate limited beyond the maximum retry wait, not retrying",
+				append(logAttrs, slog.Duration("retryAfter", retryAfter))...)
+			isLastAttempt = true
+		}
 		if isLastAttempt {
 			log.Warn("HTTP request returned retryable status after all attempts", logAttrs...)
 			// Read body before attemptCtx is canceled to prevent "context canceled" errors
@@ -172,6 +222,9 @@ func (c *RetryableHTTPClient) doAttempt(ctx context.Context, req *http.Request,
 		if closeErr := resp.Body.Close(); closeErr != nil {
 			log.Warn("failed to close response body", slog.String("error", closeErr.Error()))
 		}
+		if hasRetryAfter {
+			return nil, &retryAfterError{wait: retryAfter}
+		}
 		return nil, errRetry
 	}
 
@@ -204,3 +257,24 @@ func calculateBackoff(min, max time.Duration, attempt int) time.Duration {
 	}
 	return halfBase + time.Duration(rand.Int64N(int64(halfBase)))
 }
+
+// parseRetryAfter reads a Retry-After header value given either as delay seconds or as an
+// HTTP date, returning the delay from now.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		if wait := at.Sub(now); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
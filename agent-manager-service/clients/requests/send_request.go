@@ -101,3 +101,23 @@ func (r *Result) GetHeader(key string) string {
 	}
 	return r.response.Header.Get(key)
 }
+
+// CheckStatus returns an error unless the response status is one of the given statuses.
+// Use it for requests whose response body is not needed.
+func (r *Result) CheckStatus(successStatuses ...int) error {
+	if r.err != nil {
+		return r.err
+	}
+	if r.response == nil {
+		return fmt.Errorf("unexpected nil response")
+	}
+	for _, status := range successStatuses {
+		if r.response.StatusCode == status {
+			return nil
+		}
+	}
+	return &HttpError{
+		StatusCode: r.response.StatusCode,
+		Body:       string(r.responseBody),
+	}
+}
@@ -17,6 +17,7 @@
 package traceobserversvc
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -24,8 +25,11 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
 )
 
@@ -34,24 +38,81 @@ type TraceObserverClient interface {
 	ListTraces(ctx context.Context, params ListTracesParams) (*TraceOverviewResponse, error)
 	ExportTraces(ctx context.Context, params ListTracesParams) (*TraceExportResponse, error)
 	TraceDetailsById(ctx context.Context, params TraceDetailsByIdParams) (*TraceResponse, error)
+	GetModelUsage(ctx context.Context, params ModelUsageParams) (*ModelUsageResponse, error)
+	GetStorageUsage(ctx context.Context, componentUids []string) (*StorageUsageResponse, error)
+	DeleteSpans(ctx context.Context, params DeleteSpansParams) (*DeleteSpansResponse, error)
+	EraseSpans(ctx context.Context, params EraseSpansParams) (*DeleteSpansResponse, error)
+	GetTask(ctx context.Context, taskID string) (*TaskStatus, error)
+	GetTraceLogs(ctx context.Context, params TraceLogsParams) (*TraceLogsResponse, error)
+	GetSLOStatus(ctx context.Context, params SLOParams) (*SLOStatusResponse, error)
+	GetSLOHistory(ctx context.Context, params SLOParams) (*SLOHistoryResponse, error)
+	ListIssues(ctx context.Context, params ListIssuesParams) (*IssueListResponse, error)
 }
 
+// correlationIDHeader is the header that carries the correlation ID of a request. This package
+// cannot import utils, which depends on it, so the header name is repeated here.
+const correlationIDHeader = "x-correlation-id"
+
+// contentVisibilityHeader selects how much of the content of spans the trace observer returns
+const contentVisibilityHeader = "x-content-visibility"
+
 type traceObserverClient struct {
 	baseURL    string
 	httpClient *http.Client
 }
 
+// TokenSource returns the bearer token of the request being served, if any
+type TokenSource func(ctx context.Context) string
+
+// CorrelationIDSource returns the correlation ID of the request being served, if any
+type CorrelationIDSource func(ctx context.Context) string
+
 // NewTraceObserverClient creates a new TraceObserverClient instance
-func NewTraceObserverClient() TraceObserverClient {
+func NewTraceObserverClient(tokenSource TokenSource, correlationIDSource CorrelationIDSource) TraceObserverClient {
 	cfg := config.GetConfig()
 	return &traceObserverClient{
 		baseURL: cfg.TraceObserver.URL,
 		httpClient: &http.Client{
 			Timeout: 15 * time.Second,
+			Transport: otelhttp.NewTransport(&forwardingTransport{
+				next:                http.DefaultTransport,
+				tokenSource:         tokenSource,
+				correlationIDSource: correlationIDSource,
+			}),
 		},
 	}
 }
 
+// forwardingTransport passes the caller's bearer token on to the trace observer service, which
+// validates the same tokens as this service, together with the correlation ID of the request so
+// that the trace observer logs it with the same ID
+type forwardingTransport struct {
+	next                http.RoundTripper
+	tokenSource         TokenSource
+	correlationIDSource CorrelationIDSource
+}
+
+func (t *forwardingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var token, correlationID string
+	if t.tokenSource != nil && req.Header.Get("Authorization") == "" {
+		token = t.tokenSource(req.Context())
+	}
+	if t.correlationIDSource != nil && req.Header.Get(correlationIDHeader) == "" {
+		correlationID = t.correlationIDSource(req.Context())
+	}
+	if token == "" && correlationID == "" {
+		return t.next.RoundTrip(req)
+	}
+	req = req.Clone(req.Context())
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	if correlationID != "" {
+		req.Header.Set(correlationIDHeader, correlationID)
+	}
+	return t.next.RoundTrip(req)
+}
+
 // ListTraces retrieves trace overviews from the trace observer service
 func (c *traceObserverClient) ListTraces(ctx context.Context, params ListTracesParams) (*TraceOverviewResponse, error) {
 	// Build query parameters
@@ -69,6 +130,9 @@ func (c *traceObserverClient) ListTraces(ctx context.Context, params ListTracesP
 	queryParams.Add("limit", strconv.Itoa(params.Limit))
 	queryParams.Add("offset", strconv.Itoa(params.Offset))
 	queryParams.Add("sortOrder", params.SortOrder)
+	if params.Archived {
+		queryParams.Add("archived", "true")
+	}
 
 	// Build URL - endpoint is /api/v1/traces
 	requestURL := fmt.Sprintf("%s/api/v1/traces?%s", c.baseURL, queryParams.Encode())
@@ -78,6 +142,9 @@ func (c *traceObserverClient) ListTraces(ctx context.Context, params ListTracesP
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
+	if params.ContentVisibility != "" {
+		req.Header.Set(contentVisibilityHeader, params.ContentVisibility)
+	}
 
 	// Execute request
 	resp, err := c.httpClient.Do(req)
@@ -121,6 +188,9 @@ func (c *traceObserverClient) ExportTraces(ctx context.Context, params ListTrace
 	queryParams.Add("limit", strconv.Itoa(params.Limit))
 	queryParams.Add("offset", strconv.Itoa(params.Offset))
 	queryParams.Add("sortOrder", params.SortOrder)
+	if params.Archived {
+		queryParams.Add("archived", "true")
+	}
 
 	// Build URL - endpoint is /api/v1/traces/export
 	requestURL := fmt.Sprintf("%s/api/v1/traces/export?%s", c.baseURL, queryParams.Encode())
@@ -130,6 +200,9 @@ func (c *traceObserverClient) ExportTraces(ctx context.Context, params ListTrace
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
+	if params.ContentVisibility != "" {
+		req.Header.Set(contentVisibilityHeader, params.ContentVisibility)
+	}
 
 	// Execute request
 	resp, err := c.httpClient.Do(req)
@@ -165,6 +238,9 @@ func (c *traceObserverClient) TraceDetailsById(ctx context.Context, params Trace
 	if params.EnvironmentUid != "" {
 		queryParams.Add("environmentUid", params.EnvironmentUid)
 	}
+	if params.Archived {
+		queryParams.Add("archived", "true")
+	}
 
 	// Build URL - endpoint is /api/v1/trace (singular, not plural)
 	requestURL := fmt.Sprintf("%s/api/v1/trace?%s", c.baseURL, queryParams.Encode())
@@ -174,6 +250,9 @@ func (c *traceObserverClient) TraceDetailsById(ctx context.Context, params Trace
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
+	if params.ContentVisibility != "" {
+		req.Header.Set(contentVisibilityHeader, params.ContentVisibility)
+	}
 
 	// Execute request
 	resp, err := c.httpClient.Do(req)
@@ -199,3 +278,376 @@ func (c *traceObserverClient) TraceDetailsById(ctx context.Context, params Trace
 
 	return &response, nil
 }
+
+// GetModelUsage retrieves the usage of LLM and embedding models of the given components
+func (c *traceObserverClient) GetModelUsage(ctx context.Context, params ModelUsageParams) (*ModelUsageResponse, error) {
+	// Build query parameters
+	queryParams := url.Values{}
+	queryParams.Add("environmentUid", params.EnvironmentUid)
+	if len(params.ComponentUids) > 0 {
+		queryParams.Add("componentUids", strings.Join(params.ComponentUids, ","))
+	}
+	if params.StartTime != "" {
+		queryParams.Add("startTime", params.StartTime)
+	}
+	if params.EndTime != "" {
+		queryParams.Add("endTime", params.EndTime)
+	}
+
+	// Build URL - endpoint is /api/v1/models/usage
+	requestURL := fmt.Sprintf("%s/api/v1/models/usage?%s", c.baseURL, queryParams.Encode())
+
+	// Create HTTP request
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute request
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	// Check response status
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, &HTTPError{
+			StatusCode: resp.StatusCode,
+			Message:    string(body),
+		}
+	}
+
+	// Parse response
+	var response ModelUsageResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetStorageUsage retrieves the stored span data of the given components
+func (c *traceObserverClient) GetStorageUsage(ctx context.Context, componentUids []string) (*StorageUsageResponse, error) {
+	// Build query parameters
+	queryParams := url.Values{}
+	queryParams.Add("componentUids", strings.Join(componentUids, ","))
+
+	// Build URL - endpoint is /api/v1/storage
+	requestURL := fmt.Sprintf("%s/api/v1/storage?%s", c.baseURL, queryParams.Encode())
+
+	// Create HTTP request
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute request
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	// Check response status
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, &HTTPError{
+			StatusCode: resp.StatusCode,
+			Message:    string(body),
+		}
+	}
+
+	// Parse response
+	var response StorageUsageResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// DeleteSpans starts deleting the spans of the given components that are past their retention period
+func (c *traceObserverClient) DeleteSpans(ctx context.Context, params DeleteSpansParams) (*DeleteSpansResponse, error) {
+	body, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	// Build URL - endpoint is /api/v1/spans/delete
+	requestURL := fmt.Sprintf("%s/api/v1/spans/delete", c.baseURL)
+
+	// Create HTTP request
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, requestURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Execute request
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	// Check response status
+	if resp.StatusCode != http.StatusAccepted {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, &HTTPError{
+			StatusCode: resp.StatusCode,
+			Message:    string(body),
+		}
+	}
+
+	// Parse response
+	var response DeleteSpansResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// EraseSpans starts deleting the spans of the given components that carry a personal identifier
+func (c *traceObserverClient) EraseSpans(ctx context.Context, params EraseSpansParams) (*DeleteSpansResponse, error) {
+	body, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	// Build URL - endpoint is /api/v1/spans/erase
+	requestURL := fmt.Sprintf("%s/api/v1/spans/erase", c.baseURL)
+
+	// Create HTTP request
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, requestURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	// Execute request
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	// Check response status
+	if resp.StatusCode != http.StatusAccepted {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, &HTTPError{
+			StatusCode: resp.StatusCode,
+			Message:    string(body),
+		}
+	}
+
+	// Parse response
+	var response DeleteSpansResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetTask retrieves the progress of a span deletion task
+func (c *traceObserverClient) GetTask(ctx context.Context, taskID string) (*TaskStatus, error) {
+	// Build URL - endpoint is /api/v1/tasks/{taskId}
+	requestURL := fmt.Sprintf("%s/api/v1/tasks/%s", c.baseURL, url.PathEscape(taskID))
+
+	// Create HTTP request
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute request
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	// Check response status
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, &HTTPError{
+			StatusCode: resp.StatusCode,
+			Message:    string(body),
+		}
+	}
+
+	// Parse response
+	var response TaskStatus
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetTraceLogs retrieves the application logs that carry the trace context of a trace
+func (c *traceObserverClient) GetTraceLogs(ctx context.Context, params TraceLogsParams) (*TraceLogsResponse, error) {
+	// Build query parameters
+	queryParams := url.Values{}
+	queryParams.Add("traceId", params.TraceID)
+	queryParams.Add("componentUid", params.ComponentUid)
+	queryParams.Add("environmentUid", params.EnvironmentUid)
+	if params.SpanID != "" {
+		queryParams.Add("spanId", params.SpanID)
+	}
+	if params.Limit > 0 {
+		queryParams.Add("limit", strconv.Itoa(params.Limit))
+	}
+
+	// Build URL - endpoint is /api/v1/trace/logs
+	requestURL := fmt.Sprintf("%s/api/v1/trace/logs?%s", c.baseURL, queryParams.Encode())
+
+	// Create HTTP request
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute request
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	// Check response status
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, &HTTPError{
+			StatusCode: resp.StatusCode,
+			Message:    string(body),
+		}
+	}
+
+	// Parse response
+	var response TraceLogsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetSLOStatus computes the compliance of a component with a service level objective over its window
+func (c *traceObserverClient) GetSLOStatus(ctx context.Context, params SLOParams) (*SLOStatusResponse, error) {
+	var response SLOStatusResponse
+	if err := c.getSLO(ctx, "status", params, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// GetSLOHistory computes the compliance of a component with a service level objective per interval
+func (c *traceObserverClient) GetSLOHistory(ctx context.Context, params SLOParams) (*SLOHistoryResponse, error) {
+	var response SLOHistoryResponse
+	if err := c.getSLO(ctx, "history", params, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// getSLO reads an SLO endpoint of the trace observer into response
+func (c *traceObserverClient) getSLO(ctx context.Context, endpoint string, params SLOParams, response interface{}) error {
+	// Build query parameters
+	queryParams := url.Values{}
+	queryParams.Add("componentUid", params.ComponentUid)
+	queryParams.Add("environmentUid", params.EnvironmentUid)
+	queryParams.Add("indicator", params.Indicator)
+	queryParams.Add("target", strconv.FormatFloat(params.Target, 'f', -1, 64))
+	if params.ThresholdMs > 0 {
+		queryParams.Add("thresholdMs", strconv.FormatFloat(params.ThresholdMs, 'f', -1, 64))
+	}
+	if params.WindowDays > 0 {
+		queryParams.Add("windowDays", strconv.Itoa(params.WindowDays))
+	}
+	if params.Interval != "" {
+		queryParams.Add("interval", params.Interval)
+	}
+
+	// Build URL - endpoint is /api/v1/slo/{status|history}
+	requestURL := fmt.Sprintf("%s/api/v1/slo/%s?%s", c.baseURL, endpoint, queryParams.Encode())
+
+	// Create HTTP request
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute request
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	// Check response status
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return &HTTPError{
+			StatusCode: resp.StatusCode,
+			Message:    string(body),
+		}
+	}
+
+	// Parse response
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// ListIssues retrieves the issues of a component, most recently seen first
+func (c *traceObserverClient) ListIssues(ctx context.Context, params ListIssuesParams) (*IssueListResponse, error) {
+	// Build query parameters
+	queryParams := url.Values{}
+	queryParams.Add("componentUid", params.ComponentUid)
+	if params.Status != "" {
+		queryParams.Add("status", params.Status)
+	}
+	if params.Limit > 0 {
+		queryParams.Add("limit", strconv.Itoa(params.Limit))
+	}
+	if params.Offset > 0 {
+		queryParams.Add("offset", strconv.Itoa(params.Offset))
+	}
+
+	// Build URL
+	requestURL := fmt.Sprintf("%s/api/v1/issues?%s", c.baseURL, queryParams.Encode())
+
+	// Create HTTP request
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Execute request
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	// Check response status
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, &HTTPError{
+			StatusCode: resp.StatusCode,
+			Message:    string(body),
+		}
+	}
+
+	// Parse response
+	var response IssueListResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return &response, nil
+}
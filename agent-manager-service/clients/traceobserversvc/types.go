@@ -41,6 +41,11 @@ type ListTracesParams struct {
 	Limit          int
 	Offset         int
 	SortOrder      string
+	// ContentVisibility limits the span content returned: full, redacted or metadata-only. The trace
+	// observer never returns more than it allows the caller; empty asks for that much.
+	ContentVisibility string
+	// Archived also searches the spans moved to the trace archive, which is slower
+	Archived bool
 }
 
 // TraceDetailsByIdParams holds parameters for getting trace details by ID
@@ -49,6 +54,11 @@ type TraceDetailsByIdParams struct {
 	ServiceName    string
 	ComponentUid   string
 	EnvironmentUid string
+	// ContentVisibility limits the span content returned: full, redacted or metadata-only. The trace
+	// observer never returns more than it allows the caller; empty asks for that much.
+	ContentVisibility string
+	// Archived also searches the spans moved to the trace archive, which is slower
+	Archived bool
 }
 
 // TraceOverview represents a single trace overview with root span info
@@ -177,4 +187,212 @@ type TraceResponse struct {
 	TotalCount int          `json:"totalCount"`
 	TokenUsage *TokenUsage  `json:"tokenUsage,omitempty"` // Aggregated token usage from GenAI spans
 	Status     *TraceStatus `json:"status,omitempty"`     // Trace status including error information
+	Input      interface{}  `json:"input,omitempty"`      // Input from the root span (nil if not found)
+	Output     interface{}  `json:"output,omitempty"`     // Output from the root span (nil if not found)
+}
+
+// ModelUsageParams holds parameters for model usage queries
+type ModelUsageParams struct {
+	ComponentUids  []string
+	EnvironmentUid string
+	StartTime      string
+	EndTime        string
+}
+
+// UsageStats holds request, latency, error and cost figures of LLM and embedding calls
+type UsageStats struct {
+	RequestCount  int      `json:"requestCount"`
+	ErrorCount    int      `json:"errorCount"`
+	ErrorRate     float64  `json:"errorRate"`
+	AvgLatencyMs  float64  `json:"avgLatencyMs"`
+	P50LatencyMs  float64  `json:"p50LatencyMs"`
+	P95LatencyMs  float64  `json:"p95LatencyMs"`
+	InputTokens   int      `json:"inputTokens"`
+	OutputTokens  int      `json:"outputTokens"`
+	TotalTokens   int      `json:"totalTokens"`
+	EstimatedCost *float64 `json:"estimatedCost,omitempty"`
+}
+
+// ModelUsage holds the usage of a single model of a provider
+type ModelUsage struct {
+	Model    string `json:"model"`
+	Provider string `json:"provider,omitempty"`
+	UsageStats
+}
+
+// ProviderUsage holds the usage of all models of a provider
+type ProviderUsage struct {
+	Provider string `json:"provider"`
+	UsageStats
+}
+
+// ModelUsageResponse represents the response for model usage queries
+type ModelUsageResponse struct {
+	Models    []ModelUsage    `json:"models"`
+	Providers []ProviderUsage `json:"providers"`
+	SpanCount int             `json:"spanCount"`
+	Truncated bool            `json:"truncated"`
+}
+
+// ComponentStorageUsage holds the stored span data of a component
+type ComponentStorageUsage struct {
+	ComponentUid       string     `json:"componentUid"`
+	SpanCount          int64      `json:"spanCount"`
+	TraceCount         int64      `json:"traceCount"`
+	OldestSpanTime     *time.Time `json:"oldestSpanTime,omitempty"`
+	NewestSpanTime     *time.Time `json:"newestSpanTime,omitempty"`
+	EstimatedSizeBytes int64      `json:"estimatedSizeBytes"`
+}
+
+// StorageUsageResponse represents the response for storage usage queries
+type StorageUsageResponse struct {
+	Components     []ComponentStorageUsage `json:"components"`
+	TotalSpanCount int64                   `json:"totalSpanCount"`
+	TotalSizeBytes int64                   `json:"totalSizeBytes"`
+}
+
+// DeleteSpansParams selects the spans of components that are past their retention period
+type DeleteSpansParams struct {
+	ComponentUids []string `json:"componentUids"`
+	// Before deletes spans that started before this time
+	Before string `json:"before"`
+	// ErrorsBefore keeps error spans that started after this earlier time
+	ErrorsBefore string `json:"errorsBefore,omitempty"`
+}
+
+// DeleteSpansResponse represents the response of a span deletion request
+type DeleteSpansResponse struct {
+	TaskID string `json:"taskId"`
+}
+
+// EraseSpansParams selects the spans of components that carry a personal identifier
+type EraseSpansParams struct {
+	ComponentUids []string `json:"componentUids"`
+	// Attribute is the span attribute holding the identifier; the trace observer defaults to enduser.id
+	Attribute string `json:"attribute,omitempty"`
+	Value     string `json:"value"`
+}
+
+// TaskStatus represents the progress of a span deletion task
+type TaskStatus struct {
+	TaskID    string `json:"taskId"`
+	Completed bool   `json:"completed"`
+	Total     int64  `json:"total"`
+	Deleted   int64  `json:"deleted"`
+	Failures  int    `json:"failures"`
+	Error     string `json:"error,omitempty"`
+}
+
+// TraceLogsParams holds parameters for getting the application logs of a trace
+type TraceLogsParams struct {
+	TraceID        string
+	SpanID         string
+	ComponentUid   string
+	EnvironmentUid string
+	Limit          int
+}
+
+// LogRecord represents an application log record carrying the trace context of a span
+type LogRecord struct {
+	Timestamp time.Time              `json:"timestamp"`
+	Message   string                 `json:"message"`
+	TraceID   string                 `json:"traceId,omitempty"`
+	SpanID    string                 `json:"spanId,omitempty"`
+	Fields    map[string]interface{} `json:"fields,omitempty"`
+}
+
+// TraceLogsResponse represents the response of the trace logs endpoint
+type TraceLogsResponse struct {
+	TraceID    string      `json:"traceId"`
+	SpanID     string      `json:"spanId,omitempty"`
+	Logs       []LogRecord `json:"logs"`
+	TotalCount int         `json:"totalCount"`
+	Truncated  bool        `json:"truncated"`
+}
+
+// SLOParams holds the component and service level objective to compute compliance for
+type SLOParams struct {
+	ComponentUid   string
+	EnvironmentUid string
+	// Indicator is latency or availability
+	Indicator   string
+	ThresholdMs float64
+	// Target is the percentage of traces that have to be good
+	Target     float64
+	WindowDays int
+	// Interval is the interval of history points, e.g. 24h
+	Interval string
+}
+
+// SLOErrorBudget is the number of bad traces an objective allows within its window
+type SLOErrorBudget struct {
+	Allowed   float64 `json:"allowed"`
+	Consumed  int64   `json:"consumed"`
+	Remaining float64 `json:"remaining"`
+}
+
+// SLOBurnRate is the rate at which the error budget was consumed over a trailing window
+type SLOBurnRate struct {
+	Window   string   `json:"window"`
+	BurnRate *float64 `json:"burnRate"`
+}
+
+// SLOStatusResponse represents the compliance of a component with an objective over its window
+type SLOStatusResponse struct {
+	WindowStart   time.Time      `json:"windowStart"`
+	WindowEnd     time.Time      `json:"windowEnd"`
+	TotalCount    int64          `json:"totalCount"`
+	GoodCount     int64          `json:"goodCount"`
+	Compliance    *float64       `json:"compliance"`
+	ObservedValue *float64       `json:"observedValue"`
+	ErrorBudget   SLOErrorBudget `json:"errorBudget"`
+	BurnRates     []SLOBurnRate  `json:"burnRates"`
+	Status        string         `json:"status"`
+}
+
+// SLOHistoryPoint is the compliance of the traces of one interval
+type SLOHistoryPoint struct {
+	Time            time.Time `json:"time"`
+	TotalCount      int64     `json:"totalCount"`
+	GoodCount       int64     `json:"goodCount"`
+	Compliance      *float64  `json:"compliance"`
+	BudgetRemaining float64   `json:"budgetRemaining"`
+}
+
+// SLOHistoryResponse represents the compliance of a component with an objective over time
+type SLOHistoryResponse struct {
+	WindowStart time.Time         `json:"windowStart"`
+	WindowEnd   time.Time         `json:"windowEnd"`
+	Interval    string            `json:"interval"`
+	Points      []SLOHistoryPoint `json:"points"`
+}
+
+// ListIssuesParams holds the parameters for listing the issues of a component
+type ListIssuesParams struct {
+	ComponentUid string
+	// Status is open, acknowledged or resolved; all issues are listed when empty
+	Status string
+	Limit  int
+	Offset int
+}
+
+// Issue is a group of errors of a component that share the same error type and tool
+type Issue struct {
+	ID           string `json:"id"`
+	ComponentUid string `json:"componentUid"`
+	ErrorType    string `json:"errorType,omitempty"`
+	ToolName     string `json:"toolName,omitempty"`
+	SpanName     string `json:"spanName"`
+	// Status is open, acknowledged or resolved
+	Status         string    `json:"status"`
+	Occurrences    int       `json:"occurrences"`
+	FirstSeen      time.Time `json:"firstSeen"`
+	LastSeen       time.Time `json:"lastSeen"`
+	RecentTraceIDs []string  `json:"recentTraceIds"`
+}
+
+// IssueListResponse is a page of the issues of a component, most recently seen first
+type IssueListResponse struct {
+	Issues     []Issue `json:"issues"`
+	TotalCount int     `json:"totalCount"`
 }
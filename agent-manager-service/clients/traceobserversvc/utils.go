@@ -29,3 +29,13 @@ func IsNotFound(err error) bool {
 	}
 	return false
 }
+
+// IsNotImplemented checks if the error is a 501 Not Implemented error, returned for features the
+// trace observer service is not configured for
+func IsNotImplemented(err error) bool {
+	httpErr := &HTTPError{}
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode == http.StatusNotImplemented
+	}
+	return false
+}
@@ -24,20 +24,38 @@ type Config struct {
 	AuthHeader          string
 	AutoMaxProcsEnabled bool
 	LogLevel            string
-	POSTGRESQL          POSTGRESQL
-	KubeConfig          string
+	// BodyLogging logs redacted request and response bodies at DEBUG level for troubleshooting
+	BodyLogging BodyLoggingConfig
+	// DBDriver selects the database backend; SQLite is intended for local development only
+	DBDriver   string
+	POSTGRESQL POSTGRESQL
+	// SQLitePath is the database file used when DBDriver is sqlite
+	SQLitePath string
+	KubeConfig string
 	// HTTP Server timeout configurations
 	ReadTimeoutSeconds  int
 	WriteTimeoutSeconds int
 	IdleTimeoutSeconds  int
 	MaxHeaderBytes      int
+	// Graceful shutdown configurations
+	ShutdownDrainDelaySeconds int
+	ShutdownTimeoutSeconds    int
 	// Database operation timeout configuration
 	DbOperationTimeoutSeconds int
 	HealthCheckTimeoutSeconds int
+	// DBMigrationStartupMode controls the schema check at startup: "auto", "check" or "off"
+	DBMigrationStartupMode string
 
 	// CORSAllowedOrigin is the single allowed origin for CORS; use "*" to allow all
 	CORSAllowedOrigin string
 
+	// PlatformAdminScope is the token scope needed to create, change, suspend and delete
+	// organizations and to provision or end their trial sandboxes
+	PlatformAdminScope string
+
+	// Regions is the catalog of regions that environments and gateways can be placed in
+	Regions []string
+
 	// OpenTelemetry configuration
 	OTEL OTELConfig
 
@@ -70,6 +88,111 @@ type Config struct {
 
 	// API Platform configuration
 	APIPlatform APIPlatformConfig
+
+	// Internal gRPC server configuration
+	GRPC GRPCConfig
+
+	// Tracing of agent-manager-service itself
+	Tracing TracingConfig
+
+	// CredentialsEncryptionKey is the base64 encoded AES-256 key used to encrypt stored credentials
+	CredentialsEncryptionKey string `json:"-"`
+
+	// MCP server registry configuration
+	MCP MCPConfig
+
+	// LLM judge configuration for automated trace scoring
+	TraceJudge TraceJudgeConfig
+
+	// Approval of production deployments
+	DeploymentApproval DeploymentApprovalConfig
+
+	// Windows during which changes to an organization are frozen
+	ChangeFreeze ChangeFreezeConfig
+
+	// Trial sandboxes served by a shared managed gateway
+	TrialSandbox TrialSandboxConfig
+
+	// Email notifications sent through an SMTP server
+	Email EmailConfig
+}
+
+// BodyLoggingConfig holds the settings of request and response body logging
+type BodyLoggingConfig struct {
+	// Enabled turns body logging on; bodies are only logged when LOG_LEVEL is DEBUG as well
+	Enabled bool
+	// MaxBytes caps how much of each body is logged
+	MaxBytes int
+}
+
+// TraceJudgeConfig holds the LLM that scores sampled traces of agents with a scoring policy
+type TraceJudgeConfig struct {
+	// URL is the base URL of an OpenAI compatible chat completions API, such as an LLM proxy of the
+	// AI gateway; empty disables trace scoring
+	URL    string
+	APIKey string `json:"-"`
+	Model  string
+	// IntervalSeconds is how often new traces of each scoring policy are sampled and scored; 0 disables it
+	IntervalSeconds int
+	// MaxTracesPerRun bounds the traces of a scoring policy sampled on each run
+	MaxTracesPerRun int
+}
+
+// DeploymentApprovalConfig holds the settings of production deployment approvals
+type DeploymentApprovalConfig struct {
+	// WebhookURL is sent a JSON notification when a deployment approval is requested or reviewed;
+	// empty disables notifications
+	WebhookURL string
+	// AdminScope is the token scope needed to set or delete the approval policy of an organization
+	AdminScope string
+}
+
+// ChangeFreezeConfig holds the settings of change freezes
+type ChangeFreezeConfig struct {
+	// AdminScope is the token scope needed to declare or delete the change freeze windows of an
+	// organization
+	AdminScope string
+}
+
+// TrialSandboxConfig holds the settings of trial sandboxes
+type TrialSandboxConfig struct {
+	// GatewayID is the shared managed gateway that serves trial sandboxes; empty disables them
+	GatewayID string
+	// LLMProviderID is the LLM provider offered in trial sandboxes. Its default consumer rate limit
+	// is set to RequestsPerMinute and TokensPerDay when it has none; empty leaves rate limits alone.
+	LLMProviderID     string
+	RequestsPerMinute int
+	TokensPerDay      int
+	// DurationDays is how long a trial sandbox lasts
+	DurationDays int
+	// AutoProvision provisions a trial sandbox for each new organization
+	AutoProvision bool
+	// CleanupIntervalSeconds is how often expired trial sandboxes are removed; 0 disables it
+	CleanupIntervalSeconds int
+}
+
+// EmailConfig holds the SMTP server email notifications are sent through
+type EmailConfig struct {
+	// SMTPHost is the SMTP server; empty disables email notifications
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string `json:"-"`
+	// ImplicitTLS connects over TLS, as on port 465. Otherwise the connection is upgraded with
+	// STARTTLS when the server offers it.
+	ImplicitTLS bool
+	// From is the sender address of notification emails
+	From string
+	// TokenExpiryWarningDays is how long before an agent API key expires its recipients are warned
+	TokenExpiryWarningDays int
+	// TokenExpiryCheckIntervalSeconds is how often expiring agent API keys are looked up; 0 disables it
+	TokenExpiryCheckIntervalSeconds int
+}
+
+// MCPConfig holds MCP server registry configuration
+type MCPConfig struct {
+	// ToolRefreshIntervalSeconds is how often the tool lists of registered MCP servers are refreshed; 0 disables it
+	ToolRefreshIntervalSeconds int
 }
 
 // OpenChoreoConfig holds OpenChoreo API configuration
@@ -83,6 +206,8 @@ type GitHubConfig struct {
 	// Token is a GitHub Personal Access Token for API authentication (optional but recommended)
 	// Without a token, rate limit is 60 requests/hour; with token, 5000 requests/hour
 	Token string `json:"-"`
+	// TemplateOwner is the user or organization that hosts the agent template repositories
+	TemplateOwner string
 }
 
 type IDPConfig struct {
@@ -96,6 +221,23 @@ type KeyManagerConfigurations struct {
 	Audience      []string
 	JWKSUrl       string
 	DefaultIssuer string // Default issuer allowed to skip JWKS signature validation
+
+	// TrustedIssuers lists additional identity providers whose tokens are accepted, each with its own JWKS
+	TrustedIssuers []TrustedIssuer
+	// ClockSkewSeconds is the leeway applied to exp, nbf and iat checks
+	ClockSkewSeconds int64
+	// JWKSCacheTTLSeconds is how long fetched signing keys are used before they are refreshed
+	JWKSCacheTTLSeconds int64
+	// JWKSMinRefreshIntervalSeconds limits refreshes triggered by tokens signed with an unknown key
+	JWKSMinRefreshIntervalSeconds int64
+}
+
+// TrustedIssuer is an identity provider whose tokens are accepted
+type TrustedIssuer struct {
+	Issuer  string `json:"issuer"`
+	JWKSUrl string `json:"jwksUrl"`
+	// Audiences overrides KEY_MANAGER_AUDIENCE for tokens from this issuer
+	Audiences []string `json:"audiences,omitempty"`
 }
 
 type AgentWorkload struct {
@@ -129,6 +271,16 @@ type ObserverConfig struct {
 type TraceObserverConfig struct {
 	// Trace Observer service URL
 	URL string
+	// RetentionEnforceIntervalSeconds is how often spans past the retention period of each organization are deleted; 0 disables it
+	RetentionEnforceIntervalSeconds int
+	// GoldenTraceIntervalSeconds is how often due golden trace replays are started and pending runs are scored; 0 disables it
+	GoldenTraceIntervalSeconds int
+	// TokenBudgetIntervalSeconds is how often the token usage of agents with a budget is checked; 0 disables it
+	TokenBudgetIntervalSeconds int
+	// UsageReportIntervalSeconds is how often the usage reports of the previous month are generated for organizations without one; 0 disables it
+	UsageReportIntervalSeconds int
+	// NotificationDigestIntervalSeconds is how often due daily and weekly notification digests are sent; 0 disables it
+	NotificationDigestIntervalSeconds int
 }
 
 type POSTGRESQL struct {
@@ -150,6 +302,14 @@ type DbConfigs struct {
 	MaxOpenCount       *int64 // <= 0 means unlimited
 	MaxLifetimeSeconds *int64 // maximum amount of time a connection may be reused
 	MaxIdleTimeSeconds *int64
+
+	// ReadReplicaDSNs are connection strings of PostgreSQL read replicas. Reads outside of
+	// transactions are load balanced across them; the pool settings above apply to each replica.
+	ReadReplicaDSNs []string `json:"-"`
+	// QueryTimeoutSeconds and WriteTimeoutSeconds bound a single read or write statement.
+	// Zero leaves statements bounded only by DbOperationTimeoutSeconds.
+	QueryTimeoutSeconds int64
+	WriteTimeoutSeconds int64
 }
 
 type DefaultChatAPIConfig struct {
@@ -187,8 +347,47 @@ type PublicKeysConfig struct {
 	Keys []PublicKeyConfig `json:"keys"`
 }
 
+// GRPCConfig holds configuration of the gRPC server used for internal service-to-service calls
+type GRPCConfig struct {
+	Enabled bool
+	Port    int
+	// TLSCertFile and TLSKeyFile enable TLS when both are set
+	TLSCertFile string
+	TLSKeyFile  string
+}
+
+const (
+	DBDriverPostgres = "postgres"
+	DBDriverSQLite   = "sqlite"
+)
+
+const (
+	// DBMigrationStartupModeAuto applies pending migrations at startup
+	DBMigrationStartupModeAuto = "auto"
+	// DBMigrationStartupModeCheck refuses to start while migrations are pending
+	DBMigrationStartupModeCheck = "check"
+	// DBMigrationStartupModeOff skips the schema check
+	DBMigrationStartupModeOff = "off"
+)
+
+// TracingConfig holds the OpenTelemetry configuration used to trace agent-manager-service itself.
+// It is separate from OTELConfig, which configures the instrumentation injected into agents.
+type TracingConfig struct {
+	Enabled     bool
+	ServiceName string
+	// OTLPEndpoint is the host:port of an OTLP gRPC collector
+	OTLPEndpoint string
+	Insecure     bool
+	// SamplingRatio is the fraction of root spans sampled, between 0 and 1
+	SamplingRatio float64
+}
+
 // APIPlatformConfig holds API Platform client configuration
 type APIPlatformConfig struct {
 	BaseURL string // Base URL for API Platform
 	Enable  bool
+	// InMemory replaces the API Platform with an in-memory client for local development
+	InMemory bool
+	// CacheTTLSeconds is how long gateway and organization lookups are cached; 0 disables caching
+	CacheTTLSeconds int64
 }
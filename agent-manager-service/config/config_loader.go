@@ -17,20 +17,30 @@
 package config
 
 import (
+	"encoding/base64"
+	"errors"
 	"fmt"
 	"log/slog"
+	"net/mail"
+	"net/url"
 	"os"
+	"strings"
+	"sync/atomic"
 
+	"github.com/google/uuid"
 	"github.com/joho/godotenv"
 )
 
 var (
-	config              *Config
+	// current holds the configuration, which Reload replaces while the service runs
+	current             atomic.Pointer[Config]
 	agentWorkloadConfig *AgentWorkload
+	// schema describes the settings read by the last load
+	schema []Setting
 )
 
 func GetConfig() *Config {
-	return config
+	return current.Load()
 }
 
 func GetAgentWorkloadConfig() *AgentWorkload {
@@ -42,9 +52,6 @@ func init() {
 }
 
 func loadEnvs() {
-	config = &Config{}
-	agentWorkloadConfig = &AgentWorkload{}
-
 	envFilePath := os.Getenv("ENV_FILE_PATH")
 	if envFilePath != "" {
 		err := godotenv.Load(envFilePath)
@@ -53,12 +60,57 @@ func loadEnvs() {
 		}
 	}
 
+	config, workload, r := readConfig()
+	r.logAndExitIfErrorsFound()
+
+	current.Store(config)
+	agentWorkloadConfig = workload
+	schema = r.settings
+
+	slog.Info("configReader: configs loaded")
+}
+
+// Reload reads the configuration again and applies the settings that can change while the service
+// runs, such as the log level. Other settings keep the values read at startup until the service is
+// restarted. The environment file, if any, is read again and its values take precedence. Nothing is
+// applied when the new configuration is invalid. The names of the settings that changed are returned.
+func Reload() ([]string, error) {
+	if envFilePath := os.Getenv("ENV_FILE_PATH"); envFilePath != "" {
+		if err := godotenv.Overload(envFilePath); err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", envFilePath, err)
+		}
+	}
+
+	next, _, r := readConfig()
+	if err := errors.Join(r.errors...); err != nil {
+		return nil, err
+	}
+
+	config := *GetConfig()
+	var changed []string
+	for _, setting := range reloadableSettings {
+		if setting.apply(&config, next) {
+			changed = append(changed, setting.name)
+		}
+	}
+	current.Store(&config)
+	return changed, nil
+}
+
+// readConfig reads the configuration from environment variables and validates it. Problems are
+// collected in the returned reader rather than reported.
+func readConfig() (*Config, *AgentWorkload, *configReader) {
+	config := &Config{}
+	agentWorkloadConfig := &AgentWorkload{}
+
 	r := &configReader{}
 	config.ServerHost = r.readOptionalString("SERVER_HOST", "")
 	config.ServerPort = int(r.readOptionalInt64("SERVER_PORT", 8080))
 	config.AuthHeader = r.readOptionalString("AUTH_HEADER", "Authorization")
 	config.AutoMaxProcsEnabled = r.readOptionalBool("AUTO_MAX_PROCS_ENABLED", true)
 	config.CORSAllowedOrigin = r.readOptionalString("CORS_ALLOWED_ORIGIN", "http://localhost:3000")
+	config.Regions = r.readOptionalStringList("REGIONS", "US")
+	config.PlatformAdminScope = r.readOptionalString("PLATFORM_ADMIN_SCOPE", "platform:admin")
 
 	agentWorkloadConfig.CORS = CORSConfig{
 		AllowOrigin:  r.readOptionalString("AGENT_WORKLOAD_CORS_ALLOWED_ORIGIN", "http://localhost:3000"),
@@ -67,15 +119,27 @@ func loadEnvs() {
 	}
 
 	// Logging configuration
-	config.LogLevel = r.readOptionalString("LOG_LEVEL", "INFO")
+	config.LogLevel = strings.ToUpper(r.readOptionalString("LOG_LEVEL", "INFO"))
+	config.BodyLogging = BodyLoggingConfig{
+		Enabled:  r.readOptionalBool("LOG_HTTP_BODIES", false),
+		MaxBytes: int(r.readOptionalInt64("LOG_HTTP_BODY_MAX_BYTES", 4096)),
+	}
 
 	// read database configs
-	config.POSTGRESQL = POSTGRESQL{
-		Host:     r.readRequiredString("DB_HOST"),
-		Port:     int(r.readOptionalInt64("DB_PORT", 5432)),
-		User:     r.readRequiredString("DB_USER"),
-		Password: r.readRequiredString("DB_PASSWORD"),
-		DBName:   r.readRequiredString("DB_NAME"),
+	config.DBDriver = r.readOptionalString("DB_DRIVER", DBDriverPostgres)
+	switch config.DBDriver {
+	case DBDriverPostgres:
+		config.POSTGRESQL = POSTGRESQL{
+			Host:     r.readRequiredString("DB_HOST"),
+			Port:     int(r.readOptionalInt64("DB_PORT", 5432)),
+			User:     r.readRequiredString("DB_USER"),
+			Password: r.readRequiredString("DB_PASSWORD"),
+			DBName:   r.readRequiredString("DB_NAME"),
+		}
+	case DBDriverSQLite:
+		config.SQLitePath = r.readOptionalString("SQLITE_PATH", "agent-manager.db")
+	default:
+		r.errors = append(r.errors, fmt.Errorf("DB_DRIVER must be one of %s or %s, got %q", DBDriverPostgres, DBDriverSQLite, config.DBDriver))
 	}
 	config.POSTGRESQL.DbConfigs = DbConfigs{
 		// gorm configs
@@ -87,6 +151,10 @@ func loadEnvs() {
 		MaxOpenCount:       r.readNullableInt64("DB_MAX_OPEN_COUNT"),
 		MaxIdleTimeSeconds: r.readNullableInt64("DB_MAX_IDLE_TIME_SECONDS"),
 		MaxLifetimeSeconds: r.readNullableInt64("DB_MAX_LIFETIME_SECONDS"),
+
+		ReadReplicaDSNs:     r.readOptionalStringList("DB_READ_REPLICA_DSNS", ""),
+		QueryTimeoutSeconds: r.readOptionalInt64("DB_QUERY_TIMEOUT_SECONDS", 0),
+		WriteTimeoutSeconds: r.readOptionalInt64("DB_WRITE_TIMEOUT_SECONDS", 0),
 	}
 	config.KubeConfig = r.readOptionalString("KUBECONFIG", "")
 
@@ -96,9 +164,15 @@ func loadEnvs() {
 	config.IdleTimeoutSeconds = int(r.readOptionalInt64("HTTP_IDLE_TIMEOUT_SECONDS", 60))
 	config.MaxHeaderBytes = int(r.readOptionalInt64("HTTP_MAX_HEADER_BYTES", 65536)) // 1024 * 64
 
+	// Graceful shutdown configurations
+	// The drain delay gives load balancers time to observe the failing readiness check
+	config.ShutdownDrainDelaySeconds = int(r.readOptionalInt64("SHUTDOWN_DRAIN_DELAY_SECONDS", 5))
+	config.ShutdownTimeoutSeconds = int(r.readOptionalInt64("SHUTDOWN_TIMEOUT_SECONDS", 60))
+
 	// Database operation timeout configuration
 	config.DbOperationTimeoutSeconds = int(r.readOptionalInt64("DB_OPERATION_TIMEOUT_SECONDS", 10))
 	config.HealthCheckTimeoutSeconds = int(r.readOptionalInt64("HEALTH_CHECK_TIMEOUT_SECONDS", 5))
+	config.DBMigrationStartupMode = r.readOptionalString("DB_MIGRATION_STARTUP_MODE", DBMigrationStartupModeCheck)
 
 	config.DefaultChatAPI = DefaultChatAPIConfig{
 		DefaultHTTPPort: int32(r.readOptionalInt64("DEFAULT_CHAT_API_HTTP_PORT", 8000)),
@@ -127,7 +201,12 @@ func loadEnvs() {
 
 	// Trace Observer service configuration - for distributed tracing
 	config.TraceObserver = TraceObserverConfig{
-		URL: r.readOptionalString("TRACE_OBSERVER_URL", "http://localhost:9098"),
+		URL:                               r.readOptionalString("TRACE_OBSERVER_URL", "http://localhost:9098"),
+		RetentionEnforceIntervalSeconds:   int(r.readOptionalInt64("TRACE_RETENTION_ENFORCE_INTERVAL_SECONDS", 3600)),
+		GoldenTraceIntervalSeconds:        int(r.readOptionalInt64("GOLDEN_TRACE_INTERVAL_SECONDS", 60)),
+		TokenBudgetIntervalSeconds:        int(r.readOptionalInt64("TOKEN_BUDGET_INTERVAL_SECONDS", 300)),
+		UsageReportIntervalSeconds:        int(r.readOptionalInt64("USAGE_REPORT_INTERVAL_SECONDS", 3600)),
+		NotificationDigestIntervalSeconds: int(r.readOptionalInt64("NOTIFICATION_DIGEST_INTERVAL_SECONDS", 3600)),
 	}
 
 	config.IsLocalDevEnv = r.readOptionalBool("IS_LOCAL_DEV_ENV", false)
@@ -138,6 +217,11 @@ func loadEnvs() {
 		Audience:      r.readOptionalStringList("KEY_MANAGER_AUDIENCE", "localhost"),
 		JWKSUrl:       r.readOptionalString("KEY_MANAGER_JWKS_URL", ""),
 		DefaultIssuer: r.readOptionalString("KEY_MANAGER_DEFAULT_ISSUER", "Agent Management Platform Local"),
+		// JSON list of {"issuer", "jwksUrl", "audiences"} objects
+		TrustedIssuers:                r.readTrustedIssuers("KEY_MANAGER_TRUSTED_ISSUERS"),
+		ClockSkewSeconds:              r.readOptionalInt64("KEY_MANAGER_CLOCK_SKEW_SECONDS", 60),
+		JWKSCacheTTLSeconds:           r.readOptionalInt64("KEY_MANAGER_JWKS_CACHE_TTL_SECONDS", 3600),
+		JWKSMinRefreshIntervalSeconds: r.readOptionalInt64("KEY_MANAGER_JWKS_MIN_REFRESH_SECONDS", 30),
 	}
 	config.IsOnPremDeployment = r.readOptionalBool("IS_ON_PREM_DEPLOYMENT", true)
 
@@ -160,7 +244,8 @@ func loadEnvs() {
 
 	// GitHub configuration for repository API access
 	config.GitHub = GitHubConfig{
-		Token: r.readOptionalString("GITHUB_TOKEN", ""),
+		Token:         r.readOptionalString("GITHUB_TOKEN", ""),
+		TemplateOwner: r.readOptionalString("AGENT_TEMPLATES_GITHUB_OWNER", "wso2"),
 	}
 	config.OpenChoreo = OpenChoreoConfig{
 		BaseURL: r.readRequiredString("OPEN_CHOREO_BASE_URL"),
@@ -168,16 +253,164 @@ func loadEnvs() {
 
 	// API Platform configuration
 	config.APIPlatform = APIPlatformConfig{
-		BaseURL: r.readOptionalString("API_PLATFORM_BASE_URL", ""),
-		Enable:  r.readOptionalBool("API_PLATFORM_ENABLED", false),
+		BaseURL:         r.readOptionalString("API_PLATFORM_BASE_URL", ""),
+		Enable:          r.readOptionalBool("API_PLATFORM_ENABLED", false),
+		InMemory:        r.readOptionalBool("API_PLATFORM_IN_MEMORY", false),
+		CacheTTLSeconds: r.readOptionalInt64("API_PLATFORM_CACHE_TTL_SECONDS", 30),
+	}
+
+	// Internal gRPC server configuration
+	config.GRPC = GRPCConfig{
+		Enabled:     r.readOptionalBool("GRPC_ENABLED", false),
+		Port:        int(r.readOptionalInt64("GRPC_PORT", 9090)),
+		TLSCertFile: r.readOptionalString("GRPC_TLS_CERT_FILE", ""),
+		TLSKeyFile:  r.readOptionalString("GRPC_TLS_KEY_FILE", ""),
+	}
+
+	config.Tracing = TracingConfig{
+		Enabled:       r.readOptionalBool("TRACING_ENABLED", false),
+		ServiceName:   r.readOptionalString("TRACING_SERVICE_NAME", "agent-manager-service"),
+		OTLPEndpoint:  r.readOptionalString("TRACING_OTLP_ENDPOINT", "localhost:4317"),
+		Insecure:      r.readOptionalBool("TRACING_OTLP_INSECURE", true),
+		SamplingRatio: r.readOptionalFloat64("TRACING_SAMPLING_RATIO", 1.0),
+	}
+
+	config.CredentialsEncryptionKey = r.readOptionalString("CREDENTIALS_ENCRYPTION_KEY", "")
+	config.MCP = MCPConfig{
+		ToolRefreshIntervalSeconds: int(r.readOptionalInt64("MCP_TOOL_REFRESH_INTERVAL_SECONDS", 300)),
+	}
+	config.TraceJudge = TraceJudgeConfig{
+		URL:             r.readOptionalString("TRACE_JUDGE_LLM_URL", ""),
+		APIKey:          r.readOptionalString("TRACE_JUDGE_LLM_API_KEY", ""),
+		Model:           r.readOptionalString("TRACE_JUDGE_LLM_MODEL", "gpt-4o-mini"),
+		IntervalSeconds: int(r.readOptionalInt64("TRACE_JUDGE_INTERVAL_SECONDS", 300)),
+		MaxTracesPerRun: int(r.readOptionalInt64("TRACE_JUDGE_MAX_TRACES_PER_RUN", 50)),
+	}
+	config.DeploymentApproval = DeploymentApprovalConfig{
+		WebhookURL: r.readOptionalString("DEPLOYMENT_APPROVAL_WEBHOOK_URL", ""),
+		AdminScope: r.readOptionalString("DEPLOYMENT_APPROVAL_ADMIN_SCOPE", "deployment-approval:admin"),
+	}
+	config.ChangeFreeze = ChangeFreezeConfig{
+		AdminScope: r.readOptionalString("CHANGE_FREEZE_ADMIN_SCOPE", "change-freeze:admin"),
+	}
+	config.TrialSandbox = TrialSandboxConfig{
+		GatewayID:              r.readOptionalString("TRIAL_SANDBOX_GATEWAY_ID", ""),
+		LLMProviderID:          r.readOptionalString("TRIAL_SANDBOX_LLM_PROVIDER_ID", ""),
+		RequestsPerMinute:      int(r.readOptionalInt64("TRIAL_SANDBOX_REQUESTS_PER_MINUTE", 20)),
+		TokensPerDay:           int(r.readOptionalInt64("TRIAL_SANDBOX_TOKENS_PER_DAY", 100000)),
+		DurationDays:           int(r.readOptionalInt64("TRIAL_SANDBOX_DURATION_DAYS", 14)),
+		AutoProvision:          r.readOptionalBool("TRIAL_SANDBOX_AUTO_PROVISION", false),
+		CleanupIntervalSeconds: int(r.readOptionalInt64("TRIAL_SANDBOX_CLEANUP_INTERVAL_SECONDS", 3600)),
+	}
+	config.Email = EmailConfig{
+		SMTPHost:                        r.readOptionalString("EMAIL_SMTP_HOST", ""),
+		SMTPPort:                        int(r.readOptionalInt64("EMAIL_SMTP_PORT", 587)),
+		SMTPUsername:                    r.readOptionalString("EMAIL_SMTP_USERNAME", ""),
+		SMTPPassword:                    r.readOptionalString("EMAIL_SMTP_PASSWORD", ""),
+		ImplicitTLS:                     r.readOptionalBool("EMAIL_SMTP_IMPLICIT_TLS", false),
+		From:                            r.readOptionalString("EMAIL_FROM", ""),
+		TokenExpiryWarningDays:          int(r.readOptionalInt64("EMAIL_TOKEN_EXPIRY_WARNING_DAYS", 14)),
+		TokenExpiryCheckIntervalSeconds: int(r.readOptionalInt64("EMAIL_TOKEN_EXPIRY_CHECK_INTERVAL_SECONDS", 3600)),
 	}
 
 	// Validate HTTP server configurations
 	validateHTTPServerConfigs(config, r)
+	validateGRPCConfigs(config, r)
+	validateTracingConfigs(config, r)
+	validateLoggingConfigs(config, r)
+	validateRegionConfigs(config, r)
+	validatePlatformAdminConfigs(config, r)
+	validateDBConfigs(config, r)
+	validateDBMigrationConfigs(config, r)
+	validateKeyManagerConfigs(config, r)
+	validateCredentialsEncryptionKey(config, r)
+	validateMCPConfigs(config, r)
+	validateTraceObserverConfigs(config, r)
+	validateTraceJudgeConfigs(config, r)
+	validateDeploymentApprovalConfigs(config, r)
+	validateChangeFreezeConfigs(config, r)
+	validateTrialSandboxConfigs(config, r)
+	validateEmailConfigs(config, r)
+	validateAPIPlatformConfigs(config, r)
+
+	return config, agentWorkloadConfig, r
+}
 
-	r.logAndExitIfErrorsFound()
+func validateCredentialsEncryptionKey(cfg *Config, r *configReader) {
+	if cfg.CredentialsEncryptionKey == "" {
+		return
+	}
+	key, err := base64.StdEncoding.DecodeString(cfg.CredentialsEncryptionKey)
+	if err != nil || len(key) != 32 {
+		r.errors = append(r.errors, fmt.Errorf("CREDENTIALS_ENCRYPTION_KEY must be a base64 encoded 32 byte key"))
+	}
+}
 
-	slog.Info("configReader: configs loaded")
+func validateMCPConfigs(cfg *Config, r *configReader) {
+	if cfg.MCP.ToolRefreshIntervalSeconds < 0 {
+		r.errors = append(r.errors, fmt.Errorf("MCP_TOOL_REFRESH_INTERVAL_SECONDS must not be negative, got %d", cfg.MCP.ToolRefreshIntervalSeconds))
+	}
+}
+
+func validateTraceObserverConfigs(cfg *Config, r *configReader) {
+	if cfg.TraceObserver.RetentionEnforceIntervalSeconds < 0 {
+		r.errors = append(r.errors, fmt.Errorf("TRACE_RETENTION_ENFORCE_INTERVAL_SECONDS must not be negative, got %d", cfg.TraceObserver.RetentionEnforceIntervalSeconds))
+	}
+	if cfg.TraceObserver.GoldenTraceIntervalSeconds < 0 {
+		r.errors = append(r.errors, fmt.Errorf("GOLDEN_TRACE_INTERVAL_SECONDS must not be negative, got %d", cfg.TraceObserver.GoldenTraceIntervalSeconds))
+	}
+	if cfg.TraceObserver.TokenBudgetIntervalSeconds < 0 {
+		r.errors = append(r.errors, fmt.Errorf("TOKEN_BUDGET_INTERVAL_SECONDS must not be negative, got %d", cfg.TraceObserver.TokenBudgetIntervalSeconds))
+	}
+	if cfg.TraceObserver.UsageReportIntervalSeconds < 0 {
+		r.errors = append(r.errors, fmt.Errorf("USAGE_REPORT_INTERVAL_SECONDS must not be negative, got %d", cfg.TraceObserver.UsageReportIntervalSeconds))
+	}
+	if cfg.TraceObserver.NotificationDigestIntervalSeconds < 0 {
+		r.errors = append(r.errors, fmt.Errorf("NOTIFICATION_DIGEST_INTERVAL_SECONDS must not be negative, got %d", cfg.TraceObserver.NotificationDigestIntervalSeconds))
+	}
+}
+
+func validateTraceJudgeConfigs(cfg *Config, r *configReader) {
+	if cfg.TraceJudge.IntervalSeconds < 0 {
+		r.errors = append(r.errors, fmt.Errorf("TRACE_JUDGE_INTERVAL_SECONDS must not be negative, got %d", cfg.TraceJudge.IntervalSeconds))
+	}
+	if cfg.TraceJudge.MaxTracesPerRun < 1 || cfg.TraceJudge.MaxTracesPerRun > 1000 {
+		r.errors = append(r.errors, fmt.Errorf("TRACE_JUDGE_MAX_TRACES_PER_RUN must be between 1 and 1000, got %d", cfg.TraceJudge.MaxTracesPerRun))
+	}
+	if cfg.TraceJudge.URL != "" && cfg.TraceJudge.Model == "" {
+		r.errors = append(r.errors, fmt.Errorf("TRACE_JUDGE_LLM_MODEL is required when TRACE_JUDGE_LLM_URL is set"))
+	}
+}
+
+func validatePlatformAdminConfigs(cfg *Config, r *configReader) {
+	if strings.TrimSpace(cfg.PlatformAdminScope) == "" {
+		r.errors = append(r.errors, fmt.Errorf("PLATFORM_ADMIN_SCOPE must not be empty"))
+	}
+}
+
+func validateChangeFreezeConfigs(cfg *Config, r *configReader) {
+	if strings.TrimSpace(cfg.ChangeFreeze.AdminScope) == "" {
+		r.errors = append(r.errors, fmt.Errorf("CHANGE_FREEZE_ADMIN_SCOPE must not be empty"))
+	}
+}
+
+func validateDeploymentApprovalConfigs(cfg *Config, r *configReader) {
+	if strings.TrimSpace(cfg.DeploymentApproval.AdminScope) == "" {
+		r.errors = append(r.errors, fmt.Errorf("DEPLOYMENT_APPROVAL_ADMIN_SCOPE must not be empty"))
+	}
+	if cfg.DeploymentApproval.WebhookURL == "" {
+		return
+	}
+	u, err := url.Parse(cfg.DeploymentApproval.WebhookURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		r.errors = append(r.errors, fmt.Errorf("DEPLOYMENT_APPROVAL_WEBHOOK_URL must be an http or https URL"))
+	}
+}
+
+func validateAPIPlatformConfigs(cfg *Config, r *configReader) {
+	if cfg.APIPlatform.CacheTTLSeconds < 0 {
+		r.errors = append(r.errors, fmt.Errorf("API_PLATFORM_CACHE_TTL_SECONDS must not be negative, got %d", cfg.APIPlatform.CacheTTLSeconds))
+	}
 }
 
 func validateHTTPServerConfigs(cfg *Config, r *configReader) {
@@ -197,7 +430,148 @@ func validateHTTPServerConfigs(cfg *Config, r *configReader) {
 	if cfg.IdleTimeoutSeconds <= 0 {
 		r.errors = append(r.errors, fmt.Errorf("HTTP_IDLE_TIMEOUT_SECONDS must be greater than 0, got %d", cfg.IdleTimeoutSeconds))
 	}
+	if cfg.ShutdownDrainDelaySeconds < 0 {
+		r.errors = append(r.errors, fmt.Errorf("SHUTDOWN_DRAIN_DELAY_SECONDS must not be negative, got %d", cfg.ShutdownDrainDelaySeconds))
+	}
+	if cfg.ShutdownTimeoutSeconds <= 0 {
+		r.errors = append(r.errors, fmt.Errorf("SHUTDOWN_TIMEOUT_SECONDS must be greater than 0, got %d", cfg.ShutdownTimeoutSeconds))
+	}
 	if cfg.MaxHeaderBytes < 1024 || cfg.MaxHeaderBytes > 1048576 { // 1KB to 1MB
 		r.errors = append(r.errors, fmt.Errorf("HTTP_MAX_HEADER_BYTES must be between 1024 and 1048576, got %d", cfg.MaxHeaderBytes))
 	}
 }
+
+func validateDBConfigs(cfg *Config, r *configReader) {
+	dbCfg := cfg.POSTGRESQL.DbConfigs
+	if cfg.DBDriver == DBDriverSQLite && len(dbCfg.ReadReplicaDSNs) > 0 {
+		r.errors = append(r.errors, fmt.Errorf("DB_READ_REPLICA_DSNS is not supported with DB_DRIVER=%s", DBDriverSQLite))
+	}
+	if dbCfg.QueryTimeoutSeconds < 0 {
+		r.errors = append(r.errors, fmt.Errorf("DB_QUERY_TIMEOUT_SECONDS must not be negative, got %d", dbCfg.QueryTimeoutSeconds))
+	}
+	if dbCfg.WriteTimeoutSeconds < 0 {
+		r.errors = append(r.errors, fmt.Errorf("DB_WRITE_TIMEOUT_SECONDS must not be negative, got %d", dbCfg.WriteTimeoutSeconds))
+	}
+}
+
+func validateDBMigrationConfigs(cfg *Config, r *configReader) {
+	switch cfg.DBMigrationStartupMode {
+	case DBMigrationStartupModeAuto, DBMigrationStartupModeCheck, DBMigrationStartupModeOff:
+	default:
+		r.errors = append(r.errors, fmt.Errorf("DB_MIGRATION_STARTUP_MODE must be one of auto, check or off, got %q", cfg.DBMigrationStartupMode))
+	}
+}
+
+func validateKeyManagerConfigs(cfg *Config, r *configReader) {
+	km := cfg.KeyManagerConfigurations
+	for i, issuer := range km.TrustedIssuers {
+		if issuer.Issuer == "" || issuer.JWKSUrl == "" {
+			r.errors = append(r.errors, fmt.Errorf("KEY_MANAGER_TRUSTED_ISSUERS[%d] requires both issuer and jwksUrl", i))
+		}
+	}
+	if km.ClockSkewSeconds < 0 {
+		r.errors = append(r.errors, fmt.Errorf("KEY_MANAGER_CLOCK_SKEW_SECONDS must not be negative, got %d", km.ClockSkewSeconds))
+	}
+	if km.JWKSCacheTTLSeconds <= 0 {
+		r.errors = append(r.errors, fmt.Errorf("KEY_MANAGER_JWKS_CACHE_TTL_SECONDS must be positive, got %d", km.JWKSCacheTTLSeconds))
+	}
+	if km.JWKSMinRefreshIntervalSeconds < 0 {
+		r.errors = append(r.errors, fmt.Errorf("KEY_MANAGER_JWKS_MIN_REFRESH_SECONDS must not be negative, got %d", km.JWKSMinRefreshIntervalSeconds))
+	}
+}
+
+func validateTracingConfigs(cfg *Config, r *configReader) {
+	if !cfg.Tracing.Enabled {
+		return
+	}
+	if cfg.Tracing.OTLPEndpoint == "" {
+		r.errors = append(r.errors, fmt.Errorf("TRACING_OTLP_ENDPOINT is required when TRACING_ENABLED is true"))
+	}
+	if cfg.Tracing.SamplingRatio < 0 || cfg.Tracing.SamplingRatio > 1 {
+		r.errors = append(r.errors, fmt.Errorf("TRACING_SAMPLING_RATIO must be between 0 and 1, got %v", cfg.Tracing.SamplingRatio))
+	}
+}
+
+func validateLoggingConfigs(cfg *Config, r *configReader) {
+	switch cfg.LogLevel {
+	case "DEBUG", "INFO", "WARN", "ERROR":
+	default:
+		r.errors = append(r.errors, fmt.Errorf("LOG_LEVEL must be one of DEBUG, INFO, WARN or ERROR, got %q", cfg.LogLevel))
+	}
+	if cfg.BodyLogging.Enabled && cfg.BodyLogging.MaxBytes <= 0 {
+		r.errors = append(r.errors, fmt.Errorf("LOG_HTTP_BODY_MAX_BYTES must be positive, got %d", cfg.BodyLogging.MaxBytes))
+	}
+}
+
+func validateRegionConfigs(cfg *Config, r *configReader) {
+	if len(cfg.Regions) == 0 {
+		r.errors = append(r.errors, fmt.Errorf("REGIONS must list at least one region"))
+	}
+	seen := make(map[string]bool, len(cfg.Regions))
+	for _, region := range cfg.Regions {
+		if len(region) > 64 {
+			r.errors = append(r.errors, fmt.Errorf("REGIONS entry %q is longer than 64 characters", region))
+		}
+		if seen[region] {
+			r.errors = append(r.errors, fmt.Errorf("REGIONS lists %q more than once", region))
+		}
+		seen[region] = true
+	}
+}
+
+func validateGRPCConfigs(cfg *Config, r *configReader) {
+	if !cfg.GRPC.Enabled {
+		return
+	}
+	if cfg.GRPC.Port < 1 || cfg.GRPC.Port > 65535 {
+		r.errors = append(r.errors, fmt.Errorf("GRPC_PORT must be between 1 and 65535, got %d", cfg.GRPC.Port))
+	}
+	if cfg.GRPC.Port == cfg.ServerPort {
+		r.errors = append(r.errors, fmt.Errorf("GRPC_PORT must differ from SERVER_PORT (%d)", cfg.ServerPort))
+	}
+	if (cfg.GRPC.TLSCertFile == "") != (cfg.GRPC.TLSKeyFile == "") {
+		r.errors = append(r.errors, fmt.Errorf("GRPC_TLS_CERT_FILE and GRPC_TLS_KEY_FILE must be set together"))
+	}
+}
+
+func validateTrialSandboxConfigs(cfg *Config, r *configReader) {
+	if cfg.TrialSandbox.GatewayID == "" {
+		if cfg.TrialSandbox.AutoProvision {
+			r.errors = append(r.errors, fmt.Errorf("TRIAL_SANDBOX_AUTO_PROVISION requires TRIAL_SANDBOX_GATEWAY_ID"))
+		}
+		return
+	}
+	if _, err := uuid.Parse(cfg.TrialSandbox.GatewayID); err != nil {
+		r.errors = append(r.errors, fmt.Errorf("TRIAL_SANDBOX_GATEWAY_ID must be a gateway UUID"))
+	}
+	if cfg.TrialSandbox.RequestsPerMinute <= 0 {
+		r.errors = append(r.errors, fmt.Errorf("TRIAL_SANDBOX_REQUESTS_PER_MINUTE must be greater than 0, got %d", cfg.TrialSandbox.RequestsPerMinute))
+	}
+	if cfg.TrialSandbox.TokensPerDay <= 0 {
+		r.errors = append(r.errors, fmt.Errorf("TRIAL_SANDBOX_TOKENS_PER_DAY must be greater than 0, got %d", cfg.TrialSandbox.TokensPerDay))
+	}
+	if cfg.TrialSandbox.DurationDays <= 0 {
+		r.errors = append(r.errors, fmt.Errorf("TRIAL_SANDBOX_DURATION_DAYS must be greater than 0, got %d", cfg.TrialSandbox.DurationDays))
+	}
+	if cfg.TrialSandbox.CleanupIntervalSeconds < 0 {
+		r.errors = append(r.errors, fmt.Errorf("TRIAL_SANDBOX_CLEANUP_INTERVAL_SECONDS must not be negative, got %d", cfg.TrialSandbox.CleanupIntervalSeconds))
+	}
+}
+
+func validateEmailConfigs(cfg *Config, r *configReader) {
+	if cfg.Email.SMTPHost == "" {
+		return
+	}
+	if cfg.Email.SMTPPort < 1 || cfg.Email.SMTPPort > 65535 {
+		r.errors = append(r.errors, fmt.Errorf("EMAIL_SMTP_PORT must be between 1 and 65535, got %d", cfg.Email.SMTPPort))
+	}
+	if _, err := mail.ParseAddress(cfg.Email.From); err != nil {
+		r.errors = append(r.errors, fmt.Errorf("EMAIL_FROM must be an email address when EMAIL_SMTP_HOST is set"))
+	}
+	if cfg.Email.TokenExpiryWarningDays <= 0 {
+		r.errors = append(r.errors, fmt.Errorf("EMAIL_TOKEN_EXPIRY_WARNING_DAYS must be greater than 0, got %d", cfg.Email.TokenExpiryWarningDays))
+	}
+	if cfg.Email.TokenExpiryCheckIntervalSeconds < 0 {
+		r.errors = append(r.errors, fmt.Errorf("EMAIL_TOKEN_EXPIRY_CHECK_INTERVAL_SECONDS must not be negative, got %d", cfg.Email.TokenExpiryCheckIntervalSeconds))
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReload(t *testing.T) {
+	original := GetConfig()
+	t.Cleanup(func() { current.Store(original) })
+
+	t.Run("Reloadable settings are applied and others are kept", func(t *testing.T) {
+		t.Setenv("LOG_LEVEL", "debug")
+		t.Setenv("TRACE_JUDGE_MAX_TRACES_PER_RUN", "7")
+		t.Setenv("SERVER_PORT", "9999")
+
+		changed, err := Reload()
+		require.NoError(t, err)
+
+		assert.Contains(t, changed, "LOG_LEVEL")
+		assert.Contains(t, changed, "TRACE_JUDGE_MAX_TRACES_PER_RUN")
+		assert.Equal(t, "DEBUG", GetConfig().LogLevel)
+		assert.Equal(t, 7, GetConfig().TraceJudge.MaxTracesPerRun)
+		assert.Equal(t, original.ServerPort, GetConfig().ServerPort)
+		assert.Equal(t, "INFO", original.LogLevel, "the configuration read before must not change")
+	})
+
+	t.Run("Invalid configuration is not applied", func(t *testing.T) {
+		before := GetConfig()
+		t.Setenv("LOG_LEVEL", "verbose")
+		t.Setenv("LOG_HTTP_BODIES", "yes")
+
+		_, err := Reload()
+		require.Error(t, err)
+
+		assert.ErrorContains(t, err, "LOG_LEVEL must be one of DEBUG, INFO, WARN or ERROR")
+		assert.ErrorContains(t, err, "LOG_HTTP_BODIES is not a valid boolean")
+		assert.Same(t, before, GetConfig())
+	})
+}
+
+func TestSchema(t *testing.T) {
+	settings := make(map[string]Setting)
+	for _, setting := range Schema() {
+		settings[setting.Name] = setting
+	}
+
+	assert.Equal(t, Setting{Name: "SERVER_PORT", Type: settingTypeInteger, Default: "8080"}, settings["SERVER_PORT"])
+	assert.Equal(t, Setting{Name: "LOG_LEVEL", Type: settingTypeString, Default: "INFO", Reloadable: true}, settings["LOG_LEVEL"])
+}
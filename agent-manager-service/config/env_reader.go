@@ -17,6 +17,7 @@
 package config
 
 import (
+	"encoding/json"
 	"fmt"
 	"log/slog"
 	"os"
@@ -26,6 +27,19 @@ import (
 
 type configReader struct {
 	errors []error
+	// settings describes each environment variable read, in the order read
+	settings []Setting
+}
+
+// describe records an environment variable in the settings schema
+func (c *configReader) describe(envVarName, valueType, defaultValue string, required bool) {
+	c.settings = append(c.settings, Setting{
+		Name:       envVarName,
+		Type:       valueType,
+		Default:    defaultValue,
+		Required:   required,
+		Reloadable: isReloadable(envVarName),
+	})
 }
 
 func (c *configReader) logAndExitIfErrorsFound() {
@@ -40,6 +54,7 @@ func (c *configReader) logAndExitIfErrorsFound() {
 }
 
 func (c *configReader) readRequiredString(envVarName string) string {
+	c.describe(envVarName, settingTypeString, "", true)
 	v := os.Getenv(envVarName)
 	if v == "" {
 		c.errors = append(c.errors, fmt.Errorf("required environment variable %s not found", envVarName))
@@ -48,6 +63,7 @@ func (c *configReader) readRequiredString(envVarName string) string {
 }
 
 func (c *configReader) readOptionalString(envVarName string, defaultValue string) string {
+	c.describe(envVarName, settingTypeString, defaultValue, false)
 	v := os.Getenv(envVarName)
 	if v == "" {
 		return defaultValue
@@ -57,6 +73,7 @@ func (c *configReader) readOptionalString(envVarName string, defaultValue string
 
 // readOptionalStringList reads a comma-separated string from environment and returns a slice
 func (c *configReader) readOptionalStringList(key string, defaultValue string) []string {
+	c.describe(key, settingTypeStringList, defaultValue, false)
 	value := os.Getenv(key)
 	if value == "" {
 		value = defaultValue
@@ -79,6 +96,7 @@ func (c *configReader) readOptionalStringList(key string, defaultValue string) [
 }
 
 func (c *configReader) readOptionalInt64(envVarName string, defaultValue int64) int64 {
+	c.describe(envVarName, settingTypeInteger, strconv.FormatInt(defaultValue, 10), false)
 	v := os.Getenv(envVarName)
 	if v == "" {
 		return defaultValue
@@ -92,6 +110,7 @@ func (c *configReader) readOptionalInt64(envVarName string, defaultValue int64)
 }
 
 func (c *configReader) readNullableInt64(envVarName string) *int64 {
+	c.describe(envVarName, settingTypeInteger, "", false)
 	v := os.Getenv(envVarName)
 	if v == "" {
 		return nil
@@ -105,13 +124,44 @@ func (c *configReader) readNullableInt64(envVarName string) *int64 {
 }
 
 func (c *configReader) readOptionalBool(envVarName string, defaultValue bool) bool {
+	c.describe(envVarName, settingTypeBoolean, strconv.FormatBool(defaultValue), false)
 	v := os.Getenv(envVarName)
 	if v == "" {
 		return defaultValue
 	}
 	value, err := strconv.ParseBool(v)
 	if err != nil {
+		c.errors = append(c.errors, fmt.Errorf("optional environment variable %s is not a valid boolean, use true or false [%w]", envVarName, err))
+		return defaultValue
+	}
+	return value
+}
+
+func (c *configReader) readOptionalFloat64(envVarName string, defaultValue float64) float64 {
+	c.describe(envVarName, settingTypeNumber, strconv.FormatFloat(defaultValue, 'f', -1, 64), false)
+	v := os.Getenv(envVarName)
+	if v == "" {
 		return defaultValue
 	}
+	value, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		c.errors = append(c.errors, fmt.Errorf("optional environment variable %s is not a valid number [%w]", envVarName, err))
+		return 0
+	}
 	return value
 }
+
+// readTrustedIssuers reads a JSON encoded list of trusted token issuers
+func (c *configReader) readTrustedIssuers(envVarName string) []TrustedIssuer {
+	c.describe(envVarName, settingTypeJSON, "", false)
+	v := os.Getenv(envVarName)
+	if v == "" {
+		return nil
+	}
+	var issuers []TrustedIssuer
+	if err := json.Unmarshal([]byte(v), &issuers); err != nil {
+		c.errors = append(c.errors, fmt.Errorf("environment variable %s is not a valid JSON list of issuers [%w]", envVarName, err))
+		return nil
+	}
+	return issuers
+}
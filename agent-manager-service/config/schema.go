@@ -0,0 +1,84 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package config
+
+// Types of the values of settings
+const (
+	settingTypeString     = "string"
+	settingTypeStringList = "string list"
+	settingTypeInteger    = "integer"
+	settingTypeNumber     = "number"
+	settingTypeBoolean    = "boolean"
+	settingTypeJSON       = "json"
+)
+
+// Setting describes an environment variable the service is configured with
+type Setting struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Default  string `json:"default,omitempty"`
+	Required bool   `json:"required,omitempty"`
+	// Reloadable settings are applied by Reload without restarting the service
+	Reloadable bool `json:"reloadable,omitempty"`
+}
+
+// Schema returns the settings of the service in the order they are read. Settings that only apply
+// to an enabled feature or backend are only listed when it is configured.
+func Schema() []Setting {
+	return schema
+}
+
+// reloadableSettings are the settings Reload applies, each copying its value from a newly read
+// configuration and reporting whether it changed. Settings that are only read when the service
+// starts, such as ports, database connections and background job intervals, are not listed.
+var reloadableSettings = []struct {
+	name  string
+	apply func(dst, src *Config) bool
+}{
+	{"LOG_LEVEL", func(dst, src *Config) bool { return update(&dst.LogLevel, src.LogLevel) }},
+	{"LOG_HTTP_BODIES", func(dst, src *Config) bool { return update(&dst.BodyLogging.Enabled, src.BodyLogging.Enabled) }},
+	{"LOG_HTTP_BODY_MAX_BYTES", func(dst, src *Config) bool { return update(&dst.BodyLogging.MaxBytes, src.BodyLogging.MaxBytes) }},
+	{"DB_OPERATION_TIMEOUT_SECONDS", func(dst, src *Config) bool {
+		return update(&dst.DbOperationTimeoutSeconds, src.DbOperationTimeoutSeconds)
+	}},
+	{"HEALTH_CHECK_TIMEOUT_SECONDS", func(dst, src *Config) bool {
+		return update(&dst.HealthCheckTimeoutSeconds, src.HealthCheckTimeoutSeconds)
+	}},
+	{"TRACE_JUDGE_LLM_API_KEY", func(dst, src *Config) bool { return update(&dst.TraceJudge.APIKey, src.TraceJudge.APIKey) }},
+	{"TRACE_JUDGE_LLM_MODEL", func(dst, src *Config) bool { return update(&dst.TraceJudge.Model, src.TraceJudge.Model) }},
+	{"TRACE_JUDGE_MAX_TRACES_PER_RUN", func(dst, src *Config) bool {
+		return update(&dst.TraceJudge.MaxTracesPerRun, src.TraceJudge.MaxTracesPerRun)
+	}},
+}
+
+func isReloadable(envVarName string) bool {
+	for _, setting := range reloadableSettings {
+		if setting.name == envVarName {
+			return true
+		}
+	}
+	return false
+}
+
+// update sets dst to value and reports whether that changed it
+func update[T comparable](dst *T, value T) bool {
+	if *dst == value {
+		return false
+	}
+	*dst = value
+	return true
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// agentCardMaxAge is how long clients may cache a well-known agent card, short enough for cards to
+// follow new deployments
+const agentCardMaxAge = "max-age=60"
+
+// AgentCardController defines the interface for A2A agent card HTTP handlers
+type AgentCardController interface {
+	GetAgentCard(w http.ResponseWriter, r *http.Request)
+	GetWellKnownAgentCard(w http.ResponseWriter, r *http.Request)
+}
+
+type agentCardController struct {
+	agentCardService services.AgentCardService
+}
+
+// NewAgentCardController creates a new agent card controller
+func NewAgentCardController(agentCardService services.AgentCardService) AgentCardController {
+	return &agentCardController{
+		agentCardService: agentCardService,
+	}
+}
+
+func handleAgentCardErrors(w http.ResponseWriter, err error, fallbackMsg string) {
+	// An agent card is addressed by its agent, so a missing organization or project is a missing agent
+	if errors.Is(err, utils.ErrOrganizationNotFound) || errors.Is(err, utils.ErrProjectNotFound) {
+		utils.WriteError(w, utils.ErrAgentNotFound, fallbackMsg)
+		return
+	}
+	utils.WriteError(w, err, fallbackMsg)
+}
+
+func (c *agentCardController) GetAgentCard(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	environment := r.URL.Query().Get("environment")
+
+	card, err := c.agentCardService.GetAgentCard(ctx, orgName, projName, agentName, environment)
+	if err != nil {
+		log.Error("GetAgentCard: failed to generate agent card", "agentName", agentName, "environment", environment, "error", err)
+		handleAgentCardErrors(w, err, "Failed to generate agent card")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, card)
+}
+
+func (c *agentCardController) GetWellKnownAgentCard(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	environment := r.URL.Query().Get("environment")
+
+	card, err := c.agentCardService.GetPublicAgentCard(ctx, orgName, projName, agentName, environment)
+	if err != nil {
+		log.Error("GetWellKnownAgentCard: failed to generate agent card", "agentName", agentName, "environment", environment, "error", err)
+		handleAgentCardErrors(w, err, "Failed to generate agent card")
+		return
+	}
+
+	w.Header().Set("Cache-Control", agentCardMaxAge)
+	utils.WriteSuccessResponse(w, http.StatusOK, card)
+}
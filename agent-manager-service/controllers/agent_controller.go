@@ -18,7 +18,6 @@ package controllers
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -41,6 +40,9 @@ type AgentController interface {
 	DeployAgent(w http.ResponseWriter, r *http.Request)
 	ListAgentBuilds(w http.ResponseWriter, r *http.Request)
 	GetAgentDeployments(w http.ResponseWriter, r *http.Request)
+	ListDeploymentRevisions(w http.ResponseWriter, r *http.Request)
+	GetDeploymentRevision(w http.ResponseWriter, r *http.Request)
+	RollbackDeployment(w http.ResponseWriter, r *http.Request)
 	GetAgentEndpoints(w http.ResponseWriter, r *http.Request)
 	GetBuild(w http.ResponseWriter, r *http.Request)
 	GetAgentConfigurations(w http.ResponseWriter, r *http.Request)
@@ -63,53 +65,6 @@ func NewAgentController(agentService services.AgentManagerService) AgentControll
 	}
 }
 
-// handleCommonErrors checks for common resource errors and writes appropriate responses.
-// If no common error matches, writes an internal server error with the provided fallback message.
-func handleCommonErrors(w http.ResponseWriter, err error, fallbackMsg string) {
-	switch {
-	// Not found errors
-	case errors.Is(err, utils.ErrOrganizationNotFound):
-		utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
-	case errors.Is(err, utils.ErrProjectNotFound):
-		utils.WriteErrorResponse(w, http.StatusNotFound, "Project not found")
-	case errors.Is(err, utils.ErrAgentNotFound):
-		utils.WriteErrorResponse(w, http.StatusNotFound, "Agent not found")
-	case errors.Is(err, utils.ErrBuildNotFound):
-		utils.WriteErrorResponse(w, http.StatusNotFound, "Build not found")
-	case errors.Is(err, utils.ErrEnvironmentNotFound):
-		utils.WriteErrorResponse(w, http.StatusNotFound, "Environment not found")
-
-	// Conflict errors
-	case errors.Is(err, utils.ErrAgentAlreadyExists):
-		utils.WriteErrorResponse(w, http.StatusConflict, "Agent already exists")
-	case errors.Is(err, utils.ErrProjectAlreadyExists):
-		utils.WriteErrorResponse(w, http.StatusConflict, "Project already exists")
-	case errors.Is(err, utils.ErrProjectHasAssociatedAgents):
-		utils.WriteErrorResponse(w, http.StatusConflict, "Project has associated agents")
-
-	// Bad request errors
-	case errors.Is(err, utils.ErrImmutableFieldChange):
-		utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
-	case errors.Is(err, utils.ErrBadRequest):
-		utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
-	case errors.Is(err, utils.ErrDeploymentPipelineNotFound):
-		utils.WriteErrorResponse(w, http.StatusBadRequest, "Deployment pipeline not found")
-
-	// Authorization errors
-	case errors.Is(err, utils.ErrUnauthorized):
-		utils.WriteErrorResponse(w, http.StatusUnauthorized, err.Error())
-	case errors.Is(err, utils.ErrForbidden):
-		utils.WriteErrorResponse(w, http.StatusForbidden, err.Error())
-
-	// Service unavailable
-	case errors.Is(err, utils.ErrServiceUnavailable):
-		utils.WriteErrorResponse(w, http.StatusServiceUnavailable, err.Error())
-
-	default:
-		utils.WriteErrorResponse(w, http.StatusInternalServerError, fallbackMsg)
-	}
-}
-
 func (c *agentController) GetAgent(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	log := logger.GetLogger(ctx)
@@ -121,7 +76,7 @@ func (c *agentController) GetAgent(w http.ResponseWriter, r *http.Request) {
 	agent, err := c.agentService.GetAgent(ctx, orgName, projName, agentName)
 	if err != nil {
 		log.Error("GetAgent: failed to get agent", "error", err)
-		handleCommonErrors(w, err, "Failed to get agent")
+		utils.WriteError(w, err, "Failed to get agent")
 		return
 	}
 
@@ -164,7 +119,7 @@ func (c *agentController) ListAgents(w http.ResponseWriter, r *http.Request) {
 	agents, total, err := c.agentService.ListAgents(ctx, orgName, projName, int32(limit), int32(offset))
 	if err != nil {
 		log.Error("ListAgents: failed to list agents", "error", err)
-		handleCommonErrors(w, err, "Failed to list agents")
+		utils.WriteError(w, err, "Failed to list agents")
 		return
 	}
 
@@ -204,7 +159,7 @@ func (c *agentController) CreateAgent(w http.ResponseWriter, r *http.Request) {
 	err := c.agentService.CreateAgent(ctx, orgName, projName, &payload)
 	if err != nil {
 		log.Error("CreateAgent: failed to create agent", "error", err)
-		handleCommonErrors(w, err, "Failed to create agent")
+		utils.WriteError(w, err, "Failed to create agent")
 		return
 	}
 	response := &spec.AgentResponse{
@@ -246,7 +201,7 @@ func (c *agentController) UpdateAgentBasicInfo(w http.ResponseWriter, r *http.Re
 	agent, err := c.agentService.UpdateAgentBasicInfo(ctx, orgName, projName, agentName, &payload)
 	if err != nil {
 		log.Error("UpdateAgent: failed to update agent", "error", err)
-		handleCommonErrors(w, err, "Failed to update agent")
+		utils.WriteError(w, err, "Failed to update agent")
 		return
 	}
 
@@ -278,7 +233,7 @@ func (c *agentController) UpdateAgentBuildParameters(w http.ResponseWriter, r *h
 	agent, err := c.agentService.UpdateAgentBuildParameters(ctx, orgName, projName, agentName, &payload)
 	if err != nil {
 		log.Error("UpdateAgentBuildParameters: failed to update agent build parameters", "error", err)
-		handleCommonErrors(w, err, "Failed to update agent build parameters")
+		utils.WriteError(w, err, "Failed to update agent build parameters")
 		return
 	}
 
@@ -299,7 +254,7 @@ func (c *agentController) GetAgentResourceConfigs(w http.ResponseWriter, r *http
 	configs, err := c.agentService.GetAgentResourceConfigs(ctx, orgName, projName, agentName, environment)
 	if err != nil {
 		log.Error("GetAgentResourceConfigs: failed to get agent resource configurations", "error", err)
-		handleCommonErrors(w, err, "Failed to get agent resource configurations")
+		utils.WriteError(w, err, "Failed to get agent resource configurations")
 		return
 	}
 
@@ -331,7 +286,7 @@ func (c *agentController) UpdateAgentResourceConfigs(w http.ResponseWriter, r *h
 	resourceConfigs, err := c.agentService.UpdateAgentResourceConfigs(ctx, orgName, projName, agentName, environment, &payload)
 	if err != nil {
 		log.Error("UpdateAgentResourceConfigs: failed to update agent resource configurations", "error", err)
-		handleCommonErrors(w, err, "Failed to update agent resource configurations")
+		utils.WriteError(w, err, "Failed to update agent resource configurations")
 		return
 	}
 
@@ -346,10 +301,10 @@ func (c *agentController) DeleteAgent(w http.ResponseWriter, r *http.Request) {
 	projName := r.PathValue(utils.PathParamProjName)
 	agentName := r.PathValue(utils.PathParamAgentName)
 
-	err := c.agentService.DeleteAgent(ctx, orgName, projName, agentName)
+	err := c.agentService.DeleteAgent(ctx, orgName, projName, agentName, requestSubject(ctx), r.Header.Get(utils.HeaderBreakGlassReason))
 	if err != nil {
 		log.Error("DeleteAgent: failed to delete agent", "error", err)
-		handleCommonErrors(w, err, "Failed to delete agent")
+		utils.WriteError(w, err, "Failed to delete agent")
 		return
 	}
 	utils.WriteSuccessResponse(w, http.StatusNoContent, "")
@@ -372,7 +327,7 @@ func (c *agentController) BuildAgent(w http.ResponseWriter, r *http.Request) {
 	build, err := c.agentService.BuildAgent(ctx, orgName, projName, agentName, commitId)
 	if err != nil {
 		log.Error("BuildAgent: failed to build agent", "error", err)
-		handleCommonErrors(w, err, "Failed to build agent")
+		utils.WriteError(w, err, "Failed to build agent")
 		return
 	}
 	utils.WriteSuccessResponse(w, http.StatusAccepted, build)
@@ -391,7 +346,7 @@ func (c *agentController) GetBuildLogs(w http.ResponseWriter, r *http.Request) {
 	buildLogs, err := c.agentService.GetBuildLogs(ctx, orgName, projName, agentName, buildName)
 	if err != nil {
 		log.Error("GetBuildLogs: failed to get build logs", "error", err)
-		handleCommonErrors(w, err, "Failed to get build logs")
+		utils.WriteError(w, err, "Failed to get build logs")
 		return
 	}
 	buildLogsResponse := utils.ConvertToLogsResponse(*buildLogs)
@@ -424,7 +379,7 @@ func (c *agentController) GetAgentRuntimeLogs(w http.ResponseWriter, r *http.Req
 	applicationLogs, err := c.agentService.GetAgentRuntimeLogs(ctx, orgName, projName, agentName, payload)
 	if err != nil {
 		log.Error("GetAgentRuntimeLogs: failed to get run-time logs", "error", err)
-		handleCommonErrors(w, err, "Failed to get run-time logs")
+		utils.WriteError(w, err, "Failed to get run-time logs")
 		return
 	}
 	buildLogsResponse := utils.ConvertToLogsResponse(*applicationLogs)
@@ -458,7 +413,7 @@ func (c *agentController) GetAgentMetrics(w http.ResponseWriter, r *http.Request
 	metricsResponse, err := c.agentService.GetAgentMetrics(ctx, orgName, projName, agentName, payload)
 	if err != nil {
 		log.Error("GetAgentMetrics: failed to get agent metrics", "error", err)
-		handleCommonErrors(w, err, "Failed to get agent metrics")
+		utils.WriteError(w, err, "Failed to get agent metrics")
 		return
 	}
 	utils.WriteSuccessResponse(w, http.StatusOK, metricsResponse)
@@ -487,10 +442,10 @@ func (c *agentController) DeployAgent(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	deployedEnv, err := c.agentService.DeployAgent(ctx, orgName, projName, agentName, &payload)
+	deployedEnv, approval, err := c.agentService.DeployAgent(ctx, orgName, projName, agentName, &payload, requestSubject(ctx), r.Header.Get(utils.HeaderBreakGlassReason))
 	if err != nil {
 		log.Error("DeployAgent: failed to deploy agent", "error", err)
-		handleCommonErrors(w, err, "Failed to deploy agent")
+		utils.WriteError(w, err, "Failed to deploy agent")
 		return
 	}
 
@@ -500,6 +455,77 @@ func (c *agentController) DeployAgent(w http.ResponseWriter, r *http.Request) {
 		ImageId:     payload.ImageId,
 		Environment: deployedEnv,
 	}
+	if approval != nil {
+		response.SetApprovalId(approval.ID)
+	}
+	utils.WriteSuccessResponse(w, http.StatusAccepted, response)
+}
+
+func (c *agentController) ListDeploymentRevisions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+
+	revisions, err := c.agentService.ListDeploymentRevisions(ctx, orgName, projName, agentName)
+	if err != nil {
+		log.Error("ListDeploymentRevisions: failed to list deployment revisions", "error", err)
+		utils.WriteError(w, err, "Failed to list deployment revisions")
+		return
+	}
+	utils.WriteSuccessResponse(w, http.StatusOK, revisions)
+}
+
+func (c *agentController) GetDeploymentRevision(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	revision, err := strconv.Atoi(r.PathValue(utils.PathParamRevision))
+	if err != nil || revision < 1 {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid revision")
+		return
+	}
+
+	response, err := c.agentService.GetDeploymentRevision(ctx, orgName, projName, agentName, revision)
+	if err != nil {
+		log.Error("GetDeploymentRevision: failed to get deployment revision", "error", err)
+		utils.WriteError(w, err, "Failed to get deployment revision")
+		return
+	}
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *agentController) RollbackDeployment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	revision, err := strconv.Atoi(r.PathValue(utils.PathParamRevision))
+	if err != nil || revision < 1 {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid revision")
+		return
+	}
+
+	response, approval, err := c.agentService.RollbackDeployment(ctx, orgName, projName, agentName, revision, requestSubject(ctx), r.Header.Get(utils.HeaderBreakGlassReason))
+	if err != nil {
+		log.Error("RollbackDeployment: failed to roll back deployment", "error", err)
+		utils.WriteError(w, err, "Failed to roll back deployment")
+		return
+	}
+	if approval != nil {
+		utils.WriteSuccessResponse(w, http.StatusAccepted, approval)
+		return
+	}
 	utils.WriteSuccessResponse(w, http.StatusAccepted, response)
 }
 
@@ -540,7 +566,7 @@ func (c *agentController) ListAgentBuilds(w http.ResponseWriter, r *http.Request
 	builds, total, err := c.agentService.ListAgentBuilds(ctx, orgName, projName, agentName, int32(limit), int32(offset))
 	if err != nil {
 		log.Error("ListAgentBuilds: failed to list agent builds", "error", err)
-		handleCommonErrors(w, err, "Failed to list agent builds")
+		utils.WriteError(w, err, "Failed to list agent builds")
 		return
 	}
 
@@ -579,7 +605,7 @@ func (c *agentController) GenerateName(w http.ResponseWriter, r *http.Request) {
 	candidateName, err := c.agentService.GenerateName(ctx, orgName, payload)
 	if err != nil {
 		log.Error("GenerateAgentName: failed to generate agent name", "error", err)
-		handleCommonErrors(w, err, "Failed to check agent name availability")
+		utils.WriteError(w, err, "Failed to check agent name availability")
 		return
 	}
 
@@ -604,7 +630,7 @@ func (c *agentController) GetBuild(w http.ResponseWriter, r *http.Request) {
 	build, err := c.agentService.GetBuild(ctx, orgName, projName, agentName, buildName)
 	if err != nil {
 		log.Error("GetBuild: failed to get build", "error", err)
-		handleCommonErrors(w, err, "Failed to get build")
+		utils.WriteError(w, err, "Failed to get build")
 		return
 	}
 
@@ -624,7 +650,7 @@ func (c *agentController) GetAgentDeployments(w http.ResponseWriter, r *http.Req
 	deployments, err := c.agentService.GetAgentDeployments(ctx, orgName, projName, agentName)
 	if err != nil {
 		log.Error("GetAgentDeployments: failed to get deployments", "error", err)
-		handleCommonErrors(w, err, "Failed to get deployments")
+		utils.WriteError(w, err, "Failed to get deployments")
 		return
 	}
 
@@ -650,7 +676,7 @@ func (c *agentController) GetAgentEndpoints(w http.ResponseWriter, r *http.Reque
 	endpoints, err := c.agentService.GetAgentEndpoints(ctx, orgName, projName, agentName, environment)
 	if err != nil {
 		log.Error("GetAgentEndpoints: failed to get agent endpoints", "error", err)
-		handleCommonErrors(w, err, "Failed to get agent endpoints")
+		utils.WriteError(w, err, "Failed to get agent endpoints")
 		return
 	}
 
@@ -677,7 +703,7 @@ func (c *agentController) GetAgentConfigurations(w http.ResponseWriter, r *http.
 	configurations, err := c.agentService.GetAgentConfigurations(ctx, orgName, projName, agentName, environment)
 	if err != nil {
 		log.Error("GetAgentConfigurations: failed to get configurations", "error", err)
-		handleCommonErrors(w, err, "Failed to get configurations")
+		utils.WriteError(w, err, "Failed to get configurations")
 		return
 	}
 
@@ -0,0 +1,109 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+const (
+	// maxAgentInvocationRequestBytes bounds the request body sent to an agent
+	maxAgentInvocationRequestBytes = 10 << 20
+	// traceIDHeader returns the trace ID of an invocation, so that the console can open the trace
+	traceIDHeader = "x-trace-id"
+)
+
+// AgentInvocationController defines the interface for the agent "try it" HTTP handlers
+type AgentInvocationController interface {
+	InvokeAgent(w http.ResponseWriter, r *http.Request)
+}
+
+type agentInvocationController struct {
+	agentInvocationService services.AgentInvocationService
+}
+
+// NewAgentInvocationController creates a new agent invocation controller
+func NewAgentInvocationController(agentInvocationService services.AgentInvocationService) AgentInvocationController {
+	return &agentInvocationController{
+		agentInvocationService: agentInvocationService,
+	}
+}
+
+// InvokeAgent sends the request body to the agent and streams the agent's response back as it
+// arrives, with the status code and content type of the agent
+func (c *agentInvocationController) InvokeAgent(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	query := r.URL.Query()
+	req := services.AgentInvocationRequest{
+		OrgName:     r.PathValue(utils.PathParamOrgName),
+		ProjectName: r.PathValue(utils.PathParamProjName),
+		AgentName:   r.PathValue(utils.PathParamAgentName),
+		Environment: query.Get("environment"),
+		Endpoint:    query.Get("endpoint"),
+		Method:      query.Get("method"),
+		Path:        query.Get("path"),
+		ContentType: r.Header.Get("Content-Type"),
+		Accept:      r.Header.Get("Accept"),
+		Body:        http.MaxBytesReader(w, r.Body, maxAgentInvocationRequestBytes),
+	}
+	if claims := jwtassertion.GetTokenClaims(ctx); claims != nil {
+		req.RequestedBy = claims.Sub
+	}
+
+	invocation, err := c.agentInvocationService.InvokeAgent(ctx, req)
+	if err != nil {
+		log.Error("InvokeAgent: failed to invoke agent", "agentName", req.AgentName, "environment", req.Environment, "error", err)
+		utils.WriteError(w, err, "Failed to invoke agent")
+		return
+	}
+	defer func() { _ = invocation.Close() }()
+
+	w.Header().Set(traceIDHeader, invocation.TraceID)
+	if contentType := invocation.Response.Header.Get("Content-Type"); contentType != "" {
+		w.Header().Set("Content-Type", contentType)
+	}
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(invocation.Response.StatusCode)
+
+	// Flush each chunk so that streamed responses, such as server-sent events, reach the console as they arrive
+	rc := http.NewResponseController(w)
+	buf := make([]byte, 32*1024)
+	for {
+		n, readErr := invocation.Response.Body.Read(buf)
+		if n > 0 {
+			if _, err := w.Write(buf[:n]); err != nil {
+				log.Warn("InvokeAgent: console disconnected", "agentName", req.AgentName, "traceId", invocation.TraceID, "error", err)
+				return
+			}
+			_ = rc.Flush()
+		}
+		if readErr != nil {
+			if readErr != io.EOF {
+				log.Warn("InvokeAgent: failed to read agent response", "agentName", req.AgentName, "traceId", invocation.TraceID, "error", readErr)
+			}
+			return
+		}
+	}
+}
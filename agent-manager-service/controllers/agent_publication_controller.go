@@ -0,0 +1,181 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// AgentPublicationController defines the interface for agent developer portal publishing HTTP handlers
+type AgentPublicationController interface {
+	CreateAgentPublication(w http.ResponseWriter, r *http.Request)
+	GetAgentPublication(w http.ResponseWriter, r *http.Request)
+	UpdateAgentPublication(w http.ResponseWriter, r *http.Request)
+	DeleteAgentPublication(w http.ResponseWriter, r *http.Request)
+	ChangeAgentPublicationLifecycle(w http.ResponseWriter, r *http.Request)
+	ListAgentPublications(w http.ResponseWriter, r *http.Request)
+}
+
+type agentPublicationController struct {
+	agentPublicationService services.AgentPublicationService
+}
+
+// NewAgentPublicationController creates a new agent publication controller
+func NewAgentPublicationController(agentPublicationService services.AgentPublicationService) AgentPublicationController {
+	return &agentPublicationController{
+		agentPublicationService: agentPublicationService,
+	}
+}
+
+func (c *agentPublicationController) CreateAgentPublication(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+
+	var req models.CreateAgentPublicationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error("CreateAgentPublication: failed to decode request", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	var createdBy string
+	if claims := jwtassertion.GetTokenClaims(ctx); claims != nil {
+		createdBy = claims.Sub
+	}
+
+	publication, err := c.agentPublicationService.CreateAgentPublication(ctx, orgName, projName, agentName, createdBy, &req)
+	if err != nil {
+		log.Error("CreateAgentPublication: failed to register agent for publishing", "agentName", agentName, "error", err)
+		utils.WriteError(w, err, "Failed to register agent for publishing")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusCreated, publication)
+}
+
+func (c *agentPublicationController) GetAgentPublication(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+
+	publication, err := c.agentPublicationService.GetAgentPublication(ctx, orgName, projName, agentName)
+	if err != nil {
+		log.Error("GetAgentPublication: failed to get agent publication", "agentName", agentName, "error", err)
+		utils.WriteError(w, err, "Failed to get agent publication")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, publication)
+}
+
+func (c *agentPublicationController) UpdateAgentPublication(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+
+	var req models.UpdateAgentPublicationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error("UpdateAgentPublication: failed to decode request", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	publication, err := c.agentPublicationService.UpdateAgentPublication(ctx, orgName, projName, agentName, &req)
+	if err != nil {
+		log.Error("UpdateAgentPublication: failed to update agent publication", "agentName", agentName, "error", err)
+		utils.WriteError(w, err, "Failed to update agent publication")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, publication)
+}
+
+func (c *agentPublicationController) DeleteAgentPublication(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+
+	if err := c.agentPublicationService.DeleteAgentPublication(ctx, orgName, projName, agentName); err != nil {
+		log.Error("DeleteAgentPublication: failed to delete agent publication", "agentName", agentName, "error", err)
+		utils.WriteError(w, err, "Failed to delete agent publication")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusNoContent, struct{}{})
+}
+
+func (c *agentPublicationController) ChangeAgentPublicationLifecycle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+
+	var req models.AgentPublicationLifecycleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error("ChangeAgentPublicationLifecycle: failed to decode request", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	publication, err := c.agentPublicationService.ChangeAgentPublicationLifecycle(ctx, orgName, projName, agentName, req.Status)
+	if err != nil {
+		log.Error("ChangeAgentPublicationLifecycle: failed to change lifecycle", "agentName", agentName, "status", req.Status, "error", err)
+		utils.WriteError(w, err, "Failed to change agent publication lifecycle")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, publication)
+}
+
+func (c *agentPublicationController) ListAgentPublications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	status := r.URL.Query().Get("status")
+
+	publications, err := c.agentPublicationService.ListAgentPublications(ctx, orgName, status)
+	if err != nil {
+		log.Error("ListAgentPublications: failed to list agent publications", "orgName", orgName, "error", err)
+		utils.WriteError(w, err, "Failed to list agent publications")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, models.AgentPublicationListResponse{Publications: publications})
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// AgentTemplateController defines the interface for agent template HTTP handlers
+type AgentTemplateController interface {
+	ListAgentTemplates(w http.ResponseWriter, r *http.Request)
+	ScaffoldAgentRepository(w http.ResponseWriter, r *http.Request)
+}
+
+type agentTemplateController struct {
+	agentTemplateService services.AgentTemplateService
+}
+
+// NewAgentTemplateController creates a new agent template controller
+func NewAgentTemplateController(agentTemplateService services.AgentTemplateService) AgentTemplateController {
+	return &agentTemplateController{
+		agentTemplateService: agentTemplateService,
+	}
+}
+
+func (c *agentTemplateController) ListAgentTemplates(w http.ResponseWriter, r *http.Request) {
+	templates := c.agentTemplateService.ListAgentTemplates(r.Context())
+	utils.WriteSuccessResponse(w, http.StatusOK, templates)
+}
+
+func (c *agentTemplateController) ScaffoldAgentRepository(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+	templateName := r.PathValue(utils.PathParamTemplateName)
+
+	var req models.ScaffoldAgentRepositoryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error("ScaffoldAgentRepository: failed to decode request", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	resp, err := c.agentTemplateService.ScaffoldAgentRepository(ctx, templateName, &req)
+	if err != nil {
+		log.Error("ScaffoldAgentRepository: failed to scaffold repository", "template", templateName, "owner", req.Owner, "name", req.Name, "error", err)
+		if _, ok := utils.LookupError(err); ok {
+			utils.WriteError(w, err, "Failed to scaffold repository")
+		} else {
+			handleGitProviderError(w, err)
+		}
+		return
+	}
+
+	log.Info("ScaffoldAgentRepository: repository scaffolded", "template", templateName, "repository", resp.RepositoryURL)
+	utils.WriteSuccessResponse(w, http.StatusCreated, resp)
+}
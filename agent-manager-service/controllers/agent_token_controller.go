@@ -18,7 +18,6 @@ package controllers
 
 import (
 	"encoding/json"
-	"errors"
 	"net/http"
 
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
@@ -93,28 +92,7 @@ func (c *agentTokenController) GenerateToken(w http.ResponseWriter, r *http.Requ
 	tokenResponse, err := c.tokenService.GenerateToken(ctx, req)
 	if err != nil {
 		log.Error("GenerateToken: failed to generate token", "error", err)
-		// Check for specific error types using errors.Is()
-		if errors.Is(err, utils.ErrOrganizationNotFound) {
-			utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
-			return
-		}
-		if errors.Is(err, utils.ErrProjectNotFound) {
-			utils.WriteErrorResponse(w, http.StatusNotFound, "Project not found")
-			return
-		}
-		if errors.Is(err, utils.ErrAgentNotFound) {
-			utils.WriteErrorResponse(w, http.StatusNotFound, "Agent not found")
-			return
-		}
-		if errors.Is(err, utils.ErrEnvironmentNotFound) {
-			utils.WriteErrorResponse(w, http.StatusNotFound, "Environment not found")
-			return
-		}
-		if errors.Is(err, utils.ErrInvalidInput) {
-			utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
-			return
-		}
-		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to generate token")
+		utils.WriteError(w, err, "Failed to generate token")
 		return
 	}
 
@@ -0,0 +1,98 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// ApplyController defines the interface for the declarative apply HTTP handlers
+type ApplyController interface {
+	Apply(w http.ResponseWriter, r *http.Request)
+}
+
+type applyController struct {
+	applyService services.ApplyService
+}
+
+// NewApplyController creates a new apply controller
+func NewApplyController(applyService services.ApplyService) ApplyController {
+	return &applyController{
+		applyService: applyService,
+	}
+}
+
+func handleApplyErrors(w http.ResponseWriter, err error, fallbackMsg string) {
+	if errors.Is(err, utils.ErrServiceUnavailable) {
+		utils.WriteErrorResponse(w, http.StatusServiceUnavailable, "Gateway management is unavailable")
+		return
+	}
+	utils.WriteError(w, err, fallbackMsg)
+}
+
+func (c *applyController) Apply(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	opts, err := parseApplyOptions(r)
+	if err != nil {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid query parameters")
+		return
+	}
+
+	var manifest models.ApplyManifest
+	if err := json.NewDecoder(r.Body).Decode(&manifest); err != nil {
+		log.Error("Apply: failed to decode manifest", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	plan, err := c.applyService.Apply(ctx, orgName, &manifest, opts)
+	if err != nil {
+		log.Error("Apply: failed to apply manifest", "orgName", orgName, "error", err)
+		handleApplyErrors(w, err, "Failed to apply manifest")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, plan)
+}
+
+func parseApplyOptions(r *http.Request) (models.ApplyOptions, error) {
+	var opts models.ApplyOptions
+	var err error
+	if v := r.URL.Query().Get("dryRun"); v != "" {
+		if opts.DryRun, err = strconv.ParseBool(v); err != nil {
+			return opts, err
+		}
+	}
+	if v := r.URL.Query().Get("prune"); v != "" {
+		if opts.Prune, err = strconv.ParseBool(v); err != nil {
+			return opts, err
+		}
+	}
+	return opts, nil
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// ChangeFreezeController defines the interface for the change freeze HTTP handlers
+type ChangeFreezeController interface {
+	CreateChangeFreezeWindow(w http.ResponseWriter, r *http.Request)
+	ListChangeFreezeWindows(w http.ResponseWriter, r *http.Request)
+	DeleteChangeFreezeWindow(w http.ResponseWriter, r *http.Request)
+	GetChangeFreezeWindowEvents(w http.ResponseWriter, r *http.Request)
+}
+
+type changeFreezeController struct {
+	changeFreezeService services.ChangeFreezeService
+	eventService        services.ResourceEventService
+}
+
+// NewChangeFreezeController creates a new change freeze controller
+func NewChangeFreezeController(changeFreezeService services.ChangeFreezeService, eventService services.ResourceEventService) ChangeFreezeController {
+	return &changeFreezeController{
+		changeFreezeService: changeFreezeService,
+		eventService:        eventService,
+	}
+}
+
+func (c *changeFreezeController) CreateChangeFreezeWindow(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	if !isChangeFreezeAdmin(ctx) {
+		log.Warn("CreateChangeFreezeWindow: caller lacks the change freeze admin scope", "orgName", orgName)
+		utils.WriteError(w, utils.ErrNotChangeFreezeAdmin, "Failed to create change freeze window")
+		return
+	}
+
+	var req models.CreateChangeFreezeWindowRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error("CreateChangeFreezeWindow: failed to decode request", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if fieldErrors := utils.ValidateRequest(&req); fieldErrors != nil {
+		utils.WriteValidationError(w, "Invalid request body", fieldErrors)
+		return
+	}
+
+	window, err := c.changeFreezeService.CreateWindow(ctx, orgName, requestSubject(ctx), &req)
+	if err != nil {
+		log.Error("CreateChangeFreezeWindow: failed to create change freeze window", "orgName", orgName, "error", err)
+		utils.WriteError(w, err, "Failed to create change freeze window")
+		return
+	}
+	utils.WriteSuccessResponse(w, http.StatusCreated, window)
+}
+
+func (c *changeFreezeController) ListChangeFreezeWindows(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	windows, err := c.changeFreezeService.ListWindows(ctx, orgName)
+	if err != nil {
+		log.Error("ListChangeFreezeWindows: failed to list change freeze windows", "orgName", orgName, "error", err)
+		utils.WriteError(w, err, "Failed to list change freeze windows")
+		return
+	}
+	utils.WriteSuccessResponse(w, http.StatusOK, windows)
+}
+
+func (c *changeFreezeController) DeleteChangeFreezeWindow(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	freezeID := r.PathValue(utils.PathParamFreezeId)
+
+	if !isChangeFreezeAdmin(ctx) {
+		log.Warn("DeleteChangeFreezeWindow: caller lacks the change freeze admin scope", "freezeId", freezeID)
+		utils.WriteError(w, utils.ErrNotChangeFreezeAdmin, "Failed to delete change freeze window")
+		return
+	}
+
+	if err := c.changeFreezeService.DeleteWindow(ctx, orgName, freezeID); err != nil {
+		log.Error("DeleteChangeFreezeWindow: failed to delete change freeze window", "freezeId", freezeID, "error", err)
+		utils.WriteError(w, err, "Failed to delete change freeze window")
+		return
+	}
+	utils.WriteSuccessResponse(w, http.StatusNoContent, struct{}{})
+}
+
+// GetChangeFreezeWindowEvents lists the changes made during a change freeze by overriding it
+func (c *changeFreezeController) GetChangeFreezeWindowEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	freezeID := r.PathValue(utils.PathParamFreezeId)
+
+	limit := getIntQueryParam(r, "limit", utils.DefaultLimit)
+	offset := getIntQueryParam(r, "offset", utils.DefaultOffset)
+	if limit < utils.MinLimit || limit > utils.MaxLimit {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid limit parameter")
+		return
+	}
+	if offset < 0 {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid offset parameter")
+		return
+	}
+
+	window, err := c.changeFreezeService.GetWindow(ctx, orgName, freezeID)
+	if err != nil {
+		log.Error("GetChangeFreezeWindowEvents: failed to get change freeze window", "freezeId", freezeID, "error", err)
+		utils.WriteError(w, err, "Failed to get change freeze window")
+		return
+	}
+	events, err := c.eventService.ListEvents(ctx, orgName, models.ResourceTypeChangeFreeze, window.ID, limit, offset)
+	if err != nil {
+		log.Error("GetChangeFreezeWindowEvents: failed to list events", "freezeId", freezeID, "error", err)
+		utils.WriteError(w, err, "Failed to list change freeze window events")
+		return
+	}
+	utils.WriteSuccessResponse(w, http.StatusOK, events)
+}
+
+// isChangeFreezeAdmin reports whether the caller may declare or delete change freeze windows. Without
+// this, anyone blocked by a freeze could delete the window and make the change without a trace.
+func isChangeFreezeAdmin(ctx context.Context) bool {
+	return jwtassertion.HasAllScopes(ctx, []string{config.GetConfig().ChangeFreeze.AdminScope})
+}
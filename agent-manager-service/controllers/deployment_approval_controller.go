@@ -0,0 +1,258 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// DeploymentApprovalController defines the interface for the deployment approval HTTP handlers
+type DeploymentApprovalController interface {
+	GetDeploymentApprovalPolicy(w http.ResponseWriter, r *http.Request)
+	SetDeploymentApprovalPolicy(w http.ResponseWriter, r *http.Request)
+	DeleteDeploymentApprovalPolicy(w http.ResponseWriter, r *http.Request)
+	ListDeploymentApprovals(w http.ResponseWriter, r *http.Request)
+	GetDeploymentApproval(w http.ResponseWriter, r *http.Request)
+	ApproveDeployment(w http.ResponseWriter, r *http.Request)
+	RejectDeployment(w http.ResponseWriter, r *http.Request)
+	GetDeploymentApprovalEvents(w http.ResponseWriter, r *http.Request)
+}
+
+type deploymentApprovalController struct {
+	agentService services.AgentManagerService
+	eventService services.ResourceEventService
+}
+
+// NewDeploymentApprovalController creates a new deployment approval controller
+func NewDeploymentApprovalController(agentService services.AgentManagerService, eventService services.ResourceEventService) DeploymentApprovalController {
+	return &deploymentApprovalController{
+		agentService: agentService,
+		eventService: eventService,
+	}
+}
+
+func (c *deploymentApprovalController) GetDeploymentApprovalPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	policy, err := c.agentService.GetDeploymentApprovalPolicy(ctx, orgName)
+	if err != nil {
+		log.Error("GetDeploymentApprovalPolicy: failed to get deployment approval policy", "orgName", orgName, "error", err)
+		utils.WriteError(w, err, "Failed to get deployment approval policy")
+		return
+	}
+	utils.WriteSuccessResponse(w, http.StatusOK, policy)
+}
+
+func (c *deploymentApprovalController) SetDeploymentApprovalPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	if !isDeploymentApprovalAdmin(ctx) {
+		log.Warn("SetDeploymentApprovalPolicy: caller lacks the deployment approval admin scope", "orgName", orgName)
+		utils.WriteError(w, utils.ErrNotDeploymentApprovalAdmin, "Failed to set deployment approval policy")
+		return
+	}
+
+	var req models.DeploymentApprovalPolicyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error("SetDeploymentApprovalPolicy: failed to decode request", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if fieldErrors := utils.ValidateRequest(&req); fieldErrors != nil {
+		utils.WriteValidationError(w, "Invalid request body", fieldErrors)
+		return
+	}
+
+	policy, err := c.agentService.SetDeploymentApprovalPolicy(ctx, orgName, &req, requestSubject(ctx))
+	if err != nil {
+		log.Error("SetDeploymentApprovalPolicy: failed to set deployment approval policy", "orgName", orgName, "error", err)
+		utils.WriteError(w, err, "Failed to set deployment approval policy")
+		return
+	}
+	utils.WriteSuccessResponse(w, http.StatusOK, policy)
+}
+
+func (c *deploymentApprovalController) DeleteDeploymentApprovalPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	if !isDeploymentApprovalAdmin(ctx) {
+		log.Warn("DeleteDeploymentApprovalPolicy: caller lacks the deployment approval admin scope", "orgName", orgName)
+		utils.WriteError(w, utils.ErrNotDeploymentApprovalAdmin, "Failed to delete deployment approval policy")
+		return
+	}
+
+	if err := c.agentService.DeleteDeploymentApprovalPolicy(ctx, orgName); err != nil {
+		log.Error("DeleteDeploymentApprovalPolicy: failed to delete deployment approval policy", "orgName", orgName, "error", err)
+		utils.WriteError(w, err, "Failed to delete deployment approval policy")
+		return
+	}
+	utils.WriteSuccessResponse(w, http.StatusNoContent, struct{}{})
+}
+
+func (c *deploymentApprovalController) ListDeploymentApprovals(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	status := r.URL.Query().Get("status")
+	switch status {
+	case "", models.DeploymentApprovalStatusPending, models.DeploymentApprovalStatusApproved,
+		models.DeploymentApprovalStatusRejected, models.DeploymentApprovalStatusFailed:
+	default:
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "status must be one of pending, approved, rejected or failed")
+		return
+	}
+
+	approvals, err := c.agentService.ListDeploymentApprovals(ctx, orgName, status)
+	if err != nil {
+		log.Error("ListDeploymentApprovals: failed to list deployment approvals", "orgName", orgName, "error", err)
+		utils.WriteError(w, err, "Failed to list deployment approvals")
+		return
+	}
+	utils.WriteSuccessResponse(w, http.StatusOK, approvals)
+}
+
+func (c *deploymentApprovalController) GetDeploymentApproval(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	approvalID := r.PathValue(utils.PathParamApprovalId)
+
+	approval, err := c.agentService.GetDeploymentApproval(ctx, orgName, approvalID)
+	if err != nil {
+		log.Error("GetDeploymentApproval: failed to get deployment approval", "approvalId", approvalID, "error", err)
+		utils.WriteError(w, err, "Failed to get deployment approval")
+		return
+	}
+	utils.WriteSuccessResponse(w, http.StatusOK, approval)
+}
+
+func (c *deploymentApprovalController) ApproveDeployment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	approvalID := r.PathValue(utils.PathParamApprovalId)
+	req, ok := decodeDeploymentReviewRequest(w, r)
+	if !ok {
+		return
+	}
+
+	approval, err := c.agentService.ApproveDeployment(ctx, orgName, approvalID, requestSubject(ctx), req, r.Header.Get(utils.HeaderBreakGlassReason))
+	if err != nil {
+		log.Error("ApproveDeployment: failed to approve deployment", "approvalId", approvalID, "error", err)
+		utils.WriteError(w, err, "Failed to approve deployment")
+		return
+	}
+	utils.WriteSuccessResponse(w, http.StatusOK, approval)
+}
+
+func (c *deploymentApprovalController) RejectDeployment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	approvalID := r.PathValue(utils.PathParamApprovalId)
+	req, ok := decodeDeploymentReviewRequest(w, r)
+	if !ok {
+		return
+	}
+
+	approval, err := c.agentService.RejectDeployment(ctx, orgName, approvalID, requestSubject(ctx), req)
+	if err != nil {
+		log.Error("RejectDeployment: failed to reject deployment", "approvalId", approvalID, "error", err)
+		utils.WriteError(w, err, "Failed to reject deployment")
+		return
+	}
+	utils.WriteSuccessResponse(w, http.StatusOK, approval)
+}
+
+func (c *deploymentApprovalController) GetDeploymentApprovalEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	approvalID := r.PathValue(utils.PathParamApprovalId)
+
+	limit := getIntQueryParam(r, "limit", utils.DefaultLimit)
+	offset := getIntQueryParam(r, "offset", utils.DefaultOffset)
+	if limit < utils.MinLimit || limit > utils.MaxLimit {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid limit parameter")
+		return
+	}
+	if offset < 0 {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid offset parameter")
+		return
+	}
+
+	approval, err := c.agentService.GetDeploymentApproval(ctx, orgName, approvalID)
+	if err != nil {
+		log.Error("GetDeploymentApprovalEvents: failed to get deployment approval", "approvalId", approvalID, "error", err)
+		utils.WriteError(w, err, "Failed to get deployment approval")
+		return
+	}
+	events, err := c.eventService.ListEvents(ctx, orgName, models.ResourceTypeDeploymentApproval, approval.ID, limit, offset)
+	if err != nil {
+		log.Error("GetDeploymentApprovalEvents: failed to list events", "approvalId", approvalID, "error", err)
+		utils.WriteError(w, err, "Failed to list deployment approval events")
+		return
+	}
+	utils.WriteSuccessResponse(w, http.StatusOK, events)
+}
+
+// decodeDeploymentReviewRequest reads the optional comment of a review, writing the error response if it is invalid
+func decodeDeploymentReviewRequest(w http.ResponseWriter, r *http.Request) (*models.DeploymentReviewRequest, bool) {
+	var req models.DeploymentReviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		logger.GetLogger(r.Context()).Error("failed to decode deployment review request", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return nil, false
+	}
+	if fieldErrors := utils.ValidateRequest(&req); fieldErrors != nil {
+		utils.WriteValidationError(w, "Invalid request body", fieldErrors)
+		return nil, false
+	}
+	return &req, true
+}
+
+// isDeploymentApprovalAdmin reports whether the caller may change the approval policy. Reviewers
+// and requesters cannot, so that a requester cannot add themselves as a reviewer or turn approvals
+// off to get their own deployment through.
+func isDeploymentApprovalAdmin(ctx context.Context) bool {
+	return jwtassertion.HasAllScopes(ctx, []string{config.GetConfig().DeploymentApproval.AdminScope})
+}
@@ -37,6 +37,7 @@ type EnvironmentController interface {
 	UpdateEnvironment(w http.ResponseWriter, r *http.Request)
 	DeleteEnvironment(w http.ResponseWriter, r *http.Request)
 	GetEnvironmentGateways(w http.ResponseWriter, r *http.Request)
+	ListRegions(w http.ResponseWriter, r *http.Request)
 }
 
 type environmentController struct {
@@ -51,18 +52,11 @@ func NewEnvironmentController(environmentService services.EnvironmentService) En
 }
 
 func handleEnvironmentErrors(w http.ResponseWriter, err error, fallbackMsg string) {
-	switch {
-	case errors.Is(err, utils.ErrEnvironmentNotFound):
-		utils.WriteErrorResponse(w, http.StatusNotFound, "Environment not found")
-	case errors.Is(err, utils.ErrEnvironmentAlreadyExists):
-		utils.WriteErrorResponse(w, http.StatusConflict, "Environment already exists")
-	case errors.Is(err, utils.ErrEnvironmentHasGateways):
-		utils.WriteErrorResponse(w, http.StatusConflict, "Environment has associated gateways")
-	case errors.Is(err, utils.ErrInvalidInput):
-		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid input")
-	default:
-		utils.WriteErrorResponse(w, http.StatusInternalServerError, fallbackMsg)
+	if errors.Is(err, utils.ErrPreconditionFailed) {
+		utils.WriteErrorResponse(w, http.StatusPreconditionFailed, "Environment has been modified")
+		return
 	}
+	utils.WriteError(w, err, fallbackMsg)
 }
 
 func (c *environmentController) CreateEnvironment(w http.ResponseWriter, r *http.Request) {
@@ -77,6 +71,10 @@ func (c *environmentController) CreateEnvironment(w http.ResponseWriter, r *http
 		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
 		return
 	}
+	if fieldErrors := utils.ValidateRequest(&req); fieldErrors != nil {
+		utils.WriteValidationError(w, "Invalid request body", fieldErrors)
+		return
+	}
 
 	// Convert spec request to internal model
 	internalReq := &models.CreateEnvironmentRequest{
@@ -93,6 +91,9 @@ func (c *environmentController) CreateEnvironment(w http.ResponseWriter, r *http
 	if req.IsProduction != nil {
 		internalReq.IsProduction = *req.IsProduction
 	}
+	if req.Region != nil {
+		internalReq.Region = *req.Region
+	}
 
 	env, err := c.environmentService.CreateEnvironment(ctx, orgName, internalReq)
 	if err != nil {
@@ -103,6 +104,7 @@ func (c *environmentController) CreateEnvironment(w http.ResponseWriter, r *http
 
 	// Convert internal response to spec response
 	response := convertToSpecEnvironmentResponse(env)
+	utils.SetETagHeader(w, env)
 	utils.WriteSuccessResponse(w, http.StatusCreated, response)
 }
 
@@ -121,6 +123,7 @@ func (c *environmentController) GetEnvironment(w http.ResponseWriter, r *http.Re
 	}
 
 	response := convertToSpecEnvironmentResponse(env)
+	utils.SetETagHeader(w, env)
 	utils.WriteSuccessResponse(w, http.StatusOK, response)
 }
 
@@ -143,8 +146,13 @@ func (c *environmentController) ListEnvironments(w http.ResponseWriter, r *http.
 		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid offset parameter")
 		return
 	}
+	region := r.URL.Query().Get("region")
+	if err := services.ValidateRegion(region); err != nil {
+		utils.WriteError(w, err, "Invalid region parameter")
+		return
+	}
 
-	envList, err := c.environmentService.ListEnvironments(ctx, orgName, int32(limit), int32(offset))
+	envList, err := c.environmentService.ListEnvironments(ctx, orgName, region, int32(limit), int32(offset))
 	if err != nil {
 		log.Error("ListEnvironments: failed to list environments", "error", err)
 		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to list environments")
@@ -173,6 +181,10 @@ func (c *environmentController) UpdateEnvironment(w http.ResponseWriter, r *http
 		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
 		return
 	}
+	if fieldErrors := utils.ValidateRequest(&req); fieldErrors != nil {
+		utils.WriteValidationError(w, "Invalid request body", fieldErrors)
+		return
+	}
 
 	// Convert spec request to internal model
 	var description *string
@@ -183,9 +195,10 @@ func (c *environmentController) UpdateEnvironment(w http.ResponseWriter, r *http
 	internalReq := &models.UpdateEnvironmentRequest{
 		DisplayName: req.DisplayName,
 		Description: description,
+		Region:      req.Region,
 	}
 
-	env, err := c.environmentService.UpdateEnvironment(ctx, orgName, envID, internalReq)
+	env, err := c.environmentService.UpdateEnvironment(ctx, orgName, envID, internalReq, r.Header.Get(utils.HeaderIfMatch))
 	if err != nil {
 		log.Error("UpdateEnvironment: failed to update environment", "error", err)
 		handleEnvironmentErrors(w, err, "Failed to update environment")
@@ -193,6 +206,7 @@ func (c *environmentController) UpdateEnvironment(w http.ResponseWriter, r *http
 	}
 
 	response := convertToSpecEnvironmentResponse(env)
+	utils.SetETagHeader(w, env)
 	utils.WriteSuccessResponse(w, http.StatusOK, response)
 }
 
@@ -203,7 +217,7 @@ func (c *environmentController) DeleteEnvironment(w http.ResponseWriter, r *http
 	orgName := r.PathValue(utils.PathParamOrgName)
 	envID := r.PathValue("envID")
 
-	if err := c.environmentService.DeleteEnvironment(ctx, orgName, envID); err != nil {
+	if err := c.environmentService.DeleteEnvironment(ctx, orgName, envID, r.Header.Get(utils.HeaderIfMatch)); err != nil {
 		log.Error("DeleteEnvironment: failed to delete environment", "error", err)
 		handleEnvironmentErrors(w, err, "Failed to delete environment")
 		return
@@ -239,6 +253,22 @@ func (c *environmentController) GetEnvironmentGateways(w http.ResponseWriter, r
 	utils.WriteSuccessResponse(w, http.StatusOK, response)
 }
 
+func (c *environmentController) ListRegions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	regions, err := c.environmentService.ListRegions(ctx, orgName)
+	if err != nil {
+		log.Error("ListRegions: failed to list regions", "error", err)
+		utils.WriteError(w, err, "Failed to list regions")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, regions)
+}
+
 // Helper function to get int query param with default value
 func getIntQueryParam(r *http.Request, key string, defaultValue int) int {
 	if val := r.URL.Query().Get(key); val != "" {
@@ -266,6 +296,9 @@ func convertToSpecEnvironmentResponse(env *models.GatewayEnvironmentResponse) sp
 	if env.Description != "" {
 		response.Description = &env.Description
 	}
+	if env.Region != "" {
+		response.Region = &env.Region
+	}
 
 	return response
 }
@@ -0,0 +1,88 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// GatewayBulkOperationController defines the interface for the gateway bulk operation HTTP handlers
+type GatewayBulkOperationController interface {
+	CreateGatewayBulkOperation(w http.ResponseWriter, r *http.Request)
+	GetGatewayBulkOperation(w http.ResponseWriter, r *http.Request)
+}
+
+type gatewayBulkOperationController struct {
+	bulkOperationService services.GatewayBulkOperationService
+}
+
+// NewGatewayBulkOperationController creates a new gateway bulk operation controller
+func NewGatewayBulkOperationController(bulkOperationService services.GatewayBulkOperationService) GatewayBulkOperationController {
+	return &gatewayBulkOperationController{
+		bulkOperationService: bulkOperationService,
+	}
+}
+
+func (c *gatewayBulkOperationController) CreateGatewayBulkOperation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	var req models.CreateGatewayBulkOperationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error("CreateGatewayBulkOperation: failed to decode request", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if fieldErrors := utils.ValidateRequest(&req); fieldErrors != nil {
+		utils.WriteValidationError(w, "Invalid request body", fieldErrors)
+		return
+	}
+
+	operation, err := c.bulkOperationService.StartBulkOperation(ctx, orgName, requestSubject(ctx), &req, r.Header.Get(utils.HeaderBreakGlassReason))
+	if err != nil {
+		log.Error("CreateGatewayBulkOperation: failed to start bulk operation", "orgName", orgName, "action", req.Action, "error", err)
+		utils.WriteError(w, err, "Failed to start gateway bulk operation")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusAccepted, operation)
+}
+
+func (c *gatewayBulkOperationController) GetGatewayBulkOperation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	operationID := r.PathValue("operationID")
+
+	operation, err := c.bulkOperationService.GetBulkOperation(ctx, orgName, operationID)
+	if err != nil {
+		log.Error("GetGatewayBulkOperation: failed to get bulk operation", "operationId", operationID, "error", err)
+		utils.WriteError(w, err, "Failed to get gateway bulk operation")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, operation)
+}
@@ -22,6 +22,9 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -30,8 +33,10 @@ import (
 
 	apiplatformclient "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/apiplatformsvc/client"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
 )
@@ -40,6 +45,9 @@ const (
 	// Default limit for pagination
 	defaultLimit = 100
 
+	// Maximum limit for pagination
+	maxLimit = 100
+
 	// Default offset for pagination
 	defaultOffset = 0
 )
@@ -55,37 +63,39 @@ type GatewayController interface {
 	RemoveGatewayFromEnvironment(w http.ResponseWriter, r *http.Request)
 	GetGatewayEnvironments(w http.ResponseWriter, r *http.Request)
 	CheckGatewayHealth(w http.ResponseWriter, r *http.Request)
+	DiagnoseGateway(w http.ResponseWriter, r *http.Request)
 	RotateGatewayToken(w http.ResponseWriter, r *http.Request)
 	RevokeGatewayToken(w http.ResponseWriter, r *http.Request)
+	GetGatewayEvents(w http.ResponseWriter, r *http.Request)
 }
 
 type gatewayController struct {
-	apiPlatformClient apiplatformclient.APIPlatformClient
-	db                *gorm.DB
+	apiPlatformClient   apiplatformclient.APIPlatformClient
+	db                  *gorm.DB
+	eventService        services.ResourceEventService
+	changeFreezeService services.ChangeFreezeService
 }
 
 // NewGatewayController creates a new gateway controller
-func NewGatewayController(apiPlatformClient apiplatformclient.APIPlatformClient, db *gorm.DB) GatewayController {
+func NewGatewayController(apiPlatformClient apiplatformclient.APIPlatformClient, db *gorm.DB, eventService services.ResourceEventService,
+	changeFreezeService services.ChangeFreezeService,
+) GatewayController {
 	return &gatewayController{
-		apiPlatformClient: apiPlatformClient,
-		db:                db,
+		apiPlatformClient:   apiPlatformClient,
+		db:                  db,
+		eventService:        eventService,
+		changeFreezeService: changeFreezeService,
 	}
 }
 
 func handleGatewayErrors(w http.ResponseWriter, err error, fallbackMsg string) {
 	switch {
-	case errors.Is(err, utils.ErrGatewayNotFound):
-		utils.WriteErrorResponse(w, http.StatusNotFound, "Gateway not found")
-	case errors.Is(err, utils.ErrGatewayAlreadyExists):
-		utils.WriteErrorResponse(w, http.StatusConflict, "Gateway already exists")
-	case errors.Is(err, utils.ErrEnvironmentNotFound):
-		utils.WriteErrorResponse(w, http.StatusNotFound, "Environment not found")
-	case errors.Is(err, utils.ErrInvalidInput):
-		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid input")
+	case errors.Is(err, utils.ErrPreconditionFailed):
+		utils.WriteErrorResponse(w, http.StatusPreconditionFailed, "Gateway has been modified")
 	case errors.Is(err, gorm.ErrRecordNotFound):
 		utils.WriteErrorResponse(w, http.StatusNotFound, "Resource not found")
 	default:
-		utils.WriteErrorResponse(w, http.StatusInternalServerError, fallbackMsg)
+		utils.WriteError(w, err, fallbackMsg)
 	}
 }
 
@@ -100,6 +110,27 @@ func (c *gatewayController) RegisterGateway(w http.ResponseWriter, r *http.Reque
 		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
 		return
 	}
+	if fieldErrors := utils.ValidateRequest(&req); fieldErrors != nil {
+		utils.WriteValidationError(w, "Invalid request body", fieldErrors)
+		return
+	}
+
+	region := req.GetRegion()
+	if err := services.ValidateRegion(region); err != nil {
+		utils.WriteError(w, err, "Invalid region")
+		return
+	}
+	// Environments in another region are rejected before the gateway is created
+	if err := checkEnvironmentRegions(ctx, orgName, req.EnvironmentIds, region); err != nil {
+		log.Error("RegisterGateway: gateway cannot serve the environments", "error", err)
+		handleGatewayErrors(w, err, "Failed to register gateway")
+		return
+	}
+	if err := services.CheckGatewayVhost(ctx, c.apiPlatformClient, req.Vhost); err != nil {
+		log.Error("RegisterGateway: vhost check failed", "error", err)
+		handleGatewayErrors(w, err, "Failed to register gateway")
+		return
+	}
 
 	// Convert spec request to API Platform client request
 	clientReq := apiplatformclient.CreateGatewayRequest{
@@ -109,6 +140,11 @@ func (c *gatewayController) RegisterGateway(w http.ResponseWriter, r *http.Reque
 		FunctionalityType: convertSpecGatewayTypeToFunctionalityType(req.GatewayType),
 		IsCritical:        req.IsCritical,
 	}
+	properties := map[string]interface{}{}
+	if region != "" {
+		properties[services.GatewayRegionProperty] = region
+	}
+	clientReq.Properties = services.GatewayProperties(orgName, properties)
 
 	// Create gateway in API Platform
 	gateway, err := c.apiPlatformClient.CreateGateway(ctx, clientReq)
@@ -118,13 +154,20 @@ func (c *gatewayController) RegisterGateway(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	actor := requestSubject(ctx)
+	c.eventService.RecordEvent(ctx, orgName, models.ResourceTypeGateway, gateway.ID, models.ResourceEventCreated, actor,
+		map[string]interface{}{"name": gateway.Name, "vhost": gateway.Vhost})
+
 	// Assign to environments if provided (using gateway_environment_mappings table)
 	if len(req.EnvironmentIds) > 0 {
 		for _, envID := range req.EnvironmentIds {
-			if err := c.assignGatewayToEnvironmentInDB(ctx, orgName, gateway.ID, envID); err != nil {
+			if err := c.assignGatewayToEnvironmentInDB(ctx, orgName, gateway.ID, envID, region); err != nil {
 				log.Warn("RegisterGateway: failed to assign gateway to environment", "envID", envID, "error", err)
 				// Continue with other environments
+				continue
 			}
+			c.eventService.RecordEvent(ctx, orgName, models.ResourceTypeGateway, gateway.ID, models.ResourceEventEnvironmentAssigned, actor,
+				map[string]interface{}{"environmentId": envID})
 		}
 	}
 
@@ -133,6 +176,7 @@ func (c *gatewayController) RegisterGateway(w http.ResponseWriter, r *http.Reque
 
 	// Convert to spec response
 	response := convertAPIPlatformGatewayToSpecResponse(gateway, orgName, environments)
+	utils.SetETagHeader(w, gateway)
 	utils.WriteSuccessResponse(w, http.StatusCreated, response)
 }
 
@@ -154,6 +198,7 @@ func (c *gatewayController) GetGateway(w http.ResponseWriter, r *http.Request) {
 	environments := c.getGatewayEnvironmentsFromDB(ctx, orgName, gatewayID)
 
 	response := convertAPIPlatformGatewayToSpecResponse(gateway, orgName, environments)
+	utils.SetETagHeader(w, gateway)
 	utils.WriteSuccessResponse(w, http.StatusOK, response)
 }
 
@@ -162,46 +207,82 @@ func (c *gatewayController) ListGateways(w http.ResponseWriter, r *http.Request)
 	log := logger.GetLogger(ctx)
 	orgName := r.PathValue(utils.PathParamOrgName)
 
-	// Parse pagination parameters
-	limit := getIntQueryParam(r, "limit", defaultLimit)
-	offset := getIntQueryParam(r, "offset", defaultOffset)
-
-	// Get gateways from API Platform
-	gateways, err := c.apiPlatformClient.ListGateways(ctx)
+	filters, matchesNone, err := parseGatewayFilters(r)
 	if err != nil {
-		log.Error("ListGateways: failed to list gateways from API Platform", "error", err)
-		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to list gateways")
+		log.Error("ListGateways: invalid query parameters", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-
-	// Convert to spec responses
-	specGateways := make([]spec.GatewayResponse, 0)
-	for _, gw := range gateways {
-		// Get environments from DB for each gateway
-		environments := c.getGatewayEnvironmentsFromDB(ctx, orgName, gw.ID)
-		specGateways = append(specGateways, convertAPIPlatformGatewayToSpecResponse(gw, orgName, environments))
+	region := r.URL.Query().Get("region")
+	if err := services.ValidateRegion(region); err != nil {
+		utils.WriteError(w, err, "Invalid region parameter")
+		return
+	}
+	sortOpt, fields, expand, err := parseGatewayListOptions(r)
+	if err != nil {
+		log.Error("ListGateways: invalid query parameters", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
 	}
 
-	// Apply pagination (client-side for now)
-	total := len(specGateways)
-	start := offset
-	end := offset + limit
-	if start > len(specGateways) {
-		start = len(specGateways)
+	// Filters and pagination are applied by API Platform, except for the region filter and sorting
+	gateways := &apiplatformclient.GatewayListResponse{}
+	if !matchesNone {
+		if region != "" || sortOpt != nil {
+			gateways, err = c.listGatewaysLocally(ctx, filters, region, sortOpt)
+		} else {
+			gateways, err = c.apiPlatformClient.ListGateways(ctx, filters)
+		}
+		if err != nil {
+			log.Error("ListGateways: failed to list gateways from API Platform", "error", err)
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to list gateways")
+			return
+		}
 	}
-	if end > len(specGateways) {
-		end = len(specGateways)
+
+	// Environments are only read when the response includes them
+	withEnvironments := len(fields) == 0 || slices.Contains(fields, gatewayFieldEnvironments) || slices.Contains(expand, gatewayFieldEnvironments)
+
+	// Convert to spec responses
+	specGateways := make([]spec.GatewayResponse, 0, len(gateways.Gateways))
+	for _, gw := range gateways.Gateways {
+		var environments []models.Environment
+		if withEnvironments {
+			environments = c.getGatewayEnvironmentsFromDB(ctx, orgName, gw.ID)
+		}
+		specGateways = append(specGateways, convertAPIPlatformGatewayToSpecResponse(gw, orgName, environments))
 	}
-	paginatedGateways := specGateways[start:end]
 
 	response := spec.GatewayListResponse{
-		Gateways: paginatedGateways,
-		Total:    int32(total),
-		Limit:    int32(limit),
-		Offset:   int32(offset),
+		Gateways: specGateways,
+		Total:    int32(gateways.Total),
+		Limit:    int32(*filters.Limit),
+		Offset:   int32(*filters.Offset),
+	}
+	if len(fields) == 0 {
+		utils.WriteSuccessResponse(w, http.StatusOK, response)
+		return
 	}
 
-	utils.WriteSuccessResponse(w, http.StatusOK, response)
+	// A sparse fieldset always carries the gateway UUID, and the relations it expands
+	fields = append(fields, "uuid")
+	fields = append(fields, expand...)
+	sparseGateways := make([]map[string]interface{}, 0, len(specGateways))
+	for _, gw := range specGateways {
+		item, err := gw.ToMap()
+		if err != nil {
+			log.Error("ListGateways: failed to convert gateway", "gatewayId", gw.Uuid, "error", err)
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to list gateways")
+			return
+		}
+		sparseGateways = append(sparseGateways, utils.SelectFields(item, fields))
+	}
+	utils.WriteSuccessResponse(w, http.StatusOK, sparseGatewayListResponse{
+		Gateways: sparseGateways,
+		Total:    response.Total,
+		Limit:    response.Limit,
+		Offset:   response.Offset,
+	})
 }
 
 func (c *gatewayController) UpdateGateway(w http.ResponseWriter, r *http.Request) {
@@ -216,6 +297,16 @@ func (c *gatewayController) UpdateGateway(w http.ResponseWriter, r *http.Request
 		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
 		return
 	}
+	if fieldErrors := utils.ValidateRequest(&req); fieldErrors != nil {
+		utils.WriteValidationError(w, "Invalid request body", fieldErrors)
+		return
+	}
+
+	if err := c.checkGatewayIfMatch(ctx, gatewayID, r.Header.Get(utils.HeaderIfMatch)); err != nil {
+		log.Error("UpdateGateway: precondition check failed", "error", err)
+		handleGatewayErrors(w, err, "Failed to update gateway")
+		return
+	}
 
 	// Convert spec request to API Platform client request
 	clientReq := apiplatformclient.UpdateGatewayRequest{
@@ -230,25 +321,36 @@ func (c *gatewayController) UpdateGateway(w http.ResponseWriter, r *http.Request
 		handleGatewayErrors(w, err, "Failed to update gateway")
 		return
 	}
+	c.eventService.RecordEvent(ctx, orgName, models.ResourceTypeGateway, gatewayID, models.ResourceEventUpdated, requestSubject(ctx),
+		map[string]interface{}{"displayName": gateway.DisplayName, "isCritical": gateway.IsCritical})
 
 	// Get environments from DB
 	environments := c.getGatewayEnvironmentsFromDB(ctx, orgName, gatewayID)
 
 	response := convertAPIPlatformGatewayToSpecResponse(gateway, orgName, environments)
+	utils.SetETagHeader(w, gateway)
 	utils.WriteSuccessResponse(w, http.StatusOK, response)
 }
 
 func (c *gatewayController) DeleteGateway(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	log := logger.GetLogger(ctx)
+	orgName := r.PathValue(utils.PathParamOrgName)
 	gatewayID := strings.TrimSpace(r.PathValue("gatewayID"))
 
+	if err := c.checkGatewayIfMatch(ctx, gatewayID, r.Header.Get(utils.HeaderIfMatch)); err != nil {
+		log.Error("DeleteGateway: precondition check failed", "error", err)
+		handleGatewayErrors(w, err, "Failed to delete gateway")
+		return
+	}
+
 	// Delete from API Platform
 	if err := c.apiPlatformClient.DeleteGateway(ctx, gatewayID); err != nil {
 		log.Error("DeleteGateway: failed to delete gateway from API Platform", "error", err)
 		handleGatewayErrors(w, err, "Failed to delete gateway")
 		return
 	}
+	c.eventService.RecordEvent(ctx, orgName, models.ResourceTypeGateway, gatewayID, models.ResourceEventDeleted, requestSubject(ctx), nil)
 
 	// Delete environment mappings from DB
 	gwUUID, err := uuid.Parse(gatewayID)
@@ -269,18 +371,21 @@ func (c *gatewayController) AssignGatewayToEnvironment(w http.ResponseWriter, r
 	envID := strings.TrimSpace(r.PathValue("envID"))
 
 	// Verify gateway exists in API Platform
-	if _, err := c.apiPlatformClient.GetGateway(ctx, gatewayID); err != nil {
+	gateway, err := c.apiPlatformClient.GetGateway(ctx, gatewayID)
+	if err != nil {
 		log.Error("AssignGatewayToEnvironment: gateway not found in API Platform", "error", err)
 		handleGatewayErrors(w, err, "Failed to assign gateway")
 		return
 	}
 
 	// Assign in DB
-	if err := c.assignGatewayToEnvironmentInDB(ctx, orgName, gatewayID, envID); err != nil {
+	if err := c.assignGatewayToEnvironmentInDB(ctx, orgName, gatewayID, envID, services.GatewayRegion(gateway)); err != nil {
 		log.Error("AssignGatewayToEnvironment: failed to assign", "error", err)
 		handleGatewayErrors(w, err, "Failed to assign gateway to environment")
 		return
 	}
+	c.eventService.RecordEvent(ctx, orgName, models.ResourceTypeGateway, gatewayID, models.ResourceEventEnvironmentAssigned, requestSubject(ctx),
+		map[string]interface{}{"environmentId": envID})
 
 	utils.WriteSuccessResponse(w, http.StatusCreated, map[string]string{"message": "Gateway assigned successfully"})
 }
@@ -288,6 +393,7 @@ func (c *gatewayController) AssignGatewayToEnvironment(w http.ResponseWriter, r
 func (c *gatewayController) RemoveGatewayFromEnvironment(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	log := logger.GetLogger(ctx)
+	orgName := r.PathValue(utils.PathParamOrgName)
 	gatewayID := strings.TrimSpace(r.PathValue("gatewayID"))
 	envID := strings.TrimSpace(r.PathValue("envID"))
 
@@ -319,6 +425,8 @@ func (c *gatewayController) RemoveGatewayFromEnvironment(w http.ResponseWriter,
 		utils.WriteErrorResponse(w, http.StatusNotFound, "Gateway-environment mapping not found")
 		return
 	}
+	c.eventService.RecordEvent(ctx, orgName, models.ResourceTypeGateway, gatewayID, models.ResourceEventEnvironmentRemoved, requestSubject(ctx),
+		map[string]interface{}{"environmentId": envID})
 
 	utils.WriteSuccessResponse(w, http.StatusNoContent, struct{}{})
 }
@@ -347,6 +455,7 @@ func (c *gatewayController) GetGatewayEnvironments(w http.ResponseWriter, r *htt
 func (c *gatewayController) CheckGatewayHealth(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	log := logger.GetLogger(ctx)
+	orgName := r.PathValue(utils.PathParamOrgName)
 	gatewayID := strings.TrimSpace(r.PathValue("gatewayID"))
 
 	// Get gateway from API Platform to check if it exists
@@ -362,6 +471,7 @@ func (c *gatewayController) CheckGatewayHealth(w http.ResponseWriter, r *http.Re
 	if !gateway.IsActive {
 		status = "unhealthy"
 	}
+	c.recordHealthChange(ctx, orgName, gatewayID, status)
 
 	response := spec.HealthStatusResponse{
 		GatewayId: gatewayID,
@@ -372,11 +482,65 @@ func (c *gatewayController) CheckGatewayHealth(w http.ResponseWriter, r *http.Re
 	utils.WriteSuccessResponse(w, http.StatusOK, response)
 }
 
+// DiagnoseGateway runs the connectivity checks of a gateway through the API Platform adapter.
+// The report is returned with 200 whatever the outcome of the checks.
+func (c *gatewayController) DiagnoseGateway(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+	gatewayID := strings.TrimSpace(r.PathValue("gatewayID"))
+
+	if _, err := c.apiPlatformClient.GetGateway(ctx, gatewayID); err != nil {
+		log.Error("DiagnoseGateway: gateway not found", "error", err)
+		handleGatewayErrors(w, err, "Failed to diagnose gateway")
+		return
+	}
+
+	diagnostics, err := c.apiPlatformClient.DiagnoseGateway(ctx, gatewayID)
+	if err != nil {
+		log.Error("DiagnoseGateway: failed to diagnose gateway", "gatewayId", gatewayID, "error", err)
+		handleGatewayErrors(w, err, "Failed to diagnose gateway")
+		return
+	}
+
+	response := models.GatewayDiagnosticsResponse{
+		GatewayID:       gatewayID,
+		Status:          models.GatewayDiagnosisHealthy,
+		ControlPlaneURL: diagnostics.ControlPlaneURL,
+		Checks:          make([]models.GatewayDiagnosticCheck, 0, len(diagnostics.Checks)),
+		CheckedAt:       time.Now().UTC().Format(time.RFC3339),
+	}
+	for _, check := range diagnostics.Checks {
+		response.Checks = append(response.Checks, models.GatewayDiagnosticCheck{
+			Name:       check.Name,
+			Status:     check.Status,
+			Message:    check.Message,
+			DurationMs: check.Duration.Milliseconds(),
+		})
+		switch {
+		case check.Status == apiplatformclient.DiagnosticStatusFail:
+			response.Status = models.GatewayDiagnosisUnhealthy
+		case check.Status == apiplatformclient.DiagnosticStatusWarn && response.Status == models.GatewayDiagnosisHealthy:
+			response.Status = models.GatewayDiagnosisDegraded
+		}
+	}
+	log.Info("DiagnoseGateway: diagnosed gateway", "gatewayId", gatewayID, "status", response.Status)
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
 func (c *gatewayController) RotateGatewayToken(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	log := logger.GetLogger(ctx)
+	orgName := r.PathValue(utils.PathParamOrgName)
 	gatewayID := strings.TrimSpace(r.PathValue("gatewayID"))
 
+	if err := c.changeFreezeService.CheckChangeAllowed(ctx, orgName, models.ChangeOperationTokenRotation, "gateways/"+gatewayID,
+		requestSubject(ctx), r.Header.Get(utils.HeaderBreakGlassReason)); err != nil {
+		log.Warn("RotateGatewayToken: token rotation is blocked", "error", err)
+		handleGatewayErrors(w, err, "Failed to rotate gateway token")
+		return
+	}
+
 	// Call API Platform to rotate the token
 	tokenResp, err := c.apiPlatformClient.RotateGatewayToken(ctx, gatewayID)
 	if err != nil {
@@ -384,6 +548,8 @@ func (c *gatewayController) RotateGatewayToken(w http.ResponseWriter, r *http.Re
 		handleGatewayErrors(w, err, "Failed to rotate gateway token")
 		return
 	}
+	c.eventService.RecordEvent(ctx, orgName, models.ResourceTypeGateway, gatewayID, models.ResourceEventTokenRotated, requestSubject(ctx),
+		map[string]interface{}{"tokenId": tokenResp.TokenID})
 
 	// Convert to spec response
 	response := spec.GatewayTokenResponse{
@@ -400,9 +566,17 @@ func (c *gatewayController) RotateGatewayToken(w http.ResponseWriter, r *http.Re
 func (c *gatewayController) RevokeGatewayToken(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	log := logger.GetLogger(ctx)
+	orgName := r.PathValue(utils.PathParamOrgName)
 	gatewayID := strings.TrimSpace(r.PathValue("gatewayID"))
 	tokenID := strings.TrimSpace(r.PathValue("tokenID"))
 
+	if err := c.changeFreezeService.CheckChangeAllowed(ctx, orgName, models.ChangeOperationTokenRevocation, "gateways/"+gatewayID+"/tokens/"+tokenID,
+		requestSubject(ctx), r.Header.Get(utils.HeaderBreakGlassReason)); err != nil {
+		log.Warn("RevokeGatewayToken: token revocation is blocked", "error", err)
+		handleGatewayErrors(w, err, "Failed to revoke gateway token")
+		return
+	}
+
 	// Call API Platform to revoke the token
 	err := c.apiPlatformClient.RevokeGatewayToken(ctx, gatewayID, tokenID)
 	if err != nil {
@@ -410,14 +584,130 @@ func (c *gatewayController) RevokeGatewayToken(w http.ResponseWriter, r *http.Re
 		handleGatewayErrors(w, err, "Failed to revoke gateway token")
 		return
 	}
+	c.eventService.RecordEvent(ctx, orgName, models.ResourceTypeGateway, gatewayID, models.ResourceEventTokenRevoked, requestSubject(ctx),
+		map[string]interface{}{"tokenId": tokenID})
 
 	utils.WriteSuccessResponse(w, http.StatusNoContent, struct{}{})
 }
 
+func (c *gatewayController) GetGatewayEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+	orgName := r.PathValue(utils.PathParamOrgName)
+	gatewayID := strings.TrimSpace(r.PathValue("gatewayID"))
+
+	limit := getIntQueryParam(r, "limit", utils.DefaultLimit)
+	offset := getIntQueryParam(r, "offset", utils.DefaultOffset)
+	if limit < utils.MinLimit || limit > utils.MaxLimit {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid limit parameter")
+		return
+	}
+	if offset < 0 {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid offset parameter")
+		return
+	}
+
+	// The timeline outlives the gateway, so the events of a deleted gateway can still be read
+	events, err := c.eventService.ListEvents(ctx, orgName, models.ResourceTypeGateway, gatewayID, limit, offset)
+	if err != nil {
+		log.Error("GetGatewayEvents: failed to list gateway events", "gatewayId", gatewayID, "error", err)
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to list gateway events")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, events)
+}
+
 // Internal helper methods
 
+// recordHealthChange adds a health change to the timeline of a gateway when its health differs
+// from the last one recorded
+func (c *gatewayController) recordHealthChange(ctx context.Context, orgName, gatewayID, status string) {
+	last, err := c.eventService.LastEvent(ctx, orgName, models.ResourceTypeGateway, gatewayID, models.ResourceEventHealthChanged)
+	if err != nil {
+		logger.GetLogger(ctx).Warn("Failed to read the last gateway health change", "gatewayId", gatewayID, "error", err)
+		return
+	}
+	if last != nil && last.Details["status"] == status {
+		return
+	}
+	c.eventService.RecordEvent(ctx, orgName, models.ResourceTypeGateway, gatewayID, models.ResourceEventHealthChanged, "",
+		map[string]interface{}{"status": status})
+}
+
+// requestSubject returns the subject of the token the request was made with
+func requestSubject(ctx context.Context) string {
+	if claims := jwtassertion.GetTokenClaims(ctx); claims != nil {
+		return claims.Sub
+	}
+	return ""
+}
+
+// checkGatewayIfMatch compares an If-Match header with the current ETag of a gateway.
+// Gateways are stored in the API Platform, so the check cannot be made atomic with the
+// change that follows it.
+func (c *gatewayController) checkGatewayIfMatch(ctx context.Context, gatewayID, ifMatch string) error {
+	if strings.TrimSpace(ifMatch) == "" {
+		return nil
+	}
+	// The ETag must be compared with the current gateway, not one cached by this replica
+	current, err := c.apiPlatformClient.GetGateway(apiplatformclient.WithoutCache(ctx), gatewayID)
+	if err != nil {
+		return err
+	}
+	return utils.CheckIfMatch(ifMatch, current)
+}
+
+// listGatewaysLocally lists every gateway matching filters from API Platform, then applies the
+// region filter, sorting and pagination that API Platform does not support
+func (c *gatewayController) listGatewaysLocally(ctx context.Context, filters apiplatformclient.GatewayFilters, region string, sortOpt *utils.SortOption) (*apiplatformclient.GatewayListResponse, error) {
+	limit, offset := *filters.Limit, *filters.Offset
+	filters.Limit, filters.Offset = nil, nil
+	all, err := c.apiPlatformClient.ListGateways(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	matching := make([]*apiplatformclient.GatewayResponse, 0, len(all.Gateways))
+	for _, gw := range all.Gateways {
+		if region == "" || services.GatewayRegion(gw) == region {
+			matching = append(matching, gw)
+		}
+	}
+	if sortOpt != nil {
+		sortGateways(matching, sortOpt)
+	}
+	start := min(offset, len(matching))
+	end := min(start+limit, len(matching))
+	return &apiplatformclient.GatewayListResponse{
+		Gateways: matching[start:end],
+		Total:    len(matching),
+		Limit:    limit,
+		Offset:   offset,
+	}, nil
+}
+
+// checkEnvironmentRegions checks that a gateway in the given region can serve the environments.
+// Environments that do not exist are left to the assignment to report.
+func checkEnvironmentRegions(ctx context.Context, orgName string, envIDs []string, region string) error {
+	if len(envIDs) == 0 {
+		return nil
+	}
+	var environments []models.Environment
+	if err := db.DB(ctx).Where("uuid IN ? AND organization_name = ?", envIDs, orgName).Find(&environments).Error; err != nil {
+		return fmt.Errorf("failed to get environments: %w", err)
+	}
+	for i := range environments {
+		if err := services.CheckGatewayRegion(&environments[i], region); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // assignGatewayToEnvironmentInDB creates a mapping in the gateway_environment_mappings table
-func (c *gatewayController) assignGatewayToEnvironmentInDB(ctx context.Context, orgName, gatewayID, envID string) error {
+// after checking that a gateway in gatewayRegion can serve the environment
+func (c *gatewayController) assignGatewayToEnvironmentInDB(ctx context.Context, orgName, gatewayID, envID, gatewayRegion string) error {
 	log := logger.GetLogger(ctx)
 
 	gwUUID, err := uuid.Parse(gatewayID)
@@ -438,6 +728,9 @@ func (c *gatewayController) assignGatewayToEnvironmentInDB(ctx context.Context,
 		}
 		return fmt.Errorf("failed to verify environment: %w", err)
 	}
+	if err := services.CheckGatewayRegion(&env, gatewayRegion); err != nil {
+		return err
+	}
 
 	// Check if mapping already exists
 	var existing models.GatewayEnvironmentMapping
@@ -512,6 +805,9 @@ func convertAPIPlatformGatewayToSpecResponse(gw *apiplatformclient.GatewayRespon
 		CreatedAt:        gw.CreatedAt,
 		UpdatedAt:        gw.UpdatedAt,
 	}
+	if region := services.GatewayRegion(gw); region != "" {
+		response.Region = &region
+	}
 
 	// Convert environments
 	if len(environments) > 0 {
@@ -525,6 +821,111 @@ func convertAPIPlatformGatewayToSpecResponse(gw *apiplatformclient.GatewayRespon
 	return response
 }
 
+// parseGatewayFilters reads the type, status and pagination query parameters of a gateway list
+// request. matchesNone is set for filters that no gateway of API Platform can match.
+func parseGatewayFilters(r *http.Request) (filters apiplatformclient.GatewayFilters, matchesNone bool, err error) {
+	query := r.URL.Query()
+
+	limitStr := query.Get("limit")
+	if limitStr == "" {
+		limitStr = strconv.Itoa(defaultLimit)
+	}
+	offsetStr := query.Get("offset")
+	if offsetStr == "" {
+		offsetStr = strconv.Itoa(defaultOffset)
+	}
+
+	limit, err := strconv.Atoi(limitStr)
+	if err != nil || limit < 1 || limit > maxLimit {
+		return filters, false, fmt.Errorf("invalid limit parameter: must be between 1 and %d", maxLimit)
+	}
+	offset, err := strconv.Atoi(offsetStr)
+	if err != nil || offset < 0 {
+		return filters, false, fmt.Errorf("invalid offset parameter: must be 0 or greater")
+	}
+	filters.Limit = &limit
+	filters.Offset = &offset
+
+	if gatewayType := query.Get("type"); gatewayType != "" {
+		switch functionalityType := apiplatformclient.FunctionalityType(strings.ToLower(gatewayType)); functionalityType {
+		case apiplatformclient.FunctionalityTypeAI, apiplatformclient.FunctionalityTypeRegular:
+			filters.FunctionalityType = &functionalityType
+		default:
+			return filters, false, fmt.Errorf("invalid type parameter: must be AI or REGULAR")
+		}
+	}
+
+	// API Platform only knows whether a gateway is active, so the other statuses select no gateway
+	if status := query.Get("status"); status != "" {
+		switch status {
+		case "ACTIVE", "INACTIVE":
+			isActive := status == "ACTIVE"
+			filters.IsActive = &isActive
+		case "PROVISIONING", "ERROR":
+			matchesNone = true
+		default:
+			return filters, false, fmt.Errorf("invalid status parameter: must be ACTIVE, INACTIVE, PROVISIONING or ERROR")
+		}
+	}
+
+	return filters, matchesNone, nil
+}
+
+// gatewayFieldEnvironments is the environments of a gateway, the one relation a gateway list can expand
+const gatewayFieldEnvironments = "environments"
+
+// Fields a gateway list can be sorted by, and return with fields=
+var (
+	gatewaySortFields = []string{"name", "displayName", "createdAt", "updatedAt"}
+	gatewayFields     = []string{"uuid", "organizationName", "name", "displayName", "gatewayType", "vhost", "region", "isCritical", "status", "createdAt", "updatedAt", gatewayFieldEnvironments}
+)
+
+// sparseGatewayListResponse is a gateway list holding only the fields requested with fields=
+type sparseGatewayListResponse struct {
+	Gateways []map[string]interface{} `json:"gateways"`
+	Total    int32                    `json:"total"`
+	Limit    int32                    `json:"limit"`
+	Offset   int32                    `json:"offset"`
+}
+
+// parseGatewayListOptions reads the sort, fields and expand query parameters of a gateway list request
+func parseGatewayListOptions(r *http.Request) (sortOpt *utils.SortOption, fields []string, expand []string, err error) {
+	query := r.URL.Query()
+	if sortOpt, err = utils.ParseSortParam(query.Get("sort"), gatewaySortFields); err != nil {
+		return nil, nil, nil, err
+	}
+	if fields, err = utils.ParseListParam("fields", query.Get("fields"), gatewayFields); err != nil {
+		return nil, nil, nil, err
+	}
+	if expand, err = utils.ParseListParam("expand", query.Get("expand"), []string{gatewayFieldEnvironments}); err != nil {
+		return nil, nil, nil, err
+	}
+	return sortOpt, fields, expand, nil
+}
+
+// sortGateways sorts gateways by a field, falling back to the name for equal values
+func sortGateways(gateways []*apiplatformclient.GatewayResponse, sortOpt *utils.SortOption) {
+	sort.SliceStable(gateways, func(i, j int) bool {
+		a, b := gateways[i], gateways[j]
+		if sortOpt.Descending {
+			a, b = b, a
+		}
+		var cmp int
+		switch sortOpt.Field {
+		case "displayName":
+			cmp = strings.Compare(strings.ToLower(a.DisplayName), strings.ToLower(b.DisplayName))
+		case "createdAt":
+			cmp = a.CreatedAt.Compare(b.CreatedAt)
+		case "updatedAt":
+			cmp = a.UpdatedAt.Compare(b.UpdatedAt)
+		}
+		if cmp == 0 {
+			cmp = strings.Compare(a.Name, b.Name)
+		}
+		return cmp < 0
+	})
+}
+
 func convertAPIPlatformStatusToGatewayStatus(isActive bool) spec.GatewayStatus {
 	if isActive {
 		return "ACTIVE"
@@ -533,7 +934,7 @@ func convertAPIPlatformStatusToGatewayStatus(isActive bool) spec.GatewayStatus {
 }
 
 func convertDBEnvironmentToSpecResponse(env *models.Environment) spec.GatewayEnvironmentResponse {
-	return spec.GatewayEnvironmentResponse{
+	response := spec.GatewayEnvironmentResponse{
 		Id:               env.UUID.String(),
 		OrganizationName: env.OrganizationName,
 		Name:             env.Name,
@@ -544,4 +945,8 @@ func convertDBEnvironmentToSpecResponse(env *models.Environment) spec.GatewayEnv
 		CreatedAt:        env.CreatedAt,
 		UpdatedAt:        env.UpdatedAt,
 	}
+	if env.Region != "" {
+		response.Region = &env.Region
+	}
+	return response
 }
@@ -18,7 +18,6 @@ package controllers
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -85,7 +84,7 @@ func (c *infraResourceController) ListOrganizations(w http.ResponseWriter, r *ht
 	orgs, total, err := c.infraResourceManager.ListOrganizations(ctx, limit, offset)
 	if err != nil {
 		log.Error("ListOrganizations: failed to list organizations", "error", err)
-		handleCommonErrors(w, err, "Failed to list organizations")
+		utils.WriteError(w, err, "Failed to list organizations")
 		return
 	}
 
@@ -109,7 +108,7 @@ func (c *infraResourceController) GetOrganization(w http.ResponseWriter, r *http
 	org, err := c.infraResourceManager.GetOrganization(ctx, orgName)
 	if err != nil {
 		log.Error("GetOrganization: failed to get organization", "error", err)
-		handleCommonErrors(w, err, "Failed to get organization")
+		utils.WriteError(w, err, "Failed to get organization")
 		return
 	}
 
@@ -152,7 +151,7 @@ func (c *infraResourceController) ListProjects(w http.ResponseWriter, r *http.Re
 	projects, total, err := c.infraResourceManager.ListProjects(ctx, orgName, limit, offset)
 	if err != nil {
 		log.Error("ListProjects: failed to list projects", "error", err)
-		handleCommonErrors(w, err, "Failed to list projects")
+		utils.WriteError(w, err, "Failed to list projects")
 		return
 	}
 	projectList := utils.ConvertToProjectListResponse(projects)
@@ -201,7 +200,7 @@ func (c *infraResourceController) CreateProject(w http.ResponseWriter, r *http.R
 	project, err := c.infraResourceManager.CreateProject(ctx, orgName, payload)
 	if err != nil {
 		log.Error("CreateProject: failed to create project", "error", err)
-		handleCommonErrors(w, err, "Failed to create project")
+		utils.WriteError(w, err, "Failed to create project")
 		return
 	}
 	projectResponse := spec.ProjectResponse{
@@ -241,7 +240,7 @@ func (c *infraResourceController) UpdateProject(w http.ResponseWriter, r *http.R
 	project, err := c.infraResourceManager.UpdateProject(ctx, orgName, projectName, payload)
 	if err != nil {
 		log.Error("UpdateProject: failed to update project", "error", err)
-		handleCommonErrors(w, err, "Failed to update project")
+		utils.WriteError(w, err, "Failed to update project")
 		return
 	}
 
@@ -268,7 +267,7 @@ func (c *infraResourceController) DeleteProject(w http.ResponseWriter, r *http.R
 	err := c.infraResourceManager.DeleteProject(ctx, orgName, projectName)
 	if err != nil {
 		log.Error("DeleteProject: failed to delete project", "error", err)
-		handleCommonErrors(w, err, "Failed to delete project")
+		utils.WriteError(w, err, "Failed to delete project")
 		return
 	}
 
@@ -310,7 +309,7 @@ func (c *infraResourceController) ListOrgDeploymentPipelines(w http.ResponseWrit
 	deploymentPipelines, total, err := c.infraResourceManager.ListOrgDeploymentPipelines(ctx, orgName, limit, offset)
 	if err != nil {
 		log.Error("ListOrgDeploymentPipelines: failed to get deployment pipelines", "error", err)
-		handleCommonErrors(w, err, "Failed to get deployment pipelines")
+		utils.WriteError(w, err, "Failed to get deployment pipelines")
 		return
 	}
 
@@ -329,15 +328,7 @@ func (c *infraResourceController) GetProject(w http.ResponseWriter, r *http.Requ
 	project, err := c.infraResourceManager.GetProject(ctx, orgName, projectName)
 	if err != nil {
 		log.Error("GetProject: failed to get project", "error", err)
-		if errors.Is(err, utils.ErrOrganizationNotFound) {
-			utils.WriteErrorResponse(w, http.StatusNotFound, "Organization not found")
-			return
-		}
-		if errors.Is(err, utils.ErrProjectNotFound) {
-			utils.WriteErrorResponse(w, http.StatusNotFound, "Project not found")
-			return
-		}
-		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to get project")
+		utils.WriteError(w, err, "Failed to get project")
 		return
 	}
 
@@ -356,7 +347,7 @@ func (c *infraResourceController) ListOrgEnvironments(w http.ResponseWriter, r *
 	environments, err := c.infraResourceManager.ListOrgEnvironments(ctx, orgName)
 	if err != nil {
 		log.Error("ListOrgEnvironments: failed to get environments", "error", err)
-		handleCommonErrors(w, err, "Failed to get environments")
+		utils.WriteError(w, err, "Failed to get environments")
 		return
 	}
 	environmentsListResponse := utils.ConvertToEnvironmentListResponse(environments)
@@ -374,7 +365,7 @@ func (c *infraResourceController) GetProjectDeploymentPipeline(w http.ResponseWr
 	deploymentPipeline, err := c.infraResourceManager.GetProjectDeploymentPipeline(ctx, orgName, projectName)
 	if err != nil {
 		log.Error("GetProjectDeploymentPipeline: failed to get deployment pipeline", "error", err)
-		handleCommonErrors(w, err, "Failed to get deployment pipeline")
+		utils.WriteError(w, err, "Failed to get deployment pipeline")
 		return
 	}
 
@@ -392,7 +383,7 @@ func (c *infraResourceController) GetDataplanes(w http.ResponseWriter, r *http.R
 	dataplanes, err := c.infraResourceManager.GetDataplanes(ctx, orgName)
 	if err != nil {
 		log.Error("GetDataplanes: failed to get dataplanes", "error", err)
-		handleCommonErrors(w, err, "Failed to list dataplanes")
+		utils.WriteError(w, err, "Failed to list dataplanes")
 		return
 	}
 	dataplaneListResponse := utils.ConvertToDataPlaneListResponse(dataplanes)
@@ -0,0 +1,214 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// MCPServerController defines the interface for MCP server registry HTTP handlers
+type MCPServerController interface {
+	CreateMCPServer(w http.ResponseWriter, r *http.Request)
+	ListMCPServers(w http.ResponseWriter, r *http.Request)
+	GetMCPServer(w http.ResponseWriter, r *http.Request)
+	UpdateMCPServer(w http.ResponseWriter, r *http.Request)
+	DeleteMCPServer(w http.ResponseWriter, r *http.Request)
+	RefreshMCPServerTools(w http.ResponseWriter, r *http.Request)
+	ListAgentMCPServers(w http.ResponseWriter, r *http.Request)
+	SetAgentMCPServers(w http.ResponseWriter, r *http.Request)
+}
+
+type mcpServerController struct {
+	mcpServerService services.MCPServerService
+}
+
+// NewMCPServerController creates a new MCP server controller
+func NewMCPServerController(mcpServerService services.MCPServerService) MCPServerController {
+	return &mcpServerController{
+		mcpServerService: mcpServerService,
+	}
+}
+
+func (c *mcpServerController) CreateMCPServer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	var req models.CreateMCPServerRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error("CreateMCPServer: failed to decode request", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if fieldErrors := utils.ValidateRequest(&req); fieldErrors != nil {
+		utils.WriteValidationError(w, "Invalid request body", fieldErrors)
+		return
+	}
+
+	server, err := c.mcpServerService.CreateMCPServer(ctx, orgName, &req)
+	if err != nil {
+		log.Error("CreateMCPServer: failed to register MCP server", "orgName", orgName, "name", req.Name, "error", err)
+		utils.WriteError(w, err, "Failed to register MCP server")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusCreated, server)
+}
+
+func (c *mcpServerController) ListMCPServers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	servers, err := c.mcpServerService.ListMCPServers(ctx, orgName)
+	if err != nil {
+		log.Error("ListMCPServers: failed to list MCP servers", "orgName", orgName, "error", err)
+		utils.WriteError(w, err, "Failed to list MCP servers")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, models.MCPServerListResponse{MCPServers: servers})
+}
+
+func (c *mcpServerController) GetMCPServer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	name := r.PathValue(utils.PathParamMCPServerName)
+
+	server, err := c.mcpServerService.GetMCPServer(ctx, orgName, name)
+	if err != nil {
+		log.Error("GetMCPServer: failed to get MCP server", "orgName", orgName, "name", name, "error", err)
+		utils.WriteError(w, err, "Failed to get MCP server")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, server)
+}
+
+func (c *mcpServerController) UpdateMCPServer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	name := r.PathValue(utils.PathParamMCPServerName)
+
+	var req models.UpdateMCPServerRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error("UpdateMCPServer: failed to decode request", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if fieldErrors := utils.ValidateRequest(&req); fieldErrors != nil {
+		utils.WriteValidationError(w, "Invalid request body", fieldErrors)
+		return
+	}
+
+	server, err := c.mcpServerService.UpdateMCPServer(ctx, orgName, name, &req)
+	if err != nil {
+		log.Error("UpdateMCPServer: failed to update MCP server", "orgName", orgName, "name", name, "error", err)
+		utils.WriteError(w, err, "Failed to update MCP server")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, server)
+}
+
+func (c *mcpServerController) DeleteMCPServer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	name := r.PathValue(utils.PathParamMCPServerName)
+
+	if err := c.mcpServerService.DeleteMCPServer(ctx, orgName, name); err != nil {
+		log.Error("DeleteMCPServer: failed to delete MCP server", "orgName", orgName, "name", name, "error", err)
+		utils.WriteError(w, err, "Failed to delete MCP server")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (c *mcpServerController) RefreshMCPServerTools(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	name := r.PathValue(utils.PathParamMCPServerName)
+
+	server, err := c.mcpServerService.RefreshMCPServerTools(ctx, orgName, name)
+	if err != nil {
+		log.Error("RefreshMCPServerTools: failed to refresh MCP server tools", "orgName", orgName, "name", name, "error", err)
+		utils.WriteError(w, err, "Failed to refresh MCP server tools")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, server)
+}
+
+func (c *mcpServerController) ListAgentMCPServers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+
+	servers, err := c.mcpServerService.ListAgentMCPServers(ctx, orgName, projName, agentName)
+	if err != nil {
+		log.Error("ListAgentMCPServers: failed to list agent MCP servers", "agentName", agentName, "error", err)
+		utils.WriteError(w, err, "Failed to list agent MCP servers")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, models.MCPServerListResponse{MCPServers: servers})
+}
+
+func (c *mcpServerController) SetAgentMCPServers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+
+	var req models.AgentMCPServersRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error("SetAgentMCPServers: failed to decode request", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	servers, err := c.mcpServerService.SetAgentMCPServers(ctx, orgName, projName, agentName, req.MCPServers)
+	if err != nil {
+		log.Error("SetAgentMCPServers: failed to set agent MCP servers", "agentName", agentName, "error", err)
+		utils.WriteError(w, err, "Failed to set agent MCP servers")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, models.MCPServerListResponse{MCPServers: servers})
+}
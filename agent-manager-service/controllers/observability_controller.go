@@ -17,12 +17,16 @@
 package controllers
 
 import (
+	"encoding/json"
 	"errors"
+	"io"
 	"net/http"
 	"strconv"
 	"time"
 
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
 )
@@ -38,6 +42,71 @@ type ObservabilityController interface {
 	ListTraces(w http.ResponseWriter, r *http.Request)
 	ExportTraces(w http.ResponseWriter, r *http.Request)
 	GetTrace(w http.ResponseWriter, r *http.Request)
+	GetTraceLogs(w http.ResponseWriter, r *http.Request)
+	ReplayTrace(w http.ResponseWriter, r *http.Request)
+	ListTraceReplays(w http.ResponseWriter, r *http.Request)
+	CreateGoldenTrace(w http.ResponseWriter, r *http.Request)
+	ListGoldenTraces(w http.ResponseWriter, r *http.Request)
+	GetGoldenTrace(w http.ResponseWriter, r *http.Request)
+	DeleteGoldenTrace(w http.ResponseWriter, r *http.Request)
+	RunGoldenTrace(w http.ResponseWriter, r *http.Request)
+	ListGoldenTraceRuns(w http.ResponseWriter, r *http.Request)
+	GetGoldenTraceRun(w http.ResponseWriter, r *http.Request)
+	GetTraceScoringPolicy(w http.ResponseWriter, r *http.Request)
+	SetTraceScoringPolicy(w http.ResponseWriter, r *http.Request)
+	DeleteTraceScoringPolicy(w http.ResponseWriter, r *http.Request)
+	ScoreTrace(w http.ResponseWriter, r *http.Request)
+	GetTraceScores(w http.ResponseWriter, r *http.Request)
+	GetTraceScoreSummary(w http.ResponseWriter, r *http.Request)
+	CreateAgentSLO(w http.ResponseWriter, r *http.Request)
+	ListAgentSLOs(w http.ResponseWriter, r *http.Request)
+	GetAgentSLO(w http.ResponseWriter, r *http.Request)
+	UpdateAgentSLO(w http.ResponseWriter, r *http.Request)
+	DeleteAgentSLO(w http.ResponseWriter, r *http.Request)
+	GetAgentSLOStatus(w http.ResponseWriter, r *http.Request)
+	GetAgentSLOHistory(w http.ResponseWriter, r *http.Request)
+	CreateAgentTokenBudget(w http.ResponseWriter, r *http.Request)
+	ListAgentTokenBudgets(w http.ResponseWriter, r *http.Request)
+	GetAgentTokenBudget(w http.ResponseWriter, r *http.Request)
+	UpdateAgentTokenBudget(w http.ResponseWriter, r *http.Request)
+	DeleteAgentTokenBudget(w http.ResponseWriter, r *http.Request)
+	ListAgentTokenBudgetEvents(w http.ResponseWriter, r *http.Request)
+	GetModelUsage(w http.ResponseWriter, r *http.Request)
+	GetTraceRetention(w http.ResponseWriter, r *http.Request)
+	SetTraceRetention(w http.ResponseWriter, r *http.Request)
+	DeleteTraceRetention(w http.ResponseWriter, r *http.Request)
+	GetTraceContentVisibility(w http.ResponseWriter, r *http.Request)
+	SetTraceContentVisibility(w http.ResponseWriter, r *http.Request)
+	GetTraceStorageUsage(w http.ResponseWriter, r *http.Request)
+	CreateTraceErasure(w http.ResponseWriter, r *http.Request)
+	ListTraceErasures(w http.ResponseWriter, r *http.Request)
+	GetTraceErasure(w http.ResponseWriter, r *http.Request)
+	GenerateUsageReport(w http.ResponseWriter, r *http.Request)
+	ListUsageReports(w http.ResponseWriter, r *http.Request)
+	GetUsageReport(w http.ResponseWriter, r *http.Request)
+	DownloadUsageReport(w http.ResponseWriter, r *http.Request)
+	SetCostCenterMapping(w http.ResponseWriter, r *http.Request)
+	ListCostCenterMappings(w http.ResponseWriter, r *http.Request)
+	DeleteCostCenterMapping(w http.ResponseWriter, r *http.Request)
+	GetCostCenterUsage(w http.ResponseWriter, r *http.Request)
+	CreateNotificationDigest(w http.ResponseWriter, r *http.Request)
+	ListNotificationDigests(w http.ResponseWriter, r *http.Request)
+	GetNotificationDigest(w http.ResponseWriter, r *http.Request)
+	UpdateNotificationDigest(w http.ResponseWriter, r *http.Request)
+	DeleteNotificationDigest(w http.ResponseWriter, r *http.Request)
+	SendNotificationDigest(w http.ResponseWriter, r *http.Request)
+	CreateEmailRecipient(w http.ResponseWriter, r *http.Request)
+	ListEmailRecipients(w http.ResponseWriter, r *http.Request)
+	GetEmailRecipient(w http.ResponseWriter, r *http.Request)
+	UpdateEmailRecipient(w http.ResponseWriter, r *http.Request)
+	DeleteEmailRecipient(w http.ResponseWriter, r *http.Request)
+	SendEmailRecipientVerification(w http.ResponseWriter, r *http.Request)
+	VerifyEmailRecipient(w http.ResponseWriter, r *http.Request)
+	GetTraceSamplingPolicy(w http.ResponseWriter, r *http.Request)
+	SetTraceSamplingPolicy(w http.ResponseWriter, r *http.Request)
+	DeleteTraceSamplingPolicy(w http.ResponseWriter, r *http.Request)
+	ListTraceSamplingPolicies(w http.ResponseWriter, r *http.Request)
+	GetTraceSamplingVerdict(w http.ResponseWriter, r *http.Request)
 }
 
 type observabilityController struct {
@@ -134,6 +203,13 @@ func (c *observabilityController) ListTraces(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	archived, ok := archivedParam(r)
+	if !ok {
+		log.Error("ListTraces: invalid archived parameter", "archived", r.URL.Query().Get("archived"))
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid archived parameter: must be true or false")
+		return
+	}
+
 	// Build parameters for the service
 	params := services.ListTracesRequest{
 		OrgName:     orgName,
@@ -145,6 +221,7 @@ func (c *observabilityController) ListTraces(w http.ResponseWriter, r *http.Requ
 		Limit:       limit,
 		Offset:      offset,
 		SortOrder:   sortOrder,
+		Archived:    archived,
 	}
 
 	// Call the service
@@ -242,6 +319,13 @@ func (c *observabilityController) ExportTraces(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	archived, ok := archivedParam(r)
+	if !ok {
+		log.Error("ExportTraces: invalid archived parameter", "archived", r.URL.Query().Get("archived"))
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid archived parameter: must be true or false")
+		return
+	}
+
 	// Build parameters for the service
 	params := services.ListTracesRequest{
 		OrgName:     orgName,
@@ -253,6 +337,7 @@ func (c *observabilityController) ExportTraces(w http.ResponseWriter, r *http.Re
 		Limit:       limit,
 		Offset:      offset,
 		SortOrder:   sortOrder,
+		Archived:    archived,
 	}
 
 	// Call the service
@@ -298,6 +383,13 @@ func (c *observabilityController) GetTrace(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	archived, ok := archivedParam(r)
+	if !ok {
+		log.Error("GetTrace: invalid archived parameter", "archived", r.URL.Query().Get("archived"))
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid archived parameter: must be true or false")
+		return
+	}
+
 	// Build parameters for the service
 	params := services.TraceDetailsRequest{
 		TraceID:     traceID,
@@ -305,6 +397,7 @@ func (c *observabilityController) GetTrace(w http.ResponseWriter, r *http.Reques
 		ProjectName: projName,
 		AgentName:   agentName,
 		Environment: environment,
+		Archived:    archived,
 	}
 
 	// Call the service
@@ -324,3 +417,1516 @@ func (c *observabilityController) GetTrace(w http.ResponseWriter, r *http.Reques
 	log.Info("GetTrace: successfully retrieved trace details", "traceId", traceID, "agentName", agentName, "spanCount", response.TotalCount)
 	utils.WriteSuccessResponse(w, http.StatusOK, response)
 }
+
+// archivedParam reads the optional archived query parameter, which also searches the spans moved
+// to the trace archive, and reports whether it is valid
+func archivedParam(r *http.Request) (bool, bool) {
+	value := r.URL.Query().Get("archived")
+	if value == "" {
+		return false, true
+	}
+	archived, err := strconv.ParseBool(value)
+	return archived, err == nil
+}
+
+func (c *observabilityController) GetTraceLogs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	// Extract path parameters
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	traceID := r.PathValue(utils.PathParamTraceId)
+
+	environment := r.URL.Query().Get("environment")
+	if environment == "" {
+		log.Error("GetTraceLogs: environment is required")
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Missing parameter: environment is required")
+		return
+	}
+
+	// The limit is optional; the trace observer caps it at its configured maximum
+	limit := 0
+	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil || parsed < 1 {
+			log.Error("GetTraceLogs: invalid limit parameter", "limit", limitStr)
+			utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid limit parameter: must be a positive integer")
+			return
+		}
+		limit = parsed
+	}
+
+	params := services.TraceLogsRequest{
+		TraceID:     traceID,
+		SpanID:      r.URL.Query().Get("spanId"),
+		OrgName:     orgName,
+		ProjectName: projName,
+		AgentName:   agentName,
+		Environment: environment,
+		Limit:       limit,
+	}
+
+	response, err := c.observabilityService.GetTraceLogs(ctx, params)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrTraceNotFound):
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Trace not found")
+		case errors.Is(err, services.ErrTraceLogsNotConfigured):
+			utils.WriteErrorResponse(w, http.StatusNotImplemented, "Log correlation is not configured")
+		default:
+			log.Error("GetTraceLogs: failed to get trace logs", "traceId", traceID, "agentName", agentName, "error", err)
+			utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve trace logs")
+		}
+		return
+	}
+
+	log.Info("GetTraceLogs: successfully retrieved trace logs", "traceId", traceID, "agentName", agentName, "records", response.TotalCount)
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) ReplayTrace(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	traceID := r.PathValue(utils.PathParamTraceId)
+
+	environment := r.URL.Query().Get("environment")
+	if environment == "" {
+		log.Error("ReplayTrace: environment is required")
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Missing parameter: environment is required")
+		return
+	}
+
+	// The body is optional; the first endpoint of the agent is invoked by default
+	var payload models.CreateTraceReplayRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil && !errors.Is(err, io.EOF) {
+		log.Error("ReplayTrace: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	var requestedBy string
+	if claims := jwtassertion.GetTokenClaims(ctx); claims != nil {
+		requestedBy = claims.Sub
+	}
+
+	response, err := c.observabilityService.ReplayTrace(ctx, services.TraceReplayRequest{
+		TraceID:     traceID,
+		OrgName:     orgName,
+		ProjectName: projName,
+		AgentName:   agentName,
+		Environment: environment,
+		RequestedBy: requestedBy,
+		Endpoint:    payload.Endpoint,
+		Path:        payload.Path,
+	})
+	if err != nil {
+		log.Error("ReplayTrace: failed to replay trace", "traceId", traceID, "agentName", agentName, "error", err)
+		utils.WriteError(w, err, "Failed to replay trace")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusCreated, response)
+}
+
+func (c *observabilityController) ListTraceReplays(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	traceID := r.PathValue(utils.PathParamTraceId)
+
+	response, err := c.observabilityService.ListTraceReplays(ctx, orgName, projName, agentName, traceID)
+	if err != nil {
+		log.Error("ListTraceReplays: failed to list trace replays", "traceId", traceID, "agentName", agentName, "error", err)
+		utils.WriteError(w, err, "Failed to list trace replays")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) GetModelUsage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	req, ok := parseModelUsageRequest(w, r, orgName)
+	if !ok {
+		return
+	}
+
+	response, err := c.observabilityService.GetModelUsage(ctx, req)
+	if err != nil {
+		if errors.Is(err, utils.ErrEnvironmentNotFound) {
+			utils.WriteErrorResponse(w, http.StatusNotFound, "Environment not found")
+			return
+		}
+		log.Error("GetModelUsage: failed to get model usage", "orgName", orgName, "error", err)
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve model usage")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// parseModelUsageRequest reads the environment and optional time range of a usage query, writing a
+// 400 response if they are invalid
+func parseModelUsageRequest(w http.ResponseWriter, r *http.Request, orgName string) (services.ModelUsageRequest, bool) {
+	log := logger.GetLogger(r.Context())
+
+	environment := r.URL.Query().Get("environment")
+	if environment == "" {
+		log.Error("Usage query without environment")
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Missing parameter: environment is required")
+		return services.ModelUsageRequest{}, false
+	}
+
+	// The time range is optional; the trace observer defaults to the last 7 days
+	startTime := r.URL.Query().Get("startTime")
+	endTime := r.URL.Query().Get("endTime")
+	if startTime != "" || endTime != "" {
+		if startTime == "" || endTime == "" {
+			log.Error("Usage query with only one of startTime and endTime")
+			utils.WriteErrorResponse(w, http.StatusBadRequest, "Missing parameter: startTime and endTime must be given together")
+			return services.ModelUsageRequest{}, false
+		}
+		if _, err := time.Parse(time.RFC3339, startTime); err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid startTime format: must be RFC3339 (e.g., 2025-12-20T10:00:00Z)")
+			return services.ModelUsageRequest{}, false
+		}
+		if _, err := time.Parse(time.RFC3339, endTime); err != nil {
+			utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid endTime format: must be RFC3339 (e.g., 2025-12-20T10:00:00Z)")
+			return services.ModelUsageRequest{}, false
+		}
+	}
+
+	return services.ModelUsageRequest{
+		OrgName:     orgName,
+		Environment: environment,
+		StartTime:   startTime,
+		EndTime:     endTime,
+	}, true
+}
+
+func (c *observabilityController) GetTraceRetention(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	response, err := c.observabilityService.GetTraceRetention(ctx, orgName)
+	if err != nil {
+		log.Error("GetTraceRetention: failed to get trace retention policy", "orgName", orgName, "error", err)
+		utils.WriteError(w, err, "Failed to get trace retention policy")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) SetTraceRetention(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	var payload models.TraceRetentionPolicyRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		log.Error("SetTraceRetention: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	response, err := c.observabilityService.SetTraceRetention(ctx, orgName, &payload)
+	if err != nil {
+		log.Error("SetTraceRetention: failed to set trace retention policy", "orgName", orgName, "error", err)
+		utils.WriteError(w, err, "Failed to set trace retention policy")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) DeleteTraceRetention(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	if err := c.observabilityService.DeleteTraceRetention(ctx, orgName); err != nil {
+		log.Error("DeleteTraceRetention: failed to delete trace retention policy", "orgName", orgName, "error", err)
+		utils.WriteError(w, err, "Failed to delete trace retention policy")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusNoContent, struct{}{})
+}
+
+func (c *observabilityController) GetTraceContentVisibility(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	response, err := c.observabilityService.GetTraceContentVisibility(ctx, orgName)
+	if err != nil {
+		log.Error("GetTraceContentVisibility: failed to get trace content visibility", "orgName", orgName, "error", err)
+		utils.WriteError(w, err, "Failed to get trace content visibility")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) SetTraceContentVisibility(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	var payload models.TraceContentVisibilityRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		log.Error("SetTraceContentVisibility: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if fieldErrors := utils.ValidateRequest(&payload); fieldErrors != nil {
+		utils.WriteValidationError(w, "Invalid request body", fieldErrors)
+		return
+	}
+
+	response, err := c.observabilityService.SetTraceContentVisibility(ctx, orgName, requestSubject(ctx), &payload)
+	if err != nil {
+		log.Error("SetTraceContentVisibility: failed to set trace content visibility", "orgName", orgName, "error", err)
+		utils.WriteError(w, err, "Failed to set trace content visibility")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) GetTraceStorageUsage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	response, err := c.observabilityService.GetTraceStorageUsage(ctx, orgName)
+	if err != nil {
+		log.Error("GetTraceStorageUsage: failed to get trace storage usage", "orgName", orgName, "error", err)
+		utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to retrieve trace storage usage")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) CreateTraceErasure(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	var payload models.CreateTraceErasureRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		log.Error("CreateTraceErasure: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// The requester is recorded for the audit trail of the erasure
+	var requestedBy string
+	if claims := jwtassertion.GetTokenClaims(ctx); claims != nil {
+		requestedBy = claims.Sub
+	}
+
+	response, err := c.observabilityService.CreateTraceErasure(ctx, orgName, requestedBy, &payload)
+	if err != nil {
+		log.Error("CreateTraceErasure: failed to erase traces", "orgName", orgName, "error", err)
+		utils.WriteError(w, err, "Failed to erase traces")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusAccepted, response)
+}
+
+func (c *observabilityController) ListTraceErasures(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	response, err := c.observabilityService.ListTraceErasures(ctx, orgName)
+	if err != nil {
+		log.Error("ListTraceErasures: failed to list trace erasures", "orgName", orgName, "error", err)
+		utils.WriteError(w, err, "Failed to list trace erasures")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) GetTraceErasure(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	erasureID := r.PathValue(utils.PathParamErasureId)
+
+	response, err := c.observabilityService.GetTraceErasure(ctx, orgName, erasureID)
+	if err != nil {
+		log.Error("GetTraceErasure: failed to get trace erasure", "orgName", orgName, "erasureId", erasureID, "error", err)
+		utils.WriteError(w, err, "Failed to get trace erasure")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) GenerateUsageReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	var payload models.CreateUsageReportRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		log.Error("GenerateUsageReport: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if fieldErrors := utils.ValidateRequest(&payload); fieldErrors != nil {
+		utils.WriteValidationError(w, "Invalid request body", fieldErrors)
+		return
+	}
+
+	response, err := c.observabilityService.GenerateUsageReport(ctx, orgName, requestSubject(ctx), &payload)
+	if err != nil {
+		log.Error("GenerateUsageReport: failed to generate usage report", "orgName", orgName, "period", payload.Period, "error", err)
+		utils.WriteError(w, err, "Failed to generate usage report")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusAccepted, response)
+}
+
+func (c *observabilityController) ListUsageReports(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	response, err := c.observabilityService.ListUsageReports(ctx, orgName)
+	if err != nil {
+		log.Error("ListUsageReports: failed to list usage reports", "orgName", orgName, "error", err)
+		utils.WriteError(w, err, "Failed to list usage reports")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) GetUsageReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	period := r.PathValue(utils.PathParamPeriod)
+
+	response, err := c.observabilityService.GetUsageReport(ctx, orgName, period)
+	if err != nil {
+		log.Error("GetUsageReport: failed to get usage report", "orgName", orgName, "period", period, "error", err)
+		utils.WriteError(w, err, "Failed to get usage report")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) DownloadUsageReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	period := r.PathValue(utils.PathParamPeriod)
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = models.UsageReportFormatCSV
+	}
+
+	content, fileName, err := c.observabilityService.RenderUsageReport(ctx, orgName, period, format)
+	if err != nil {
+		log.Error("DownloadUsageReport: failed to render usage report", "orgName", orgName, "period", period, "format", format, "error", err)
+		utils.WriteError(w, err, "Failed to download usage report")
+		return
+	}
+
+	contentType := "text/csv; charset=utf-8"
+	if format == models.UsageReportFormatPDF {
+		contentType = "application/pdf"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", "attachment; filename="+fileName)
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(content); err != nil {
+		log.Error("DownloadUsageReport: failed to write usage report", "orgName", orgName, "period", period, "error", err)
+	}
+}
+
+func (c *observabilityController) SetCostCenterMapping(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	var payload models.CostCenterMappingRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		log.Error("SetCostCenterMapping: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if fieldErrors := utils.ValidateRequest(&payload); fieldErrors != nil {
+		utils.WriteValidationError(w, "Invalid request body", fieldErrors)
+		return
+	}
+
+	response, err := c.observabilityService.SetCostCenterMapping(ctx, orgName, requestSubject(ctx), &payload)
+	if err != nil {
+		log.Error("SetCostCenterMapping: failed to set cost center", "orgName", orgName, "resourceName", payload.ResourceName, "error", err)
+		utils.WriteError(w, err, "Failed to set cost center")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) ListCostCenterMappings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	response, err := c.observabilityService.ListCostCenterMappings(ctx, orgName)
+	if err != nil {
+		log.Error("ListCostCenterMappings: failed to list cost center mappings", "orgName", orgName, "error", err)
+		utils.WriteError(w, err, "Failed to list cost center mappings")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) DeleteCostCenterMapping(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	mappingID := r.PathValue(utils.PathParamMappingId)
+
+	if err := c.observabilityService.DeleteCostCenterMapping(ctx, orgName, mappingID); err != nil {
+		log.Error("DeleteCostCenterMapping: failed to delete cost center mapping", "orgName", orgName, "mappingId", mappingID, "error", err)
+		utils.WriteError(w, err, "Failed to delete cost center mapping")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusNoContent, struct{}{})
+}
+
+func (c *observabilityController) GetCostCenterUsage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	req, ok := parseModelUsageRequest(w, r, orgName)
+	if !ok {
+		return
+	}
+
+	response, err := c.observabilityService.GetCostCenterUsage(ctx, req)
+	if err != nil {
+		log.Error("GetCostCenterUsage: failed to get cost center usage", "orgName", orgName, "error", err)
+		utils.WriteError(w, err, "Failed to retrieve cost center usage")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) CreateGoldenTrace(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+
+	var payload models.CreateGoldenTraceRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		log.Error("CreateGoldenTrace: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	var createdBy string
+	if claims := jwtassertion.GetTokenClaims(ctx); claims != nil {
+		createdBy = claims.Sub
+	}
+
+	response, err := c.observabilityService.CreateGoldenTrace(ctx, orgName, projName, agentName, createdBy, &payload)
+	if err != nil {
+		log.Error("CreateGoldenTrace: failed to create golden trace", "traceId", payload.TraceID, "agentName", agentName, "error", err)
+		utils.WriteError(w, err, "Failed to create golden trace")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusCreated, response)
+}
+
+func (c *observabilityController) ListGoldenTraces(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+
+	response, err := c.observabilityService.ListGoldenTraces(ctx, orgName, projName, agentName)
+	if err != nil {
+		log.Error("ListGoldenTraces: failed to list golden traces", "agentName", agentName, "error", err)
+		utils.WriteError(w, err, "Failed to list golden traces")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) GetGoldenTrace(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	goldenTraceID := r.PathValue(utils.PathParamGoldenTraceId)
+
+	response, err := c.observabilityService.GetGoldenTrace(ctx, orgName, projName, agentName, goldenTraceID)
+	if err != nil {
+		log.Error("GetGoldenTrace: failed to get golden trace", "goldenTraceId", goldenTraceID, "error", err)
+		utils.WriteError(w, err, "Failed to get golden trace")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) DeleteGoldenTrace(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	goldenTraceID := r.PathValue(utils.PathParamGoldenTraceId)
+
+	if err := c.observabilityService.DeleteGoldenTrace(ctx, orgName, projName, agentName, goldenTraceID); err != nil {
+		log.Error("DeleteGoldenTrace: failed to delete golden trace", "goldenTraceId", goldenTraceID, "error", err)
+		utils.WriteError(w, err, "Failed to delete golden trace")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusNoContent, struct{}{})
+}
+
+func (c *observabilityController) RunGoldenTrace(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	goldenTraceID := r.PathValue(utils.PathParamGoldenTraceId)
+
+	var requestedBy string
+	if claims := jwtassertion.GetTokenClaims(ctx); claims != nil {
+		requestedBy = claims.Sub
+	}
+
+	response, err := c.observabilityService.RunGoldenTrace(ctx, orgName, projName, agentName, goldenTraceID, requestedBy)
+	if err != nil {
+		log.Error("RunGoldenTrace: failed to run golden trace", "goldenTraceId", goldenTraceID, "error", err)
+		utils.WriteError(w, err, "Failed to run golden trace")
+		return
+	}
+
+	// The run is scored once the replayed trace is recorded
+	utils.WriteSuccessResponse(w, http.StatusAccepted, response)
+}
+
+func (c *observabilityController) ListGoldenTraceRuns(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	goldenTraceID := r.PathValue(utils.PathParamGoldenTraceId)
+
+	response, err := c.observabilityService.ListGoldenTraceRuns(ctx, orgName, projName, agentName, goldenTraceID)
+	if err != nil {
+		log.Error("ListGoldenTraceRuns: failed to list golden trace runs", "goldenTraceId", goldenTraceID, "error", err)
+		utils.WriteError(w, err, "Failed to list golden trace runs")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) GetGoldenTraceRun(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	goldenTraceID := r.PathValue(utils.PathParamGoldenTraceId)
+	runID := r.PathValue(utils.PathParamRunId)
+
+	response, err := c.observabilityService.GetGoldenTraceRun(ctx, orgName, projName, agentName, goldenTraceID, runID)
+	if err != nil {
+		log.Error("GetGoldenTraceRun: failed to get golden trace run", "goldenTraceId", goldenTraceID, "runId", runID, "error", err)
+		utils.WriteError(w, err, "Failed to get golden trace run")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) GetTraceScoringPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+
+	response, err := c.observabilityService.GetTraceScoringPolicy(ctx, orgName, projName, agentName)
+	if err != nil {
+		log.Error("GetTraceScoringPolicy: failed to get trace scoring policy", "agentName", agentName, "error", err)
+		utils.WriteError(w, err, "Failed to get trace scoring policy")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) SetTraceScoringPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+
+	var payload models.TraceScoringPolicyRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		log.Error("SetTraceScoringPolicy: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	response, err := c.observabilityService.SetTraceScoringPolicy(ctx, orgName, projName, agentName, &payload)
+	if err != nil {
+		log.Error("SetTraceScoringPolicy: failed to set trace scoring policy", "agentName", agentName, "error", err)
+		utils.WriteError(w, err, "Failed to set trace scoring policy")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) DeleteTraceScoringPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+
+	if err := c.observabilityService.DeleteTraceScoringPolicy(ctx, orgName, projName, agentName); err != nil {
+		log.Error("DeleteTraceScoringPolicy: failed to delete trace scoring policy", "agentName", agentName, "error", err)
+		utils.WriteError(w, err, "Failed to delete trace scoring policy")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusNoContent, struct{}{})
+}
+
+func (c *observabilityController) ScoreTrace(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	traceID := r.PathValue(utils.PathParamTraceId)
+
+	environment := r.URL.Query().Get("environment")
+	if environment == "" {
+		log.Error("ScoreTrace: environment is required")
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Missing parameter: environment is required")
+		return
+	}
+
+	response, err := c.observabilityService.ScoreTrace(ctx, services.TraceScoreRequest{
+		TraceID:     traceID,
+		OrgName:     orgName,
+		ProjectName: projName,
+		AgentName:   agentName,
+		Environment: environment,
+	})
+	if err != nil {
+		log.Error("ScoreTrace: failed to score trace", "traceId", traceID, "agentName", agentName, "error", err)
+		utils.WriteError(w, err, "Failed to score trace")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusCreated, response)
+}
+
+func (c *observabilityController) GetTraceScores(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	traceID := r.PathValue(utils.PathParamTraceId)
+
+	response, err := c.observabilityService.GetTraceScores(ctx, orgName, projName, agentName, traceID)
+	if err != nil {
+		log.Error("GetTraceScores: failed to get trace scores", "traceId", traceID, "agentName", agentName, "error", err)
+		utils.WriteError(w, err, "Failed to get trace scores")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) GetTraceScoreSummary(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+
+	// The summary covers the last day by default
+	endTime := time.Now().UTC()
+	startTime := endTime.Add(-24 * time.Hour)
+	for name, target := range map[string]*time.Time{"startTime": &startTime, "endTime": &endTime} {
+		value := r.URL.Query().Get(name)
+		if value == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			log.Error("GetTraceScoreSummary: invalid time format", name, value, "error", err)
+			utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid "+name+" format: must be RFC3339 (e.g., 2025-12-20T10:00:00Z)")
+			return
+		}
+		*target = parsed
+	}
+	if !startTime.Before(endTime) {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "startTime must be before endTime")
+		return
+	}
+
+	response, err := c.observabilityService.GetTraceScoreSummary(ctx, orgName, projName, agentName, startTime, endTime)
+	if err != nil {
+		log.Error("GetTraceScoreSummary: failed to summarize trace scores", "agentName", agentName, "error", err)
+		utils.WriteError(w, err, "Failed to summarize trace scores")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) CreateAgentSLO(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+
+	var payload models.AgentSLORequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		log.Error("CreateAgentSLO: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if fieldErrors := utils.ValidateRequest(&payload); fieldErrors != nil {
+		utils.WriteValidationError(w, "Invalid request body", fieldErrors)
+		return
+	}
+
+	var createdBy string
+	if claims := jwtassertion.GetTokenClaims(ctx); claims != nil {
+		createdBy = claims.Sub
+	}
+
+	response, err := c.observabilityService.CreateAgentSLO(ctx, orgName, projName, agentName, createdBy, &payload)
+	if err != nil {
+		log.Error("CreateAgentSLO: failed to create SLO", "agentName", agentName, "error", err)
+		utils.WriteError(w, err, "Failed to create SLO")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusCreated, response)
+}
+
+func (c *observabilityController) ListAgentSLOs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+
+	response, err := c.observabilityService.ListAgentSLOs(ctx, orgName, projName, agentName)
+	if err != nil {
+		log.Error("ListAgentSLOs: failed to list SLOs", "agentName", agentName, "error", err)
+		utils.WriteError(w, err, "Failed to list SLOs")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) GetAgentSLO(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	sloID := r.PathValue(utils.PathParamSLOId)
+
+	response, err := c.observabilityService.GetAgentSLO(ctx, orgName, projName, agentName, sloID)
+	if err != nil {
+		log.Error("GetAgentSLO: failed to get SLO", "sloId", sloID, "error", err)
+		utils.WriteError(w, err, "Failed to get SLO")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) UpdateAgentSLO(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	sloID := r.PathValue(utils.PathParamSLOId)
+
+	var payload models.AgentSLORequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		log.Error("UpdateAgentSLO: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if fieldErrors := utils.ValidateRequest(&payload); fieldErrors != nil {
+		utils.WriteValidationError(w, "Invalid request body", fieldErrors)
+		return
+	}
+
+	response, err := c.observabilityService.UpdateAgentSLO(ctx, orgName, projName, agentName, sloID, &payload)
+	if err != nil {
+		log.Error("UpdateAgentSLO: failed to update SLO", "sloId", sloID, "error", err)
+		utils.WriteError(w, err, "Failed to update SLO")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) DeleteAgentSLO(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	sloID := r.PathValue(utils.PathParamSLOId)
+
+	if err := c.observabilityService.DeleteAgentSLO(ctx, orgName, projName, agentName, sloID); err != nil {
+		log.Error("DeleteAgentSLO: failed to delete SLO", "sloId", sloID, "error", err)
+		utils.WriteError(w, err, "Failed to delete SLO")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusNoContent, struct{}{})
+}
+
+func (c *observabilityController) GetAgentSLOStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	sloID := r.PathValue(utils.PathParamSLOId)
+
+	response, err := c.observabilityService.GetAgentSLOStatus(ctx, orgName, projName, agentName, sloID)
+	if err != nil {
+		log.Error("GetAgentSLOStatus: failed to get SLO status", "sloId", sloID, "error", err)
+		utils.WriteError(w, err, "Failed to get SLO status")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) GetAgentSLOHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	sloID := r.PathValue(utils.PathParamSLOId)
+	interval := r.URL.Query().Get("interval")
+
+	response, err := c.observabilityService.GetAgentSLOHistory(ctx, orgName, projName, agentName, sloID, interval)
+	if err != nil {
+		log.Error("GetAgentSLOHistory: failed to get SLO history", "sloId", sloID, "interval", interval, "error", err)
+		utils.WriteError(w, err, "Failed to get SLO history")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) CreateAgentTokenBudget(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+
+	var payload models.AgentTokenBudgetRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		log.Error("CreateAgentTokenBudget: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if fieldErrors := utils.ValidateRequest(&payload); fieldErrors != nil {
+		utils.WriteValidationError(w, "Invalid request body", fieldErrors)
+		return
+	}
+
+	var createdBy string
+	if claims := jwtassertion.GetTokenClaims(ctx); claims != nil {
+		createdBy = claims.Sub
+	}
+
+	response, err := c.observabilityService.CreateAgentTokenBudget(ctx, orgName, projName, agentName, createdBy, &payload)
+	if err != nil {
+		log.Error("CreateAgentTokenBudget: failed to create token budget", "agentName", agentName, "error", err)
+		utils.WriteError(w, err, "Failed to create token budget")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusCreated, response)
+}
+
+func (c *observabilityController) ListAgentTokenBudgets(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+
+	response, err := c.observabilityService.ListAgentTokenBudgets(ctx, orgName, projName, agentName)
+	if err != nil {
+		log.Error("ListAgentTokenBudgets: failed to list token budgets", "agentName", agentName, "error", err)
+		utils.WriteError(w, err, "Failed to list token budgets")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) GetAgentTokenBudget(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	budgetID := r.PathValue(utils.PathParamBudgetId)
+
+	response, err := c.observabilityService.GetAgentTokenBudget(ctx, orgName, projName, agentName, budgetID)
+	if err != nil {
+		log.Error("GetAgentTokenBudget: failed to get token budget", "budgetId", budgetID, "error", err)
+		utils.WriteError(w, err, "Failed to get token budget")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) UpdateAgentTokenBudget(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	budgetID := r.PathValue(utils.PathParamBudgetId)
+
+	var payload models.AgentTokenBudgetRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		log.Error("UpdateAgentTokenBudget: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if fieldErrors := utils.ValidateRequest(&payload); fieldErrors != nil {
+		utils.WriteValidationError(w, "Invalid request body", fieldErrors)
+		return
+	}
+
+	response, err := c.observabilityService.UpdateAgentTokenBudget(ctx, orgName, projName, agentName, budgetID, &payload)
+	if err != nil {
+		log.Error("UpdateAgentTokenBudget: failed to update token budget", "budgetId", budgetID, "error", err)
+		utils.WriteError(w, err, "Failed to update token budget")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) DeleteAgentTokenBudget(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	budgetID := r.PathValue(utils.PathParamBudgetId)
+
+	if err := c.observabilityService.DeleteAgentTokenBudget(ctx, orgName, projName, agentName, budgetID); err != nil {
+		log.Error("DeleteAgentTokenBudget: failed to delete token budget", "budgetId", budgetID, "error", err)
+		utils.WriteError(w, err, "Failed to delete token budget")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusNoContent, struct{}{})
+}
+
+func (c *observabilityController) ListAgentTokenBudgetEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+	budgetID := r.PathValue(utils.PathParamBudgetId)
+
+	response, err := c.observabilityService.ListAgentTokenBudgetEvents(ctx, orgName, projName, agentName, budgetID)
+	if err != nil {
+		log.Error("ListAgentTokenBudgetEvents: failed to list token budget events", "budgetId", budgetID, "error", err)
+		utils.WriteError(w, err, "Failed to list token budget events")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) CreateNotificationDigest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	var payload models.NotificationDigestRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		log.Error("CreateNotificationDigest: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if fieldErrors := utils.ValidateRequest(&payload); fieldErrors != nil {
+		utils.WriteValidationError(w, "Invalid request body", fieldErrors)
+		return
+	}
+
+	response, err := c.observabilityService.CreateNotificationDigest(ctx, orgName, requestSubject(ctx), &payload)
+	if err != nil {
+		log.Error("CreateNotificationDigest: failed to create notification digest", "orgName", orgName, "error", err)
+		utils.WriteError(w, err, "Failed to create notification digest")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusCreated, response)
+}
+
+func (c *observabilityController) ListNotificationDigests(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	response, err := c.observabilityService.ListNotificationDigests(ctx, orgName)
+	if err != nil {
+		log.Error("ListNotificationDigests: failed to list notification digests", "orgName", orgName, "error", err)
+		utils.WriteError(w, err, "Failed to list notification digests")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) GetNotificationDigest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	digestID := r.PathValue(utils.PathParamDigestId)
+
+	response, err := c.observabilityService.GetNotificationDigest(ctx, orgName, digestID)
+	if err != nil {
+		log.Error("GetNotificationDigest: failed to get notification digest", "digestId", digestID, "error", err)
+		utils.WriteError(w, err, "Failed to get notification digest")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) UpdateNotificationDigest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	digestID := r.PathValue(utils.PathParamDigestId)
+
+	var payload models.NotificationDigestRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		log.Error("UpdateNotificationDigest: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if fieldErrors := utils.ValidateRequest(&payload); fieldErrors != nil {
+		utils.WriteValidationError(w, "Invalid request body", fieldErrors)
+		return
+	}
+
+	response, err := c.observabilityService.UpdateNotificationDigest(ctx, orgName, digestID, &payload)
+	if err != nil {
+		log.Error("UpdateNotificationDigest: failed to update notification digest", "digestId", digestID, "error", err)
+		utils.WriteError(w, err, "Failed to update notification digest")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) DeleteNotificationDigest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	digestID := r.PathValue(utils.PathParamDigestId)
+
+	if err := c.observabilityService.DeleteNotificationDigest(ctx, orgName, digestID); err != nil {
+		log.Error("DeleteNotificationDigest: failed to delete notification digest", "digestId", digestID, "error", err)
+		utils.WriteError(w, err, "Failed to delete notification digest")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusNoContent, struct{}{})
+}
+
+func (c *observabilityController) SendNotificationDigest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	digestID := r.PathValue(utils.PathParamDigestId)
+
+	response, err := c.observabilityService.SendNotificationDigest(ctx, orgName, digestID)
+	if err != nil {
+		log.Error("SendNotificationDigest: failed to send notification digest", "digestId", digestID, "error", err)
+		utils.WriteError(w, err, "Failed to send notification digest")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) CreateEmailRecipient(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	var payload models.CreateEmailRecipientRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		log.Error("CreateEmailRecipient: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if fieldErrors := utils.ValidateRequest(&payload); fieldErrors != nil {
+		utils.WriteValidationError(w, "Invalid request body", fieldErrors)
+		return
+	}
+
+	response, err := c.observabilityService.CreateEmailRecipient(ctx, orgName, requestSubject(ctx), &payload)
+	if err != nil {
+		log.Error("CreateEmailRecipient: failed to create email recipient", "orgName", orgName, "error", err)
+		utils.WriteError(w, err, "Failed to create email recipient")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusCreated, response)
+}
+
+func (c *observabilityController) ListEmailRecipients(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	response, err := c.observabilityService.ListEmailRecipients(ctx, orgName)
+	if err != nil {
+		log.Error("ListEmailRecipients: failed to list email recipients", "orgName", orgName, "error", err)
+		utils.WriteError(w, err, "Failed to list email recipients")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) GetEmailRecipient(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	recipientID := r.PathValue(utils.PathParamRecipientId)
+
+	response, err := c.observabilityService.GetEmailRecipient(ctx, orgName, recipientID)
+	if err != nil {
+		log.Error("GetEmailRecipient: failed to get email recipient", "recipientId", recipientID, "error", err)
+		utils.WriteError(w, err, "Failed to get email recipient")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) UpdateEmailRecipient(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	recipientID := r.PathValue(utils.PathParamRecipientId)
+
+	var payload models.UpdateEmailRecipientRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		log.Error("UpdateEmailRecipient: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if fieldErrors := utils.ValidateRequest(&payload); fieldErrors != nil {
+		utils.WriteValidationError(w, "Invalid request body", fieldErrors)
+		return
+	}
+
+	response, err := c.observabilityService.UpdateEmailRecipient(ctx, orgName, recipientID, &payload)
+	if err != nil {
+		log.Error("UpdateEmailRecipient: failed to update email recipient", "recipientId", recipientID, "error", err)
+		utils.WriteError(w, err, "Failed to update email recipient")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) DeleteEmailRecipient(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	recipientID := r.PathValue(utils.PathParamRecipientId)
+
+	if err := c.observabilityService.DeleteEmailRecipient(ctx, orgName, recipientID); err != nil {
+		log.Error("DeleteEmailRecipient: failed to delete email recipient", "recipientId", recipientID, "error", err)
+		utils.WriteError(w, err, "Failed to delete email recipient")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusNoContent, struct{}{})
+}
+
+func (c *observabilityController) SendEmailRecipientVerification(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	recipientID := r.PathValue(utils.PathParamRecipientId)
+
+	response, err := c.observabilityService.SendEmailRecipientVerification(ctx, orgName, recipientID)
+	if err != nil {
+		log.Error("SendEmailRecipientVerification: failed to send verification", "recipientId", recipientID, "error", err)
+		utils.WriteError(w, err, "Failed to send verification email")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) VerifyEmailRecipient(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	recipientID := r.PathValue(utils.PathParamRecipientId)
+
+	var payload models.VerifyEmailRecipientRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		log.Error("VerifyEmailRecipient: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if fieldErrors := utils.ValidateRequest(&payload); fieldErrors != nil {
+		utils.WriteValidationError(w, "Invalid request body", fieldErrors)
+		return
+	}
+
+	response, err := c.observabilityService.VerifyEmailRecipient(ctx, orgName, recipientID, &payload)
+	if err != nil {
+		log.Error("VerifyEmailRecipient: failed to verify email recipient", "recipientId", recipientID, "error", err)
+		utils.WriteError(w, err, "Failed to verify email recipient")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// GetTraceSamplingPolicy serves the sampling policy of an organization and of its agents; the
+// project and agent name are empty on the organization's route
+func (c *observabilityController) GetTraceSamplingPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+
+	response, err := c.observabilityService.GetTraceSamplingPolicy(ctx, orgName, projName, agentName)
+	if err != nil {
+		log.Error("GetTraceSamplingPolicy: failed to get trace sampling policy", "orgName", orgName, "agentName", agentName, "error", err)
+		utils.WriteError(w, err, "Failed to get trace sampling policy")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// SetTraceSamplingPolicy serves the organization's route as well as those of its agents
+func (c *observabilityController) SetTraceSamplingPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+
+	var payload models.TraceSamplingPolicyRequest
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		log.Error("SetTraceSamplingPolicy: failed to decode request body", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if fieldErrors := utils.ValidateRequest(&payload); fieldErrors != nil {
+		utils.WriteValidationError(w, "Invalid request body", fieldErrors)
+		return
+	}
+
+	response, err := c.observabilityService.SetTraceSamplingPolicy(ctx, orgName, projName, agentName, requestSubject(ctx), &payload)
+	if err != nil {
+		log.Error("SetTraceSamplingPolicy: failed to set trace sampling policy", "orgName", orgName, "agentName", agentName, "error", err)
+		utils.WriteError(w, err, "Failed to set trace sampling policy")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+// DeleteTraceSamplingPolicy serves the organization's route as well as those of its agents
+func (c *observabilityController) DeleteTraceSamplingPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+
+	if err := c.observabilityService.DeleteTraceSamplingPolicy(ctx, orgName, projName, agentName); err != nil {
+		log.Error("DeleteTraceSamplingPolicy: failed to delete trace sampling policy", "orgName", orgName, "agentName", agentName, "error", err)
+		utils.WriteError(w, err, "Failed to delete trace sampling policy")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusNoContent, struct{}{})
+}
+
+func (c *observabilityController) ListTraceSamplingPolicies(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	response, err := c.observabilityService.ListTraceSamplingPolicies(ctx, orgName)
+	if err != nil {
+		log.Error("ListTraceSamplingPolicies: failed to list trace sampling policies", "orgName", orgName, "error", err)
+		utils.WriteError(w, err, "Failed to list trace sampling policies")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
+
+func (c *observabilityController) GetTraceSamplingVerdict(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+	projName := r.PathValue(utils.PathParamProjName)
+	agentName := r.PathValue(utils.PathParamAgentName)
+
+	response, err := c.observabilityService.GetTraceSamplingVerdict(ctx, orgName, projName, agentName)
+	if err != nil {
+		log.Error("GetTraceSamplingVerdict: failed to get trace sampling verdict", "agentName", agentName, "error", err)
+		utils.WriteError(w, err, "Failed to get trace sampling verdict")
+		return
+	}
+
+	// SDKs and collectors may cache the verdict until they are asked to refresh it
+	w.Header().Set("Cache-Control", "private, max-age="+strconv.Itoa(response.RefreshIntervalSeconds))
+	utils.WriteSuccessResponse(w, http.StatusOK, response)
+}
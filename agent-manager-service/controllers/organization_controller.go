@@ -0,0 +1,258 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// OrganizationController defines the interface for organization lifecycle HTTP handlers
+type OrganizationController interface {
+	CreateOrganization(w http.ResponseWriter, r *http.Request)
+	UpdateOrganization(w http.ResponseWriter, r *http.Request)
+	SuspendOrganization(w http.ResponseWriter, r *http.Request)
+	ResumeOrganization(w http.ResponseWriter, r *http.Request)
+	DeleteOrganization(w http.ResponseWriter, r *http.Request)
+	ProvisionTrialSandbox(w http.ResponseWriter, r *http.Request)
+	GetTrialSandbox(w http.ResponseWriter, r *http.Request)
+	EndTrialSandbox(w http.ResponseWriter, r *http.Request)
+}
+
+type organizationController struct {
+	organizationService services.OrganizationService
+}
+
+// NewOrganizationController creates a new organization controller
+func NewOrganizationController(organizationService services.OrganizationService) OrganizationController {
+	return &organizationController{
+		organizationService: organizationService,
+	}
+}
+
+func handleOrganizationErrors(w http.ResponseWriter, err error, fallbackMsg string) {
+	if errors.Is(err, utils.ErrServiceUnavailable) {
+		utils.WriteErrorResponse(w, http.StatusServiceUnavailable, "Gateway management is unavailable")
+		return
+	}
+	utils.WriteError(w, err, fallbackMsg)
+}
+
+func (c *organizationController) CreateOrganization(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	if !isPlatformAdmin(ctx) {
+		log.Warn("CreateOrganization: caller lacks the platform admin scope")
+		utils.WriteError(w, utils.ErrNotPlatformAdmin, "Failed to create organization")
+		return
+	}
+
+	var req models.CreateOrganizationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error("CreateOrganization: failed to decode request", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if fieldErrors := utils.ValidateRequest(&req); fieldErrors != nil {
+		utils.WriteValidationError(w, "Invalid request body", fieldErrors)
+		return
+	}
+
+	org, err := c.organizationService.CreateOrganization(ctx, &req)
+	if err != nil {
+		log.Error("CreateOrganization: failed to create organization", "name", req.Name, "error", err)
+		handleOrganizationErrors(w, err, "Failed to create organization")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusCreated, org)
+}
+
+func (c *organizationController) UpdateOrganization(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	if !isPlatformAdmin(ctx) {
+		log.Warn("UpdateOrganization: caller lacks the platform admin scope", "orgName", orgName)
+		utils.WriteError(w, utils.ErrNotPlatformAdmin, "Failed to update organization")
+		return
+	}
+
+	var req models.UpdateOrganizationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error("UpdateOrganization: failed to decode request", "error", err)
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if fieldErrors := utils.ValidateRequest(&req); fieldErrors != nil {
+		utils.WriteValidationError(w, "Invalid request body", fieldErrors)
+		return
+	}
+
+	org, err := c.organizationService.UpdateOrganization(ctx, orgName, &req)
+	if err != nil {
+		log.Error("UpdateOrganization: failed to update organization", "orgName", orgName, "error", err)
+		handleOrganizationErrors(w, err, "Failed to update organization")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, org)
+}
+
+func (c *organizationController) SuspendOrganization(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	if !isPlatformAdmin(ctx) {
+		log.Warn("SuspendOrganization: caller lacks the platform admin scope", "orgName", orgName)
+		utils.WriteError(w, utils.ErrNotPlatformAdmin, "Failed to suspend organization")
+		return
+	}
+
+	org, err := c.organizationService.SuspendOrganization(ctx, orgName)
+	if err != nil {
+		log.Error("SuspendOrganization: failed to suspend organization", "orgName", orgName, "error", err)
+		handleOrganizationErrors(w, err, "Failed to suspend organization")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, org)
+}
+
+func (c *organizationController) ResumeOrganization(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	if !isPlatformAdmin(ctx) {
+		log.Warn("ResumeOrganization: caller lacks the platform admin scope", "orgName", orgName)
+		utils.WriteError(w, utils.ErrNotPlatformAdmin, "Failed to resume organization")
+		return
+	}
+
+	org, err := c.organizationService.ResumeOrganization(ctx, orgName)
+	if err != nil {
+		log.Error("ResumeOrganization: failed to resume organization", "orgName", orgName, "error", err)
+		handleOrganizationErrors(w, err, "Failed to resume organization")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, org)
+}
+
+func (c *organizationController) DeleteOrganization(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	if !isPlatformAdmin(ctx) {
+		log.Warn("DeleteOrganization: caller lacks the platform admin scope", "orgName", orgName)
+		utils.WriteError(w, utils.ErrNotPlatformAdmin, "Failed to delete organization")
+		return
+	}
+
+	if err := c.organizationService.DeleteOrganization(ctx, orgName); err != nil {
+		log.Error("DeleteOrganization: failed to delete organization", "orgName", orgName, "error", err)
+		handleOrganizationErrors(w, err, "Failed to delete organization")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusNoContent, struct{}{})
+}
+
+func (c *organizationController) ProvisionTrialSandbox(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	if !isPlatformAdmin(ctx) {
+		log.Warn("ProvisionTrialSandbox: caller lacks the platform admin scope", "orgName", orgName)
+		utils.WriteError(w, utils.ErrNotPlatformAdmin, "Failed to provision trial sandbox")
+		return
+	}
+
+	sandbox, err := c.organizationService.ProvisionTrialSandbox(ctx, orgName, requestSubject(ctx))
+	if err != nil {
+		log.Error("ProvisionTrialSandbox: failed to provision trial sandbox", "orgName", orgName, "error", err)
+		handleOrganizationErrors(w, err, "Failed to provision trial sandbox")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusCreated, sandbox)
+}
+
+func (c *organizationController) GetTrialSandbox(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	sandbox, err := c.organizationService.GetTrialSandbox(ctx, orgName)
+	if err != nil {
+		log.Error("GetTrialSandbox: failed to get trial sandbox", "orgName", orgName, "error", err)
+		handleOrganizationErrors(w, err, "Failed to get trial sandbox")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, sandbox)
+}
+
+func (c *organizationController) EndTrialSandbox(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	if !isPlatformAdmin(ctx) {
+		log.Warn("EndTrialSandbox: caller lacks the platform admin scope", "orgName", orgName)
+		utils.WriteError(w, utils.ErrNotPlatformAdmin, "Failed to end trial sandbox")
+		return
+	}
+
+	sandbox, err := c.organizationService.EndTrialSandbox(ctx, orgName)
+	if err != nil {
+		log.Error("EndTrialSandbox: failed to end trial sandbox", "orgName", orgName, "error", err)
+		handleOrganizationErrors(w, err, "Failed to end trial sandbox")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, sandbox)
+}
+
+// isPlatformAdmin reports whether the caller may manage the lifecycle of organizations. Membership of
+// an organization is not enough: its members must not be able to resume it after a suspension or
+// take another trial sandbox.
+func isPlatformAdmin(ctx context.Context) bool {
+	return jwtassertion.HasAllScopes(ctx, []string{config.GetConfig().PlatformAdminScope})
+}
@@ -0,0 +1,429 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/orgcontext"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+const scimContentType = "application/scim+json"
+
+// ScimController defines the interface for the SCIM 2.0 provisioning HTTP handlers
+type ScimController interface {
+	GetServiceProviderConfig(w http.ResponseWriter, r *http.Request)
+
+	ListUsers(w http.ResponseWriter, r *http.Request)
+	GetUser(w http.ResponseWriter, r *http.Request)
+	CreateUser(w http.ResponseWriter, r *http.Request)
+	ReplaceUser(w http.ResponseWriter, r *http.Request)
+	PatchUser(w http.ResponseWriter, r *http.Request)
+	DeleteUser(w http.ResponseWriter, r *http.Request)
+
+	ListGroups(w http.ResponseWriter, r *http.Request)
+	GetGroup(w http.ResponseWriter, r *http.Request)
+	CreateGroup(w http.ResponseWriter, r *http.Request)
+	ReplaceGroup(w http.ResponseWriter, r *http.Request)
+	PatchGroup(w http.ResponseWriter, r *http.Request)
+	DeleteGroup(w http.ResponseWriter, r *http.Request)
+}
+
+type scimController struct {
+	scimService services.ScimService
+}
+
+// NewScimController creates a new SCIM controller
+func NewScimController(scimService services.ScimService) ScimController {
+	return &scimController{
+		scimService: scimService,
+	}
+}
+
+// SCIM clients expect errors in the RFC 7644 error format rather than the API's error response
+func handleScimErrors(w http.ResponseWriter, err error, fallbackMsg string) {
+	switch {
+	case errors.Is(err, utils.ErrScimUserNotFound), errors.Is(err, utils.ErrScimGroupNotFound):
+		writeScimError(w, http.StatusNotFound, "", err.Error())
+	case errors.Is(err, utils.ErrScimUserAlreadyExists), errors.Is(err, utils.ErrScimGroupAlreadyExists):
+		writeScimError(w, http.StatusConflict, "uniqueness", err.Error())
+	case errors.Is(err, utils.ErrScimInvalidFilter):
+		writeScimError(w, http.StatusBadRequest, "invalidFilter", err.Error())
+	case errors.Is(err, utils.ErrInvalidInput):
+		writeScimError(w, http.StatusBadRequest, "invalidValue", err.Error())
+	default:
+		writeScimError(w, http.StatusInternalServerError, "", fallbackMsg)
+	}
+}
+
+func writeScimError(w http.ResponseWriter, status int, scimType string, detail string) {
+	writeScimResponse(w, status, models.ScimErrorResponse{
+		Schemas:  []string{models.ScimSchemaError},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   detail,
+	})
+}
+
+func writeScimResponse(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", scimContentType)
+	w.WriteHeader(status)
+	if status == http.StatusNoContent {
+		return
+	}
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func (c *scimController) GetServiceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	writeScimResponse(w, http.StatusOK, map[string]any{
+		"schemas":        []string{models.ScimSchemaServiceProviderConfig},
+		"patch":          map[string]bool{"supported": true},
+		"bulk":           map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]any{"supported": true, "maxResults": services.ScimMaxCount},
+		"changePassword": map[string]bool{"supported": false},
+		"sort":           map[string]bool{"supported": false},
+		"etag":           map[string]bool{"supported": false},
+		"authenticationSchemes": []map[string]any{{
+			"type":        "oauthbearertoken",
+			"name":        "OAuth Bearer Token",
+			"description": "Authentication using the platform's bearer tokens",
+			"primary":     true,
+		}},
+	})
+}
+
+// -----------------------------------------------------------------------------
+// Users
+// -----------------------------------------------------------------------------
+
+func (c *scimController) ListUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+	orgName := orgcontext.GetOrgContext(r.Context()).OrgName
+
+	query, err := parseScimListQuery(r)
+	if err != nil {
+		writeScimError(w, http.StatusBadRequest, "invalidValue", err.Error())
+		return
+	}
+
+	users, total, err := c.scimService.ListUsers(ctx, orgName, query)
+	if err != nil {
+		log.Error("ListUsers: failed to list SCIM users", "orgName", orgName, "error", err)
+		handleScimErrors(w, err, "Failed to list users")
+		return
+	}
+
+	resources := make([]any, len(users))
+	for i := range users {
+		setScimLocation(users[i].Meta, orgName, "Users", users[i].ID)
+		resources[i] = users[i]
+	}
+	writeScimResponse(w, http.StatusOK, newScimListResponse(resources, total, query))
+}
+
+func (c *scimController) GetUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+	orgName := orgcontext.GetOrgContext(r.Context()).OrgName
+	userID := r.PathValue("userID")
+
+	user, err := c.scimService.GetUser(ctx, orgName, userID)
+	if err != nil {
+		log.Error("GetUser: failed to get SCIM user", "orgName", orgName, "userID", userID, "error", err)
+		handleScimErrors(w, err, "Failed to get user")
+		return
+	}
+
+	setScimLocation(user.Meta, orgName, "Users", user.ID)
+	writeScimResponse(w, http.StatusOK, user)
+}
+
+func (c *scimController) CreateUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+	orgName := orgcontext.GetOrgContext(r.Context()).OrgName
+
+	var req models.ScimUserResource
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error("CreateUser: failed to decode request", "error", err)
+		writeScimError(w, http.StatusBadRequest, "invalidSyntax", "Invalid request body")
+		return
+	}
+
+	user, err := c.scimService.CreateUser(ctx, orgName, &req)
+	if err != nil {
+		log.Error("CreateUser: failed to provision SCIM user", "orgName", orgName, "error", err)
+		handleScimErrors(w, err, "Failed to create user")
+		return
+	}
+
+	setScimLocation(user.Meta, orgName, "Users", user.ID)
+	w.Header().Set("Location", user.Meta.Location)
+	writeScimResponse(w, http.StatusCreated, user)
+}
+
+func (c *scimController) ReplaceUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+	orgName := orgcontext.GetOrgContext(r.Context()).OrgName
+	userID := r.PathValue("userID")
+
+	var req models.ScimUserResource
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error("ReplaceUser: failed to decode request", "error", err)
+		writeScimError(w, http.StatusBadRequest, "invalidSyntax", "Invalid request body")
+		return
+	}
+
+	user, err := c.scimService.ReplaceUser(ctx, orgName, userID, &req)
+	if err != nil {
+		log.Error("ReplaceUser: failed to replace SCIM user", "orgName", orgName, "userID", userID, "error", err)
+		handleScimErrors(w, err, "Failed to update user")
+		return
+	}
+
+	setScimLocation(user.Meta, orgName, "Users", user.ID)
+	writeScimResponse(w, http.StatusOK, user)
+}
+
+func (c *scimController) PatchUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+	orgName := orgcontext.GetOrgContext(r.Context()).OrgName
+	userID := r.PathValue("userID")
+
+	var req models.ScimPatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error("PatchUser: failed to decode request", "error", err)
+		writeScimError(w, http.StatusBadRequest, "invalidSyntax", "Invalid request body")
+		return
+	}
+
+	user, err := c.scimService.PatchUser(ctx, orgName, userID, &req)
+	if err != nil {
+		log.Error("PatchUser: failed to patch SCIM user", "orgName", orgName, "userID", userID, "error", err)
+		handleScimErrors(w, err, "Failed to update user")
+		return
+	}
+
+	setScimLocation(user.Meta, orgName, "Users", user.ID)
+	writeScimResponse(w, http.StatusOK, user)
+}
+
+func (c *scimController) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+	orgName := orgcontext.GetOrgContext(r.Context()).OrgName
+	userID := r.PathValue("userID")
+
+	if err := c.scimService.DeleteUser(ctx, orgName, userID); err != nil {
+		log.Error("DeleteUser: failed to delete SCIM user", "orgName", orgName, "userID", userID, "error", err)
+		handleScimErrors(w, err, "Failed to delete user")
+		return
+	}
+
+	writeScimResponse(w, http.StatusNoContent, nil)
+}
+
+// -----------------------------------------------------------------------------
+// Groups
+// -----------------------------------------------------------------------------
+
+func (c *scimController) ListGroups(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+	orgName := orgcontext.GetOrgContext(r.Context()).OrgName
+
+	query, err := parseScimListQuery(r)
+	if err != nil {
+		writeScimError(w, http.StatusBadRequest, "invalidValue", err.Error())
+		return
+	}
+
+	groups, total, err := c.scimService.ListGroups(ctx, orgName, query)
+	if err != nil {
+		log.Error("ListGroups: failed to list SCIM groups", "orgName", orgName, "error", err)
+		handleScimErrors(w, err, "Failed to list groups")
+		return
+	}
+
+	resources := make([]any, len(groups))
+	for i := range groups {
+		setScimLocation(groups[i].Meta, orgName, "Groups", groups[i].ID)
+		resources[i] = groups[i]
+	}
+	writeScimResponse(w, http.StatusOK, newScimListResponse(resources, total, query))
+}
+
+func (c *scimController) GetGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+	orgName := orgcontext.GetOrgContext(r.Context()).OrgName
+	groupID := r.PathValue("groupID")
+
+	group, err := c.scimService.GetGroup(ctx, orgName, groupID)
+	if err != nil {
+		log.Error("GetGroup: failed to get SCIM group", "orgName", orgName, "groupID", groupID, "error", err)
+		handleScimErrors(w, err, "Failed to get group")
+		return
+	}
+
+	setScimLocation(group.Meta, orgName, "Groups", group.ID)
+	writeScimResponse(w, http.StatusOK, group)
+}
+
+func (c *scimController) CreateGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+	orgName := orgcontext.GetOrgContext(r.Context()).OrgName
+
+	var req models.ScimGroupResource
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error("CreateGroup: failed to decode request", "error", err)
+		writeScimError(w, http.StatusBadRequest, "invalidSyntax", "Invalid request body")
+		return
+	}
+
+	group, err := c.scimService.CreateGroup(ctx, orgName, &req)
+	if err != nil {
+		log.Error("CreateGroup: failed to provision SCIM group", "orgName", orgName, "error", err)
+		handleScimErrors(w, err, "Failed to create group")
+		return
+	}
+
+	setScimLocation(group.Meta, orgName, "Groups", group.ID)
+	w.Header().Set("Location", group.Meta.Location)
+	writeScimResponse(w, http.StatusCreated, group)
+}
+
+func (c *scimController) ReplaceGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+	orgName := orgcontext.GetOrgContext(r.Context()).OrgName
+	groupID := r.PathValue("groupID")
+
+	var req models.ScimGroupResource
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error("ReplaceGroup: failed to decode request", "error", err)
+		writeScimError(w, http.StatusBadRequest, "invalidSyntax", "Invalid request body")
+		return
+	}
+
+	group, err := c.scimService.ReplaceGroup(ctx, orgName, groupID, &req)
+	if err != nil {
+		log.Error("ReplaceGroup: failed to replace SCIM group", "orgName", orgName, "groupID", groupID, "error", err)
+		handleScimErrors(w, err, "Failed to update group")
+		return
+	}
+
+	setScimLocation(group.Meta, orgName, "Groups", group.ID)
+	writeScimResponse(w, http.StatusOK, group)
+}
+
+func (c *scimController) PatchGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+	orgName := orgcontext.GetOrgContext(r.Context()).OrgName
+	groupID := r.PathValue("groupID")
+
+	var req models.ScimPatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.Error("PatchGroup: failed to decode request", "error", err)
+		writeScimError(w, http.StatusBadRequest, "invalidSyntax", "Invalid request body")
+		return
+	}
+
+	group, err := c.scimService.PatchGroup(ctx, orgName, groupID, &req)
+	if err != nil {
+		log.Error("PatchGroup: failed to patch SCIM group", "orgName", orgName, "groupID", groupID, "error", err)
+		handleScimErrors(w, err, "Failed to update group")
+		return
+	}
+
+	setScimLocation(group.Meta, orgName, "Groups", group.ID)
+	writeScimResponse(w, http.StatusOK, group)
+}
+
+func (c *scimController) DeleteGroup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+	orgName := orgcontext.GetOrgContext(r.Context()).OrgName
+	groupID := r.PathValue("groupID")
+
+	if err := c.scimService.DeleteGroup(ctx, orgName, groupID); err != nil {
+		log.Error("DeleteGroup: failed to delete SCIM group", "orgName", orgName, "groupID", groupID, "error", err)
+		handleScimErrors(w, err, "Failed to delete group")
+		return
+	}
+
+	writeScimResponse(w, http.StatusNoContent, nil)
+}
+
+// -----------------------------------------------------------------------------
+// Helpers
+// -----------------------------------------------------------------------------
+
+// parseScimListQuery reads the filter, startIndex and count parameters. Per RFC 7644 a startIndex
+// below 1 is treated as 1 and a negative count as 0.
+func parseScimListQuery(r *http.Request) (models.ScimListQuery, error) {
+	query := models.ScimListQuery{
+		Filter:     r.URL.Query().Get("filter"),
+		StartIndex: 1,
+		Count:      services.ScimDefaultCount,
+	}
+	if v := r.URL.Query().Get("startIndex"); v != "" {
+		startIndex, err := strconv.Atoi(v)
+		if err != nil {
+			return query, fmt.Errorf("invalid startIndex: %s", v)
+		}
+		query.StartIndex = max(startIndex, 1)
+	}
+	if v := r.URL.Query().Get("count"); v != "" {
+		count, err := strconv.Atoi(v)
+		if err != nil {
+			return query, fmt.Errorf("invalid count: %s", v)
+		}
+		query.Count = min(max(count, 0), services.ScimMaxCount)
+	}
+	return query, nil
+}
+
+func newScimListResponse(resources []any, total int64, query models.ScimListQuery) models.ScimListResponse {
+	return models.ScimListResponse{
+		Schemas:      []string{models.ScimSchemaListResponse},
+		TotalResults: total,
+		StartIndex:   query.StartIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	}
+}
+
+func setScimLocation(meta *models.ScimMeta, orgName string, resourceType string, id string) {
+	if meta == nil {
+		return
+	}
+	meta.Location = fmt.Sprintf("/api/v1/orgs/%s/scim/v2/%s/%s", orgName, resourceType, id)
+}
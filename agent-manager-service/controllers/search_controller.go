@@ -0,0 +1,85 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// maxSearchQueryLength is the longest search query accepted
+const maxSearchQueryLength = 100
+
+// SearchController defines the interface for the search HTTP handlers
+type SearchController interface {
+	Search(w http.ResponseWriter, r *http.Request)
+}
+
+type searchController struct {
+	searchService services.SearchService
+}
+
+// NewSearchController creates a new search controller
+func NewSearchController(searchService services.SearchService) SearchController {
+	return &searchController{
+		searchService: searchService,
+	}
+}
+
+func (c *searchController) Search(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.GetLogger(ctx)
+
+	orgName := r.PathValue(utils.PathParamOrgName)
+
+	query := strings.TrimSpace(r.URL.Query().Get("q"))
+	if query == "" || len(query) > maxSearchQueryLength {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid q parameter")
+		return
+	}
+	limit := getIntQueryParam(r, "limit", utils.DefaultLimit)
+	if limit < utils.MinLimit || limit > utils.MaxLimit {
+		utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid limit parameter")
+		return
+	}
+	var types []string
+	if v := r.URL.Query().Get("types"); v != "" {
+		for _, t := range strings.Split(v, ",") {
+			t = strings.TrimSpace(t)
+			if !slices.Contains(models.SearchResultTypes, t) {
+				utils.WriteErrorResponse(w, http.StatusBadRequest, "Invalid types parameter")
+				return
+			}
+			types = append(types, t)
+		}
+	}
+
+	results, err := c.searchService.Search(ctx, orgName, query, types, limit)
+	if err != nil {
+		log.Error("Search: failed to search resources", "orgName", orgName, "error", err)
+		utils.WriteError(w, err, "Failed to search resources")
+		return
+	}
+
+	utils.WriteSuccessResponse(w, http.StatusOK, results)
+}
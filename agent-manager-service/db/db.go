@@ -28,6 +28,7 @@ import (
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
+	"gorm.io/plugin/opentelemetry/tracing"
 
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db/connpool"
@@ -36,7 +37,12 @@ import (
 var db *gorm.DB
 
 func init() {
-	db = initDbConn(config.GetConfig().POSTGRESQL)
+	cfg := config.GetConfig()
+	if cfg.DBDriver == config.DBDriverSQLite {
+		db = initSQLiteConn(cfg.SQLitePath, cfg.POSTGRESQL.DbConfigs)
+		return
+	}
+	db = initDbConn(cfg.POSTGRESQL)
 }
 
 // slogWriter implements the GORM logger Writer interface using slog
@@ -65,19 +71,10 @@ func initDbConn(cfg config.POSTGRESQL) *gorm.DB {
 		},
 	})
 
-	gormLogger := logger.New(
-		slogWriter{},
-		logger.Config{
-			SlowThreshold:             time.Duration(cfg.SlowThresholdMilliseconds) * time.Millisecond,
-			IgnoreRecordNotFoundError: true,
-			LogLevel:                  logger.Warn,
-		},
-	)
-
 	// Open PostgreSQL connection
 	dialector := postgres.Open(dsn)
 	gormDB, err := gorm.Open(dialector, &gorm.Config{
-		Logger:                 gormLogger,
+		Logger:                 newGormLogger(cfg.DbConfigs),
 		SkipDefaultTransaction: cfg.SkipDefaultTransaction,
 		PrepareStmt:            false,
 		FullSaveAssociations:   false,
@@ -87,10 +84,34 @@ func initDbConn(cfg config.POSTGRESQL) *gorm.DB {
 		slog.Error("initDbConn: gorm.Open failed", "error", err)
 		os.Exit(1)
 	}
+	useTracing(gormDB, "postgresql")
+	useReadReplicas(gormDB, cfg.DbConfigs)
+	useStatementTimeouts(gormDB, cfg.DbConfigs)
 	slog.Info("database connected")
 	return gormDB
 }
 
+func newGormLogger(cfg config.DbConfigs) logger.Interface {
+	return logger.New(
+		slogWriter{},
+		logger.Config{
+			SlowThreshold:             time.Duration(cfg.SlowThresholdMilliseconds) * time.Millisecond,
+			IgnoreRecordNotFoundError: true,
+			LogLevel:                  logger.Warn,
+		},
+	)
+}
+
+// useTracing registers the GORM tracing plugin. Spans are recorded through the global tracer
+// provider, which is installed at startup. Query variables are omitted so that secrets never
+// end up in span attributes.
+func useTracing(gormDB *gorm.DB, dbSystem string) {
+	if err := gormDB.Use(tracing.NewPlugin(tracing.WithoutMetrics(), tracing.WithoutQueryVariables(), tracing.WithDBSystem(dbSystem))); err != nil {
+		slog.Error("initDbConn: failed to register tracing plugin", "error", err)
+		os.Exit(1)
+	}
+}
+
 func setConfigsOnDB(db *sql.DB, cfg config.DbConfigs) {
 	if cfg.MaxIdleTimeSeconds != nil {
 		db.SetConnMaxIdleTime(time.Duration(*cfg.MaxIdleTimeSeconds) * time.Second)
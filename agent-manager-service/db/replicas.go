@@ -0,0 +1,73 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package db
+
+import (
+	"log/slog"
+	"os"
+	"time"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+)
+
+// useReadReplicas routes reads to the configured replicas. dbresolver keeps writes, raw
+// statements that modify data, and everything inside a transaction on the primary, so
+// read-after-write within a transaction stays consistent.
+func useReadReplicas(gormDB *gorm.DB, cfg config.DbConfigs) {
+	if len(cfg.ReadReplicaDSNs) == 0 {
+		return
+	}
+
+	replicas := make([]gorm.Dialector, 0, len(cfg.ReadReplicaDSNs))
+	for _, dsn := range cfg.ReadReplicaDSNs {
+		replicas = append(replicas, postgres.Open(dsn))
+	}
+
+	resolver := dbresolver.Register(dbresolver.Config{
+		Replicas:          replicas,
+		Policy:            dbresolver.RandomPolicy{},
+		TraceResolverMode: true,
+	})
+	if cfg.MaxIdleTimeSeconds != nil {
+		resolver.SetConnMaxIdleTime(time.Duration(*cfg.MaxIdleTimeSeconds) * time.Second)
+	}
+	if cfg.MaxLifetimeSeconds != nil {
+		resolver.SetConnMaxLifetime(time.Duration(*cfg.MaxLifetimeSeconds) * time.Second)
+	}
+	if cfg.MaxOpenCount != nil {
+		resolver.SetMaxOpenConns(int(*cfg.MaxOpenCount))
+	}
+	if cfg.MaxIdleCount != nil {
+		resolver.SetMaxIdleConns(int(*cfg.MaxIdleCount))
+	}
+
+	if err := gormDB.Use(resolver); err != nil {
+		slog.Error("initDbConn: failed to register read replicas", "error", err)
+		os.Exit(1)
+	}
+	slog.Info("read replicas configured", "count", len(replicas))
+}
+
+// Primary forces the statements of conn to run on the primary database. Use it for reads that
+// must observe a write made earlier outside of a transaction.
+func Primary(conn *gorm.DB) *gorm.DB {
+	return conn.Clauses(dbresolver.Write)
+}
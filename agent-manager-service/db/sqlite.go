@@ -0,0 +1,66 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package db
+
+import (
+	"log/slog"
+	"net/url"
+	"os"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+)
+
+// DialectSQLite is the GORM dialector name of the SQLite driver
+const DialectSQLite = "sqlite"
+
+// initSQLiteConn opens the SQLite database used for local development. The pure Go driver
+// avoids a cgo toolchain requirement on developer machines.
+func initSQLiteConn(path string, cfg config.DbConfigs) *gorm.DB {
+	params := url.Values{}
+	params.Add("_pragma", "foreign_keys(1)")
+	params.Add("_pragma", "journal_mode(WAL)")
+	params.Add("_pragma", "busy_timeout(5000)")
+	dsn := path + "?" + params.Encode()
+
+	gormDB, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{
+		Logger:                 newGormLogger(cfg),
+		SkipDefaultTransaction: cfg.SkipDefaultTransaction,
+	})
+	if err != nil {
+		slog.Error("initSQLiteConn: gorm.Open failed", "error", err)
+		os.Exit(1)
+	}
+	sqlDB, err := gormDB.DB()
+	if err != nil {
+		slog.Error("initSQLiteConn: failed to get sql.DB", "error", err)
+		os.Exit(1)
+	}
+	setConfigsOnDB(sqlDB, cfg)
+	useTracing(gormDB, "sqlite")
+	useStatementTimeouts(gormDB, cfg)
+
+	slog.Warn("using SQLite database; this mode is intended for local development only", "path", path)
+	return gormDB
+}
+
+// IsSQLite reports whether the given connection uses the SQLite driver
+func IsSQLite(conn *gorm.DB) bool {
+	return conn.Dialector.Name() == DialectSQLite
+}
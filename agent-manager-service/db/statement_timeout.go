@@ -0,0 +1,103 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package db
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+)
+
+const statementCancelKey = "agent-manager:statement_cancel"
+
+// statementTimeouts is a GORM plugin that gives every read and write statement its own
+// deadline, on top of the operation timeout applied by DB(ctx)
+type statementTimeouts struct {
+	read  time.Duration
+	write time.Duration
+}
+
+func (p *statementTimeouts) Name() string {
+	return "agent-manager:statement_timeouts"
+}
+
+func (p *statementTimeouts) Initialize(gormDB *gorm.DB) error {
+	cb := gormDB.Callback()
+	if p.read > 0 {
+		before := withStatementTimeout(p.read)
+		if err := cb.Query().Before("gorm:query").Register("timeout:before_query", before); err != nil {
+			return err
+		}
+		if err := cb.Query().After("gorm:query").Register("timeout:after_query", cancelStatementContext); err != nil {
+			return err
+		}
+	}
+	if p.write > 0 {
+		before := withStatementTimeout(p.write)
+		if err := cb.Create().Before("gorm:create").Register("timeout:before_create", before); err != nil {
+			return err
+		}
+		if err := cb.Create().After("gorm:create").Register("timeout:after_create", cancelStatementContext); err != nil {
+			return err
+		}
+		if err := cb.Update().Before("gorm:update").Register("timeout:before_update", before); err != nil {
+			return err
+		}
+		if err := cb.Update().After("gorm:update").Register("timeout:after_update", cancelStatementContext); err != nil {
+			return err
+		}
+		if err := cb.Delete().Before("gorm:delete").Register("timeout:before_delete", before); err != nil {
+			return err
+		}
+		if err := cb.Delete().After("gorm:delete").Register("timeout:after_delete", cancelStatementContext); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func withStatementTimeout(timeout time.Duration) func(*gorm.DB) {
+	return func(tx *gorm.DB) {
+		ctx, cancel := context.WithTimeout(tx.Statement.Context, timeout)
+		tx.Statement.Context = ctx
+		tx.Statement.Settings.Store(statementCancelKey, cancel)
+	}
+}
+
+func cancelStatementContext(tx *gorm.DB) {
+	if cancel, ok := tx.Statement.Settings.LoadAndDelete(statementCancelKey); ok {
+		cancel.(context.CancelFunc)()
+	}
+}
+
+func useStatementTimeouts(gormDB *gorm.DB, cfg config.DbConfigs) {
+	if cfg.QueryTimeoutSeconds == 0 && cfg.WriteTimeoutSeconds == 0 {
+		return
+	}
+	if err := gormDB.Use(&statementTimeouts{
+		read:  time.Duration(cfg.QueryTimeoutSeconds) * time.Second,
+		write: time.Duration(cfg.WriteTimeoutSeconds) * time.Second,
+	}); err != nil {
+		slog.Error("initDbConn: failed to register statement timeouts", "error", err)
+		os.Exit(1)
+	}
+}
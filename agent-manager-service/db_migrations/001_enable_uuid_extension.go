@@ -25,9 +25,17 @@ var migration001 = migration{
 	ID: 1,
 	Migrate: func(db *gorm.DB) error {
 		enableUUIDExtension := `CREATE EXTENSION IF NOT EXISTS "uuid-ossp"`
+		// SQLite has no extensions; UUIDs are generated by the application
+		if db.Dialector.Name() == "sqlite" {
+			return nil
+		}
 
 		return db.Transaction(func(tx *gorm.DB) error {
 			return runSQL(tx, enableUUIDExtension)
 		})
 	},
+	// The extension may be shared with other schemas in the database, so it is left in place
+	Rollback: func(db *gorm.DB) error {
+		return nil
+	},
 }
@@ -44,8 +44,31 @@ var migration002 = migration{
 			CREATE INDEX idx_environments_org ON environments(organization_name);
 			CREATE INDEX idx_environments_deleted ON environments(deleted_at);
 		`
+		createEnvironmentsSQLite := `
+			CREATE TABLE environments (
+				uuid TEXT PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				name VARCHAR(64) NOT NULL,
+				display_name VARCHAR(128) NOT NULL,
+				description TEXT,
+				dataplane_ref VARCHAR(100) NOT NULL DEFAULT 'default',
+				dns_prefix VARCHAR(100) NOT NULL DEFAULT 'default',
+				is_production BOOLEAN NOT NULL DEFAULT FALSE,
+				created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				deleted_at TIMESTAMP,
+
+				UNIQUE(organization_name, name)
+			);
+
+			CREATE INDEX idx_environments_org ON environments(organization_name);
+			CREATE INDEX idx_environments_deleted ON environments(deleted_at);
+		`
 		return db.Transaction(func(tx *gorm.DB) error {
-			return runSQL(tx, createEnvironmentsSQL)
+			return runSQL(tx, dialectSQL(tx, createEnvironmentsSQL, createEnvironmentsSQLite))
 		})
 	},
+	Rollback: func(db *gorm.DB) error {
+		return runSQL(db, `DROP TABLE IF EXISTS environments`)
+	},
 }
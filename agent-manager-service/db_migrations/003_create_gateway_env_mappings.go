@@ -33,9 +33,23 @@ var migration003 = migration{
 		);
 		CREATE INDEX idx_gem_gateway ON gateway_environment_mappings(gateway_uuid);
 		CREATE INDEX idx_gem_environment ON gateway_environment_mappings(environment_uuid);
+	`
+		sqliteSQL := `
+		CREATE TABLE gateway_environment_mappings (
+				id INTEGER PRIMARY KEY AUTOINCREMENT,
+				gateway_uuid TEXT NOT NULL,
+				environment_uuid TEXT NOT NULL REFERENCES environments(uuid) ON DELETE CASCADE,
+				created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				UNIQUE(gateway_uuid, environment_uuid)
+		);
+		CREATE INDEX idx_gem_gateway ON gateway_environment_mappings(gateway_uuid);
+		CREATE INDEX idx_gem_environment ON gateway_environment_mappings(environment_uuid);
 	`
 		return db.Transaction(func(tx *gorm.DB) error {
-			return runSQL(tx, sql)
+			return runSQL(tx, dialectSQL(tx, sql, sqliteSQL))
 		})
 	},
+	Rollback: func(db *gorm.DB) error {
+		return runSQL(db, `DROP TABLE IF EXISTS gateway_environment_mappings`)
+	},
 }
@@ -39,8 +39,26 @@ var migration004 = migration{
 			CREATE INDEX idx_organizations_handle ON organizations(handle);
 			CREATE INDEX idx_organizations_deleted ON organizations(deleted_at);
 		`
+		createOrganizationsSQLite := `
+			CREATE TABLE organizations (
+				uuid TEXT PRIMARY KEY,
+				name VARCHAR(100) NOT NULL UNIQUE,
+				handle VARCHAR(100) NOT NULL UNIQUE,
+				region VARCHAR(50) NOT NULL DEFAULT 'US',
+				created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				deleted_at TIMESTAMP
+			);
+
+			CREATE INDEX idx_organizations_name ON organizations(name);
+			CREATE INDEX idx_organizations_handle ON organizations(handle);
+			CREATE INDEX idx_organizations_deleted ON organizations(deleted_at);
+		`
 		return db.Transaction(func(tx *gorm.DB) error {
-			return runSQL(tx, createOrganizationsSQL)
+			return runSQL(tx, dialectSQL(tx, createOrganizationsSQL, createOrganizationsSQLite))
 		})
 	},
+	Rollback: func(db *gorm.DB) error {
+		return runSQL(db, `DROP TABLE IF EXISTS organizations`)
+	},
 }
@@ -0,0 +1,67 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Add lifecycle columns to organizations so they can be onboarded, suspended and described via the API
+var migration005 = migration{
+	ID: 5,
+	Migrate: func(db *gorm.DB) error {
+		alterOrganizationsSQL := `
+			ALTER TABLE organizations
+				ADD COLUMN display_name VARCHAR(128) NOT NULL DEFAULT '',
+				ADD COLUMN description TEXT NOT NULL DEFAULT '',
+				ADD COLUMN metadata JSONB NOT NULL DEFAULT '{}',
+				ADD COLUMN default_gateway_config JSONB,
+				ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'ACTIVE',
+				ADD COLUMN suspended_at TIMESTAMP;
+
+			CREATE INDEX idx_organizations_status ON organizations(status);
+		`
+		// SQLite only supports a single column per ALTER TABLE statement
+		alterOrganizationsSQLite := []string{
+			`ALTER TABLE organizations ADD COLUMN display_name VARCHAR(128) NOT NULL DEFAULT ''`,
+			`ALTER TABLE organizations ADD COLUMN description TEXT NOT NULL DEFAULT ''`,
+			`ALTER TABLE organizations ADD COLUMN metadata TEXT NOT NULL DEFAULT '{}'`,
+			`ALTER TABLE organizations ADD COLUMN default_gateway_config TEXT`,
+			`ALTER TABLE organizations ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'ACTIVE'`,
+			`ALTER TABLE organizations ADD COLUMN suspended_at TIMESTAMP`,
+			`CREATE INDEX idx_organizations_status ON organizations(status)`,
+		}
+		return db.Transaction(func(tx *gorm.DB) error {
+			if isSQLite(tx) {
+				return runSQL(tx, alterOrganizationsSQLite...)
+			}
+			return runSQL(tx, alterOrganizationsSQL)
+		})
+	},
+	Rollback: func(db *gorm.DB) error {
+		dropColumnsSQL := []string{
+			`DROP INDEX IF EXISTS idx_organizations_status`,
+			`ALTER TABLE organizations DROP COLUMN suspended_at`,
+			`ALTER TABLE organizations DROP COLUMN status`,
+			`ALTER TABLE organizations DROP COLUMN default_gateway_config`,
+			`ALTER TABLE organizations DROP COLUMN metadata`,
+			`ALTER TABLE organizations DROP COLUMN description`,
+			`ALTER TABLE organizations DROP COLUMN display_name`,
+		}
+		return runSQL(db, dropColumnsSQL...)
+	},
+}
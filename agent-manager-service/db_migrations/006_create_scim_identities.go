@@ -0,0 +1,112 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Create tables for users and groups provisioned by identity providers over SCIM
+var migration006 = migration{
+	ID: 6,
+	Migrate: func(db *gorm.DB) error {
+		createScimTablesSQL := `
+			CREATE TABLE scim_users (
+				uuid UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+				organization_name VARCHAR(100) NOT NULL,
+				user_name VARCHAR(255) NOT NULL,
+				external_id VARCHAR(255),
+				display_name VARCHAR(255) NOT NULL DEFAULT '',
+				given_name VARCHAR(255) NOT NULL DEFAULT '',
+				family_name VARCHAR(255) NOT NULL DEFAULT '',
+				emails JSONB NOT NULL DEFAULT '[]',
+				active BOOLEAN NOT NULL DEFAULT TRUE,
+				created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+				updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+				UNIQUE(organization_name, user_name)
+			);
+
+			CREATE INDEX idx_scim_users_external_id ON scim_users(organization_name, external_id);
+
+			CREATE TABLE scim_groups (
+				uuid UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+				organization_name VARCHAR(100) NOT NULL,
+				display_name VARCHAR(255) NOT NULL,
+				external_id VARCHAR(255),
+				created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+				updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+				UNIQUE(organization_name, display_name)
+			);
+
+			CREATE TABLE scim_group_members (
+				group_uuid UUID NOT NULL REFERENCES scim_groups(uuid) ON DELETE CASCADE,
+				user_uuid UUID NOT NULL REFERENCES scim_users(uuid) ON DELETE CASCADE,
+				created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+				PRIMARY KEY (group_uuid, user_uuid)
+			);
+
+			CREATE INDEX idx_scim_group_members_user ON scim_group_members(user_uuid);
+		`
+		createScimTablesSQLite := `
+			CREATE TABLE scim_users (
+				uuid TEXT PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				user_name VARCHAR(255) NOT NULL,
+				external_id VARCHAR(255),
+				display_name VARCHAR(255) NOT NULL DEFAULT '',
+				given_name VARCHAR(255) NOT NULL DEFAULT '',
+				family_name VARCHAR(255) NOT NULL DEFAULT '',
+				emails TEXT NOT NULL DEFAULT '[]',
+				active BOOLEAN NOT NULL DEFAULT TRUE,
+				created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				UNIQUE(organization_name, user_name)
+			);
+
+			CREATE INDEX idx_scim_users_external_id ON scim_users(organization_name, external_id);
+
+			CREATE TABLE scim_groups (
+				uuid TEXT PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				display_name VARCHAR(255) NOT NULL,
+				external_id VARCHAR(255),
+				created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				UNIQUE(organization_name, display_name)
+			);
+
+			CREATE TABLE scim_group_members (
+				group_uuid TEXT NOT NULL REFERENCES scim_groups(uuid) ON DELETE CASCADE,
+				user_uuid TEXT NOT NULL REFERENCES scim_users(uuid) ON DELETE CASCADE,
+				created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				PRIMARY KEY (group_uuid, user_uuid)
+			);
+
+			CREATE INDEX idx_scim_group_members_user ON scim_group_members(user_uuid);
+		`
+		return db.Transaction(func(tx *gorm.DB) error {
+			return runSQL(tx, dialectSQL(tx, createScimTablesSQL, createScimTablesSQLite))
+		})
+	},
+	Rollback: func(db *gorm.DB) error {
+		return runSQL(db,
+			`DROP TABLE IF EXISTS scim_group_members`,
+			`DROP TABLE IF EXISTS scim_groups`,
+			`DROP TABLE IF EXISTS scim_users`,
+		)
+	},
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Create the MCP server registry and the bindings of agents to registered servers
+var migration007 = migration{
+	ID: 7,
+	Migrate: func(db *gorm.DB) error {
+		createMCPTablesSQL := `
+			CREATE TABLE mcp_servers (
+				uuid UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+				organization_name VARCHAR(100) NOT NULL,
+				name VARCHAR(100) NOT NULL,
+				display_name VARCHAR(255) NOT NULL DEFAULT '',
+				description TEXT NOT NULL DEFAULT '',
+				url VARCHAR(2048) NOT NULL,
+				gateway_url VARCHAR(2048) NOT NULL DEFAULT '',
+				auth_type VARCHAR(20) NOT NULL DEFAULT 'none',
+				auth_header VARCHAR(255) NOT NULL DEFAULT '',
+				encrypted_credential BYTEA,
+				tool_discovery BOOLEAN NOT NULL DEFAULT TRUE,
+				tools JSONB NOT NULL DEFAULT '[]',
+				tools_fetched_at TIMESTAMP,
+				last_fetch_error TEXT NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+				updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+				UNIQUE(organization_name, name)
+			);
+
+			CREATE TABLE agent_mcp_servers (
+				organization_name VARCHAR(100) NOT NULL,
+				project_name VARCHAR(100) NOT NULL,
+				agent_name VARCHAR(100) NOT NULL,
+				mcp_server_uuid UUID NOT NULL REFERENCES mcp_servers(uuid) ON DELETE CASCADE,
+				created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+				PRIMARY KEY (organization_name, project_name, agent_name, mcp_server_uuid)
+			);
+
+			CREATE INDEX idx_agent_mcp_servers_server ON agent_mcp_servers(mcp_server_uuid);
+		`
+		createMCPTablesSQLite := `
+			CREATE TABLE mcp_servers (
+				uuid TEXT PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				name VARCHAR(100) NOT NULL,
+				display_name VARCHAR(255) NOT NULL DEFAULT '',
+				description TEXT NOT NULL DEFAULT '',
+				url VARCHAR(2048) NOT NULL,
+				gateway_url VARCHAR(2048) NOT NULL DEFAULT '',
+				auth_type VARCHAR(20) NOT NULL DEFAULT 'none',
+				auth_header VARCHAR(255) NOT NULL DEFAULT '',
+				encrypted_credential BLOB,
+				tool_discovery BOOLEAN NOT NULL DEFAULT TRUE,
+				tools TEXT NOT NULL DEFAULT '[]',
+				tools_fetched_at TIMESTAMP,
+				last_fetch_error TEXT NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				UNIQUE(organization_name, name)
+			);
+
+			CREATE TABLE agent_mcp_servers (
+				organization_name VARCHAR(100) NOT NULL,
+				project_name VARCHAR(100) NOT NULL,
+				agent_name VARCHAR(100) NOT NULL,
+				mcp_server_uuid TEXT NOT NULL REFERENCES mcp_servers(uuid) ON DELETE CASCADE,
+				created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				PRIMARY KEY (organization_name, project_name, agent_name, mcp_server_uuid)
+			);
+
+			CREATE INDEX idx_agent_mcp_servers_server ON agent_mcp_servers(mcp_server_uuid);
+		`
+		return db.Transaction(func(tx *gorm.DB) error {
+			return runSQL(tx, dialectSQL(tx, createMCPTablesSQL, createMCPTablesSQLite))
+		})
+	},
+	Rollback: func(db *gorm.DB) error {
+		return runSQL(db,
+			`DROP TABLE IF EXISTS agent_mcp_servers`,
+			`DROP TABLE IF EXISTS mcp_servers`,
+		)
+	},
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Record every agent deployment as an immutable revision so that it can be rolled back to
+var migration008 = migration{
+	ID: 8,
+	Migrate: func(db *gorm.DB) error {
+		createRevisionsTableSQL := `
+			CREATE TABLE agent_deployment_revisions (
+				uuid UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+				organization_name VARCHAR(100) NOT NULL,
+				project_name VARCHAR(100) NOT NULL,
+				agent_name VARCHAR(100) NOT NULL,
+				revision INTEGER NOT NULL,
+				environment VARCHAR(100) NOT NULL DEFAULT '',
+				image_id VARCHAR(2048) NOT NULL,
+				env JSONB NOT NULL DEFAULT '[]',
+				rollback_of INTEGER,
+				created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+				UNIQUE(organization_name, project_name, agent_name, revision)
+			);
+		`
+		createRevisionsTableSQLite := `
+			CREATE TABLE agent_deployment_revisions (
+				uuid TEXT PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				project_name VARCHAR(100) NOT NULL,
+				agent_name VARCHAR(100) NOT NULL,
+				revision INTEGER NOT NULL,
+				environment VARCHAR(100) NOT NULL DEFAULT '',
+				image_id VARCHAR(2048) NOT NULL,
+				env TEXT NOT NULL DEFAULT '[]',
+				rollback_of INTEGER,
+				created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				UNIQUE(organization_name, project_name, agent_name, revision)
+			);
+		`
+		return db.Transaction(func(tx *gorm.DB) error {
+			return runSQL(tx, dialectSQL(tx, createRevisionsTableSQL, createRevisionsTableSQLite))
+		})
+	},
+	Rollback: func(db *gorm.DB) error {
+		return runSQL(db, `DROP TABLE IF EXISTS agent_deployment_revisions`)
+	},
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Create the per organization trace retention policies and the state of their enforcement
+var migration009 = migration{
+	ID: 9,
+	Migrate: func(db *gorm.DB) error {
+		createRetentionTableSQL := `
+			CREATE TABLE trace_retention_policies (
+				organization_name VARCHAR(100) PRIMARY KEY,
+				retention_days INTEGER NOT NULL,
+				error_retention_days INTEGER NOT NULL DEFAULT 0,
+				last_enforced_at TIMESTAMP,
+				last_enforcement_task VARCHAR(255) NOT NULL DEFAULT '',
+				last_enforcement_error TEXT NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+				updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+			);
+		`
+		createRetentionTableSQLite := `
+			CREATE TABLE trace_retention_policies (
+				organization_name VARCHAR(100) PRIMARY KEY,
+				retention_days INTEGER NOT NULL,
+				error_retention_days INTEGER NOT NULL DEFAULT 0,
+				last_enforced_at TIMESTAMP,
+				last_enforcement_task VARCHAR(255) NOT NULL DEFAULT '',
+				last_enforcement_error TEXT NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+			);
+		`
+		return db.Transaction(func(tx *gorm.DB) error {
+			return runSQL(tx, dialectSQL(tx, createRetentionTableSQL, createRetentionTableSQLite))
+		})
+	},
+	Rollback: func(db *gorm.DB) error {
+		return runSQL(db, `DROP TABLE IF EXISTS trace_retention_policies`)
+	},
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Create the audit records of requests to erase the spans carrying a personal identifier
+var migration010 = migration{
+	ID: 10,
+	Migrate: func(db *gorm.DB) error {
+		createErasureTableSQL := `
+			CREATE TABLE trace_erasure_requests (
+				uuid UUID PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				attribute VARCHAR(255) NOT NULL,
+				value_hash VARCHAR(64) NOT NULL,
+				requested_by VARCHAR(255) NOT NULL DEFAULT '',
+				task_id VARCHAR(255) NOT NULL DEFAULT '',
+				status VARCHAR(20) NOT NULL,
+				total_spans BIGINT NOT NULL DEFAULT 0,
+				deleted_spans BIGINT NOT NULL DEFAULT 0,
+				error TEXT NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+				completed_at TIMESTAMP
+			);
+			CREATE INDEX idx_trace_erasure_requests_org ON trace_erasure_requests(organization_name, created_at);
+		`
+		createErasureTableSQLite := `
+			CREATE TABLE trace_erasure_requests (
+				uuid TEXT PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				attribute VARCHAR(255) NOT NULL,
+				value_hash VARCHAR(64) NOT NULL,
+				requested_by VARCHAR(255) NOT NULL DEFAULT '',
+				task_id VARCHAR(255) NOT NULL DEFAULT '',
+				status VARCHAR(20) NOT NULL,
+				total_spans INTEGER NOT NULL DEFAULT 0,
+				deleted_spans INTEGER NOT NULL DEFAULT 0,
+				error TEXT NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				completed_at TIMESTAMP
+			);
+			CREATE INDEX idx_trace_erasure_requests_org ON trace_erasure_requests(organization_name, created_at);
+		`
+		return db.Transaction(func(tx *gorm.DB) error {
+			return runSQL(tx, dialectSQL(tx, createErasureTableSQL, createErasureTableSQLite))
+		})
+	},
+	Rollback: func(db *gorm.DB) error {
+		return runSQL(db, `DROP TABLE IF EXISTS trace_erasure_requests`)
+	},
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Create the records linking replayed traces to the traces whose input they replayed
+var migration011 = migration{
+	ID: 11,
+	Migrate: func(db *gorm.DB) error {
+		createReplayTableSQL := `
+			CREATE TABLE trace_replays (
+				uuid UUID PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				project_name VARCHAR(100) NOT NULL,
+				agent_name VARCHAR(100) NOT NULL,
+				environment_name VARCHAR(100) NOT NULL,
+				original_trace_id VARCHAR(64) NOT NULL,
+				replay_trace_id VARCHAR(64) NOT NULL,
+				endpoint_url TEXT NOT NULL,
+				status_code INTEGER NOT NULL DEFAULT 0,
+				error TEXT NOT NULL DEFAULT '',
+				requested_by VARCHAR(255) NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT NOW()
+			);
+			CREATE INDEX idx_trace_replays_original ON trace_replays(organization_name, project_name, agent_name, original_trace_id);
+		`
+		createReplayTableSQLite := `
+			CREATE TABLE trace_replays (
+				uuid TEXT PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				project_name VARCHAR(100) NOT NULL,
+				agent_name VARCHAR(100) NOT NULL,
+				environment_name VARCHAR(100) NOT NULL,
+				original_trace_id VARCHAR(64) NOT NULL,
+				replay_trace_id VARCHAR(64) NOT NULL,
+				endpoint_url TEXT NOT NULL,
+				status_code INTEGER NOT NULL DEFAULT 0,
+				error TEXT NOT NULL DEFAULT '',
+				requested_by VARCHAR(255) NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+			);
+			CREATE INDEX idx_trace_replays_original ON trace_replays(organization_name, project_name, agent_name, original_trace_id);
+		`
+		return db.Transaction(func(tx *gorm.DB) error {
+			return runSQL(tx, dialectSQL(tx, createReplayTableSQL, createReplayTableSQLite))
+		})
+	},
+	Rollback: func(db *gorm.DB) error {
+		return runSQL(db, `DROP TABLE IF EXISTS trace_replays`)
+	},
+}
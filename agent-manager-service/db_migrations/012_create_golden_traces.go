@@ -0,0 +1,109 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Create the golden trace snapshots and the runs comparing replayed traces against them
+var migration012 = migration{
+	ID: 12,
+	Migrate: func(db *gorm.DB) error {
+		createGoldenTablesSQL := `
+			CREATE TABLE golden_traces (
+				uuid UUID PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				project_name VARCHAR(100) NOT NULL,
+				agent_name VARCHAR(100) NOT NULL,
+				environment_name VARCHAR(100) NOT NULL,
+				name VARCHAR(100) NOT NULL,
+				trace_id VARCHAR(64) NOT NULL,
+				snapshot TEXT NOT NULL,
+				endpoint VARCHAR(100) NOT NULL DEFAULT '',
+				path VARCHAR(255) NOT NULL DEFAULT '',
+				threshold DOUBLE PRECISION NOT NULL,
+				replay_interval_minutes INTEGER NOT NULL DEFAULT 0,
+				next_run_at TIMESTAMP,
+				created_by VARCHAR(255) NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+				updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+				UNIQUE(organization_name, project_name, agent_name, name)
+			);
+			CREATE TABLE golden_trace_runs (
+				uuid UUID PRIMARY KEY,
+				golden_trace_uuid UUID NOT NULL REFERENCES golden_traces(uuid) ON DELETE CASCADE,
+				replay_trace_id VARCHAR(64) NOT NULL DEFAULT '',
+				replay_parent_span_id VARCHAR(16) NOT NULL DEFAULT '',
+				status VARCHAR(20) NOT NULL,
+				structural_score DOUBLE PRECISION,
+				semantic_score DOUBLE PRECISION,
+				diff TEXT NOT NULL DEFAULT '',
+				error TEXT NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+				completed_at TIMESTAMP
+			);
+			CREATE INDEX idx_golden_trace_runs_golden ON golden_trace_runs(golden_trace_uuid, created_at);
+			CREATE INDEX idx_golden_trace_runs_status ON golden_trace_runs(status);
+		`
+		createGoldenTablesSQLite := `
+			CREATE TABLE golden_traces (
+				uuid TEXT PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				project_name VARCHAR(100) NOT NULL,
+				agent_name VARCHAR(100) NOT NULL,
+				environment_name VARCHAR(100) NOT NULL,
+				name VARCHAR(100) NOT NULL,
+				trace_id VARCHAR(64) NOT NULL,
+				snapshot TEXT NOT NULL,
+				endpoint VARCHAR(100) NOT NULL DEFAULT '',
+				path VARCHAR(255) NOT NULL DEFAULT '',
+				threshold REAL NOT NULL,
+				replay_interval_minutes INTEGER NOT NULL DEFAULT 0,
+				next_run_at TIMESTAMP,
+				created_by VARCHAR(255) NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				UNIQUE(organization_name, project_name, agent_name, name)
+			);
+			CREATE TABLE golden_trace_runs (
+				uuid TEXT PRIMARY KEY,
+				golden_trace_uuid TEXT NOT NULL REFERENCES golden_traces(uuid) ON DELETE CASCADE,
+				replay_trace_id VARCHAR(64) NOT NULL DEFAULT '',
+				replay_parent_span_id VARCHAR(16) NOT NULL DEFAULT '',
+				status VARCHAR(20) NOT NULL,
+				structural_score REAL,
+				semantic_score REAL,
+				diff TEXT NOT NULL DEFAULT '',
+				error TEXT NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				completed_at TIMESTAMP
+			);
+			CREATE INDEX idx_golden_trace_runs_golden ON golden_trace_runs(golden_trace_uuid, created_at);
+			CREATE INDEX idx_golden_trace_runs_status ON golden_trace_runs(status);
+		`
+		return db.Transaction(func(tx *gorm.DB) error {
+			return runSQL(tx, dialectSQL(tx, createGoldenTablesSQL, createGoldenTablesSQLite))
+		})
+	},
+	Rollback: func(db *gorm.DB) error {
+		return runSQL(db, `
+			DROP TABLE IF EXISTS golden_trace_runs;
+			DROP TABLE IF EXISTS golden_traces;
+		`)
+	},
+}
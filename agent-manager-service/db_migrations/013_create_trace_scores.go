@@ -0,0 +1,105 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Create the trace scoring policies of agents and the scores the LLM judge gave their traces
+var migration013 = migration{
+	ID: 13,
+	Migrate: func(db *gorm.DB) error {
+		createScoreTablesSQL := `
+			CREATE TABLE trace_scoring_policies (
+				uuid UUID PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				project_name VARCHAR(100) NOT NULL,
+				agent_name VARCHAR(100) NOT NULL,
+				environment_name VARCHAR(100) NOT NULL,
+				sample_rate DOUBLE PRECISION NOT NULL,
+				criteria TEXT NOT NULL,
+				thresholds TEXT NOT NULL,
+				scored_until TIMESTAMP,
+				last_run_at TIMESTAMP,
+				last_run_error TEXT NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+				updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+				UNIQUE(organization_name, project_name, agent_name)
+			);
+			CREATE TABLE trace_scores (
+				uuid UUID PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				project_name VARCHAR(100) NOT NULL,
+				agent_name VARCHAR(100) NOT NULL,
+				environment_name VARCHAR(100) NOT NULL,
+				trace_id VARCHAR(64) NOT NULL,
+				trace_start_time TIMESTAMP NOT NULL,
+				criterion VARCHAR(50) NOT NULL,
+				score DOUBLE PRECISION NOT NULL,
+				reason TEXT NOT NULL DEFAULT '',
+				model VARCHAR(100) NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+				UNIQUE(organization_name, project_name, agent_name, trace_id, criterion)
+			);
+			CREATE INDEX idx_trace_scores_agent_time ON trace_scores(organization_name, project_name, agent_name, trace_start_time);
+		`
+		createScoreTablesSQLite := `
+			CREATE TABLE trace_scoring_policies (
+				uuid TEXT PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				project_name VARCHAR(100) NOT NULL,
+				agent_name VARCHAR(100) NOT NULL,
+				environment_name VARCHAR(100) NOT NULL,
+				sample_rate REAL NOT NULL,
+				criteria TEXT NOT NULL,
+				thresholds TEXT NOT NULL,
+				scored_until TIMESTAMP,
+				last_run_at TIMESTAMP,
+				last_run_error TEXT NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				UNIQUE(organization_name, project_name, agent_name)
+			);
+			CREATE TABLE trace_scores (
+				uuid TEXT PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				project_name VARCHAR(100) NOT NULL,
+				agent_name VARCHAR(100) NOT NULL,
+				environment_name VARCHAR(100) NOT NULL,
+				trace_id VARCHAR(64) NOT NULL,
+				trace_start_time TIMESTAMP NOT NULL,
+				criterion VARCHAR(50) NOT NULL,
+				score REAL NOT NULL,
+				reason TEXT NOT NULL DEFAULT '',
+				model VARCHAR(100) NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				UNIQUE(organization_name, project_name, agent_name, trace_id, criterion)
+			);
+			CREATE INDEX idx_trace_scores_agent_time ON trace_scores(organization_name, project_name, agent_name, trace_start_time);
+		`
+		return db.Transaction(func(tx *gorm.DB) error {
+			return runSQL(tx, dialectSQL(tx, createScoreTablesSQL, createScoreTablesSQLite))
+		})
+	},
+	Rollback: func(db *gorm.DB) error {
+		return runSQL(db, `
+			DROP TABLE IF EXISTS trace_scores;
+			DROP TABLE IF EXISTS trace_scoring_policies;
+		`)
+	},
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Create the service level objectives of agents
+var migration014 = migration{
+	ID: 14,
+	Migrate: func(db *gorm.DB) error {
+		createAgentSLOsSQL := `
+			CREATE TABLE agent_slos (
+				uuid UUID PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				project_name VARCHAR(100) NOT NULL,
+				agent_name VARCHAR(100) NOT NULL,
+				environment_name VARCHAR(100) NOT NULL,
+				name VARCHAR(100) NOT NULL,
+				indicator VARCHAR(20) NOT NULL,
+				threshold_ms DOUBLE PRECISION NOT NULL DEFAULT 0,
+				target DOUBLE PRECISION NOT NULL,
+				window_days INTEGER NOT NULL,
+				created_by VARCHAR(255) NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+				updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+				UNIQUE(organization_name, project_name, agent_name, name)
+			);
+		`
+		createAgentSLOsSQLite := `
+			CREATE TABLE agent_slos (
+				uuid TEXT PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				project_name VARCHAR(100) NOT NULL,
+				agent_name VARCHAR(100) NOT NULL,
+				environment_name VARCHAR(100) NOT NULL,
+				name VARCHAR(100) NOT NULL,
+				indicator VARCHAR(20) NOT NULL,
+				threshold_ms REAL NOT NULL DEFAULT 0,
+				target REAL NOT NULL,
+				window_days INTEGER NOT NULL,
+				created_by VARCHAR(255) NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				UNIQUE(organization_name, project_name, agent_name, name)
+			);
+		`
+		return db.Transaction(func(tx *gorm.DB) error {
+			return runSQL(tx, dialectSQL(tx, createAgentSLOsSQL, createAgentSLOsSQLite))
+		})
+	},
+	Rollback: func(db *gorm.DB) error {
+		return runSQL(db, `DROP TABLE IF EXISTS agent_slos;`)
+	},
+}
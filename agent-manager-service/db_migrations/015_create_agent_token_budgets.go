@@ -0,0 +1,106 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Create the token budgets of agents and the events raised when agents reach them
+var migration015 = migration{
+	ID: 15,
+	Migrate: func(db *gorm.DB) error {
+		createTokenBudgetTablesSQL := `
+			CREATE TABLE agent_token_budgets (
+				uuid UUID PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				project_name VARCHAR(100) NOT NULL,
+				agent_name VARCHAR(100) NOT NULL,
+				environment_name VARCHAR(100) NOT NULL,
+				period VARCHAR(10) NOT NULL,
+				token_limit BIGINT NOT NULL,
+				soft_limit_percent INTEGER NOT NULL,
+				enforcement VARCHAR(10) NOT NULL,
+				llm_proxy_id VARCHAR(255) NOT NULL DEFAULT '',
+				status VARCHAR(20) NOT NULL,
+				period_start TIMESTAMP,
+				used_tokens BIGINT NOT NULL DEFAULT 0,
+				last_checked_at TIMESTAMP,
+				last_check_error TEXT NOT NULL DEFAULT '',
+				created_by VARCHAR(255) NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+				updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+				UNIQUE(organization_name, project_name, agent_name, environment_name, period)
+			);
+
+			CREATE TABLE agent_token_budget_events (
+				uuid UUID PRIMARY KEY,
+				budget_uuid UUID NOT NULL REFERENCES agent_token_budgets(uuid) ON DELETE CASCADE,
+				type VARCHAR(30) NOT NULL,
+				period_start TIMESTAMP NOT NULL,
+				used_tokens BIGINT NOT NULL,
+				token_limit BIGINT NOT NULL,
+				enforced BOOLEAN NOT NULL DEFAULT FALSE,
+				created_at TIMESTAMP NOT NULL DEFAULT NOW()
+			);
+
+			CREATE INDEX idx_agent_token_budget_events_budget ON agent_token_budget_events(budget_uuid, created_at);
+		`
+		createTokenBudgetTablesSQLite := `
+			CREATE TABLE agent_token_budgets (
+				uuid TEXT PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				project_name VARCHAR(100) NOT NULL,
+				agent_name VARCHAR(100) NOT NULL,
+				environment_name VARCHAR(100) NOT NULL,
+				period VARCHAR(10) NOT NULL,
+				token_limit INTEGER NOT NULL,
+				soft_limit_percent INTEGER NOT NULL,
+				enforcement VARCHAR(10) NOT NULL,
+				llm_proxy_id VARCHAR(255) NOT NULL DEFAULT '',
+				status VARCHAR(20) NOT NULL,
+				period_start TIMESTAMP,
+				used_tokens INTEGER NOT NULL DEFAULT 0,
+				last_checked_at TIMESTAMP,
+				last_check_error TEXT NOT NULL DEFAULT '',
+				created_by VARCHAR(255) NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				UNIQUE(organization_name, project_name, agent_name, environment_name, period)
+			);
+
+			CREATE TABLE agent_token_budget_events (
+				uuid TEXT PRIMARY KEY,
+				budget_uuid TEXT NOT NULL REFERENCES agent_token_budgets(uuid) ON DELETE CASCADE,
+				type VARCHAR(30) NOT NULL,
+				period_start TIMESTAMP NOT NULL,
+				used_tokens INTEGER NOT NULL,
+				token_limit INTEGER NOT NULL,
+				enforced BOOLEAN NOT NULL DEFAULT FALSE,
+				created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+			);
+
+			CREATE INDEX idx_agent_token_budget_events_budget ON agent_token_budget_events(budget_uuid, created_at);
+		`
+		return db.Transaction(func(tx *gorm.DB) error {
+			return runSQL(tx, dialectSQL(tx, createTokenBudgetTablesSQL, createTokenBudgetTablesSQLite))
+		})
+	},
+	Rollback: func(db *gorm.DB) error {
+		return runSQL(db, `DROP TABLE IF EXISTS agent_token_budget_events; DROP TABLE IF EXISTS agent_token_budgets;`)
+	},
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Create the developer portal publications of agents
+var migration016 = migration{
+	ID: 16,
+	Migrate: func(db *gorm.DB) error {
+		createAgentPublicationsSQL := `
+			CREATE TABLE agent_publications (
+				uuid UUID PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				project_name VARCHAR(100) NOT NULL,
+				agent_name VARCHAR(100) NOT NULL,
+				environment_name VARCHAR(100) NOT NULL,
+				endpoint_name VARCHAR(100) NOT NULL DEFAULT '',
+				endpoint_url TEXT NOT NULL,
+				definition_type VARCHAR(10) NOT NULL,
+				definition TEXT NOT NULL,
+				documentation TEXT NOT NULL DEFAULT '',
+				visibility VARCHAR(20) NOT NULL,
+				visible_groups JSONB NOT NULL DEFAULT '[]',
+				tags JSONB NOT NULL DEFAULT '[]',
+				lifecycle_status VARCHAR(20) NOT NULL,
+				api_id VARCHAR(255) NOT NULL,
+				dev_portal_id VARCHAR(255) NOT NULL,
+				published_at TIMESTAMP,
+				created_by VARCHAR(255) NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+				updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+				UNIQUE(organization_name, project_name, agent_name)
+			);
+
+			CREATE INDEX idx_agent_publications_org_status ON agent_publications(organization_name, lifecycle_status);
+		`
+		createAgentPublicationsSQLite := `
+			CREATE TABLE agent_publications (
+				uuid TEXT PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				project_name VARCHAR(100) NOT NULL,
+				agent_name VARCHAR(100) NOT NULL,
+				environment_name VARCHAR(100) NOT NULL,
+				endpoint_name VARCHAR(100) NOT NULL DEFAULT '',
+				endpoint_url TEXT NOT NULL,
+				definition_type VARCHAR(10) NOT NULL,
+				definition TEXT NOT NULL,
+				documentation TEXT NOT NULL DEFAULT '',
+				visibility VARCHAR(20) NOT NULL,
+				visible_groups TEXT NOT NULL DEFAULT '[]',
+				tags TEXT NOT NULL DEFAULT '[]',
+				lifecycle_status VARCHAR(20) NOT NULL,
+				api_id VARCHAR(255) NOT NULL,
+				dev_portal_id VARCHAR(255) NOT NULL,
+				published_at TIMESTAMP,
+				created_by VARCHAR(255) NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				UNIQUE(organization_name, project_name, agent_name)
+			);
+
+			CREATE INDEX idx_agent_publications_org_status ON agent_publications(organization_name, lifecycle_status);
+		`
+		return db.Transaction(func(tx *gorm.DB) error {
+			return runSQL(tx, dialectSQL(tx, createAgentPublicationsSQL, createAgentPublicationsSQLite))
+		})
+	},
+	Rollback: func(db *gorm.DB) error {
+		return runSQL(db, `DROP TABLE IF EXISTS agent_publications;`)
+	},
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Add the region an environment runs in, which constrains the gateways that can serve it
+var migration017 = migration{
+	ID: 17,
+	Migrate: func(db *gorm.DB) error {
+		return db.Transaction(func(tx *gorm.DB) error {
+			return runSQL(tx,
+				`ALTER TABLE environments ADD COLUMN region VARCHAR(64) NOT NULL DEFAULT ''`,
+				`CREATE INDEX idx_environments_region ON environments(organization_name, region)`,
+			)
+		})
+	},
+	Rollback: func(db *gorm.DB) error {
+		return runSQL(db,
+			`DROP INDEX IF EXISTS idx_environments_region`,
+			`ALTER TABLE environments DROP COLUMN region`,
+		)
+	},
+}
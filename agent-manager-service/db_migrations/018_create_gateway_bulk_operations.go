@@ -0,0 +1,60 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Create the records of actions run on many gateways in the background, with per-gateway results
+var migration018 = migration{
+	ID: 18,
+	Migrate: func(db *gorm.DB) error {
+		createBulkOperationsSQL := `
+			CREATE TABLE gateway_bulk_operations (
+				uuid UUID PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				action VARCHAR(20) NOT NULL,
+				status VARCHAR(20) NOT NULL,
+				requested_by VARCHAR(255) NOT NULL DEFAULT '',
+				items JSONB NOT NULL DEFAULT '[]',
+				created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+				completed_at TIMESTAMP
+			);
+			CREATE INDEX idx_gateway_bulk_operations_org ON gateway_bulk_operations(organization_name, created_at);
+		`
+		createBulkOperationsSQLite := `
+			CREATE TABLE gateway_bulk_operations (
+				uuid TEXT PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				action VARCHAR(20) NOT NULL,
+				status VARCHAR(20) NOT NULL,
+				requested_by VARCHAR(255) NOT NULL DEFAULT '',
+				items TEXT NOT NULL DEFAULT '[]',
+				created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				completed_at TIMESTAMP
+			);
+			CREATE INDEX idx_gateway_bulk_operations_org ON gateway_bulk_operations(organization_name, created_at);
+		`
+		return db.Transaction(func(tx *gorm.DB) error {
+			return runSQL(tx, dialectSQL(tx, createBulkOperationsSQL, createBulkOperationsSQLite))
+		})
+	},
+	Rollback: func(db *gorm.DB) error {
+		return runSQL(db, `DROP TABLE IF EXISTS gateway_bulk_operations`)
+	},
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Create the event timeline of resources, such as gateway creation, token rotation and health changes
+var migration019 = migration{
+	ID: 19,
+	Migrate: func(db *gorm.DB) error {
+		createResourceEventsSQL := `
+			CREATE TABLE resource_events (
+				uuid UUID PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				resource_type VARCHAR(50) NOT NULL,
+				resource_id VARCHAR(100) NOT NULL,
+				event_type VARCHAR(50) NOT NULL,
+				actor VARCHAR(255) NOT NULL DEFAULT '',
+				details JSONB,
+				created_at TIMESTAMP NOT NULL DEFAULT NOW()
+			);
+			CREATE INDEX idx_resource_events_resource ON resource_events(organization_name, resource_type, resource_id, created_at);
+		`
+		createResourceEventsSQLite := `
+			CREATE TABLE resource_events (
+				uuid TEXT PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				resource_type VARCHAR(50) NOT NULL,
+				resource_id VARCHAR(100) NOT NULL,
+				event_type VARCHAR(50) NOT NULL,
+				actor VARCHAR(255) NOT NULL DEFAULT '',
+				details TEXT,
+				created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+			);
+			CREATE INDEX idx_resource_events_resource ON resource_events(organization_name, resource_type, resource_id, created_at);
+		`
+		return db.Transaction(func(tx *gorm.DB) error {
+			return runSQL(tx, dialectSQL(tx, createResourceEventsSQL, createResourceEventsSQLite))
+		})
+	},
+	Rollback: func(db *gorm.DB) error {
+		return runSQL(db, `DROP TABLE IF EXISTS resource_events`)
+	},
+}
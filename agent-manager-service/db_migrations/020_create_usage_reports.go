@@ -0,0 +1,62 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Create the monthly usage reports of organizations
+var migration020 = migration{
+	ID: 20,
+	Migrate: func(db *gorm.DB) error {
+		createUsageReportsSQL := `
+			CREATE TABLE usage_reports (
+				uuid UUID PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				period VARCHAR(7) NOT NULL,
+				status VARCHAR(20) NOT NULL,
+				usage_rows JSONB,
+				requested_by VARCHAR(255) NOT NULL DEFAULT '',
+				error TEXT NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+				completed_at TIMESTAMP,
+				CONSTRAINT uq_usage_reports_org_period UNIQUE (organization_name, period)
+			);
+		`
+		createUsageReportsSQLite := `
+			CREATE TABLE usage_reports (
+				uuid TEXT PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				period VARCHAR(7) NOT NULL,
+				status VARCHAR(20) NOT NULL,
+				usage_rows TEXT,
+				requested_by VARCHAR(255) NOT NULL DEFAULT '',
+				error TEXT NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				completed_at TIMESTAMP,
+				CONSTRAINT uq_usage_reports_org_period UNIQUE (organization_name, period)
+			);
+		`
+		return db.Transaction(func(tx *gorm.DB) error {
+			return runSQL(tx, dialectSQL(tx, createUsageReportsSQL, createUsageReportsSQLite))
+		})
+	},
+	Rollback: func(db *gorm.DB) error {
+		return runSQL(db, `DROP TABLE IF EXISTS usage_reports`)
+	},
+}
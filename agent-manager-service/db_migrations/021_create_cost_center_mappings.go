@@ -0,0 +1,62 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Create the mappings of agents and LLM providers to the cost centers their usage is charged to
+var migration021 = migration{
+	ID: 21,
+	Migrate: func(db *gorm.DB) error {
+		createCostCenterMappingsSQL := `
+			CREATE TABLE cost_center_mappings (
+				uuid UUID PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				resource_type VARCHAR(20) NOT NULL,
+				project_name VARCHAR(100) NOT NULL DEFAULT '',
+				resource_name VARCHAR(100) NOT NULL,
+				cost_center VARCHAR(100) NOT NULL,
+				created_by VARCHAR(255) NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+				updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+				CONSTRAINT uq_cost_center_mappings_resource UNIQUE (organization_name, resource_type, project_name, resource_name)
+			);
+		`
+		createCostCenterMappingsSQLite := `
+			CREATE TABLE cost_center_mappings (
+				uuid TEXT PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				resource_type VARCHAR(20) NOT NULL,
+				project_name VARCHAR(100) NOT NULL DEFAULT '',
+				resource_name VARCHAR(100) NOT NULL,
+				cost_center VARCHAR(100) NOT NULL,
+				created_by VARCHAR(255) NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				CONSTRAINT uq_cost_center_mappings_resource UNIQUE (organization_name, resource_type, project_name, resource_name)
+			);
+		`
+		return db.Transaction(func(tx *gorm.DB) error {
+			return runSQL(tx, dialectSQL(tx, createCostCenterMappingsSQL, createCostCenterMappingsSQLite))
+		})
+	},
+	Rollback: func(db *gorm.DB) error {
+		return runSQL(db, `DROP TABLE IF EXISTS cost_center_mappings`)
+	},
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Create the reviewers that approve production deployments of an organization and the approval
+// requests of deployments waiting for them
+var migration022 = migration{
+	ID: 22,
+	Migrate: func(db *gorm.DB) error {
+		createDeploymentApprovalsSQL := `
+			CREATE TABLE deployment_approval_policies (
+				organization_name VARCHAR(100) PRIMARY KEY,
+				reviewers JSONB NOT NULL DEFAULT '[]',
+				updated_by VARCHAR(255) NOT NULL DEFAULT '',
+				updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+			);
+			CREATE TABLE deployment_approvals (
+				uuid UUID PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				project_name VARCHAR(100) NOT NULL,
+				agent_name VARCHAR(100) NOT NULL,
+				environment VARCHAR(100) NOT NULL,
+				image_id VARCHAR(2048) NOT NULL,
+				env JSONB NOT NULL DEFAULT '[]',
+				rollback_of INTEGER,
+				status VARCHAR(20) NOT NULL,
+				requested_by VARCHAR(255) NOT NULL DEFAULT '',
+				reviewed_by VARCHAR(255) NOT NULL DEFAULT '',
+				review_comment TEXT NOT NULL DEFAULT '',
+				error TEXT NOT NULL DEFAULT '',
+				revision INTEGER,
+				created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+				reviewed_at TIMESTAMP
+			);
+			CREATE INDEX idx_deployment_approvals_org_status ON deployment_approvals(organization_name, status, created_at);
+		`
+		createDeploymentApprovalsSQLite := `
+			CREATE TABLE deployment_approval_policies (
+				organization_name VARCHAR(100) PRIMARY KEY,
+				reviewers TEXT NOT NULL DEFAULT '[]',
+				updated_by VARCHAR(255) NOT NULL DEFAULT '',
+				updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+			);
+			CREATE TABLE deployment_approvals (
+				uuid TEXT PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				project_name VARCHAR(100) NOT NULL,
+				agent_name VARCHAR(100) NOT NULL,
+				environment VARCHAR(100) NOT NULL,
+				image_id VARCHAR(2048) NOT NULL,
+				env TEXT NOT NULL DEFAULT '[]',
+				rollback_of INTEGER,
+				status VARCHAR(20) NOT NULL,
+				requested_by VARCHAR(255) NOT NULL DEFAULT '',
+				reviewed_by VARCHAR(255) NOT NULL DEFAULT '',
+				review_comment TEXT NOT NULL DEFAULT '',
+				error TEXT NOT NULL DEFAULT '',
+				revision INTEGER,
+				created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				reviewed_at TIMESTAMP
+			);
+			CREATE INDEX idx_deployment_approvals_org_status ON deployment_approvals(organization_name, status, created_at);
+		`
+		return db.Transaction(func(tx *gorm.DB) error {
+			return runSQL(tx, dialectSQL(tx, createDeploymentApprovalsSQL, createDeploymentApprovalsSQLite))
+		})
+	},
+	Rollback: func(db *gorm.DB) error {
+		return runSQL(db, `DROP TABLE IF EXISTS deployment_approvals`, `DROP TABLE IF EXISTS deployment_approval_policies`)
+	},
+}
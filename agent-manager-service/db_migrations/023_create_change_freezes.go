@@ -0,0 +1,60 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Create the windows during which deployments and token rotations of an organization are frozen
+var migration023 = migration{
+	ID: 23,
+	Migrate: func(db *gorm.DB) error {
+		createChangeFreezeWindowsSQL := `
+			CREATE TABLE change_freeze_windows (
+				uuid UUID PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				name VARCHAR(100) NOT NULL,
+				reason TEXT NOT NULL DEFAULT '',
+				start_time TIMESTAMP NOT NULL,
+				end_time TIMESTAMP NOT NULL,
+				created_by VARCHAR(255) NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT NOW()
+			);
+			CREATE INDEX idx_change_freeze_windows_org ON change_freeze_windows(organization_name, end_time);
+		`
+		createChangeFreezeWindowsSQLite := `
+			CREATE TABLE change_freeze_windows (
+				uuid TEXT PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				name VARCHAR(100) NOT NULL,
+				reason TEXT NOT NULL DEFAULT '',
+				start_time TIMESTAMP NOT NULL,
+				end_time TIMESTAMP NOT NULL,
+				created_by VARCHAR(255) NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+			);
+			CREATE INDEX idx_change_freeze_windows_org ON change_freeze_windows(organization_name, end_time);
+		`
+		return db.Transaction(func(tx *gorm.DB) error {
+			return runSQL(tx, dialectSQL(tx, createChangeFreezeWindowsSQL, createChangeFreezeWindowsSQLite))
+		})
+	},
+	Rollback: func(db *gorm.DB) error {
+		return runSQL(db, `DROP TABLE IF EXISTS change_freeze_windows`)
+	},
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Create the per organization content visibility of traces
+var migration024 = migration{
+	ID: 24,
+	Migrate: func(db *gorm.DB) error {
+		createTraceContentSettingsSQL := `
+			CREATE TABLE trace_content_settings (
+				organization_name VARCHAR(100) PRIMARY KEY,
+				content_visibility VARCHAR(20) NOT NULL,
+				updated_by VARCHAR(255) NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+				updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+			);
+		`
+		createTraceContentSettingsSQLite := `
+			CREATE TABLE trace_content_settings (
+				organization_name VARCHAR(100) PRIMARY KEY,
+				content_visibility VARCHAR(20) NOT NULL,
+				updated_by VARCHAR(255) NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+			);
+		`
+		return db.Transaction(func(tx *gorm.DB) error {
+			return runSQL(tx, dialectSQL(tx, createTraceContentSettingsSQL, createTraceContentSettingsSQLite))
+		})
+	},
+	Rollback: func(db *gorm.DB) error {
+		return runSQL(db, `DROP TABLE IF EXISTS trace_content_settings`)
+	},
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Create the daily and weekly activity digests of organizations
+var migration025 = migration{
+	ID: 25,
+	Migrate: func(db *gorm.DB) error {
+		createNotificationDigestsSQL := `
+			CREATE TABLE notification_digests (
+				uuid UUID PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				name VARCHAR(100) NOT NULL,
+				frequency VARCHAR(20) NOT NULL,
+				channel VARCHAR(20) NOT NULL,
+				url TEXT NOT NULL,
+				enabled BOOLEAN NOT NULL DEFAULT TRUE,
+				last_period_end TIMESTAMP NOT NULL,
+				last_sent_at TIMESTAMP,
+				last_error TEXT NOT NULL DEFAULT '',
+				created_by VARCHAR(255) NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+				updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+			);
+			CREATE INDEX idx_notification_digests_org ON notification_digests(organization_name);
+		`
+		createNotificationDigestsSQLite := `
+			CREATE TABLE notification_digests (
+				uuid TEXT PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				name VARCHAR(100) NOT NULL,
+				frequency VARCHAR(20) NOT NULL,
+				channel VARCHAR(20) NOT NULL,
+				url TEXT NOT NULL,
+				enabled BOOLEAN NOT NULL DEFAULT TRUE,
+				last_period_end TIMESTAMP NOT NULL,
+				last_sent_at TIMESTAMP,
+				last_error TEXT NOT NULL DEFAULT '',
+				created_by VARCHAR(255) NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+			);
+			CREATE INDEX idx_notification_digests_org ON notification_digests(organization_name);
+		`
+		return db.Transaction(func(tx *gorm.DB) error {
+			return runSQL(tx, dialectSQL(tx, createNotificationDigestsSQL, createNotificationDigestsSQLite))
+		})
+	},
+	Rollback: func(db *gorm.DB) error {
+		return runSQL(db, `DROP TABLE IF EXISTS notification_digests`)
+	},
+}
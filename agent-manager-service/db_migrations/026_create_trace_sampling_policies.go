@@ -0,0 +1,62 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Create the trace sampling rates of organizations and their agents
+var migration026 = migration{
+	ID: 26,
+	Migrate: func(db *gorm.DB) error {
+		createTraceSamplingPoliciesSQL := `
+			CREATE TABLE trace_sampling_policies (
+				uuid UUID PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				project_name VARCHAR(100) NOT NULL DEFAULT '',
+				agent_name VARCHAR(100) NOT NULL DEFAULT '',
+				error_sample_rate DOUBLE PRECISION NOT NULL,
+				success_sample_rate DOUBLE PRECISION NOT NULL,
+				updated_by VARCHAR(255) NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+				updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+				UNIQUE(organization_name, project_name, agent_name)
+			);
+		`
+		createTraceSamplingPoliciesSQLite := `
+			CREATE TABLE trace_sampling_policies (
+				uuid TEXT PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				project_name VARCHAR(100) NOT NULL DEFAULT '',
+				agent_name VARCHAR(100) NOT NULL DEFAULT '',
+				error_sample_rate REAL NOT NULL,
+				success_sample_rate REAL NOT NULL,
+				updated_by VARCHAR(255) NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				UNIQUE(organization_name, project_name, agent_name)
+			);
+		`
+		return db.Transaction(func(tx *gorm.DB) error {
+			return runSQL(tx, dialectSQL(tx, createTraceSamplingPoliciesSQL, createTraceSamplingPoliciesSQLite))
+		})
+	},
+	Rollback: func(db *gorm.DB) error {
+		return runSQL(db, `DROP TABLE IF EXISTS trace_sampling_policies`)
+	},
+}
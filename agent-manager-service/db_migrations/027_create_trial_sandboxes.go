@@ -0,0 +1,63 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Create the trial sandboxes of organizations. An organization keeps its row after the sandbox
+// expires, so that it gets one trial only.
+var migration027 = migration{
+	ID: 27,
+	Migrate: func(db *gorm.DB) error {
+		createTrialSandboxesSQL := `
+			CREATE TABLE trial_sandboxes (
+				uuid UUID PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL UNIQUE,
+				environment_uuid UUID NOT NULL,
+				gateway_uuid UUID NOT NULL,
+				status VARCHAR(20) NOT NULL,
+				expires_at TIMESTAMP NOT NULL,
+				ended_at TIMESTAMP,
+				created_by VARCHAR(255) NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT NOW()
+			);
+			CREATE INDEX idx_trial_sandboxes_status_expires ON trial_sandboxes(status, expires_at);
+		`
+		createTrialSandboxesSQLite := `
+			CREATE TABLE trial_sandboxes (
+				uuid TEXT PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL UNIQUE,
+				environment_uuid TEXT NOT NULL,
+				gateway_uuid TEXT NOT NULL,
+				status VARCHAR(20) NOT NULL,
+				expires_at TIMESTAMP NOT NULL,
+				ended_at TIMESTAMP,
+				created_by VARCHAR(255) NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+			);
+			CREATE INDEX idx_trial_sandboxes_status_expires ON trial_sandboxes(status, expires_at);
+		`
+		return db.Transaction(func(tx *gorm.DB) error {
+			return runSQL(tx, dialectSQL(tx, createTrialSandboxesSQL, createTrialSandboxesSQLite))
+		})
+	},
+	Rollback: func(db *gorm.DB) error {
+		return runSQL(db, `DROP TABLE IF EXISTS trial_sandboxes`)
+	},
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dbmigrations
+
+import (
+	"gorm.io/gorm"
+)
+
+// Create the email recipients of organizations and the agent API keys watched for expiry. A
+// recipient only receives notifications once the address is verified.
+var migration028 = migration{
+	ID: 28,
+	Migrate: func(db *gorm.DB) error {
+		createEmailRecipientsSQL := `
+			CREATE TABLE email_recipients (
+				uuid UUID PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				address VARCHAR(254) NOT NULL,
+				notifications JSONB NOT NULL DEFAULT '[]',
+				verified_at TIMESTAMP,
+				verification_code_hash VARCHAR(64) NOT NULL DEFAULT '',
+				verification_sent_at TIMESTAMP,
+				verification_attempts INTEGER NOT NULL DEFAULT 0,
+				created_by VARCHAR(255) NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+				updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+				UNIQUE (organization_name, address)
+			);
+			CREATE TABLE agent_token_expiries (
+				uuid UUID PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				project_name VARCHAR(100) NOT NULL,
+				agent_name VARCHAR(100) NOT NULL,
+				environment VARCHAR(100) NOT NULL,
+				expires_at TIMESTAMP NOT NULL,
+				notified_at TIMESTAMP,
+				created_at TIMESTAMP NOT NULL DEFAULT NOW()
+			);
+			CREATE INDEX idx_agent_token_expiries_expires ON agent_token_expiries(expires_at);
+		`
+		createEmailRecipientsSQLite := `
+			CREATE TABLE email_recipients (
+				uuid TEXT PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				address VARCHAR(254) NOT NULL,
+				notifications TEXT NOT NULL DEFAULT '[]',
+				verified_at TIMESTAMP,
+				verification_code_hash VARCHAR(64) NOT NULL DEFAULT '',
+				verification_sent_at TIMESTAMP,
+				verification_attempts INTEGER NOT NULL DEFAULT 0,
+				created_by VARCHAR(255) NOT NULL DEFAULT '',
+				created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+				UNIQUE (organization_name, address)
+			);
+			CREATE TABLE agent_token_expiries (
+				uuid TEXT PRIMARY KEY,
+				organization_name VARCHAR(100) NOT NULL,
+				project_name VARCHAR(100) NOT NULL,
+				agent_name VARCHAR(100) NOT NULL,
+				environment VARCHAR(100) NOT NULL,
+				expires_at TIMESTAMP NOT NULL,
+				notified_at TIMESTAMP,
+				created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+			);
+			CREATE INDEX idx_agent_token_expiries_expires ON agent_token_expiries(expires_at);
+		`
+		return db.Transaction(func(tx *gorm.DB) error {
+			return runSQL(tx, dialectSQL(tx, createEmailRecipientsSQL, createEmailRecipientsSQLite))
+		})
+	},
+	Rollback: func(db *gorm.DB) error {
+		return runSQL(db, `
+			DROP TABLE IF EXISTS agent_token_expiries;
+			DROP TABLE IF EXISTS email_recipients;
+		`)
+	},
+}
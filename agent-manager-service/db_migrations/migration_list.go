@@ -16,7 +16,7 @@
 
 package dbmigrations
 
-const latestVersion = 4
+const latestVersion = 28
 
 // migration list sorted by version.  Add new migrations to the end of the list.
 // Previous migrations should not be modified.
@@ -25,4 +25,28 @@ var migrations = []migration{
 	migration002,
 	migration003,
 	migration004,
+	migration005,
+	migration006,
+	migration007,
+	migration008,
+	migration009,
+	migration010,
+	migration011,
+	migration012,
+	migration013,
+	migration014,
+	migration015,
+	migration016,
+	migration017,
+	migration018,
+	migration019,
+	migration020,
+	migration021,
+	migration022,
+	migration023,
+	migration024,
+	migration025,
+	migration026,
+	migration027,
+	migration028,
 }
@@ -18,8 +18,10 @@ package dbmigrations
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
 
 	"github.com/go-gormigrate/gormigrate/v2"
 	"gorm.io/gorm"
@@ -35,40 +37,207 @@ var migrateOptions = &gormigrate.Options{
 	ValidateUnknownMigrations: true, // Controls validation of migrations that exist in the database but not in the code
 }
 
+// migrationLockKey is the PostgreSQL advisory lock key that serializes migration runs of
+// concurrently starting replicas
+const migrationLockKey int64 = 4_815_162_342
+
+// ErrPendingMigrations is returned by CheckSchema when the database is behind this binary
+var ErrPendingMigrations = errors.New("database has pending migrations")
+
 type migration struct {
 	ID      int32
 	Migrate gormigrate.MigrateFunc
+	// Rollback reverts Migrate. Migrations without a rollback cannot be reverted.
+	Rollback gormigrate.RollbackFunc
+}
+
+// MigrationStatus describes whether a migration known to this binary has been applied
+type MigrationStatus struct {
+	Version int32
+	Applied bool
 }
 
+// Migrate applies all pending migrations
 func Migrate() error {
-	dbConn := db.DB(context.Background())
+	return MigrateTo(latestVersion)
+}
+
+// MigrateTo applies all pending migrations up to and including the given version
+func MigrateTo(version int32) error {
+	if err := validateVersion(version); err != nil {
+		return err
+	}
+	targetID := generateIdStr(version)
 
 	successCount := 0
-	var list []*gormigrate.Migration
+	slog.Info("dbmigrations:starting migration", "target", targetID)
+	err := withMigrationLock(func(conn *gorm.DB) error {
+		return gormigrate.New(conn, migrateOptions, buildMigrationList(&successCount)).MigrateTo(targetID)
+	})
+	if err != nil {
+		return err
+	}
+	slog.Info("dbmigrations:migration completed", "target", targetID, "successCount", successCount)
+	return nil
+}
+
+// RollbackTo reverts all applied migrations newer than the given version.
+// A version of 0 reverts every migration.
+func RollbackTo(version int32) error {
+	if version != 0 {
+		if err := validateVersion(version); err != nil {
+			return err
+		}
+	}
+
+	slog.Info("dbmigrations:starting rollback", "target", generateIdStr(version))
+	err := withMigrationLock(func(conn *gorm.DB) error {
+		m := gormigrate.New(conn, migrateOptions, buildMigrationList(nil))
+		if version != 0 {
+			return m.RollbackTo(generateIdStr(version))
+		}
+		for {
+			err := m.RollbackLast()
+			if errors.Is(err, gormigrate.ErrNoRunMigration) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+		}
+	})
+	if err != nil {
+		return err
+	}
+	slog.Info("dbmigrations:rollback completed", "target", generateIdStr(version))
+	return nil
+}
+
+// Status reports which of the migrations known to this binary have been applied
+func Status(ctx context.Context) ([]MigrationStatus, error) {
+	applied, err := appliedMigrationIDs(db.DB(ctx))
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]MigrationStatus, 0, len(migrations))
+	for _, m := range migrations {
+		statuses = append(statuses, MigrationStatus{
+			Version: m.ID,
+			Applied: applied[generateIdStr(m.ID)],
+		})
+	}
+	return statuses, nil
+}
+
+// CheckSchema verifies that the database schema matches this binary. It returns
+// ErrPendingMigrations when migrations are yet to be applied, and an error when the database
+// has migrations that this binary does not know about, e.g. after a downgrade.
+func CheckSchema(ctx context.Context) error {
+	applied, err := appliedMigrationIDs(db.DB(ctx))
+	if err != nil {
+		return err
+	}
+
+	known := make(map[string]bool, len(migrations))
+	var pending []string
 	for _, m := range migrations {
-		m := m
 		id := generateIdStr(m.ID)
-		list = append(list, &gormigrate.Migration{
+		known[id] = true
+		if !applied[id] {
+			pending = append(pending, id)
+		}
+	}
+	var unknown []string
+	for id := range applied {
+		if !known[id] {
+			unknown = append(unknown, id)
+		}
+	}
+
+	if len(unknown) > 0 {
+		return fmt.Errorf("database has migrations unknown to this version: %s", strings.Join(unknown, ", "))
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("%w: %s", ErrPendingMigrations, strings.Join(pending, ", "))
+	}
+	return nil
+}
+
+func buildMigrationList(successCount *int) []*gormigrate.Migration {
+	list := make([]*gormigrate.Migration, 0, len(migrations))
+	for _, m := range migrations {
+		id := generateIdStr(m.ID)
+		item := &gormigrate.Migration{
 			ID: id,
 			Migrate: func(g *gorm.DB) error {
 				slog.Info("dbmigrations:applying migration", "id", id)
 				if err := m.Migrate(g); err != nil {
 					return err
 				}
-				successCount++
+				if successCount != nil {
+					*successCount++
+				}
 				slog.Info("dbmigrations:migration applied successfully", "id", id)
 				return nil
 			},
-		})
+		}
+		if m.Rollback != nil {
+			item.Rollback = func(g *gorm.DB) error {
+				slog.Info("dbmigrations:rolling back migration", "id", id)
+				if err := m.Rollback(g); err != nil {
+					return err
+				}
+				slog.Info("dbmigrations:migration rolled back successfully", "id", id)
+				return nil
+			}
+		}
+		list = append(list, item)
 	}
-	latestId := generateIdStr(latestVersion)
-	slog.Info("dbmigrations:starting migration", "latest", latestId)
-	m := gormigrate.New(dbConn, migrateOptions, list)
-	if err := m.MigrateTo(latestId); err != nil {
-		return err
+	return list
+}
+
+// withMigrationLock runs fn on a dedicated connection holding the migration advisory lock.
+// SQLite is single-process local development storage and needs no lock.
+func withMigrationLock(fn func(conn *gorm.DB) error) error {
+	dbConn := db.DB(context.Background())
+	if db.IsSQLite(dbConn) {
+		return fn(dbConn)
 	}
-	slog.Info("dbmigrations:migration completed", "latest", latestId, "successCount", successCount)
-	return nil
+	return dbConn.Connection(func(conn *gorm.DB) error {
+		if err := conn.Exec("SELECT pg_advisory_lock(?)", migrationLockKey).Error; err != nil {
+			return fmt.Errorf("failed to acquire migration lock: %w", err)
+		}
+		defer func() {
+			if err := conn.Exec("SELECT pg_advisory_unlock(?)", migrationLockKey).Error; err != nil {
+				slog.Error("dbmigrations:failed to release migration lock", "error", err)
+			}
+		}()
+		return fn(conn)
+	})
+}
+
+func appliedMigrationIDs(conn *gorm.DB) (map[string]bool, error) {
+	applied := make(map[string]bool)
+	if !conn.Migrator().HasTable(migrateOptions.TableName) {
+		return applied, nil
+	}
+	var ids []string
+	if err := conn.Table(migrateOptions.TableName).Pluck(migrateOptions.IDColumnName, &ids).Error; err != nil {
+		return nil, fmt.Errorf("failed to read migration history: %w", err)
+	}
+	for _, id := range ids {
+		applied[id] = true
+	}
+	return applied, nil
+}
+
+func validateVersion(version int32) error {
+	for _, m := range migrations {
+		if m.ID == version {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown migration version %d, latest is %d", version, latestVersion)
 }
 
 func generateIdStr(id int32) string {
@@ -18,8 +18,25 @@ package dbmigrations
 
 import (
 	"gorm.io/gorm"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
 )
 
+// dialectSQL selects the statement for the connected database. SQLite is only used for local
+// development, so its schema mirrors the PostgreSQL one without PostgreSQL-specific types and defaults.
+func dialectSQL(tx *gorm.DB, postgresSQL string, sqliteSQL string) string {
+	if db.IsSQLite(tx) {
+		return sqliteSQL
+	}
+	return postgresSQL
+}
+
+// isSQLite reports whether the migration runs against SQLite, for changes that need a different
+// statement sequence rather than a different statement.
+func isSQLite(tx *gorm.DB) bool {
+	return db.IsSQLite(tx)
+}
+
 func runSQL(tx *gorm.DB, ddl ...string) error {
 	for _, s := range ddl {
 		if err := tx.Exec(s).Error; err != nil {
@@ -0,0 +1,122 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package grpcapi
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
+	agentmanagerv1 "github.com/wso2/ai-agent-management-platform/agent-manager-service/proto/agentmanager/v1"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+type deploymentServer struct {
+	agentmanagerv1.UnimplementedDeploymentServiceServer
+	agentService services.AgentManagerService
+}
+
+func newDeploymentServer(agentService services.AgentManagerService) *deploymentServer {
+	return &deploymentServer{
+		agentService: agentService,
+	}
+}
+
+func (s *deploymentServer) ListAgentDeployments(ctx context.Context, req *agentmanagerv1.ListAgentDeploymentsRequest) (*agentmanagerv1.ListAgentDeploymentsResponse, error) {
+	log := logger.GetLogger(ctx)
+	if req.GetOrgName() == "" || req.GetProjectName() == "" || req.GetAgentName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "org_name, project_name and agent_name are required")
+	}
+
+	deployments, err := s.agentService.GetAgentDeployments(ctx, req.GetOrgName(), req.GetProjectName(), req.GetAgentName())
+	if err != nil {
+		log.Error("ListAgentDeployments: failed to get agent deployments", "error", err)
+		return nil, toStatusError(err, "failed to get agent deployments")
+	}
+
+	resp := &agentmanagerv1.ListAgentDeploymentsResponse{
+		Deployments: make([]*agentmanagerv1.Deployment, 0, len(deployments)),
+	}
+	for _, d := range deployments {
+		deployment := &agentmanagerv1.Deployment{
+			AgentName:      d.AgentName,
+			ProjectName:    d.ProjectName,
+			ImageId:        d.ImageId,
+			Status:         d.Status,
+			Environment:    d.Environment,
+			LastDeployedAt: timestamppb.New(d.LastDeployedAt),
+		}
+		for _, ep := range d.Endpoints {
+			deployment.Endpoints = append(deployment.Endpoints, &agentmanagerv1.Endpoint{
+				Name:       ep.Name,
+				Url:        ep.URL,
+				Visibility: ep.Visibility,
+			})
+		}
+		resp.Deployments = append(resp.Deployments, deployment)
+	}
+	return resp, nil
+}
+
+func (s *deploymentServer) DeployAgent(ctx context.Context, req *agentmanagerv1.DeployAgentRequest) (*agentmanagerv1.DeployAgentResponse, error) {
+	log := logger.GetLogger(ctx)
+	if req.GetOrgName() == "" || req.GetProjectName() == "" || req.GetAgentName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "org_name, project_name and agent_name are required")
+	}
+	if req.GetImageId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "image_id is required")
+	}
+
+	deployReq := &spec.DeployAgentRequest{
+		ImageId: req.GetImageId(),
+	}
+	for _, env := range req.GetEnv() {
+		deployReq.Env = append(deployReq.Env, spec.EnvironmentVariable{
+			Key:   env.GetKey(),
+			Value: env.GetValue(),
+		})
+	}
+
+	environment, approval, err := s.agentService.DeployAgent(ctx, req.GetOrgName(), req.GetProjectName(), req.GetAgentName(), deployReq, "", breakGlassReason(ctx))
+	if err != nil {
+		log.Error("DeployAgent: failed to deploy agent", "error", err)
+		return nil, toStatusError(err, "failed to deploy agent")
+	}
+	if approval != nil {
+		// The response has no field for the approval, so that callers are told the agent was not deployed
+		return nil, status.Errorf(codes.FailedPrecondition, "deployment to %s is waiting for approval %s", environment, approval.ID)
+	}
+
+	return &agentmanagerv1.DeployAgentResponse{
+		Environment: environment,
+	}, nil
+}
+
+// breakGlassReason returns the justification sent in the call metadata for deploying during a change freeze
+func breakGlassReason(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(utils.HeaderBreakGlassReason); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package grpcapi
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	apiplatformclient "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/apiplatformsvc/client"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	agentmanagerv1 "github.com/wso2/ai-agent-management-platform/agent-manager-service/proto/agentmanager/v1"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
+)
+
+type gatewayServer struct {
+	agentmanagerv1.UnimplementedGatewayServiceServer
+	apiPlatformClient apiplatformclient.APIPlatformClient
+}
+
+func newGatewayServer(apiPlatformClient apiplatformclient.APIPlatformClient) *gatewayServer {
+	return &gatewayServer{
+		apiPlatformClient: apiPlatformClient,
+	}
+}
+
+func (s *gatewayServer) ListGateways(ctx context.Context, req *agentmanagerv1.ListGatewaysRequest) (*agentmanagerv1.ListGatewaysResponse, error) {
+	log := logger.GetLogger(ctx)
+	if s.apiPlatformClient == nil {
+		return nil, status.Error(codes.Unavailable, "gateway management is not enabled")
+	}
+
+	gateways, err := s.apiPlatformClient.ListGateways(ctx, apiplatformclient.GatewayFilters{})
+	if err != nil {
+		log.Error("ListGateways: failed to list gateways from API Platform", "error", err)
+		return nil, toStatusError(err, "failed to list gateways")
+	}
+
+	resp := &agentmanagerv1.ListGatewaysResponse{
+		Gateways: make([]*agentmanagerv1.Gateway, 0, len(gateways.Gateways)),
+	}
+	// The API Platform lists the gateways of every organization
+	for _, gw := range gateways.Gateways {
+		environments := gatewayEnvironments(ctx, req.GetOrgName(), gw.ID)
+		if !services.GatewayBelongsToOrganization(gw, req.GetOrgName(), len(environments) > 0) {
+			continue
+		}
+		resp.Gateways = append(resp.Gateways, toProtoGateway(gw, req.GetOrgName(), environments))
+	}
+	return resp, nil
+}
+
+func (s *gatewayServer) GetGateway(ctx context.Context, req *agentmanagerv1.GetGatewayRequest) (*agentmanagerv1.GetGatewayResponse, error) {
+	log := logger.GetLogger(ctx)
+	if s.apiPlatformClient == nil {
+		return nil, status.Error(codes.Unavailable, "gateway management is not enabled")
+	}
+	if req.GetGatewayId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "gateway_id is required")
+	}
+
+	gw, err := s.apiPlatformClient.GetGateway(ctx, req.GetGatewayId())
+	if err != nil {
+		log.Error("GetGateway: failed to get gateway from API Platform", "error", err)
+		return nil, toStatusError(err, "failed to get gateway")
+	}
+	environments := gatewayEnvironments(ctx, req.GetOrgName(), gw.ID)
+	if !services.GatewayBelongsToOrganization(gw, req.GetOrgName(), len(environments) > 0) {
+		// Gateways of other organizations are reported as missing so that their IDs are not confirmed
+		return nil, status.Error(codes.NotFound, "gateway not found")
+	}
+
+	return &agentmanagerv1.GetGatewayResponse{
+		Gateway: toProtoGateway(gw, req.GetOrgName(), environments),
+	}, nil
+}
+
+// gatewayEnvironments returns the environments of the organization the gateway is assigned to
+func gatewayEnvironments(ctx context.Context, orgName, gatewayID string) []models.Environment {
+	gwUUID, err := uuid.Parse(gatewayID)
+	if err != nil {
+		return nil
+	}
+
+	var environments []models.Environment
+	if err := db.DB(ctx).
+		Joins("JOIN gateway_environment_mappings ON gateway_environment_mappings.environment_uuid = environments.uuid").
+		Where("gateway_environment_mappings.gateway_uuid = ? AND environments.organization_name = ?", gwUUID, orgName).
+		Find(&environments).Error; err != nil {
+		logger.GetLogger(ctx).Warn("gatewayEnvironments: failed to get environments", "error", err)
+		return nil
+	}
+	return environments
+}
+
+func toProtoGateway(gw *apiplatformclient.GatewayResponse, orgName string, environments []models.Environment) *agentmanagerv1.Gateway {
+	gatewayStatus := models.GatewayStatusInactive
+	if gw.IsActive {
+		gatewayStatus = models.GatewayStatusActive
+	}
+
+	result := &agentmanagerv1.Gateway{
+		Uuid:             gw.ID,
+		OrganizationName: orgName,
+		Name:             gw.Name,
+		DisplayName:      gw.DisplayName,
+		GatewayType:      gw.FunctionalityType,
+		Vhost:            gw.Vhost,
+		IsCritical:       gw.IsCritical,
+		Status:           string(gatewayStatus),
+		CreatedAt:        timestamppb.New(gw.CreatedAt),
+		UpdatedAt:        timestamppb.New(gw.UpdatedAt),
+	}
+	for _, env := range environments {
+		result.Environments = append(result.Environments, &agentmanagerv1.Environment{
+			Uuid:         env.UUID.String(),
+			Name:         env.Name,
+			DisplayName:  env.DisplayName,
+			IsProduction: env.IsProduction,
+		})
+	}
+	return result
+}
@@ -0,0 +1,209 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package grpcapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/orgcontext"
+	agentmanagerv1 "github.com/wso2/ai-agent-management-platform/agent-manager-service/proto/agentmanager/v1"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// httpMiddlewareInterceptor runs an HTTP middleware for every unary call. The incoming
+// metadata is exposed to the middleware as request headers and the context it passes on
+// is handed to the gRPC handler. If the middleware writes a response instead of calling
+// the next handler, the call is rejected with the matching gRPC status.
+func httpMiddlewareInterceptor(mw func(http.Handler) http.Handler) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, info.FullMethod, nil)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to process request")
+		}
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			for key, values := range md {
+				for _, v := range values {
+					httpReq.Header.Add(key, v)
+				}
+			}
+		}
+
+		var nextCtx context.Context
+		rec := &responseRecorder{header: http.Header{}}
+		mw(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+			nextCtx = r.Context()
+		})).ServeHTTP(rec, httpReq)
+
+		if md := headersToMetadata(rec.header); len(md) > 0 {
+			_ = grpc.SetHeader(ctx, md)
+		}
+		if nextCtx == nil {
+			return nil, status.Error(httpStatusToCode(rec.status), errorMessage(rec.body))
+		}
+		return handler(nextCtx, req)
+	}
+}
+
+// orgRequest is a request scoped to an organization
+type orgRequest interface {
+	GetOrgName() string
+}
+
+// projectRequest is a request scoped to a project of an organization
+type projectRequest interface {
+	GetProjectName() string
+}
+
+// readOnlyMethods are the calls that suspended organizations may still make
+var readOnlyMethods = map[string]bool{
+	agentmanagerv1.GatewayService_ListGateways_FullMethodName:            true,
+	agentmanagerv1.GatewayService_GetGateway_FullMethodName:              true,
+	agentmanagerv1.DeploymentService_ListAgentDeployments_FullMethodName: true,
+}
+
+// orgContextInterceptor is the gRPC counterpart of orgcontext.ResolveOrgContext and
+// middleware.RejectSuspendedOrganizations. It resolves the organization named in the request,
+// rejects callers that do not belong to it and calls that change a suspended organization, and
+// stores the org context for the handler. Requests of organization-scoped calls must name the
+// organization. It must run after the auth interceptor.
+func orgContextInterceptor(resolver orgcontext.OrganizationResolver) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		scoped, ok := req.(orgRequest)
+		if !ok {
+			return handler(ctx, req)
+		}
+		if scoped.GetOrgName() == "" {
+			return nil, status.Error(codes.InvalidArgument, "org_name is required")
+		}
+		var projName string
+		if project, ok := req.(projectRequest); ok {
+			projName = project.GetProjectName()
+		}
+
+		orgCtx, err := orgcontext.Resolve(ctx, resolver, scoped.GetOrgName(), projName)
+		if errors.Is(err, utils.ErrForbidden) {
+			return nil, status.Error(codes.PermissionDenied, "not a member of the organization")
+		}
+		if err != nil {
+			logger.GetLogger(ctx).Error("Failed to resolve organization", "orgName", scoped.GetOrgName(), "error", err)
+			return nil, status.Error(codes.Internal, "failed to resolve organization")
+		}
+		if orgCtx.IsSuspended() && !readOnlyMethods[info.FullMethod] {
+			return nil, status.Error(codes.PermissionDenied, "organization is suspended")
+		}
+		return handler(orgcontext.WithOrgContext(ctx, orgCtx), req)
+	}
+}
+
+// responseRecorder captures what an HTTP middleware writes when it short-circuits a request
+type responseRecorder struct {
+	header http.Header
+	status int
+	body   []byte
+}
+
+func (r *responseRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	r.body = append(r.body, b...)
+	return len(b), nil
+}
+
+func (r *responseRecorder) WriteHeader(statusCode int) {
+	r.status = statusCode
+}
+
+func headersToMetadata(header http.Header) metadata.MD {
+	md := metadata.MD{}
+	for key, values := range header {
+		key = strings.ToLower(key)
+		if key == "content-type" {
+			continue
+		}
+		md.Append(key, values...)
+	}
+	return md
+}
+
+func errorMessage(body []byte) string {
+	var errResp spec.ErrorResponse
+	if err := json.Unmarshal(body, &errResp); err == nil && errResp.Message != "" {
+		return errResp.Message
+	}
+	return http.StatusText(http.StatusInternalServerError)
+}
+
+func httpStatusToCode(statusCode int) codes.Code {
+	switch statusCode {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.AlreadyExists
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	default:
+		return codes.Internal
+	}
+}
+
+// toStatusError converts domain errors returned by services and clients to gRPC status errors
+func toStatusError(err error, fallbackMsg string) error {
+	switch {
+	case errors.Is(err, utils.ErrGatewayNotFound),
+		errors.Is(err, utils.ErrAgentNotFound),
+		errors.Is(err, utils.ErrProjectNotFound),
+		errors.Is(err, utils.ErrOrganizationNotFound),
+		errors.Is(err, utils.ErrEnvironmentNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, utils.ErrInvalidInput), errors.Is(err, utils.ErrBadRequest):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, utils.ErrUnauthorized):
+		return status.Error(codes.Unauthenticated, "unauthorized")
+	case errors.Is(err, utils.ErrForbidden):
+		return status.Error(codes.PermissionDenied, "forbidden")
+	case errors.Is(err, utils.ErrChangeFreezeActive):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, utils.ErrServiceUnavailable):
+		return status.Error(codes.Unavailable, fallbackMsg)
+	default:
+		return status.Error(codes.Internal, fallbackMsg)
+	}
+}
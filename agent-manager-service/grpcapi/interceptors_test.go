@@ -0,0 +1,96 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package grpcapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/orgcontext"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	agentmanagerv1 "github.com/wso2/ai-agent-management-platform/agent-manager-service/proto/agentmanager/v1"
+)
+
+type fakeOrganizationResolver map[string]*models.Organization
+
+func (f fakeOrganizationResolver) FindOrganization(_ context.Context, orgName string) (*models.Organization, error) {
+	return f[orgName], nil
+}
+
+// contextWithClaims returns a context authenticated with the given claims
+func contextWithClaims(t *testing.T, claims *jwtassertion.TokenClaims) context.Context {
+	var ctx context.Context
+	jwtassertion.NewMockMiddlewareWithClaims(t, claims)(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		ctx = r.Context()
+	})).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", nil))
+	return ctx
+}
+
+func TestOrgContextInterceptor(t *testing.T) {
+	interceptor := orgContextInterceptor(fakeOrganizationResolver{
+		"acme":      {Name: "acme", Status: models.OrganizationStatusActive},
+		"globex":    {Name: "globex", Status: models.OrganizationStatusActive},
+		"suspended": {Name: "suspended", Status: models.OrganizationStatusSuspended},
+	})
+	ctx := contextWithClaims(t, &jwtassertion.TokenClaims{OrgName: "acme"})
+	suspendedCtx := contextWithClaims(t, &jwtassertion.TokenClaims{OrgName: "suspended"})
+	listInfo := &grpc.UnaryServerInfo{FullMethod: agentmanagerv1.GatewayService_ListGateways_FullMethodName}
+	deployInfo := &grpc.UnaryServerInfo{FullMethod: agentmanagerv1.DeploymentService_DeployAgent_FullMethodName}
+
+	var handled *orgcontext.OrgContext
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		handled = orgcontext.GetOrgContext(ctx)
+		return nil, nil
+	}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		req      interface{}
+		info     *grpc.UnaryServerInfo
+		wantCode codes.Code
+	}{
+		{"member", ctx, &agentmanagerv1.ListGatewaysRequest{OrgName: "acme"}, listInfo, codes.OK},
+		{"empty organization", ctx, &agentmanagerv1.ListGatewaysRequest{}, listInfo, codes.InvalidArgument},
+		{"other organization", ctx, &agentmanagerv1.ListGatewaysRequest{OrgName: "globex"}, listInfo, codes.PermissionDenied},
+		{"other organization deploy", ctx, &agentmanagerv1.DeployAgentRequest{OrgName: "globex"}, deployInfo, codes.PermissionDenied},
+		{"suspended read", suspendedCtx, &agentmanagerv1.ListGatewaysRequest{OrgName: "suspended"}, listInfo, codes.OK},
+		{"suspended change", suspendedCtx, &agentmanagerv1.DeployAgentRequest{OrgName: "suspended"}, deployInfo, codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handled = nil
+			_, err := interceptor(tt.ctx, tt.req, tt.info, handler)
+			require.Equal(t, tt.wantCode, status.Code(err), err)
+			if tt.wantCode == codes.OK {
+				require.NotNil(t, handled)
+				assert.Equal(t, tt.req.(orgRequest).GetOrgName(), handled.OrgName)
+			} else {
+				assert.Nil(t, handled)
+			}
+		})
+	}
+}
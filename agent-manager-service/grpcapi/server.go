@@ -0,0 +1,91 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package grpcapi
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
+	agentmanagerv1 "github.com/wso2/ai-agent-management-platform/agent-manager-service/proto/agentmanager/v1"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+// NewGRPCServer creates the gRPC server for internal service-to-service calls.
+// Calls go through the same correlation ID, request logging and authentication
+// middleware as the REST API, and the same organization membership and suspension checks.
+func NewGRPCServer(cfg config.GRPCConfig, params *wiring.AppParams) (*grpc.Server, error) {
+	creds := insecure.NewCredentials()
+	if cfg.TLSCertFile != "" && cfg.TLSKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.TLSCertFile, cfg.TLSKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load gRPC TLS key pair: %w", err)
+		}
+		creds = credentials.NewTLS(&tls.Config{
+			Certificates: []tls.Certificate{cert},
+			MinVersion:   tls.VersionTLS12,
+		})
+	} else {
+		slog.Warn("gRPC server is running without TLS")
+	}
+
+	server := grpc.NewServer(
+		grpc.Creds(creds),
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(
+			recoverInterceptor(),
+			httpMiddlewareInterceptor(middleware.AddCorrelationID()),
+			httpMiddlewareInterceptor(logger.RequestLogger()),
+			httpMiddlewareInterceptor(params.AuthMiddleware),
+			orgContextInterceptor(params.OrganizationService),
+		),
+	)
+
+	agentmanagerv1.RegisterGatewayServiceServer(server, newGatewayServer(params.APIPlatformClient))
+	agentmanagerv1.RegisterDeploymentServiceServer(server, newDeploymentServer(params.AgentManagerService))
+
+	return server, nil
+}
+
+// recoverInterceptor converts panics in handlers into internal errors
+func recoverInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if rec := recover(); rec != nil {
+				slog.Error("recoverOnPanic",
+					"method", info.FullMethod,
+					"log_type", "err_response",
+					"panic", rec,
+					"stack", string(debug.Stack()))
+				err = status.Error(codes.Internal, "internal server error")
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
@@ -18,9 +18,11 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"log/slog"
+	"net"
 	"net/http"
 	"os"
 	"time"
@@ -30,37 +32,61 @@ import (
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
 
 	"go.uber.org/automaxprocs/maxprocs"
+	"google.golang.org/grpc"
 
 	dbmigrations "github.com/wso2/ai-agent-management-platform/agent-manager-service/db_migrations"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/grpcapi"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/signals"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/telemetry"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
 )
 
+// logLevel is the level of the default logger, which a configuration reload can change
+var logLevel = new(slog.LevelVar)
+
 func setupLogger(cfg *config.Config) {
-	var level slog.Level
-	switch cfg.LogLevel {
-	case "DEBUG":
-		level = slog.LevelDebug
-	case "INFO":
-		level = slog.LevelInfo
-	case "WARN":
-		level = slog.LevelWarn
-	case "ERROR":
-		level = slog.LevelError
-	default:
-		level = slog.LevelInfo // default to INFO
-	}
+	logLevel.Set(parseLogLevel(cfg.LogLevel))
 
 	// Create handler options
 	opts := &slog.HandlerOptions{
-		Level: level,
+		Level: logLevel,
 	}
 	handler := slog.NewJSONHandler(os.Stdout, opts)
 	logger := slog.New(handler)
 	slog.SetDefault(logger)
 
 	slog.Info("Logger configured",
-		"level", level.String())
+		"level", logLevel.Level().String())
+}
+
+func parseLogLevel(name string) slog.Level {
+	switch name {
+	case "DEBUG":
+		return slog.LevelDebug
+	case "INFO":
+		return slog.LevelInfo
+	case "WARN":
+		return slog.LevelWarn
+	case "ERROR":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo // default to INFO
+	}
+}
+
+// reloadConfigOnSignal applies the reloadable settings each time the process receives SIGHUP.
+// An invalid configuration is reported and the current one is kept.
+func reloadConfigOnSignal(reloadCh <-chan os.Signal) {
+	for range reloadCh {
+		changed, err := config.Reload()
+		if err != nil {
+			slog.Error("Configuration reload failed, keeping the current configuration", "error", err)
+			continue
+		}
+		logLevel.Set(parseLogLevel(config.GetConfig().LogLevel))
+		slog.Info("Configuration reloaded", "changed", changed)
+	}
 }
 
 func main() {
@@ -77,11 +103,36 @@ func main() {
 			os.Exit(1)
 		}
 	}
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrateCommand(os.Args[2:]); err != nil {
+			slog.Error("error occurred while running migrate command", "error", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	serverFlag := flag.Bool("server", true, "start the http Server")
 	migrateFlag := flag.Bool("migrate", false, "migrate the database")
+	validateConfigFlag := flag.Bool("validate-config", false, "validate the configuration and exit")
+	configSchemaFlag := flag.Bool("config-schema", false, "print the settings the service reads as JSON and exit")
 
 	flag.Parse()
 
+	// The configuration is validated when it is loaded, which exits with the problems found
+	if *validateConfigFlag {
+		slog.Info("Configuration is valid")
+		return
+	}
+	if *configSchemaFlag {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(config.Schema()); err != nil {
+			slog.Error("failed to print the configuration schema", "error", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	if *migrateFlag {
 		if err := dbmigrations.Migrate(); err != nil {
 			slog.Error("error occurred while migrating", "error", err)
@@ -92,6 +143,17 @@ func main() {
 	if !*serverFlag {
 		return
 	}
+
+	if err := ensureSchema(context.Background(), cfg.DBMigrationStartupMode); err != nil {
+		slog.Error("database schema check failed", "error", err)
+		os.Exit(1)
+	}
+
+	shutdownTracing, err := telemetry.InitTracing(context.Background(), cfg.Tracing, cfg.PackageVersion)
+	if err != nil {
+		slog.Error("failed to initialize tracing", "error", err)
+		os.Exit(1)
+	}
 	db := db.DB(context.Background())
 	dependencies, err := wiring.InitializeAppParams(cfg, db)
 	if err != nil {
@@ -109,16 +171,92 @@ func main() {
 		MaxHeaderBytes: cfg.MaxHeaderBytes,
 	}
 
+	var grpcServer *grpc.Server
+	if cfg.GRPC.Enabled {
+		grpcServer, err = grpcapi.NewGRPCServer(cfg.GRPC, dependencies)
+		if err != nil {
+			slog.Error("failed to create gRPC server", "error", err)
+			os.Exit(1)
+		}
+		listener, err := net.Listen("tcp", fmt.Sprintf("%s:%d", cfg.ServerHost, cfg.GRPC.Port))
+		if err != nil {
+			slog.Error("failed to listen for gRPC", "error", err)
+			os.Exit(1)
+		}
+		go func() {
+			slog.Info("gRPC server is running", "address", listener.Addr().String())
+			if err := grpcServer.Serve(listener); err != nil {
+				slog.Error("gRPC server stopped", "error", err)
+			}
+		}()
+	}
+
 	stopCh := signals.SetupSignalHandler()
+	go reloadConfigOnSignal(signals.SetupReloadHandler())
+
+	refresherCtx, stopRefresher := context.WithCancel(context.Background())
+	defer stopRefresher()
+	if cfg.MCP.ToolRefreshIntervalSeconds > 0 {
+		go dependencies.MCPServerService.RunToolRefresher(refresherCtx, time.Duration(cfg.MCP.ToolRefreshIntervalSeconds)*time.Second)
+	}
+	if cfg.TraceObserver.RetentionEnforceIntervalSeconds > 0 {
+		go dependencies.ObservabilityManagerService.RunRetentionEnforcer(refresherCtx, time.Duration(cfg.TraceObserver.RetentionEnforceIntervalSeconds)*time.Second)
+	}
+	if cfg.TraceObserver.GoldenTraceIntervalSeconds > 0 {
+		go dependencies.ObservabilityManagerService.RunGoldenTraceScheduler(refresherCtx, time.Duration(cfg.TraceObserver.GoldenTraceIntervalSeconds)*time.Second)
+	}
+	if cfg.TraceObserver.TokenBudgetIntervalSeconds > 0 {
+		go dependencies.ObservabilityManagerService.RunTokenBudgetChecker(refresherCtx, time.Duration(cfg.TraceObserver.TokenBudgetIntervalSeconds)*time.Second)
+	}
+	if cfg.TraceObserver.UsageReportIntervalSeconds > 0 {
+		go dependencies.ObservabilityManagerService.RunUsageReportGenerator(refresherCtx, time.Duration(cfg.TraceObserver.UsageReportIntervalSeconds)*time.Second)
+	}
+	if cfg.TraceObserver.NotificationDigestIntervalSeconds > 0 {
+		go dependencies.ObservabilityManagerService.RunNotificationDigestSender(refresherCtx, time.Duration(cfg.TraceObserver.NotificationDigestIntervalSeconds)*time.Second)
+	}
+	if cfg.Email.SMTPHost != "" && cfg.Email.TokenExpiryCheckIntervalSeconds > 0 {
+		go dependencies.ObservabilityManagerService.RunAgentTokenExpiryNotifier(refresherCtx, time.Duration(cfg.Email.TokenExpiryCheckIntervalSeconds)*time.Second)
+	}
+	if cfg.TrialSandbox.GatewayID != "" && cfg.TrialSandbox.CleanupIntervalSeconds > 0 {
+		go dependencies.OrganizationService.RunTrialSandboxCleanup(refresherCtx, time.Duration(cfg.TrialSandbox.CleanupIntervalSeconds)*time.Second)
+	}
+	if cfg.TraceJudge.URL != "" && cfg.TraceJudge.IntervalSeconds > 0 {
+		go dependencies.ObservabilityManagerService.RunTraceScorer(refresherCtx, time.Duration(cfg.TraceJudge.IntervalSeconds)*time.Second)
+	}
 
+	// Closed once the servers are stopped and traces flushed; ListenAndServe returns as soon as
+	// Shutdown starts, so main waits on it before exiting
+	done := make(chan struct{})
 	go func() {
+		defer close(done)
 		<-stopCh
-		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+		stopRefresher()
+		slog.Info("draining agent-manager-service", "drainDelaySeconds", cfg.ShutdownDrainDelaySeconds)
+		middleware.StartDraining()
+		time.Sleep(time.Duration(cfg.ShutdownDrainDelaySeconds) * time.Second)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(cfg.ShutdownTimeoutSeconds)*time.Second)
 		defer cancel()
 
+		if grpcServer != nil {
+			stopped := make(chan struct{})
+			go func() {
+				grpcServer.GracefulStop()
+				close(stopped)
+			}()
+			select {
+			case <-stopped:
+			case <-ctx.Done():
+				slog.Error("forced gRPC shutdown after timeout")
+				grpcServer.Stop()
+			}
+		}
 		if err := server.Shutdown(ctx); err != nil {
 			slog.Error("forced shutdown after timeout", "error", err)
 		}
+		if err := shutdownTracing(ctx); err != nil {
+			slog.Error("failed to flush traces", "error", err)
+		}
 	}()
 
 	slog.Info("agent-manager-service is running", "address", server.Addr)
@@ -126,4 +264,6 @@ func main() {
 		slog.Error("failed to start server", "error", err)
 		os.Exit(1)
 	}
+	<-done
+	slog.Info("agent-manager-service stopped")
 }
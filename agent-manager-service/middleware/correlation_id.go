@@ -26,7 +26,7 @@ import (
 )
 
 const (
-	CorrelationIDHeader string = "x-correlation-id"
+	CorrelationIDHeader = utils.CorrelationIDHeader
 )
 
 // AddCorrelationID middleware adds or generates a correlation ID for request tracing
@@ -39,7 +39,8 @@ func CORS(allowedOrigin string) func(http.Handler) http.Handler {
 					w.Header().Set("Access-Control-Allow-Credentials", "true")
 				}
 				w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
-				w.Header().Set("Access-Control-Allow-Headers", "Authorization, Content-Type, X-Requested-With, Accept, Origin, x-correlation-id")
+				w.Header().Set("Access-Control-Allow-Headers", "Authorization, Content-Type, X-Requested-With, Accept, Origin, x-correlation-id, If-Match, X-Break-Glass-Reason")
+				w.Header().Set("Access-Control-Expose-Headers", "ETag, x-correlation-id, x-trace-id")
 				w.Header().Set("Access-Control-Max-Age", "86400")
 			}
 
@@ -0,0 +1,48 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package middleware
+
+import (
+	"net/http"
+	"sync/atomic"
+)
+
+var draining atomic.Bool
+
+// StartDraining marks the server as draining. Readiness checks start failing so that load
+// balancers stop routing new requests, while in-flight requests are allowed to complete.
+func StartDraining() {
+	draining.Store(true)
+}
+
+// IsDraining reports whether the server is shutting down
+func IsDraining() bool {
+	return draining.Load()
+}
+
+// CloseConnectionsWhenDraining asks clients to close keep-alive connections once the server
+// is draining so that their next request is sent to another replica.
+func CloseConnectionsWhenDraining() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if IsDraining() {
+				w.Header().Set("Connection", "close")
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
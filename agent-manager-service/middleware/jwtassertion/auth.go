@@ -18,26 +18,24 @@ package jwtassertion
 
 import (
 	"context"
-	"crypto/rsa"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"log/slog"
-	"math/big"
 	"net/http"
 	"strings"
-	"sync"
-	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 
-	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
 )
 
 type TokenClaims struct {
 	Sub   string `json:"sub"`
 	Scope string `json:"scope"`
+	// OrgName and OrgHandle identify the organization the token was issued for, when the IdP sets them
+	OrgName   string `json:"org_name,omitempty"`
+	OrgHandle string `json:"org_handle,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -57,30 +55,8 @@ const (
 	scopesKey ctxKeyName = "scopes"
 )
 
-// JWKS represents a JSON Web Key Set
-type JWKS struct {
-	Keys []JSONWebKey `json:"keys"`
-}
-
-// JSONWebKey represents a single key in a JWKS
-type JSONWebKey struct {
-	Kty string   `json:"kty"`
-	Kid string   `json:"kid"`
-	Use string   `json:"use"`
-	N   string   `json:"n"`
-	E   string   `json:"e"`
-	Alg string   `json:"alg"`
-	X5c []string `json:"x5c,omitempty"`
-}
-
-var (
-	jwksCache      *JWKS
-	jwksCacheMutex sync.RWMutex
-	jwksCacheTime  time.Time
-	jwksCacheTTL   = 1 * time.Hour
-)
-
-func JWTAuthMiddleware(header string) func(http.Handler) http.Handler {
+// JWTAuthMiddleware authenticates requests with a bearer token from the given header
+func JWTAuthMiddleware(header string, validator *Validator) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			tokenString := r.Header.Get(header)
@@ -91,8 +67,7 @@ func JWTAuthMiddleware(header string) func(http.Handler) http.Handler {
 			// replace "Bearer " prefix
 			tokenString = strings.Replace(tokenString, "Bearer ", "", 1)
 
-			// Validate the token using JWKS
-			claims, err := validateJWTWithJWKS(tokenString)
+			claims, err := validator.Validate(r.Context(), tokenString)
 			if err != nil {
 				slog.Error("JWT validation failed", "error", err)
 				utils.WriteErrorResponse(w, http.StatusUnauthorized, "invalid jwt")
@@ -143,99 +118,6 @@ func HasAllScopes(ctx context.Context, requiredScopes []string) bool {
 	return true
 }
 
-// validateJWTWithJWKS validates a JWT token using JWKS and validates issuer and audience
-func validateJWTWithJWKS(tokenString string) (*TokenClaims, error) {
-	cfg := config.GetConfig()
-	if cfg == nil {
-		return nil, fmt.Errorf("configuration not loaded")
-	}
-
-	var claims *TokenClaims
-
-	// If JWKS URL is configured, validate signature with JWKS
-	if cfg.KeyManagerConfigurations.JWKSUrl != "" {
-		// Perform full JWKS validation with signature verification
-		token, err := jwt.ParseWithClaims(tokenString, &TokenClaims{}, func(token *jwt.Token) (interface{}, error) {
-			// Verify signing method is RSA
-			if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
-				return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-			}
-
-			// Get the key ID from the token header
-			kid, ok := token.Header["kid"].(string)
-			if !ok {
-				return nil, fmt.Errorf("kid not found in token header")
-			}
-
-			// Fetch JWKS and get the public key
-			jwks, err := fetchJWKS(cfg.KeyManagerConfigurations.JWKSUrl)
-			if err != nil {
-				return nil, fmt.Errorf("failed to fetch JWKS: %w", err)
-			}
-
-			// Find the key with matching kid
-			for _, key := range jwks.Keys {
-				if key.Kid == kid {
-					return convertJWKToPublicKey(&key)
-				}
-			}
-
-			return nil, fmt.Errorf("unable to find key with kid: %s", kid)
-		})
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse token: %w", err)
-		}
-
-		if !token.Valid {
-			return nil, fmt.Errorf("token is not valid")
-		}
-
-		validatedClaims, ok := token.Claims.(*TokenClaims)
-		if !ok {
-			return nil, fmt.Errorf("failed to extract claims")
-		}
-		claims = validatedClaims
-	} else {
-		// No JWKS URL configured - extract claims without signature validation
-		// This is only allowed for tokens with the configured DefaultIssuer
-		extractedClaims, err := extractClaimsFromJWT(tokenString)
-		if err != nil {
-			return nil, fmt.Errorf("failed to extract claims: %w", err)
-		}
-		claims = extractedClaims
-
-		// Ensures we only skip signature validation for the default issuer
-		if strings.TrimSpace(claims.Issuer) != strings.TrimSpace(cfg.KeyManagerConfigurations.DefaultIssuer) {
-			return nil, fmt.Errorf("JWKS signature validation required for issuer '%s'",
-				claims.Issuer)
-		}
-
-		// Validate expiration using RegisteredClaims.ExpiresAt
-		if claims.ExpiresAt != nil {
-			if !claims.ExpiresAt.After(time.Now()) {
-				return nil, fmt.Errorf("token has expired")
-			}
-		}
-
-		// Validate audience
-		if err := validateAudience(claims.Audience, cfg.KeyManagerConfigurations.Audience); err != nil {
-			return nil, err
-		}
-
-		return claims, nil
-	}
-
-	if err := validateIssuer(claims.Issuer, cfg.KeyManagerConfigurations.Issuer); err != nil {
-		return nil, err
-	}
-
-	if err := validateAudience(claims.Audience, cfg.KeyManagerConfigurations.Audience); err != nil {
-		return nil, err
-	}
-
-	return claims, nil
-}
-
 // validateIssuer validates the issuer claim against allowed issuers
 func validateIssuer(issuer string, allowedIssuers []string) error {
 	if len(allowedIssuers) == 0 {
@@ -272,70 +154,6 @@ func validateAudience(audiences jwt.ClaimStrings, allowedAudiences []string) err
 	return fmt.Errorf("invalid audience: got %v", audiences)
 }
 
-// fetchJWKS fetches the JWKS from the provided URL with caching
-func fetchJWKS(jwksURL string) (*JWKS, error) {
-	jwksCacheMutex.RLock()
-	if jwksCache != nil && time.Since(jwksCacheTime) < jwksCacheTTL {
-		defer jwksCacheMutex.RUnlock()
-		return jwksCache, nil
-	}
-	jwksCacheMutex.RUnlock()
-
-	// Fetch new JWKS
-	client := &http.Client{Timeout: 10 * time.Second}
-	resp, err := client.Get(jwksURL)
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch JWKS: %w", err)
-	}
-	defer func() { _ = resp.Body.Close() }()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("JWKS endpoint returned status: %d", resp.StatusCode)
-	}
-
-	var jwks JWKS
-	if err := json.NewDecoder(resp.Body).Decode(&jwks); err != nil {
-		return nil, fmt.Errorf("failed to decode JWKS: %w", err)
-	}
-
-	// Update cache
-	jwksCacheMutex.Lock()
-	jwksCache = &jwks
-	jwksCacheTime = time.Now()
-	jwksCacheMutex.Unlock()
-
-	return &jwks, nil
-}
-
-// convertJWKToPublicKey converts a JWK to an RSA public key
-func convertJWKToPublicKey(jwk *JSONWebKey) (*rsa.PublicKey, error) {
-	// Decode the modulus (n)
-	nBytes, err := base64.RawURLEncoding.DecodeString(jwk.N)
-	if err != nil {
-		return nil, fmt.Errorf("failed to decode modulus: %w", err)
-	}
-
-	// Decode the exponent (e)
-	eBytes, err := base64.RawURLEncoding.DecodeString(jwk.E)
-	if err != nil {
-		return nil, fmt.Errorf("failed to decode exponent: %w", err)
-	}
-
-	// Convert bytes to big.Int for modulus
-	n := new(big.Int).SetBytes(nBytes)
-
-	// Convert bytes to int for exponent
-	var e int
-	for _, b := range eBytes {
-		e = e<<8 + int(b)
-	}
-
-	return &rsa.PublicKey{
-		N: n,
-		E: e,
-	}, nil
-}
-
 func extractClaimsFromJWT(tokenString string) (*TokenClaims, error) {
 	parts := strings.Split(tokenString, ".")
 	if len(parts) != 3 {
@@ -29,12 +29,17 @@ import (
 func NewMockMiddleware(t *testing.T) Middleware {
 	t.Helper()
 
-	tokenClaims := &TokenClaims{
+	return NewMockMiddlewareWithClaims(t, &TokenClaims{
 		Scope: "scopes",
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
 		},
-	}
+	})
+}
+
+// NewMockMiddlewareWithClaims creates a mock JWT middleware that authenticates every request with the given claims
+func NewMockMiddlewareWithClaims(t *testing.T, tokenClaims *TokenClaims) Middleware {
+	t.Helper()
 
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,150 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package jwtassertion
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+)
+
+// trustedIssuer is an issuer whose tokens are verified against its JWKS
+type trustedIssuer struct {
+	audiences []string
+	keys      *jwksCache
+}
+
+// Validator validates bearer tokens issued by any of the configured identity providers.
+// Tokens are matched to an issuer by their iss claim and verified with that issuer's keys.
+type Validator struct {
+	issuers map[string]*trustedIssuer
+	// defaultIssuer is the issuer whose tokens are accepted without a signature check. It is only
+	// set when no JWKS is configured at all, so that it cannot bypass the keys of a deployment.
+	defaultIssuer    string
+	defaultAudiences []string
+	leeway           time.Duration
+}
+
+// NewValidator creates a validator from the key manager configuration. The legacy single
+// KEY_MANAGER_JWKS_URL applies to every KEY_MANAGER_ISSUER and is merged with KEY_MANAGER_TRUSTED_ISSUERS.
+func NewValidator(cfg config.KeyManagerConfigurations) *Validator {
+	ttl := time.Duration(cfg.JWKSCacheTTLSeconds) * time.Second
+	minRefresh := time.Duration(cfg.JWKSMinRefreshIntervalSeconds) * time.Second
+
+	v := &Validator{
+		issuers:          make(map[string]*trustedIssuer),
+		defaultAudiences: cfg.Audience,
+		leeway:           time.Duration(cfg.ClockSkewSeconds) * time.Second,
+	}
+	if cfg.JWKSUrl == "" && len(cfg.TrustedIssuers) == 0 {
+		v.defaultIssuer = strings.TrimSpace(cfg.DefaultIssuer)
+	}
+
+	caches := make(map[string]*jwksCache)
+	cacheFor := func(url string) *jwksCache {
+		if c, ok := caches[url]; ok {
+			return c
+		}
+		c := newJWKSCache(url, ttl, minRefresh)
+		caches[url] = c
+		return c
+	}
+
+	if cfg.JWKSUrl != "" {
+		for _, issuer := range cfg.Issuer {
+			v.issuers[strings.TrimSpace(issuer)] = &trustedIssuer{
+				audiences: cfg.Audience,
+				keys:      cacheFor(cfg.JWKSUrl),
+			}
+		}
+	}
+	for _, issuer := range cfg.TrustedIssuers {
+		audiences := issuer.Audiences
+		if len(audiences) == 0 {
+			audiences = cfg.Audience
+		}
+		v.issuers[strings.TrimSpace(issuer.Issuer)] = &trustedIssuer{
+			audiences: audiences,
+			keys:      cacheFor(issuer.JWKSUrl),
+		}
+	}
+	return v
+}
+
+// Validate verifies the token signature, issuer, audience and time based claims and returns its claims
+func (v *Validator) Validate(ctx context.Context, tokenString string) (*TokenClaims, error) {
+	unverified, err := extractClaimsFromJWT(tokenString)
+	if err != nil {
+		return nil, err
+	}
+	issuerName := strings.TrimSpace(unverified.Issuer)
+
+	issuer, ok := v.issuers[issuerName]
+	if !ok {
+		// Tokens of the default issuer are accepted without a signature check when no JWKS is configured
+		if v.defaultIssuer != "" && issuerName == v.defaultIssuer {
+			return v.validateUnsigned(unverified)
+		}
+		return nil, fmt.Errorf("untrusted issuer: %s", unverified.Issuer)
+	}
+
+	token, err := jwt.ParseWithClaims(tokenString, &TokenClaims{}, func(token *jwt.Token) (interface{}, error) {
+		kid, ok := token.Header["kid"].(string)
+		if !ok {
+			return nil, fmt.Errorf("kid not found in token header")
+		}
+		return issuer.keys.key(ctx, kid)
+	},
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512"}),
+		jwt.WithLeeway(v.leeway),
+		jwt.WithIssuer(unverified.Issuer),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse token: %w", err)
+	}
+	if !token.Valid {
+		return nil, fmt.Errorf("token is not valid")
+	}
+
+	claims, ok := token.Claims.(*TokenClaims)
+	if !ok {
+		return nil, fmt.Errorf("failed to extract claims")
+	}
+	if err := validateAudience(claims.Audience, issuer.audiences); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+func (v *Validator) validateUnsigned(claims *TokenClaims) (*TokenClaims, error) {
+	now := time.Now()
+	if claims.ExpiresAt != nil && !now.Before(claims.ExpiresAt.Add(v.leeway)) {
+		return nil, fmt.Errorf("token has expired")
+	}
+	if claims.NotBefore != nil && now.Add(v.leeway).Before(claims.NotBefore.Time) {
+		return nil, fmt.Errorf("token is not valid yet")
+	}
+	if err := validateAudience(claims.Audience, v.defaultAudiences); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
@@ -0,0 +1,209 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package jwtassertion
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+)
+
+type testIDP struct {
+	t       *testing.T
+	mu      sync.Mutex
+	keys    map[string]*rsa.PrivateKey
+	fetches atomic.Int32
+	server  *httptest.Server
+}
+
+func newTestIDP(t *testing.T, kid string) *testIDP {
+	idp := &testIDP{t: t, keys: map[string]*rsa.PrivateKey{}}
+	idp.addKey(kid)
+	idp.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		idp.fetches.Add(1)
+		idp.mu.Lock()
+		defer idp.mu.Unlock()
+		jwks := JWKS{}
+		for kid, key := range idp.keys {
+			jwks.Keys = append(jwks.Keys, JSONWebKey{
+				Kty: "RSA",
+				Kid: kid,
+				Use: "sig",
+				N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			})
+		}
+		_ = json.NewEncoder(w).Encode(jwks)
+	}))
+	t.Cleanup(idp.server.Close)
+	return idp
+}
+
+func (idp *testIDP) addKey(kid string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		idp.t.Fatalf("failed to generate key: %v", err)
+	}
+	idp.mu.Lock()
+	idp.keys[kid] = key
+	idp.mu.Unlock()
+}
+
+func (idp *testIDP) sign(kid string, claims jwt.RegisteredClaims) string {
+	idp.mu.Lock()
+	key := idp.keys[kid]
+	idp.mu.Unlock()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, TokenClaims{RegisteredClaims: claims})
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	if err != nil {
+		idp.t.Fatalf("failed to sign token: %v", err)
+	}
+	return signed
+}
+
+func TestValidatorMultipleIssuers(t *testing.T) {
+	idpA := newTestIDP(t, "a1")
+	idpB := newTestIDP(t, "b1")
+
+	validator := NewValidator(config.KeyManagerConfigurations{
+		Audience: []string{"agent-manager"},
+		TrustedIssuers: []config.TrustedIssuer{
+			{Issuer: "https://idp-a", JWKSUrl: idpA.server.URL},
+			{Issuer: "https://idp-b", JWKSUrl: idpB.server.URL, Audiences: []string{"platform"}},
+		},
+		ClockSkewSeconds:              30,
+		JWKSCacheTTLSeconds:           3600,
+		JWKSMinRefreshIntervalSeconds: 0,
+	})
+	ctx := context.Background()
+	exp := jwt.NewNumericDate(time.Now().Add(time.Hour))
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{"token from first issuer", idpA.sign("a1", jwt.RegisteredClaims{Issuer: "https://idp-a", Audience: jwt.ClaimStrings{"agent-manager"}, ExpiresAt: exp}), false},
+		{"token from second issuer with its own audience", idpB.sign("b1", jwt.RegisteredClaims{Issuer: "https://idp-b", Audience: jwt.ClaimStrings{"platform"}, ExpiresAt: exp}), false},
+		{"wrong audience for issuer", idpB.sign("b1", jwt.RegisteredClaims{Issuer: "https://idp-b", Audience: jwt.ClaimStrings{"agent-manager"}, ExpiresAt: exp}), true},
+		{"signed by another issuer's key", idpB.sign("b1", jwt.RegisteredClaims{Issuer: "https://idp-a", Audience: jwt.ClaimStrings{"agent-manager"}, ExpiresAt: exp}), true},
+		{"untrusted issuer", idpA.sign("a1", jwt.RegisteredClaims{Issuer: "https://evil", Audience: jwt.ClaimStrings{"agent-manager"}, ExpiresAt: exp}), true},
+		{"expired within clock skew", idpA.sign("a1", jwt.RegisteredClaims{Issuer: "https://idp-a", Audience: jwt.ClaimStrings{"agent-manager"}, ExpiresAt: jwt.NewNumericDate(time.Now().Add(-10 * time.Second))}), false},
+		{"expired beyond clock skew", idpA.sign("a1", jwt.RegisteredClaims{Issuer: "https://idp-a", Audience: jwt.ClaimStrings{"agent-manager"}, ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Minute))}), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := validator.Validate(ctx, tt.token)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidatorDefaultIssuerUnsigned(t *testing.T) {
+	idp := newTestIDP(t, "k1")
+	ctx := context.Background()
+
+	claims := TokenClaims{RegisteredClaims: jwt.RegisteredClaims{
+		Issuer:    "Agent Management Platform Local",
+		Audience:  jwt.ClaimStrings{"agent-manager"},
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}}
+	unsigned, err := jwt.NewWithClaims(jwt.SigningMethodNone, claims).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	if err != nil {
+		t.Fatalf("failed to build unsigned token: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		cfg     config.KeyManagerConfigurations
+		wantErr bool
+	}{
+		{"no JWKS configured", config.KeyManagerConfigurations{}, false},
+		{"JWKS URL configured", config.KeyManagerConfigurations{
+			JWKSUrl: idp.server.URL,
+			Issuer:  []string{"https://idp"},
+		}, true},
+		{"trusted issuers configured", config.KeyManagerConfigurations{
+			TrustedIssuers: []config.TrustedIssuer{{Issuer: "https://idp", JWKSUrl: idp.server.URL}},
+		}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.cfg.DefaultIssuer = "Agent Management Platform Local"
+			tt.cfg.Audience = []string{"agent-manager"}
+			_, err := NewValidator(tt.cfg).Validate(ctx, unsigned)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidatorKeyRotation(t *testing.T) {
+	idp := newTestIDP(t, "k1")
+	validator := NewValidator(config.KeyManagerConfigurations{
+		Audience:                      []string{"agent-manager"},
+		TrustedIssuers:                []config.TrustedIssuer{{Issuer: "https://idp", JWKSUrl: idp.server.URL}},
+		JWKSCacheTTLSeconds:           3600,
+		JWKSMinRefreshIntervalSeconds: 3600,
+	})
+	ctx := context.Background()
+	claims := jwt.RegisteredClaims{
+		Issuer:    "https://idp",
+		Audience:  jwt.ClaimStrings{"agent-manager"},
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}
+
+	if _, err := validator.Validate(ctx, idp.sign("k1", claims)); err != nil {
+		t.Fatalf("Validate() with initial key: %v", err)
+	}
+	if _, err := validator.Validate(ctx, idp.sign("k1", claims)); err != nil {
+		t.Fatalf("Validate() with cached key: %v", err)
+	}
+	if got := idp.fetches.Load(); got != 1 {
+		t.Fatalf("expected keys to be cached, got %d fetches", got)
+	}
+
+	// A key rotated in after the last refresh is only picked up once the refresh interval allows it
+	idp.addKey("k2")
+	if _, err := validator.Validate(ctx, idp.sign("k2", claims)); err == nil {
+		t.Fatal("expected unknown key to be rejected within the refresh interval")
+	}
+	validator.issuers["https://idp"].keys.lastAttempt = time.Time{}
+	if _, err := validator.Validate(ctx, idp.sign("k2", claims)); err != nil {
+		t.Fatalf("Validate() with rotated key: %v", err)
+	}
+	if got := idp.fetches.Load(); got != 2 {
+		t.Fatalf("expected a single refresh for the rotated key, got %d fetches", got)
+	}
+}
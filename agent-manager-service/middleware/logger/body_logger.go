@@ -0,0 +1,152 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package logger
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+)
+
+// BodyLogger logs the request and response bodies of each request at DEBUG level, with tokens,
+// credentials and API keys redacted and at most MaxBytes of each body. It is meant for
+// troubleshooting and only reads bodies while the request logger has DEBUG enabled, so it costs
+// nothing otherwise. The settings are read on every request, so that a configuration reload can
+// turn body logging on and off. It must run after RequestLogger so that the log carries the
+// request fields.
+func BodyLogger(settings func() config.BodyLoggingConfig) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			cfg := settings()
+			log := GetLogger(r.Context())
+			if !cfg.Enabled || cfg.MaxBytes <= 0 || !log.Enabled(r.Context(), slog.LevelDebug) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			requestBody := &cappedBuffer{max: cfg.MaxBytes}
+			if r.Body != nil && r.Body != http.NoBody {
+				r.Body = &teeReadCloser{Reader: io.TeeReader(r.Body, requestBody), Closer: r.Body}
+			}
+			recorder := &bodyRecorder{ResponseWriter: w, status: http.StatusOK, body: &cappedBuffer{max: cfg.MaxBytes}}
+
+			next.ServeHTTP(recorder, r)
+
+			log.Debug("HTTP bodies",
+				slog.Group("request",
+					slog.String("body", describeBody(r.Header.Get("Content-Type"), requestBody)),
+					slog.Int("size", requestBody.size),
+				),
+				slog.Group("response",
+					slog.Int("status", recorder.status),
+					slog.String("body", describeBody(recorder.Header().Get("Content-Type"), recorder.body)),
+					slog.Int("size", recorder.body.size),
+				),
+			)
+		})
+	}
+}
+
+// describeBody returns the redacted text of a captured body, or a placeholder for bodies that
+// are not text
+func describeBody(contentType string, body *cappedBuffer) string {
+	if body.size == 0 {
+		return ""
+	}
+	if !isTextContent(contentType) {
+		return "[" + contentType + " body not logged]"
+	}
+	text := redactBody(contentType, body.buf.Bytes(), body.truncated())
+	if body.truncated() {
+		text += "...[truncated]"
+	}
+	return text
+}
+
+func isTextContent(contentType string) bool {
+	contentType = strings.ToLower(contentType)
+	return contentType == "" ||
+		strings.HasPrefix(contentType, "text/") ||
+		strings.Contains(contentType, "json") ||
+		strings.Contains(contentType, "xml") ||
+		strings.Contains(contentType, "yaml") ||
+		strings.HasPrefix(contentType, "application/x-www-form-urlencoded")
+}
+
+// cappedBuffer keeps the first max bytes written to it and counts the rest
+type cappedBuffer struct {
+	buf  bytes.Buffer
+	max  int
+	size int
+}
+
+func (b *cappedBuffer) Write(p []byte) (int, error) {
+	if room := b.max - b.buf.Len(); room > 0 {
+		b.buf.Write(p[:min(room, len(p))])
+	}
+	b.size += len(p)
+	return len(p), nil
+}
+
+func (b *cappedBuffer) truncated() bool {
+	return b.size > b.buf.Len()
+}
+
+// teeReadCloser copies the request body into a buffer as the handler reads it, so that bodies
+// are neither read twice nor held in memory beyond the cap
+type teeReadCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// bodyRecorder captures the status and body written to a response
+type bodyRecorder struct {
+	http.ResponseWriter
+	status      int
+	body        *cappedBuffer
+	wroteHeader bool
+}
+
+func (r *bodyRecorder) WriteHeader(status int) {
+	if !r.wroteHeader {
+		r.status = status
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *bodyRecorder) Write(p []byte) (int, error) {
+	r.wroteHeader = true
+	_, _ = r.body.Write(p)
+	return r.ResponseWriter.Write(p)
+}
+
+// Flush keeps streamed responses, such as agent invocations, streaming
+func (r *bodyRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Unwrap lets http.ResponseController reach the underlying response writer
+func (r *bodyRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
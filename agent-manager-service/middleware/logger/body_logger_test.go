@@ -0,0 +1,113 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+)
+
+func serveWithBodyLogger(t *testing.T, level slog.Level, maxBytes int, body string) (map[string]any, *httptest.ResponseRecorder) {
+	t.Helper()
+	var logs bytes.Buffer
+	log := slog.New(slog.NewJSONHandler(&logs, &slog.HandlerOptions{Level: level}))
+
+	settings := func() config.BodyLoggingConfig { return config.BodyLoggingConfig{Enabled: true, MaxBytes: maxBytes} }
+	handler := BodyLogger(settings)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, body, string(received), "the handler must receive the whole body")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"abc","token":"eyJhbGciOiJIUzI1NiJ9.eyJzdWIiOiIxIn0.sig"}`))
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/agents", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req = req.WithContext(WithLogger(req.Context(), log))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if logs.Len() == 0 {
+		return nil, rec
+	}
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(logs.Bytes(), &entry))
+	return entry, rec
+}
+
+func TestBodyLogger(t *testing.T) {
+	t.Run("Bodies are logged with secrets redacted", func(t *testing.T) {
+		body := `{"name":"agent","apiKey":"sk-abcdefghijklmnopqrstuvwx","maxTokens":100}`
+		entry, rec := serveWithBodyLogger(t, slog.LevelDebug, 4096, body)
+
+		require.NotNil(t, entry)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		request := entry["request"].(map[string]any)
+		assert.Equal(t, `{"apiKey":"[REDACTED]","maxTokens":100,"name":"agent"}`, request["body"])
+		response := entry["response"].(map[string]any)
+		assert.Equal(t, float64(http.StatusCreated), response["status"])
+		assert.Equal(t, `{"id":"abc","token":"[REDACTED]"}`, response["body"])
+	})
+
+	t.Run("Bodies over the cap are truncated and redacted by pattern", func(t *testing.T) {
+		body := `{"password":"hunter22","description":"` + strings.Repeat("x", 100) + `"}`
+		entry, _ := serveWithBodyLogger(t, slog.LevelDebug, 32, body)
+
+		request := entry["request"].(map[string]any)
+		assert.Equal(t, `{"password":"[REDACTED]","descript...[truncated]`, request["body"])
+		assert.Equal(t, float64(len(body)), request["size"])
+	})
+
+	t.Run("Nothing is logged above DEBUG level", func(t *testing.T) {
+		entry, rec := serveWithBodyLogger(t, slog.LevelInfo, 4096, `{"name":"agent"}`)
+
+		assert.Nil(t, entry)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+	})
+}
+
+func TestRedactBody(t *testing.T) {
+	t.Run("Nested credentials are redacted as a whole", func(t *testing.T) {
+		body := `{"auth":{"type":"bearer","credentials":{"user":"a","value":"b"}},"tokenBudget":{"monthlyLimit":10}}`
+		assert.Equal(t,
+			`{"auth":{"credentials":"[REDACTED]","type":"bearer"},"tokenBudget":{"monthlyLimit":10}}`,
+			redactBody("application/json", []byte(body), false))
+	})
+
+	t.Run("Form fields are redacted by name", func(t *testing.T) {
+		body := "grant_type=client_credentials&client_secret=s3cr3t"
+		assert.Equal(t, "client_secret=%5BREDACTED%5D&grant_type=client_credentials",
+			redactBody("application/x-www-form-urlencoded", []byte(body), false))
+	})
+
+	t.Run("Bearer tokens in text are redacted", func(t *testing.T) {
+		assert.Equal(t, "sent Bearer [REDACTED] upstream",
+			redactBody("text/plain", []byte("sent Bearer abc.def.ghi upstream"), false))
+	})
+}
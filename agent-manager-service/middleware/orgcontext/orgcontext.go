@@ -0,0 +1,132 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package orgcontext
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// OrgContext is the organization and project a request operates on, resolved once per request
+type OrgContext struct {
+	OrgName     string
+	ProjectName string
+	// Organization is nil for organizations that are not onboarded in the database
+	Organization *models.Organization
+}
+
+// IsSuspended reports whether the organization of the request has been suspended
+func (c *OrgContext) IsSuspended() bool {
+	return c.Organization != nil && c.Organization.IsSuspended()
+}
+
+// OrganizationResolver loads an organization by name, returning nil if it is not onboarded
+type OrganizationResolver interface {
+	FindOrganization(ctx context.Context, orgName string) (*models.Organization, error)
+}
+
+type orgContextKey struct{}
+
+// WithOrgContext adds the org context to the context
+func WithOrgContext(ctx context.Context, orgCtx *OrgContext) context.Context {
+	return context.WithValue(ctx, orgContextKey{}, orgCtx)
+}
+
+// GetOrgContext retrieves the org context, or nil for requests that are not scoped to an organization
+func GetOrgContext(ctx context.Context) *OrgContext {
+	orgCtx, _ := ctx.Value(orgContextKey{}).(*OrgContext)
+	return orgCtx
+}
+
+// ResolveOrgContext resolves the organization and project of the request from the path, falling back to
+// the organization claim of the token, checks that the caller belongs to the organization and stores the
+// result in the request context. It must run after the auth middleware.
+func ResolveOrgContext(resolver OrganizationResolver) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims := jwtassertion.GetTokenClaims(r.Context())
+			orgName, projName := orgAndProjectFromPath(r.URL.Path)
+			if orgName == "" && claims != nil {
+				orgName = claims.OrgName
+			}
+			if orgName == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			orgCtx, err := Resolve(r.Context(), resolver, orgName, projName)
+			if errors.Is(err, utils.ErrForbidden) {
+				utils.WriteErrorResponse(w, http.StatusForbidden, "Not a member of the organization")
+				return
+			}
+			if err != nil {
+				logger.GetLogger(r.Context()).Error("Failed to resolve organization", "orgName", orgName, "error", err)
+				utils.WriteErrorResponse(w, http.StatusInternalServerError, "Failed to resolve organization")
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(WithOrgContext(r.Context(), orgCtx)))
+		})
+	}
+}
+
+// Resolve loads the organization a request operates on and checks that the caller of the request
+// belongs to it, returning utils.ErrForbidden if not. It is shared by the REST and gRPC APIs.
+func Resolve(ctx context.Context, resolver OrganizationResolver, orgName, projName string) (*OrgContext, error) {
+	org, err := resolver.FindOrganization(ctx, orgName)
+	if err != nil {
+		return nil, err
+	}
+	if !isMember(jwtassertion.GetTokenClaims(ctx), orgName, org) {
+		return nil, utils.ErrForbidden
+	}
+	return &OrgContext{
+		OrgName:      orgName,
+		ProjectName:  projName,
+		Organization: org,
+	}, nil
+}
+
+// orgAndProjectFromPath extracts the names from paths like /orgs/{orgName}/projects/{projName}/...
+func orgAndProjectFromPath(path string) (string, string) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) < 2 || segments[0] != "orgs" {
+		return "", ""
+	}
+	if len(segments) >= 4 && segments[2] == "projects" {
+		return segments[1], segments[3]
+	}
+	return segments[1], ""
+}
+
+// isMember checks the organization claims of the token. Tokens without organization claims, such as
+// those of the local key manager, are not bound to an organization.
+func isMember(claims *jwtassertion.TokenClaims, orgName string, org *models.Organization) bool {
+	if claims == nil || (claims.OrgName == "" && claims.OrgHandle == "") {
+		return true
+	}
+	if claims.OrgName == orgName || claims.OrgHandle == orgName {
+		return true
+	}
+	return org != nil && claims.OrgHandle != "" && claims.OrgHandle == org.Handle
+}
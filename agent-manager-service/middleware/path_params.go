@@ -55,7 +55,7 @@ func HandleFuncWithValidation(mux *http.ServeMux, pattern string, handler http.H
 		handler = WithPathParamValidation(handler, params...)
 	}
 
-	mux.HandleFunc(pattern, handler)
+	mux.HandleFunc(pattern, withRouteSpanName(pattern, handler))
 }
 
 // extractPathParams extracts parameter names from a route pattern
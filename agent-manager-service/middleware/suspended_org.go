@@ -0,0 +1,56 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/orgcontext"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// RejectSuspendedOrganizations blocks changes to the resources of suspended organizations.
+// Reads are still allowed, as are the organization lifecycle routes themselves so that an
+// admin can resume or delete the organization. It relies on the org context resolved by
+// orgcontext.ResolveOrgContext.
+func RejectSuspendedOrganizations() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			orgCtx := orgcontext.GetOrgContext(r.Context())
+			if orgCtx != nil && orgCtx.IsSuspended() && isSuspendableOrgResource(r) {
+				utils.WriteErrorResponse(w, http.StatusForbidden, "Organization is suspended")
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// isSuspendableOrgResource reports whether the request mutates one of the sub-resources of an
+// organization, e.g. POST /orgs/{orgName}/environments
+func isSuspendableOrgResource(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(segments) < 3 || segments[0] != "orgs" || segments[1] == "" {
+		return false
+	}
+	return len(segments) != 3 || (segments[2] != "suspend" && segments[2] != "resume")
+}
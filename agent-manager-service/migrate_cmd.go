@@ -0,0 +1,105 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	dbmigrations "github.com/wso2/ai-agent-management-platform/agent-manager-service/db_migrations"
+)
+
+const migrateUsage = `Usage: agent-manager-service migrate [up|down|status] [--to <version>]
+
+  up      apply pending migrations, up to --to if given (default)
+  down    revert migrations newer than --to; --to 0 reverts all migrations
+  status  list migrations and whether they have been applied
+`
+
+// runMigrateCommand implements the migrate subcommand
+func runMigrateCommand(args []string) error {
+	action := "up"
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		action, args = args[0], args[1:]
+	}
+
+	fs := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	fs.Usage = func() { fmt.Fprint(fs.Output(), migrateUsage) }
+	to := fs.Int("to", -1, "target migration version")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	switch action {
+	case "up":
+		if *to < 0 {
+			return dbmigrations.Migrate()
+		}
+		return dbmigrations.MigrateTo(int32(*to))
+	case "down":
+		if *to < 0 {
+			return errors.New("migrate down requires --to <version>")
+		}
+		return dbmigrations.RollbackTo(int32(*to))
+	case "status":
+		return printMigrationStatus(context.Background())
+	default:
+		fs.Usage()
+		return fmt.Errorf("unknown migrate action %q", action)
+	}
+}
+
+func printMigrationStatus(ctx context.Context) error {
+	statuses, err := dbmigrations.Status(ctx)
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tSTATUS")
+	for _, s := range statuses {
+		status := "pending"
+		if s.Applied {
+			status = "applied"
+		}
+		fmt.Fprintf(w, "%04d\t%s\n", s.Version, status)
+	}
+	return w.Flush()
+}
+
+// ensureSchema verifies the database schema before the server starts, applying pending
+// migrations when the startup mode is auto
+func ensureSchema(ctx context.Context, mode string) error {
+	if mode == config.DBMigrationStartupModeOff {
+		return nil
+	}
+	err := dbmigrations.CheckSchema(ctx)
+	if !errors.Is(err, dbmigrations.ErrPendingMigrations) {
+		return err
+	}
+	if mode == config.DBMigrationStartupModeAuto {
+		slog.Info("applying pending database migrations", "pending", err.Error())
+		return dbmigrations.Migrate()
+	}
+	return fmt.Errorf("%w; run 'agent-manager-service migrate up' or set DB_MIGRATION_STARTUP_MODE=auto", err)
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Lifecycle states of an agent publication
+const (
+	// AgentPublicationStatusCreated is registered with API Platform but not listed in the developer portal
+	AgentPublicationStatusCreated = "created"
+	// AgentPublicationStatusPublished is listed in the developer portal
+	AgentPublicationStatusPublished = "published"
+	// AgentPublicationStatusDeprecated stays listed in the developer portal, marked for retirement
+	AgentPublicationStatusDeprecated = "deprecated"
+	// AgentPublicationStatusRetired is removed from the developer portal
+	AgentPublicationStatusRetired = "retired"
+)
+
+// Definition types an agent is published with
+const (
+	AgentDefinitionTypeOpenAPI = "openapi"
+	AgentDefinitionTypeA2A     = "a2a"
+)
+
+// Visibility of an agent in the developer portal
+const (
+	AgentPublicationVisibilityPublic     = "public"
+	AgentPublicationVisibilityPrivate    = "private"
+	AgentPublicationVisibilityRestricted = "restricted"
+)
+
+// AgentPublication is the database model for an agent published to a developer portal through API Platform
+type AgentPublication struct {
+	UUID             uuid.UUID  `gorm:"column:uuid;primaryKey"`
+	OrganizationName string     `gorm:"column:organization_name"`
+	ProjectName      string     `gorm:"column:project_name"`
+	AgentName        string     `gorm:"column:agent_name"`
+	EnvironmentName  string     `gorm:"column:environment_name"`
+	EndpointName     string     `gorm:"column:endpoint_name"`
+	EndpointURL      string     `gorm:"column:endpoint_url"`
+	DefinitionType   string     `gorm:"column:definition_type"`
+	Definition       string     `gorm:"column:definition"`
+	Documentation    string     `gorm:"column:documentation"`
+	Visibility       string     `gorm:"column:visibility"`
+	VisibleGroups    []string   `gorm:"column:visible_groups;serializer:json"`
+	Tags             []string   `gorm:"column:tags;serializer:json"`
+	LifecycleStatus  string     `gorm:"column:lifecycle_status"`
+	APIID            string     `gorm:"column:api_id"`
+	DevPortalID      string     `gorm:"column:dev_portal_id"`
+	PublishedAt      *time.Time `gorm:"column:published_at"`
+	CreatedBy        string     `gorm:"column:created_by"`
+	CreatedAt        time.Time  `gorm:"column:created_at"`
+	UpdatedAt        time.Time  `gorm:"column:updated_at"`
+}
+
+// TableName returns the table name for GORM
+func (AgentPublication) TableName() string {
+	return "agent_publications"
+}
+
+// ToResponse converts the database model to the API response
+func (p *AgentPublication) ToResponse() *AgentPublicationResponse {
+	return &AgentPublicationResponse{
+		ID:              p.UUID.String(),
+		ProjectName:     p.ProjectName,
+		AgentName:       p.AgentName,
+		Environment:     p.EnvironmentName,
+		Endpoint:        p.EndpointName,
+		EndpointURL:     p.EndpointURL,
+		DefinitionType:  p.DefinitionType,
+		Definition:      p.Definition,
+		Documentation:   p.Documentation,
+		Visibility:      p.Visibility,
+		VisibleGroups:   p.VisibleGroups,
+		Tags:            p.Tags,
+		LifecycleStatus: p.LifecycleStatus,
+		APIID:           p.APIID,
+		DevPortalID:     p.DevPortalID,
+		PublishedAt:     p.PublishedAt,
+		CreatedBy:       p.CreatedBy,
+		CreatedAt:       p.CreatedAt,
+		UpdatedAt:       p.UpdatedAt,
+	}
+}
+
+// CreateAgentPublicationRequest is the request to register an agent for publishing to a developer portal
+type CreateAgentPublicationRequest struct {
+	// Environment is the environment whose endpoint consumers are routed to
+	Environment string `json:"environment"`
+	// Endpoint is the name of the agent endpoint; defaults to the first endpoint by name
+	Endpoint string `json:"endpoint,omitempty"`
+	// DefinitionType is openapi or a2a; defaults to openapi
+	DefinitionType string `json:"definitionType,omitempty"`
+	// Definition is the OpenAPI document or A2A agent card. An OpenAPI definition defaults to the
+	// schema of the agent endpoint.
+	Definition    string   `json:"definition,omitempty"`
+	Documentation string   `json:"documentation,omitempty"`
+	Visibility    string   `json:"visibility,omitempty"`
+	VisibleGroups []string `json:"visibleGroups,omitempty"`
+	Tags          []string `json:"tags,omitempty"`
+	// DevPortalID is the developer portal to publish to; defaults to the organization's default portal
+	DevPortalID string `json:"devPortalId,omitempty"`
+}
+
+// UpdateAgentPublicationRequest updates the developer portal listing of an agent
+type UpdateAgentPublicationRequest struct {
+	Documentation *string  `json:"documentation,omitempty"`
+	Visibility    *string  `json:"visibility,omitempty"`
+	VisibleGroups []string `json:"visibleGroups,omitempty"`
+	Tags          []string `json:"tags,omitempty"`
+}
+
+// AgentPublicationLifecycleRequest moves an agent publication to another lifecycle state
+type AgentPublicationLifecycleRequest struct {
+	// Status is published, deprecated or retired
+	Status string `json:"status"`
+}
+
+// AgentPublicationResponse is an agent published to a developer portal
+type AgentPublicationResponse struct {
+	ID             string `json:"id"`
+	ProjectName    string `json:"projectName"`
+	AgentName      string `json:"agentName"`
+	Environment    string `json:"environment"`
+	Endpoint       string `json:"endpoint,omitempty"`
+	EndpointURL    string `json:"endpointUrl"`
+	DefinitionType string `json:"definitionType"`
+	// Definition is omitted from catalog listings
+	Definition    string   `json:"definition,omitempty"`
+	Documentation string   `json:"documentation,omitempty"`
+	Visibility    string   `json:"visibility"`
+	VisibleGroups []string `json:"visibleGroups,omitempty"`
+	Tags          []string `json:"tags,omitempty"`
+	// LifecycleStatus is created, published, deprecated or retired
+	LifecycleStatus string     `json:"lifecycleStatus"`
+	APIID           string     `json:"apiId"`
+	DevPortalID     string     `json:"devPortalId"`
+	PublishedAt     *time.Time `json:"publishedAt,omitempty"`
+	CreatedBy       string     `json:"createdBy,omitempty"`
+	CreatedAt       time.Time  `json:"createdAt"`
+	UpdatedAt       time.Time  `json:"updatedAt"`
+}
+
+// AgentPublicationListResponse is the catalog of agents an organization publishes
+type AgentPublicationListResponse struct {
+	Publications []*AgentPublicationResponse `json:"publications"`
+}
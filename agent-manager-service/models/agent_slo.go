@@ -0,0 +1,156 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Service level indicators an agent SLO can be defined on
+const (
+	// AgentSLOIndicatorLatency counts traces whose duration is within the threshold as good
+	AgentSLOIndicatorLatency = "latency"
+	// AgentSLOIndicatorAvailability counts traces without an error as good
+	AgentSLOIndicatorAvailability = "availability"
+)
+
+// AgentSLO is the database model for a service level objective of an agent
+type AgentSLO struct {
+	UUID             uuid.UUID `gorm:"column:uuid;primaryKey"`
+	OrganizationName string    `gorm:"column:organization_name"`
+	ProjectName      string    `gorm:"column:project_name"`
+	AgentName        string    `gorm:"column:agent_name"`
+	EnvironmentName  string    `gorm:"column:environment_name"`
+	Name             string    `gorm:"column:name"`
+	Indicator        string    `gorm:"column:indicator"`
+	ThresholdMs      float64   `gorm:"column:threshold_ms"`
+	Target           float64   `gorm:"column:target"`
+	WindowDays       int       `gorm:"column:window_days"`
+	CreatedBy        string    `gorm:"column:created_by"`
+	CreatedAt        time.Time `gorm:"column:created_at"`
+	UpdatedAt        time.Time `gorm:"column:updated_at"`
+}
+
+// TableName returns the table name for GORM
+func (AgentSLO) TableName() string {
+	return "agent_slos"
+}
+
+// ToResponse converts the database model to the API response
+func (s *AgentSLO) ToResponse() *AgentSLOResponse {
+	return &AgentSLOResponse{
+		ID:          s.UUID.String(),
+		Name:        s.Name,
+		Environment: s.EnvironmentName,
+		Indicator:   s.Indicator,
+		ThresholdMs: s.ThresholdMs,
+		Target:      s.Target,
+		WindowDays:  s.WindowDays,
+		CreatedBy:   s.CreatedBy,
+		CreatedAt:   s.CreatedAt,
+		UpdatedAt:   s.UpdatedAt,
+	}
+}
+
+// AgentSLORequest is the request to create or replace a service level objective of an agent, e.g.
+// 95% of traces complete within 3000ms over 28 days
+type AgentSLORequest struct {
+	// Name is at most as long as the name column
+	Name        string `json:"name" validate:"notblank,max=100"`
+	Environment string `json:"environment" validate:"required"`
+	// Indicator is latency or availability
+	Indicator string `json:"indicator" validate:"oneof=latency availability"`
+	// ThresholdMs is the duration a trace must complete within to be good; required for latency
+	ThresholdMs float64 `json:"thresholdMs,omitempty" validate:"gte=0"`
+	// Target is the percentage of traces that have to be good, between 0 and 100
+	Target float64 `json:"target" validate:"gt=0,lt=100"`
+	// WindowDays is the rolling window compliance is computed over; defaults to 28 and is at most
+	// 90, the longest window the trace observer computes compliance over
+	WindowDays int `json:"windowDays,omitempty" validate:"gte=0,lte=90"`
+}
+
+// AgentSLOResponse is a service level objective of an agent
+type AgentSLOResponse struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Environment string    `json:"environment"`
+	Indicator   string    `json:"indicator"`
+	ThresholdMs float64   `json:"thresholdMs,omitempty"`
+	Target      float64   `json:"target"`
+	WindowDays  int       `json:"windowDays"`
+	CreatedBy   string    `json:"createdBy,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// AgentSLOListResponse lists the service level objectives of an agent
+type AgentSLOListResponse struct {
+	SLOs []AgentSLOResponse `json:"slos"`
+}
+
+// AgentSLOErrorBudget is the number of bad traces an objective allows within its window
+type AgentSLOErrorBudget struct {
+	Allowed  float64 `json:"allowed"`
+	Consumed int64   `json:"consumed"`
+	// Remaining is the fraction of the budget left, negative once the objective is breached
+	Remaining float64 `json:"remaining"`
+}
+
+// AgentSLOBurnRate is the rate the error budget was consumed at over a trailing window, where 1
+// spends exactly the budget over the objective's window
+type AgentSLOBurnRate struct {
+	Window   string   `json:"window"`
+	BurnRate *float64 `json:"burnRate"`
+}
+
+// AgentSLOStatusResponse is the compliance of an agent with a service level objective over its window
+type AgentSLOStatusResponse struct {
+	SLO         AgentSLOResponse `json:"slo"`
+	WindowStart time.Time        `json:"windowStart"`
+	WindowEnd   time.Time        `json:"windowEnd"`
+	TotalCount  int64            `json:"totalCount"`
+	GoodCount   int64            `json:"goodCount"`
+	// Compliance is the percentage of good traces, unset without traces
+	Compliance *float64 `json:"compliance"`
+	// ObservedValue is the latency in milliseconds at the target percentile, only for latency objectives
+	ObservedValue *float64            `json:"observedValue,omitempty"`
+	ErrorBudget   AgentSLOErrorBudget `json:"errorBudget"`
+	BurnRates     []AgentSLOBurnRate  `json:"burnRates"`
+	// Status is met, at_risk, breached or no_data
+	Status string `json:"status"`
+}
+
+// AgentSLOHistoryPoint is the compliance of the traces of one interval
+type AgentSLOHistoryPoint struct {
+	Time       time.Time `json:"time"`
+	TotalCount int64     `json:"totalCount"`
+	GoodCount  int64     `json:"goodCount"`
+	Compliance *float64  `json:"compliance"`
+	// BudgetRemaining is the fraction of the error budget left at the end of the interval
+	BudgetRemaining float64 `json:"budgetRemaining"`
+}
+
+// AgentSLOHistoryResponse is the compliance of an agent with a service level objective over time
+type AgentSLOHistoryResponse struct {
+	SLO         AgentSLOResponse       `json:"slo"`
+	WindowStart time.Time              `json:"windowStart"`
+	WindowEnd   time.Time              `json:"windowEnd"`
+	Interval    string                 `json:"interval"`
+	Points      []AgentSLOHistoryPoint `json:"points"`
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+// ScaffoldAgentRepositoryRequest is the request to create an agent repository from a template
+type ScaffoldAgentRepositoryRequest struct {
+	// Owner is the GitHub user or organization that will own the repository
+	Owner       string `json:"owner"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Private     *bool  `json:"private,omitempty"`
+	// Secrets are stored as CI secrets of the new repository, e.g. model provider API keys
+	Secrets map[string]string `json:"secrets,omitempty"`
+}
+
+// ScaffoldAgentRepositoryResponse describes a repository scaffolded from a template
+type ScaffoldAgentRepositoryResponse struct {
+	Template      string   `json:"template"`
+	RepositoryURL string   `json:"repositoryUrl"`
+	CloneURL      string   `json:"cloneUrl"`
+	DefaultBranch string   `json:"defaultBranch"`
+	Secrets       []string `json:"secrets"`
+	WorkflowPath  string   `json:"workflowPath"`
+	// Language, LanguageVersion and RunCommand can be used as the build parameters of the agent
+	Language        string `json:"language"`
+	LanguageVersion string `json:"languageVersion"`
+	RunCommand      string `json:"runCommand"`
+}
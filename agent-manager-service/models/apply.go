@@ -0,0 +1,124 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+// ApplyResourceType identifies the kind of resource managed through the apply API
+type ApplyResourceType string
+
+const (
+	ApplyResourceTypeEnvironment ApplyResourceType = "environment"
+	ApplyResourceTypeGateway     ApplyResourceType = "gateway"
+)
+
+// ApplyAction is the action the apply API takes on a single resource
+type ApplyAction string
+
+const (
+	ApplyActionCreate ApplyAction = "create"
+	ApplyActionUpdate ApplyAction = "update"
+	ApplyActionDelete ApplyAction = "delete"
+	ApplyActionNoop   ApplyAction = "noop"
+)
+
+// ApplyManifest is the declarative description of the platform resources of an organization
+type ApplyManifest struct {
+	Environments []EnvironmentManifest `json:"environments,omitempty"`
+	Gateways     []GatewayManifest     `json:"gateways,omitempty"`
+}
+
+// EnvironmentManifest is the desired state of an environment
+type EnvironmentManifest struct {
+	Name         string `json:"name"`
+	DisplayName  string `json:"displayName"`
+	Description  string `json:"description,omitempty"`
+	DataplaneRef string `json:"dataplaneRef"`
+	DNSPrefix    string `json:"dnsPrefix"`
+	IsProduction bool   `json:"isProduction"`
+}
+
+// GatewayManifest is the desired state of a gateway.
+// Environments are referenced by name.
+type GatewayManifest struct {
+	Name         string   `json:"name"`
+	DisplayName  string   `json:"displayName"`
+	Description  string   `json:"description,omitempty"`
+	Vhost        string   `json:"vhost"`
+	GatewayType  string   `json:"gatewayType,omitempty"`
+	IsCritical   bool     `json:"isCritical"`
+	Environments []string `json:"environments,omitempty"`
+}
+
+// ApplyOptions controls how a manifest is applied
+type ApplyOptions struct {
+	// DryRun computes and returns the plan without changing anything
+	DryRun bool
+	// Prune deletes resources that exist but are absent from the manifest
+	Prune bool
+}
+
+// ApplyFieldDiff is the change of a single field of a resource.
+// Before is omitted for created resources and After is omitted for deleted ones.
+type ApplyFieldDiff struct {
+	Field  string      `json:"field"`
+	Before interface{} `json:"before,omitempty"`
+	After  interface{} `json:"after,omitempty"`
+}
+
+// ApplyChange describes the action planned for a single resource
+type ApplyChange struct {
+	ResourceType ApplyResourceType `json:"resourceType"`
+	Name         string            `json:"name"`
+	Action       ApplyAction       `json:"action"`
+	Diffs        []ApplyFieldDiff  `json:"diffs,omitempty"`
+}
+
+// ApplyPlanSummary counts the planned changes by kind
+type ApplyPlanSummary struct {
+	Add       int `json:"add"`
+	Change    int `json:"change"`
+	Destroy   int `json:"destroy"`
+	Unchanged int `json:"unchanged"`
+}
+
+// ApplyPlan is the response of the apply API
+type ApplyPlan struct {
+	DryRun  bool             `json:"dryRun"`
+	Applied bool             `json:"applied"`
+	Summary ApplyPlanSummary `json:"summary"`
+	Changes []ApplyChange    `json:"changes"`
+}
+
+// NewApplyPlan builds a plan from the given changes and summarizes them
+func NewApplyPlan(changes []ApplyChange, dryRun bool) *ApplyPlan {
+	plan := &ApplyPlan{
+		DryRun:  dryRun,
+		Changes: changes,
+	}
+	for _, change := range changes {
+		switch change.Action {
+		case ApplyActionCreate:
+			plan.Summary.Add++
+		case ApplyActionUpdate:
+			plan.Summary.Change++
+		case ApplyActionDelete:
+			plan.Summary.Destroy++
+		default:
+			plan.Summary.Unchanged++
+		}
+	}
+	return plan
+}
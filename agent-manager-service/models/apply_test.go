@@ -0,0 +1,48 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewApplyPlan(t *testing.T) {
+	changes := []ApplyChange{
+		{ResourceType: ApplyResourceTypeEnvironment, Name: "staging", Action: ApplyActionCreate},
+		{ResourceType: ApplyResourceTypeEnvironment, Name: "dev", Action: ApplyActionUpdate},
+		{ResourceType: ApplyResourceTypeEnvironment, Name: "qa", Action: ApplyActionDelete},
+		{ResourceType: ApplyResourceTypeEnvironment, Name: "prod", Action: ApplyActionNoop},
+		{ResourceType: ApplyResourceTypeGateway, Name: "internal", Action: ApplyActionCreate},
+		{ResourceType: ApplyResourceTypeGateway, Name: "edge", Action: ApplyActionUpdate},
+		{ResourceType: ApplyResourceTypeGateway, Name: "legacy", Action: ApplyActionDelete},
+		{ResourceType: ApplyResourceTypeGateway, Name: "public", Action: ApplyActionNoop},
+		{ResourceType: ApplyResourceTypeGateway, Name: "shared", Action: ApplyActionNoop},
+	}
+
+	plan := NewApplyPlan(changes, true)
+	assert.True(t, plan.DryRun)
+	assert.False(t, plan.Applied)
+	assert.Equal(t, ApplyPlanSummary{Add: 2, Change: 2, Destroy: 2, Unchanged: 3}, plan.Summary)
+	assert.Equal(t, changes, plan.Changes)
+
+	empty := NewApplyPlan([]ApplyChange{}, false)
+	assert.False(t, empty.DryRun)
+	assert.Equal(t, ApplyPlanSummary{}, empty.Summary)
+	assert.NotNil(t, empty.Changes)
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Operations blocked while a change freeze is active
+const (
+	ChangeOperationDeploy          = "deploy"
+	ChangeOperationRollback        = "rollback"
+	ChangeOperationAgentDeletion   = "agent_deletion"
+	ChangeOperationTokenRotation   = "token_rotation"
+	ChangeOperationTokenRevocation = "token_revocation"
+)
+
+// ChangeFreezeWindow is the database model for a period during which deployments and token
+// rotations of an organization are blocked, such as a peak sales week
+type ChangeFreezeWindow struct {
+	UUID             uuid.UUID `gorm:"column:uuid;primaryKey"`
+	OrganizationName string    `gorm:"column:organization_name"`
+	Name             string    `gorm:"column:name"`
+	Reason           string    `gorm:"column:reason"`
+	StartTime        time.Time `gorm:"column:start_time"`
+	EndTime          time.Time `gorm:"column:end_time"`
+	CreatedBy        string    `gorm:"column:created_by"`
+	CreatedAt        time.Time `gorm:"column:created_at"`
+}
+
+// TableName returns the table name for GORM
+func (ChangeFreezeWindow) TableName() string {
+	return "change_freeze_windows"
+}
+
+// IsActive reports whether changes are frozen at the given time
+func (w *ChangeFreezeWindow) IsActive(at time.Time) bool {
+	return !at.Before(w.StartTime) && at.Before(w.EndTime)
+}
+
+// ToResponse converts the database model to the API response
+func (w *ChangeFreezeWindow) ToResponse() *ChangeFreezeWindowResponse {
+	return &ChangeFreezeWindowResponse{
+		ID:        w.UUID.String(),
+		Name:      w.Name,
+		Reason:    w.Reason,
+		StartTime: w.StartTime,
+		EndTime:   w.EndTime,
+		Active:    w.IsActive(time.Now()),
+		CreatedBy: w.CreatedBy,
+		CreatedAt: w.CreatedAt,
+	}
+}
+
+// CreateChangeFreezeWindowRequest is the request to declare a change freeze window
+type CreateChangeFreezeWindowRequest struct {
+	Name      string    `json:"name" validate:"required,notblank,max=100"`
+	Reason    string    `json:"reason,omitempty" validate:"max=1000"`
+	StartTime time.Time `json:"startTime" validate:"required"`
+	EndTime   time.Time `json:"endTime" validate:"required,gtfield=StartTime"`
+}
+
+// ChangeFreezeWindowResponse is a change freeze window of an organization
+type ChangeFreezeWindowResponse struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	Reason    string    `json:"reason,omitempty"`
+	StartTime time.Time `json:"startTime"`
+	EndTime   time.Time `json:"endTime"`
+	// Active is set while the window is in force
+	Active    bool      `json:"active"`
+	CreatedBy string    `json:"createdBy,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// ChangeFreezeWindowListResponse lists the change freeze windows of an organization, by start time
+type ChangeFreezeWindowListResponse struct {
+	Windows []ChangeFreezeWindowResponse `json:"windows"`
+}
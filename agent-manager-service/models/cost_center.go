@@ -0,0 +1,117 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Resources a cost center can be mapped to
+const (
+	// CostCenterResourceAgent charges all LLM usage of an agent to the cost center
+	CostCenterResourceAgent = "agent"
+	// CostCenterResourceProvider charges the usage of an LLM provider by agents without a cost center to the cost center
+	CostCenterResourceProvider = "provider"
+)
+
+// CostCenterMapping is the database model for the cost center LLM usage of an agent or provider is charged to
+type CostCenterMapping struct {
+	UUID             uuid.UUID `gorm:"column:uuid;primaryKey"`
+	OrganizationName string    `gorm:"column:organization_name"`
+	ResourceType     string    `gorm:"column:resource_type"`
+	// ProjectName is the project of a mapped agent, empty for providers
+	ProjectName  string    `gorm:"column:project_name"`
+	ResourceName string    `gorm:"column:resource_name"`
+	CostCenter   string    `gorm:"column:cost_center"`
+	CreatedBy    string    `gorm:"column:created_by"`
+	CreatedAt    time.Time `gorm:"column:created_at"`
+	UpdatedAt    time.Time `gorm:"column:updated_at"`
+}
+
+// TableName returns the table name for GORM
+func (CostCenterMapping) TableName() string {
+	return "cost_center_mappings"
+}
+
+// ToResponse converts the database model to the API response
+func (m *CostCenterMapping) ToResponse() *CostCenterMappingResponse {
+	return &CostCenterMappingResponse{
+		ID:           m.UUID.String(),
+		ResourceType: m.ResourceType,
+		ProjectName:  m.ProjectName,
+		ResourceName: m.ResourceName,
+		CostCenter:   m.CostCenter,
+		CreatedBy:    m.CreatedBy,
+		CreatedAt:    m.CreatedAt,
+		UpdatedAt:    m.UpdatedAt,
+	}
+}
+
+// CostCenterMappingRequest is the request to charge an agent or an LLM provider to a cost center,
+// replacing its earlier cost center
+type CostCenterMappingRequest struct {
+	// ResourceType is agent or provider
+	ResourceType string `json:"resourceType" validate:"oneof=agent provider"`
+	// ProjectName is the project of the agent; required for agents
+	ProjectName  string `json:"projectName,omitempty"`
+	ResourceName string `json:"resourceName" validate:"required,max=100"`
+	CostCenter   string `json:"costCenter" validate:"required,notblank,max=100"`
+}
+
+// CostCenterMappingResponse is the cost center of an agent or LLM provider
+type CostCenterMappingResponse struct {
+	ID           string    `json:"id"`
+	ResourceType string    `json:"resourceType"`
+	ProjectName  string    `json:"projectName,omitempty"`
+	ResourceName string    `json:"resourceName"`
+	CostCenter   string    `json:"costCenter"`
+	CreatedBy    string    `json:"createdBy,omitempty"`
+	CreatedAt    time.Time `json:"createdAt"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+// CostCenterMappingListResponse lists the cost center mappings of an organization
+type CostCenterMappingListResponse struct {
+	Mappings []CostCenterMappingResponse `json:"mappings"`
+}
+
+// CostCenterUsage is the LLM usage charged to a cost center
+type CostCenterUsage struct {
+	CostCenter string `json:"costCenter"`
+	// Agents are the agents with usage charged to the cost center, as project/agent
+	Agents       []string `json:"agents"`
+	RequestCount int      `json:"requestCount"`
+	InputTokens  int      `json:"inputTokens"`
+	OutputTokens int      `json:"outputTokens"`
+	TotalTokens  int      `json:"totalTokens"`
+	// EstimatedCost is in USD; nil if no price is known for the models used
+	EstimatedCost *float64 `json:"estimatedCost,omitempty"`
+}
+
+// CostCenterUsageResponse is the LLM usage of an organization's agents in an environment, grouped by cost center
+type CostCenterUsageResponse struct {
+	Environment string            `json:"environment"`
+	StartTime   string            `json:"startTime,omitempty"`
+	EndTime     string            `json:"endTime,omitempty"`
+	CostCenters []CostCenterUsage `json:"costCenters"`
+	// Unassigned is the usage of agents and providers without a cost center
+	Unassigned CostCenterUsage `json:"unassigned"`
+	// Truncated is set when the trace observer capped the spans of an agent, so that usage may be undercounted
+	Truncated bool `json:"truncated"`
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Statuses of a deployment approval
+const (
+	DeploymentApprovalStatusPending  = "pending"
+	DeploymentApprovalStatusApproved = "approved"
+	DeploymentApprovalStatusRejected = "rejected"
+	// DeploymentApprovalStatusFailed is an approved deployment that could not be deployed
+	DeploymentApprovalStatusFailed = "failed"
+)
+
+// DeploymentApprovalPolicy is the database model for the reviewers that approve the production
+// deployments of an organization. Organizations without a policy deploy without approval.
+type DeploymentApprovalPolicy struct {
+	OrganizationName string    `gorm:"column:organization_name;primaryKey"`
+	Reviewers        []string  `gorm:"column:reviewers;serializer:json"`
+	UpdatedBy        string    `gorm:"column:updated_by"`
+	UpdatedAt        time.Time `gorm:"column:updated_at"`
+}
+
+// TableName returns the table name for GORM
+func (DeploymentApprovalPolicy) TableName() string {
+	return "deployment_approval_policies"
+}
+
+// IsReviewer reports whether a user may approve or reject deployments
+func (p *DeploymentApprovalPolicy) IsReviewer(user string) bool {
+	for _, reviewer := range p.Reviewers {
+		if reviewer == user {
+			return true
+		}
+	}
+	return false
+}
+
+// ToResponse converts the database model to the API response
+func (p *DeploymentApprovalPolicy) ToResponse() *DeploymentApprovalPolicyResponse {
+	return &DeploymentApprovalPolicyResponse{
+		Reviewers: p.Reviewers,
+		UpdatedBy: p.UpdatedBy,
+		UpdatedAt: p.UpdatedAt,
+	}
+}
+
+// DeploymentApprovalPolicyRequest is the request to set the reviewers of production deployments
+type DeploymentApprovalPolicyRequest struct {
+	// Reviewers are the subjects of the users who may approve or reject deployments
+	Reviewers []string `json:"reviewers" validate:"required,min=1,max=50,unique,dive,required,max=255"`
+}
+
+// DeploymentApprovalPolicyResponse is the deployment approval policy of an organization
+type DeploymentApprovalPolicyResponse struct {
+	Reviewers []string  `json:"reviewers"`
+	UpdatedBy string    `json:"updatedBy,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// DeploymentApproval is the database model for a production deployment waiting for, or given, the
+// decision of a reviewer. It holds the deployment request so that it can be deployed once approved.
+type DeploymentApproval struct {
+	UUID             uuid.UUID `gorm:"column:uuid;primaryKey"`
+	OrganizationName string    `gorm:"column:organization_name"`
+	ProjectName      string    `gorm:"column:project_name"`
+	AgentName        string    `gorm:"column:agent_name"`
+	Environment      string    `gorm:"column:environment"`
+	ImageID          string    `gorm:"column:image_id"`
+	Env              []EnvVars `gorm:"column:env;serializer:json"`
+	// RollbackOf is the revision to redeploy, if the deployment is a rollback
+	RollbackOf    *int   `gorm:"column:rollback_of"`
+	Status        string `gorm:"column:status"`
+	RequestedBy   string `gorm:"column:requested_by"`
+	ReviewedBy    string `gorm:"column:reviewed_by"`
+	ReviewComment string `gorm:"column:review_comment"`
+	// Error is why an approved deployment failed
+	Error string `gorm:"column:error"`
+	// Revision is the deployment revision recorded once the deployment is approved and deployed
+	Revision   *int       `gorm:"column:revision"`
+	CreatedAt  time.Time  `gorm:"column:created_at"`
+	ReviewedAt *time.Time `gorm:"column:reviewed_at"`
+}
+
+// TableName returns the table name for GORM
+func (DeploymentApproval) TableName() string {
+	return "deployment_approvals"
+}
+
+// ToResponse converts the database model to the API response
+func (a *DeploymentApproval) ToResponse() *DeploymentApprovalResponse {
+	env := a.Env
+	if env == nil {
+		env = []EnvVars{}
+	}
+	return &DeploymentApprovalResponse{
+		ID:            a.UUID.String(),
+		ProjectName:   a.ProjectName,
+		AgentName:     a.AgentName,
+		Environment:   a.Environment,
+		ImageID:       a.ImageID,
+		Env:           env,
+		RollbackOf:    a.RollbackOf,
+		Status:        a.Status,
+		RequestedBy:   a.RequestedBy,
+		ReviewedBy:    a.ReviewedBy,
+		ReviewComment: a.ReviewComment,
+		Error:         a.Error,
+		Revision:      a.Revision,
+		CreatedAt:     a.CreatedAt,
+		ReviewedAt:    a.ReviewedAt,
+	}
+}
+
+// DeploymentReviewRequest is the request to approve or reject a deployment
+type DeploymentReviewRequest struct {
+	Comment string `json:"comment,omitempty" validate:"max=1000"`
+}
+
+// DeploymentApprovalResponse is a deployment approval request
+type DeploymentApprovalResponse struct {
+	ID            string     `json:"id"`
+	ProjectName   string     `json:"projectName"`
+	AgentName     string     `json:"agentName"`
+	Environment   string     `json:"environment"`
+	ImageID       string     `json:"imageId"`
+	Env           []EnvVars  `json:"env"`
+	RollbackOf    *int       `json:"rollbackOf,omitempty"`
+	Status        string     `json:"status"`
+	RequestedBy   string     `json:"requestedBy,omitempty"`
+	ReviewedBy    string     `json:"reviewedBy,omitempty"`
+	ReviewComment string     `json:"reviewComment,omitempty"`
+	Error         string     `json:"error,omitempty"`
+	Revision      *int       `json:"revision,omitempty"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	ReviewedAt    *time.Time `json:"reviewedAt,omitempty"`
+}
+
+// DeploymentApprovalListResponse lists deployment approvals, newest first
+type DeploymentApprovalListResponse struct {
+	Approvals []DeploymentApprovalResponse `json:"approvals"`
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AgentDeploymentRevision is the database model for an immutable record of an agent deployment
+type AgentDeploymentRevision struct {
+	UUID             uuid.UUID `gorm:"column:uuid;primaryKey"`
+	OrganizationName string    `gorm:"column:organization_name"`
+	ProjectName      string    `gorm:"column:project_name"`
+	AgentName        string    `gorm:"column:agent_name"`
+	// Revision numbers the deployments of an agent, starting at 1
+	Revision    int    `gorm:"column:revision"`
+	Environment string `gorm:"column:environment"`
+	ImageID     string `gorm:"column:image_id"`
+	// Env is the snapshot of the environment variables given with the deployment
+	Env []EnvVars `gorm:"column:env;serializer:json"`
+	// RollbackOf is the revision that was redeployed, if this revision is a rollback
+	RollbackOf *int      `gorm:"column:rollback_of"`
+	CreatedAt  time.Time `gorm:"column:created_at"`
+}
+
+// TableName returns the table name for GORM
+func (AgentDeploymentRevision) TableName() string {
+	return "agent_deployment_revisions"
+}
+
+// ToResponse converts the database model to the API response
+func (r *AgentDeploymentRevision) ToResponse() *DeploymentRevisionResponse {
+	env := r.Env
+	if env == nil {
+		env = []EnvVars{}
+	}
+	return &DeploymentRevisionResponse{
+		Revision:    r.Revision,
+		Environment: r.Environment,
+		ImageID:     r.ImageID,
+		Env:         env,
+		RollbackOf:  r.RollbackOf,
+		CreatedAt:   r.CreatedAt,
+	}
+}
+
+// DeploymentRevisionResponse is a deployment revision of an agent
+type DeploymentRevisionResponse struct {
+	Revision    int       `json:"revision"`
+	Environment string    `json:"environment"`
+	ImageID     string    `json:"imageId"`
+	Env         []EnvVars `json:"env"`
+	RollbackOf  *int      `json:"rollbackOf,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
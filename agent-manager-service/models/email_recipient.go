@@ -0,0 +1,137 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Notifications an email recipient subscribes to
+const (
+	// EmailNotificationDeploymentResults is sent when an agent deployment succeeds or fails
+	EmailNotificationDeploymentResults = "deployment_results"
+	// EmailNotificationTokenExpiry is sent when an agent API key is about to expire
+	EmailNotificationTokenExpiry = "token_expiry"
+	// EmailNotificationBudgetAlerts is sent when an agent reaches the soft limit of a token budget or exceeds it
+	EmailNotificationBudgetAlerts = "budget_alerts"
+	// EmailNotificationDigests is sent the notification digests of the organization with the email channel
+	EmailNotificationDigests = "digests"
+)
+
+// EmailRecipient is the database model for an address of an organization that notifications are
+// emailed to. Nothing is sent to the address until it is verified.
+type EmailRecipient struct {
+	UUID             uuid.UUID  `gorm:"column:uuid;primaryKey"`
+	OrganizationName string     `gorm:"column:organization_name"`
+	Address          string     `gorm:"column:address"`
+	Notifications    []string   `gorm:"column:notifications;serializer:json"`
+	VerifiedAt       *time.Time `gorm:"column:verified_at"`
+	// VerificationCodeHash is the SHA-256 hash of the code last emailed to the address
+	VerificationCodeHash string     `gorm:"column:verification_code_hash"`
+	VerificationSentAt   *time.Time `gorm:"column:verification_sent_at"`
+	// VerificationAttempts counts the wrong codes entered since the code was sent
+	VerificationAttempts int       `gorm:"column:verification_attempts"`
+	CreatedBy            string    `gorm:"column:created_by"`
+	CreatedAt            time.Time `gorm:"column:created_at"`
+	UpdatedAt            time.Time `gorm:"column:updated_at"`
+}
+
+// TableName returns the table name for GORM
+func (EmailRecipient) TableName() string {
+	return "email_recipients"
+}
+
+// Subscribes reports whether the recipient subscribes to the given notification
+func (r *EmailRecipient) Subscribes(notification string) bool {
+	for _, n := range r.Notifications {
+		if n == notification {
+			return true
+		}
+	}
+	return false
+}
+
+// ToResponse converts the database model to the API response
+func (r *EmailRecipient) ToResponse() *EmailRecipientResponse {
+	return &EmailRecipientResponse{
+		ID:                 r.UUID.String(),
+		Address:            r.Address,
+		Notifications:      r.Notifications,
+		Verified:           r.VerifiedAt != nil,
+		VerifiedAt:         r.VerifiedAt,
+		VerificationSentAt: r.VerificationSentAt,
+		CreatedBy:          r.CreatedBy,
+		CreatedAt:          r.CreatedAt,
+		UpdatedAt:          r.UpdatedAt,
+	}
+}
+
+// CreateEmailRecipientRequest is the request to add an email recipient. A verification code is
+// emailed to the address.
+type CreateEmailRecipientRequest struct {
+	Address string `json:"address" validate:"required,email,max=254"`
+	// Notifications are deployment_results, token_expiry, budget_alerts or digests
+	Notifications []string `json:"notifications" validate:"required,min=1,unique,dive,oneof=deployment_results token_expiry budget_alerts digests"`
+}
+
+// UpdateEmailRecipientRequest replaces the notifications an email recipient subscribes to
+type UpdateEmailRecipientRequest struct {
+	Notifications []string `json:"notifications" validate:"required,min=1,unique,dive,oneof=deployment_results token_expiry budget_alerts digests"`
+}
+
+// VerifyEmailRecipientRequest is the code emailed to a recipient to verify the address
+type VerifyEmailRecipientRequest struct {
+	Code string `json:"code" validate:"required,max=20"`
+}
+
+// EmailRecipientResponse is an email recipient of an organization
+type EmailRecipientResponse struct {
+	ID                 string     `json:"id"`
+	Address            string     `json:"address"`
+	Notifications      []string   `json:"notifications"`
+	Verified           bool       `json:"verified"`
+	VerifiedAt         *time.Time `json:"verifiedAt,omitempty"`
+	VerificationSentAt *time.Time `json:"verificationSentAt,omitempty"`
+	CreatedBy          string     `json:"createdBy,omitempty"`
+	CreatedAt          time.Time  `json:"createdAt"`
+	UpdatedAt          time.Time  `json:"updatedAt"`
+}
+
+// EmailRecipientListResponse lists the email recipients of an organization, by address
+type EmailRecipientListResponse struct {
+	Recipients []EmailRecipientResponse `json:"recipients"`
+}
+
+// AgentTokenExpiry is the database model for the expiry of an agent API key, watched to warn the
+// organization's email recipients before the key expires
+type AgentTokenExpiry struct {
+	UUID             uuid.UUID  `gorm:"column:uuid;primaryKey"`
+	OrganizationName string     `gorm:"column:organization_name"`
+	ProjectName      string     `gorm:"column:project_name"`
+	AgentName        string     `gorm:"column:agent_name"`
+	Environment      string     `gorm:"column:environment"`
+	ExpiresAt        time.Time  `gorm:"column:expires_at"`
+	NotifiedAt       *time.Time `gorm:"column:notified_at"`
+	CreatedAt        time.Time  `gorm:"column:created_at"`
+}
+
+// TableName returns the table name for GORM
+func (AgentTokenExpiry) TableName() string {
+	return "agent_token_expiries"
+}
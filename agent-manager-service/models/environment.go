@@ -33,6 +33,7 @@ type GatewayEnvironmentResponse struct {
 	DataplaneRef     string    `json:"dataplaneRef"`
 	DNSPrefix        string    `json:"dnsPrefix"`
 	IsProduction     bool      `json:"isProduction"`
+	Region           string    `json:"region,omitempty"`
 	CreatedAt        time.Time `json:"createdAt"`
 	UpdatedAt        time.Time `json:"updatedAt"`
 }
@@ -46,12 +47,15 @@ type CreateEnvironmentRequest struct {
 	DataplaneRef     string `json:"dataplaneRef" validate:"required,max=100"`
 	DNSPrefix        string `json:"dnsPrefix" validate:"required,max=100"`
 	IsProduction     bool   `json:"isProduction"`
+	// Region restricts the gateways of the environment to those in the region; empty allows any region
+	Region string `json:"region,omitempty"`
 }
 
 // UpdateEnvironmentRequest is the API request for updating an environment
 type UpdateEnvironmentRequest struct {
 	DisplayName *string `json:"displayName,omitempty"`
 	Description *string `json:"description,omitempty"`
+	Region      *string `json:"region,omitempty"`
 }
 
 // Environment is the database model
@@ -64,6 +68,7 @@ type Environment struct {
 	DataplaneRef     string         `gorm:"column:dataplane_ref;default:'default'"`
 	DNSPrefix        string         `gorm:"column:dns_prefix;default:'default'"`
 	IsProduction     bool           `gorm:"column:is_production;default:false"`
+	Region           string         `gorm:"column:region"`
 	CreatedAt        time.Time      `gorm:"column:created_at"`
 	UpdatedAt        time.Time      `gorm:"column:updated_at"`
 	DeletedAt        gorm.DeletedAt `gorm:"column:deleted_at"`
@@ -85,6 +90,7 @@ func (e *Environment) ToResponse() *GatewayEnvironmentResponse {
 		DataplaneRef:     e.DataplaneRef,
 		DNSPrefix:        e.DNSPrefix,
 		IsProduction:     e.IsProduction,
+		Region:           e.Region,
 		CreatedAt:        e.CreatedAt,
 		UpdatedAt:        e.UpdatedAt,
 	}
@@ -98,3 +104,15 @@ type EnvironmentListResponse struct {
 	Limit        int32                        `json:"limit"`
 	Offset       int32                        `json:"offset"`
 }
+
+// Region is an entry of the regions catalog
+type Region struct {
+	Name string `json:"name"`
+	// EnvironmentCount is the number of environments of the organization placed in the region
+	EnvironmentCount int64 `json:"environmentCount"`
+}
+
+// RegionListResponse lists the regions of the catalog
+type RegionListResponse struct {
+	Regions []Region `json:"regions"`
+}
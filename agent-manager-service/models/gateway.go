@@ -173,3 +173,27 @@ type HealthStatusResponse struct {
 	ErrorMessage string `json:"errorMessage,omitempty"`
 	CheckedAt    string `json:"checkedAt"`
 }
+
+// Overall statuses of a gateway diagnosis
+const (
+	GatewayDiagnosisHealthy   = "healthy"
+	GatewayDiagnosisDegraded  = "degraded"
+	GatewayDiagnosisUnhealthy = "unhealthy"
+)
+
+// GatewayDiagnosticCheck is the outcome of one check of a gateway diagnosis
+type GatewayDiagnosticCheck struct {
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	Message    string `json:"message,omitempty"`
+	DurationMs int64  `json:"durationMs"`
+}
+
+// GatewayDiagnosticsResponse is the gateway connectivity diagnosis response
+type GatewayDiagnosticsResponse struct {
+	GatewayID       string                   `json:"gatewayId"`
+	Status          string                   `json:"status"`
+	ControlPlaneURL string                   `json:"controlPlaneUrl,omitempty"`
+	Checks          []GatewayDiagnosticCheck `json:"checks"`
+	CheckedAt       string                   `json:"checkedAt"`
+}
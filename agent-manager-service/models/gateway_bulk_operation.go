@@ -0,0 +1,99 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Gateway bulk operation actions
+const (
+	GatewayBulkActionDelete      = "DELETE"
+	GatewayBulkActionRotateToken = "ROTATE_TOKEN"
+)
+
+// Gateway bulk operation statuses
+const (
+	GatewayBulkOperationStatusRunning   = "running"
+	GatewayBulkOperationStatusCompleted = "completed"
+)
+
+// Gateway bulk operation item statuses
+const (
+	GatewayBulkItemStatusPending   = "pending"
+	GatewayBulkItemStatusSucceeded = "succeeded"
+	GatewayBulkItemStatusFailed    = "failed"
+)
+
+// GatewayBulkOperation is the database model of an action run on many gateways in the background
+type GatewayBulkOperation struct {
+	UUID             uuid.UUID                  `gorm:"column:uuid;primaryKey"`
+	OrganizationName string                     `gorm:"column:organization_name"`
+	Action           string                     `gorm:"column:action"`
+	Status           string                     `gorm:"column:status"`
+	RequestedBy      string                     `gorm:"column:requested_by"`
+	Items            []GatewayBulkOperationItem `gorm:"column:items;serializer:json"`
+	CreatedAt        time.Time                  `gorm:"column:created_at"`
+	CompletedAt      *time.Time                 `gorm:"column:completed_at"`
+}
+
+// GatewayBulkOperationItem is the result of the action on a single gateway. Rotated tokens are
+// stored encrypted.
+type GatewayBulkOperationItem struct {
+	GatewayID      string     `json:"gatewayId"`
+	Status         string     `json:"status"`
+	Error          string     `json:"error,omitempty"`
+	TokenID        string     `json:"tokenId,omitempty"`
+	EncryptedToken []byte     `json:"encryptedToken,omitempty"`
+	ExpiresAt      *time.Time `json:"expiresAt,omitempty"`
+}
+
+// TableName returns the table name for GORM
+func (GatewayBulkOperation) TableName() string {
+	return "gateway_bulk_operations"
+}
+
+// CreateGatewayBulkOperationRequest is the request to run an action on many gateways
+type CreateGatewayBulkOperationRequest struct {
+	Action     string   `json:"action" validate:"required,oneof=DELETE ROTATE_TOKEN"`
+	GatewayIDs []string `json:"gatewayIds" validate:"required,min=1,max=100,unique,dive,uuid"`
+}
+
+// GatewayBulkOperationResponse is the progress and per-gateway results of a bulk operation
+type GatewayBulkOperationResponse struct {
+	ID          string                             `json:"id"`
+	Action      string                             `json:"action"`
+	Status      string                             `json:"status"`
+	RequestedBy string                             `json:"requestedBy,omitempty"`
+	Succeeded   int                                `json:"succeeded"`
+	Failed      int                                `json:"failed"`
+	Items       []GatewayBulkOperationItemResponse `json:"items"`
+	CreatedAt   time.Time                          `json:"createdAt"`
+	CompletedAt *time.Time                         `json:"completedAt,omitempty"`
+}
+
+// GatewayBulkOperationItemResponse is the result of a bulk operation on a single gateway
+type GatewayBulkOperationItemResponse struct {
+	GatewayID string     `json:"gatewayId"`
+	Status    string     `json:"status"`
+	Error     string     `json:"error,omitempty"`
+	TokenID   string     `json:"tokenId,omitempty"`
+	Token     string     `json:"token,omitempty"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
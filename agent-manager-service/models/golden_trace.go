@@ -0,0 +1,210 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Golden trace run statuses
+const (
+	// GoldenTraceRunStatusPending is a run whose replayed trace has not been recorded yet
+	GoldenTraceRunStatusPending = "pending"
+	GoldenTraceRunStatusPassed  = "passed"
+	GoldenTraceRunStatusFailed  = "failed"
+	// GoldenTraceRunStatusError is a run that could not be scored, e.g. because the agent was unreachable
+	GoldenTraceRunStatusError = "error"
+)
+
+// Changes of a step between a golden trace and a replayed trace
+const (
+	GoldenTraceStepMissing    = "missing"
+	GoldenTraceStepUnexpected = "unexpected"
+	GoldenTraceStepChanged    = "changed"
+)
+
+// GoldenTrace is the database model for a trace marked as the expected behaviour of an agent. The
+// snapshot is stored as JSON.
+type GoldenTrace struct {
+	UUID                  uuid.UUID  `gorm:"column:uuid;primaryKey"`
+	OrganizationName      string     `gorm:"column:organization_name"`
+	ProjectName           string     `gorm:"column:project_name"`
+	AgentName             string     `gorm:"column:agent_name"`
+	EnvironmentName       string     `gorm:"column:environment_name"`
+	Name                  string     `gorm:"column:name"`
+	TraceID               string     `gorm:"column:trace_id"`
+	Snapshot              string     `gorm:"column:snapshot"`
+	Endpoint              string     `gorm:"column:endpoint"`
+	Path                  string     `gorm:"column:path"`
+	Threshold             float64    `gorm:"column:threshold"`
+	ReplayIntervalMinutes int        `gorm:"column:replay_interval_minutes"`
+	NextRunAt             *time.Time `gorm:"column:next_run_at"`
+	CreatedBy             string     `gorm:"column:created_by"`
+	CreatedAt             time.Time  `gorm:"column:created_at"`
+	UpdatedAt             time.Time  `gorm:"column:updated_at"`
+}
+
+// TableName returns the table name for GORM
+func (GoldenTrace) TableName() string {
+	return "golden_traces"
+}
+
+// ToResponse converts the database model to the API response, without the snapshot
+func (g *GoldenTrace) ToResponse() *GoldenTraceResponse {
+	return &GoldenTraceResponse{
+		ID:                    g.UUID.String(),
+		Name:                  g.Name,
+		TraceID:               g.TraceID,
+		Environment:           g.EnvironmentName,
+		Endpoint:              g.Endpoint,
+		Path:                  g.Path,
+		Threshold:             g.Threshold,
+		ReplayIntervalMinutes: g.ReplayIntervalMinutes,
+		NextRunAt:             g.NextRunAt,
+		CreatedBy:             g.CreatedBy,
+		CreatedAt:             g.CreatedAt,
+		UpdatedAt:             g.UpdatedAt,
+	}
+}
+
+// GoldenTraceRun is the database model for the comparison of a replayed trace against a golden
+// trace. The step differences are stored as JSON.
+type GoldenTraceRun struct {
+	UUID            uuid.UUID `gorm:"column:uuid;primaryKey"`
+	GoldenTraceUUID uuid.UUID `gorm:"column:golden_trace_uuid"`
+	ReplayTraceID   string    `gorm:"column:replay_trace_id"`
+	// ReplayParentSpanID is the parent span ID sent to the agent, so its entry span can be told apart
+	ReplayParentSpanID string     `gorm:"column:replay_parent_span_id"`
+	Status             string     `gorm:"column:status"`
+	StructuralScore    *float64   `gorm:"column:structural_score"`
+	SemanticScore      *float64   `gorm:"column:semantic_score"`
+	Diff               string     `gorm:"column:diff"`
+	Error              string     `gorm:"column:error"`
+	CreatedAt          time.Time  `gorm:"column:created_at"`
+	CompletedAt        *time.Time `gorm:"column:completed_at"`
+}
+
+// TableName returns the table name for GORM
+func (GoldenTraceRun) TableName() string {
+	return "golden_trace_runs"
+}
+
+// ToResponse converts the database model to the API response, without the step differences
+func (r *GoldenTraceRun) ToResponse() *GoldenTraceRunResponse {
+	return &GoldenTraceRunResponse{
+		ID:              r.UUID.String(),
+		GoldenTraceID:   r.GoldenTraceUUID.String(),
+		ReplayTraceID:   r.ReplayTraceID,
+		Status:          r.Status,
+		StructuralScore: r.StructuralScore,
+		SemanticScore:   r.SemanticScore,
+		Error:           r.Error,
+		CreatedAt:       r.CreatedAt,
+		CompletedAt:     r.CompletedAt,
+	}
+}
+
+// GoldenTraceSnapshot is the normalized form of a trace that replayed traces are compared against.
+// IDs, timestamps and durations are left out, so only the behaviour of the agent is kept.
+type GoldenTraceSnapshot struct {
+	// Input is the root input of the trace, which scheduled runs send to the agent
+	Input  interface{}       `json:"input"`
+	Output string            `json:"output,omitempty"`
+	Steps  []GoldenTraceStep `json:"steps"`
+}
+
+// GoldenTraceStep is a span of a snapshot, in depth first order of the span tree
+type GoldenTraceStep struct {
+	Depth  int    `json:"depth"`
+	Name   string `json:"name"`
+	Kind   string `json:"kind,omitempty"`
+	Output string `json:"output,omitempty"`
+	Error  bool   `json:"error,omitempty"`
+}
+
+// GoldenTraceStepDiff is a difference between a step of a golden trace and of a replayed trace
+type GoldenTraceStepDiff struct {
+	// Change is missing, unexpected or changed
+	Change   string `json:"change"`
+	Step     string `json:"step"`
+	Expected string `json:"expected,omitempty"`
+	Actual   string `json:"actual,omitempty"`
+	// Similarity of the outputs of a changed step, between 0 and 1
+	Similarity *float64 `json:"similarity,omitempty"`
+}
+
+// CreateGoldenTraceRequest is the request to mark a trace of an agent as golden
+type CreateGoldenTraceRequest struct {
+	TraceID     string `json:"traceId"`
+	Name        string `json:"name"`
+	Environment string `json:"environment"`
+	// Endpoint is the name of the agent endpoint runs invoke; defaults to the first endpoint by name
+	Endpoint string `json:"endpoint,omitempty"`
+	// Path is appended to the endpoint URL, e.g. /chat
+	Path string `json:"path,omitempty"`
+	// Threshold is the score both the structural and semantic scores of a run must reach to pass; defaults to 0.8
+	Threshold *float64 `json:"threshold,omitempty"`
+	// ReplayIntervalMinutes schedules a run at the interval; 0 only runs the golden trace on request
+	ReplayIntervalMinutes int `json:"replayIntervalMinutes,omitempty"`
+}
+
+// GoldenTraceResponse is a golden trace of an agent
+type GoldenTraceResponse struct {
+	ID                    string     `json:"id"`
+	Name                  string     `json:"name"`
+	TraceID               string     `json:"traceId"`
+	Environment           string     `json:"environment"`
+	Endpoint              string     `json:"endpoint,omitempty"`
+	Path                  string     `json:"path,omitempty"`
+	Threshold             float64    `json:"threshold"`
+	ReplayIntervalMinutes int        `json:"replayIntervalMinutes"`
+	NextRunAt             *time.Time `json:"nextRunAt,omitempty"`
+	CreatedBy             string     `json:"createdBy,omitempty"`
+	CreatedAt             time.Time  `json:"createdAt"`
+	UpdatedAt             time.Time  `json:"updatedAt"`
+	// Snapshot is only returned for a single golden trace
+	Snapshot *GoldenTraceSnapshot `json:"snapshot,omitempty"`
+}
+
+// GoldenTraceListResponse lists the golden traces of an agent
+type GoldenTraceListResponse struct {
+	GoldenTraces []GoldenTraceResponse `json:"goldenTraces"`
+}
+
+// GoldenTraceRunResponse is the comparison of a replayed trace against a golden trace
+type GoldenTraceRunResponse struct {
+	ID            string `json:"id"`
+	GoldenTraceID string `json:"goldenTraceId"`
+	ReplayTraceID string `json:"replayTraceId,omitempty"`
+	Status        string `json:"status"`
+	// StructuralScore is the similarity of the step sequences, between 0 and 1
+	StructuralScore *float64 `json:"structuralScore,omitempty"`
+	// SemanticScore is the similarity of the step outputs, between 0 and 1
+	SemanticScore *float64   `json:"semanticScore,omitempty"`
+	Error         string     `json:"error,omitempty"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	CompletedAt   *time.Time `json:"completedAt,omitempty"`
+	// Diff is only returned for a single run
+	Diff []GoldenTraceStepDiff `json:"diff,omitempty"`
+}
+
+// GoldenTraceRunListResponse lists the runs of a golden trace, newest first
+type GoldenTraceRunListResponse struct {
+	Runs []GoldenTraceRunResponse `json:"runs"`
+}
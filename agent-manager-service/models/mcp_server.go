@@ -0,0 +1,183 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MCPAuthType is how agent-manager-service authenticates to an MCP server
+type MCPAuthType string
+
+const (
+	MCPAuthTypeNone MCPAuthType = "none"
+	// MCPAuthTypeBearer sends the credential as a bearer token
+	MCPAuthTypeBearer MCPAuthType = "bearer"
+	// MCPAuthTypeHeader sends the credential in a custom header, e.g. an API key
+	MCPAuthTypeHeader MCPAuthType = "header"
+)
+
+// MCPServer is the database model for an MCP tool server registered by an organization
+type MCPServer struct {
+	UUID             uuid.UUID   `gorm:"column:uuid;primaryKey"`
+	OrganizationName string      `gorm:"column:organization_name"`
+	Name             string      `gorm:"column:name"`
+	DisplayName      string      `gorm:"column:display_name"`
+	Description      string      `gorm:"column:description"`
+	URL              string      `gorm:"column:url"`
+	GatewayURL       string      `gorm:"column:gateway_url"`
+	AuthType         MCPAuthType `gorm:"column:auth_type"`
+	AuthHeader       string      `gorm:"column:auth_header"`
+	// EncryptedCredential is encrypted with CREDENTIALS_ENCRYPTION_KEY and never returned by the API
+	EncryptedCredential []byte     `gorm:"column:encrypted_credential"`
+	ToolDiscovery       bool       `gorm:"column:tool_discovery"`
+	Tools               []MCPTool  `gorm:"column:tools;serializer:json"`
+	ToolsFetchedAt      *time.Time `gorm:"column:tools_fetched_at"`
+	LastFetchError      string     `gorm:"column:last_fetch_error"`
+	CreatedAt           time.Time  `gorm:"column:created_at"`
+	UpdatedAt           time.Time  `gorm:"column:updated_at"`
+}
+
+// TableName returns the table name for GORM
+func (MCPServer) TableName() string {
+	return "mcp_servers"
+}
+
+// EndpointURL returns the URL agents use to reach the server, preferring the gateway route
+func (s *MCPServer) EndpointURL() string {
+	if s.GatewayURL != "" {
+		return s.GatewayURL
+	}
+	return s.URL
+}
+
+// ToResponse converts the database model to the API response
+func (s *MCPServer) ToResponse() *MCPServerResponse {
+	tools := s.Tools
+	if tools == nil {
+		tools = []MCPTool{}
+	}
+	return &MCPServerResponse{
+		UUID:           s.UUID.String(),
+		Name:           s.Name,
+		DisplayName:    s.DisplayName,
+		Description:    s.Description,
+		URL:            s.URL,
+		GatewayURL:     s.GatewayURL,
+		AuthType:       s.AuthType,
+		AuthHeader:     s.AuthHeader,
+		ToolDiscovery:  s.ToolDiscovery,
+		Tools:          tools,
+		ToolsFetchedAt: s.ToolsFetchedAt,
+		LastFetchError: s.LastFetchError,
+		CreatedAt:      s.CreatedAt,
+		UpdatedAt:      s.UpdatedAt,
+	}
+}
+
+// AgentMCPServer binds an agent to a registered MCP server
+type AgentMCPServer struct {
+	OrganizationName string    `gorm:"column:organization_name;primaryKey"`
+	ProjectName      string    `gorm:"column:project_name;primaryKey"`
+	AgentName        string    `gorm:"column:agent_name;primaryKey"`
+	MCPServerUUID    uuid.UUID `gorm:"column:mcp_server_uuid;primaryKey"`
+	CreatedAt        time.Time `gorm:"column:created_at"`
+}
+
+// TableName returns the table name for GORM
+func (AgentMCPServer) TableName() string {
+	return "agent_mcp_servers"
+}
+
+// MCPTool is a tool exposed by an MCP server
+type MCPTool struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description,omitempty"`
+	InputSchema json.RawMessage `json:"inputSchema,omitempty"`
+}
+
+// MCPServerAuth is the authentication used to reach an MCP server
+type MCPServerAuth struct {
+	Type MCPAuthType `json:"type" validate:"omitempty,oneof=none bearer header"`
+	// Header is the header name for the header auth type
+	Header     string `json:"header,omitempty"`
+	Credential string `json:"credential,omitempty"`
+}
+
+// CreateMCPServerRequest is the request to register an MCP server
+type CreateMCPServerRequest struct {
+	Name        string `json:"name" validate:"required"`
+	DisplayName string `json:"displayName,omitempty" validate:"max=128"`
+	Description string `json:"description,omitempty"`
+	URL         string `json:"url" validate:"required"`
+	// GatewayURL is the route on the organization's gateway that exposes the server to agents
+	GatewayURL string         `json:"gatewayUrl,omitempty"`
+	Auth       *MCPServerAuth `json:"auth,omitempty"`
+	// ToolDiscovery fetches the tool list from the server periodically; defaults to true
+	ToolDiscovery *bool `json:"toolDiscovery,omitempty"`
+	// Tools is a static tool manifest, used when tool discovery is disabled
+	Tools []MCPTool `json:"tools,omitempty"`
+}
+
+// UpdateMCPServerRequest is the request to update a registered MCP server
+type UpdateMCPServerRequest struct {
+	DisplayName   *string        `json:"displayName,omitempty" validate:"omitnil,max=128"`
+	Description   *string        `json:"description,omitempty"`
+	URL           *string        `json:"url,omitempty"`
+	GatewayURL    *string        `json:"gatewayUrl,omitempty"`
+	Auth          *MCPServerAuth `json:"auth,omitempty"`
+	ToolDiscovery *bool          `json:"toolDiscovery,omitempty"`
+	Tools         []MCPTool      `json:"tools,omitempty"`
+}
+
+// MCPServerResponse is the API representation of a registered MCP server
+type MCPServerResponse struct {
+	UUID           string      `json:"uuid"`
+	Name           string      `json:"name"`
+	DisplayName    string      `json:"displayName,omitempty"`
+	Description    string      `json:"description,omitempty"`
+	URL            string      `json:"url"`
+	GatewayURL     string      `json:"gatewayUrl,omitempty"`
+	AuthType       MCPAuthType `json:"authType"`
+	AuthHeader     string      `json:"authHeader,omitempty"`
+	ToolDiscovery  bool        `json:"toolDiscovery"`
+	Tools          []MCPTool   `json:"tools"`
+	ToolsFetchedAt *time.Time  `json:"toolsFetchedAt,omitempty"`
+	LastFetchError string      `json:"lastFetchError,omitempty"`
+	CreatedAt      time.Time   `json:"createdAt"`
+	UpdatedAt      time.Time   `json:"updatedAt"`
+}
+
+// MCPServerListResponse is the list of MCP servers of an organization
+type MCPServerListResponse struct {
+	MCPServers []*MCPServerResponse `json:"mcpServers"`
+}
+
+// AgentMCPServersRequest sets the MCP servers an agent uses, by server name
+type AgentMCPServersRequest struct {
+	MCPServers []string `json:"mcpServers"`
+}
+
+// AgentMCPServerReference is the MCP server information passed to a deployed agent
+type AgentMCPServerReference struct {
+	Name  string   `json:"name"`
+	URL   string   `json:"url"`
+	Tools []string `json:"tools"`
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// How often a notification digest is sent
+const (
+	// NotificationDigestFrequencyDaily covers the previous day and is sent after midnight UTC
+	NotificationDigestFrequencyDaily = "daily"
+	// NotificationDigestFrequencyWeekly covers the previous week and is sent after midnight UTC on Mondays
+	NotificationDigestFrequencyWeekly = "weekly"
+)
+
+// Channels a notification digest is delivered to
+const (
+	// NotificationChannelWebhook posts the digest as JSON
+	NotificationChannelWebhook = "webhook"
+	// NotificationChannelSlack posts a text summary of the digest to a Slack incoming webhook
+	NotificationChannelSlack = "slack"
+	// NotificationChannelEmail emails the digest to the verified email recipients of the organization
+	// subscribed to digests
+	NotificationChannelEmail = "email"
+)
+
+// NotificationDigest is the database model for a recurring summary of an organization's activity
+// and the channel it is delivered to
+type NotificationDigest struct {
+	UUID             uuid.UUID `gorm:"column:uuid;primaryKey"`
+	OrganizationName string    `gorm:"column:organization_name"`
+	Name             string    `gorm:"column:name"`
+	Frequency        string    `gorm:"column:frequency"`
+	Channel          string    `gorm:"column:channel"`
+	URL              string    `gorm:"column:url"`
+	Enabled          bool      `gorm:"column:enabled"`
+	// LastPeriodEnd is the end of the last period a digest was sent for; the next digest covers the period after it
+	LastPeriodEnd time.Time  `gorm:"column:last_period_end"`
+	LastSentAt    *time.Time `gorm:"column:last_sent_at"`
+	LastError     string     `gorm:"column:last_error"`
+	CreatedBy     string     `gorm:"column:created_by"`
+	CreatedAt     time.Time  `gorm:"column:created_at"`
+	UpdatedAt     time.Time  `gorm:"column:updated_at"`
+}
+
+// TableName returns the table name for GORM
+func (NotificationDigest) TableName() string {
+	return "notification_digests"
+}
+
+// ToResponse converts the database model to the API response. Only the scheme and host of the URL
+// are returned, as webhook URLs such as Slack's carry a secret in their path.
+func (d *NotificationDigest) ToResponse() *NotificationDigestResponse {
+	response := &NotificationDigestResponse{
+		ID:            d.UUID.String(),
+		Name:          d.Name,
+		Frequency:     d.Frequency,
+		Channel:       d.Channel,
+		Enabled:       d.Enabled,
+		LastPeriodEnd: d.LastPeriodEnd,
+		LastSentAt:    d.LastSentAt,
+		LastError:     d.LastError,
+		CreatedBy:     d.CreatedBy,
+		CreatedAt:     d.CreatedAt,
+		UpdatedAt:     d.UpdatedAt,
+	}
+	if u, err := url.Parse(d.URL); err == nil && d.URL != "" {
+		response.URLHost = u.Scheme + "://" + u.Host
+	}
+	return response
+}
+
+// NotificationDigestRequest is the request to create or replace a notification digest, e.g. a
+// weekly summary posted to a Slack channel
+type NotificationDigestRequest struct {
+	Name string `json:"name" validate:"required,notblank,max=100"`
+	// Frequency is daily or weekly
+	Frequency string `json:"frequency" validate:"oneof=daily weekly"`
+	// Channel is webhook, slack or email
+	Channel string `json:"channel" validate:"oneof=webhook slack email"`
+	// URL is required for the webhook and slack channels
+	URL string `json:"url" validate:"required_unless=Channel email,omitempty,url,max=2048"`
+	// Enabled defaults to true
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// NotificationDigestResponse is a notification digest of an organization
+type NotificationDigestResponse struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Frequency string `json:"frequency"`
+	Channel   string `json:"channel"`
+	// URLHost is the scheme and host of the URL the digest is delivered to
+	URLHost       string     `json:"urlHost,omitempty"`
+	Enabled       bool       `json:"enabled"`
+	LastPeriodEnd time.Time  `json:"lastPeriodEnd"`
+	LastSentAt    *time.Time `json:"lastSentAt,omitempty"`
+	LastError     string     `json:"lastError,omitempty"`
+	CreatedBy     string     `json:"createdBy,omitempty"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	UpdatedAt     time.Time  `json:"updatedAt"`
+}
+
+// NotificationDigestListResponse lists the notification digests of an organization, by name
+type NotificationDigestListResponse struct {
+	Digests []NotificationDigestResponse `json:"digests"`
+}
+
+// NotificationDigestReport is the content of a notification digest: the activity of an
+// organization between PeriodStart and PeriodEnd
+type NotificationDigestReport struct {
+	OrganizationName string                         `json:"organizationName"`
+	Frequency        string                         `json:"frequency"`
+	PeriodStart      time.Time                      `json:"periodStart"`
+	PeriodEnd        time.Time                      `json:"periodEnd"`
+	NewIssues        []NotificationDigestIssue      `json:"newIssues"`
+	Alerts           []NotificationDigestAlert      `json:"alerts"`
+	Budgets          []NotificationDigestBudget     `json:"budgets"`
+	Deployments      []NotificationDigestDeployment `json:"deployments"`
+}
+
+// NotificationDigestIssue is an error of an agent first seen during the period of a digest
+type NotificationDigestIssue struct {
+	ProjectName string    `json:"projectName"`
+	AgentName   string    `json:"agentName"`
+	IssueID     string    `json:"issueId"`
+	ErrorType   string    `json:"errorType,omitempty"`
+	ToolName    string    `json:"toolName,omitempty"`
+	SpanName    string    `json:"spanName"`
+	Occurrences int       `json:"occurrences"`
+	FirstSeen   time.Time `json:"firstSeen"`
+}
+
+// NotificationDigestAlert is a token budget event raised during the period of a digest
+type NotificationDigestAlert struct {
+	ProjectName string `json:"projectName"`
+	AgentName   string `json:"agentName"`
+	Environment string `json:"environment"`
+	// Type is soft_limit_reached or limit_exceeded
+	Type       string    `json:"type"`
+	UsedTokens int64     `json:"usedTokens"`
+	TokenLimit int64     `json:"tokenLimit"`
+	Enforced   bool      `json:"enforced"`
+	RaisedAt   time.Time `json:"raisedAt"`
+}
+
+// NotificationDigestBudget is the consumption of a token budget in its current period
+type NotificationDigestBudget struct {
+	ProjectName string `json:"projectName"`
+	AgentName   string `json:"agentName"`
+	Environment string `json:"environment"`
+	Period      string `json:"period"`
+	TokenLimit  int64  `json:"tokenLimit"`
+	UsedTokens  int64  `json:"usedTokens"`
+	// Status is within_budget, soft_limit_reached or exceeded
+	Status string `json:"status"`
+}
+
+// NotificationDigestDeployment counts the deployments of an agent to an environment during the period of a digest
+type NotificationDigestDeployment struct {
+	ProjectName string `json:"projectName"`
+	AgentName   string `json:"agentName"`
+	Environment string `json:"environment"`
+	Deployments int    `json:"deployments"`
+}
@@ -23,15 +23,29 @@ import (
 	"gorm.io/gorm"
 )
 
+// OrganizationStatus enum
+type OrganizationStatus string
+
+const (
+	OrganizationStatusActive    OrganizationStatus = "ACTIVE"
+	OrganizationStatusSuspended OrganizationStatus = "SUSPENDED"
+)
+
 // Organization is the database model for organizations
 type Organization struct {
-	UUID      uuid.UUID      `gorm:"column:uuid;primaryKey"`
-	Name      string         `gorm:"column:name;uniqueIndex"`
-	Handle    string         `gorm:"column:handle;uniqueIndex"`
-	Region    string         `gorm:"column:region"`
-	CreatedAt time.Time      `gorm:"column:created_at"`
-	UpdatedAt time.Time      `gorm:"column:updated_at"`
-	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at;index"`
+	UUID                 uuid.UUID                  `gorm:"column:uuid;primaryKey"`
+	Name                 string                     `gorm:"column:name;uniqueIndex"`
+	Handle               string                     `gorm:"column:handle;uniqueIndex"`
+	Region               string                     `gorm:"column:region"`
+	DisplayName          string                     `gorm:"column:display_name"`
+	Description          string                     `gorm:"column:description"`
+	Metadata             map[string]string          `gorm:"column:metadata;default:'{}';serializer:json"`
+	DefaultGatewayConfig *OrganizationGatewayConfig `gorm:"column:default_gateway_config;serializer:json"`
+	Status               OrganizationStatus         `gorm:"column:status;default:ACTIVE"`
+	SuspendedAt          *time.Time                 `gorm:"column:suspended_at"`
+	CreatedAt            time.Time                  `gorm:"column:created_at"`
+	UpdatedAt            time.Time                  `gorm:"column:updated_at"`
+	DeletedAt            gorm.DeletedAt             `gorm:"column:deleted_at;index"`
 }
 
 // TableName returns the table name for GORM
@@ -39,6 +53,92 @@ func (Organization) TableName() string {
 	return "organizations"
 }
 
+// IsSuspended reports whether the organization has been suspended by a platform admin
+func (o *Organization) IsSuspended() bool {
+	return o.Status == OrganizationStatusSuspended
+}
+
+// ToDetailsResponse converts the database model to the lifecycle API response
+func (o *Organization) ToDetailsResponse() *OrganizationDetailsResponse {
+	status := o.Status
+	if status == "" {
+		status = OrganizationStatusActive
+	}
+	return &OrganizationDetailsResponse{
+		UUID:           o.UUID.String(),
+		Name:           o.Name,
+		Handle:         o.Handle,
+		Region:         o.Region,
+		DisplayName:    o.DisplayName,
+		Description:    o.Description,
+		Metadata:       o.Metadata,
+		DefaultGateway: o.DefaultGatewayConfig,
+		Status:         string(status),
+		SuspendedAt:    o.SuspendedAt,
+		CreatedAt:      o.CreatedAt,
+		UpdatedAt:      o.UpdatedAt,
+	}
+}
+
+// OrganizationGatewayConfig is the default gateway configuration applied when an organization is onboarded
+type OrganizationGatewayConfig struct {
+	Name          string                 `json:"name" validate:"required"`
+	DisplayName   string                 `json:"displayName,omitempty"`
+	GatewayType   string                 `json:"gatewayType,omitempty" validate:"omitempty,oneof=REGULAR AI"`
+	VHost         string                 `json:"vhost" validate:"required"`
+	IsCritical    bool                   `json:"isCritical"`
+	AdapterConfig map[string]interface{} `json:"adapterConfig,omitempty"`
+}
+
+// OrganizationEnvironmentConfig overrides the default environment created for a new organization
+type OrganizationEnvironmentConfig struct {
+	Name         string `json:"name,omitempty"`
+	DisplayName  string `json:"displayName,omitempty"`
+	DataplaneRef string `json:"dataplaneRef,omitempty"`
+	DNSPrefix    string `json:"dnsPrefix,omitempty"`
+}
+
+// CreateOrganizationRequest is the API request for onboarding an organization
+type CreateOrganizationRequest struct {
+	Name                   string                         `json:"name" validate:"required,max=100"`
+	Handle                 string                         `json:"handle,omitempty"`
+	Region                 string                         `json:"region,omitempty"`
+	DisplayName            string                         `json:"displayName,omitempty"`
+	Description            string                         `json:"description,omitempty"`
+	Metadata               map[string]string              `json:"metadata,omitempty"`
+	DefaultEnvironment     *OrganizationEnvironmentConfig `json:"defaultEnvironment,omitempty"`
+	SkipDefaultEnvironment bool                           `json:"skipDefaultEnvironment,omitempty"`
+	DefaultGateway         *OrganizationGatewayConfig     `json:"defaultGateway,omitempty"`
+}
+
+// UpdateOrganizationRequest is the API request for updating organization metadata.
+// Metadata replaces the stored metadata when set.
+type UpdateOrganizationRequest struct {
+	DisplayName *string           `json:"displayName,omitempty" validate:"omitnil,notblank"`
+	Description *string           `json:"description,omitempty"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+}
+
+// OrganizationDetailsResponse is the API response DTO for the organization lifecycle endpoints
+type OrganizationDetailsResponse struct {
+	UUID               string                      `json:"uuid"`
+	Name               string                      `json:"name"`
+	Handle             string                      `json:"handle"`
+	Region             string                      `json:"region"`
+	DisplayName        string                      `json:"displayName,omitempty"`
+	Description        string                      `json:"description,omitempty"`
+	Metadata           map[string]string           `json:"metadata,omitempty"`
+	DefaultGateway     *OrganizationGatewayConfig  `json:"defaultGateway,omitempty"`
+	Status             string                      `json:"status"`
+	SuspendedAt        *time.Time                  `json:"suspendedAt,omitempty"`
+	CreatedAt          time.Time                   `json:"createdAt"`
+	UpdatedAt          time.Time                   `json:"updatedAt"`
+	DefaultEnvironment *GatewayEnvironmentResponse `json:"defaultEnvironment,omitempty"`
+	DefaultGatewayID   string                      `json:"defaultGatewayId,omitempty"`
+	// TrialSandbox is set when a trial sandbox was provisioned with the organization
+	TrialSandbox *TrialSandboxResponse `json:"trialSandbox,omitempty"`
+}
+
 // API Response DTO (from OpenChoreo)
 type OrganizationResponse struct {
 	Name        string    `json:"name"`
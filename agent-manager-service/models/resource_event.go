@@ -0,0 +1,82 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Types of the resources with an event timeline
+const (
+	ResourceTypeGateway            = "gateway"
+	ResourceTypeDeploymentApproval = "deployment_approval"
+	ResourceTypeChangeFreeze       = "change_freeze"
+)
+
+// Types of resource events
+const (
+	ResourceEventCreated             = "created"
+	ResourceEventUpdated             = "updated"
+	ResourceEventDeleted             = "deleted"
+	ResourceEventEnvironmentAssigned = "environment_assigned"
+	ResourceEventEnvironmentRemoved  = "environment_removed"
+	ResourceEventTokenRotated        = "token_rotated"
+	ResourceEventTokenRevoked        = "token_revoked"
+	ResourceEventHealthChanged       = "health_changed"
+	ResourceEventApprovalRequested   = "approval_requested"
+	ResourceEventApproved            = "approved"
+	ResourceEventRejected            = "rejected"
+	ResourceEventDeployed            = "deployed"
+	ResourceEventDeployFailed        = "deploy_failed"
+	ResourceEventBreakGlass          = "break_glass"
+)
+
+// ResourceEvent is the database model of a change to a resource, shown on the resource's timeline
+type ResourceEvent struct {
+	UUID             uuid.UUID              `gorm:"column:uuid;primaryKey"`
+	OrganizationName string                 `gorm:"column:organization_name"`
+	ResourceType     string                 `gorm:"column:resource_type"`
+	ResourceID       string                 `gorm:"column:resource_id"`
+	EventType        string                 `gorm:"column:event_type"`
+	Actor            string                 `gorm:"column:actor"`
+	Details          map[string]interface{} `gorm:"column:details;serializer:json"`
+	CreatedAt        time.Time              `gorm:"column:created_at"`
+}
+
+// TableName returns the table name for GORM
+func (ResourceEvent) TableName() string {
+	return "resource_events"
+}
+
+// ResourceEventResponse is an event on a resource timeline
+type ResourceEventResponse struct {
+	ID        string                 `json:"id"`
+	Type      string                 `json:"type"`
+	Actor     string                 `json:"actor,omitempty"`
+	Details   map[string]interface{} `json:"details,omitempty"`
+	CreatedAt time.Time              `json:"createdAt"`
+}
+
+// ResourceEventListResponse is a page of a resource timeline, newest event first
+type ResourceEventListResponse struct {
+	Events []ResourceEventResponse `json:"events"`
+	Total  int64                   `json:"total"`
+	Limit  int                     `json:"limit"`
+	Offset int                     `json:"offset"`
+}
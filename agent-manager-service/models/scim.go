@@ -0,0 +1,177 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SCIM 2.0 schema URNs (RFC 7643, RFC 7644)
+const (
+	ScimSchemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	ScimSchemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	ScimSchemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	ScimSchemaPatchOp               = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	ScimSchemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	ScimSchemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+)
+
+// ScimUser is the database model for a user provisioned by an identity provider
+type ScimUser struct {
+	UUID             uuid.UUID   `gorm:"column:uuid;primaryKey"`
+	OrganizationName string      `gorm:"column:organization_name"`
+	UserName         string      `gorm:"column:user_name"`
+	ExternalID       *string     `gorm:"column:external_id"`
+	DisplayName      string      `gorm:"column:display_name"`
+	GivenName        string      `gorm:"column:given_name"`
+	FamilyName       string      `gorm:"column:family_name"`
+	Emails           []ScimEmail `gorm:"column:emails;serializer:json"`
+	Active           bool        `gorm:"column:active"`
+	CreatedAt        time.Time   `gorm:"column:created_at"`
+	UpdatedAt        time.Time   `gorm:"column:updated_at"`
+}
+
+// TableName returns the table name for GORM
+func (ScimUser) TableName() string {
+	return "scim_users"
+}
+
+// ScimGroup is the database model for a group provisioned by an identity provider
+type ScimGroup struct {
+	UUID             uuid.UUID `gorm:"column:uuid;primaryKey"`
+	OrganizationName string    `gorm:"column:organization_name"`
+	DisplayName      string    `gorm:"column:display_name"`
+	ExternalID       *string   `gorm:"column:external_id"`
+	CreatedAt        time.Time `gorm:"column:created_at"`
+	UpdatedAt        time.Time `gorm:"column:updated_at"`
+}
+
+// TableName returns the table name for GORM
+func (ScimGroup) TableName() string {
+	return "scim_groups"
+}
+
+// ScimGroupMember is the junction table model between groups and users
+type ScimGroupMember struct {
+	GroupUUID uuid.UUID `gorm:"column:group_uuid;primaryKey"`
+	UserUUID  uuid.UUID `gorm:"column:user_uuid;primaryKey"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
+
+// TableName returns the table name for GORM
+func (ScimGroupMember) TableName() string {
+	return "scim_group_members"
+}
+
+// ScimMeta is the common resource metadata
+type ScimMeta struct {
+	ResourceType string    `json:"resourceType"`
+	Created      time.Time `json:"created"`
+	LastModified time.Time `json:"lastModified"`
+	Location     string    `json:"location,omitempty"`
+}
+
+// ScimName is the components of a user's name
+type ScimName struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+// ScimEmail is an email address of a user
+type ScimEmail struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// ScimGroupRef references a group a user belongs to. It is read-only on users.
+type ScimGroupRef struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+// ScimMemberRef references a user that is a member of a group
+type ScimMemberRef struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+// ScimUserResource is the SCIM representation of a user
+type ScimUserResource struct {
+	Schemas     []string       `json:"schemas"`
+	ID          string         `json:"id,omitempty"`
+	ExternalID  string         `json:"externalId,omitempty"`
+	UserName    string         `json:"userName"`
+	Name        *ScimName      `json:"name,omitempty"`
+	DisplayName string         `json:"displayName,omitempty"`
+	Emails      []ScimEmail    `json:"emails,omitempty"`
+	Active      *bool          `json:"active,omitempty"`
+	Groups      []ScimGroupRef `json:"groups,omitempty"`
+	Meta        *ScimMeta      `json:"meta,omitempty"`
+}
+
+// ScimGroupResource is the SCIM representation of a group
+type ScimGroupResource struct {
+	Schemas     []string        `json:"schemas"`
+	ID          string          `json:"id,omitempty"`
+	ExternalID  string          `json:"externalId,omitempty"`
+	DisplayName string          `json:"displayName"`
+	Members     []ScimMemberRef `json:"members,omitempty"`
+	Meta        *ScimMeta       `json:"meta,omitempty"`
+}
+
+// ScimListResponse is the SCIM list response envelope
+type ScimListResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int64    `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+// ScimPatchRequest is a SCIM PATCH request
+type ScimPatchRequest struct {
+	Schemas    []string             `json:"schemas"`
+	Operations []ScimPatchOperation `json:"Operations"`
+}
+
+// ScimPatchOperation is a single operation of a SCIM PATCH request
+type ScimPatchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path,omitempty"`
+	Value any    `json:"value,omitempty"`
+}
+
+// ScimErrorResponse is the SCIM error response body
+type ScimErrorResponse struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+// ScimListQuery holds the supported SCIM list query parameters
+type ScimListQuery struct {
+	Filter     string
+	StartIndex int
+	Count      int
+}
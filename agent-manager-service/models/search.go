@@ -0,0 +1,55 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+// Types of the resources returned by search
+const (
+	SearchResultTypeProject     = "project"
+	SearchResultTypeAgent       = "agent"
+	SearchResultTypeEnvironment = "environment"
+	SearchResultTypeGateway     = "gateway"
+	SearchResultTypeMCPServer   = "mcpServer"
+)
+
+// SearchResultTypes lists the resource types search covers, in the order results of equal
+// relevance are returned
+var SearchResultTypes = []string{
+	SearchResultTypeProject,
+	SearchResultTypeAgent,
+	SearchResultTypeEnvironment,
+	SearchResultTypeGateway,
+	SearchResultTypeMCPServer,
+}
+
+// SearchResult is a resource matching a search query
+type SearchResult struct {
+	Type        string `json:"type"`
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName,omitempty"`
+	Description string `json:"description,omitempty"`
+	// ProjectName is the project of an agent
+	ProjectName string `json:"projectName,omitempty"`
+}
+
+// SearchResponse is the most relevant resources matching a search query
+type SearchResponse struct {
+	Query   string         `json:"query"`
+	Results []SearchResult `json:"results"`
+	// Total is the number of matching resources, including those beyond the limit
+	Total int `json:"total"`
+}
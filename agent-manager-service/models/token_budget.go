@@ -0,0 +1,189 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Periods a token budget is reset after
+const (
+	// TokenBudgetPeriodDaily resets the budget at midnight UTC
+	TokenBudgetPeriodDaily = "daily"
+	// TokenBudgetPeriodMonthly resets the budget on the first day of each month, UTC
+	TokenBudgetPeriodMonthly = "monthly"
+)
+
+// How a token budget is enforced
+const (
+	// TokenBudgetEnforcementSoft only records events when the budget is reached
+	TokenBudgetEnforcementSoft = "soft"
+	// TokenBudgetEnforcementHard also limits the tokens of the agent's LLM proxy on the gateway
+	TokenBudgetEnforcementHard = "hard"
+)
+
+// Token budget statuses
+const (
+	TokenBudgetStatusWithinBudget     = "within_budget"
+	TokenBudgetStatusSoftLimitReached = "soft_limit_reached"
+	TokenBudgetStatusExceeded         = "exceeded"
+)
+
+// Token budget event types
+const (
+	TokenBudgetEventSoftLimitReached = "soft_limit_reached"
+	TokenBudgetEventLimitExceeded    = "limit_exceeded"
+)
+
+// AgentTokenBudget is the database model for the token budget of an agent in an environment, along
+// with the usage last read back from the trace analytics
+type AgentTokenBudget struct {
+	UUID             uuid.UUID  `gorm:"column:uuid;primaryKey"`
+	OrganizationName string     `gorm:"column:organization_name"`
+	ProjectName      string     `gorm:"column:project_name"`
+	AgentName        string     `gorm:"column:agent_name"`
+	EnvironmentName  string     `gorm:"column:environment_name"`
+	Period           string     `gorm:"column:period"`
+	TokenLimit       int64      `gorm:"column:token_limit"`
+	SoftLimitPercent int        `gorm:"column:soft_limit_percent"`
+	Enforcement      string     `gorm:"column:enforcement"`
+	LLMProxyID       string     `gorm:"column:llm_proxy_id"`
+	Status           string     `gorm:"column:status"`
+	PeriodStart      *time.Time `gorm:"column:period_start"`
+	UsedTokens       int64      `gorm:"column:used_tokens"`
+	LastCheckedAt    *time.Time `gorm:"column:last_checked_at"`
+	LastCheckError   string     `gorm:"column:last_check_error"`
+	CreatedBy        string     `gorm:"column:created_by"`
+	CreatedAt        time.Time  `gorm:"column:created_at"`
+	UpdatedAt        time.Time  `gorm:"column:updated_at"`
+}
+
+// TableName returns the table name for GORM
+func (AgentTokenBudget) TableName() string {
+	return "agent_token_budgets"
+}
+
+// ToResponse converts the database model to the API response
+func (b *AgentTokenBudget) ToResponse() *AgentTokenBudgetResponse {
+	return &AgentTokenBudgetResponse{
+		ID:               b.UUID.String(),
+		Environment:      b.EnvironmentName,
+		Period:           b.Period,
+		TokenLimit:       b.TokenLimit,
+		SoftLimitPercent: b.SoftLimitPercent,
+		Enforcement:      b.Enforcement,
+		LLMProxyID:       b.LLMProxyID,
+		Status:           b.Status,
+		PeriodStart:      b.PeriodStart,
+		UsedTokens:       b.UsedTokens,
+		LastCheckedAt:    b.LastCheckedAt,
+		LastCheckError:   b.LastCheckError,
+		CreatedBy:        b.CreatedBy,
+		CreatedAt:        b.CreatedAt,
+		UpdatedAt:        b.UpdatedAt,
+	}
+}
+
+// AgentTokenBudgetEvent is the database model for an agent reaching the soft limit or exceeding its token budget
+type AgentTokenBudgetEvent struct {
+	UUID        uuid.UUID `gorm:"column:uuid;primaryKey"`
+	BudgetUUID  uuid.UUID `gorm:"column:budget_uuid"`
+	Type        string    `gorm:"column:type"`
+	PeriodStart time.Time `gorm:"column:period_start"`
+	UsedTokens  int64     `gorm:"column:used_tokens"`
+	TokenLimit  int64     `gorm:"column:token_limit"`
+	Enforced    bool      `gorm:"column:enforced"`
+	CreatedAt   time.Time `gorm:"column:created_at"`
+}
+
+// TableName returns the table name for GORM
+func (AgentTokenBudgetEvent) TableName() string {
+	return "agent_token_budget_events"
+}
+
+// ToResponse converts the database model to the API response
+func (e *AgentTokenBudgetEvent) ToResponse() *AgentTokenBudgetEventResponse {
+	return &AgentTokenBudgetEventResponse{
+		ID:          e.UUID.String(),
+		Type:        e.Type,
+		PeriodStart: e.PeriodStart,
+		UsedTokens:  e.UsedTokens,
+		TokenLimit:  e.TokenLimit,
+		Enforced:    e.Enforced,
+		CreatedAt:   e.CreatedAt,
+	}
+}
+
+// AgentTokenBudgetRequest is the request to create or replace the token budget of an agent, e.g.
+// 1,000,000 tokens a day in production with a warning at 80%
+type AgentTokenBudgetRequest struct {
+	Environment string `json:"environment" validate:"required"`
+	// Period is daily or monthly
+	Period     string `json:"period" validate:"oneof=daily monthly"`
+	TokenLimit int64  `json:"tokenLimit" validate:"gt=0"`
+	// SoftLimitPercent is the share of the limit an event is raised at, between 1 and 100; defaults to 80
+	SoftLimitPercent int `json:"softLimitPercent,omitempty" validate:"gte=0,lte=100"`
+	// Enforcement is soft or hard; defaults to soft
+	Enforcement string `json:"enforcement,omitempty" validate:"omitempty,oneof=soft hard"`
+	// LLMProxyID is the API Platform LLM proxy of the agent the limit is enforced on; required for hard enforcement
+	LLMProxyID string `json:"llmProxyId,omitempty"`
+}
+
+// AgentTokenBudgetResponse is the token budget of an agent and its usage in the current period
+type AgentTokenBudgetResponse struct {
+	ID               string `json:"id"`
+	Environment      string `json:"environment"`
+	Period           string `json:"period"`
+	TokenLimit       int64  `json:"tokenLimit"`
+	SoftLimitPercent int    `json:"softLimitPercent"`
+	Enforcement      string `json:"enforcement"`
+	LLMProxyID       string `json:"llmProxyId,omitempty"`
+	// Status is within_budget, soft_limit_reached or exceeded
+	Status         string     `json:"status"`
+	PeriodStart    *time.Time `json:"periodStart,omitempty"`
+	UsedTokens     int64      `json:"usedTokens"`
+	LastCheckedAt  *time.Time `json:"lastCheckedAt,omitempty"`
+	LastCheckError string     `json:"lastCheckError,omitempty"`
+	CreatedBy      string     `json:"createdBy,omitempty"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	UpdatedAt      time.Time  `json:"updatedAt"`
+}
+
+// AgentTokenBudgetListResponse lists the token budgets of an agent
+type AgentTokenBudgetListResponse struct {
+	Budgets []AgentTokenBudgetResponse `json:"budgets"`
+}
+
+// AgentTokenBudgetEventResponse is an agent reaching the soft limit or exceeding its token budget
+type AgentTokenBudgetEventResponse struct {
+	ID string `json:"id"`
+	// Type is soft_limit_reached or limit_exceeded
+	Type        string    `json:"type"`
+	PeriodStart time.Time `json:"periodStart"`
+	UsedTokens  int64     `json:"usedTokens"`
+	TokenLimit  int64     `json:"tokenLimit"`
+	// Enforced is whether the gateway limits the agent's tokens, true for budgets with hard enforcement
+	Enforced  bool      `json:"enforced"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// AgentTokenBudgetEventListResponse lists the events of a token budget, most recent first
+type AgentTokenBudgetEventListResponse struct {
+	Events []AgentTokenBudgetEventResponse `json:"events"`
+}
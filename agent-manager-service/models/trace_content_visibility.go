@@ -0,0 +1,67 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import "time"
+
+// Levels of the content visibility of traces. Latency, token usage, models and statuses are
+// returned at every level.
+const (
+	// TraceContentVisibilityFull returns prompts, completions and other span content as recorded
+	TraceContentVisibilityFull = "full"
+	// TraceContentVisibilityRedacted replaces content with a placeholder, keeping message roles and tool names
+	TraceContentVisibilityRedacted = "redacted"
+	// TraceContentVisibilityMetadataOnly leaves content out of traces
+	TraceContentVisibilityMetadataOnly = "metadata-only"
+)
+
+// TraceContentSetting is the database model for how much span content is returned with the traces
+// of an organization's agents. Organizations without one get full content.
+type TraceContentSetting struct {
+	OrganizationName  string    `gorm:"column:organization_name;primaryKey"`
+	ContentVisibility string    `gorm:"column:content_visibility"`
+	UpdatedBy         string    `gorm:"column:updated_by"`
+	CreatedAt         time.Time `gorm:"column:created_at"`
+	UpdatedAt         time.Time `gorm:"column:updated_at"`
+}
+
+// TableName returns the table name for GORM
+func (TraceContentSetting) TableName() string {
+	return "trace_content_settings"
+}
+
+// ToResponse converts the database model to the API response
+func (s *TraceContentSetting) ToResponse() *TraceContentVisibilityResponse {
+	return &TraceContentVisibilityResponse{
+		ContentVisibility: s.ContentVisibility,
+		UpdatedBy:         s.UpdatedBy,
+		UpdatedAt:         &s.UpdatedAt,
+	}
+}
+
+// TraceContentVisibilityRequest is the request to set the content visibility of an organization's traces
+type TraceContentVisibilityRequest struct {
+	ContentVisibility string `json:"contentVisibility" validate:"required,oneof=full redacted metadata-only"`
+}
+
+// TraceContentVisibilityResponse is the content visibility of an organization's traces
+type TraceContentVisibilityResponse struct {
+	ContentVisibility string `json:"contentVisibility"`
+	UpdatedBy         string `json:"updatedBy,omitempty"`
+	// UpdatedAt is not set for organizations that never changed the default
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
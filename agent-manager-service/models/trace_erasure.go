@@ -0,0 +1,97 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Trace erasure statuses
+const (
+	TraceErasureStatusRunning   = "running"
+	TraceErasureStatusCompleted = "completed"
+	TraceErasureStatusFailed    = "failed"
+)
+
+// TraceErasureRequest is the database model for the audit record of a request to erase the spans
+// carrying a personal identifier. Only a hash of the identifier is kept.
+type TraceErasureRequest struct {
+	UUID             uuid.UUID  `gorm:"column:uuid;primaryKey"`
+	OrganizationName string     `gorm:"column:organization_name"`
+	Attribute        string     `gorm:"column:attribute"`
+	ValueHash        string     `gorm:"column:value_hash"`
+	RequestedBy      string     `gorm:"column:requested_by"`
+	TaskID           string     `gorm:"column:task_id"`
+	Status           string     `gorm:"column:status"`
+	TotalSpans       int64      `gorm:"column:total_spans"`
+	DeletedSpans     int64      `gorm:"column:deleted_spans"`
+	Error            string     `gorm:"column:error"`
+	CreatedAt        time.Time  `gorm:"column:created_at"`
+	CompletedAt      *time.Time `gorm:"column:completed_at"`
+}
+
+// TableName returns the table name for GORM
+func (TraceErasureRequest) TableName() string {
+	return "trace_erasure_requests"
+}
+
+// ToResponse converts the database model to the API response
+func (e *TraceErasureRequest) ToResponse() *TraceErasureResponse {
+	return &TraceErasureResponse{
+		ID:           e.UUID.String(),
+		Attribute:    e.Attribute,
+		ValueHash:    e.ValueHash,
+		RequestedBy:  e.RequestedBy,
+		TaskID:       e.TaskID,
+		Status:       e.Status,
+		TotalSpans:   e.TotalSpans,
+		DeletedSpans: e.DeletedSpans,
+		Error:        e.Error,
+		CreatedAt:    e.CreatedAt,
+		CompletedAt:  e.CompletedAt,
+	}
+}
+
+// CreateTraceErasureRequest is the request to erase the spans carrying a personal identifier
+type CreateTraceErasureRequest struct {
+	// Attribute is the span attribute holding the identifier; defaults to enduser.id
+	Attribute string `json:"attribute,omitempty"`
+	Value     string `json:"value"`
+}
+
+// TraceErasureResponse is the audit record and progress of a trace erasure
+type TraceErasureResponse struct {
+	ID        string `json:"id"`
+	Attribute string `json:"attribute"`
+	// ValueHash is the SHA-256 hash of the erased identifier
+	ValueHash    string     `json:"valueHash"`
+	RequestedBy  string     `json:"requestedBy,omitempty"`
+	TaskID       string     `json:"taskId,omitempty"`
+	Status       string     `json:"status"`
+	TotalSpans   int64      `json:"totalSpans"`
+	DeletedSpans int64      `json:"deletedSpans"`
+	Error        string     `json:"error,omitempty"`
+	CreatedAt    time.Time  `json:"createdAt"`
+	CompletedAt  *time.Time `json:"completedAt,omitempty"`
+}
+
+// TraceErasureListResponse lists the trace erasures of an organization
+type TraceErasureListResponse struct {
+	Erasures []TraceErasureResponse `json:"erasures"`
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TraceReplay is the database model linking a replayed trace to the trace whose root input was
+// sent to the agent again
+type TraceReplay struct {
+	UUID             uuid.UUID `gorm:"column:uuid;primaryKey"`
+	OrganizationName string    `gorm:"column:organization_name"`
+	ProjectName      string    `gorm:"column:project_name"`
+	AgentName        string    `gorm:"column:agent_name"`
+	EnvironmentName  string    `gorm:"column:environment_name"`
+	OriginalTraceID  string    `gorm:"column:original_trace_id"`
+	ReplayTraceID    string    `gorm:"column:replay_trace_id"`
+	EndpointURL      string    `gorm:"column:endpoint_url"`
+	StatusCode       int       `gorm:"column:status_code"`
+	Error            string    `gorm:"column:error"`
+	RequestedBy      string    `gorm:"column:requested_by"`
+	CreatedAt        time.Time `gorm:"column:created_at"`
+}
+
+// TableName returns the table name for GORM
+func (TraceReplay) TableName() string {
+	return "trace_replays"
+}
+
+// ToResponse converts the database model to the API response
+func (r *TraceReplay) ToResponse() *TraceReplayResponse {
+	return &TraceReplayResponse{
+		ID:              r.UUID.String(),
+		OriginalTraceID: r.OriginalTraceID,
+		ReplayTraceID:   r.ReplayTraceID,
+		Environment:     r.EnvironmentName,
+		EndpointURL:     r.EndpointURL,
+		StatusCode:      r.StatusCode,
+		Error:           r.Error,
+		RequestedBy:     r.RequestedBy,
+		CreatedAt:       r.CreatedAt,
+	}
+}
+
+// CreateTraceReplayRequest is the request to replay the root input of a trace
+type CreateTraceReplayRequest struct {
+	// Endpoint is the name of the agent endpoint to invoke; defaults to the first endpoint by name
+	Endpoint string `json:"endpoint,omitempty"`
+	// Path is appended to the endpoint URL, e.g. /chat
+	Path string `json:"path,omitempty"`
+}
+
+// TraceReplayResponse links a replayed trace to the original trace
+type TraceReplayResponse struct {
+	ID              string `json:"id"`
+	OriginalTraceID string `json:"originalTraceId"`
+	// ReplayTraceID is the trace the agent recorded for the replayed request
+	ReplayTraceID string `json:"replayTraceId"`
+	Environment   string `json:"environment"`
+	EndpointURL   string `json:"endpointUrl"`
+	// StatusCode is the HTTP status the agent responded with; 0 if it could not be reached
+	StatusCode  int       `json:"statusCode"`
+	Error       string    `json:"error,omitempty"`
+	RequestedBy string    `json:"requestedBy,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
+	// Response is the body the agent responded with, only returned when the replay is created
+	Response json.RawMessage `json:"response,omitempty"`
+}
+
+// TraceReplayListResponse lists the replays of a trace, newest first
+type TraceReplayListResponse struct {
+	Replays []TraceReplayResponse `json:"replays"`
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import "time"
+
+// TraceRetentionPolicy is the database model for how long the traces of an organization's agents are kept
+type TraceRetentionPolicy struct {
+	OrganizationName string `gorm:"column:organization_name;primaryKey"`
+	RetentionDays    int    `gorm:"column:retention_days"`
+	// ErrorRetentionDays keeps error spans longer than the others; 0 applies RetentionDays to them as well
+	ErrorRetentionDays   int        `gorm:"column:error_retention_days"`
+	LastEnforcedAt       *time.Time `gorm:"column:last_enforced_at"`
+	LastEnforcementTask  string     `gorm:"column:last_enforcement_task"`
+	LastEnforcementError string     `gorm:"column:last_enforcement_error"`
+	CreatedAt            time.Time  `gorm:"column:created_at"`
+	UpdatedAt            time.Time  `gorm:"column:updated_at"`
+}
+
+// TableName returns the table name for GORM
+func (TraceRetentionPolicy) TableName() string {
+	return "trace_retention_policies"
+}
+
+// ToResponse converts the database model to the API response
+func (p *TraceRetentionPolicy) ToResponse() *TraceRetentionPolicyResponse {
+	return &TraceRetentionPolicyResponse{
+		RetentionDays:        p.RetentionDays,
+		ErrorRetentionDays:   p.ErrorRetentionDays,
+		LastEnforcedAt:       p.LastEnforcedAt,
+		LastEnforcementTask:  p.LastEnforcementTask,
+		LastEnforcementError: p.LastEnforcementError,
+		UpdatedAt:            p.UpdatedAt,
+	}
+}
+
+// TraceRetentionPolicyRequest is the request to set the trace retention of an organization
+type TraceRetentionPolicyRequest struct {
+	RetentionDays      int `json:"retentionDays"`
+	ErrorRetentionDays int `json:"errorRetentionDays,omitempty"`
+}
+
+// TraceRetentionPolicyResponse is the trace retention of an organization
+type TraceRetentionPolicyResponse struct {
+	RetentionDays      int `json:"retentionDays"`
+	ErrorRetentionDays int `json:"errorRetentionDays,omitempty"`
+	// LastEnforcedAt is when spans past the retention period were last deleted
+	LastEnforcedAt *time.Time `json:"lastEnforcedAt,omitempty"`
+	// LastEnforcementTask is the trace store task that performed the last deletion
+	LastEnforcementTask  string    `json:"lastEnforcementTask,omitempty"`
+	LastEnforcementError string    `json:"lastEnforcementError,omitempty"`
+	UpdatedAt            time.Time `json:"updatedAt"`
+}
+
+// AgentTraceStorage holds the stored trace data of an agent
+type AgentTraceStorage struct {
+	ProjectName        string     `json:"projectName"`
+	AgentName          string     `json:"agentName"`
+	SpanCount          int64      `json:"spanCount"`
+	TraceCount         int64      `json:"traceCount"`
+	OldestSpanTime     *time.Time `json:"oldestSpanTime,omitempty"`
+	NewestSpanTime     *time.Time `json:"newestSpanTime,omitempty"`
+	EstimatedSizeBytes int64      `json:"estimatedSizeBytes"`
+}
+
+// TraceStorageUsageResponse is the stored trace data of an organization's agents
+type TraceStorageUsageResponse struct {
+	Agents             []AgentTraceStorage `json:"agents"`
+	SpanCount          int64               `json:"spanCount"`
+	EstimatedSizeBytes int64               `json:"estimatedSizeBytes"`
+}
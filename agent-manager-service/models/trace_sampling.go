@@ -0,0 +1,101 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Where the sampling rates of a verdict come from
+const (
+	// TraceSamplingSourceAgent is the sampling policy of the agent
+	TraceSamplingSourceAgent = "agent"
+	// TraceSamplingSourceOrganization is the default sampling policy of the organization
+	TraceSamplingSourceOrganization = "organization"
+	// TraceSamplingSourceDefault keeps all traces, for agents without a sampling policy
+	TraceSamplingSourceDefault = "default"
+)
+
+// TraceSamplingPolicy is the database model for the share of traces agents record. The policy of
+// an organization, which has no project and agent name, applies to agents without their own.
+type TraceSamplingPolicy struct {
+	UUID              uuid.UUID `gorm:"column:uuid;primaryKey"`
+	OrganizationName  string    `gorm:"column:organization_name"`
+	ProjectName       string    `gorm:"column:project_name"`
+	AgentName         string    `gorm:"column:agent_name"`
+	ErrorSampleRate   float64   `gorm:"column:error_sample_rate"`
+	SuccessSampleRate float64   `gorm:"column:success_sample_rate"`
+	UpdatedBy         string    `gorm:"column:updated_by"`
+	CreatedAt         time.Time `gorm:"column:created_at"`
+	UpdatedAt         time.Time `gorm:"column:updated_at"`
+}
+
+// TableName returns the table name for GORM
+func (TraceSamplingPolicy) TableName() string {
+	return "trace_sampling_policies"
+}
+
+// ToResponse converts the database model to the API response
+func (p *TraceSamplingPolicy) ToResponse() *TraceSamplingPolicyResponse {
+	return &TraceSamplingPolicyResponse{
+		ProjectName:       p.ProjectName,
+		AgentName:         p.AgentName,
+		ErrorSampleRate:   p.ErrorSampleRate,
+		SuccessSampleRate: p.SuccessSampleRate,
+		UpdatedBy:         p.UpdatedBy,
+		UpdatedAt:         p.UpdatedAt,
+	}
+}
+
+// TraceSamplingPolicyRequest is the request to set the sampling rates of an organization or
+// agent, e.g. keeping all traces with errors and 5% of the others
+type TraceSamplingPolicyRequest struct {
+	// ErrorSampleRate is the share of traces with an error that are kept, between 0 and 1
+	ErrorSampleRate *float64 `json:"errorSampleRate" validate:"required,gte=0,lte=1"`
+	// SuccessSampleRate is the share of the other traces that are kept, between 0 and 1
+	SuccessSampleRate *float64 `json:"successSampleRate" validate:"required,gte=0,lte=1"`
+}
+
+// TraceSamplingPolicyResponse is the sampling policy of an organization, or of an agent when the
+// project and agent name are set
+type TraceSamplingPolicyResponse struct {
+	ProjectName       string    `json:"projectName,omitempty"`
+	AgentName         string    `json:"agentName,omitempty"`
+	ErrorSampleRate   float64   `json:"errorSampleRate"`
+	SuccessSampleRate float64   `json:"successSampleRate"`
+	UpdatedBy         string    `json:"updatedBy,omitempty"`
+	UpdatedAt         time.Time `json:"updatedAt"`
+}
+
+// TraceSamplingPolicyListResponse lists the sampling policies of an organization, its own first
+type TraceSamplingPolicyListResponse struct {
+	Policies []TraceSamplingPolicyResponse `json:"policies"`
+}
+
+// TraceSamplingVerdictResponse is the sampling rates an agent's SDK or collector applies to the
+// traces of the agent
+type TraceSamplingVerdictResponse struct {
+	ComponentUid      string  `json:"componentUid"`
+	ErrorSampleRate   float64 `json:"errorSampleRate"`
+	SuccessSampleRate float64 `json:"successSampleRate"`
+	// Source is agent, organization or default
+	Source string `json:"source"`
+	// RefreshIntervalSeconds is how long the verdict may be used before it is fetched again
+	RefreshIntervalSeconds int `json:"refreshIntervalSeconds"`
+}
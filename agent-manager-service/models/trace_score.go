@@ -0,0 +1,151 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Qualities the LLM judge scores traces on, between 0 and 1
+const (
+	// TraceScoreHelpfulness is how well the output serves the input; higher is better
+	TraceScoreHelpfulness = "helpfulness"
+	// TraceScoreHallucination is the risk that the output states things not supported by the input
+	// or the tool results; lower is better
+	TraceScoreHallucination = "hallucination"
+	// TraceScoreToxicity is how offensive or harmful the output is; lower is better
+	TraceScoreToxicity = "toxicity"
+)
+
+// TraceScoringPolicy is the database model for the sampling of an agent's traces for scoring.
+// Criteria are stored comma separated and thresholds as JSON.
+type TraceScoringPolicy struct {
+	UUID             uuid.UUID  `gorm:"column:uuid;primaryKey"`
+	OrganizationName string     `gorm:"column:organization_name"`
+	ProjectName      string     `gorm:"column:project_name"`
+	AgentName        string     `gorm:"column:agent_name"`
+	EnvironmentName  string     `gorm:"column:environment_name"`
+	SampleRate       float64    `gorm:"column:sample_rate"`
+	Criteria         string     `gorm:"column:criteria"`
+	Thresholds       string     `gorm:"column:thresholds"`
+	ScoredUntil      *time.Time `gorm:"column:scored_until"`
+	LastRunAt        *time.Time `gorm:"column:last_run_at"`
+	LastRunError     string     `gorm:"column:last_run_error"`
+	CreatedAt        time.Time  `gorm:"column:created_at"`
+	UpdatedAt        time.Time  `gorm:"column:updated_at"`
+}
+
+// TableName returns the table name for GORM
+func (TraceScoringPolicy) TableName() string {
+	return "trace_scoring_policies"
+}
+
+// TraceScore is the database model for the score the LLM judge gave a trace on one criterion
+type TraceScore struct {
+	UUID             uuid.UUID `gorm:"column:uuid;primaryKey"`
+	OrganizationName string    `gorm:"column:organization_name"`
+	ProjectName      string    `gorm:"column:project_name"`
+	AgentName        string    `gorm:"column:agent_name"`
+	EnvironmentName  string    `gorm:"column:environment_name"`
+	TraceID          string    `gorm:"column:trace_id"`
+	TraceStartTime   time.Time `gorm:"column:trace_start_time"`
+	Criterion        string    `gorm:"column:criterion"`
+	Score            float64   `gorm:"column:score"`
+	Reason           string    `gorm:"column:reason"`
+	Model            string    `gorm:"column:model"`
+	CreatedAt        time.Time `gorm:"column:created_at"`
+}
+
+// TableName returns the table name for GORM
+func (TraceScore) TableName() string {
+	return "trace_scores"
+}
+
+// ToResponse converts the database model to the API response
+func (s *TraceScore) ToResponse() *TraceScoreResponse {
+	return &TraceScoreResponse{
+		Criterion: s.Criterion,
+		Score:     s.Score,
+		Reason:    s.Reason,
+		Model:     s.Model,
+		CreatedAt: s.CreatedAt,
+	}
+}
+
+// TraceScoringPolicyRequest is the request to sample an agent's traces for scoring
+type TraceScoringPolicyRequest struct {
+	Environment string `json:"environment"`
+	// SampleRate is the share of traces scored, greater than 0 and at most 1
+	SampleRate float64 `json:"sampleRate"`
+	// Criteria defaults to all of helpfulness, hallucination and toxicity
+	Criteria []string `json:"criteria,omitempty"`
+	// Thresholds overrides the alert threshold of criteria: an average helpfulness below it, or an
+	// average hallucination or toxicity above it, raises an alert
+	Thresholds map[string]float64 `json:"thresholds,omitempty"`
+}
+
+// TraceScoringPolicyResponse is the trace scoring policy of an agent
+type TraceScoringPolicyResponse struct {
+	Environment string             `json:"environment"`
+	SampleRate  float64            `json:"sampleRate"`
+	Criteria    []string           `json:"criteria"`
+	Thresholds  map[string]float64 `json:"thresholds"`
+	// ScoredUntil is the start time up to which traces have been sampled
+	ScoredUntil  *time.Time `json:"scoredUntil,omitempty"`
+	LastRunAt    *time.Time `json:"lastRunAt,omitempty"`
+	LastRunError string     `json:"lastRunError,omitempty"`
+	CreatedAt    time.Time  `json:"createdAt"`
+	UpdatedAt    time.Time  `json:"updatedAt"`
+}
+
+// TraceScoreResponse is the score of a trace on one criterion
+type TraceScoreResponse struct {
+	Criterion string    `json:"criterion"`
+	Score     float64   `json:"score"`
+	Reason    string    `json:"reason,omitempty"`
+	Model     string    `json:"model,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// TraceScoresResponse lists the scores of a trace
+type TraceScoresResponse struct {
+	TraceID string               `json:"traceId"`
+	Scores  []TraceScoreResponse `json:"scores"`
+}
+
+// TraceScoreAggregate summarizes the scores of an agent's traces on one criterion
+type TraceScoreAggregate struct {
+	Criterion string  `json:"criterion"`
+	Count     int64   `json:"count"`
+	Average   float64 `json:"average"`
+	Min       float64 `json:"min"`
+	Max       float64 `json:"max"`
+	Threshold float64 `json:"threshold"`
+	// Breaches is the number of traces whose score is past the threshold
+	Breaches int64 `json:"breaches"`
+	// Alert is set when the average score is past the threshold
+	Alert bool `json:"alert"`
+}
+
+// TraceScoreSummaryResponse summarizes the scores of the traces of an agent started in a time window
+type TraceScoreSummaryResponse struct {
+	StartTime time.Time             `json:"startTime"`
+	EndTime   time.Time             `json:"endTime"`
+	Criteria  []TraceScoreAggregate `json:"criteria"`
+}
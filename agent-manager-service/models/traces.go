@@ -148,4 +148,62 @@ type TraceResponse struct {
 	TotalCount int          `json:"totalCount"`
 	TokenUsage *TokenUsage  `json:"tokenUsage,omitempty"` // Aggregated token usage from GenAI spans
 	Status     *TraceStatus `json:"status,omitempty"`     // Trace status including error information
+	Input      interface{}  `json:"input,omitempty"`      // Input from the root span (nil if not found)
+	Output     interface{}  `json:"output,omitempty"`     // Output from the root span (nil if not found)
+}
+
+// UsageStats holds request, latency, error and cost figures of LLM and embedding calls
+type UsageStats struct {
+	RequestCount  int      `json:"requestCount"`
+	ErrorCount    int      `json:"errorCount"`
+	ErrorRate     float64  `json:"errorRate"`               // Fraction of failed requests
+	AvgLatencyMs  float64  `json:"avgLatencyMs"`            // Mean call duration in milliseconds
+	P50LatencyMs  float64  `json:"p50LatencyMs"`            // Median call duration in milliseconds
+	P95LatencyMs  float64  `json:"p95LatencyMs"`            // 95th percentile call duration in milliseconds
+	InputTokens   int      `json:"inputTokens"`             // Total input tokens
+	OutputTokens  int      `json:"outputTokens"`            // Total output tokens
+	TotalTokens   int      `json:"totalTokens"`             // Total tokens
+	EstimatedCost *float64 `json:"estimatedCost,omitempty"` // Cost from the configured model prices (nil if no price is known)
+}
+
+// ModelUsage holds the usage of a single model of a provider
+type ModelUsage struct {
+	Model    string `json:"model"`
+	Provider string `json:"provider,omitempty"`
+	UsageStats
+}
+
+// ProviderUsage holds the usage of all models of a provider
+type ProviderUsage struct {
+	Provider string `json:"provider"`
+	UsageStats
+}
+
+// ModelUsageResponse breaks down the model usage of the agents of an organization
+type ModelUsageResponse struct {
+	Environment string          `json:"environment"`
+	StartTime   string          `json:"startTime,omitempty"`
+	EndTime     string          `json:"endTime,omitempty"`
+	AgentCount  int             `json:"agentCount"` // Number of agents included
+	Models      []ModelUsage    `json:"models"`     // Ordered by request count, highest first
+	Providers   []ProviderUsage `json:"providers"`  // Ordered by request count, highest first
+	Truncated   bool            `json:"truncated"`  // Whether only the most recent calls were included
+}
+
+// LogRecord is an application log record carrying the trace context of a span
+type LogRecord struct {
+	Timestamp time.Time              `json:"timestamp"`
+	Message   string                 `json:"message"`
+	TraceID   string                 `json:"traceId,omitempty"`
+	SpanID    string                 `json:"spanId,omitempty"`
+	Fields    map[string]interface{} `json:"fields,omitempty"` // Stored fields or stream labels of the record
+}
+
+// TraceLogsResponse holds the application logs of a trace, oldest first
+type TraceLogsResponse struct {
+	TraceID    string      `json:"traceId"`
+	SpanID     string      `json:"spanId,omitempty"`
+	Logs       []LogRecord `json:"logs"`
+	TotalCount int         `json:"totalCount"`
+	Truncated  bool        `json:"truncated"` // Whether more records matched than were returned
 }
@@ -0,0 +1,82 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Trial sandbox statuses
+const (
+	TrialSandboxStatusActive = "active"
+	// TrialSandboxStatusEnded marks a sandbox that expired or was ended early; its environment is removed
+	TrialSandboxStatusEnded = "ended"
+)
+
+// TrialSandbox is the database model for the trial sandbox of an organization: an environment
+// served by a shared managed gateway until it expires
+type TrialSandbox struct {
+	UUID             uuid.UUID  `gorm:"column:uuid;primaryKey"`
+	OrganizationName string     `gorm:"column:organization_name"`
+	EnvironmentUUID  uuid.UUID  `gorm:"column:environment_uuid"`
+	GatewayUUID      uuid.UUID  `gorm:"column:gateway_uuid"`
+	Status           string     `gorm:"column:status"`
+	ExpiresAt        time.Time  `gorm:"column:expires_at"`
+	EndedAt          *time.Time `gorm:"column:ended_at"`
+	CreatedBy        string     `gorm:"column:created_by"`
+	CreatedAt        time.Time  `gorm:"column:created_at"`
+}
+
+// TableName returns the table name for GORM
+func (TrialSandbox) TableName() string {
+	return "trial_sandboxes"
+}
+
+// ToResponse converts the database model to the API response
+func (s *TrialSandbox) ToResponse() *TrialSandboxResponse {
+	return &TrialSandboxResponse{
+		ID:            s.UUID.String(),
+		EnvironmentID: s.EnvironmentUUID.String(),
+		GatewayID:     s.GatewayUUID.String(),
+		Status:        s.Status,
+		ExpiresAt:     s.ExpiresAt,
+		EndedAt:       s.EndedAt,
+		CreatedBy:     s.CreatedBy,
+		CreatedAt:     s.CreatedAt,
+	}
+}
+
+// TrialSandboxRateLimits are the default limits applied to each consumer of the trial LLM provider
+type TrialSandboxRateLimits struct {
+	RequestsPerMinute int `json:"requestsPerMinute"`
+	TokensPerDay      int `json:"tokensPerDay"`
+}
+
+// TrialSandboxResponse is the API response DTO for a trial sandbox
+type TrialSandboxResponse struct {
+	ID            string                  `json:"id"`
+	EnvironmentID string                  `json:"environmentId"`
+	GatewayID     string                  `json:"gatewayId"`
+	Status        string                  `json:"status"`
+	RateLimits    *TrialSandboxRateLimits `json:"rateLimits,omitempty"`
+	ExpiresAt     time.Time               `json:"expiresAt"`
+	EndedAt       *time.Time              `json:"endedAt,omitempty"`
+	CreatedBy     string                  `json:"createdBy,omitempty"`
+	CreatedAt     time.Time               `json:"createdAt"`
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Usage report statuses
+const (
+	UsageReportStatusGenerating = "generating"
+	UsageReportStatusCompleted  = "completed"
+	UsageReportStatusFailed     = "failed"
+)
+
+// Formats a usage report can be downloaded in
+const (
+	UsageReportFormatCSV = "csv"
+	UsageReportFormatPDF = "pdf"
+)
+
+// UsageReport is the database model for the usage of an organization's agents in a calendar month
+type UsageReport struct {
+	UUID             uuid.UUID `gorm:"column:uuid;primaryKey"`
+	OrganizationName string    `gorm:"column:organization_name"`
+	// Period is the month of the report, formatted as YYYY-MM
+	Period      string           `gorm:"column:period"`
+	Status      string           `gorm:"column:status"`
+	Rows        []UsageReportRow `gorm:"column:usage_rows;serializer:json"`
+	RequestedBy string           `gorm:"column:requested_by"`
+	Error       string           `gorm:"column:error"`
+	CreatedAt   time.Time        `gorm:"column:created_at"`
+	CompletedAt *time.Time       `gorm:"column:completed_at"`
+}
+
+// TableName returns the table name for GORM
+func (UsageReport) TableName() string {
+	return "usage_reports"
+}
+
+// UsageReportRow is the usage of an agent in an environment during the month of a report
+type UsageReportRow struct {
+	ProjectName   string   `json:"projectName"`
+	AgentName     string   `json:"agentName"`
+	Environment   string   `json:"environment"`
+	TraceCount    int      `json:"traceCount"`
+	LLMRequests   int      `json:"llmRequests"`
+	InputTokens   int      `json:"inputTokens"`
+	OutputTokens  int      `json:"outputTokens"`
+	TotalTokens   int      `json:"totalTokens"`
+	EstimatedCost *float64 `json:"estimatedCost,omitempty"` // nil if no price is known for the models used
+	Deployments   int      `json:"deployments"`
+}
+
+// UsageReportTotals sums the rows of a usage report
+type UsageReportTotals struct {
+	TraceCount    int      `json:"traceCount"`
+	LLMRequests   int      `json:"llmRequests"`
+	TotalTokens   int      `json:"totalTokens"`
+	EstimatedCost *float64 `json:"estimatedCost,omitempty"`
+	Deployments   int      `json:"deployments"`
+}
+
+// ToResponse converts the database model to the API response. The rows are only included when withRows is set.
+func (r *UsageReport) ToResponse(withRows bool) *UsageReportResponse {
+	response := &UsageReportResponse{
+		ID:          r.UUID.String(),
+		Period:      r.Period,
+		Status:      r.Status,
+		RequestedBy: r.RequestedBy,
+		Error:       r.Error,
+		CreatedAt:   r.CreatedAt,
+		CompletedAt: r.CompletedAt,
+	}
+	for _, row := range r.Rows {
+		response.Totals.TraceCount += row.TraceCount
+		response.Totals.LLMRequests += row.LLMRequests
+		response.Totals.TotalTokens += row.TotalTokens
+		response.Totals.Deployments += row.Deployments
+		if row.EstimatedCost != nil {
+			cost := *row.EstimatedCost
+			if response.Totals.EstimatedCost != nil {
+				cost += *response.Totals.EstimatedCost
+			}
+			response.Totals.EstimatedCost = &cost
+		}
+	}
+	if withRows {
+		response.Rows = r.Rows
+		if response.Rows == nil {
+			response.Rows = []UsageReportRow{}
+		}
+	}
+	return response
+}
+
+// CreateUsageReportRequest is the request to generate the usage report of a month
+type CreateUsageReportRequest struct {
+	Period string `json:"period" validate:"required,datetime=2006-01"`
+}
+
+// UsageReportResponse is a usage report with its totals
+type UsageReportResponse struct {
+	ID          string            `json:"id"`
+	Period      string            `json:"period"`
+	Status      string            `json:"status"`
+	RequestedBy string            `json:"requestedBy,omitempty"`
+	Error       string            `json:"error,omitempty"`
+	Totals      UsageReportTotals `json:"totals"`
+	Rows        []UsageReportRow  `json:"rows,omitempty"`
+	CreatedAt   time.Time         `json:"createdAt"`
+	CompletedAt *time.Time        `json:"completedAt,omitempty"`
+}
+
+// UsageReportListResponse lists the usage reports of an organization, newest period first
+type UsageReportListResponse struct {
+	Reports []UsageReportResponse `json:"reports"`
+}
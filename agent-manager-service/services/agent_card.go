@@ -0,0 +1,234 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/openchoreosvc/client"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// agentSkillIDPattern matches the characters replaced when a skill ID is derived from an operation
+var agentSkillIDPattern = regexp.MustCompile(`[^a-z0-9]+`)
+
+// AgentCardService generates A2A agent cards from the current deployments of agents, so that a card
+// always describes what is deployed
+type AgentCardService interface {
+	// GetAgentCard returns the card of the agent in the environment, or in the furthest promoted
+	// environment it is active in when no environment is given
+	GetAgentCard(ctx context.Context, orgName, projectName, agentName, environment string) (*models.AgentCard, error)
+	// GetPublicAgentCard returns the card of an agent whose endpoint is public, for unauthenticated discovery.
+	// Agents without a public endpoint are reported as not found so that their existence is not disclosed.
+	GetPublicAgentCard(ctx context.Context, orgName, projectName, agentName, environment string) (*models.AgentCard, error)
+}
+
+type agentCardService struct {
+	logger   *slog.Logger
+	ocClient client.OpenChoreoClient
+}
+
+// NewAgentCardService creates a new agent card service
+func NewAgentCardService(logger *slog.Logger, ocClient client.OpenChoreoClient) AgentCardService {
+	return &agentCardService{
+		logger:   logger,
+		ocClient: ocClient,
+	}
+}
+
+func (s *agentCardService) GetAgentCard(ctx context.Context, orgName, projectName, agentName, environment string) (*models.AgentCard, error) {
+	card, _, err := s.generateAgentCard(ctx, orgName, projectName, agentName, environment)
+	return card, err
+}
+
+func (s *agentCardService) GetPublicAgentCard(ctx context.Context, orgName, projectName, agentName, environment string) (*models.AgentCard, error) {
+	card, visibility, err := s.generateAgentCard(ctx, orgName, projectName, agentName, environment)
+	if err != nil {
+		return nil, err
+	}
+	if visibility != client.EndpointVisibilityPublic {
+		return nil, utils.ErrAgentNotFound
+	}
+	return card, nil
+}
+
+// generateAgentCard builds the card of an agent and returns it with the visibility of its primary endpoint
+func (s *agentCardService) generateAgentCard(ctx context.Context, orgName, projectName, agentName, environment string) (*models.AgentCard, string, error) {
+	org, err := s.ocClient.GetOrganization(ctx, orgName)
+	if err != nil {
+		return nil, "", err
+	}
+	project, err := s.ocClient.GetProject(ctx, orgName, projectName)
+	if err != nil {
+		return nil, "", err
+	}
+	agent, err := s.ocClient.GetComponent(ctx, orgName, projectName, agentName)
+	if err != nil {
+		return nil, "", err
+	}
+	deployments, err := s.ocClient.GetDeployments(ctx, orgName, project.DeploymentPipeline, projectName, agentName)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get deployments for agent %s: %w", agentName, err)
+	}
+	deployment, err := selectAgentCardDeployment(deployments, environment)
+	if err != nil {
+		return nil, "", err
+	}
+	endpoints, err := s.ocClient.GetComponentEndpoints(ctx, orgName, projectName, agentName, deployment.Environment)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get agent endpoints: %w", err)
+	}
+	primaryName, primary, err := selectAgentEndpoint(endpoints, "")
+	if err != nil {
+		return nil, "", err
+	}
+
+	card := &models.AgentCard{
+		ProtocolVersion:    models.A2AProtocolVersion,
+		Name:               agent.Name,
+		Description:        agent.Description,
+		URL:                primary.URL,
+		PreferredTransport: models.A2ATransportHTTPJSON,
+		Version:            deployment.LastDeployedAt.UTC().Format("2006.01.02.150405"),
+		DefaultInputModes:  agentCardModes(agent.Type.SubType),
+		DefaultOutputModes: agentCardModes(agent.Type.SubType),
+		Skills:             []models.AgentSkill{},
+	}
+	if agent.DisplayName != "" {
+		card.Name = agent.DisplayName
+	}
+	if card.Description == "" {
+		card.Description = card.Name
+	}
+	providerName := org.DisplayName
+	if providerName == "" {
+		providerName = org.Name
+	}
+	card.Provider = &models.AgentProvider{Organization: providerName}
+	if primary.Visibility != client.EndpointVisibilityPublic {
+		card.SecuritySchemes = map[string]models.SecurityScheme{
+			"bearer": {
+				Type:         "http",
+				Scheme:       "bearer",
+				BearerFormat: "JWT",
+				Description:  "Access token issued by the identity provider of the organization",
+			},
+		}
+		card.Security = []map[string][]string{{"bearer": {}}}
+	}
+
+	for _, name := range sortedEndpointNames(endpoints) {
+		endpoint := endpoints[name]
+		if name != primaryName && endpoint.URL != "" && endpoint.URL != primary.URL {
+			card.AdditionalInterfaces = append(card.AdditionalInterfaces, models.AgentInterface{
+				URL:       endpoint.URL,
+				Transport: models.A2ATransportHTTPJSON,
+			})
+		}
+		card.Skills = append(card.Skills, s.endpointSkills(agentName, name, endpoint)...)
+	}
+	if len(card.Skills) == 0 {
+		card.Skills = append(card.Skills, models.AgentSkill{
+			ID:          agentSkillID(agentName),
+			Name:        card.Name,
+			Description: card.Description,
+			Tags:        []string{agent.Type.SubType},
+		})
+	}
+	return card, primary.Visibility, nil
+}
+
+// endpointSkills derives one skill per operation of the endpoint schema
+func (s *agentCardService) endpointSkills(agentName, endpointName string, endpoint models.EndpointsResponse) []models.AgentSkill {
+	if strings.TrimSpace(endpoint.Schema.Content) == "" {
+		return nil
+	}
+	operations, err := parseOpenAPIOperations(endpoint.Schema.Content)
+	if err != nil {
+		// An unreadable schema leaves the card without skills for the endpoint rather than failing discovery
+		s.logger.Warn("Failed to derive agent skills from endpoint schema", "agentName", agentName, "endpoint", endpointName, "error", err)
+		return nil
+	}
+	skills := make([]models.AgentSkill, 0, len(operations))
+	for _, op := range operations {
+		skill := models.AgentSkill{
+			ID:          agentSkillID(op.OperationID),
+			Name:        op.Summary,
+			Description: op.Description,
+			Tags:        op.Tags,
+		}
+		if skill.ID == "" {
+			skill.ID = agentSkillID(op.Method + " " + op.Path)
+		}
+		if skill.Name == "" {
+			skill.Name = op.Method + " " + op.Path
+		}
+		if skill.Description == "" {
+			skill.Description = skill.Name
+		}
+		if skill.Tags == nil {
+			skill.Tags = []string{}
+		}
+		skills = append(skills, skill)
+	}
+	return skills
+}
+
+// selectAgentCardDeployment returns the active deployment in the environment, or the active deployment
+// of the furthest promoted environment when no environment is given
+func selectAgentCardDeployment(deployments []*models.DeploymentResponse, environment string) (*models.DeploymentResponse, error) {
+	var selected *models.DeploymentResponse
+	for _, deployment := range deployments {
+		if deployment.Status != client.DeploymentStatusActive {
+			continue
+		}
+		if environment == "" || deployment.Environment == environment {
+			selected = deployment
+		}
+	}
+	if selected == nil {
+		return nil, utils.ErrAgentNotDeployed
+	}
+	return selected, nil
+}
+
+// agentCardModes returns the media types an agent of the sub-type exchanges
+func agentCardModes(subType string) []string {
+	if subType == string(utils.AgentSubTypeChatAPI) {
+		return []string{"text/plain", "application/json"}
+	}
+	return []string{"application/json"}
+}
+
+func agentSkillID(name string) string {
+	return strings.Trim(agentSkillIDPattern.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
+
+func sortedEndpointNames(endpoints map[string]models.EndpointsResponse) []string {
+	names := make([]string, 0, len(endpoints))
+	for name := range endpoints {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
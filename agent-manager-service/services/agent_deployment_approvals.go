@@ -0,0 +1,355 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+const deploymentApprovalWebhookTimeout = 10 * time.Second
+
+var deploymentApprovalWebhookClient = &http.Client{Timeout: deploymentApprovalWebhookTimeout}
+
+// deploymentApprovalNotification is sent to the approval webhook. It leaves out the environment
+// variables of the deployment, which may hold secrets.
+type deploymentApprovalNotification struct {
+	Event            string `json:"event"`
+	OrganizationName string `json:"organizationName"`
+	ApprovalID       string `json:"approvalId"`
+	ProjectName      string `json:"projectName"`
+	AgentName        string `json:"agentName"`
+	Environment      string `json:"environment"`
+	ImageID          string `json:"imageId"`
+	Status           string `json:"status"`
+	RequestedBy      string `json:"requestedBy,omitempty"`
+	ReviewedBy       string `json:"reviewedBy,omitempty"`
+	ReviewComment    string `json:"reviewComment,omitempty"`
+	Error            string `json:"error,omitempty"`
+}
+
+func (s *agentManagerService) GetDeploymentApprovalPolicy(ctx context.Context, orgName string) (*models.DeploymentApprovalPolicyResponse, error) {
+	policy, err := getDeploymentApprovalPolicy(db.DB(ctx), orgName)
+	if err != nil {
+		return nil, err
+	}
+	return policy.ToResponse(), nil
+}
+
+func (s *agentManagerService) SetDeploymentApprovalPolicy(ctx context.Context, orgName string, req *models.DeploymentApprovalPolicyRequest, updatedBy string) (*models.DeploymentApprovalPolicyResponse, error) {
+	policy := &models.DeploymentApprovalPolicy{
+		OrganizationName: orgName,
+		Reviewers:        req.Reviewers,
+		UpdatedBy:        updatedBy,
+		UpdatedAt:        time.Now(),
+	}
+	if err := db.DB(ctx).Save(policy).Error; err != nil {
+		return nil, fmt.Errorf("failed to save deployment approval policy: %w", err)
+	}
+	s.logger.Info("Set deployment approval policy", "orgName", orgName, "reviewers", len(policy.Reviewers))
+	return policy.ToResponse(), nil
+}
+
+func (s *agentManagerService) DeleteDeploymentApprovalPolicy(ctx context.Context, orgName string) error {
+	result := db.DB(ctx).Where("organization_name = ?", orgName).Delete(&models.DeploymentApprovalPolicy{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete deployment approval policy: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return utils.ErrDeploymentApprovalPolicyNotFound
+	}
+	s.logger.Info("Deleted deployment approval policy", "orgName", orgName)
+	return nil
+}
+
+func (s *agentManagerService) ListDeploymentApprovals(ctx context.Context, orgName string, status string) (*models.DeploymentApprovalListResponse, error) {
+	query := db.DB(ctx).Where("organization_name = ?", orgName)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	var approvals []models.DeploymentApproval
+	if err := query.Order("created_at DESC").Find(&approvals).Error; err != nil {
+		return nil, fmt.Errorf("failed to list deployment approvals: %w", err)
+	}
+	response := &models.DeploymentApprovalListResponse{Approvals: make([]models.DeploymentApprovalResponse, 0, len(approvals))}
+	for i := range approvals {
+		response.Approvals = append(response.Approvals, *approvals[i].ToResponse())
+	}
+	return response, nil
+}
+
+func (s *agentManagerService) GetDeploymentApproval(ctx context.Context, orgName string, approvalID string) (*models.DeploymentApprovalResponse, error) {
+	approval, err := getDeploymentApproval(db.DB(ctx), orgName, approvalID)
+	if err != nil {
+		return nil, err
+	}
+	return approval.ToResponse(), nil
+}
+
+// ApproveDeployment approves a pending deployment and deploys it. The approval is kept if the
+// deployment fails, with the approval marked failed and the error recorded. During a change
+// freeze, the approval stays pending unless a break-glass reason is given.
+func (s *agentManagerService) ApproveDeployment(ctx context.Context, orgName string, approvalID string, reviewer string, req *models.DeploymentReviewRequest, breakGlassReason string) (*models.DeploymentApprovalResponse, error) {
+	pending, err := getDeploymentApproval(db.DB(ctx), orgName, approvalID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.changeFreezeService.CheckChangeAllowed(ctx, orgName, models.ChangeOperationDeploy, pending.ProjectName+"/"+pending.AgentName, reviewer, breakGlassReason); err != nil {
+		return nil, err
+	}
+	approval, err := s.reviewDeployment(ctx, orgName, approvalID, reviewer, req.Comment, models.DeploymentApprovalStatusApproved)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info("Deployment approved", "approvalId", approval.UUID, "orgName", orgName, "projectName", approval.ProjectName,
+		"agentName", approval.AgentName, "environment", approval.Environment, "reviewer", reviewer)
+	s.recordDeploymentApprovalEvent(ctx, approval, models.ResourceEventApproved, reviewer)
+
+	deployReq := &spec.DeployAgentRequest{ImageId: approval.ImageID}
+	for _, env := range approval.Env {
+		deployReq.Env = append(deployReq.Env, spec.EnvironmentVariable{Key: env.Key, Value: env.Value})
+	}
+	revision, deployErr := s.deployToEnvironment(ctx, orgName, approval.ProjectName, approval.AgentName, approval.Environment, deployReq, approval.RollbackOf)
+	updates := map[string]interface{}{}
+	if deployErr != nil {
+		approval.Status = models.DeploymentApprovalStatusFailed
+		approval.Error = deployErr.Error()
+		updates["status"] = approval.Status
+		updates["error"] = approval.Error
+	} else {
+		approval.Revision = &revision.Revision
+		updates["revision"] = revision.Revision
+	}
+	if err := db.DB(ctx).Model(approval).Updates(updates).Error; err != nil {
+		s.logger.Error("Failed to update deployment approval", "approvalId", approval.UUID, "error", err)
+	}
+	if deployErr != nil {
+		s.recordDeploymentApprovalEvent(ctx, approval, models.ResourceEventDeployFailed, reviewer)
+		return nil, deployErr
+	}
+	s.recordDeploymentApprovalEvent(ctx, approval, models.ResourceEventDeployed, reviewer)
+	return approval.ToResponse(), nil
+}
+
+func (s *agentManagerService) RejectDeployment(ctx context.Context, orgName string, approvalID string, reviewer string, req *models.DeploymentReviewRequest) (*models.DeploymentApprovalResponse, error) {
+	approval, err := s.reviewDeployment(ctx, orgName, approvalID, reviewer, req.Comment, models.DeploymentApprovalStatusRejected)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info("Deployment rejected", "approvalId", approval.UUID, "orgName", orgName, "projectName", approval.ProjectName,
+		"agentName", approval.AgentName, "environment", approval.Environment, "reviewer", reviewer)
+	s.recordDeploymentApprovalEvent(ctx, approval, models.ResourceEventRejected, reviewer)
+	return approval.ToResponse(), nil
+}
+
+// reviewDeployment records the decision of a reviewer on a pending deployment. Reviewers must be
+// listed in the approval policy of the organization and cannot review their own deployments.
+func (s *agentManagerService) reviewDeployment(ctx context.Context, orgName, approvalID, reviewer, comment, status string) (*models.DeploymentApproval, error) {
+	approval, err := getDeploymentApproval(db.DB(ctx), orgName, approvalID)
+	if err != nil {
+		return nil, err
+	}
+	if approval.Status != models.DeploymentApprovalStatusPending {
+		return nil, utils.ErrDeploymentApprovalNotPending
+	}
+	policy, err := getDeploymentApprovalPolicy(db.DB(ctx), orgName)
+	if errors.Is(err, utils.ErrDeploymentApprovalPolicyNotFound) {
+		return nil, utils.ErrNotDeploymentApprover
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !policy.IsReviewer(reviewer) {
+		return nil, utils.ErrNotDeploymentApprover
+	}
+	if reviewer == approval.RequestedBy {
+		return nil, utils.ErrDeploymentSelfApproval
+	}
+
+	now := time.Now()
+	// Only a pending approval is updated, so that concurrent reviews cannot both take effect
+	result := db.DB(ctx).Model(&models.DeploymentApproval{}).
+		Where("uuid = ? AND status = ?", approval.UUID, models.DeploymentApprovalStatusPending).
+		Updates(map[string]interface{}{
+			"status":         status,
+			"reviewed_by":    reviewer,
+			"review_comment": comment,
+			"reviewed_at":    now,
+		})
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to review deployment approval: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, utils.ErrDeploymentApprovalNotPending
+	}
+	approval.Status = status
+	approval.ReviewedBy = reviewer
+	approval.ReviewComment = comment
+	approval.ReviewedAt = &now
+	return approval, nil
+}
+
+// requestDeploymentApproval creates a pending approval for a deployment that needs one: a
+// deployment to a production environment of an organization with an approval policy. It returns
+// nil if the deployment can proceed without approval.
+func (s *agentManagerService) requestDeploymentApproval(ctx context.Context, orgName, projectName, agentName, environment string, req *spec.DeployAgentRequest, rollbackOf *int, requestedBy string) (*models.DeploymentApproval, error) {
+	if environment == "" {
+		return nil, nil
+	}
+	_, err := getDeploymentApprovalPolicy(db.DB(ctx), orgName)
+	if errors.Is(err, utils.ErrDeploymentApprovalPolicyNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	env, err := s.ocClient.GetEnvironment(ctx, orgName, environment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get environment: %w", err)
+	}
+	if !env.IsProduction {
+		return nil, nil
+	}
+
+	approval := &models.DeploymentApproval{
+		UUID:             uuid.New(),
+		OrganizationName: orgName,
+		ProjectName:      projectName,
+		AgentName:        agentName,
+		Environment:      environment,
+		ImageID:          req.ImageId,
+		Env:              make([]models.EnvVars, 0, len(req.Env)),
+		RollbackOf:       rollbackOf,
+		Status:           models.DeploymentApprovalStatusPending,
+		RequestedBy:      requestedBy,
+		CreatedAt:        time.Now(),
+	}
+	for _, env := range req.Env {
+		approval.Env = append(approval.Env, models.EnvVars{Key: env.Key, Value: env.Value})
+	}
+	if err := db.DB(ctx).Create(approval).Error; err != nil {
+		return nil, fmt.Errorf("failed to create deployment approval: %w", err)
+	}
+	s.logger.Info("Deployment is waiting for approval", "approvalId", approval.UUID, "agentName", agentName, "orgName", orgName,
+		"projectName", projectName, "environment", environment)
+	s.recordDeploymentApprovalEvent(ctx, approval, models.ResourceEventApprovalRequested, requestedBy)
+	return approval, nil
+}
+
+// recordDeploymentApprovalEvent adds a step of an approval to its audit timeline and notifies the approval webhook
+func (s *agentManagerService) recordDeploymentApprovalEvent(ctx context.Context, approval *models.DeploymentApproval, eventType, actor string) {
+	details := map[string]interface{}{
+		"projectName": approval.ProjectName,
+		"agentName":   approval.AgentName,
+		"environment": approval.Environment,
+		"imageId":     approval.ImageID,
+	}
+	if approval.ReviewComment != "" {
+		details["comment"] = approval.ReviewComment
+	}
+	if approval.Revision != nil {
+		details["revision"] = *approval.Revision
+	}
+	if approval.Error != "" {
+		details["error"] = approval.Error
+	}
+	s.eventService.RecordEvent(ctx, approval.OrganizationName, models.ResourceTypeDeploymentApproval, approval.UUID.String(), eventType, actor, details)
+
+	webhookURL := config.GetConfig().DeploymentApproval.WebhookURL
+	if webhookURL == "" {
+		return
+	}
+	notification := deploymentApprovalNotification{
+		Event:            eventType,
+		OrganizationName: approval.OrganizationName,
+		ApprovalID:       approval.UUID.String(),
+		ProjectName:      approval.ProjectName,
+		AgentName:        approval.AgentName,
+		Environment:      approval.Environment,
+		ImageID:          approval.ImageID,
+		Status:           approval.Status,
+		RequestedBy:      approval.RequestedBy,
+		ReviewedBy:       approval.ReviewedBy,
+		ReviewComment:    approval.ReviewComment,
+		Error:            approval.Error,
+	}
+	// Notifications are best effort and must not hold up the deployment or its review
+	go s.notifyDeploymentApproval(context.WithoutCancel(ctx), webhookURL, notification)
+}
+
+func (s *agentManagerService) notifyDeploymentApproval(ctx context.Context, webhookURL string, notification deploymentApprovalNotification) {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		s.logger.Warn("Failed to encode deployment approval notification", "approvalId", notification.ApprovalID, "error", err)
+		return
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, webhookURL, bytes.NewReader(body))
+	if err != nil {
+		s.logger.Warn("Failed to create deployment approval notification", "approvalId", notification.ApprovalID, "error", err)
+		return
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	resp, err := deploymentApprovalWebhookClient.Do(httpReq)
+	if err != nil {
+		s.logger.Warn("Failed to send deployment approval notification", "approvalId", notification.ApprovalID, "error", err)
+		return
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		s.logger.Warn("Deployment approval webhook rejected the notification", "approvalId", notification.ApprovalID, "status", resp.StatusCode)
+	}
+}
+
+func getDeploymentApprovalPolicy(tx *gorm.DB, orgName string) (*models.DeploymentApprovalPolicy, error) {
+	var policy models.DeploymentApprovalPolicy
+	if err := tx.Where("organization_name = ?", orgName).First(&policy).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, utils.ErrDeploymentApprovalPolicyNotFound
+		}
+		return nil, fmt.Errorf("failed to get deployment approval policy: %w", err)
+	}
+	return &policy, nil
+}
+
+func getDeploymentApproval(tx *gorm.DB, orgName, approvalID string) (*models.DeploymentApproval, error) {
+	id, err := uuid.Parse(approvalID)
+	if err != nil {
+		return nil, utils.ErrDeploymentApprovalNotFound
+	}
+	var approval models.DeploymentApproval
+	if err := tx.Where("uuid = ? AND organization_name = ?", id, orgName).First(&approval).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, utils.ErrDeploymentApprovalNotFound
+		}
+		return nil, fmt.Errorf("failed to get deployment approval: %w", err)
+	}
+	return &approval, nil
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+func (s *agentManagerService) ListDeploymentRevisions(ctx context.Context, orgName string, projectName string, agentName string) ([]*models.DeploymentRevisionResponse, error) {
+	if _, err := s.ocClient.GetComponent(ctx, orgName, projectName, agentName); err != nil {
+		return nil, err
+	}
+	var revisions []models.AgentDeploymentRevision
+	if err := db.DB(ctx).
+		Where("organization_name = ? AND project_name = ? AND agent_name = ?", orgName, projectName, agentName).
+		Order("revision DESC").
+		Find(&revisions).Error; err != nil {
+		return nil, fmt.Errorf("failed to list deployment revisions: %w", err)
+	}
+	responses := make([]*models.DeploymentRevisionResponse, 0, len(revisions))
+	for i := range revisions {
+		responses = append(responses, revisions[i].ToResponse())
+	}
+	return responses, nil
+}
+
+func (s *agentManagerService) GetDeploymentRevision(ctx context.Context, orgName string, projectName string, agentName string, revision int) (*models.DeploymentRevisionResponse, error) {
+	if _, err := s.ocClient.GetComponent(ctx, orgName, projectName, agentName); err != nil {
+		return nil, err
+	}
+	rev, err := getDeploymentRevision(db.DB(ctx), orgName, projectName, agentName, revision)
+	if err != nil {
+		return nil, err
+	}
+	return rev.ToResponse(), nil
+}
+
+func (s *agentManagerService) RollbackDeployment(ctx context.Context, orgName string, projectName string, agentName string, revision int, requestedBy string, breakGlassReason string) (*models.DeploymentRevisionResponse, *models.DeploymentApprovalResponse, error) {
+	target, err := getDeploymentRevision(db.DB(ctx), orgName, projectName, agentName, revision)
+	if err != nil {
+		return nil, nil, err
+	}
+	s.logger.Info("Rolling back agent deployment", "agentName", agentName, "orgName", orgName, "projectName", projectName, "revision", revision)
+
+	req := &spec.DeployAgentRequest{ImageId: target.ImageID}
+	for _, env := range target.Env {
+		req.Env = append(req.Env, spec.EnvironmentVariable{Key: env.Key, Value: env.Value})
+	}
+	rolledBack, approval, err := s.deployAgent(ctx, orgName, projectName, agentName, req, &target.Revision, requestedBy, breakGlassReason)
+	if err != nil {
+		return nil, nil, err
+	}
+	if approval != nil {
+		return nil, approval.ToResponse(), nil
+	}
+	return rolledBack.ToResponse(), nil, nil
+}
+
+// recordDeploymentRevision stores a deployment as the next revision of the agent
+func recordDeploymentRevision(ctx context.Context, orgName, projectName, agentName, environment string, req *spec.DeployAgentRequest, rollbackOf *int) (*models.AgentDeploymentRevision, error) {
+	revision := &models.AgentDeploymentRevision{
+		UUID:             uuid.New(),
+		OrganizationName: orgName,
+		ProjectName:      projectName,
+		AgentName:        agentName,
+		Environment:      environment,
+		ImageID:          req.ImageId,
+		Env:              make([]models.EnvVars, 0, len(req.Env)),
+		RollbackOf:       rollbackOf,
+	}
+	for _, env := range req.Env {
+		revision.Env = append(revision.Env, models.EnvVars{Key: env.Key, Value: env.Value})
+	}
+	err := db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		var latest int
+		if err := tx.Model(&models.AgentDeploymentRevision{}).
+			Where("organization_name = ? AND project_name = ? AND agent_name = ?", orgName, projectName, agentName).
+			Select("COALESCE(MAX(revision), 0)").
+			Scan(&latest).Error; err != nil {
+			return err
+		}
+		revision.Revision = latest + 1
+		return tx.Create(revision).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to record deployment revision: %w", err)
+	}
+	return revision, nil
+}
+
+func getDeploymentRevision(tx *gorm.DB, orgName, projectName, agentName string, revision int) (*models.AgentDeploymentRevision, error) {
+	var rev models.AgentDeploymentRevision
+	if err := tx.Where("organization_name = ? AND project_name = ? AND agent_name = ? AND revision = ?", orgName, projectName, agentName, revision).
+		First(&rev).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, utils.ErrDeploymentRevisionNotFound
+		}
+		return nil, fmt.Errorf("failed to get deployment revision: %w", err)
+	}
+	return &rev, nil
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/openchoreosvc/client"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+const (
+	// agentInvocationTimeout bounds an invocation including a streamed response
+	agentInvocationTimeout = 5 * time.Minute
+	// agentInvocationTokenExpiry is the lifetime of the token an invocation authenticates with
+	agentInvocationTokenExpiry = "10m"
+	// agentInvocationBaggage marks the traces of invocations from the console
+	agentInvocationBaggage = "amp.invocation.source=console"
+)
+
+// agentInvocationClient sends console invocations to agents. Like the trace replay client it is not
+// instrumented, so that the traceparent of the invocation is kept. Invocations are bounded by their
+// context instead of a client timeout, which would also cut off streamed responses.
+var agentInvocationClient = &http.Client{}
+
+// agentInvocationMethods are the HTTP methods an agent can be invoked with
+var agentInvocationMethods = map[string]bool{
+	http.MethodGet:    true,
+	http.MethodPost:   true,
+	http.MethodPut:    true,
+	http.MethodPatch:  true,
+	http.MethodDelete: true,
+}
+
+// AgentInvocationService invokes deployed agents on behalf of console users, authenticating with a
+// token issued by the platform so that users do not handle agent credentials
+type AgentInvocationService interface {
+	// InvokeAgent sends a request to the agent as the start of a new trace. The caller must close the
+	// invocation, which holds the agent's response.
+	InvokeAgent(ctx context.Context, req AgentInvocationRequest) (*AgentInvocation, error)
+}
+
+// AgentInvocationRequest is a request to send to an agent
+type AgentInvocationRequest struct {
+	OrgName     string
+	ProjectName string
+	AgentName   string
+	Environment string
+	// Endpoint is the name of the agent endpoint; defaults to the first endpoint by name
+	Endpoint string
+	// Method defaults to POST
+	Method string
+	// Path is appended to the endpoint URL
+	Path        string
+	ContentType string
+	Accept      string
+	Body        io.Reader
+	RequestedBy string
+}
+
+// AgentInvocation is the response of an agent to an invocation
+type AgentInvocation struct {
+	// TraceID is the ID of the trace the invocation started
+	TraceID  string
+	Response *http.Response
+	cancel   context.CancelFunc
+}
+
+// Close releases the agent's response
+func (i *AgentInvocation) Close() error {
+	defer i.cancel()
+	return i.Response.Body.Close()
+}
+
+type agentInvocationService struct {
+	logger       *slog.Logger
+	ocClient     client.OpenChoreoClient
+	tokenService AgentTokenManagerService
+}
+
+// NewAgentInvocationService creates a new agent invocation service
+func NewAgentInvocationService(logger *slog.Logger, ocClient client.OpenChoreoClient, tokenService AgentTokenManagerService) AgentInvocationService {
+	return &agentInvocationService{
+		logger:       logger,
+		ocClient:     ocClient,
+		tokenService: tokenService,
+	}
+}
+
+func (s *agentInvocationService) InvokeAgent(ctx context.Context, req AgentInvocationRequest) (*AgentInvocation, error) {
+	if req.Environment == "" {
+		return nil, fmt.Errorf("%w: environment is required", utils.ErrInvalidInput)
+	}
+	if req.Method == "" {
+		req.Method = http.MethodPost
+	}
+	req.Method = strings.ToUpper(req.Method)
+	if !agentInvocationMethods[req.Method] {
+		return nil, fmt.Errorf("%w: method must be one of GET, POST, PUT, PATCH or DELETE", utils.ErrInvalidInput)
+	}
+	if err := validateReplayPath(req.Path); err != nil {
+		return nil, err
+	}
+
+	endpoints, err := s.ocClient.GetComponentEndpoints(ctx, req.OrgName, req.ProjectName, req.AgentName, req.Environment)
+	if err != nil {
+		s.logger.Error("Failed to get agent endpoints", "agentName", req.AgentName, "environment", req.Environment, "error", err)
+		return nil, fmt.Errorf("failed to get agent endpoints: %w", err)
+	}
+	endpointURL, err := selectReplayEndpoint(endpoints, req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	endpointURL = strings.TrimSuffix(endpointURL, "/") + req.Path
+
+	token, err := s.tokenService.GenerateToken(ctx, GenerateTokenRequest{
+		OrgName:     req.OrgName,
+		ProjectName: req.ProjectName,
+		AgentName:   req.AgentName,
+		Environment: req.Environment,
+		ExpiresIn:   agentInvocationTokenExpiry,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate agent token: %w", err)
+	}
+	traceID, spanID, err := newTraceContext()
+	if err != nil {
+		return nil, err
+	}
+
+	// The deadline covers streaming the response after this call returns; the invocation is cancelled
+	// with ctx when the console disconnects
+	invocationCtx, cancel := context.WithTimeout(ctx, agentInvocationTimeout)
+	httpReq, err := http.NewRequestWithContext(invocationCtx, req.Method, endpointURL, req.Body)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	if req.ContentType != "" {
+		httpReq.Header.Set("Content-Type", req.ContentType)
+	}
+	if req.Accept != "" {
+		httpReq.Header.Set("Accept", req.Accept)
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+token.Token)
+	httpReq.Header.Set("traceparent", fmt.Sprintf("00-%s-%s-01", traceID, spanID))
+	httpReq.Header.Set("baggage", agentInvocationBaggage)
+
+	resp, err := agentInvocationClient.Do(httpReq)
+	if err != nil {
+		cancel()
+		s.logger.Warn("Agent invocation failed", "agentName", req.AgentName, "environment", req.Environment, "traceId", traceID, "error", err)
+		return nil, fmt.Errorf("%w: %s", utils.ErrAgentInvocationFailed, err.Error())
+	}
+	s.logger.Info("Invoked agent", "agentName", req.AgentName, "environment", req.Environment, "traceId", traceID,
+		"statusCode", resp.StatusCode, "requestedBy", req.RequestedBy)
+	return &AgentInvocation{TraceID: traceID, Response: resp, cancel: cancel}, nil
+}
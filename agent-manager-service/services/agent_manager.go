@@ -18,6 +18,7 @@ package services
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -36,8 +37,12 @@ type AgentManagerService interface {
 	UpdateAgentBasicInfo(ctx context.Context, orgName string, projectName string, agentName string, req *spec.UpdateAgentBasicInfoRequest) (*models.AgentResponse, error)
 	UpdateAgentBuildParameters(ctx context.Context, orgName string, projectName string, agentName string, req *spec.UpdateAgentBuildParametersRequest) (*models.AgentResponse, error)
 	BuildAgent(ctx context.Context, orgName string, projectName string, agentName string, commitId string) (*models.BuildResponse, error)
-	DeleteAgent(ctx context.Context, orgName string, projectName string, agentName string) error
-	DeployAgent(ctx context.Context, orgName string, projectName string, agentName string, req *spec.DeployAgentRequest) (string, error)
+	// DeleteAgent deletes an agent. During a change freeze, deletions need a break-glass reason.
+	DeleteAgent(ctx context.Context, orgName string, projectName string, agentName string, requestedBy string, breakGlassReason string) error
+	// DeployAgent deploys an agent to the lowest environment of its pipeline and returns the environment. A
+	// production deployment of an organization with an approval policy is not deployed but returned as a
+	// pending approval instead. During a change freeze, deployments need a break-glass reason.
+	DeployAgent(ctx context.Context, orgName string, projectName string, agentName string, req *spec.DeployAgentRequest, requestedBy string, breakGlassReason string) (string, *models.DeploymentApprovalResponse, error)
 	GetAgent(ctx context.Context, orgName string, projectName string, agentName string) (*models.AgentResponse, error)
 	ListAgentBuilds(ctx context.Context, orgName string, projectName string, agentName string, limit int32, offset int32) ([]*models.BuildResponse, int32, error)
 	GetBuild(ctx context.Context, orgName string, projectName string, agentName string, buildName string) (*models.BuildDetailsResponse, error)
@@ -50,6 +55,21 @@ type AgentManagerService interface {
 	GetAgentRuntimeLogs(ctx context.Context, orgName string, projectName string, agentName string, payload spec.LogFilterRequest) (*models.LogsResponse, error)
 	GetAgentResourceConfigs(ctx context.Context, orgName string, projectName string, agentName string, environment string) (*spec.AgentResourceConfigsResponse, error)
 	UpdateAgentResourceConfigs(ctx context.Context, orgName string, projectName string, agentName string, environment string, req *spec.UpdateAgentResourceConfigsRequest) (*spec.AgentResourceConfigsResponse, error)
+	ListDeploymentRevisions(ctx context.Context, orgName string, projectName string, agentName string) ([]*models.DeploymentRevisionResponse, error)
+	GetDeploymentRevision(ctx context.Context, orgName string, projectName string, agentName string, revision int) (*models.DeploymentRevisionResponse, error)
+	// RollbackDeployment redeploys the image and environment variables of a previous revision as a new
+	// revision, or returns a pending approval if the redeployment needs one
+	RollbackDeployment(ctx context.Context, orgName string, projectName string, agentName string, revision int, requestedBy string, breakGlassReason string) (*models.DeploymentRevisionResponse, *models.DeploymentApprovalResponse, error)
+	GetDeploymentApprovalPolicy(ctx context.Context, orgName string) (*models.DeploymentApprovalPolicyResponse, error)
+	// SetDeploymentApprovalPolicy sets the reviewers of production deployments, turning approvals on
+	SetDeploymentApprovalPolicy(ctx context.Context, orgName string, req *models.DeploymentApprovalPolicyRequest, updatedBy string) (*models.DeploymentApprovalPolicyResponse, error)
+	// DeleteDeploymentApprovalPolicy turns approvals off; deployments already waiting for approval can no longer be reviewed
+	DeleteDeploymentApprovalPolicy(ctx context.Context, orgName string) error
+	ListDeploymentApprovals(ctx context.Context, orgName string, status string) (*models.DeploymentApprovalListResponse, error)
+	GetDeploymentApproval(ctx context.Context, orgName string, approvalID string) (*models.DeploymentApprovalResponse, error)
+	// ApproveDeployment approves a pending deployment and deploys it
+	ApproveDeployment(ctx context.Context, orgName string, approvalID string, reviewer string, req *models.DeploymentReviewRequest, breakGlassReason string) (*models.DeploymentApprovalResponse, error)
+	RejectDeployment(ctx context.Context, orgName string, approvalID string, reviewer string, req *models.DeploymentReviewRequest) (*models.DeploymentApprovalResponse, error)
 }
 
 type agentManagerService struct {
@@ -57,6 +77,9 @@ type agentManagerService struct {
 	observabilitySvcClient observabilitysvc.ObservabilitySvcClient
 	gitRepositoryService   RepositoryService
 	tokenManagerService    AgentTokenManagerService
+	mcpServerService       MCPServerService
+	eventService           ResourceEventService
+	changeFreezeService    ChangeFreezeService
 	logger                 *slog.Logger
 }
 
@@ -65,6 +88,9 @@ func NewAgentManagerService(
 	observabilitySvcClient observabilitysvc.ObservabilitySvcClient,
 	gitRepositoryService RepositoryService,
 	tokenManagerService AgentTokenManagerService,
+	mcpServerService MCPServerService,
+	eventService ResourceEventService,
+	changeFreezeService ChangeFreezeService,
 	logger *slog.Logger,
 ) AgentManagerService {
 	return &agentManagerService{
@@ -72,6 +98,9 @@ func NewAgentManagerService(
 		observabilitySvcClient: observabilitySvcClient,
 		gitRepositoryService:   gitRepositoryService,
 		tokenManagerService:    tokenManagerService,
+		mcpServerService:       mcpServerService,
+		eventService:           eventService,
+		changeFreezeService:    changeFreezeService,
 		logger:                 logger,
 	}
 }
@@ -842,8 +871,11 @@ func (s *agentManagerService) generateUniqueAgentName(ctx context.Context, orgNa
 	return uniqueName, nil
 }
 
-func (s *agentManagerService) DeleteAgent(ctx context.Context, orgName string, projectName string, agentName string) error {
+func (s *agentManagerService) DeleteAgent(ctx context.Context, orgName string, projectName string, agentName string, requestedBy string, breakGlassReason string) error {
 	s.logger.Info("Deleting agent", "agentName", agentName, "orgName", orgName, "projectName", projectName)
+	if err := s.changeFreezeService.CheckChangeAllowed(ctx, orgName, models.ChangeOperationAgentDeletion, projectName+"/"+agentName, requestedBy, breakGlassReason); err != nil {
+		return err
+	}
 	// Validate organization exists
 	_, err := s.ocClient.GetOrganization(ctx, orgName)
 	if err != nil {
@@ -908,22 +940,68 @@ func (s *agentManagerService) BuildAgent(ctx context.Context, orgName string, pr
 }
 
 // DeployAgent deploys an agent.
-func (s *agentManagerService) DeployAgent(ctx context.Context, orgName string, projectName string, agentName string, req *spec.DeployAgentRequest) (string, error) {
+func (s *agentManagerService) DeployAgent(ctx context.Context, orgName string, projectName string, agentName string, req *spec.DeployAgentRequest, requestedBy string, breakGlassReason string) (string, *models.DeploymentApprovalResponse, error) {
+	revision, approval, err := s.deployAgent(ctx, orgName, projectName, agentName, req, nil, requestedBy, breakGlassReason)
+	if err != nil {
+		return "", nil, err
+	}
+	if approval != nil {
+		return approval.Environment, approval.ToResponse(), nil
+	}
+	return revision.Environment, nil, nil
+}
+
+// deployAgent deploys an agent and records the deployment as a new revision. rollbackOf is the
+// revision being redeployed, if any. A deployment that needs approval is not deployed; the pending
+// approval is returned instead.
+func (s *agentManagerService) deployAgent(ctx context.Context, orgName string, projectName string, agentName string, req *spec.DeployAgentRequest, rollbackOf *int, requestedBy string, breakGlassReason string) (*models.AgentDeploymentRevision, *models.DeploymentApproval, error) {
 	s.logger.Info("Deploying agent", "agentName", agentName, "orgName", orgName, "projectName", projectName, "imageId", req.ImageId)
+	operation := models.ChangeOperationDeploy
+	if rollbackOf != nil {
+		operation = models.ChangeOperationRollback
+	}
+	if err := s.changeFreezeService.CheckChangeAllowed(ctx, orgName, operation, projectName+"/"+agentName, requestedBy, breakGlassReason); err != nil {
+		return nil, nil, err
+	}
 	org, err := s.ocClient.GetOrganization(ctx, orgName)
 	if err != nil {
 		s.logger.Error("Failed to find organization", "orgName", orgName, "error", err)
-		return "", err
+		return nil, nil, err
 	}
 	agent, err := s.ocClient.GetComponent(ctx, org.Name, projectName, agentName)
 	if err != nil {
 		s.logger.Error("Failed to fetch agent from OpenChoreo", "agentName", agentName, "error", err)
-		return "", err
+		return nil, nil, err
 	}
 	if agent.Provisioning.Type != string(utils.InternalAgent) {
-		return "", fmt.Errorf("deploy operation is not supported for agent type: '%s'", agent.Provisioning.Type)
+		return nil, nil, fmt.Errorf("deploy operation is not supported for agent type: '%s'", agent.Provisioning.Type)
+	}
+
+	// Get deployment pipeline from project
+	pipeline, err := s.ocClient.GetProjectDeploymentPipeline(ctx, orgName, projectName)
+	if err != nil {
+		s.logger.Error("Failed to fetch deployment pipeline", "orgName", orgName, "projectName", projectName, "error", err)
+		return nil, nil, fmt.Errorf("failed to fetch deployment pipeline: %w", err)
+	}
+	lowestEnv := findLowestEnvironment(pipeline.PromotionPaths)
+
+	approval, err := s.requestDeploymentApproval(ctx, orgName, projectName, agentName, lowestEnv, req, rollbackOf, requestedBy)
+	if err != nil {
+		return nil, nil, err
+	}
+	if approval != nil {
+		return nil, approval, nil
 	}
 
+	revision, err := s.deployToEnvironment(ctx, orgName, projectName, agentName, lowestEnv, req, rollbackOf)
+	if err != nil {
+		return nil, nil, err
+	}
+	return revision, nil, nil
+}
+
+// deployToEnvironment deploys an agent component in OpenChoreo and records the deployment as a new revision
+func (s *agentManagerService) deployToEnvironment(ctx context.Context, orgName, projectName, agentName, environment string, req *spec.DeployAgentRequest, rollbackOf *int) (*models.AgentDeploymentRevision, error) {
 	// Convert to deploy request
 	deployReq := client.DeployRequest{
 		ImageID: req.ImageId,
@@ -937,23 +1015,57 @@ func (s *agentManagerService) DeployAgent(ctx context.Context, orgName string, p
 			}
 		}
 	}
+	mcpEnv, err := s.mcpServersEnvVar(ctx, orgName, projectName, agentName)
+	if err != nil {
+		return nil, err
+	}
+	if mcpEnv != nil {
+		deployReq.Env = append(deployReq.Env, *mcpEnv)
+	}
 
 	// Deploy agent component in OpenChoreo
 	s.logger.Debug("Deploying agent component in OpenChoreo", "agentName", agentName, "orgName", orgName, "projectName", projectName, "imageId", req.ImageId)
+	result := deploymentResultEmail{
+		OrganizationName: orgName,
+		ProjectName:      projectName,
+		AgentName:        agentName,
+		Environment:      environment,
+		ImageID:          req.ImageId,
+	}
 	if err := s.ocClient.Deploy(ctx, orgName, projectName, agentName, deployReq); err != nil {
 		s.logger.Error("Failed to deploy agent component in OpenChoreo", "agentName", agentName, "orgName", orgName, "projectName", projectName, "error", err)
-		return "", err
+		result.Error = err.Error()
+		notifyByEmail(ctx, s.logger, orgName, models.EmailNotificationDeploymentResults, emailTemplateDeploymentResult, result)
+		return nil, err
 	}
+	s.logger.Info("Agent deployed successfully to "+environment, "agentName", agentName, "orgName", orgName, "projectName", projectName, "environment", environment)
 
-	// Get deployment pipeline from project
-	pipeline, err := s.ocClient.GetProjectDeploymentPipeline(ctx, orgName, projectName)
+	revision, err := recordDeploymentRevision(ctx, orgName, projectName, agentName, environment, req, rollbackOf)
 	if err != nil {
-		s.logger.Error("Failed to fetch deployment pipeline", "orgName", orgName, "projectName", projectName, "error", err)
-		return "", fmt.Errorf("failed to fetch deployment pipeline: %w", err)
+		s.logger.Error("Failed to record deployment revision", "agentName", agentName, "orgName", orgName, "projectName", projectName, "error", err)
+		return nil, err
 	}
-	lowestEnv := findLowestEnvironment(pipeline.PromotionPaths)
-	s.logger.Info("Agent deployed successfully to "+lowestEnv, "agentName", agentName, "orgName", org.Name, "projectName", projectName, "environment", lowestEnv)
-	return lowestEnv, nil
+	result.Succeeded = true
+	result.Revision = revision.Revision
+	notifyByEmail(ctx, s.logger, orgName, models.EmailNotificationDeploymentResults, emailTemplateDeploymentResult, result)
+	return revision, nil
+}
+
+// mcpServersEnvVar returns the environment variable listing the MCP servers bound to the agent, or nil if there are none
+func (s *agentManagerService) mcpServersEnvVar(ctx context.Context, orgName, projectName, agentName string) (*client.EnvVar, error) {
+	refs, err := s.mcpServerService.GetAgentMCPServerReferences(ctx, orgName, projectName, agentName)
+	if err != nil {
+		s.logger.Error("Failed to load MCP servers of agent", "agentName", agentName, "orgName", orgName, "projectName", projectName, "error", err)
+		return nil, err
+	}
+	if len(refs) == 0 {
+		return nil, nil
+	}
+	value, err := json.Marshal(refs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal MCP servers: %w", err)
+	}
+	return &client.EnvVar{Key: client.EnvVarMCPServers, Value: string(value)}, nil
 }
 
 func findLowestEnvironment(promotionPaths []models.PromotionPath) string {
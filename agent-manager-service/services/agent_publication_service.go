@@ -0,0 +1,502 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v3"
+	"gorm.io/gorm"
+
+	apiplatformclient "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/apiplatformsvc/client"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/openchoreosvc/client"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// agentPublicationAPIVersion is the version of the API Platform API registered for an agent
+const agentPublicationAPIVersion = "v1"
+
+// openAPIMethods are the operation keys of an OpenAPI path item
+var openAPIMethods = []string{"get", "put", "post", "delete", "patch", "head", "options"}
+
+// AgentPublicationService publishes agents to an API Platform developer portal and moves them through
+// the created, published, deprecated and retired lifecycle states
+type AgentPublicationService interface {
+	CreateAgentPublication(ctx context.Context, orgName, projectName, agentName, createdBy string, req *models.CreateAgentPublicationRequest) (*models.AgentPublicationResponse, error)
+	GetAgentPublication(ctx context.Context, orgName, projectName, agentName string) (*models.AgentPublicationResponse, error)
+	// UpdateAgentPublication updates the developer portal listing, republishing a listed agent
+	UpdateAgentPublication(ctx context.Context, orgName, projectName, agentName string, req *models.UpdateAgentPublicationRequest) (*models.AgentPublicationResponse, error)
+	// ChangeAgentPublicationLifecycle moves a publication to the requested lifecycle state
+	ChangeAgentPublicationLifecycle(ctx context.Context, orgName, projectName, agentName, status string) (*models.AgentPublicationResponse, error)
+	// DeleteAgentPublication removes a created or retired publication and its API Platform API
+	DeleteAgentPublication(ctx context.Context, orgName, projectName, agentName string) error
+	// ListAgentPublications returns the publication catalog of an organization, optionally filtered by lifecycle state
+	ListAgentPublications(ctx context.Context, orgName, status string) ([]*models.AgentPublicationResponse, error)
+}
+
+type agentPublicationService struct {
+	logger            *slog.Logger
+	ocClient          client.OpenChoreoClient
+	apiPlatformClient apiplatformclient.APIPlatformClient
+}
+
+// NewAgentPublicationService creates a new agent publication service
+func NewAgentPublicationService(
+	logger *slog.Logger,
+	ocClient client.OpenChoreoClient,
+	apiPlatformClient apiplatformclient.APIPlatformClient,
+) AgentPublicationService {
+	return &agentPublicationService{
+		logger:            logger,
+		ocClient:          ocClient,
+		apiPlatformClient: apiPlatformClient,
+	}
+}
+
+func (s *agentPublicationService) CreateAgentPublication(ctx context.Context, orgName, projectName, agentName, createdBy string, req *models.CreateAgentPublicationRequest) (*models.AgentPublicationResponse, error) {
+	if s.apiPlatformClient == nil {
+		return nil, fmt.Errorf("%w: API Platform is not configured", utils.ErrServiceUnavailable)
+	}
+	if req.Environment == "" {
+		return nil, fmt.Errorf("%w: environment is required", utils.ErrInvalidInput)
+	}
+	if req.DefinitionType == "" {
+		req.DefinitionType = models.AgentDefinitionTypeOpenAPI
+	}
+	if req.Visibility == "" {
+		req.Visibility = models.AgentPublicationVisibilityPublic
+	}
+	if err := validateAgentPublicationVisibility(req.Visibility, req.VisibleGroups); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.ocClient.GetComponent(ctx, orgName, projectName, agentName); err != nil {
+		return nil, err
+	}
+	endpoints, err := s.ocClient.GetComponentEndpoints(ctx, orgName, projectName, agentName, req.Environment)
+	if err != nil {
+		s.logger.Error("Failed to get agent endpoints", "agentName", agentName, "environment", req.Environment, "error", err)
+		return nil, fmt.Errorf("failed to get agent endpoints: %w", err)
+	}
+	endpointName, endpoint, err := selectAgentEndpoint(endpoints, req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	definition := req.Definition
+	if definition == "" && req.DefinitionType == models.AgentDefinitionTypeOpenAPI {
+		definition = endpoint.Schema.Content
+	}
+	operations, err := agentDefinitionOperations(req.DefinitionType, definition)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fail before registering anything in API Platform when the agent is already registered
+	if _, err := getAgentPublication(db.DB(ctx), orgName, projectName, agentName); err == nil {
+		return nil, utils.ErrAgentPublicationAlreadyExists
+	} else if !errors.Is(err, utils.ErrAgentPublicationNotFound) {
+		return nil, err
+	}
+
+	devPortalID := req.DevPortalID
+	if devPortalID == "" {
+		devPortal, err := s.apiPlatformClient.GetDefaultDevPortal(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the default developer portal: %w", err)
+		}
+		devPortalID = devPortal.ID
+	}
+	projectID, err := s.apiPlatformProject(ctx, projectName)
+	if err != nil {
+		return nil, err
+	}
+	api, err := s.apiPlatformClient.CreateAPI(ctx, apiplatformclient.CreateAPIRequest{
+		ProjectID:   projectID,
+		Name:        agentName,
+		Version:     agentPublicationAPIVersion,
+		Context:     fmt.Sprintf("/%s/%s", projectName, agentName),
+		Description: optionalString(req.Documentation),
+		UpstreamURL: endpoint.URL,
+		Operations:  operations,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create API for agent: %w", err)
+	}
+
+	now := time.Now()
+	publication := &models.AgentPublication{
+		UUID:             uuid.New(),
+		OrganizationName: orgName,
+		ProjectName:      projectName,
+		AgentName:        agentName,
+		EnvironmentName:  req.Environment,
+		EndpointName:     endpointName,
+		EndpointURL:      endpoint.URL,
+		DefinitionType:   req.DefinitionType,
+		Definition:       definition,
+		Documentation:    req.Documentation,
+		Visibility:       req.Visibility,
+		VisibleGroups:    req.VisibleGroups,
+		Tags:             req.Tags,
+		LifecycleStatus:  models.AgentPublicationStatusCreated,
+		APIID:            api.ID,
+		DevPortalID:      devPortalID,
+		CreatedBy:        createdBy,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+	if publication.VisibleGroups == nil {
+		publication.VisibleGroups = []string{}
+	}
+	if publication.Tags == nil {
+		publication.Tags = []string{}
+	}
+	if err := db.DB(ctx).Create(publication).Error; err != nil {
+		// Do not leave an API behind that no publication refers to
+		if deleteErr := s.apiPlatformClient.DeleteAPI(ctx, api.ID); deleteErr != nil {
+			s.logger.Warn("Failed to delete API of unsaved agent publication", "apiId", api.ID, "error", deleteErr)
+		}
+		if isUniqueViolation(err) {
+			return nil, utils.ErrAgentPublicationAlreadyExists
+		}
+		return nil, fmt.Errorf("failed to create agent publication: %w", err)
+	}
+	s.logger.Info("Registered agent for publishing", "orgName", orgName, "projectName", projectName, "agentName", agentName, "apiId", api.ID)
+	return publication.ToResponse(), nil
+}
+
+func (s *agentPublicationService) GetAgentPublication(ctx context.Context, orgName, projectName, agentName string) (*models.AgentPublicationResponse, error) {
+	publication, err := getAgentPublication(db.DB(ctx), orgName, projectName, agentName)
+	if err != nil {
+		return nil, err
+	}
+	return publication.ToResponse(), nil
+}
+
+func (s *agentPublicationService) UpdateAgentPublication(ctx context.Context, orgName, projectName, agentName string, req *models.UpdateAgentPublicationRequest) (*models.AgentPublicationResponse, error) {
+	publication, err := getAgentPublication(db.DB(ctx), orgName, projectName, agentName)
+	if err != nil {
+		return nil, err
+	}
+	if req.Documentation != nil {
+		publication.Documentation = *req.Documentation
+	}
+	if req.Visibility != nil {
+		publication.Visibility = *req.Visibility
+	}
+	if req.VisibleGroups != nil {
+		publication.VisibleGroups = req.VisibleGroups
+	}
+	if req.Tags != nil {
+		publication.Tags = req.Tags
+	}
+	if err := validateAgentPublicationVisibility(publication.Visibility, publication.VisibleGroups); err != nil {
+		return nil, err
+	}
+
+	// A listed agent is republished so that the developer portal shows the new metadata
+	if isAgentPublicationListed(publication.LifecycleStatus) {
+		if err := s.publish(ctx, publication); err != nil {
+			return nil, err
+		}
+	}
+	publication.UpdatedAt = time.Now()
+	if err := db.DB(ctx).Save(publication).Error; err != nil {
+		return nil, fmt.Errorf("failed to update agent publication: %w", err)
+	}
+	return publication.ToResponse(), nil
+}
+
+func (s *agentPublicationService) ChangeAgentPublicationLifecycle(ctx context.Context, orgName, projectName, agentName, status string) (*models.AgentPublicationResponse, error) {
+	switch status {
+	case models.AgentPublicationStatusPublished, models.AgentPublicationStatusDeprecated, models.AgentPublicationStatusRetired:
+	default:
+		return nil, fmt.Errorf("%w: status must be one of published, deprecated or retired", utils.ErrInvalidInput)
+	}
+	if s.apiPlatformClient == nil {
+		return nil, fmt.Errorf("%w: API Platform is not configured", utils.ErrServiceUnavailable)
+	}
+	publication, err := getAgentPublication(db.DB(ctx), orgName, projectName, agentName)
+	if err != nil {
+		return nil, err
+	}
+	if !isAgentPublicationTransitionAllowed(publication.LifecycleStatus, status) {
+		return nil, fmt.Errorf("%w: %s to %s", utils.ErrAgentPublicationInvalidTransition, publication.LifecycleStatus, status)
+	}
+
+	switch status {
+	case models.AgentPublicationStatusPublished:
+		if publication.LifecycleStatus == models.AgentPublicationStatusCreated {
+			if err := s.publish(ctx, publication); err != nil {
+				return nil, err
+			}
+			now := time.Now()
+			publication.PublishedAt = &now
+		}
+	case models.AgentPublicationStatusRetired:
+		if err := s.apiPlatformClient.UnpublishAPIFromDevPortal(ctx, publication.APIID, publication.DevPortalID); err != nil {
+			return nil, fmt.Errorf("failed to unpublish agent from the developer portal: %w", err)
+		}
+	}
+	if _, err := s.apiPlatformClient.UpdateAPILifeCycleStatus(ctx, publication.APIID, agentPublicationAPIStatus(status)); err != nil {
+		return nil, fmt.Errorf("failed to update API lifecycle status: %w", err)
+	}
+
+	previous := publication.LifecycleStatus
+	publication.LifecycleStatus = status
+	publication.UpdatedAt = time.Now()
+	if err := db.DB(ctx).Save(publication).Error; err != nil {
+		return nil, fmt.Errorf("failed to update agent publication: %w", err)
+	}
+	s.logger.Info("Changed agent publication lifecycle", "orgName", orgName, "projectName", projectName, "agentName", agentName, "from", previous, "to", status)
+	return publication.ToResponse(), nil
+}
+
+func (s *agentPublicationService) DeleteAgentPublication(ctx context.Context, orgName, projectName, agentName string) error {
+	publication, err := getAgentPublication(db.DB(ctx), orgName, projectName, agentName)
+	if err != nil {
+		return err
+	}
+	if isAgentPublicationListed(publication.LifecycleStatus) {
+		return utils.ErrAgentPublicationActive
+	}
+	if s.apiPlatformClient == nil {
+		return fmt.Errorf("%w: API Platform is not configured", utils.ErrServiceUnavailable)
+	}
+	if err := s.apiPlatformClient.DeleteAPI(ctx, publication.APIID); err != nil && !errors.Is(err, utils.ErrAPINotFound) {
+		return fmt.Errorf("failed to delete API of agent: %w", err)
+	}
+	if err := db.DB(ctx).Delete(publication).Error; err != nil {
+		return fmt.Errorf("failed to delete agent publication: %w", err)
+	}
+	return nil
+}
+
+func (s *agentPublicationService) ListAgentPublications(ctx context.Context, orgName, status string) ([]*models.AgentPublicationResponse, error) {
+	query := db.DB(ctx).Where("organization_name = ?", orgName)
+	if status != "" {
+		query = query.Where("lifecycle_status = ?", status)
+	}
+	var publications []models.AgentPublication
+	if err := query.Order("project_name, agent_name").Find(&publications).Error; err != nil {
+		return nil, fmt.Errorf("failed to list agent publications: %w", err)
+	}
+	responses := make([]*models.AgentPublicationResponse, len(publications))
+	for i := range publications {
+		responses[i] = publications[i].ToResponse()
+		// Catalog listings leave out the definitions, which are fetched per agent
+		responses[i].Definition = ""
+	}
+	return responses, nil
+}
+
+// publish lists the agent in its developer portal with the current metadata
+func (s *agentPublicationService) publish(ctx context.Context, publication *models.AgentPublication) error {
+	err := s.apiPlatformClient.PublishAPIToDevPortal(ctx, publication.APIID, apiplatformclient.PublishAPIRequest{
+		DevPortalID:   publication.DevPortalID,
+		Name:          publication.AgentName,
+		Description:   publication.Documentation,
+		Type:          agentPublicationAPIType(publication.DefinitionType),
+		Tags:          publication.Tags,
+		Visibility:    apiplatformclient.APIVisibility(publication.Visibility),
+		VisibleGroups: publication.VisibleGroups,
+		ProductionURL: publication.EndpointURL,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to publish agent to the developer portal: %w", err)
+	}
+	return nil
+}
+
+// apiPlatformProject returns the ID of the API Platform project named after the agent's project,
+// creating it on first use
+func (s *agentPublicationService) apiPlatformProject(ctx context.Context, projectName string) (string, error) {
+	projects, err := s.apiPlatformClient.ListProjects(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to list API Platform projects: %w", err)
+	}
+	for _, project := range projects {
+		if project.Name == projectName {
+			return project.ID, nil
+		}
+	}
+	project, err := s.apiPlatformClient.CreateProject(ctx, apiplatformclient.CreateProjectRequest{Name: projectName})
+	if err != nil {
+		return "", fmt.Errorf("failed to create API Platform project: %w", err)
+	}
+	return project.ID, nil
+}
+
+// agentDefinitionOperations validates an agent definition and returns the operations to register for it.
+// An OpenAPI document, in JSON or YAML, contributes its path operations; an A2A agent card is served
+// through the JSON-RPC endpoint at the root of the agent.
+func agentDefinitionOperations(definitionType, definition string) ([]apiplatformclient.APIOperation, error) {
+	switch definitionType {
+	case models.AgentDefinitionTypeOpenAPI:
+		if strings.TrimSpace(definition) == "" {
+			return nil, fmt.Errorf("%w: definition is required as the agent endpoint has no schema", utils.ErrInvalidInput)
+		}
+		parsed, err := parseOpenAPIOperations(definition)
+		if err != nil {
+			return nil, err
+		}
+		if len(parsed) == 0 {
+			return nil, fmt.Errorf("%w: definition has no operations", utils.ErrInvalidInput)
+		}
+		operations := make([]apiplatformclient.APIOperation, len(parsed))
+		for i, op := range parsed {
+			operations[i] = apiplatformclient.APIOperation{Method: op.Method, Path: op.Path}
+		}
+		return operations, nil
+	case models.AgentDefinitionTypeA2A:
+		var card struct {
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal([]byte(definition), &card); err != nil {
+			return nil, fmt.Errorf("%w: definition is not a valid A2A agent card: %s", utils.ErrInvalidInput, err.Error())
+		}
+		if card.Name == "" {
+			return nil, fmt.Errorf("%w: A2A agent card must have a name", utils.ErrInvalidInput)
+		}
+		return []apiplatformclient.APIOperation{{Method: "POST", Path: "/"}}, nil
+	default:
+		return nil, fmt.Errorf("%w: definitionType must be openapi or a2a", utils.ErrInvalidInput)
+	}
+}
+
+// openAPIOperation is an operation of an OpenAPI document
+type openAPIOperation struct {
+	Method      string
+	Path        string
+	OperationID string   `yaml:"operationId"`
+	Summary     string   `yaml:"summary"`
+	Description string   `yaml:"description"`
+	Tags        []string `yaml:"tags"`
+}
+
+// parseOpenAPIOperations returns the operations of an OpenAPI 3 document in JSON or YAML, ordered by path
+func parseOpenAPIOperations(definition string) ([]openAPIOperation, error) {
+	var document struct {
+		OpenAPI string                          `yaml:"openapi"`
+		Paths   map[string]map[string]yaml.Node `yaml:"paths"`
+	}
+	if err := yaml.Unmarshal([]byte(definition), &document); err != nil {
+		return nil, fmt.Errorf("%w: definition is not a valid OpenAPI document: %s", utils.ErrInvalidInput, err.Error())
+	}
+	if document.OpenAPI == "" {
+		return nil, fmt.Errorf("%w: definition is not an OpenAPI 3 document", utils.ErrInvalidInput)
+	}
+	paths := make([]string, 0, len(document.Paths))
+	for path := range document.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	var operations []openAPIOperation
+	for _, path := range paths {
+		for _, method := range openAPIMethods {
+			node, ok := document.Paths[path][method]
+			if !ok {
+				continue
+			}
+			var op openAPIOperation
+			if err := node.Decode(&op); err != nil {
+				return nil, fmt.Errorf("%w: invalid operation %s %s: %s", utils.ErrInvalidInput, strings.ToUpper(method), path, err.Error())
+			}
+			op.Method = strings.ToUpper(method)
+			op.Path = path
+			operations = append(operations, op)
+		}
+	}
+	return operations, nil
+}
+
+func validateAgentPublicationVisibility(visibility string, visibleGroups []string) error {
+	switch visibility {
+	case models.AgentPublicationVisibilityPublic, models.AgentPublicationVisibilityPrivate:
+		return nil
+	case models.AgentPublicationVisibilityRestricted:
+		if len(visibleGroups) == 0 {
+			return fmt.Errorf("%w: visibleGroups is required for restricted visibility", utils.ErrInvalidInput)
+		}
+		return nil
+	default:
+		return fmt.Errorf("%w: visibility must be one of public, private or restricted", utils.ErrInvalidInput)
+	}
+}
+
+// isAgentPublicationListed reports whether an agent in the state is shown in the developer portal
+func isAgentPublicationListed(status string) bool {
+	return status == models.AgentPublicationStatusPublished || status == models.AgentPublicationStatusDeprecated
+}
+
+// isAgentPublicationTransitionAllowed reports whether a publication can move from one lifecycle state to another.
+// A deprecated agent can be published again; a retired agent is final.
+func isAgentPublicationTransitionAllowed(from, to string) bool {
+	switch from {
+	case models.AgentPublicationStatusCreated:
+		return to == models.AgentPublicationStatusPublished
+	case models.AgentPublicationStatusPublished:
+		return to == models.AgentPublicationStatusDeprecated || to == models.AgentPublicationStatusRetired
+	case models.AgentPublicationStatusDeprecated:
+		return to == models.AgentPublicationStatusPublished || to == models.AgentPublicationStatusRetired
+	default:
+		return false
+	}
+}
+
+func agentPublicationAPIStatus(status string) apiplatformclient.APILifeCycleStatus {
+	switch status {
+	case models.AgentPublicationStatusPublished:
+		return apiplatformclient.APILifeCycleStatusPublished
+	case models.AgentPublicationStatusDeprecated:
+		return apiplatformclient.APILifeCycleStatusDeprecated
+	case models.AgentPublicationStatusRetired:
+		return apiplatformclient.APILifeCycleStatusRetired
+	default:
+		return apiplatformclient.APILifeCycleStatusCreated
+	}
+}
+
+func agentPublicationAPIType(definitionType string) string {
+	if definitionType == models.AgentDefinitionTypeA2A {
+		return "A2A"
+	}
+	return "REST"
+}
+
+func getAgentPublication(tx *gorm.DB, orgName, projectName, agentName string) (*models.AgentPublication, error) {
+	var publication models.AgentPublication
+	if err := tx.Where("organization_name = ? AND project_name = ? AND agent_name = ?", orgName, projectName, agentName).
+		First(&publication).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, utils.ErrAgentPublicationNotFound
+		}
+		return nil, fmt.Errorf("failed to get agent publication: %w", err)
+	}
+	return &publication, nil
+}
@@ -0,0 +1,272 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+const (
+	// defaultAgentSLOWindowDays is the rolling window of an objective that does not set one
+	defaultAgentSLOWindowDays = 28
+	// defaultAgentSLOHistoryInterval is the interval of history points when none is requested
+	defaultAgentSLOHistoryInterval = 24 * time.Hour
+)
+
+func (s *observabilityManagerService) CreateAgentSLO(ctx context.Context, orgName, projectName, agentName, createdBy string, req *models.AgentSLORequest) (*models.AgentSLOResponse, error) {
+	if err := validateAgentSLORequest(req); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	slo := &models.AgentSLO{
+		UUID:             uuid.New(),
+		OrganizationName: orgName,
+		ProjectName:      projectName,
+		AgentName:        agentName,
+		CreatedBy:        createdBy,
+		CreatedAt:        now,
+	}
+	applyAgentSLORequest(slo, req, now)
+	if err := db.DB(ctx).Create(slo).Error; err != nil {
+		if isUniqueViolation(err) {
+			return nil, utils.ErrAgentSLOAlreadyExists
+		}
+		return nil, fmt.Errorf("failed to save agent SLO: %w", err)
+	}
+	s.logger.Info("Created agent SLO", "agentName", agentName, "sloId", slo.UUID, "indicator", slo.Indicator, "target", slo.Target)
+	return slo.ToResponse(), nil
+}
+
+func (s *observabilityManagerService) ListAgentSLOs(ctx context.Context, orgName, projectName, agentName string) (*models.AgentSLOListResponse, error) {
+	var slos []models.AgentSLO
+	if err := db.DB(ctx).
+		Where("organization_name = ? AND project_name = ? AND agent_name = ?", orgName, projectName, agentName).
+		Order("name").
+		Find(&slos).Error; err != nil {
+		return nil, fmt.Errorf("failed to list agent SLOs: %w", err)
+	}
+	response := &models.AgentSLOListResponse{SLOs: make([]models.AgentSLOResponse, 0, len(slos))}
+	for i := range slos {
+		response.SLOs = append(response.SLOs, *slos[i].ToResponse())
+	}
+	return response, nil
+}
+
+func (s *observabilityManagerService) GetAgentSLO(ctx context.Context, orgName, projectName, agentName, sloID string) (*models.AgentSLOResponse, error) {
+	slo, err := getAgentSLO(db.DB(ctx), orgName, projectName, agentName, sloID)
+	if err != nil {
+		return nil, err
+	}
+	return slo.ToResponse(), nil
+}
+
+func (s *observabilityManagerService) UpdateAgentSLO(ctx context.Context, orgName, projectName, agentName, sloID string, req *models.AgentSLORequest) (*models.AgentSLOResponse, error) {
+	if err := validateAgentSLORequest(req); err != nil {
+		return nil, err
+	}
+	var slo *models.AgentSLO
+	err := db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		slo, err = getAgentSLO(tx, orgName, projectName, agentName, sloID)
+		if err != nil {
+			return err
+		}
+		applyAgentSLORequest(slo, req, time.Now())
+		return tx.Save(slo).Error
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, utils.ErrAgentSLOAlreadyExists
+		}
+		if errors.Is(err, utils.ErrAgentSLONotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to update agent SLO: %w", err)
+	}
+	return slo.ToResponse(), nil
+}
+
+func (s *observabilityManagerService) DeleteAgentSLO(ctx context.Context, orgName, projectName, agentName, sloID string) error {
+	return db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		slo, err := getAgentSLO(tx, orgName, projectName, agentName, sloID)
+		if err != nil {
+			return err
+		}
+		if err := tx.Delete(slo).Error; err != nil {
+			return fmt.Errorf("failed to delete agent SLO: %w", err)
+		}
+		return nil
+	})
+}
+
+func (s *observabilityManagerService) GetAgentSLOStatus(ctx context.Context, orgName, projectName, agentName, sloID string) (*models.AgentSLOStatusResponse, error) {
+	slo, params, err := s.agentSLOParams(ctx, orgName, projectName, agentName, sloID)
+	if err != nil {
+		return nil, err
+	}
+	status, err := s.traceObserverClient.GetSLOStatus(ctx, params)
+	if err != nil {
+		s.logger.Error("Failed to get SLO status", "agentName", agentName, "sloId", sloID, "error", err)
+		return nil, fmt.Errorf("failed to get SLO status: %w", err)
+	}
+
+	response := &models.AgentSLOStatusResponse{
+		SLO:           *slo.ToResponse(),
+		WindowStart:   status.WindowStart,
+		WindowEnd:     status.WindowEnd,
+		TotalCount:    status.TotalCount,
+		GoodCount:     status.GoodCount,
+		Compliance:    status.Compliance,
+		ObservedValue: status.ObservedValue,
+		ErrorBudget: models.AgentSLOErrorBudget{
+			Allowed:   status.ErrorBudget.Allowed,
+			Consumed:  status.ErrorBudget.Consumed,
+			Remaining: status.ErrorBudget.Remaining,
+		},
+		BurnRates: make([]models.AgentSLOBurnRate, 0, len(status.BurnRates)),
+		Status:    status.Status,
+	}
+	for _, burnRate := range status.BurnRates {
+		response.BurnRates = append(response.BurnRates, models.AgentSLOBurnRate{
+			Window:   burnRate.Window,
+			BurnRate: burnRate.BurnRate,
+		})
+	}
+	return response, nil
+}
+
+func (s *observabilityManagerService) GetAgentSLOHistory(ctx context.Context, orgName, projectName, agentName, sloID, interval string) (*models.AgentSLOHistoryResponse, error) {
+	slo, params, err := s.agentSLOParams(ctx, orgName, projectName, agentName, sloID)
+	if err != nil {
+		return nil, err
+	}
+	historyInterval := defaultAgentSLOHistoryInterval
+	if interval != "" {
+		historyInterval, err = time.ParseDuration(interval)
+		if err != nil || historyInterval < time.Hour || historyInterval%time.Hour != 0 {
+			return nil, fmt.Errorf("%w: interval must be a whole number of hours, e.g. 6h", utils.ErrInvalidInput)
+		}
+	}
+	if historyInterval > time.Duration(slo.WindowDays)*24*time.Hour {
+		return nil, fmt.Errorf("%w: interval must not be longer than the %d day window", utils.ErrInvalidInput, slo.WindowDays)
+	}
+	params.Interval = fmt.Sprintf("%dh", historyInterval/time.Hour)
+
+	history, err := s.traceObserverClient.GetSLOHistory(ctx, params)
+	if err != nil {
+		s.logger.Error("Failed to get SLO history", "agentName", agentName, "sloId", sloID, "error", err)
+		return nil, fmt.Errorf("failed to get SLO history: %w", err)
+	}
+
+	response := &models.AgentSLOHistoryResponse{
+		SLO:         *slo.ToResponse(),
+		WindowStart: history.WindowStart,
+		WindowEnd:   history.WindowEnd,
+		Interval:    history.Interval,
+		Points:      make([]models.AgentSLOHistoryPoint, 0, len(history.Points)),
+	}
+	for _, point := range history.Points {
+		response.Points = append(response.Points, models.AgentSLOHistoryPoint{
+			Time:            point.Time,
+			TotalCount:      point.TotalCount,
+			GoodCount:       point.GoodCount,
+			Compliance:      point.Compliance,
+			BudgetRemaining: point.BudgetRemaining,
+		})
+	}
+	return response, nil
+}
+
+// agentSLOParams loads an SLO and resolves the component and environment its traces are recorded under
+func (s *observabilityManagerService) agentSLOParams(ctx context.Context, orgName, projectName, agentName, sloID string) (*models.AgentSLO, traceobserversvc.SLOParams, error) {
+	slo, err := getAgentSLO(db.DB(ctx), orgName, projectName, agentName, sloID)
+	if err != nil {
+		return nil, traceobserversvc.SLOParams{}, err
+	}
+	component, err := s.ocClient.GetComponent(ctx, orgName, projectName, agentName)
+	if err != nil {
+		s.logger.Error("Failed to get agent component", "agentName", agentName, "error", err)
+		return nil, traceobserversvc.SLOParams{}, fmt.Errorf("failed to get agent component: %w", err)
+	}
+	environment, err := s.ocClient.GetEnvironment(ctx, orgName, slo.EnvironmentName)
+	if err != nil {
+		s.logger.Error("Failed to get environment", "environment", slo.EnvironmentName, "error", err)
+		return nil, traceobserversvc.SLOParams{}, fmt.Errorf("failed to get environment: %w", err)
+	}
+	return slo, traceobserversvc.SLOParams{
+		ComponentUid:   component.UUID,
+		EnvironmentUid: environment.UUID,
+		Indicator:      slo.Indicator,
+		ThresholdMs:    slo.ThresholdMs,
+		Target:         slo.Target,
+		WindowDays:     slo.WindowDays,
+	}, nil
+}
+
+// validateAgentSLORequest checks the rules that depend on more than one field; the fields
+// themselves are validated by the controller
+func validateAgentSLORequest(req *models.AgentSLORequest) error {
+	if req.Indicator == models.AgentSLOIndicatorLatency && req.ThresholdMs <= 0 {
+		return fmt.Errorf("%w: thresholdMs must be greater than 0 for a latency objective", utils.ErrInvalidInput)
+	}
+	return nil
+}
+
+func applyAgentSLORequest(slo *models.AgentSLO, req *models.AgentSLORequest, now time.Time) {
+	slo.Name = strings.TrimSpace(req.Name)
+	slo.EnvironmentName = req.Environment
+	slo.Indicator = req.Indicator
+	slo.ThresholdMs = 0
+	// The threshold only applies to latency objectives
+	if req.Indicator == models.AgentSLOIndicatorLatency {
+		slo.ThresholdMs = req.ThresholdMs
+	}
+	slo.Target = req.Target
+	slo.WindowDays = req.WindowDays
+	if slo.WindowDays == 0 {
+		slo.WindowDays = defaultAgentSLOWindowDays
+	}
+	slo.UpdatedAt = now
+}
+
+func getAgentSLO(tx *gorm.DB, orgName, projectName, agentName, sloID string) (*models.AgentSLO, error) {
+	id, err := uuid.Parse(sloID)
+	if err != nil {
+		return nil, utils.ErrAgentSLONotFound
+	}
+	var slo models.AgentSLO
+	if err := tx.Where("uuid = ? AND organization_name = ? AND project_name = ? AND agent_name = ?", id, orgName, projectName, agentName).
+		First(&slo).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, utils.ErrAgentSLONotFound
+		}
+		return nil, fmt.Errorf("failed to get agent SLO: %w", err)
+	}
+	return &slo, nil
+}
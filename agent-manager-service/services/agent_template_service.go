@@ -0,0 +1,177 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/gitprovider"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// ciWorkflowPath is where the scaffolded CI workflow is committed
+const ciWorkflowPath = ".github/workflows/amp-ci.yml"
+
+var (
+	repositoryNameRegex = regexp.MustCompile(`^[A-Za-z0-9._-]{1,100}$`)
+	secretNameRegex     = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+// AgentTemplateService defines the interface for the agent runtime catalog and repository scaffolding
+type AgentTemplateService interface {
+	// ListAgentTemplates returns the supported agent runtimes
+	ListAgentTemplates(ctx context.Context) []utils.AgentTemplate
+	// ScaffoldAgentRepository creates a repository from a template, stores the given CI secrets and adds a CI workflow
+	ScaffoldAgentRepository(ctx context.Context, templateName string, req *models.ScaffoldAgentRepositoryRequest) (*models.ScaffoldAgentRepositoryResponse, error)
+}
+
+type agentTemplateService struct {
+	logger *slog.Logger
+}
+
+// NewAgentTemplateService creates a new agent template service
+func NewAgentTemplateService(logger *slog.Logger) AgentTemplateService {
+	return &agentTemplateService{
+		logger: logger,
+	}
+}
+
+func (s *agentTemplateService) ListAgentTemplates(ctx context.Context) []utils.AgentTemplate {
+	return utils.AgentTemplates
+}
+
+func (s *agentTemplateService) ScaffoldAgentRepository(ctx context.Context, templateName string, req *models.ScaffoldAgentRepositoryRequest) (*models.ScaffoldAgentRepositoryResponse, error) {
+	template, ok := utils.FindAgentTemplate(templateName)
+	if !ok {
+		return nil, utils.ErrAgentTemplateNotFound
+	}
+	if err := validateScaffoldAgentRepositoryRequest(req); err != nil {
+		return nil, err
+	}
+
+	// Create provider with server-side token configuration
+	provider, err := gitprovider.NewProvider(gitprovider.ProviderGitHub, getGitProviderConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	private := true
+	if req.Private != nil {
+		private = *req.Private
+	}
+	repo, err := provider.CreateRepositoryFromTemplate(ctx, gitprovider.CreateRepositoryFromTemplateOptions{
+		TemplateOwner: config.GetConfig().GitHub.TemplateOwner,
+		TemplateRepo:  template.TemplateRepository,
+		Owner:         req.Owner,
+		Name:          req.Name,
+		Description:   req.Description,
+		Private:       private,
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info("Created agent repository from template", "template", template.Name, "repository", repo.URL)
+
+	// Secrets are set in a stable order so that a failure is reported for the same secret on retries
+	secretNames := make([]string, 0, len(req.Secrets))
+	for name := range req.Secrets {
+		secretNames = append(secretNames, name)
+	}
+	sort.Strings(secretNames)
+	for _, name := range secretNames {
+		if err := provider.SetRepositorySecret(ctx, repo.Owner, repo.Name, name, req.Secrets[name]); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := provider.CreateFile(ctx, repo.Owner, repo.Name, gitprovider.CreateFileOptions{
+		Path:    ciWorkflowPath,
+		Content: []byte(ciWorkflow(template)),
+		Message: "Add Agent Manager CI workflow",
+		Branch:  repo.DefaultBranch,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &models.ScaffoldAgentRepositoryResponse{
+		Template:        template.Name,
+		RepositoryURL:   repo.URL,
+		CloneURL:        repo.CloneURL,
+		DefaultBranch:   repo.DefaultBranch,
+		Secrets:         secretNames,
+		WorkflowPath:    ciWorkflowPath,
+		Language:        template.Language,
+		LanguageVersion: template.LanguageVersion,
+		RunCommand:      template.RunCommand,
+	}, nil
+}
+
+func validateScaffoldAgentRepositoryRequest(req *models.ScaffoldAgentRepositoryRequest) error {
+	if strings.TrimSpace(req.Owner) == "" {
+		return fmt.Errorf("%w: owner is required", utils.ErrInvalidInput)
+	}
+	if !repositoryNameRegex.MatchString(req.Name) {
+		return fmt.Errorf("%w: repository name must be 1-100 letters, digits, '.', '-' or '_'", utils.ErrInvalidInput)
+	}
+	for name := range req.Secrets {
+		if !secretNameRegex.MatchString(name) || strings.HasPrefix(strings.ToUpper(name), "GITHUB_") {
+			return fmt.Errorf("%w: invalid secret name %q", utils.ErrInvalidInput, name)
+		}
+	}
+	return nil
+}
+
+// ciWorkflow returns a GitHub Actions workflow that installs dependencies and runs the tests of the agent
+func ciWorkflow(template utils.AgentTemplate) string {
+	var steps string
+	switch template.Language {
+	case string(utils.LanguageNodeJS):
+		steps = fmt.Sprintf(`      - uses: actions/setup-node@v4
+        with:
+          node-version: "%s"
+      - run: npm ci
+      - run: npm test --if-present
+`, strings.TrimSuffix(template.LanguageVersion, ".x.x"))
+	default:
+		steps = fmt.Sprintf(`      - uses: actions/setup-python@v5
+        with:
+          python-version: "%s"
+      - run: pip install -r requirements.txt
+      - run: if [ -d tests ]; then pip install pytest && pytest; fi
+`, strings.TrimSuffix(template.LanguageVersion, ".x"))
+	}
+	return `name: Agent CI
+
+on:
+  push:
+    branches: [main]
+  pull_request:
+
+jobs:
+  test:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/checkout@v4
+` + steps
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+)
+
+// recordAgentTokenExpiry remembers when an agent API key expires, so that the organization can be
+// warned before it does
+func recordAgentTokenExpiry(ctx context.Context, orgName, projectName, agentName, environment string, expiresAt time.Time) error {
+	expiry := &models.AgentTokenExpiry{
+		UUID:             uuid.New(),
+		OrganizationName: orgName,
+		ProjectName:      projectName,
+		AgentName:        agentName,
+		Environment:      environment,
+		ExpiresAt:        expiresAt,
+		CreatedAt:        time.Now(),
+	}
+	if err := db.DB(ctx).Create(expiry).Error; err != nil {
+		return fmt.Errorf("failed to record agent token expiry: %w", err)
+	}
+	return nil
+}
+
+func (s *observabilityManagerService) RunAgentTokenExpiryNotifier(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.notifyExpiringAgentTokens(ctx)
+		}
+	}
+}
+
+// notifyExpiringAgentTokens emails a warning for each agent API key of an active organization that
+// expires within the warning period. A key is skipped when a later key was issued for the same agent
+// and environment, as it has been replaced. Keys are forgotten once they expire.
+func (s *observabilityManagerService) notifyExpiringAgentTokens(ctx context.Context) {
+	now := time.Now()
+	if err := db.DB(ctx).Where("expires_at <= ?", now).Delete(&models.AgentTokenExpiry{}).Error; err != nil {
+		s.logger.Error("Failed to remove expired agent token expiries", "error", err)
+	}
+
+	warnBefore := time.Duration(config.GetConfig().Email.TokenExpiryWarningDays) * 24 * time.Hour
+	var expiries []models.AgentTokenExpiry
+	if err := db.DB(ctx).
+		Where("notified_at IS NULL AND expires_at <= ?", now.Add(warnBefore)).
+		Where("organization_name IN (?)", db.DB(ctx).Model(&models.Organization{}).Select("name").Where("status = ?", models.OrganizationStatusActive)).
+		Order("expires_at").
+		Find(&expiries).Error; err != nil {
+		s.logger.Error("Failed to load expiring agent tokens", "error", err)
+		return
+	}
+	for i := range expiries {
+		if ctx.Err() != nil {
+			return
+		}
+		expiry := &expiries[i]
+		// Claim the key, so that it is warned about once when several replicas run the notifier
+		result := db.DB(ctx).Model(&models.AgentTokenExpiry{}).
+			Where("uuid = ? AND notified_at IS NULL", expiry.UUID).
+			Update("notified_at", now)
+		if result.Error != nil {
+			s.logger.Error("Failed to claim agent token expiry", "expiryId", expiry.UUID, "error", result.Error)
+			continue
+		}
+		if result.RowsAffected == 0 {
+			continue
+		}
+
+		var replacements int64
+		if err := db.DB(ctx).Model(&models.AgentTokenExpiry{}).
+			Where("organization_name = ? AND project_name = ? AND agent_name = ? AND environment = ? AND expires_at > ?",
+				expiry.OrganizationName, expiry.ProjectName, expiry.AgentName, expiry.Environment, expiry.ExpiresAt).
+			Count(&replacements).Error; err != nil {
+			s.logger.Error("Failed to look up replacements of agent token", "expiryId", expiry.UUID, "error", err)
+		}
+		if replacements > 0 {
+			continue
+		}
+
+		sent, err := emailOrganization(ctx, expiry.OrganizationName, models.EmailNotificationTokenExpiry, emailTemplateTokenExpiry, tokenExpiryEmail{
+			OrganizationName: expiry.OrganizationName,
+			ProjectName:      expiry.ProjectName,
+			AgentName:        expiry.AgentName,
+			Environment:      expiry.Environment,
+			ExpiresAt:        expiry.ExpiresAt,
+			DaysLeft:         int(expiry.ExpiresAt.Sub(now).Hours() / 24),
+		})
+		if err != nil && sent == 0 {
+			s.logger.Warn("Failed to email agent token expiry; it is retried at the next interval",
+				"orgName", expiry.OrganizationName, "agentName", expiry.AgentName, "error", err)
+			// Release the key so that the warning is sent again
+			if releaseErr := db.DB(ctx).Model(&models.AgentTokenExpiry{}).Where("uuid = ?", expiry.UUID).
+				Update("notified_at", nil).Error; releaseErr != nil {
+				s.logger.Error("Failed to release agent token expiry", "expiryId", expiry.UUID, "error", releaseErr)
+			}
+			continue
+		}
+		if err != nil {
+			s.logger.Warn("Failed to email agent token expiry to some recipients", "orgName", expiry.OrganizationName, "agentName", expiry.AgentName, "error", err)
+		}
+		s.logger.Info("Warned of expiring agent token", "orgName", expiry.OrganizationName, "projectName", expiry.ProjectName,
+			"agentName", expiry.AgentName, "environment", expiry.Environment, "expiresAt", expiry.ExpiresAt, "recipients", sent)
+	}
+}
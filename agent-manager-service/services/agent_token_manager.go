@@ -310,6 +310,9 @@ func (s *agentTokenManagerService) GenerateToken(ctx context.Context, req Genera
 		"expiresAt", expiresAt,
 		"keyID", keyPair.KeyID,
 	)
+	if err := recordAgentTokenExpiry(ctx, req.OrgName, req.ProjectName, req.AgentName, environmentName, expiresAt); err != nil {
+		s.logger.Warn("Failed to record token expiry; no expiry warning is emailed for the token", "agentName", req.AgentName, "error", err)
+	}
 
 	return &spec.TokenResponse{
 		Token:     signedToken,
@@ -0,0 +1,565 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	apiplatformclient "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/apiplatformsvc/client"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// ApplyService reconciles the platform resources of an organization against a declarative manifest
+type ApplyService interface {
+	Apply(ctx context.Context, orgName string, manifest *models.ApplyManifest, opts models.ApplyOptions) (*models.ApplyPlan, error)
+}
+
+type applyService struct {
+	logger            *slog.Logger
+	apiPlatformClient apiplatformclient.APIPlatformClient
+}
+
+// NewApplyService creates a new apply service
+func NewApplyService(logger *slog.Logger, apiPlatformClient apiplatformclient.APIPlatformClient) ApplyService {
+	return &applyService{
+		logger:            logger,
+		apiPlatformClient: apiPlatformClient,
+	}
+}
+
+// applyState is the current state of the resources managed by the apply API
+type applyState struct {
+	environments map[string]models.Environment
+	gateways     map[string]*apiplatformclient.GatewayResponse
+	// allGateways holds the gateways of every organization, since a vhost can only be served once
+	allGateways []*apiplatformclient.GatewayResponse
+	// gatewayEnvironments maps a gateway name to the names of the environments it is assigned to
+	gatewayEnvironments map[string][]string
+}
+
+func (s *applyService) Apply(ctx context.Context, orgName string, manifest *models.ApplyManifest, opts models.ApplyOptions) (*models.ApplyPlan, error) {
+	s.logger.Info("Applying manifest", "orgName", orgName, "dryRun", opts.DryRun, "prune", opts.Prune)
+
+	if err := validateApplyManifest(manifest); err != nil {
+		return nil, err
+	}
+	if s.apiPlatformClient == nil && (len(manifest.Gateways) > 0 || opts.Prune) {
+		return nil, fmt.Errorf("%w: gateway management is not enabled", utils.ErrServiceUnavailable)
+	}
+
+	state, err := s.loadState(ctx, orgName)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := planApply(manifest, state, opts.Prune)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := models.NewApplyPlan(changes, opts.DryRun)
+	if opts.DryRun {
+		return plan, nil
+	}
+
+	if err := s.execute(ctx, orgName, manifest, state, changes); err != nil {
+		return nil, err
+	}
+	plan.Applied = true
+
+	s.logger.Info("Manifest applied successfully", "orgName", orgName, "changes", len(changes))
+	return plan, nil
+}
+
+func (s *applyService) loadState(ctx context.Context, orgName string) (*applyState, error) {
+	state := &applyState{
+		environments:        make(map[string]models.Environment),
+		gateways:            make(map[string]*apiplatformclient.GatewayResponse),
+		gatewayEnvironments: make(map[string][]string),
+	}
+
+	// The plan is computed from the primary so that it is not based on stale replica state
+	var environments []models.Environment
+	if err := db.Primary(db.DB(ctx)).Where("organization_name = ?", orgName).Find(&environments).Error; err != nil {
+		return nil, fmt.Errorf("failed to list environments: %w", err)
+	}
+	envNamesByUUID := make(map[uuid.UUID]string, len(environments))
+	for _, env := range environments {
+		state.environments[env.Name] = env
+		envNamesByUUID[env.UUID] = env.Name
+	}
+
+	if s.apiPlatformClient == nil {
+		return state, nil
+	}
+
+	gateways, err := s.apiPlatformClient.ListGateways(ctx, apiplatformclient.GatewayFilters{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list gateways: %w", err)
+	}
+	state.allGateways = gateways.Gateways
+
+	gatewaysByUUID := make(map[uuid.UUID]*apiplatformclient.GatewayResponse, len(gateways.Gateways))
+	gatewayUUIDs := make([]uuid.UUID, 0, len(gateways.Gateways))
+	for _, gw := range gateways.Gateways {
+		gwUUID, err := uuid.Parse(gw.ID)
+		if err != nil {
+			continue
+		}
+		gatewaysByUUID[gwUUID] = gw
+		gatewayUUIDs = append(gatewayUUIDs, gwUUID)
+	}
+	var mappings []models.GatewayEnvironmentMapping
+	if len(gatewayUUIDs) > 0 {
+		if err := db.Primary(db.DB(ctx)).Where("gateway_uuid IN ?", gatewayUUIDs).Find(&mappings).Error; err != nil {
+			return nil, fmt.Errorf("failed to list gateway environments: %w", err)
+		}
+	}
+	gatewayEnvNames := make(map[string][]string)
+	for _, mapping := range mappings {
+		if envName, ok := envNamesByUUID[mapping.EnvironmentUUID]; ok {
+			gw := gatewaysByUUID[mapping.GatewayUUID]
+			gatewayEnvNames[gw.Name] = append(gatewayEnvNames[gw.Name], envName)
+		}
+	}
+
+	// Only the gateways of the organization are managed, so that a prune never deletes the
+	// gateways of another one. Gateways registered before their organization was recorded belong
+	// to the organization whose environments they serve.
+	for _, gw := range gateways.Gateways {
+		if GatewayBelongsToOrganization(gw, orgName, len(gatewayEnvNames[gw.Name]) > 0) {
+			state.gateways[gw.Name] = gw
+			state.gatewayEnvironments[gw.Name] = gatewayEnvNames[gw.Name]
+		}
+	}
+
+	return state, nil
+}
+
+// execute applies the planned changes. Gateways live in the API Platform and cannot take part
+// in the database transaction, so they are created and updated first and newly created gateways
+// are deleted again if the transaction fails. Gateway deletions happen only after the
+// transaction commits since they cannot be undone.
+func (s *applyService) execute(ctx context.Context, orgName string, manifest *models.ApplyManifest, state *applyState, changes []models.ApplyChange) error {
+	gatewayManifests := make(map[string]models.GatewayManifest, len(manifest.Gateways))
+	for _, gw := range manifest.Gateways {
+		gatewayManifests[gw.Name] = gw
+	}
+	envManifests := make(map[string]models.EnvironmentManifest, len(manifest.Environments))
+	for _, env := range manifest.Environments {
+		envManifests[env.Name] = env
+	}
+
+	gatewayIDs := make(map[string]string, len(state.gateways))
+	for name, gw := range state.gateways {
+		gatewayIDs[name] = gw.ID
+	}
+
+	var createdGateways []string
+	var deletedGateways []string
+	for _, change := range changes {
+		if change.ResourceType != models.ApplyResourceTypeGateway {
+			continue
+		}
+		gw := gatewayManifests[change.Name]
+		switch change.Action {
+		case models.ApplyActionCreate:
+			created, err := s.apiPlatformClient.CreateGateway(ctx, apiplatformclient.CreateGatewayRequest{
+				Name:              gw.Name,
+				DisplayName:       gw.DisplayName,
+				Vhost:             gw.Vhost,
+				FunctionalityType: manifestGatewayFunctionalityType(gw.GatewayType),
+				Description:       utils.StrAsStrPointer(gw.Description),
+				IsCritical:        &gw.IsCritical,
+				Properties:        GatewayProperties(orgName, nil),
+			})
+			if err != nil {
+				s.rollbackCreatedGateways(ctx, createdGateways)
+				return fmt.Errorf("failed to create gateway %q: %w", gw.Name, err)
+			}
+			gatewayIDs[gw.Name] = created.ID
+			createdGateways = append(createdGateways, created.ID)
+		case models.ApplyActionUpdate:
+			if _, err := s.apiPlatformClient.UpdateGateway(ctx, gatewayIDs[gw.Name], apiplatformclient.UpdateGatewayRequest{
+				DisplayName: &gw.DisplayName,
+				Description: &gw.Description,
+				IsCritical:  &gw.IsCritical,
+			}); err != nil {
+				s.rollbackCreatedGateways(ctx, createdGateways)
+				return fmt.Errorf("failed to update gateway %q: %w", gw.Name, err)
+			}
+		case models.ApplyActionDelete:
+			deletedGateways = append(deletedGateways, change.Name)
+		}
+	}
+
+	err := db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		envUUIDs := make(map[string]uuid.UUID, len(state.environments))
+		for name, env := range state.environments {
+			envUUIDs[name] = env.UUID
+		}
+
+		for _, change := range changes {
+			if change.ResourceType != models.ApplyResourceTypeEnvironment {
+				continue
+			}
+			desired := envManifests[change.Name]
+			switch change.Action {
+			case models.ApplyActionCreate:
+				now := time.Now()
+				env := &models.Environment{
+					UUID:             uuid.New(),
+					OrganizationName: orgName,
+					Name:             desired.Name,
+					DisplayName:      desired.DisplayName,
+					Description:      desired.Description,
+					DataplaneRef:     desired.DataplaneRef,
+					DNSPrefix:        desired.DNSPrefix,
+					IsProduction:     desired.IsProduction,
+					CreatedAt:        now,
+					UpdatedAt:        now,
+				}
+				if err := tx.Create(env).Error; err != nil {
+					return fmt.Errorf("failed to create environment %q: %w", desired.Name, err)
+				}
+				envUUIDs[desired.Name] = env.UUID
+			case models.ApplyActionUpdate:
+				if err := tx.Model(&models.Environment{}).
+					Where("uuid = ? AND organization_name = ?", envUUIDs[desired.Name], orgName).
+					Updates(map[string]interface{}{
+						"display_name": desired.DisplayName,
+						"description":  desired.Description,
+						"updated_at":   time.Now(),
+					}).Error; err != nil {
+					return fmt.Errorf("failed to update environment %q: %w", desired.Name, err)
+				}
+			}
+		}
+
+		// Gateway-environment assignments are reconciled before environments are deleted so that
+		// environments released by the manifest no longer have gateways attached.
+		for _, gw := range manifest.Gateways {
+			if err := syncGatewayEnvironments(tx, gatewayIDs[gw.Name], gw.Environments, envUUIDs); err != nil {
+				return fmt.Errorf("failed to assign environments to gateway %q: %w", gw.Name, err)
+			}
+		}
+		for _, name := range deletedGateways {
+			if err := syncGatewayEnvironments(tx, gatewayIDs[name], nil, envUUIDs); err != nil {
+				return fmt.Errorf("failed to remove environments of gateway %q: %w", name, err)
+			}
+		}
+
+		for _, change := range changes {
+			if change.ResourceType != models.ApplyResourceTypeEnvironment || change.Action != models.ApplyActionDelete {
+				continue
+			}
+			var count int64
+			if err := tx.Model(&models.GatewayEnvironmentMapping{}).Where("environment_uuid = ?", envUUIDs[change.Name]).Count(&count).Error; err != nil {
+				return fmt.Errorf("failed to check gateway associations: %w", err)
+			}
+			if count > 0 {
+				return fmt.Errorf("%w: %s", utils.ErrEnvironmentHasGateways, change.Name)
+			}
+			if err := tx.Where("uuid = ? AND organization_name = ?", envUUIDs[change.Name], orgName).Delete(&models.Environment{}).Error; err != nil {
+				return fmt.Errorf("failed to delete environment %q: %w", change.Name, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("Failed to apply manifest", "orgName", orgName, "error", err)
+		s.rollbackCreatedGateways(ctx, createdGateways)
+		return err
+	}
+
+	for _, name := range deletedGateways {
+		if err := s.apiPlatformClient.DeleteGateway(ctx, gatewayIDs[name]); err != nil {
+			return fmt.Errorf("failed to delete gateway %q: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *applyService) rollbackCreatedGateways(ctx context.Context, gatewayIDs []string) {
+	for _, id := range gatewayIDs {
+		if err := s.apiPlatformClient.DeleteGateway(ctx, id); err != nil {
+			s.logger.Error("Failed to roll back created gateway", "gatewayID", id, "error", err)
+		}
+	}
+}
+
+// syncGatewayEnvironments makes the environment mappings of a gateway match the given environment names
+func syncGatewayEnvironments(tx *gorm.DB, gatewayID string, envNames []string, envUUIDs map[string]uuid.UUID) error {
+	gwUUID, err := uuid.Parse(gatewayID)
+	if err != nil {
+		return fmt.Errorf("invalid gateway UUID: %w", err)
+	}
+
+	desired := make(map[uuid.UUID]bool, len(envNames))
+	for _, name := range envNames {
+		desired[envUUIDs[name]] = true
+	}
+
+	var existing []models.GatewayEnvironmentMapping
+	if err := tx.Where("gateway_uuid = ?", gwUUID).Find(&existing).Error; err != nil {
+		return err
+	}
+	managed := make(map[uuid.UUID]bool, len(envUUIDs))
+	for _, id := range envUUIDs {
+		managed[id] = true
+	}
+	for _, mapping := range existing {
+		if desired[mapping.EnvironmentUUID] {
+			delete(desired, mapping.EnvironmentUUID)
+			continue
+		}
+		// Mappings to environments of other organizations are left untouched
+		if !managed[mapping.EnvironmentUUID] {
+			continue
+		}
+		if err := tx.Delete(&models.GatewayEnvironmentMapping{}, mapping.ID).Error; err != nil {
+			return err
+		}
+	}
+	for envUUID := range desired {
+		mapping := &models.GatewayEnvironmentMapping{
+			GatewayUUID:     gwUUID,
+			EnvironmentUUID: envUUID,
+			CreatedAt:       time.Now(),
+		}
+		if err := tx.Create(mapping).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateApplyManifest(manifest *models.ApplyManifest) error {
+	envNames := make(map[string]bool, len(manifest.Environments))
+	for _, env := range manifest.Environments {
+		if strings.TrimSpace(env.Name) == "" {
+			return fmt.Errorf("%w: environment name is required", utils.ErrInvalidInput)
+		}
+		if envNames[env.Name] {
+			return fmt.Errorf("%w: duplicate environment %q", utils.ErrInvalidInput, env.Name)
+		}
+		envNames[env.Name] = true
+	}
+
+	gatewayNames := make(map[string]bool, len(manifest.Gateways))
+	gatewaysByVhost := make(map[string]string, len(manifest.Gateways))
+	for _, gw := range manifest.Gateways {
+		if strings.TrimSpace(gw.Name) == "" {
+			return fmt.Errorf("%w: gateway name is required", utils.ErrInvalidInput)
+		}
+		if gw.Vhost == "" {
+			return fmt.Errorf("%w: vhost is required for gateway %q", utils.ErrInvalidInput, gw.Name)
+		}
+		if gatewayNames[gw.Name] {
+			return fmt.Errorf("%w: duplicate gateway %q", utils.ErrInvalidInput, gw.Name)
+		}
+		gatewayNames[gw.Name] = true
+		if other, ok := gatewaysByVhost[strings.ToLower(gw.Vhost)]; ok {
+			return newVhostConflictError(gw.Vhost, []string{other, gw.Name})
+		}
+		gatewaysByVhost[strings.ToLower(gw.Vhost)] = gw.Name
+	}
+	return nil
+}
+
+// planApply computes the changes needed to move the current state to the state described by the manifest
+func planApply(manifest *models.ApplyManifest, state *applyState, prune bool) ([]models.ApplyChange, error) {
+	changes := make([]models.ApplyChange, 0)
+
+	desiredEnvs := make(map[string]bool, len(manifest.Environments))
+	for _, desired := range manifest.Environments {
+		desiredEnvs[desired.Name] = true
+		var current *models.EnvironmentManifest
+		if env, ok := state.environments[desired.Name]; ok {
+			if env.DataplaneRef != desired.DataplaneRef || env.DNSPrefix != desired.DNSPrefix || env.IsProduction != desired.IsProduction {
+				return nil, fmt.Errorf("%w: environment %q", utils.ErrImmutableFieldChange, desired.Name)
+			}
+			current = environmentManifestFromModel(env)
+		}
+		changes = append(changes, newApplyChange(models.ApplyResourceTypeEnvironment, desired.Name, current, &desired))
+	}
+
+	desiredGateways := make(map[string]bool, len(manifest.Gateways))
+	for _, desired := range manifest.Gateways {
+		desiredGateways[desired.Name] = true
+		for _, envName := range desired.Environments {
+			if _, ok := state.environments[envName]; !ok && !desiredEnvs[envName] {
+				return nil, fmt.Errorf("%w: gateway %q references unknown environment %q", utils.ErrInvalidInput, desired.Name, envName)
+			}
+			if prune && !desiredEnvs[envName] {
+				return nil, fmt.Errorf("%w: gateway %q references environment %q which is being deleted", utils.ErrInvalidInput, desired.Name, envName)
+			}
+		}
+
+		// Existing gateways keep their vhost, so only new gateways can claim the vhost of another
+		if _, ok := state.gateways[desired.Name]; !ok {
+			if names := vhostConflicts(state.allGateways, desired.Vhost, desired.Name); len(names) > 0 {
+				return nil, newVhostConflictError(desired.Vhost, names)
+			}
+		}
+
+		var current *models.GatewayManifest
+		if gw, ok := state.gateways[desired.Name]; ok {
+			if gw.Vhost != desired.Vhost ||
+				(desired.GatewayType != "" && !strings.EqualFold(gw.FunctionalityType, string(manifestGatewayFunctionalityType(desired.GatewayType)))) {
+				return nil, fmt.Errorf("%w: gateway %q", utils.ErrImmutableFieldChange, desired.Name)
+			}
+			current = gatewayManifestFromState(gw, state.gatewayEnvironments[desired.Name])
+			// The gateway type is immutable and already checked above, so it is not diffed
+			current.GatewayType = desired.GatewayType
+		}
+		changes = append(changes, newApplyChange(models.ApplyResourceTypeGateway, desired.Name, current, &desired))
+	}
+
+	if prune {
+		for _, name := range sortedKeys(state.environments) {
+			if !desiredEnvs[name] {
+				changes = append(changes, newApplyChange(models.ApplyResourceTypeEnvironment, name, environmentManifestFromModel(state.environments[name]), (*models.EnvironmentManifest)(nil)))
+			}
+		}
+		for _, name := range sortedKeys(state.gateways) {
+			if !desiredGateways[name] {
+				changes = append(changes, newApplyChange(models.ApplyResourceTypeGateway, name, gatewayManifestFromState(state.gateways[name], state.gatewayEnvironments[name]), (*models.GatewayManifest)(nil)))
+			}
+		}
+	}
+
+	return changes, nil
+}
+
+// newApplyChange builds the change for a resource from its current and desired state,
+// either of which may be nil, including field-level diffs
+func newApplyChange[T any](resourceType models.ApplyResourceType, name string, current, desired *T) models.ApplyChange {
+	change := models.ApplyChange{
+		ResourceType: resourceType,
+		Name:         name,
+		Diffs:        diffManifestFields(current, desired),
+	}
+	switch {
+	case current == nil:
+		change.Action = models.ApplyActionCreate
+	case desired == nil:
+		change.Action = models.ApplyActionDelete
+	case len(change.Diffs) > 0:
+		change.Action = models.ApplyActionUpdate
+	default:
+		change.Action = models.ApplyActionNoop
+	}
+	return change
+}
+
+// diffManifestFields compares two manifest structs field by field, using the JSON field names.
+// String slices are compared as sets.
+func diffManifestFields[T any](current, desired *T) []models.ApplyFieldDiff {
+	var before, after reflect.Value
+	if current != nil {
+		before = reflect.ValueOf(*current)
+	}
+	if desired != nil {
+		after = reflect.ValueOf(*desired)
+	}
+
+	var diffs []models.ApplyFieldDiff
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	for i := 0; i < t.NumField(); i++ {
+		field := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		diff := models.ApplyFieldDiff{Field: field}
+		if before.IsValid() {
+			diff.Before = normalizeDiffValue(before.Field(i).Interface())
+		}
+		if after.IsValid() {
+			diff.After = normalizeDiffValue(after.Field(i).Interface())
+		}
+		if !reflect.DeepEqual(diff.Before, diff.After) {
+			diffs = append(diffs, diff)
+		}
+	}
+	return diffs
+}
+
+func normalizeDiffValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case string:
+		if val == "" {
+			return nil
+		}
+	case []string:
+		if len(val) == 0 {
+			return nil
+		}
+		sorted := append([]string(nil), val...)
+		sort.Strings(sorted)
+		return sorted
+	}
+	return v
+}
+
+func environmentManifestFromModel(env models.Environment) *models.EnvironmentManifest {
+	return &models.EnvironmentManifest{
+		Name:         env.Name,
+		DisplayName:  env.DisplayName,
+		Description:  env.Description,
+		DataplaneRef: env.DataplaneRef,
+		DNSPrefix:    env.DNSPrefix,
+		IsProduction: env.IsProduction,
+	}
+}
+
+func gatewayManifestFromState(gw *apiplatformclient.GatewayResponse, envNames []string) *models.GatewayManifest {
+	return &models.GatewayManifest{
+		Name:         gw.Name,
+		DisplayName:  gw.DisplayName,
+		Description:  gw.Description,
+		Vhost:        gw.Vhost,
+		GatewayType:  strings.ToUpper(gw.FunctionalityType),
+		IsCritical:   gw.IsCritical,
+		Environments: envNames,
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func manifestGatewayFunctionalityType(gatewayType string) apiplatformclient.FunctionalityType {
+	if strings.EqualFold(gatewayType, string(apiplatformclient.FunctionalityTypeAI)) {
+		return apiplatformclient.FunctionalityTypeAI
+	}
+	return apiplatformclient.FunctionalityTypeRegular
+}
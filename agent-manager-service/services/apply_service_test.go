@@ -0,0 +1,277 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apiplatformclient "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/apiplatformsvc/client"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+type diffTestLimits struct {
+	RequestsPerMinute int `json:"requestsPerMinute"`
+}
+
+type diffTestManifest struct {
+	Name     string            `json:"name"`
+	Labels   []string          `json:"labels,omitempty"`
+	Limits   diffTestLimits    `json:"limits"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+func TestDiffManifestFields(t *testing.T) {
+	base := diffTestManifest{
+		Name:     "dev",
+		Labels:   []string{"b", "a"},
+		Limits:   diffTestLimits{RequestsPerMinute: 10},
+		Metadata: map[string]string{"tier": "free"},
+	}
+	with := func(change func(m *diffTestManifest)) *diffTestManifest {
+		m := base
+		change(&m)
+		return &m
+	}
+
+	tests := []struct {
+		name             string
+		current, desired *diffTestManifest
+		want             []models.ApplyFieldDiff
+	}{
+		{
+			name:    "unchanged",
+			current: &base,
+			desired: with(func(m *diffTestManifest) {}),
+			want:    nil,
+		},
+		{
+			name:    "added resource lists its set fields without before values",
+			desired: &diffTestManifest{Name: "dev", Labels: []string{"b", "a"}, Metadata: map[string]string{"tier": "free"}},
+			want: []models.ApplyFieldDiff{
+				{Field: "name", After: "dev"},
+				{Field: "labels", After: []string{"a", "b"}},
+				{Field: "limits", After: diffTestLimits{}},
+				{Field: "metadata", After: map[string]string{"tier": "free"}},
+			},
+		},
+		{
+			name:    "removed resource lists its set fields without after values",
+			current: &diffTestManifest{Name: "dev", Metadata: map[string]string{"tier": "free"}},
+			want: []models.ApplyFieldDiff{
+				{Field: "name", Before: "dev"},
+				{Field: "limits", Before: diffTestLimits{}},
+				{Field: "metadata", Before: map[string]string{"tier": "free"}},
+			},
+		},
+		{
+			name:    "changed scalar",
+			current: &base,
+			desired: with(func(m *diffTestManifest) { m.Name = "test" }),
+			want:    []models.ApplyFieldDiff{{Field: "name", Before: "dev", After: "test"}},
+		},
+		{
+			name:    "cleared string",
+			current: &base,
+			desired: with(func(m *diffTestManifest) { m.Name = "" }),
+			want:    []models.ApplyFieldDiff{{Field: "name", Before: "dev"}},
+		},
+		{
+			name:    "reordered list is unchanged",
+			current: &base,
+			desired: with(func(m *diffTestManifest) { m.Labels = []string{"a", "b"} }),
+			want:    nil,
+		},
+		{
+			name:    "added list item",
+			current: &base,
+			desired: with(func(m *diffTestManifest) { m.Labels = []string{"c", "a", "b"} }),
+			want:    []models.ApplyFieldDiff{{Field: "labels", Before: []string{"a", "b"}, After: []string{"a", "b", "c"}}},
+		},
+		{
+			name:    "removed list items",
+			current: &base,
+			desired: with(func(m *diffTestManifest) { m.Labels = []string{} }),
+			want:    []models.ApplyFieldDiff{{Field: "labels", Before: []string{"a", "b"}}},
+		},
+		{
+			name:    "changed nested field",
+			current: &base,
+			desired: with(func(m *diffTestManifest) { m.Limits.RequestsPerMinute = 20 }),
+			want: []models.ApplyFieldDiff{{
+				Field:  "limits",
+				Before: diffTestLimits{RequestsPerMinute: 10},
+				After:  diffTestLimits{RequestsPerMinute: 20},
+			}},
+		},
+		{
+			name:    "changed map value",
+			current: &base,
+			desired: with(func(m *diffTestManifest) { m.Metadata = map[string]string{"tier": "enterprise"} }),
+			want: []models.ApplyFieldDiff{{
+				Field:  "metadata",
+				Before: map[string]string{"tier": "free"},
+				After:  map[string]string{"tier": "enterprise"},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, diffManifestFields(tt.current, tt.desired))
+		})
+	}
+}
+
+func TestNormalizeDiffValue(t *testing.T) {
+	labels := []string{"b", "a"}
+	tests := []struct {
+		name  string
+		value interface{}
+		want  interface{}
+	}{
+		{"empty string", "", nil},
+		{"string", "dev", "dev"},
+		{"nil list", []string(nil), nil},
+		{"empty list", []string{}, nil},
+		{"list is sorted", labels, []string{"a", "b"}},
+		{"bool", false, false},
+		{"struct", diffTestLimits{RequestsPerMinute: 1}, diffTestLimits{RequestsPerMinute: 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, normalizeDiffValue(tt.value))
+		})
+	}
+	assert.Equal(t, []string{"b", "a"}, labels, "the list must not be sorted in place")
+}
+
+func TestPlanApply(t *testing.T) {
+	state := &applyState{
+		environments: map[string]models.Environment{
+			"dev":  {Name: "dev", DisplayName: "Dev", DataplaneRef: "default", DNSPrefix: "dev"},
+			"prod": {Name: "prod", DisplayName: "Prod", DataplaneRef: "default", DNSPrefix: "prod", IsProduction: true},
+		},
+		gateways: map[string]*apiplatformclient.GatewayResponse{
+			"edge":   {Name: "edge", DisplayName: "Edge", Vhost: "edge.example.com", FunctionalityType: "ai"},
+			"legacy": {Name: "legacy", DisplayName: "Legacy", Vhost: "legacy.example.com", FunctionalityType: "regular"},
+		},
+		gatewayEnvironments: map[string][]string{"edge": {"dev"}, "legacy": {"prod"}},
+	}
+	state.allGateways = []*apiplatformclient.GatewayResponse{
+		state.gateways["edge"], state.gateways["legacy"],
+		{Name: "other-org-gw", Vhost: "taken.example.com"},
+	}
+	dev := models.EnvironmentManifest{Name: "dev", DisplayName: "Dev", DataplaneRef: "default", DNSPrefix: "dev"}
+	prod := models.EnvironmentManifest{Name: "prod", DisplayName: "Prod", DataplaneRef: "default", DNSPrefix: "prod", IsProduction: true}
+	edge := models.GatewayManifest{Name: "edge", DisplayName: "Edge", Vhost: "edge.example.com", GatewayType: "AI", Environments: []string{"dev"}}
+	legacy := models.GatewayManifest{Name: "legacy", DisplayName: "Legacy", Vhost: "legacy.example.com", Environments: []string{"prod"}}
+
+	actions := func(changes []models.ApplyChange) map[string]models.ApplyAction {
+		byResource := make(map[string]models.ApplyAction, len(changes))
+		for _, change := range changes {
+			byResource[string(change.ResourceType)+"/"+change.Name] = change.Action
+		}
+		return byResource
+	}
+
+	t.Run("environments", func(t *testing.T) {
+		staging := models.EnvironmentManifest{Name: "staging", DisplayName: "Staging", DataplaneRef: "default", DNSPrefix: "staging"}
+		renamed := dev
+		renamed.DisplayName = "Development"
+		changes, err := planApply(&models.ApplyManifest{Environments: []models.EnvironmentManifest{renamed, staging}}, state, true)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]models.ApplyAction{
+			"environment/dev":     models.ApplyActionUpdate,
+			"environment/staging": models.ApplyActionCreate,
+			"environment/prod":    models.ApplyActionDelete,
+			"gateway/edge":        models.ApplyActionDelete,
+			"gateway/legacy":      models.ApplyActionDelete,
+		}, actions(changes))
+		assert.Equal(t, []models.ApplyFieldDiff{{Field: "displayName", Before: "Dev", After: "Development"}}, changes[0].Diffs)
+
+		plan := models.NewApplyPlan(changes, true)
+		assert.Equal(t, models.ApplyPlanSummary{Add: 1, Change: 1, Destroy: 3}, plan.Summary)
+	})
+
+	t.Run("environment immutable fields cannot change", func(t *testing.T) {
+		moved := dev
+		moved.DNSPrefix = "development"
+		_, err := planApply(&models.ApplyManifest{Environments: []models.EnvironmentManifest{moved}}, state, false)
+		assert.ErrorIs(t, err, utils.ErrImmutableFieldChange)
+	})
+
+	t.Run("gateways", func(t *testing.T) {
+		reassigned := edge
+		reassigned.Environments = []string{"prod", "dev"}
+		added := models.GatewayManifest{Name: "internal", DisplayName: "Internal", Vhost: "internal.example.com", Environments: []string{"dev"}}
+		changes, err := planApply(&models.ApplyManifest{
+			Environments: []models.EnvironmentManifest{dev, prod},
+			Gateways:     []models.GatewayManifest{reassigned, added},
+		}, state, true)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]models.ApplyAction{
+			"environment/dev":  models.ApplyActionNoop,
+			"environment/prod": models.ApplyActionNoop,
+			"gateway/edge":     models.ApplyActionUpdate,
+			"gateway/internal": models.ApplyActionCreate,
+			"gateway/legacy":   models.ApplyActionDelete,
+		}, actions(changes))
+		assert.Equal(t, []models.ApplyFieldDiff{{Field: "environments", Before: []string{"dev"}, After: []string{"dev", "prod"}}}, changes[2].Diffs)
+
+		plan := models.NewApplyPlan(changes, false)
+		assert.Equal(t, models.ApplyPlanSummary{Add: 1, Change: 1, Destroy: 1, Unchanged: 2}, plan.Summary)
+	})
+
+	t.Run("unchanged gateways without pruning", func(t *testing.T) {
+		changes, err := planApply(&models.ApplyManifest{Gateways: []models.GatewayManifest{edge, legacy}}, state, false)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]models.ApplyAction{
+			"gateway/edge":   models.ApplyActionNoop,
+			"gateway/legacy": models.ApplyActionNoop,
+		}, actions(changes))
+	})
+
+	t.Run("gateway errors", func(t *testing.T) {
+		movedVhost := edge
+		movedVhost.Vhost = "new.example.com"
+		retyped := edge
+		retyped.GatewayType = "REGULAR"
+		unknownEnv := edge
+		unknownEnv.Environments = []string{"qa"}
+		taken := models.GatewayManifest{Name: "new", Vhost: "TAKEN.example.com"}
+
+		for name, tt := range map[string]struct {
+			manifest models.ApplyManifest
+			prune    bool
+			err      error
+		}{
+			"vhost cannot change":                 {models.ApplyManifest{Gateways: []models.GatewayManifest{movedVhost}}, false, utils.ErrImmutableFieldChange},
+			"type cannot change":                  {models.ApplyManifest{Gateways: []models.GatewayManifest{retyped}}, false, utils.ErrImmutableFieldChange},
+			"environments must exist":             {models.ApplyManifest{Gateways: []models.GatewayManifest{unknownEnv}}, false, utils.ErrInvalidInput},
+			"environments must not be pruned":     {models.ApplyManifest{Gateways: []models.GatewayManifest{edge}}, true, utils.ErrInvalidInput},
+			"vhosts of other gateways are served": {models.ApplyManifest{Gateways: []models.GatewayManifest{taken}}, false, utils.ErrGatewayVhostConflict},
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := planApply(&tt.manifest, state, tt.prune)
+				assert.ErrorIs(t, err, tt.err)
+			})
+		}
+	})
+}
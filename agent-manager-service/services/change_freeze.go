@@ -0,0 +1,160 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// maxBreakGlassReasonLength is the longest justification accepted for overriding a change freeze
+const maxBreakGlassReasonLength = 1000
+
+// ChangeFreezeService manages the windows during which deployments and token rotations of an
+// organization are blocked
+type ChangeFreezeService interface {
+	CreateWindow(ctx context.Context, orgName, createdBy string, req *models.CreateChangeFreezeWindowRequest) (*models.ChangeFreezeWindowResponse, error)
+	ListWindows(ctx context.Context, orgName string) (*models.ChangeFreezeWindowListResponse, error)
+	GetWindow(ctx context.Context, orgName, windowID string) (*models.ChangeFreezeWindowResponse, error)
+	DeleteWindow(ctx context.Context, orgName, windowID string) error
+	// CheckChangeAllowed returns ErrChangeFreezeActive if a change freeze window of the organization
+	// is active, unless a break-glass reason is given. Changes made with a break-glass reason during
+	// a freeze are recorded on the timeline of the window, and the override fails if the record
+	// cannot be stored.
+	CheckChangeAllowed(ctx context.Context, orgName, operation, resource, actor, breakGlassReason string) error
+}
+
+type changeFreezeService struct {
+	logger       *slog.Logger
+	eventService ResourceEventService
+}
+
+// NewChangeFreezeService creates a new change freeze service
+func NewChangeFreezeService(logger *slog.Logger, eventService ResourceEventService) ChangeFreezeService {
+	return &changeFreezeService{
+		logger:       logger,
+		eventService: eventService,
+	}
+}
+
+func (s *changeFreezeService) CreateWindow(ctx context.Context, orgName, createdBy string, req *models.CreateChangeFreezeWindowRequest) (*models.ChangeFreezeWindowResponse, error) {
+	if !req.EndTime.After(time.Now()) {
+		return nil, fmt.Errorf("%w: endTime must be in the future", utils.ErrInvalidInput)
+	}
+	window := &models.ChangeFreezeWindow{
+		UUID:             uuid.New(),
+		OrganizationName: orgName,
+		Name:             strings.TrimSpace(req.Name),
+		Reason:           req.Reason,
+		StartTime:        req.StartTime.UTC(),
+		EndTime:          req.EndTime.UTC(),
+		CreatedBy:        createdBy,
+		CreatedAt:        time.Now(),
+	}
+	if err := db.DB(ctx).Create(window).Error; err != nil {
+		return nil, fmt.Errorf("failed to create change freeze window: %w", err)
+	}
+	s.logger.Info("Created change freeze window", "orgName", orgName, "windowId", window.UUID,
+		"startTime", window.StartTime, "endTime", window.EndTime, "createdBy", createdBy)
+	return window.ToResponse(), nil
+}
+
+func (s *changeFreezeService) ListWindows(ctx context.Context, orgName string) (*models.ChangeFreezeWindowListResponse, error) {
+	var windows []models.ChangeFreezeWindow
+	if err := db.DB(ctx).Where("organization_name = ?", orgName).Order("start_time ASC").Find(&windows).Error; err != nil {
+		return nil, fmt.Errorf("failed to list change freeze windows: %w", err)
+	}
+	response := &models.ChangeFreezeWindowListResponse{Windows: make([]models.ChangeFreezeWindowResponse, 0, len(windows))}
+	for i := range windows {
+		response.Windows = append(response.Windows, *windows[i].ToResponse())
+	}
+	return response, nil
+}
+
+func (s *changeFreezeService) GetWindow(ctx context.Context, orgName, windowID string) (*models.ChangeFreezeWindowResponse, error) {
+	id, err := uuid.Parse(windowID)
+	if err != nil {
+		return nil, utils.ErrChangeFreezeWindowNotFound
+	}
+	var windows []models.ChangeFreezeWindow
+	if err := db.DB(ctx).Where("uuid = ? AND organization_name = ?", id, orgName).Limit(1).Find(&windows).Error; err != nil {
+		return nil, fmt.Errorf("failed to get change freeze window: %w", err)
+	}
+	if len(windows) == 0 {
+		return nil, utils.ErrChangeFreezeWindowNotFound
+	}
+	return windows[0].ToResponse(), nil
+}
+
+func (s *changeFreezeService) DeleteWindow(ctx context.Context, orgName, windowID string) error {
+	id, err := uuid.Parse(windowID)
+	if err != nil {
+		return utils.ErrChangeFreezeWindowNotFound
+	}
+	result := db.DB(ctx).Where("uuid = ? AND organization_name = ?", id, orgName).Delete(&models.ChangeFreezeWindow{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete change freeze window: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return utils.ErrChangeFreezeWindowNotFound
+	}
+	s.logger.Info("Deleted change freeze window", "orgName", orgName, "windowId", id)
+	return nil
+}
+
+func (s *changeFreezeService) CheckChangeAllowed(ctx context.Context, orgName, operation, resource, actor, breakGlassReason string) error {
+	now := time.Now().UTC()
+	var windows []models.ChangeFreezeWindow
+	err := db.DB(ctx).
+		Where("organization_name = ? AND start_time <= ? AND end_time > ?", orgName, now, now).
+		Order("end_time DESC").
+		Limit(1).
+		Find(&windows).Error
+	if err != nil {
+		return fmt.Errorf("failed to check change freeze windows: %w", err)
+	}
+	if len(windows) == 0 {
+		return nil
+	}
+	window := windows[0]
+
+	breakGlassReason = strings.TrimSpace(breakGlassReason)
+	if breakGlassReason == "" {
+		return fmt.Errorf("%w: %s is blocked by change freeze %q until %s; set the %s header to override it",
+			utils.ErrChangeFreezeActive, operation, window.Name, window.EndTime.Format(time.RFC3339), utils.HeaderBreakGlassReason)
+	}
+	if len(breakGlassReason) > maxBreakGlassReasonLength {
+		return fmt.Errorf("%w: the break-glass reason must be at most %d characters", utils.ErrInvalidInput, maxBreakGlassReasonLength)
+	}
+
+	if err := s.eventService.AppendEvent(ctx, orgName, models.ResourceTypeChangeFreeze, window.UUID.String(), models.ResourceEventBreakGlass, actor,
+		map[string]interface{}{"operation": operation, "resource": resource, "reason": breakGlassReason}); err != nil {
+		return fmt.Errorf("failed to audit change freeze override: %w", err)
+	}
+	s.logger.Warn("Change freeze overridden", "orgName", orgName, "windowId", window.UUID, "operation", operation,
+		"resource", resource, "actor", actor, "reason", breakGlassReason)
+	return nil
+}
@@ -0,0 +1,210 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+func (s *observabilityManagerService) SetCostCenterMapping(ctx context.Context, orgName, createdBy string, req *models.CostCenterMappingRequest) (*models.CostCenterMappingResponse, error) {
+	now := time.Now()
+	mapping := &models.CostCenterMapping{
+		UUID:             uuid.New(),
+		OrganizationName: orgName,
+		ResourceType:     req.ResourceType,
+		ResourceName:     strings.TrimSpace(req.ResourceName),
+		CostCenter:       strings.TrimSpace(req.CostCenter),
+		CreatedBy:        createdBy,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+	switch req.ResourceType {
+	case models.CostCenterResourceAgent:
+		if req.ProjectName == "" {
+			return nil, fmt.Errorf("%w: projectName is required for agents", utils.ErrInvalidInput)
+		}
+		if _, err := s.ocClient.GetComponent(ctx, orgName, req.ProjectName, mapping.ResourceName); err != nil {
+			return nil, fmt.Errorf("failed to get agent: %w", err)
+		}
+		mapping.ProjectName = req.ProjectName
+	case models.CostCenterResourceProvider:
+		// Providers are matched case-insensitively against the provider of each model in the trace analytics
+		mapping.ResourceName = strings.ToLower(mapping.ResourceName)
+	}
+
+	err := db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing models.CostCenterMapping
+		err := tx.Where("organization_name = ? AND resource_type = ? AND project_name = ? AND resource_name = ?",
+			orgName, mapping.ResourceType, mapping.ProjectName, mapping.ResourceName).First(&existing).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return tx.Create(mapping).Error
+		}
+		if err != nil {
+			return err
+		}
+		existing.CostCenter = mapping.CostCenter
+		existing.UpdatedAt = now
+		*mapping = existing
+		return tx.Model(&existing).Updates(map[string]interface{}{"cost_center": existing.CostCenter, "updated_at": now}).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save cost center mapping: %w", err)
+	}
+	s.logger.Info("Set cost center", "orgName", orgName, "resourceType", mapping.ResourceType, "projectName", mapping.ProjectName,
+		"resourceName", mapping.ResourceName, "costCenter", mapping.CostCenter)
+	return mapping.ToResponse(), nil
+}
+
+func (s *observabilityManagerService) ListCostCenterMappings(ctx context.Context, orgName string) (*models.CostCenterMappingListResponse, error) {
+	mappings, err := listCostCenterMappings(ctx, orgName)
+	if err != nil {
+		return nil, err
+	}
+	response := &models.CostCenterMappingListResponse{Mappings: make([]models.CostCenterMappingResponse, 0, len(mappings))}
+	for i := range mappings {
+		response.Mappings = append(response.Mappings, *mappings[i].ToResponse())
+	}
+	return response, nil
+}
+
+func (s *observabilityManagerService) DeleteCostCenterMapping(ctx context.Context, orgName, mappingID string) error {
+	id, err := uuid.Parse(mappingID)
+	if err != nil {
+		return utils.ErrCostCenterMappingNotFound
+	}
+	result := db.DB(ctx).Where("uuid = ? AND organization_name = ?", id, orgName).Delete(&models.CostCenterMapping{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete cost center mapping: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return utils.ErrCostCenterMappingNotFound
+	}
+	return nil
+}
+
+// GetCostCenterUsage charges the LLM usage of each agent to the agent's cost center. The usage of
+// agents without one is charged model by model to the cost center of the model's provider, and
+// usage left over is reported as unassigned. Cost centers without usage are listed with zero usage.
+func (s *observabilityManagerService) GetCostCenterUsage(ctx context.Context, req ModelUsageRequest) (*models.CostCenterUsageResponse, error) {
+	environment, err := s.ocClient.GetEnvironment(ctx, req.OrgName, req.Environment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get environment: %w", err)
+	}
+	agents, err := s.listOrgAgents(ctx, req.OrgName)
+	if err != nil {
+		return nil, err
+	}
+	mappings, err := listCostCenterMappings(ctx, req.OrgName)
+	if err != nil {
+		return nil, err
+	}
+
+	usages := make(map[string]*models.CostCenterUsage)
+	agentCostCenters := make(map[string]string)
+	providerCostCenters := make(map[string]string)
+	for _, mapping := range mappings {
+		if _, ok := usages[mapping.CostCenter]; !ok {
+			usages[mapping.CostCenter] = &models.CostCenterUsage{CostCenter: mapping.CostCenter, Agents: []string{}}
+		}
+		if mapping.ResourceType == models.CostCenterResourceAgent {
+			agentCostCenters[mapping.ProjectName+"/"+mapping.ResourceName] = mapping.CostCenter
+		} else {
+			providerCostCenters[mapping.ResourceName] = mapping.CostCenter
+		}
+	}
+
+	response := &models.CostCenterUsageResponse{
+		Environment: req.Environment,
+		StartTime:   req.StartTime,
+		EndTime:     req.EndTime,
+		Unassigned:  models.CostCenterUsage{Agents: []string{}},
+	}
+	for _, agent := range agents {
+		usage, err := s.traceObserverClient.GetModelUsage(ctx, traceobserversvc.ModelUsageParams{
+			ComponentUids:  []string{agent.ComponentUid},
+			EnvironmentUid: environment.UUID,
+			StartTime:      req.StartTime,
+			EndTime:        req.EndTime,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get model usage of agent %s: %w", agent.Name, err)
+		}
+		response.Truncated = response.Truncated || usage.Truncated
+
+		agentID := agent.ProjectName + "/" + agent.Name
+		for _, model := range usage.Models {
+			costCenter, ok := agentCostCenters[agentID]
+			if !ok {
+				costCenter = providerCostCenters[strings.ToLower(model.Provider)]
+			}
+			target := &response.Unassigned
+			if costCenter != "" {
+				target = usages[costCenter]
+			}
+			addCostCenterUsage(target, agentID, model.UsageStats)
+		}
+	}
+
+	response.CostCenters = make([]models.CostCenterUsage, 0, len(usages))
+	for _, usage := range usages {
+		response.CostCenters = append(response.CostCenters, *usage)
+	}
+	sort.Slice(response.CostCenters, func(i, j int) bool {
+		return response.CostCenters[i].CostCenter < response.CostCenters[j].CostCenter
+	})
+	s.logger.Info("Retrieved cost center usage", "orgName", req.OrgName, "agents", len(agents), "costCenters", len(response.CostCenters))
+	return response, nil
+}
+
+func addCostCenterUsage(usage *models.CostCenterUsage, agentID string, stats traceobserversvc.UsageStats) {
+	if len(usage.Agents) == 0 || usage.Agents[len(usage.Agents)-1] != agentID {
+		usage.Agents = append(usage.Agents, agentID)
+	}
+	usage.RequestCount += stats.RequestCount
+	usage.InputTokens += stats.InputTokens
+	usage.OutputTokens += stats.OutputTokens
+	usage.TotalTokens += stats.TotalTokens
+	if stats.EstimatedCost != nil {
+		cost := *stats.EstimatedCost
+		if usage.EstimatedCost != nil {
+			cost += *usage.EstimatedCost
+		}
+		usage.EstimatedCost = &cost
+	}
+}
+
+func listCostCenterMappings(ctx context.Context, orgName string) ([]models.CostCenterMapping, error) {
+	var mappings []models.CostCenterMapping
+	if err := db.DB(ctx).Where("organization_name = ?", orgName).
+		Order("resource_type, project_name, resource_name").Find(&mappings).Error; err != nil {
+		return nil, fmt.Errorf("failed to list cost center mappings: %w", err)
+	}
+	return mappings, nil
+}
@@ -0,0 +1,288 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"embed"
+	"fmt"
+	"log/slog"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// emailTimeout bounds the SMTP conversation of each email
+const emailTimeout = 30 * time.Second
+
+// Templates of notification emails. Each defines <name>_subject and <name>_body.
+const (
+	emailTemplateVerification     = "verification"
+	emailTemplateDeploymentResult = "deployment_result"
+	emailTemplateTokenExpiry      = "token_expiry"
+	emailTemplateBudgetAlert      = "budget_alert"
+	emailTemplateDigest           = "digest"
+)
+
+//go:embed email_templates/*.tmpl
+var emailTemplateFS embed.FS
+
+var emailTemplates = template.Must(template.New("email").Funcs(template.FuncMap{
+	"date": func(t time.Time) string {
+		return t.UTC().Format("2006-01-02")
+	},
+	// lastDay returns the day before the exclusive end of a period
+	"lastDay": func(end time.Time) time.Time {
+		return end.AddDate(0, 0, -1)
+	},
+	"percent": func(used, limit int64) string {
+		if limit <= 0 {
+			return "0%"
+		}
+		return fmt.Sprintf("%.0f%%", float64(used)*100/float64(limit))
+	},
+}).ParseFS(emailTemplateFS, "email_templates/*.tmpl"))
+
+// emailVerificationData is rendered by the verification template
+type emailVerificationData struct {
+	OrganizationName string
+	Code             string
+	ValidHours       int
+}
+
+// deploymentResultEmail is rendered by the deployment_result template
+type deploymentResultEmail struct {
+	OrganizationName string
+	ProjectName      string
+	AgentName        string
+	Environment      string
+	ImageID          string
+	Revision         int
+	Succeeded        bool
+	Error            string
+}
+
+// tokenExpiryEmail is rendered by the token_expiry template
+type tokenExpiryEmail struct {
+	OrganizationName string
+	ProjectName      string
+	AgentName        string
+	Environment      string
+	ExpiresAt        time.Time
+	DaysLeft         int
+}
+
+// budgetAlertEmail is rendered by the budget_alert template
+type budgetAlertEmail struct {
+	OrganizationName string
+	ProjectName      string
+	AgentName        string
+	Environment      string
+	Period           string
+	// Type is soft_limit_reached or limit_exceeded
+	Type       string
+	UsedTokens int64
+	TokenLimit int64
+	Enforced   bool
+}
+
+// digestEmail is rendered by the digest template
+type digestEmail struct {
+	Name            string
+	Report          *models.NotificationDigestReport
+	DeploymentCount int
+}
+
+// emailEnabled reports whether an SMTP server is configured
+func emailEnabled() bool {
+	return config.GetConfig().Email.SMTPHost != ""
+}
+
+// renderEmail renders the subject and body of an email template
+func renderEmail(templateName string, data interface{}) (string, string, error) {
+	var subject, body bytes.Buffer
+	if err := emailTemplates.ExecuteTemplate(&subject, templateName+"_subject", data); err != nil {
+		return "", "", fmt.Errorf("failed to render %s email subject: %w", templateName, err)
+	}
+	if err := emailTemplates.ExecuteTemplate(&body, templateName+"_body", data); err != nil {
+		return "", "", fmt.Errorf("failed to render %s email body: %w", templateName, err)
+	}
+	// Folding the subject onto one line keeps values such as agent names from adding headers
+	return strings.Join(strings.Fields(subject.String()), " "), body.String(), nil
+}
+
+// composeEmail returns a plain text message with its headers
+func composeEmail(from *mail.Address, to, subject, body string) ([]byte, error) {
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", from.String())
+	fmt.Fprintf(&msg, "To: %s\r\n", to)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	msg.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+	w := quotedprintable.NewWriter(&msg)
+	if _, err := w.Write([]byte(body)); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return msg.Bytes(), nil
+}
+
+// sendEmail sends an email to one address through the configured SMTP server. The connection is
+// upgraded with STARTTLS when the server offers it, unless it uses implicit TLS.
+func sendEmail(ctx context.Context, to, subject, body string) error {
+	cfg := config.GetConfig().Email
+	if cfg.SMTPHost == "" {
+		return utils.ErrEmailNotConfigured
+	}
+	from, err := mail.ParseAddress(cfg.From)
+	if err != nil {
+		return fmt.Errorf("invalid sender address: %w", err)
+	}
+	msg, err := composeEmail(from, to, subject, body)
+	if err != nil {
+		return fmt.Errorf("failed to compose email: %w", err)
+	}
+
+	addr := net.JoinHostPort(cfg.SMTPHost, strconv.Itoa(cfg.SMTPPort))
+	dialer := &net.Dialer{Timeout: emailTimeout}
+	var conn net.Conn
+	if cfg.ImplicitTLS {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: &tls.Config{ServerName: cfg.SMTPHost}}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return fmt.Errorf("%w: SMTP server is unreachable: %w", utils.ErrNotificationDeliveryFailed, err)
+	}
+	_ = conn.SetDeadline(time.Now().Add(emailTimeout))
+	client, err := smtp.NewClient(conn, cfg.SMTPHost)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("%w: %w", utils.ErrNotificationDeliveryFailed, err)
+	}
+	defer client.Close()
+
+	if !cfg.ImplicitTLS {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err := client.StartTLS(&tls.Config{ServerName: cfg.SMTPHost}); err != nil {
+				return fmt.Errorf("%w: STARTTLS failed: %w", utils.ErrNotificationDeliveryFailed, err)
+			}
+		}
+	}
+	if cfg.SMTPUsername != "" {
+		if err := client.Auth(smtp.PlainAuth("", cfg.SMTPUsername, cfg.SMTPPassword, cfg.SMTPHost)); err != nil {
+			return fmt.Errorf("%w: SMTP authentication failed: %w", utils.ErrNotificationDeliveryFailed, err)
+		}
+	}
+	if err := client.Mail(from.Address); err != nil {
+		return fmt.Errorf("%w: %w", utils.ErrNotificationDeliveryFailed, err)
+	}
+	if err := client.Rcpt(to); err != nil {
+		return fmt.Errorf("%w: recipient %s was refused: %w", utils.ErrNotificationDeliveryFailed, to, err)
+	}
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("%w: %w", utils.ErrNotificationDeliveryFailed, err)
+	}
+	if _, err := w.Write(msg); err != nil {
+		return fmt.Errorf("%w: %w", utils.ErrNotificationDeliveryFailed, err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("%w: %w", utils.ErrNotificationDeliveryFailed, err)
+	}
+	// The server accepted the message; a failure to end the session does not undo that
+	_ = client.Quit()
+	return nil
+}
+
+// verifiedEmailRecipients returns the verified recipients of an organization subscribed to a notification
+func verifiedEmailRecipients(ctx context.Context, orgName, notification string) ([]models.EmailRecipient, error) {
+	var recipients []models.EmailRecipient
+	if err := db.DB(ctx).Where("organization_name = ? AND verified_at IS NOT NULL", orgName).
+		Order("address").Find(&recipients).Error; err != nil {
+		return nil, fmt.Errorf("failed to list email recipients: %w", err)
+	}
+	subscribed := recipients[:0]
+	for _, recipient := range recipients {
+		if recipient.Subscribes(notification) {
+			subscribed = append(subscribed, recipient)
+		}
+	}
+	return subscribed, nil
+}
+
+// emailOrganization renders a template and emails it to each verified recipient of an organization
+// subscribed to the notification. It returns the number of recipients emailed; the error is that
+// of the first recipient that could not be emailed.
+func emailOrganization(ctx context.Context, orgName, notification, templateName string, data interface{}) (int, error) {
+	recipients, err := verifiedEmailRecipients(ctx, orgName, notification)
+	if err != nil || len(recipients) == 0 {
+		return 0, err
+	}
+	subject, body, err := renderEmail(templateName, data)
+	if err != nil {
+		return 0, err
+	}
+	sent := 0
+	var firstErr error
+	for _, recipient := range recipients {
+		if err := sendEmail(ctx, recipient.Address, subject, body); err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		sent++
+	}
+	return sent, firstErr
+}
+
+// notifyByEmail emails a notification to the recipients of an organization in the background.
+// Notifications are best effort and must not hold up the operation that raised them.
+func notifyByEmail(ctx context.Context, logger *slog.Logger, orgName, notification, templateName string, data interface{}) {
+	if !emailEnabled() {
+		return
+	}
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		sent, err := emailOrganization(ctx, orgName, notification, templateName, data)
+		if err != nil {
+			logger.Warn("Failed to email notification", "orgName", orgName, "notification", notification, "sent", sent, "error", err)
+			return
+		}
+		if sent > 0 {
+			logger.Debug("Emailed notification", "orgName", orgName, "notification", notification, "recipients", sent)
+		}
+	}()
+}
@@ -0,0 +1,245 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+const (
+	// emailVerificationCodeTTL is how long a verification code emailed to a recipient is valid
+	emailVerificationCodeTTL = 24 * time.Hour
+	// maxEmailVerificationAttempts is the number of wrong codes after which a code is no longer accepted
+	maxEmailVerificationAttempts = 5
+)
+
+// newEmailVerificationCode returns a random six digit code and its hash
+func newEmailVerificationCode() (string, string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", "", fmt.Errorf("failed to generate verification code: %w", err)
+	}
+	code := fmt.Sprintf("%06d", n.Int64())
+	return code, hashEmailVerificationCode(code), nil
+}
+
+func hashEmailVerificationCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+// sendEmailVerification emails a verification code to an address
+func sendEmailVerification(ctx context.Context, orgName, address, code string) error {
+	subject, body, err := renderEmail(emailTemplateVerification, emailVerificationData{
+		OrganizationName: orgName,
+		Code:             code,
+		ValidHours:       int(emailVerificationCodeTTL.Hours()),
+	})
+	if err != nil {
+		return err
+	}
+	return sendEmail(ctx, address, subject, body)
+}
+
+func (s *observabilityManagerService) CreateEmailRecipient(ctx context.Context, orgName, createdBy string, req *models.CreateEmailRecipientRequest) (*models.EmailRecipientResponse, error) {
+	if !emailEnabled() {
+		return nil, utils.ErrEmailNotConfigured
+	}
+	address := strings.ToLower(strings.TrimSpace(req.Address))
+	var existing int64
+	if err := db.DB(ctx).Model(&models.EmailRecipient{}).
+		Where("organization_name = ? AND address = ?", orgName, address).Count(&existing).Error; err != nil {
+		return nil, fmt.Errorf("failed to check email recipient: %w", err)
+	}
+	if existing > 0 {
+		return nil, utils.ErrEmailRecipientAlreadyExists
+	}
+
+	code, codeHash, err := newEmailVerificationCode()
+	if err != nil {
+		return nil, err
+	}
+	// The code is emailed before the recipient is saved, so that an address that cannot be
+	// reached is not added
+	if err := sendEmailVerification(ctx, orgName, address, code); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	recipient := &models.EmailRecipient{
+		UUID:                 uuid.New(),
+		OrganizationName:     orgName,
+		Address:              address,
+		Notifications:        req.Notifications,
+		VerificationCodeHash: codeHash,
+		VerificationSentAt:   &now,
+		CreatedBy:            createdBy,
+		CreatedAt:            now,
+		UpdatedAt:            now,
+	}
+	if err := db.DB(ctx).Create(recipient).Error; err != nil {
+		if isUniqueViolation(err) {
+			return nil, utils.ErrEmailRecipientAlreadyExists
+		}
+		return nil, fmt.Errorf("failed to create email recipient: %w", err)
+	}
+	s.logger.Info("Added email recipient; waiting for verification", "orgName", orgName, "recipientId", recipient.UUID, "createdBy", createdBy)
+	return recipient.ToResponse(), nil
+}
+
+func (s *observabilityManagerService) ListEmailRecipients(ctx context.Context, orgName string) (*models.EmailRecipientListResponse, error) {
+	var recipients []models.EmailRecipient
+	if err := db.DB(ctx).Where("organization_name = ?", orgName).Order("address ASC").Find(&recipients).Error; err != nil {
+		return nil, fmt.Errorf("failed to list email recipients: %w", err)
+	}
+	response := &models.EmailRecipientListResponse{Recipients: make([]models.EmailRecipientResponse, 0, len(recipients))}
+	for i := range recipients {
+		response.Recipients = append(response.Recipients, *recipients[i].ToResponse())
+	}
+	return response, nil
+}
+
+func (s *observabilityManagerService) GetEmailRecipient(ctx context.Context, orgName, recipientID string) (*models.EmailRecipientResponse, error) {
+	recipient, err := getEmailRecipient(db.DB(ctx), orgName, recipientID)
+	if err != nil {
+		return nil, err
+	}
+	return recipient.ToResponse(), nil
+}
+
+func (s *observabilityManagerService) UpdateEmailRecipient(ctx context.Context, orgName, recipientID string, req *models.UpdateEmailRecipientRequest) (*models.EmailRecipientResponse, error) {
+	recipient, err := getEmailRecipient(db.DB(ctx), orgName, recipientID)
+	if err != nil {
+		return nil, err
+	}
+	recipient.Notifications = req.Notifications
+	recipient.UpdatedAt = time.Now()
+	if err := db.DB(ctx).Model(recipient).Select("notifications", "updated_at").Updates(recipient).Error; err != nil {
+		return nil, fmt.Errorf("failed to update email recipient: %w", err)
+	}
+	s.logger.Info("Updated email recipient", "orgName", orgName, "recipientId", recipient.UUID, "notifications", req.Notifications)
+	return recipient.ToResponse(), nil
+}
+
+func (s *observabilityManagerService) DeleteEmailRecipient(ctx context.Context, orgName, recipientID string) error {
+	id, err := uuid.Parse(recipientID)
+	if err != nil {
+		return utils.ErrEmailRecipientNotFound
+	}
+	result := db.DB(ctx).Where("uuid = ? AND organization_name = ?", id, orgName).Delete(&models.EmailRecipient{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete email recipient: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return utils.ErrEmailRecipientNotFound
+	}
+	s.logger.Info("Deleted email recipient", "orgName", orgName, "recipientId", id)
+	return nil
+}
+
+func (s *observabilityManagerService) SendEmailRecipientVerification(ctx context.Context, orgName, recipientID string) (*models.EmailRecipientResponse, error) {
+	if !emailEnabled() {
+		return nil, utils.ErrEmailNotConfigured
+	}
+	recipient, err := getEmailRecipient(db.DB(ctx), orgName, recipientID)
+	if err != nil {
+		return nil, err
+	}
+	if recipient.VerifiedAt != nil {
+		return nil, utils.ErrEmailRecipientAlreadyVerified
+	}
+	code, codeHash, err := newEmailVerificationCode()
+	if err != nil {
+		return nil, err
+	}
+	if err := sendEmailVerification(ctx, orgName, recipient.Address, code); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	recipient.VerificationCodeHash = codeHash
+	recipient.VerificationSentAt = &now
+	recipient.VerificationAttempts = 0
+	recipient.UpdatedAt = now
+	if err := db.DB(ctx).Model(recipient).Select("verification_code_hash", "verification_sent_at", "verification_attempts", "updated_at").Updates(recipient).Error; err != nil {
+		return nil, fmt.Errorf("failed to update email recipient: %w", err)
+	}
+	s.logger.Info("Sent email recipient verification", "orgName", orgName, "recipientId", recipient.UUID)
+	return recipient.ToResponse(), nil
+}
+
+// VerifyEmailRecipient checks the code last emailed to a recipient. Only the latest code is valid,
+// until it expires or too many wrong codes are entered.
+func (s *observabilityManagerService) VerifyEmailRecipient(ctx context.Context, orgName, recipientID string, req *models.VerifyEmailRecipientRequest) (*models.EmailRecipientResponse, error) {
+	recipient, err := getEmailRecipient(db.DB(ctx), orgName, recipientID)
+	if err != nil {
+		return nil, err
+	}
+	if recipient.VerifiedAt != nil {
+		return nil, utils.ErrEmailRecipientAlreadyVerified
+	}
+	if recipient.VerificationCodeHash == "" || recipient.VerificationSentAt == nil ||
+		time.Since(*recipient.VerificationSentAt) > emailVerificationCodeTTL ||
+		recipient.VerificationAttempts >= maxEmailVerificationAttempts {
+		return nil, utils.ErrInvalidVerificationCode
+	}
+	codeHash := hashEmailVerificationCode(strings.TrimSpace(req.Code))
+	if subtle.ConstantTimeCompare([]byte(codeHash), []byte(recipient.VerificationCodeHash)) != 1 {
+		if err := db.DB(ctx).Model(recipient).
+			UpdateColumn("verification_attempts", gorm.Expr("verification_attempts + 1")).Error; err != nil {
+			return nil, fmt.Errorf("failed to record verification attempt: %w", err)
+		}
+		return nil, utils.ErrInvalidVerificationCode
+	}
+	now := time.Now()
+	recipient.VerifiedAt = &now
+	recipient.VerificationCodeHash = ""
+	recipient.UpdatedAt = now
+	if err := db.DB(ctx).Model(recipient).Select("verified_at", "verification_code_hash", "updated_at").Updates(recipient).Error; err != nil {
+		return nil, fmt.Errorf("failed to verify email recipient: %w", err)
+	}
+	s.logger.Info("Verified email recipient", "orgName", orgName, "recipientId", recipient.UUID)
+	return recipient.ToResponse(), nil
+}
+
+func getEmailRecipient(tx *gorm.DB, orgName, recipientID string) (*models.EmailRecipient, error) {
+	id, err := uuid.Parse(recipientID)
+	if err != nil {
+		return nil, utils.ErrEmailRecipientNotFound
+	}
+	var recipients []models.EmailRecipient
+	if err := tx.Where("uuid = ? AND organization_name = ?", id, orgName).Limit(1).Find(&recipients).Error; err != nil {
+		return nil, fmt.Errorf("failed to get email recipient: %w", err)
+	}
+	if len(recipients) == 0 {
+		return nil, utils.ErrEmailRecipientNotFound
+	}
+	return &recipients[0], nil
+}
@@ -26,9 +26,11 @@ import (
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	apiplatformclient "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/apiplatformsvc/client"
 	occlient "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/openchoreosvc/client"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
@@ -38,10 +40,15 @@ import (
 type EnvironmentService interface {
 	CreateEnvironment(ctx context.Context, orgName string, req *models.CreateEnvironmentRequest) (*models.GatewayEnvironmentResponse, error)
 	GetEnvironment(ctx context.Context, orgName string, envID string) (*models.GatewayEnvironmentResponse, error)
-	ListEnvironments(ctx context.Context, orgName string, limit, offset int32) (*models.EnvironmentListResponse, error)
-	UpdateEnvironment(ctx context.Context, orgName string, envID string, req *models.UpdateEnvironmentRequest) (*models.GatewayEnvironmentResponse, error)
-	DeleteEnvironment(ctx context.Context, orgName string, envID string) error
+	// ListEnvironments lists the environments of an organization, only those in region when it is set
+	ListEnvironments(ctx context.Context, orgName string, region string, limit, offset int32) (*models.EnvironmentListResponse, error)
+	// UpdateEnvironment and DeleteEnvironment reject the change with utils.ErrPreconditionFailed
+	// when ifMatch is set and does not match the current ETag of the environment
+	UpdateEnvironment(ctx context.Context, orgName string, envID string, req *models.UpdateEnvironmentRequest, ifMatch string) (*models.GatewayEnvironmentResponse, error)
+	DeleteEnvironment(ctx context.Context, orgName string, envID string, ifMatch string) error
 	GetEnvironmentGateways(ctx context.Context, orgName string, envID string) ([]models.GatewayResponse, error)
+	// ListRegions returns the regions catalog with the number of environments of the organization in each region
+	ListRegions(ctx context.Context, orgName string) (*models.RegionListResponse, error)
 }
 
 type environmentService struct {
@@ -62,6 +69,10 @@ func NewEnvironmentService(logger *slog.Logger, apiPlatformClient apiplatformcli
 func (s *environmentService) CreateEnvironment(ctx context.Context, orgName string, req *models.CreateEnvironmentRequest) (*models.GatewayEnvironmentResponse, error) {
 	s.logger.Info("Creating environment", "name", req.Name, "orgName", orgName)
 
+	if err := ValidateRegion(req.Region); err != nil {
+		return nil, err
+	}
+
 	env := &models.Environment{
 		UUID:             uuid.New(),
 		OrganizationName: orgName,
@@ -71,6 +82,7 @@ func (s *environmentService) CreateEnvironment(ctx context.Context, orgName stri
 		DataplaneRef:     req.DataplaneRef,
 		DNSPrefix:        req.DNSPrefix,
 		IsProduction:     req.IsProduction,
+		Region:           req.Region,
 		CreatedAt:        time.Now(),
 		UpdatedAt:        time.Now(),
 	}
@@ -129,8 +141,8 @@ func (s *environmentService) GetEnvironment(ctx context.Context, orgName string,
 	return env.ToResponse(), nil
 }
 
-func (s *environmentService) ListEnvironments(ctx context.Context, orgName string, limit, offset int32) (*models.EnvironmentListResponse, error) {
-	s.logger.Info("Listing environments from OpenChoreo", "orgName", orgName, "limit", limit, "offset", offset)
+func (s *environmentService) ListEnvironments(ctx context.Context, orgName string, region string, limit, offset int32) (*models.EnvironmentListResponse, error) {
+	s.logger.Info("Listing environments from OpenChoreo", "orgName", orgName, "region", region, "limit", limit, "offset", offset)
 
 	// Fetch environments directly from OpenChoreo
 	ocEnvironments, err := s.ocClient.ListEnvironments(ctx, orgName)
@@ -139,6 +151,21 @@ func (s *environmentService) ListEnvironments(ctx context.Context, orgName strin
 		return nil, fmt.Errorf("failed to list environments: %w", err)
 	}
 
+	// Regions are not known to OpenChoreo, so they are read from the synced environments
+	regions, err := s.getEnvironmentRegions(ctx, orgName)
+	if err != nil {
+		return nil, err
+	}
+	if region != "" {
+		inRegion := make([]*models.EnvironmentResponse, 0, len(ocEnvironments))
+		for _, env := range ocEnvironments {
+			if regions[env.UUID] == region {
+				inRegion = append(inRegion, env)
+			}
+		}
+		ocEnvironments = inRegion
+	}
+
 	total := int32(len(ocEnvironments))
 
 	// Apply pagination
@@ -173,6 +200,7 @@ func (s *environmentService) ListEnvironments(ctx context.Context, orgName strin
 			DataplaneRef:     env.DataplaneRef,
 			DNSPrefix:        env.DNSPrefix,
 			IsProduction:     env.IsProduction,
+			Region:           regions[env.UUID],
 			CreatedAt:        env.CreatedAt,
 			UpdatedAt:        env.CreatedAt,
 		}
@@ -186,7 +214,7 @@ func (s *environmentService) ListEnvironments(ctx context.Context, orgName strin
 	}, nil
 }
 
-func (s *environmentService) UpdateEnvironment(ctx context.Context, orgName string, envID string, req *models.UpdateEnvironmentRequest) (*models.GatewayEnvironmentResponse, error) {
+func (s *environmentService) UpdateEnvironment(ctx context.Context, orgName string, envID string, req *models.UpdateEnvironmentRequest, ifMatch string) (*models.GatewayEnvironmentResponse, error) {
 	s.logger.Info("Updating environment", "envID", envID, "orgName", orgName)
 
 	envUUID, err := uuid.Parse(envID)
@@ -195,30 +223,47 @@ func (s *environmentService) UpdateEnvironment(ctx context.Context, orgName stri
 	}
 
 	var env models.Environment
-	err = db.DB(ctx).Where("uuid = ? AND organization_name = ?", envUUID, orgName).First(&env).Error
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, utils.ErrEnvironmentNotFound
+	// The row is locked so that the If-Match check and the update cannot interleave with another update
+	err = db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("uuid = ? AND organization_name = ?", envUUID, orgName).First(&env).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return utils.ErrEnvironmentNotFound
+			}
+			return fmt.Errorf("failed to get environment: %w", err)
+		}
+		if err := utils.CheckIfMatch(ifMatch, env.ToResponse()); err != nil {
+			return err
 		}
-		return nil, fmt.Errorf("failed to get environment: %w", err)
-	}
 
-	if req.DisplayName != nil {
-		env.DisplayName = *req.DisplayName
-	}
-	if req.Description != nil {
-		env.Description = *req.Description
-	}
-	env.UpdatedAt = time.Now()
+		if req.DisplayName != nil {
+			env.DisplayName = *req.DisplayName
+		}
+		if req.Description != nil {
+			env.Description = *req.Description
+		}
+		if req.Region != nil && *req.Region != env.Region {
+			if err := s.checkRegionChange(ctx, tx, &env, *req.Region); err != nil {
+				return err
+			}
+			env.Region = *req.Region
+		}
+		env.UpdatedAt = time.Now()
 
-	if err := db.DB(ctx).Save(&env).Error; err != nil {
-		return nil, fmt.Errorf("failed to update environment: %w", err)
+		if err := tx.Save(&env).Error; err != nil {
+			return fmt.Errorf("failed to update environment: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return env.ToResponse(), nil
 }
 
-func (s *environmentService) DeleteEnvironment(ctx context.Context, orgName string, envID string) error {
+func (s *environmentService) DeleteEnvironment(ctx context.Context, orgName string, envID string, ifMatch string) error {
 	s.logger.Info("Deleting environment", "envID", envID, "orgName", orgName)
 
 	envUUID, err := uuid.Parse(envID)
@@ -228,6 +273,21 @@ func (s *environmentService) DeleteEnvironment(ctx context.Context, orgName stri
 
 	// Wrap in transaction to handle race conditions with gateway assignments
 	err = db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if ifMatch != "" {
+			var env models.Environment
+			err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+				Where("uuid = ? AND organization_name = ?", envUUID, orgName).First(&env).Error
+			if err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return utils.ErrEnvironmentNotFound
+				}
+				return fmt.Errorf("failed to get environment: %w", err)
+			}
+			if err := utils.CheckIfMatch(ifMatch, env.ToResponse()); err != nil {
+				return err
+			}
+		}
+
 		// Check if environment has associated gateways within the transaction
 		var count int64
 		if err := tx.Model(&models.GatewayEnvironmentMapping{}).Where("environment_uuid = ?", envUUID).Count(&count).Error; err != nil {
@@ -249,7 +309,7 @@ func (s *environmentService) DeleteEnvironment(ctx context.Context, orgName stri
 		return nil
 	})
 	if err != nil {
-		if errors.Is(err, utils.ErrEnvironmentHasGateways) || errors.Is(err, utils.ErrEnvironmentNotFound) {
+		if errors.Is(err, utils.ErrEnvironmentHasGateways) || errors.Is(err, utils.ErrEnvironmentNotFound) || errors.Is(err, utils.ErrPreconditionFailed) {
 			return err
 		}
 		s.logger.Error("Failed to delete environment", "error", err)
@@ -307,6 +367,7 @@ func (s *environmentService) GetEnvironmentGateways(ctx context.Context, orgName
 			GatewayType:      gateway.FunctionalityType,
 			VHost:            gateway.Vhost,
 			IsCritical:       gateway.IsCritical,
+			Region:           GatewayRegion(gateway),
 			Status:           convertAPIPlatformStatusToModelStatus(gateway.IsActive),
 			CreatedAt:        gateway.CreatedAt,
 			UpdatedAt:        gateway.UpdatedAt,
@@ -316,6 +377,76 @@ func (s *environmentService) GetEnvironmentGateways(ctx context.Context, orgName
 	return responses, nil
 }
 
+func (s *environmentService) ListRegions(ctx context.Context, orgName string) (*models.RegionListResponse, error) {
+	var counts []struct {
+		Region string
+		Count  int64
+	}
+	err := db.DB(ctx).Model(&models.Environment{}).
+		Select("region, COUNT(*) AS count").
+		Where("organization_name = ?", orgName).
+		Group("region").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to count environments by region: %w", err)
+	}
+	environmentCounts := make(map[string]int64, len(counts))
+	for _, c := range counts {
+		environmentCounts[c.Region] = c.Count
+	}
+
+	catalog := config.GetConfig().Regions
+	regions := make([]models.Region, len(catalog))
+	for i, name := range catalog {
+		regions[i] = models.Region{Name: name, EnvironmentCount: environmentCounts[name]}
+	}
+	return &models.RegionListResponse{Regions: regions}, nil
+}
+
+// getEnvironmentRegions returns the regions of the environments of an organization by environment UUID
+func (s *environmentService) getEnvironmentRegions(ctx context.Context, orgName string) (map[string]string, error) {
+	var envs []models.Environment
+	err := db.DB(ctx).Select("uuid", "region").
+		Where("organization_name = ? AND region <> ''", orgName).
+		Find(&envs).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get environment regions: %w", err)
+	}
+	regions := make(map[string]string, len(envs))
+	for _, env := range envs {
+		regions[env.UUID.String()] = env.Region
+	}
+	return regions, nil
+}
+
+// checkRegionChange checks that an environment can move to a region, which requires the region to
+// be in the catalog and every gateway of the environment to be able to serve it
+func (s *environmentService) checkRegionChange(ctx context.Context, tx *gorm.DB, env *models.Environment, region string) error {
+	if err := ValidateRegion(region); err != nil {
+		return err
+	}
+	if region == "" {
+		return nil
+	}
+
+	var mappings []models.GatewayEnvironmentMapping
+	if err := tx.Where("environment_uuid = ?", env.UUID).Find(&mappings).Error; err != nil {
+		return fmt.Errorf("failed to get gateway mappings: %w", err)
+	}
+	moved := *env
+	moved.Region = region
+	for _, mapping := range mappings {
+		gateway, err := s.apiPlatformClient.GetGateway(ctx, mapping.GatewayUUID.String())
+		if err != nil {
+			return fmt.Errorf("failed to get gateway %s: %w", mapping.GatewayUUID, err)
+		}
+		if err := CheckGatewayRegion(&moved, GatewayRegion(gateway)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // convertAPIPlatformStatusToModelStatus converts API Platform gateway active status to model status
 func convertAPIPlatformStatusToModelStatus(isActive bool) string {
 	if isActive {
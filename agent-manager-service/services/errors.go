@@ -0,0 +1,32 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"net/http"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// Registers the errors declared in this package, so that controllers report them with their own codes
+func init() {
+	utils.RegisterError(
+		utils.ErrorDefinition{Err: ErrTraceNotFound, Status: http.StatusNotFound, Code: "TRACE_NOT_FOUND", Message: "Trace not found"},
+		utils.ErrorDefinition{Err: ErrTraceJudgeNotConfigured, Status: http.StatusNotImplemented, Code: "TRACE_SCORING_NOT_CONFIGURED", Message: "Trace scoring is not configured"},
+		utils.ErrorDefinition{Err: ErrTraceLogsNotConfigured, Status: http.StatusNotImplemented, Code: "LOG_CORRELATION_NOT_CONFIGURED", Message: "Log correlation is not configured"},
+	)
+}
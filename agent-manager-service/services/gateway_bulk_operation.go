@@ -0,0 +1,231 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	apiplatformclient "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/apiplatformsvc/client"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// GatewayBulkOperationService runs an action on many gateways as a background job, so that
+// clients do not loop over the single gateway endpoints
+type GatewayBulkOperationService interface {
+	// StartBulkOperation records the operation and returns it while the gateways are processed
+	// one by one in the background. During a change freeze, token rotations need a break-glass reason.
+	StartBulkOperation(ctx context.Context, orgName string, requestedBy string, req *models.CreateGatewayBulkOperationRequest, breakGlassReason string) (*models.GatewayBulkOperationResponse, error)
+	GetBulkOperation(ctx context.Context, orgName string, operationID string) (*models.GatewayBulkOperationResponse, error)
+}
+
+type gatewayBulkOperationService struct {
+	logger              *slog.Logger
+	apiPlatformClient   apiplatformclient.APIPlatformClient
+	eventService        ResourceEventService
+	changeFreezeService ChangeFreezeService
+}
+
+// NewGatewayBulkOperationService creates a new gateway bulk operation service
+func NewGatewayBulkOperationService(logger *slog.Logger, apiPlatformClient apiplatformclient.APIPlatformClient, eventService ResourceEventService,
+	changeFreezeService ChangeFreezeService,
+) GatewayBulkOperationService {
+	return &gatewayBulkOperationService{
+		logger:              logger,
+		apiPlatformClient:   apiPlatformClient,
+		eventService:        eventService,
+		changeFreezeService: changeFreezeService,
+	}
+}
+
+func (s *gatewayBulkOperationService) StartBulkOperation(ctx context.Context, orgName string, requestedBy string, req *models.CreateGatewayBulkOperationRequest, breakGlassReason string) (*models.GatewayBulkOperationResponse, error) {
+	if req.Action == models.GatewayBulkActionRotateToken {
+		// Rotated tokens are kept encrypted until the client reads them
+		if _, err := credentialsEncryptionKey(); err != nil {
+			return nil, err
+		}
+		resource := "gateways/" + strings.Join(req.GatewayIDs, ",")
+		if err := s.changeFreezeService.CheckChangeAllowed(ctx, orgName, models.ChangeOperationTokenRotation, resource, requestedBy, breakGlassReason); err != nil {
+			return nil, err
+		}
+	}
+
+	operation := &models.GatewayBulkOperation{
+		UUID:             uuid.New(),
+		OrganizationName: orgName,
+		Action:           req.Action,
+		Status:           models.GatewayBulkOperationStatusRunning,
+		RequestedBy:      requestedBy,
+		Items:            make([]models.GatewayBulkOperationItem, len(req.GatewayIDs)),
+		CreatedAt:        time.Now(),
+	}
+	for i, gatewayID := range req.GatewayIDs {
+		operation.Items[i] = models.GatewayBulkOperationItem{GatewayID: gatewayID, Status: models.GatewayBulkItemStatusPending}
+	}
+	if err := db.DB(ctx).Create(operation).Error; err != nil {
+		return nil, fmt.Errorf("failed to save gateway bulk operation: %w", err)
+	}
+	s.logger.Info("Started gateway bulk operation", "orgName", orgName, "operationId", operation.UUID, "action", req.Action, "gateways", len(req.GatewayIDs), "requestedBy", requestedBy)
+
+	response, err := s.toResponse(operation)
+	if err != nil {
+		return nil, err
+	}
+	// The operation outlives the request, while keeping its correlation ID and logger
+	go s.run(context.WithoutCancel(ctx), operation)
+	return response, nil
+}
+
+func (s *gatewayBulkOperationService) GetBulkOperation(ctx context.Context, orgName string, operationID string) (*models.GatewayBulkOperationResponse, error) {
+	id, err := uuid.Parse(operationID)
+	if err != nil {
+		return nil, utils.ErrGatewayBulkOperationNotFound
+	}
+	var operation models.GatewayBulkOperation
+	if err := db.DB(ctx).Where("uuid = ? AND organization_name = ?", id, orgName).First(&operation).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, utils.ErrGatewayBulkOperationNotFound
+		}
+		return nil, fmt.Errorf("failed to get gateway bulk operation: %w", err)
+	}
+	return s.toResponse(&operation)
+}
+
+// run processes the gateways of an operation in order, saving the progress after each gateway.
+// Gateways are processed one at a time to stay within the API Platform rate limits.
+func (s *gatewayBulkOperationService) run(ctx context.Context, operation *models.GatewayBulkOperation) {
+	for i := range operation.Items {
+		item := &operation.Items[i]
+		var err error
+		switch operation.Action {
+		case models.GatewayBulkActionDelete:
+			err = s.deleteGateway(ctx, item.GatewayID)
+		case models.GatewayBulkActionRotateToken:
+			err = s.rotateGatewayToken(ctx, item)
+		default:
+			err = fmt.Errorf("unsupported action %q", operation.Action)
+		}
+		if err != nil {
+			s.logger.Warn("Gateway bulk operation failed for gateway", "operationId", operation.UUID, "gatewayId", item.GatewayID, "error", err)
+			item.Status = models.GatewayBulkItemStatusFailed
+			item.Error = err.Error()
+		} else {
+			item.Status = models.GatewayBulkItemStatusSucceeded
+			s.recordEvent(ctx, operation, item)
+		}
+
+		if i == len(operation.Items)-1 {
+			now := time.Now()
+			operation.Status = models.GatewayBulkOperationStatusCompleted
+			operation.CompletedAt = &now
+		}
+		if err := db.DB(ctx).Model(operation).Select("items", "status", "completed_at").Updates(operation).Error; err != nil {
+			s.logger.Error("Failed to save gateway bulk operation progress", "operationId", operation.UUID, "error", err)
+		}
+	}
+	s.logger.Info("Gateway bulk operation completed", "operationId", operation.UUID, "action", operation.Action)
+}
+
+// recordEvent adds the action taken on a gateway to the gateway's timeline
+func (s *gatewayBulkOperationService) recordEvent(ctx context.Context, operation *models.GatewayBulkOperation, item *models.GatewayBulkOperationItem) {
+	details := map[string]interface{}{"bulkOperationId": operation.UUID.String()}
+	eventType := models.ResourceEventDeleted
+	if operation.Action == models.GatewayBulkActionRotateToken {
+		eventType = models.ResourceEventTokenRotated
+		details["tokenId"] = item.TokenID
+	}
+	s.eventService.RecordEvent(ctx, operation.OrganizationName, models.ResourceTypeGateway, item.GatewayID, eventType, operation.RequestedBy, details)
+}
+
+// deleteGateway deletes a gateway from API Platform along with its environment mappings
+func (s *gatewayBulkOperationService) deleteGateway(ctx context.Context, gatewayID string) error {
+	if err := s.apiPlatformClient.DeleteGateway(ctx, gatewayID); err != nil {
+		return err
+	}
+	if err := db.DB(ctx).Where("gateway_uuid = ?", gatewayID).Delete(&models.GatewayEnvironmentMapping{}).Error; err != nil {
+		s.logger.Warn("Failed to delete gateway-environment mappings", "gatewayId", gatewayID, "error", err)
+	}
+	return nil
+}
+
+func (s *gatewayBulkOperationService) rotateGatewayToken(ctx context.Context, item *models.GatewayBulkOperationItem) error {
+	token, err := s.apiPlatformClient.RotateGatewayToken(ctx, item.GatewayID)
+	if err != nil {
+		return err
+	}
+	key, err := credentialsEncryptionKey()
+	if err != nil {
+		return err
+	}
+	encrypted, err := utils.EncryptSecret([]byte(token.Token), key)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt gateway token: %w", err)
+	}
+	item.TokenID = token.TokenID
+	item.EncryptedToken = encrypted
+	item.ExpiresAt = token.ExpiresAt
+	return nil
+}
+
+func (s *gatewayBulkOperationService) toResponse(operation *models.GatewayBulkOperation) (*models.GatewayBulkOperationResponse, error) {
+	response := &models.GatewayBulkOperationResponse{
+		ID:          operation.UUID.String(),
+		Action:      operation.Action,
+		Status:      operation.Status,
+		RequestedBy: operation.RequestedBy,
+		Items:       make([]models.GatewayBulkOperationItemResponse, len(operation.Items)),
+		CreatedAt:   operation.CreatedAt,
+		CompletedAt: operation.CompletedAt,
+	}
+	for i, item := range operation.Items {
+		itemResponse := models.GatewayBulkOperationItemResponse{
+			GatewayID: item.GatewayID,
+			Status:    item.Status,
+			Error:     item.Error,
+			TokenID:   item.TokenID,
+			ExpiresAt: item.ExpiresAt,
+		}
+		if len(item.EncryptedToken) > 0 {
+			key, err := credentialsEncryptionKey()
+			if err != nil {
+				return nil, err
+			}
+			token, err := utils.DecryptSecret(item.EncryptedToken, key)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decrypt gateway token: %w", err)
+			}
+			itemResponse.Token = string(token)
+		}
+		switch item.Status {
+		case models.GatewayBulkItemStatusSucceeded:
+			response.Succeeded++
+		case models.GatewayBulkItemStatusFailed:
+			response.Failed++
+		}
+		response.Items[i] = itemResponse
+	}
+	return response, nil
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/google/uuid"
+
+	apiplatformclient "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/apiplatformsvc/client"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+)
+
+// GatewayOrganizationProperty is the API Platform gateway property that holds the organization a
+// gateway was registered for. The API Platform lists the gateways of every organization together.
+const GatewayOrganizationProperty = "organization"
+
+// GatewayProperties returns the properties of a new gateway of an organization: the given
+// properties and the organization
+func GatewayProperties(orgName string, properties map[string]interface{}) *map[string]interface{} {
+	result := make(map[string]interface{}, len(properties)+1)
+	maps.Copy(result, properties)
+	result[GatewayOrganizationProperty] = orgName
+	return &result
+}
+
+// GatewayOrganization returns the organization of an API Platform gateway, or "" for gateways
+// registered before the organization was recorded
+func GatewayOrganization(gw *apiplatformclient.GatewayResponse) string {
+	orgName, _ := gw.Properties[GatewayOrganizationProperty].(string)
+	return orgName
+}
+
+// GatewayBelongsToOrganization reports whether a gateway is one of the organization's. Gateways
+// registered before their organization was recorded belong to the organization whose
+// environments they serve.
+func GatewayBelongsToOrganization(gw *apiplatformclient.GatewayResponse, orgName string, servesOrgEnvironments bool) bool {
+	gwOrgName := GatewayOrganization(gw)
+	return gwOrgName == orgName || (gwOrgName == "" && servesOrgEnvironments)
+}
+
+// gatewaysServingOrganization returns the IDs of the gateways assigned to environments of the
+// organization
+func gatewaysServingOrganization(ctx context.Context, orgName string) (map[string]bool, error) {
+	var gatewayUUIDs []uuid.UUID
+	err := db.DB(ctx).Model(&models.GatewayEnvironmentMapping{}).
+		Joins("JOIN environments ON environments.uuid = gateway_environment_mappings.environment_uuid").
+		Where("environments.organization_name = ?", orgName).
+		Distinct().
+		Pluck("gateway_environment_mappings.gateway_uuid", &gatewayUUIDs).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list gateways of the organization's environments: %w", err)
+	}
+	serving := make(map[string]bool, len(gatewayUUIDs))
+	for _, id := range gatewayUUIDs {
+		serving[id.String()] = true
+	}
+	return serving, nil
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	apiplatformclient "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/apiplatformsvc/client"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// CheckGatewayVhost checks that no gateway of the organization serves vhost yet, so that two
+// gateways never claim the same host
+func CheckGatewayVhost(ctx context.Context, apiPlatformClient apiplatformclient.APIPlatformClient, vhost string) error {
+	gateways, err := apiPlatformClient.ListGateways(ctx, apiplatformclient.GatewayFilters{})
+	if err != nil {
+		return fmt.Errorf("failed to list gateways: %w", err)
+	}
+	if names := vhostConflicts(gateways.Gateways, vhost, ""); len(names) > 0 {
+		return newVhostConflictError(vhost, names)
+	}
+	return nil
+}
+
+// vhostConflicts returns the names of the gateways that serve vhost, other than the gateway named
+// except. Host names are case-insensitive.
+func vhostConflicts(gateways []*apiplatformclient.GatewayResponse, vhost, except string) []string {
+	var names []string
+	for _, gw := range gateways {
+		if gw.Name != except && strings.EqualFold(gw.Vhost, vhost) {
+			names = append(names, gw.Name)
+		}
+	}
+	return names
+}
+
+func newVhostConflictError(vhost string, gatewayNames []string) error {
+	return fmt.Errorf("%w: %s is served by gateway %s", utils.ErrGatewayVhostConflict, vhost, strings.Join(gatewayNames, ", "))
+}
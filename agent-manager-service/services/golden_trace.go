@@ -0,0 +1,435 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+const (
+	// defaultGoldenTraceThreshold is the score a run must reach to pass when none is set
+	defaultGoldenTraceThreshold = 0.8
+	// minGoldenTraceReplayIntervalMinutes keeps scheduled runs from flooding an agent
+	minGoldenTraceReplayIntervalMinutes = 5
+	// maxGoldenTraceNameLength matches the name column
+	maxGoldenTraceNameLength = 100
+	// goldenTraceRunTimeout is how long a run waits for the agent's spans of the replayed request
+	goldenTraceRunTimeout = 15 * time.Minute
+	// goldenTraceScheduler is recorded as the requester of the replays of scheduled runs
+	goldenTraceScheduler = "golden-trace-scheduler"
+)
+
+func (s *observabilityManagerService) CreateGoldenTrace(ctx context.Context, orgName, projectName, agentName, createdBy string, req *models.CreateGoldenTraceRequest) (*models.GoldenTraceResponse, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" || len(name) > maxGoldenTraceNameLength {
+		return nil, fmt.Errorf("%w: name is required and must be at most %d characters", utils.ErrInvalidInput, maxGoldenTraceNameLength)
+	}
+	if req.TraceID == "" || req.Environment == "" {
+		return nil, fmt.Errorf("%w: traceId and environment are required", utils.ErrInvalidInput)
+	}
+	threshold := defaultGoldenTraceThreshold
+	if req.Threshold != nil {
+		threshold = *req.Threshold
+	}
+	if threshold < 0 || threshold > 1 {
+		return nil, fmt.Errorf("%w: threshold must be between 0 and 1", utils.ErrInvalidInput)
+	}
+	if req.ReplayIntervalMinutes < 0 || (req.ReplayIntervalMinutes > 0 && req.ReplayIntervalMinutes < minGoldenTraceReplayIntervalMinutes) {
+		return nil, fmt.Errorf("%w: replayIntervalMinutes must be 0 or at least %d", utils.ErrInvalidInput, minGoldenTraceReplayIntervalMinutes)
+	}
+	if err := validateReplayPath(req.Path); err != nil {
+		return nil, err
+	}
+
+	trace, err := s.GetTraceDetails(ctx, TraceDetailsRequest{
+		TraceID:     req.TraceID,
+		OrgName:     orgName,
+		ProjectName: projectName,
+		AgentName:   agentName,
+		Environment: req.Environment,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if trace.Input == nil {
+		return nil, utils.ErrTraceReplayNoInput
+	}
+	snapshot := snapshotTrace(trace)
+	snapshotJSON, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode golden trace snapshot: %w", err)
+	}
+
+	now := time.Now()
+	golden := &models.GoldenTrace{
+		UUID:                  uuid.New(),
+		OrganizationName:      orgName,
+		ProjectName:           projectName,
+		AgentName:             agentName,
+		EnvironmentName:       req.Environment,
+		Name:                  name,
+		TraceID:               req.TraceID,
+		Snapshot:              string(snapshotJSON),
+		Endpoint:              req.Endpoint,
+		Path:                  req.Path,
+		Threshold:             threshold,
+		ReplayIntervalMinutes: req.ReplayIntervalMinutes,
+		CreatedBy:             createdBy,
+		CreatedAt:             now,
+		UpdatedAt:             now,
+	}
+	if golden.ReplayIntervalMinutes > 0 {
+		nextRunAt := now.Add(time.Duration(golden.ReplayIntervalMinutes) * time.Minute)
+		golden.NextRunAt = &nextRunAt
+	}
+	if err := db.DB(ctx).Create(golden).Error; err != nil {
+		if isUniqueViolation(err) {
+			return nil, utils.ErrGoldenTraceAlreadyExists
+		}
+		return nil, fmt.Errorf("failed to save golden trace: %w", err)
+	}
+	s.logger.Info("Created golden trace", "agentName", agentName, "goldenTraceId", golden.UUID, "traceId", req.TraceID, "steps", len(snapshot.Steps))
+
+	response := golden.ToResponse()
+	response.Snapshot = snapshot
+	return response, nil
+}
+
+func (s *observabilityManagerService) ListGoldenTraces(ctx context.Context, orgName, projectName, agentName string) (*models.GoldenTraceListResponse, error) {
+	var goldens []models.GoldenTrace
+	if err := db.DB(ctx).
+		Where("organization_name = ? AND project_name = ? AND agent_name = ?", orgName, projectName, agentName).
+		Order("name").
+		Find(&goldens).Error; err != nil {
+		return nil, fmt.Errorf("failed to list golden traces: %w", err)
+	}
+	response := &models.GoldenTraceListResponse{GoldenTraces: make([]models.GoldenTraceResponse, 0, len(goldens))}
+	for i := range goldens {
+		response.GoldenTraces = append(response.GoldenTraces, *goldens[i].ToResponse())
+	}
+	return response, nil
+}
+
+func (s *observabilityManagerService) GetGoldenTrace(ctx context.Context, orgName, projectName, agentName, goldenTraceID string) (*models.GoldenTraceResponse, error) {
+	golden, err := getGoldenTrace(db.DB(ctx), orgName, projectName, agentName, goldenTraceID)
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := decodeGoldenTraceSnapshot(golden)
+	if err != nil {
+		return nil, err
+	}
+	response := golden.ToResponse()
+	response.Snapshot = snapshot
+	return response, nil
+}
+
+func (s *observabilityManagerService) DeleteGoldenTrace(ctx context.Context, orgName, projectName, agentName, goldenTraceID string) error {
+	return db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		golden, err := getGoldenTrace(tx, orgName, projectName, agentName, goldenTraceID)
+		if err != nil {
+			return err
+		}
+		if err := tx.Where("golden_trace_uuid = ?", golden.UUID).Delete(&models.GoldenTraceRun{}).Error; err != nil {
+			return fmt.Errorf("failed to delete golden trace runs: %w", err)
+		}
+		if err := tx.Delete(golden).Error; err != nil {
+			return fmt.Errorf("failed to delete golden trace: %w", err)
+		}
+		return nil
+	})
+}
+
+func (s *observabilityManagerService) RunGoldenTrace(ctx context.Context, orgName, projectName, agentName, goldenTraceID, requestedBy string) (*models.GoldenTraceRunResponse, error) {
+	golden, err := getGoldenTrace(db.DB(ctx), orgName, projectName, agentName, goldenTraceID)
+	if err != nil {
+		return nil, err
+	}
+	run, err := s.startGoldenTraceRun(ctx, golden, requestedBy)
+	if err != nil {
+		return nil, err
+	}
+	return run.ToResponse(), nil
+}
+
+func (s *observabilityManagerService) ListGoldenTraceRuns(ctx context.Context, orgName, projectName, agentName, goldenTraceID string) (*models.GoldenTraceRunListResponse, error) {
+	golden, err := getGoldenTrace(db.DB(ctx), orgName, projectName, agentName, goldenTraceID)
+	if err != nil {
+		return nil, err
+	}
+	var runs []models.GoldenTraceRun
+	if err := db.DB(ctx).Where("golden_trace_uuid = ?", golden.UUID).Order("created_at DESC").Find(&runs).Error; err != nil {
+		return nil, fmt.Errorf("failed to list golden trace runs: %w", err)
+	}
+	response := &models.GoldenTraceRunListResponse{Runs: make([]models.GoldenTraceRunResponse, 0, len(runs))}
+	for i := range runs {
+		response.Runs = append(response.Runs, *runs[i].ToResponse())
+	}
+	return response, nil
+}
+
+func (s *observabilityManagerService) GetGoldenTraceRun(ctx context.Context, orgName, projectName, agentName, goldenTraceID, runID string) (*models.GoldenTraceRunResponse, error) {
+	golden, err := getGoldenTrace(db.DB(ctx), orgName, projectName, agentName, goldenTraceID)
+	if err != nil {
+		return nil, err
+	}
+	id, err := uuid.Parse(runID)
+	if err != nil {
+		return nil, utils.ErrGoldenTraceRunNotFound
+	}
+	var run models.GoldenTraceRun
+	if err := db.DB(ctx).Where("uuid = ? AND golden_trace_uuid = ?", id, golden.UUID).First(&run).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, utils.ErrGoldenTraceRunNotFound
+		}
+		return nil, fmt.Errorf("failed to get golden trace run: %w", err)
+	}
+	if run.Status == models.GoldenTraceRunStatusPending {
+		s.scoreGoldenTraceRun(ctx, golden, &run)
+	}
+
+	response := run.ToResponse()
+	if run.Diff != "" {
+		if err := json.Unmarshal([]byte(run.Diff), &response.Diff); err != nil {
+			return nil, fmt.Errorf("failed to decode golden trace run diff: %w", err)
+		}
+	}
+	return response, nil
+}
+
+func (s *observabilityManagerService) RunGoldenTraceScheduler(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.startDueGoldenTraceRuns(ctx)
+			s.scorePendingGoldenTraceRuns(ctx)
+		}
+	}
+}
+
+// startDueGoldenTraceRuns starts a run of each scheduled golden trace whose next run is due
+func (s *observabilityManagerService) startDueGoldenTraceRuns(ctx context.Context) {
+	now := time.Now()
+	var goldens []models.GoldenTrace
+	if err := db.DB(ctx).Where("replay_interval_minutes > 0 AND next_run_at <= ?", now).Find(&goldens).Error; err != nil {
+		s.logger.Error("Failed to load due golden traces", "error", err)
+		return
+	}
+	for i := range goldens {
+		if ctx.Err() != nil {
+			return
+		}
+		golden := &goldens[i]
+		// Moving the next run only where it is unchanged lets one replica claim the run
+		nextRunAt := now.Add(time.Duration(golden.ReplayIntervalMinutes) * time.Minute)
+		result := db.DB(ctx).Model(&models.GoldenTrace{}).
+			Where("uuid = ? AND next_run_at = ?", golden.UUID, golden.NextRunAt).
+			UpdateColumn("next_run_at", nextRunAt)
+		if result.Error != nil {
+			s.logger.Error("Failed to schedule golden trace run", "goldenTraceId", golden.UUID, "error", result.Error)
+			continue
+		}
+		if result.RowsAffected == 0 {
+			continue
+		}
+		if _, err := s.startGoldenTraceRun(ctx, golden, goldenTraceScheduler); err != nil {
+			s.logger.Error("Failed to start golden trace run", "goldenTraceId", golden.UUID, "error", err)
+		}
+	}
+}
+
+// scorePendingGoldenTraceRuns scores the runs whose replayed traces have been recorded since
+func (s *observabilityManagerService) scorePendingGoldenTraceRuns(ctx context.Context) {
+	var runs []models.GoldenTraceRun
+	if err := db.DB(ctx).Where("status = ?", models.GoldenTraceRunStatusPending).Order("created_at").Find(&runs).Error; err != nil {
+		s.logger.Error("Failed to load pending golden trace runs", "error", err)
+		return
+	}
+	goldens := make(map[uuid.UUID]*models.GoldenTrace)
+	for i := range runs {
+		if ctx.Err() != nil {
+			return
+		}
+		run := &runs[i]
+		golden, ok := goldens[run.GoldenTraceUUID]
+		if !ok {
+			golden = &models.GoldenTrace{}
+			if err := db.DB(ctx).Where("uuid = ?", run.GoldenTraceUUID).First(golden).Error; err != nil {
+				s.logger.Error("Failed to load golden trace of run", "goldenTraceId", run.GoldenTraceUUID, "runId", run.UUID, "error", err)
+				continue
+			}
+			goldens[run.GoldenTraceUUID] = golden
+		}
+		s.scoreGoldenTraceRun(ctx, golden, run)
+	}
+}
+
+// startGoldenTraceRun sends the root input of a golden trace to the agent. The run is scored once
+// the agent's spans of the request are recorded. A run whose request cannot be sent ends as an error.
+func (s *observabilityManagerService) startGoldenTraceRun(ctx context.Context, golden *models.GoldenTrace, requestedBy string) (*models.GoldenTraceRun, error) {
+	snapshot, err := decodeGoldenTraceSnapshot(golden)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	run := &models.GoldenTraceRun{
+		UUID:            uuid.New(),
+		GoldenTraceUUID: golden.UUID,
+		Status:          models.GoldenTraceRunStatusPending,
+		CreatedAt:       now,
+	}
+	replay, err := s.replayInput(ctx, TraceReplayRequest{
+		TraceID:     golden.TraceID,
+		OrgName:     golden.OrganizationName,
+		ProjectName: golden.ProjectName,
+		AgentName:   golden.AgentName,
+		Environment: golden.EnvironmentName,
+		RequestedBy: requestedBy,
+		Endpoint:    golden.Endpoint,
+		Path:        golden.Path,
+	}, snapshot.Input)
+	switch {
+	case err != nil:
+		run.Status = models.GoldenTraceRunStatusError
+		run.Error = err.Error()
+		run.CompletedAt = &now
+	case replay.record.Error != "":
+		run.Status = models.GoldenTraceRunStatusError
+		run.Error = replay.record.Error
+		run.CompletedAt = &now
+	default:
+		run.ReplayTraceID = replay.record.ReplayTraceID
+		run.ReplayParentSpanID = replay.parentSpanID
+	}
+
+	if err := db.DB(ctx).Create(run).Error; err != nil {
+		return nil, fmt.Errorf("failed to save golden trace run: %w", err)
+	}
+	s.logger.Info("Started golden trace run", "goldenTraceId", golden.UUID, "runId", run.UUID, "replayTraceId", run.ReplayTraceID, "status", run.Status)
+	return run, nil
+}
+
+// scoreGoldenTraceRun compares the replayed trace of a pending run against the golden trace once
+// the agent's entry span, which ends last, is recorded. Failing to reach the trace observer leaves
+// the run pending.
+func (s *observabilityManagerService) scoreGoldenTraceRun(ctx context.Context, golden *models.GoldenTrace, run *models.GoldenTraceRun) {
+	trace, err := s.GetTraceDetails(ctx, TraceDetailsRequest{
+		TraceID:     run.ReplayTraceID,
+		OrgName:     golden.OrganizationName,
+		ProjectName: golden.ProjectName,
+		AgentName:   golden.AgentName,
+		Environment: golden.EnvironmentName,
+	})
+	if err != nil && !errors.Is(err, ErrTraceNotFound) {
+		s.logger.Warn("Failed to get replayed trace of golden trace run", "runId", run.UUID, "replayTraceId", run.ReplayTraceID, "error", err)
+		return
+	}
+
+	now := time.Now()
+	if err != nil || !hasEntrySpan(trace, run.ReplayParentSpanID) {
+		if now.Sub(run.CreatedAt) < goldenTraceRunTimeout {
+			return
+		}
+		run.Status = models.GoldenTraceRunStatusError
+		run.Error = "the replayed trace was not recorded; the agent may not propagate the W3C trace context"
+		run.CompletedAt = &now
+	} else {
+		goldenSnapshot, err := decodeGoldenTraceSnapshot(golden)
+		if err != nil {
+			s.logger.Error("Failed to score golden trace run", "runId", run.UUID, "error", err)
+			return
+		}
+		comparison := compareSnapshots(goldenSnapshot, snapshotTrace(trace))
+		diff, err := json.Marshal(comparison.diff)
+		if err != nil {
+			s.logger.Error("Failed to encode golden trace run diff", "runId", run.UUID, "error", err)
+			return
+		}
+		run.Status = models.GoldenTraceRunStatusFailed
+		if comparison.structuralScore >= golden.Threshold && comparison.semanticScore >= golden.Threshold {
+			run.Status = models.GoldenTraceRunStatusPassed
+		}
+		run.StructuralScore = &comparison.structuralScore
+		run.SemanticScore = &comparison.semanticScore
+		run.Diff = string(diff)
+		run.CompletedAt = &now
+	}
+
+	if err := db.DB(ctx).Model(run).UpdateColumns(map[string]interface{}{
+		"status":           run.Status,
+		"structural_score": run.StructuralScore,
+		"semantic_score":   run.SemanticScore,
+		"diff":             run.Diff,
+		"error":            run.Error,
+		"completed_at":     run.CompletedAt,
+	}).Error; err != nil {
+		s.logger.Error("Failed to save golden trace run", "runId", run.UUID, "error", err)
+		return
+	}
+	s.logger.Info("Scored golden trace run", "goldenTraceId", golden.UUID, "runId", run.UUID, "status", run.Status)
+}
+
+// hasEntrySpan reports whether a trace holds the span the agent started for a replayed request
+func hasEntrySpan(trace *models.TraceResponse, parentSpanID string) bool {
+	for _, span := range trace.Spans {
+		if span.ParentSpanID == parentSpanID {
+			return true
+		}
+	}
+	return false
+}
+
+func getGoldenTrace(tx *gorm.DB, orgName, projectName, agentName, goldenTraceID string) (*models.GoldenTrace, error) {
+	id, err := uuid.Parse(goldenTraceID)
+	if err != nil {
+		return nil, utils.ErrGoldenTraceNotFound
+	}
+	var golden models.GoldenTrace
+	if err := tx.Where("uuid = ? AND organization_name = ? AND project_name = ? AND agent_name = ?", id, orgName, projectName, agentName).
+		First(&golden).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, utils.ErrGoldenTraceNotFound
+		}
+		return nil, fmt.Errorf("failed to get golden trace: %w", err)
+	}
+	return &golden, nil
+}
+
+func decodeGoldenTraceSnapshot(golden *models.GoldenTrace) (*models.GoldenTraceSnapshot, error) {
+	var snapshot models.GoldenTraceSnapshot
+	if err := json.Unmarshal([]byte(golden.Snapshot), &snapshot); err != nil {
+		return nil, fmt.Errorf("failed to decode golden trace snapshot: %w", err)
+	}
+	return &snapshot, nil
+}
@@ -0,0 +1,299 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+)
+
+const (
+	// maxGoldenTraceSteps bounds the steps kept in a snapshot, and so the cost of aligning two snapshots
+	maxGoldenTraceSteps = 1000
+	// maxGoldenStepOutputRunes bounds the output kept for each step of a snapshot
+	maxGoldenStepOutputRunes = 4000
+)
+
+var (
+	uuidPattern      = regexp.MustCompile(`(?i)\b[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}\b`)
+	timestampPattern = regexp.MustCompile(`\b\d{4}-\d{2}-\d{2}[T ]\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:?\d{2})?\b`)
+	hexIDPattern     = regexp.MustCompile(`(?i)\b[0-9a-f]{16,}\b`)
+	whitespace       = regexp.MustCompile(`\s+`)
+	wordPattern      = regexp.MustCompile(`[\p{L}\p{N}]+`)
+)
+
+// snapshotTrace normalizes a trace into the steps of its span tree. Children are ordered by start
+// time, so concurrent steps that start in a different order across runs are reported as moved.
+func snapshotTrace(trace *models.TraceResponse) *models.GoldenTraceSnapshot {
+	spanIDs := make(map[string]bool, len(trace.Spans))
+	for _, span := range trace.Spans {
+		spanIDs[span.SpanID] = true
+	}
+	var roots []*models.Span
+	children := make(map[string][]*models.Span)
+	for i := range trace.Spans {
+		span := &trace.Spans[i]
+		if span.ParentSpanID == "" || !spanIDs[span.ParentSpanID] {
+			roots = append(roots, span)
+		} else {
+			children[span.ParentSpanID] = append(children[span.ParentSpanID], span)
+		}
+	}
+
+	snapshot := &models.GoldenTraceSnapshot{
+		Input:  trace.Input,
+		Output: normalizeOutput(trace.Output),
+		Steps:  []models.GoldenTraceStep{},
+	}
+	var walk func(spans []*models.Span, depth int)
+	walk = func(spans []*models.Span, depth int) {
+		sortSpansByStart(spans)
+		for _, span := range spans {
+			if len(snapshot.Steps) == maxGoldenTraceSteps {
+				return
+			}
+			step := models.GoldenTraceStep{Depth: depth, Name: span.Name}
+			if span.AmpAttributes != nil {
+				step.Kind = span.AmpAttributes.Kind
+				step.Output = normalizeOutput(span.AmpAttributes.Output)
+				step.Error = span.AmpAttributes.Status != nil && span.AmpAttributes.Status.Error
+			}
+			snapshot.Steps = append(snapshot.Steps, step)
+			walk(children[span.SpanID], depth+1)
+		}
+	}
+	walk(roots, 0)
+	return snapshot
+}
+
+func sortSpansByStart(spans []*models.Span) {
+	sort.SliceStable(spans, func(i, j int) bool {
+		if !spans[i].StartTime.Equal(spans[j].StartTime) {
+			return spans[i].StartTime.Before(spans[j].StartTime)
+		}
+		return spans[i].Name < spans[j].Name
+	})
+}
+
+// normalizeOutput returns the text of an output with the values that differ on every run, such as
+// IDs and timestamps, replaced by placeholders
+func normalizeOutput(output interface{}) string {
+	if output == nil {
+		return ""
+	}
+	text, ok := output.(string)
+	if !ok {
+		// Structured outputs, such as LLM messages, are reduced to their string values
+		var generic interface{}
+		if data, err := json.Marshal(output); err == nil && json.Unmarshal(data, &generic) == nil {
+			var values []string
+			collectStrings(generic, &values)
+			text = strings.Join(values, "\n")
+		} else {
+			text = fmt.Sprint(output)
+		}
+	}
+	text = uuidPattern.ReplaceAllString(text, "<uuid>")
+	text = timestampPattern.ReplaceAllString(text, "<timestamp>")
+	text = hexIDPattern.ReplaceAllString(text, "<id>")
+	text = strings.TrimSpace(whitespace.ReplaceAllString(text, " "))
+	if runes := []rune(text); len(runes) > maxGoldenStepOutputRunes {
+		text = string(runes[:maxGoldenStepOutputRunes])
+	}
+	return text
+}
+
+// collectStrings appends the string values of a decoded JSON value, visiting object keys in order
+func collectStrings(value interface{}, values *[]string) {
+	switch v := value.(type) {
+	case string:
+		if v != "" {
+			*values = append(*values, v)
+		}
+	case []interface{}:
+		for _, item := range v {
+			collectStrings(item, values)
+		}
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			collectStrings(v[key], values)
+		}
+	}
+}
+
+// goldenTraceComparison is the result of comparing a replayed trace against a golden trace
+type goldenTraceComparison struct {
+	structuralScore float64
+	semanticScore   float64
+	diff            []models.GoldenTraceStepDiff
+}
+
+// compareSnapshots aligns the steps of two snapshots by depth, kind and name. The structural score
+// is the share of steps the alignment matches. The semantic score is the mean similarity of the
+// golden outputs to the outputs of the matched steps, counting the final output and counting
+// outputs of missing steps as 0.
+func compareSnapshots(golden, actual *models.GoldenTraceSnapshot) *goldenTraceComparison {
+	matches := alignSteps(golden.Steps, actual.Steps)
+	comparison := &goldenTraceComparison{diff: []models.GoldenTraceStepDiff{}}
+
+	if total := len(golden.Steps) + len(actual.Steps); total == 0 {
+		comparison.structuralScore = 1
+	} else {
+		comparison.structuralScore = float64(2*len(matches)) / float64(total)
+	}
+
+	var similaritySum float64
+	var outputs int
+	if golden.Output != "" || actual.Output != "" {
+		similaritySum += textSimilarity(golden.Output, actual.Output)
+		outputs++
+	}
+
+	g, a := 0, 0
+	for _, match := range append(matches, [2]int{len(golden.Steps), len(actual.Steps)}) {
+		for ; g < match[0]; g++ {
+			step := golden.Steps[g]
+			comparison.diff = append(comparison.diff, models.GoldenTraceStepDiff{
+				Change:   models.GoldenTraceStepMissing,
+				Step:     stepLabel(step),
+				Expected: step.Output,
+			})
+			if step.Output != "" {
+				outputs++
+			}
+		}
+		for ; a < match[1]; a++ {
+			step := actual.Steps[a]
+			comparison.diff = append(comparison.diff, models.GoldenTraceStepDiff{
+				Change: models.GoldenTraceStepUnexpected,
+				Step:   stepLabel(step),
+				Actual: step.Output,
+			})
+		}
+		if g == len(golden.Steps) {
+			break
+		}
+
+		expected, got := golden.Steps[g], actual.Steps[a]
+		if expected.Output != "" || got.Output != "" {
+			similarity := textSimilarity(expected.Output, got.Output)
+			similaritySum += similarity
+			outputs++
+			if similarity < 1 {
+				comparison.diff = append(comparison.diff, models.GoldenTraceStepDiff{
+					Change:     models.GoldenTraceStepChanged,
+					Step:       stepLabel(expected),
+					Expected:   expected.Output,
+					Actual:     got.Output,
+					Similarity: &similarity,
+				})
+			}
+		}
+		g++
+		a++
+	}
+
+	comparison.semanticScore = 1
+	if outputs > 0 {
+		comparison.semanticScore = similaritySum / float64(outputs)
+	}
+	return comparison
+}
+
+// alignSteps returns the index pairs of the longest common subsequence of two step sequences
+func alignSteps(golden, actual []models.GoldenTraceStep) [][2]int {
+	n, m := len(golden), len(actual)
+	lengths := make([][]int, n+1)
+	for i := range lengths {
+		lengths[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if sameStep(golden[i], actual[j]) {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else {
+				lengths[i][j] = max(lengths[i+1][j], lengths[i][j+1])
+			}
+		}
+	}
+
+	var matches [][2]int
+	for i, j := 0, 0; i < n && j < m; {
+		switch {
+		case sameStep(golden[i], actual[j]):
+			matches = append(matches, [2]int{i, j})
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+	return matches
+}
+
+func sameStep(a, b models.GoldenTraceStep) bool {
+	return a.Depth == b.Depth && a.Kind == b.Kind && a.Name == b.Name
+}
+
+func stepLabel(step models.GoldenTraceStep) string {
+	if step.Kind == "" {
+		return step.Name
+	}
+	return fmt.Sprintf("%s (%s)", step.Name, step.Kind)
+}
+
+// textSimilarity is the cosine similarity of the word counts of two texts, between 0 and 1. It
+// approximates how close two outputs are in meaning without calling a model.
+func textSimilarity(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+	countsA, countsB := wordCounts(a), wordCounts(b)
+	if len(countsA) == 0 || len(countsB) == 0 {
+		return 0
+	}
+	var dot, normA, normB float64
+	for word, countA := range countsA {
+		dot += countA * countsB[word]
+		normA += countA * countA
+	}
+	for _, countB := range countsB {
+		normB += countB * countB
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
+
+func wordCounts(text string) map[string]float64 {
+	counts := make(map[string]float64)
+	for _, word := range wordPattern.FindAllString(strings.ToLower(text), -1) {
+		counts[word]++
+	}
+	return counts
+}
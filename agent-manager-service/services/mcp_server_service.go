@@ -0,0 +1,461 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/mcpclient"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// mcpToolFetchTimeout bounds a single tool list refresh so one slow server does not stall the others
+const mcpToolFetchTimeout = 30 * time.Second
+
+// MCPServerService manages the MCP tool servers registered by organizations, caches their tool
+// lists and binds them to agents
+type MCPServerService interface {
+	CreateMCPServer(ctx context.Context, orgName string, req *models.CreateMCPServerRequest) (*models.MCPServerResponse, error)
+	ListMCPServers(ctx context.Context, orgName string) ([]*models.MCPServerResponse, error)
+	GetMCPServer(ctx context.Context, orgName string, name string) (*models.MCPServerResponse, error)
+	UpdateMCPServer(ctx context.Context, orgName string, name string, req *models.UpdateMCPServerRequest) (*models.MCPServerResponse, error)
+	DeleteMCPServer(ctx context.Context, orgName string, name string) error
+	// RefreshMCPServerTools fetches the tool list of the server now
+	RefreshMCPServerTools(ctx context.Context, orgName string, name string) (*models.MCPServerResponse, error)
+
+	// SetAgentMCPServers replaces the MCP servers bound to an agent
+	SetAgentMCPServers(ctx context.Context, orgName, projectName, agentName string, serverNames []string) ([]*models.MCPServerResponse, error)
+	ListAgentMCPServers(ctx context.Context, orgName, projectName, agentName string) ([]*models.MCPServerResponse, error)
+	// GetAgentMCPServerReferences returns the endpoints and tools of the servers bound to an agent, for its deployment
+	GetAgentMCPServerReferences(ctx context.Context, orgName, projectName, agentName string) ([]models.AgentMCPServerReference, error)
+
+	// RunToolRefresher refreshes the tool lists of all servers with tool discovery at the given interval until ctx is done
+	RunToolRefresher(ctx context.Context, interval time.Duration)
+}
+
+type mcpServerService struct {
+	logger    *slog.Logger
+	mcpClient mcpclient.MCPClient
+}
+
+// NewMCPServerService creates a new MCP server registry service
+func NewMCPServerService(logger *slog.Logger) MCPServerService {
+	return &mcpServerService{
+		logger:    logger,
+		mcpClient: mcpclient.NewMCPClient(),
+	}
+}
+
+func (s *mcpServerService) CreateMCPServer(ctx context.Context, orgName string, req *models.CreateMCPServerRequest) (*models.MCPServerResponse, error) {
+	if err := utils.ValidateResourceName(req.Name, "MCP server"); err != nil {
+		return nil, fmt.Errorf("%w: %s", utils.ErrInvalidInput, err.Error())
+	}
+	if err := validateMCPServerURL(req.URL, "url"); err != nil {
+		return nil, err
+	}
+	if req.GatewayURL != "" {
+		if err := validateMCPServerURL(req.GatewayURL, "gatewayUrl"); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	server := &models.MCPServer{
+		UUID:             uuid.New(),
+		OrganizationName: orgName,
+		Name:             req.Name,
+		DisplayName:      req.DisplayName,
+		Description:      req.Description,
+		URL:              req.URL,
+		GatewayURL:       req.GatewayURL,
+		AuthType:         models.MCPAuthTypeNone,
+		ToolDiscovery:    req.ToolDiscovery == nil || *req.ToolDiscovery,
+		Tools:            req.Tools,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+	if err := applyMCPServerAuth(server, req.Auth); err != nil {
+		return nil, err
+	}
+	if server.DisplayName == "" {
+		server.DisplayName = server.Name
+	}
+
+	// The first fetch is best effort: a server that is not reachable yet can still be registered
+	if server.ToolDiscovery {
+		s.fetchTools(ctx, server)
+	}
+	if server.Tools == nil {
+		server.Tools = []models.MCPTool{}
+	}
+
+	if err := db.DB(ctx).Create(server).Error; err != nil {
+		if isUniqueViolation(err) {
+			return nil, utils.ErrMCPServerAlreadyExists
+		}
+		return nil, fmt.Errorf("failed to create MCP server: %w", err)
+	}
+	s.logger.Info("Registered MCP server", "orgName", orgName, "name", server.Name, "tools", len(server.Tools))
+	return server.ToResponse(), nil
+}
+
+func (s *mcpServerService) ListMCPServers(ctx context.Context, orgName string) ([]*models.MCPServerResponse, error) {
+	var servers []models.MCPServer
+	if err := db.DB(ctx).Where("organization_name = ?", orgName).Order("name").Find(&servers).Error; err != nil {
+		return nil, fmt.Errorf("failed to list MCP servers: %w", err)
+	}
+	return toMCPServerResponses(servers), nil
+}
+
+func (s *mcpServerService) GetMCPServer(ctx context.Context, orgName string, name string) (*models.MCPServerResponse, error) {
+	server, err := getMCPServer(db.DB(ctx), orgName, name)
+	if err != nil {
+		return nil, err
+	}
+	return server.ToResponse(), nil
+}
+
+func (s *mcpServerService) UpdateMCPServer(ctx context.Context, orgName string, name string, req *models.UpdateMCPServerRequest) (*models.MCPServerResponse, error) {
+	server, err := getMCPServer(db.DB(ctx), orgName, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.DisplayName != nil {
+		server.DisplayName = *req.DisplayName
+	}
+	if req.Description != nil {
+		server.Description = *req.Description
+	}
+	if req.URL != nil {
+		if err := validateMCPServerURL(*req.URL, "url"); err != nil {
+			return nil, err
+		}
+		server.URL = *req.URL
+	}
+	if req.GatewayURL != nil {
+		if *req.GatewayURL != "" {
+			if err := validateMCPServerURL(*req.GatewayURL, "gatewayUrl"); err != nil {
+				return nil, err
+			}
+		}
+		server.GatewayURL = *req.GatewayURL
+	}
+	if req.Auth != nil {
+		if err := applyMCPServerAuth(server, req.Auth); err != nil {
+			return nil, err
+		}
+	}
+	if req.ToolDiscovery != nil {
+		server.ToolDiscovery = *req.ToolDiscovery
+	}
+	if req.Tools != nil {
+		server.Tools = req.Tools
+	}
+	if server.ToolDiscovery && (req.URL != nil || req.Auth != nil || req.ToolDiscovery != nil) {
+		s.fetchTools(ctx, server)
+	}
+	server.UpdatedAt = time.Now()
+
+	if err := db.DB(ctx).Save(server).Error; err != nil {
+		return nil, fmt.Errorf("failed to update MCP server: %w", err)
+	}
+	return server.ToResponse(), nil
+}
+
+func (s *mcpServerService) DeleteMCPServer(ctx context.Context, orgName string, name string) error {
+	return db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		server, err := getMCPServer(tx, orgName, name)
+		if err != nil {
+			return err
+		}
+		// Remove bindings explicitly as SQLite does not enforce the foreign key cascade by default
+		if err := tx.Where("mcp_server_uuid = ?", server.UUID).Delete(&models.AgentMCPServer{}).Error; err != nil {
+			return fmt.Errorf("failed to delete MCP server bindings: %w", err)
+		}
+		if err := tx.Delete(server).Error; err != nil {
+			return fmt.Errorf("failed to delete MCP server: %w", err)
+		}
+		return nil
+	})
+}
+
+func (s *mcpServerService) RefreshMCPServerTools(ctx context.Context, orgName string, name string) (*models.MCPServerResponse, error) {
+	server, err := getMCPServer(db.DB(ctx), orgName, name)
+	if err != nil {
+		return nil, err
+	}
+	if !server.ToolDiscovery {
+		return nil, fmt.Errorf("%w: tool discovery is disabled for this MCP server", utils.ErrInvalidInput)
+	}
+	s.fetchTools(ctx, server)
+	if err := saveFetchedTools(db.DB(ctx), server); err != nil {
+		return nil, err
+	}
+	return server.ToResponse(), nil
+}
+
+func (s *mcpServerService) SetAgentMCPServers(ctx context.Context, orgName, projectName, agentName string, serverNames []string) ([]*models.MCPServerResponse, error) {
+	var servers []models.MCPServer
+	err := db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, name := range serverNames {
+			server, err := getMCPServer(tx, orgName, name)
+			if err != nil {
+				return err
+			}
+			servers = append(servers, *server)
+		}
+
+		if err := tx.Where("organization_name = ? AND project_name = ? AND agent_name = ?", orgName, projectName, agentName).
+			Delete(&models.AgentMCPServer{}).Error; err != nil {
+			return fmt.Errorf("failed to clear agent MCP servers: %w", err)
+		}
+		now := time.Now()
+		seen := make(map[uuid.UUID]bool, len(servers))
+		for _, server := range servers {
+			if seen[server.UUID] {
+				continue
+			}
+			seen[server.UUID] = true
+			if err := tx.Create(&models.AgentMCPServer{
+				OrganizationName: orgName,
+				ProjectName:      projectName,
+				AgentName:        agentName,
+				MCPServerUUID:    server.UUID,
+				CreatedAt:        now,
+			}).Error; err != nil {
+				return fmt.Errorf("failed to bind MCP server %s: %w", server.Name, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.ListAgentMCPServers(ctx, orgName, projectName, agentName)
+}
+
+func (s *mcpServerService) ListAgentMCPServers(ctx context.Context, orgName, projectName, agentName string) ([]*models.MCPServerResponse, error) {
+	servers, err := agentMCPServers(db.DB(ctx), orgName, projectName, agentName)
+	if err != nil {
+		return nil, err
+	}
+	return toMCPServerResponses(servers), nil
+}
+
+func (s *mcpServerService) GetAgentMCPServerReferences(ctx context.Context, orgName, projectName, agentName string) ([]models.AgentMCPServerReference, error) {
+	servers, err := agentMCPServers(db.DB(ctx), orgName, projectName, agentName)
+	if err != nil {
+		return nil, err
+	}
+	refs := make([]models.AgentMCPServerReference, len(servers))
+	for i := range servers {
+		tools := make([]string, len(servers[i].Tools))
+		for j, tool := range servers[i].Tools {
+			tools[j] = tool.Name
+		}
+		refs[i] = models.AgentMCPServerReference{
+			Name:  servers[i].Name,
+			URL:   servers[i].EndpointURL(),
+			Tools: tools,
+		}
+	}
+	return refs, nil
+}
+
+func (s *mcpServerService) RunToolRefresher(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.refreshAllTools(ctx)
+		}
+	}
+}
+
+func (s *mcpServerService) refreshAllTools(ctx context.Context) {
+	var servers []models.MCPServer
+	if err := db.DB(ctx).Where("tool_discovery = ?", true).Find(&servers).Error; err != nil {
+		s.logger.Error("Failed to load MCP servers for tool refresh", "error", err)
+		return
+	}
+	for i := range servers {
+		if ctx.Err() != nil {
+			return
+		}
+		s.fetchTools(ctx, &servers[i])
+		if err := saveFetchedTools(db.DB(ctx), &servers[i]); err != nil {
+			s.logger.Error("Failed to save MCP server tools", "orgName", servers[i].OrganizationName, "name", servers[i].Name, "error", err)
+		}
+	}
+	s.logger.Debug("Refreshed MCP server tools", "servers", len(servers))
+}
+
+// fetchTools updates the cached tools of the server, keeping the previous tools if the fetch fails
+func (s *mcpServerService) fetchTools(ctx context.Context, server *models.MCPServer) {
+	headers, err := mcpServerHeaders(server)
+	if err == nil {
+		fetchCtx, cancel := context.WithTimeout(ctx, mcpToolFetchTimeout)
+		defer cancel()
+		var tools []mcpclient.Tool
+		tools, err = s.mcpClient.ListTools(fetchCtx, server.URL, headers)
+		if err == nil {
+			now := time.Now()
+			server.Tools = make([]models.MCPTool, len(tools))
+			for i, tool := range tools {
+				server.Tools[i] = models.MCPTool{
+					Name:        tool.Name,
+					Description: tool.Description,
+					InputSchema: tool.InputSchema,
+				}
+			}
+			server.ToolsFetchedAt = &now
+			server.LastFetchError = ""
+			return
+		}
+	}
+	s.logger.Warn("Failed to fetch MCP server tools", "orgName", server.OrganizationName, "name", server.Name, "error", err)
+	server.LastFetchError = err.Error()
+}
+
+func saveFetchedTools(tx *gorm.DB, server *models.MCPServer) error {
+	err := tx.Model(server).Select("tools", "tools_fetched_at", "last_fetch_error").Updates(server).Error
+	if err != nil {
+		return fmt.Errorf("failed to save MCP server tools: %w", err)
+	}
+	return nil
+}
+
+// mcpServerHeaders returns the headers that authenticate requests to the server
+func mcpServerHeaders(server *models.MCPServer) (map[string]string, error) {
+	if server.AuthType == models.MCPAuthTypeNone || len(server.EncryptedCredential) == 0 {
+		return nil, nil
+	}
+	key, err := credentialsEncryptionKey()
+	if err != nil {
+		return nil, err
+	}
+	credential, err := utils.DecryptSecret(server.EncryptedCredential, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt MCP server credential: %w", err)
+	}
+	if server.AuthType == models.MCPAuthTypeBearer {
+		return map[string]string{"Authorization": "Bearer " + string(credential)}, nil
+	}
+	return map[string]string{server.AuthHeader: string(credential)}, nil
+}
+
+// applyMCPServerAuth validates the auth settings and stores them on the server with the credential encrypted
+func applyMCPServerAuth(server *models.MCPServer, auth *models.MCPServerAuth) error {
+	if auth == nil || auth.Type == "" || auth.Type == models.MCPAuthTypeNone {
+		server.AuthType = models.MCPAuthTypeNone
+		server.AuthHeader = ""
+		server.EncryptedCredential = nil
+		return nil
+	}
+	switch auth.Type {
+	case models.MCPAuthTypeBearer:
+		server.AuthHeader = ""
+	case models.MCPAuthTypeHeader:
+		if strings.TrimSpace(auth.Header) == "" {
+			return fmt.Errorf("%w: auth header is required for the header auth type", utils.ErrInvalidInput)
+		}
+		server.AuthHeader = auth.Header
+	default:
+		return fmt.Errorf("%w: unsupported auth type %q", utils.ErrInvalidInput, auth.Type)
+	}
+	if auth.Credential == "" {
+		return fmt.Errorf("%w: auth credential is required", utils.ErrInvalidInput)
+	}
+
+	key, err := credentialsEncryptionKey()
+	if err != nil {
+		return err
+	}
+	encrypted, err := utils.EncryptSecret([]byte(auth.Credential), key)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt MCP server credential: %w", err)
+	}
+	server.AuthType = auth.Type
+	server.EncryptedCredential = encrypted
+	return nil
+}
+
+func credentialsEncryptionKey() ([]byte, error) {
+	encoded := config.GetConfig().CredentialsEncryptionKey
+	if encoded == "" {
+		return nil, utils.ErrCredentialStoreUnavailable
+	}
+	// The key is validated when the configuration is loaded
+	return base64.StdEncoding.DecodeString(encoded)
+}
+
+func validateMCPServerURL(rawURL string, field string) error {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("%w: %s must be an absolute http or https URL", utils.ErrInvalidInput, field)
+	}
+	return nil
+}
+
+func getMCPServer(tx *gorm.DB, orgName string, name string) (*models.MCPServer, error) {
+	var server models.MCPServer
+	if err := tx.Where("organization_name = ? AND name = ?", orgName, name).First(&server).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, utils.ErrMCPServerNotFound
+		}
+		return nil, fmt.Errorf("failed to get MCP server: %w", err)
+	}
+	return &server, nil
+}
+
+func agentMCPServers(tx *gorm.DB, orgName, projectName, agentName string) ([]models.MCPServer, error) {
+	var servers []models.MCPServer
+	err := tx.Joins("JOIN agent_mcp_servers ON agent_mcp_servers.mcp_server_uuid = mcp_servers.uuid").
+		Where("agent_mcp_servers.organization_name = ? AND agent_mcp_servers.project_name = ? AND agent_mcp_servers.agent_name = ?",
+			orgName, projectName, agentName).
+		Order("mcp_servers.name").
+		Find(&servers).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list agent MCP servers: %w", err)
+	}
+	return servers, nil
+}
+
+func toMCPServerResponses(servers []models.MCPServer) []*models.MCPServerResponse {
+	responses := make([]*models.MCPServerResponse, len(servers))
+	for i := range servers {
+		responses[i] = servers[i].ToResponse()
+	}
+	return responses
+}
@@ -0,0 +1,511 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+const notificationDigestTimeout = 10 * time.Second
+
+var notificationDigestClient = &http.Client{Timeout: notificationDigestTimeout}
+
+// maxDigestIssuesPerAgent is the number of most recently seen issues of each agent checked for new ones
+const maxDigestIssuesPerAgent = 100
+
+// notificationDigestEvent is the event of the JSON payload posted to webhook channels
+const notificationDigestEvent = "notification_digest"
+
+// notificationDigestPayload is posted to webhook channels
+type notificationDigestPayload struct {
+	Event string `json:"event"`
+	models.NotificationDigestReport
+}
+
+// digestPeriodEnd returns the end of the last period of the given frequency that ended by now.
+// Daily periods end at midnight UTC and weekly periods at midnight UTC on Mondays.
+func digestPeriodEnd(frequency string, now time.Time) time.Time {
+	now = now.UTC()
+	end := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if frequency == models.NotificationDigestFrequencyWeekly {
+		end = end.AddDate(0, 0, -((int(end.Weekday()) + 6) % 7))
+	}
+	return end
+}
+
+// digestPeriodStart returns the start of the period of the given frequency that ends at end
+func digestPeriodStart(frequency string, end time.Time) time.Time {
+	if frequency == models.NotificationDigestFrequencyWeekly {
+		return end.AddDate(0, 0, -7)
+	}
+	return end.AddDate(0, 0, -1)
+}
+
+// validateNotificationDigestChannel checks the channel of a digest can be delivered to. The email
+// channel needs an SMTP server and has no URL.
+func validateNotificationDigestChannel(req *models.NotificationDigestRequest) error {
+	if req.Channel == models.NotificationChannelEmail {
+		if !emailEnabled() {
+			return utils.ErrEmailNotConfigured
+		}
+		req.URL = ""
+		return nil
+	}
+	return validateNotificationDigestURL(req.URL)
+}
+
+func validateNotificationDigestURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: url must be an http or https URL", utils.ErrInvalidInput)
+	}
+	return nil
+}
+
+func (s *observabilityManagerService) CreateNotificationDigest(ctx context.Context, orgName, createdBy string, req *models.NotificationDigestRequest) (*models.NotificationDigestResponse, error) {
+	if err := validateNotificationDigestChannel(req); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	digest := &models.NotificationDigest{
+		UUID:             uuid.New(),
+		OrganizationName: orgName,
+		Name:             strings.TrimSpace(req.Name),
+		Frequency:        req.Frequency,
+		Channel:          req.Channel,
+		URL:              req.URL,
+		Enabled:          req.Enabled == nil || *req.Enabled,
+		// The first digest covers the first period that ends after the digest is created
+		LastPeriodEnd: digestPeriodEnd(req.Frequency, now),
+		CreatedBy:     createdBy,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if err := db.DB(ctx).Create(digest).Error; err != nil {
+		return nil, fmt.Errorf("failed to create notification digest: %w", err)
+	}
+	s.logger.Info("Created notification digest", "orgName", orgName, "digestId", digest.UUID,
+		"frequency", digest.Frequency, "channel", digest.Channel, "createdBy", createdBy)
+	return digest.ToResponse(), nil
+}
+
+func (s *observabilityManagerService) ListNotificationDigests(ctx context.Context, orgName string) (*models.NotificationDigestListResponse, error) {
+	var digests []models.NotificationDigest
+	if err := db.DB(ctx).Where("organization_name = ?", orgName).Order("name ASC").Find(&digests).Error; err != nil {
+		return nil, fmt.Errorf("failed to list notification digests: %w", err)
+	}
+	response := &models.NotificationDigestListResponse{Digests: make([]models.NotificationDigestResponse, 0, len(digests))}
+	for i := range digests {
+		response.Digests = append(response.Digests, *digests[i].ToResponse())
+	}
+	return response, nil
+}
+
+func (s *observabilityManagerService) GetNotificationDigest(ctx context.Context, orgName, digestID string) (*models.NotificationDigestResponse, error) {
+	digest, err := getNotificationDigest(db.DB(ctx), orgName, digestID)
+	if err != nil {
+		return nil, err
+	}
+	return digest.ToResponse(), nil
+}
+
+func (s *observabilityManagerService) UpdateNotificationDigest(ctx context.Context, orgName, digestID string, req *models.NotificationDigestRequest) (*models.NotificationDigestResponse, error) {
+	if err := validateNotificationDigestChannel(req); err != nil {
+		return nil, err
+	}
+	digest, err := getNotificationDigest(db.DB(ctx), orgName, digestID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	enabled := req.Enabled == nil || *req.Enabled
+	updates := map[string]interface{}{
+		"name":       strings.TrimSpace(req.Name),
+		"frequency":  req.Frequency,
+		"channel":    req.Channel,
+		"url":        req.URL,
+		"enabled":    enabled,
+		"updated_at": now,
+	}
+	// A digest that changes frequency, or is enabled again, starts over from the current period
+	// instead of catching up on the periods it skipped
+	if req.Frequency != digest.Frequency || (enabled && !digest.Enabled) {
+		updates["last_period_end"] = digestPeriodEnd(req.Frequency, now)
+	}
+	if err := db.DB(ctx).Model(digest).Updates(updates).Error; err != nil {
+		return nil, fmt.Errorf("failed to update notification digest: %w", err)
+	}
+	s.logger.Info("Updated notification digest", "orgName", orgName, "digestId", digest.UUID, "frequency", req.Frequency, "channel", req.Channel)
+	return s.GetNotificationDigest(ctx, orgName, digestID)
+}
+
+func (s *observabilityManagerService) DeleteNotificationDigest(ctx context.Context, orgName, digestID string) error {
+	id, err := uuid.Parse(digestID)
+	if err != nil {
+		return utils.ErrNotificationDigestNotFound
+	}
+	result := db.DB(ctx).Where("uuid = ? AND organization_name = ?", id, orgName).Delete(&models.NotificationDigest{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete notification digest: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return utils.ErrNotificationDigestNotFound
+	}
+	s.logger.Info("Deleted notification digest", "orgName", orgName, "digestId", id)
+	return nil
+}
+
+func (s *observabilityManagerService) SendNotificationDigest(ctx context.Context, orgName, digestID string) (*models.NotificationDigestReport, error) {
+	digest, err := getNotificationDigest(db.DB(ctx), orgName, digestID)
+	if err != nil {
+		return nil, err
+	}
+	end := digestPeriodEnd(digest.Frequency, time.Now())
+	report, err := s.compileNotificationDigest(ctx, digest, digestPeriodStart(digest.Frequency, end), end)
+	if err != nil {
+		return nil, err
+	}
+	err = deliverNotificationDigest(ctx, digest, report)
+	s.recordNotificationDigestDelivery(ctx, digest, err)
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func (s *observabilityManagerService) RunNotificationDigestSender(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.sendDueNotificationDigests(ctx)
+		}
+	}
+}
+
+// sendDueNotificationDigests sends the enabled digests of active organizations whose period has
+// ended since they were last sent. A digest that was not sent for several periods, e.g. while the
+// service was down, covers only the last of them.
+func (s *observabilityManagerService) sendDueNotificationDigests(ctx context.Context) {
+	var digests []models.NotificationDigest
+	if err := db.DB(ctx).
+		Where("enabled = ?", true).
+		Where("organization_name IN (?)", db.DB(ctx).Model(&models.Organization{}).Select("name").Where("status = ?", models.OrganizationStatusActive)).
+		Order("organization_name, name").
+		Find(&digests).Error; err != nil {
+		s.logger.Error("Failed to load notification digests", "error", err)
+		return
+	}
+	now := time.Now()
+	for i := range digests {
+		if ctx.Err() != nil {
+			return
+		}
+		digest := &digests[i]
+		end := digestPeriodEnd(digest.Frequency, now)
+		if !end.After(digest.LastPeriodEnd) {
+			continue
+		}
+		// Claim the period, so that each period is sent once when several replicas run the sender
+		previousEnd := digest.LastPeriodEnd
+		result := db.DB(ctx).Model(&models.NotificationDigest{}).
+			Where("uuid = ? AND last_period_end = ?", digest.UUID, previousEnd).
+			Update("last_period_end", end)
+		if result.Error != nil {
+			s.logger.Error("Failed to claim notification digest", "digestId", digest.UUID, "error", result.Error)
+			continue
+		}
+		if result.RowsAffected == 0 {
+			continue
+		}
+
+		report, err := s.compileNotificationDigest(ctx, digest, digestPeriodStart(digest.Frequency, end), end)
+		if err == nil {
+			err = deliverNotificationDigest(ctx, digest, report)
+		}
+		if err != nil {
+			s.logger.Warn("Failed to send notification digest; it is retried at the next interval",
+				"orgName", digest.OrganizationName, "digestId", digest.UUID, "error", err)
+			// Release the period so that it is sent again
+			if revertErr := db.DB(ctx).Model(&models.NotificationDigest{}).Where("uuid = ?", digest.UUID).
+				Update("last_period_end", previousEnd).Error; revertErr != nil {
+				s.logger.Error("Failed to release notification digest", "digestId", digest.UUID, "error", revertErr)
+			}
+		} else {
+			s.logger.Info("Sent notification digest", "orgName", digest.OrganizationName, "digestId", digest.UUID, "periodEnd", end)
+		}
+		s.recordNotificationDigestDelivery(ctx, digest, err)
+	}
+}
+
+// recordNotificationDigestDelivery saves the outcome of sending a digest
+func (s *observabilityManagerService) recordNotificationDigestDelivery(ctx context.Context, digest *models.NotificationDigest, deliveryErr error) {
+	updates := map[string]interface{}{"last_error": ""}
+	if deliveryErr != nil {
+		updates["last_error"] = deliveryErr.Error()
+	} else {
+		updates["last_sent_at"] = time.Now()
+	}
+	if err := db.DB(ctx).Model(&models.NotificationDigest{}).Where("uuid = ?", digest.UUID).Updates(updates).Error; err != nil {
+		s.logger.Error("Failed to save notification digest delivery", "digestId", digest.UUID, "error", err)
+	}
+}
+
+// compileNotificationDigest collects the new issues, token budget alerts, budget consumption and
+// deployments of an organization between start and end
+func (s *observabilityManagerService) compileNotificationDigest(ctx context.Context, digest *models.NotificationDigest, start, end time.Time) (*models.NotificationDigestReport, error) {
+	report := &models.NotificationDigestReport{
+		OrganizationName: digest.OrganizationName,
+		Frequency:        digest.Frequency,
+		PeriodStart:      start,
+		PeriodEnd:        end,
+		NewIssues:        []models.NotificationDigestIssue{},
+		Alerts:           []models.NotificationDigestAlert{},
+		Budgets:          []models.NotificationDigestBudget{},
+		Deployments:      []models.NotificationDigestDeployment{},
+	}
+
+	agents, err := s.listOrgAgents(ctx, digest.OrganizationName)
+	if err != nil {
+		return nil, err
+	}
+	for _, agent := range agents {
+		issues, err := s.traceObserverClient.ListIssues(ctx, traceobserversvc.ListIssuesParams{
+			ComponentUid: agent.ComponentUid,
+			Limit:        maxDigestIssuesPerAgent,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list issues of agent %s: %w", agent.Name, err)
+		}
+		for _, issue := range issues.Issues {
+			if issue.FirstSeen.Before(start) || !issue.FirstSeen.Before(end) {
+				continue
+			}
+			report.NewIssues = append(report.NewIssues, models.NotificationDigestIssue{
+				ProjectName: agent.ProjectName,
+				AgentName:   agent.Name,
+				IssueID:     issue.ID,
+				ErrorType:   issue.ErrorType,
+				ToolName:    issue.ToolName,
+				SpanName:    issue.SpanName,
+				Occurrences: issue.Occurrences,
+				FirstSeen:   issue.FirstSeen,
+			})
+		}
+	}
+	sort.SliceStable(report.NewIssues, func(i, j int) bool {
+		return report.NewIssues[i].Occurrences > report.NewIssues[j].Occurrences
+	})
+
+	var budgets []models.AgentTokenBudget
+	if err := db.DB(ctx).Where("organization_name = ?", digest.OrganizationName).
+		Order("project_name, agent_name, environment_name, period").Find(&budgets).Error; err != nil {
+		return nil, fmt.Errorf("failed to list token budgets: %w", err)
+	}
+	budgetsByID := make(map[uuid.UUID]*models.AgentTokenBudget, len(budgets))
+	budgetIDs := make([]uuid.UUID, 0, len(budgets))
+	for i := range budgets {
+		budget := &budgets[i]
+		budgetsByID[budget.UUID] = budget
+		budgetIDs = append(budgetIDs, budget.UUID)
+		report.Budgets = append(report.Budgets, models.NotificationDigestBudget{
+			ProjectName: budget.ProjectName,
+			AgentName:   budget.AgentName,
+			Environment: budget.EnvironmentName,
+			Period:      budget.Period,
+			TokenLimit:  budget.TokenLimit,
+			UsedTokens:  budget.UsedTokens,
+			Status:      budget.Status,
+		})
+	}
+	if len(budgetIDs) > 0 {
+		var events []models.AgentTokenBudgetEvent
+		if err := db.DB(ctx).Where("budget_uuid IN ? AND created_at >= ? AND created_at < ?", budgetIDs, start, end).
+			Order("created_at ASC").Find(&events).Error; err != nil {
+			return nil, fmt.Errorf("failed to list token budget events: %w", err)
+		}
+		for _, event := range events {
+			budget := budgetsByID[event.BudgetUUID]
+			report.Alerts = append(report.Alerts, models.NotificationDigestAlert{
+				ProjectName: budget.ProjectName,
+				AgentName:   budget.AgentName,
+				Environment: budget.EnvironmentName,
+				Type:        event.Type,
+				UsedTokens:  event.UsedTokens,
+				TokenLimit:  event.TokenLimit,
+				Enforced:    event.Enforced,
+				RaisedAt:    event.CreatedAt,
+			})
+		}
+	}
+
+	deployments, err := usageReportDeployments(ctx, digest.OrganizationName, start, end)
+	if err != nil {
+		return nil, err
+	}
+	for key, count := range deployments {
+		report.Deployments = append(report.Deployments, models.NotificationDigestDeployment{
+			ProjectName: key.projectName,
+			AgentName:   key.agentName,
+			Environment: key.environment,
+			Deployments: count,
+		})
+	}
+	sort.Slice(report.Deployments, func(i, j int) bool {
+		a, b := report.Deployments[i], report.Deployments[j]
+		if a.ProjectName != b.ProjectName {
+			return a.ProjectName < b.ProjectName
+		}
+		if a.AgentName != b.AgentName {
+			return a.AgentName < b.AgentName
+		}
+		return a.Environment < b.Environment
+	})
+	return report, nil
+}
+
+// deliverNotificationDigest posts a digest to its channel
+func deliverNotificationDigest(ctx context.Context, digest *models.NotificationDigest, report *models.NotificationDigestReport) error {
+	if digest.Channel == models.NotificationChannelEmail {
+		return emailNotificationDigest(ctx, digest, report)
+	}
+	var payload interface{} = notificationDigestPayload{Event: notificationDigestEvent, NotificationDigestReport: *report}
+	if digest.Channel == models.NotificationChannelSlack {
+		payload = map[string]string{"text": renderNotificationDigestText(digest, report)}
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode notification digest: %w", err)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, digest.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create notification digest request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	resp, err := notificationDigestClient.Do(httpReq)
+	if err != nil {
+		// The error names the URL, which may carry a secret
+		return fmt.Errorf("%w: %s channel is unreachable", utils.ErrNotificationDeliveryFailed, digest.Channel)
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%w: %s channel responded with status %d", utils.ErrNotificationDeliveryFailed, digest.Channel, resp.StatusCode)
+	}
+	return nil
+}
+
+// emailNotificationDigest emails a digest to the verified recipients of the organization subscribed
+// to digests. The digest is delivered once any recipient receives it, so that a retry does not email
+// the others twice; a digest no one receives is not delivered.
+func emailNotificationDigest(ctx context.Context, digest *models.NotificationDigest, report *models.NotificationDigestReport) error {
+	data := digestEmail{Name: digest.Name, Report: report}
+	for _, deployment := range report.Deployments {
+		data.DeploymentCount += deployment.Deployments
+	}
+	sent, err := emailOrganization(ctx, digest.OrganizationName, models.EmailNotificationDigests, emailTemplateDigest, data)
+	if sent > 0 {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return fmt.Errorf("%w: no verified email recipient subscribes to digests", utils.ErrNotificationDeliveryFailed)
+}
+
+// renderNotificationDigestText summarizes a digest as Slack mrkdwn text
+func renderNotificationDigestText(digest *models.NotificationDigest, report *models.NotificationDigestReport) string {
+	const dateLayout = "2006-01-02"
+	var b strings.Builder
+	fmt.Fprintf(&b, "*%s* for %s, %s to %s\n", digest.Name, report.OrganizationName,
+		report.PeriodStart.Format(dateLayout), report.PeriodEnd.AddDate(0, 0, -1).Format(dateLayout))
+
+	fmt.Fprintf(&b, "\n*New issues:* %d\n", len(report.NewIssues))
+	for _, issue := range report.NewIssues {
+		title := issue.ErrorType
+		if title == "" {
+			title = issue.SpanName
+		}
+		if issue.ToolName != "" {
+			title += " in " + issue.ToolName
+		}
+		fmt.Fprintf(&b, "• %s/%s: %s (%d occurrences)\n", issue.ProjectName, issue.AgentName, title, issue.Occurrences)
+	}
+
+	fmt.Fprintf(&b, "\n*Budget alerts:* %d\n", len(report.Alerts))
+	for _, alert := range report.Alerts {
+		fmt.Fprintf(&b, "• %s/%s in %s: %s at %d of %d tokens\n", alert.ProjectName, alert.AgentName, alert.Environment,
+			strings.ReplaceAll(alert.Type, "_", " "), alert.UsedTokens, alert.TokenLimit)
+	}
+
+	if len(report.Budgets) > 0 {
+		b.WriteString("\n*Budget consumption:*\n")
+		for _, budget := range report.Budgets {
+			percent := 0.0
+			if budget.TokenLimit > 0 {
+				percent = float64(budget.UsedTokens) * 100 / float64(budget.TokenLimit)
+			}
+			fmt.Fprintf(&b, "• %s/%s in %s: %d of %d %s tokens (%.0f%%)\n", budget.ProjectName, budget.AgentName, budget.Environment,
+				budget.UsedTokens, budget.TokenLimit, budget.Period, percent)
+		}
+	}
+
+	total := 0
+	for _, deployment := range report.Deployments {
+		total += deployment.Deployments
+	}
+	fmt.Fprintf(&b, "\n*Deployments:* %d\n", total)
+	for _, deployment := range report.Deployments {
+		fmt.Fprintf(&b, "• %s/%s to %s: %d\n", deployment.ProjectName, deployment.AgentName, deployment.Environment, deployment.Deployments)
+	}
+	return b.String()
+}
+
+func getNotificationDigest(tx *gorm.DB, orgName, digestID string) (*models.NotificationDigest, error) {
+	id, err := uuid.Parse(digestID)
+	if err != nil {
+		return nil, utils.ErrNotificationDigestNotFound
+	}
+	var digests []models.NotificationDigest
+	if err := tx.Where("uuid = ? AND organization_name = ?", id, orgName).Limit(1).Find(&digests).Error; err != nil {
+		return nil, fmt.Errorf("failed to get notification digest: %w", err)
+	}
+	if len(digests) == 0 {
+		return nil, utils.ErrNotificationDigestNotFound
+	}
+	return &digests[0], nil
+}
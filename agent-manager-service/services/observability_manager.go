@@ -21,7 +21,9 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 
+	apiplatformclient "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/apiplatformsvc/client"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/openchoreosvc/client"
 	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
@@ -41,6 +43,8 @@ type ListTracesRequest struct {
 	Limit       int
 	Offset      int
 	SortOrder   string
+	// Archived also searches the spans moved to the trace archive
+	Archived bool
 }
 
 type TraceDetailsRequest struct {
@@ -49,28 +53,149 @@ type TraceDetailsRequest struct {
 	ProjectName string
 	AgentName   string
 	Environment string
+	// RawContent reads the spans with their content whatever the content visibility of the
+	// organization, for replaying and scoring traces, which do not return the content
+	RawContent bool
+	// Archived also searches the spans moved to the trace archive
+	Archived bool
+}
+
+type ModelUsageRequest struct {
+	OrgName     string
+	Environment string
+	StartTime   string
+	EndTime     string
 }
 
 type ObservabilityManagerService interface {
 	ListTraces(ctx context.Context, req ListTracesRequest) (*models.TraceOverviewResponse, error)
 	ExportTraces(ctx context.Context, req ListTracesRequest) (*models.TraceExportResponse, error)
 	GetTraceDetails(ctx context.Context, req TraceDetailsRequest) (*models.TraceResponse, error)
+	// GetTraceLogs returns the application logs that carry the trace context of a trace
+	GetTraceLogs(ctx context.Context, req TraceLogsRequest) (*models.TraceLogsResponse, error)
+	// ReplayTrace sends the root input of a trace to the agent again and links the new trace to it
+	ReplayTrace(ctx context.Context, req TraceReplayRequest) (*models.TraceReplayResponse, error)
+	ListTraceReplays(ctx context.Context, orgName, projectName, agentName, traceID string) (*models.TraceReplayListResponse, error)
+	// CreateGoldenTrace snapshots a trace of an agent as the behaviour later runs are compared against
+	CreateGoldenTrace(ctx context.Context, orgName, projectName, agentName, createdBy string, req *models.CreateGoldenTraceRequest) (*models.GoldenTraceResponse, error)
+	ListGoldenTraces(ctx context.Context, orgName, projectName, agentName string) (*models.GoldenTraceListResponse, error)
+	GetGoldenTrace(ctx context.Context, orgName, projectName, agentName, goldenTraceID string) (*models.GoldenTraceResponse, error)
+	DeleteGoldenTrace(ctx context.Context, orgName, projectName, agentName, goldenTraceID string) error
+	// RunGoldenTrace replays the input of a golden trace; the run is scored once the replayed trace is recorded
+	RunGoldenTrace(ctx context.Context, orgName, projectName, agentName, goldenTraceID, requestedBy string) (*models.GoldenTraceRunResponse, error)
+	ListGoldenTraceRuns(ctx context.Context, orgName, projectName, agentName, goldenTraceID string) (*models.GoldenTraceRunListResponse, error)
+	// GetGoldenTraceRun returns a golden trace run with its step differences, scoring a pending one
+	GetGoldenTraceRun(ctx context.Context, orgName, projectName, agentName, goldenTraceID, runID string) (*models.GoldenTraceRunResponse, error)
+	GetTraceScoringPolicy(ctx context.Context, orgName, projectName, agentName string) (*models.TraceScoringPolicyResponse, error)
+	SetTraceScoringPolicy(ctx context.Context, orgName, projectName, agentName string, req *models.TraceScoringPolicyRequest) (*models.TraceScoringPolicyResponse, error)
+	DeleteTraceScoringPolicy(ctx context.Context, orgName, projectName, agentName string) error
+	// ScoreTrace has the LLM judge score a trace now, replacing earlier scores
+	ScoreTrace(ctx context.Context, req TraceScoreRequest) (*models.TraceScoresResponse, error)
+	GetTraceScores(ctx context.Context, orgName, projectName, agentName, traceID string) (*models.TraceScoresResponse, error)
+	// GetTraceScoreSummary aggregates the scores of an agent's traces and flags the criteria past their alert threshold
+	GetTraceScoreSummary(ctx context.Context, orgName, projectName, agentName string, startTime, endTime time.Time) (*models.TraceScoreSummaryResponse, error)
+	CreateAgentSLO(ctx context.Context, orgName, projectName, agentName, createdBy string, req *models.AgentSLORequest) (*models.AgentSLOResponse, error)
+	ListAgentSLOs(ctx context.Context, orgName, projectName, agentName string) (*models.AgentSLOListResponse, error)
+	GetAgentSLO(ctx context.Context, orgName, projectName, agentName, sloID string) (*models.AgentSLOResponse, error)
+	UpdateAgentSLO(ctx context.Context, orgName, projectName, agentName, sloID string, req *models.AgentSLORequest) (*models.AgentSLOResponse, error)
+	DeleteAgentSLO(ctx context.Context, orgName, projectName, agentName, sloID string) error
+	// GetAgentSLOStatus returns the compliance and error budget of an SLO over its rolling window
+	GetAgentSLOStatus(ctx context.Context, orgName, projectName, agentName, sloID string) (*models.AgentSLOStatusResponse, error)
+	// GetAgentSLOHistory returns the compliance of an SLO per interval of its window, e.g. 24h
+	GetAgentSLOHistory(ctx context.Context, orgName, projectName, agentName, sloID, interval string) (*models.AgentSLOHistoryResponse, error)
+	CreateAgentTokenBudget(ctx context.Context, orgName, projectName, agentName, createdBy string, req *models.AgentTokenBudgetRequest) (*models.AgentTokenBudgetResponse, error)
+	ListAgentTokenBudgets(ctx context.Context, orgName, projectName, agentName string) (*models.AgentTokenBudgetListResponse, error)
+	// GetAgentTokenBudget returns a token budget, refreshing its usage in the current period
+	GetAgentTokenBudget(ctx context.Context, orgName, projectName, agentName, budgetID string) (*models.AgentTokenBudgetResponse, error)
+	UpdateAgentTokenBudget(ctx context.Context, orgName, projectName, agentName, budgetID string, req *models.AgentTokenBudgetRequest) (*models.AgentTokenBudgetResponse, error)
+	DeleteAgentTokenBudget(ctx context.Context, orgName, projectName, agentName, budgetID string) error
+	ListAgentTokenBudgetEvents(ctx context.Context, orgName, projectName, agentName, budgetID string) (*models.AgentTokenBudgetEventListResponse, error)
+	GetModelUsage(ctx context.Context, req ModelUsageRequest) (*models.ModelUsageResponse, error)
+
+	GetTraceRetention(ctx context.Context, orgName string) (*models.TraceRetentionPolicyResponse, error)
+	SetTraceRetention(ctx context.Context, orgName string, req *models.TraceRetentionPolicyRequest) (*models.TraceRetentionPolicyResponse, error)
+	DeleteTraceRetention(ctx context.Context, orgName string) error
+	// GetTraceContentVisibility returns how much span content is returned with the traces of an organization
+	GetTraceContentVisibility(ctx context.Context, orgName string) (*models.TraceContentVisibilityResponse, error)
+	SetTraceContentVisibility(ctx context.Context, orgName, updatedBy string, req *models.TraceContentVisibilityRequest) (*models.TraceContentVisibilityResponse, error)
+	// GetTraceStorageUsage returns the stored trace data of each agent of an organization
+	GetTraceStorageUsage(ctx context.Context, orgName string) (*models.TraceStorageUsageResponse, error)
+	// CreateTraceErasure starts deleting the spans of an organization's agents that carry a personal identifier
+	CreateTraceErasure(ctx context.Context, orgName string, requestedBy string, req *models.CreateTraceErasureRequest) (*models.TraceErasureResponse, error)
+	// GetTraceErasure returns a trace erasure, refreshing the progress of a running one
+	GetTraceErasure(ctx context.Context, orgName string, erasureID string) (*models.TraceErasureResponse, error)
+	ListTraceErasures(ctx context.Context, orgName string) (*models.TraceErasureListResponse, error)
+	// RunRetentionEnforcer deletes spans past the retention period of each organization at the given interval until ctx is done
+	RunRetentionEnforcer(ctx context.Context, interval time.Duration)
+	// RunGoldenTraceScheduler starts due golden trace runs and scores pending ones at the given interval until ctx is done
+	RunGoldenTraceScheduler(ctx context.Context, interval time.Duration)
+	// RunTraceScorer scores a sample of the new traces of each agent with a scoring policy at the given interval until ctx is done
+	RunTraceScorer(ctx context.Context, interval time.Duration)
+	// RunTokenBudgetChecker reads back the token usage of agents with a budget and records budget events at the given interval until ctx is done
+	RunTokenBudgetChecker(ctx context.Context, interval time.Duration)
+	// GenerateUsageReport starts compiling the usage of an organization in a month, replacing an earlier report of the month
+	GenerateUsageReport(ctx context.Context, orgName, requestedBy string, req *models.CreateUsageReportRequest) (*models.UsageReportResponse, error)
+	ListUsageReports(ctx context.Context, orgName string) (*models.UsageReportListResponse, error)
+	GetUsageReport(ctx context.Context, orgName, period string) (*models.UsageReportResponse, error)
+	// RenderUsageReport returns a completed usage report as a file in the given format, with its file name
+	RenderUsageReport(ctx context.Context, orgName, period, format string) ([]byte, string, error)
+	// SetCostCenterMapping charges the LLM usage of an agent or provider to a cost center, replacing its earlier cost center
+	SetCostCenterMapping(ctx context.Context, orgName, createdBy string, req *models.CostCenterMappingRequest) (*models.CostCenterMappingResponse, error)
+	ListCostCenterMappings(ctx context.Context, orgName string) (*models.CostCenterMappingListResponse, error)
+	DeleteCostCenterMapping(ctx context.Context, orgName, mappingID string) error
+	// GetCostCenterUsage groups the LLM usage of all agents of an organization by the cost center it is charged to
+	GetCostCenterUsage(ctx context.Context, req ModelUsageRequest) (*models.CostCenterUsageResponse, error)
+	// RunUsageReportGenerator generates the usage report of the previous month for each organization without one at the given interval until ctx is done
+	RunUsageReportGenerator(ctx context.Context, interval time.Duration)
+	// CreateNotificationDigest schedules a daily or weekly digest of an organization's activity, the first covering the period that ends next
+	CreateNotificationDigest(ctx context.Context, orgName, createdBy string, req *models.NotificationDigestRequest) (*models.NotificationDigestResponse, error)
+	ListNotificationDigests(ctx context.Context, orgName string) (*models.NotificationDigestListResponse, error)
+	GetNotificationDigest(ctx context.Context, orgName, digestID string) (*models.NotificationDigestResponse, error)
+	UpdateNotificationDigest(ctx context.Context, orgName, digestID string, req *models.NotificationDigestRequest) (*models.NotificationDigestResponse, error)
+	DeleteNotificationDigest(ctx context.Context, orgName, digestID string) error
+	// SendNotificationDigest delivers the digest of the last completed period right away, without changing its schedule
+	SendNotificationDigest(ctx context.Context, orgName, digestID string) (*models.NotificationDigestReport, error)
+	// RunNotificationDigestSender sends the due notification digests of each organization at the given interval until ctx is done
+	RunNotificationDigestSender(ctx context.Context, interval time.Duration)
+	// CreateEmailRecipient emails a verification code to an address of an organization; nothing else is sent to it until it is verified
+	CreateEmailRecipient(ctx context.Context, orgName, createdBy string, req *models.CreateEmailRecipientRequest) (*models.EmailRecipientResponse, error)
+	ListEmailRecipients(ctx context.Context, orgName string) (*models.EmailRecipientListResponse, error)
+	GetEmailRecipient(ctx context.Context, orgName, recipientID string) (*models.EmailRecipientResponse, error)
+	// UpdateEmailRecipient replaces the notifications an email recipient subscribes to
+	UpdateEmailRecipient(ctx context.Context, orgName, recipientID string, req *models.UpdateEmailRecipientRequest) (*models.EmailRecipientResponse, error)
+	DeleteEmailRecipient(ctx context.Context, orgName, recipientID string) error
+	// SendEmailRecipientVerification emails a new verification code to an unverified recipient, replacing the previous code
+	SendEmailRecipientVerification(ctx context.Context, orgName, recipientID string) (*models.EmailRecipientResponse, error)
+	VerifyEmailRecipient(ctx context.Context, orgName, recipientID string, req *models.VerifyEmailRecipientRequest) (*models.EmailRecipientResponse, error)
+	// RunAgentTokenExpiryNotifier emails a warning for the agent API keys about to expire at the given interval until ctx is done
+	RunAgentTokenExpiryNotifier(ctx context.Context, interval time.Duration)
+	// GetTraceSamplingPolicy returns the sampling policy of an agent, or of the organization when the project and agent name are empty
+	GetTraceSamplingPolicy(ctx context.Context, orgName, projectName, agentName string) (*models.TraceSamplingPolicyResponse, error)
+	// SetTraceSamplingPolicy sets the sampling rates of an agent, or the default of the organization when the project and agent name are empty
+	SetTraceSamplingPolicy(ctx context.Context, orgName, projectName, agentName, updatedBy string, req *models.TraceSamplingPolicyRequest) (*models.TraceSamplingPolicyResponse, error)
+	DeleteTraceSamplingPolicy(ctx context.Context, orgName, projectName, agentName string) error
+	ListTraceSamplingPolicies(ctx context.Context, orgName string) (*models.TraceSamplingPolicyListResponse, error)
+	// GetTraceSamplingVerdict returns the sampling rates that apply to the traces of an agent, which keep all traces unless a policy is set
+	GetTraceSamplingVerdict(ctx context.Context, orgName, projectName, agentName string) (*models.TraceSamplingVerdictResponse, error)
 }
 
 type observabilityManagerService struct {
 	traceObserverClient traceobserversvc.TraceObserverClient
 	ocClient            client.OpenChoreoClient
+	apiPlatformClient   apiplatformclient.APIPlatformClient
 	logger              *slog.Logger
 }
 
 func NewObservabilityManager(
 	traceObserverClient traceobserversvc.TraceObserverClient,
 	ocClient client.OpenChoreoClient,
+	apiPlatformClient apiplatformclient.APIPlatformClient,
 	logger *slog.Logger,
 ) ObservabilityManagerService {
 	return &observabilityManagerService{
 		traceObserverClient: traceObserverClient,
 		ocClient:            ocClient,
+		apiPlatformClient:   apiPlatformClient,
 		logger:              logger,
 	}
 }
@@ -94,16 +219,23 @@ func (s *observabilityManagerService) ListTraces(ctx context.Context, req ListTr
 		return nil, fmt.Errorf("failed to get environment: %w", err)
 	}
 
+	contentVisibility, err := s.traceContentVisibility(ctx, req.OrgName)
+	if err != nil {
+		return nil, err
+	}
+
 	// Convert service request to client params
 	clientParams := traceobserversvc.ListTracesParams{
-		ServiceName:    req.AgentName,
-		ComponentUid:   component.UUID,
-		EnvironmentUid: environment.UUID,
-		StartTime:      req.StartTime,
-		EndTime:        req.EndTime,
-		Limit:          req.Limit,
-		Offset:         req.Offset,
-		SortOrder:      req.SortOrder,
+		ServiceName:       req.AgentName,
+		ComponentUid:      component.UUID,
+		EnvironmentUid:    environment.UUID,
+		StartTime:         req.StartTime,
+		EndTime:           req.EndTime,
+		Limit:             req.Limit,
+		Offset:            req.Offset,
+		SortOrder:         req.SortOrder,
+		ContentVisibility: contentVisibility,
+		Archived:          req.Archived,
 	}
 
 	// Call the trace observer client
@@ -176,16 +308,23 @@ func (s *observabilityManagerService) ExportTraces(ctx context.Context, req List
 		return nil, fmt.Errorf("failed to get environment: %w", err)
 	}
 
+	contentVisibility, err := s.traceContentVisibility(ctx, req.OrgName)
+	if err != nil {
+		return nil, err
+	}
+
 	// Convert service request to client params
 	clientParams := traceobserversvc.ListTracesParams{
-		ServiceName:    req.AgentName,
-		ComponentUid:   component.UUID,
-		EnvironmentUid: environment.UUID,
-		StartTime:      req.StartTime,
-		EndTime:        req.EndTime,
-		Limit:          req.Limit,
-		Offset:         req.Offset,
-		SortOrder:      req.SortOrder,
+		ServiceName:       req.AgentName,
+		ComponentUid:      component.UUID,
+		EnvironmentUid:    environment.UUID,
+		StartTime:         req.StartTime,
+		EndTime:           req.EndTime,
+		Limit:             req.Limit,
+		Offset:            req.Offset,
+		SortOrder:         req.SortOrder,
+		ContentVisibility: contentVisibility,
+		Archived:          req.Archived,
 	}
 
 	// Call the trace observer client export endpoint
@@ -290,12 +429,21 @@ func (s *observabilityManagerService) GetTraceDetails(ctx context.Context, req T
 		return nil, fmt.Errorf("failed to get environment: %w", err)
 	}
 
+	contentVisibility := models.TraceContentVisibilityFull
+	if !req.RawContent {
+		if contentVisibility, err = s.traceContentVisibility(ctx, req.OrgName); err != nil {
+			return nil, err
+		}
+	}
+
 	// Convert service request to client params
 	clientParams := traceobserversvc.TraceDetailsByIdParams{
-		TraceID:        req.TraceID,
-		ServiceName:    req.AgentName,
-		ComponentUid:   component.UUID,
-		EnvironmentUid: environment.UUID,
+		TraceID:           req.TraceID,
+		ServiceName:       req.AgentName,
+		ComponentUid:      component.UUID,
+		EnvironmentUid:    environment.UUID,
+		ContentVisibility: contentVisibility,
+		Archived:          req.Archived,
 	}
 
 	// Call the trace observer client
@@ -432,8 +580,87 @@ func (s *observabilityManagerService) GetTraceDetails(ctx context.Context, req T
 		TotalCount: clientResponse.TotalCount,
 		TokenUsage: tokenUsage,
 		Status:     traceStatus,
+		Input:      clientResponse.Input,
+		Output:     clientResponse.Output,
 	}
 
 	s.logger.Info("Retrieved trace details successfully", "traceId", req.TraceID, "spanCount", response.TotalCount)
 	return response, nil
 }
+
+// GetModelUsage breaks down the LLM and embedding calls of all agents of an organization by model and provider
+func (s *observabilityManagerService) GetModelUsage(ctx context.Context, req ModelUsageRequest) (*models.ModelUsageResponse, error) {
+	s.logger.Info("Getting model usage", "orgName", req.OrgName, "environment", req.Environment)
+
+	environment, err := s.ocClient.GetEnvironment(ctx, req.OrgName, req.Environment)
+	if err != nil {
+		s.logger.Error("Failed to get environment", "environment", req.Environment, "error", err)
+		return nil, fmt.Errorf("failed to get environment: %w", err)
+	}
+
+	agents, err := s.listOrgAgents(ctx, req.OrgName)
+	if err != nil {
+		return nil, err
+	}
+	componentUids := make([]string, 0, len(agents))
+	for _, agent := range agents {
+		componentUids = append(componentUids, agent.ComponentUid)
+	}
+
+	response := &models.ModelUsageResponse{
+		Environment: req.Environment,
+		StartTime:   req.StartTime,
+		EndTime:     req.EndTime,
+		AgentCount:  len(componentUids),
+		Models:      []models.ModelUsage{},
+		Providers:   []models.ProviderUsage{},
+	}
+	// Without a component filter the trace observer would aggregate the whole environment
+	if len(componentUids) == 0 {
+		return response, nil
+	}
+
+	clientResponse, err := s.traceObserverClient.GetModelUsage(ctx, traceobserversvc.ModelUsageParams{
+		ComponentUids:  componentUids,
+		EnvironmentUid: environment.UUID,
+		StartTime:      req.StartTime,
+		EndTime:        req.EndTime,
+	})
+	if err != nil {
+		s.logger.Error("Failed to get model usage", "orgName", req.OrgName, "error", err)
+		return nil, fmt.Errorf("failed to get model usage: %w", err)
+	}
+
+	for _, usage := range clientResponse.Models {
+		response.Models = append(response.Models, models.ModelUsage{
+			Model:      usage.Model,
+			Provider:   usage.Provider,
+			UsageStats: toUsageStats(usage.UsageStats),
+		})
+	}
+	for _, usage := range clientResponse.Providers {
+		response.Providers = append(response.Providers, models.ProviderUsage{
+			Provider:   usage.Provider,
+			UsageStats: toUsageStats(usage.UsageStats),
+		})
+	}
+	response.Truncated = clientResponse.Truncated
+
+	s.logger.Info("Retrieved model usage", "orgName", req.OrgName, "agents", len(componentUids), "models", len(response.Models))
+	return response, nil
+}
+
+func toUsageStats(stats traceobserversvc.UsageStats) models.UsageStats {
+	return models.UsageStats{
+		RequestCount:  stats.RequestCount,
+		ErrorCount:    stats.ErrorCount,
+		ErrorRate:     stats.ErrorRate,
+		AvgLatencyMs:  stats.AvgLatencyMs,
+		P50LatencyMs:  stats.P50LatencyMs,
+		P95LatencyMs:  stats.P95LatencyMs,
+		InputTokens:   stats.InputTokens,
+		OutputTokens:  stats.OutputTokens,
+		TotalTokens:   stats.TotalTokens,
+		EstimatedCost: stats.EstimatedCost,
+	}
+}
@@ -0,0 +1,483 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	apiplatformclient "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/apiplatformsvc/client"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+const (
+	defaultOrganizationRegion       = "US"
+	defaultEnvironmentName          = "default"
+	defaultEnvironmentDisplayName   = "Default"
+	defaultEnvironmentDataplaneRef  = "default"
+	defaultEnvironmentDNSPrefix     = "default"
+	defaultGatewayDisplayNameSuffix = " Gateway"
+)
+
+// OrganizationService defines the interface for organization onboarding and lifecycle operations
+type OrganizationService interface {
+	CreateOrganization(ctx context.Context, req *models.CreateOrganizationRequest) (*models.OrganizationDetailsResponse, error)
+	UpdateOrganization(ctx context.Context, orgName string, req *models.UpdateOrganizationRequest) (*models.OrganizationDetailsResponse, error)
+	SuspendOrganization(ctx context.Context, orgName string) (*models.OrganizationDetailsResponse, error)
+	ResumeOrganization(ctx context.Context, orgName string) (*models.OrganizationDetailsResponse, error)
+	// DeleteOrganization removes the organization together with its environments, their gateway mappings
+	// and the API Platform gateways that are not shared with another organization
+	DeleteOrganization(ctx context.Context, orgName string) error
+	// FindOrganization returns nil without an error for organizations that are not onboarded in the database
+	FindOrganization(ctx context.Context, orgName string) (*models.Organization, error)
+
+	// ProvisionTrialSandbox creates a sandbox environment served by the shared trial gateway. An
+	// organization gets one trial sandbox, so it fails with utils.ErrTrialSandboxAlreadyUsed once
+	// the organization has had one.
+	ProvisionTrialSandbox(ctx context.Context, orgName string, actor string) (*models.TrialSandboxResponse, error)
+	GetTrialSandbox(ctx context.Context, orgName string) (*models.TrialSandboxResponse, error)
+	// EndTrialSandbox removes the environment of a trial sandbox before it expires
+	EndTrialSandbox(ctx context.Context, orgName string) (*models.TrialSandboxResponse, error)
+	// RunTrialSandboxCleanup ends the expired trial sandboxes every interval until ctx is done
+	RunTrialSandboxCleanup(ctx context.Context, interval time.Duration)
+}
+
+type organizationService struct {
+	logger            *slog.Logger
+	apiPlatformClient apiplatformclient.APIPlatformClient
+}
+
+// NewOrganizationService creates a new organization service
+func NewOrganizationService(logger *slog.Logger, apiPlatformClient apiplatformclient.APIPlatformClient) OrganizationService {
+	return &organizationService{
+		logger:            logger,
+		apiPlatformClient: apiPlatformClient,
+	}
+}
+
+func (s *organizationService) CreateOrganization(ctx context.Context, req *models.CreateOrganizationRequest) (*models.OrganizationDetailsResponse, error) {
+	s.logger.Info("Creating organization", "name", req.Name)
+
+	if err := validateCreateOrganizationRequest(req); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	org := &models.Organization{
+		UUID:                 uuid.New(),
+		Name:                 req.Name,
+		Handle:               req.Handle,
+		Region:               req.Region,
+		DisplayName:          req.DisplayName,
+		Description:          req.Description,
+		Metadata:             req.Metadata,
+		DefaultGatewayConfig: req.DefaultGateway,
+		Status:               models.OrganizationStatusActive,
+		CreatedAt:            now,
+		UpdatedAt:            now,
+	}
+	if org.Handle == "" {
+		org.Handle = generateHandle(req.Name)
+	}
+	if org.Region == "" {
+		org.Region = defaultOrganizationRegion
+	}
+	if org.DisplayName == "" {
+		org.DisplayName = req.Name
+	}
+	if org.Metadata == nil {
+		org.Metadata = map[string]string{}
+	}
+
+	var env *models.Environment
+	if !req.SkipDefaultEnvironment {
+		env = newDefaultEnvironment(req.Name, req.DefaultEnvironment, now)
+	}
+
+	// Provision the default gateway first so that a failure leaves nothing behind in the database
+	var gateway *apiplatformclient.GatewayResponse
+	if req.DefaultGateway != nil {
+		if s.apiPlatformClient == nil {
+			return nil, fmt.Errorf("%w: API Platform is not configured", utils.ErrServiceUnavailable)
+		}
+		created, err := s.createDefaultGateway(ctx, org, req.DefaultGateway)
+		if err != nil {
+			return nil, err
+		}
+		gateway = created
+	}
+
+	err := db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&models.Organization{}).Unscoped().
+			Where("name = ? OR handle = ?", org.Name, org.Handle).Count(&count).Error; err != nil {
+			return fmt.Errorf("failed to check existing organization: %w", err)
+		}
+		if count > 0 {
+			return utils.ErrOrganizationAlreadyExists
+		}
+		if err := tx.Create(org).Error; err != nil {
+			if isUniqueViolation(err) {
+				return utils.ErrOrganizationAlreadyExists
+			}
+			return fmt.Errorf("failed to create organization: %w", err)
+		}
+		if env == nil {
+			return nil
+		}
+		if err := tx.Create(env).Error; err != nil {
+			return fmt.Errorf("failed to create default environment: %w", err)
+		}
+		if gateway == nil {
+			return nil
+		}
+		gwUUID, err := uuid.Parse(gateway.ID)
+		if err != nil {
+			return fmt.Errorf("invalid gateway id %q returned by API Platform: %w", gateway.ID, err)
+		}
+		mapping := &models.GatewayEnvironmentMapping{
+			GatewayUUID:     gwUUID,
+			EnvironmentUUID: env.UUID,
+			CreatedAt:       now,
+		}
+		if err := tx.Create(mapping).Error; err != nil {
+			return fmt.Errorf("failed to assign default gateway to environment: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		if gateway != nil {
+			if delErr := s.apiPlatformClient.DeleteGateway(ctx, gateway.ID); delErr != nil {
+				s.logger.Warn("Failed to clean up default gateway", "gatewayID", gateway.ID, "error", delErr)
+			}
+		}
+		if !errors.Is(err, utils.ErrOrganizationAlreadyExists) {
+			s.logger.Error("Failed to create organization", "name", req.Name, "error", err)
+		}
+		return nil, err
+	}
+
+	if s.apiPlatformClient != nil {
+		s.registerInAPIPlatform(ctx, org)
+	}
+
+	s.logger.Info("Organization created successfully", "name", org.Name, "uuid", org.UUID)
+	resp := org.ToDetailsResponse()
+	if env != nil {
+		resp.DefaultEnvironment = env.ToResponse()
+	}
+	if gateway != nil {
+		resp.DefaultGatewayID = gateway.ID
+	}
+	if config.GetConfig().TrialSandbox.AutoProvision {
+		// The organization is usable without the sandbox, which can be provisioned again later
+		sandbox, err := s.ProvisionTrialSandbox(ctx, org.Name, "")
+		if err != nil {
+			s.logger.Warn("Failed to provision trial sandbox", "orgName", org.Name, "error", err)
+		}
+		resp.TrialSandbox = sandbox
+	}
+	return resp, nil
+}
+
+func (s *organizationService) UpdateOrganization(ctx context.Context, orgName string, req *models.UpdateOrganizationRequest) (*models.OrganizationDetailsResponse, error) {
+	s.logger.Info("Updating organization", "orgName", orgName)
+
+	return s.updateOrganization(ctx, orgName, func(org *models.Organization) {
+		if req.DisplayName != nil {
+			org.DisplayName = *req.DisplayName
+		}
+		if req.Description != nil {
+			org.Description = *req.Description
+		}
+		if req.Metadata != nil {
+			org.Metadata = req.Metadata
+		}
+	})
+}
+
+func (s *organizationService) SuspendOrganization(ctx context.Context, orgName string) (*models.OrganizationDetailsResponse, error) {
+	s.logger.Info("Suspending organization", "orgName", orgName)
+
+	return s.updateOrganization(ctx, orgName, func(org *models.Organization) {
+		if org.IsSuspended() {
+			return
+		}
+		now := time.Now()
+		org.Status = models.OrganizationStatusSuspended
+		org.SuspendedAt = &now
+	})
+}
+
+func (s *organizationService) ResumeOrganization(ctx context.Context, orgName string) (*models.OrganizationDetailsResponse, error) {
+	s.logger.Info("Resuming organization", "orgName", orgName)
+
+	return s.updateOrganization(ctx, orgName, func(org *models.Organization) {
+		org.Status = models.OrganizationStatusActive
+		org.SuspendedAt = nil
+	})
+}
+
+// organizationScopedModels are the resources keyed by organization name that are deleted with
+// the organization, so that an organization onboarded again under the same name starts empty.
+// Rows that reference them, such as SCIM group members and golden trace runs, are removed by
+// their ON DELETE CASCADE foreign keys.
+var organizationScopedModels = []interface{}{
+	&models.ScimUser{},
+	&models.ScimGroup{},
+	&models.AgentMCPServer{},
+	&models.MCPServer{},
+	&models.AgentDeploymentRevision{},
+	&models.TraceRetentionPolicy{},
+	&models.TraceErasureRequest{},
+	&models.TraceReplay{},
+	&models.GoldenTrace{},
+	&models.TraceScoringPolicy{},
+	&models.TraceScore{},
+	&models.AgentSLO{},
+	&models.AgentTokenBudget{},
+	&models.AgentPublication{},
+	&models.GatewayBulkOperation{},
+	&models.ResourceEvent{},
+	&models.UsageReport{},
+	&models.CostCenterMapping{},
+	&models.DeploymentApprovalPolicy{},
+	&models.DeploymentApproval{},
+	&models.ChangeFreezeWindow{},
+	&models.TraceContentSetting{},
+	&models.NotificationDigest{},
+	&models.TraceSamplingPolicy{},
+	&models.TrialSandbox{},
+	&models.EmailRecipient{},
+	&models.AgentTokenExpiry{},
+}
+
+func (s *organizationService) DeleteOrganization(ctx context.Context, orgName string) error {
+	s.logger.Info("Deleting organization", "orgName", orgName)
+
+	org, err := s.getOrganization(db.Primary(db.DB(ctx)), orgName)
+	if err != nil {
+		return err
+	}
+
+	var envUUIDs []uuid.UUID
+	if err := db.Primary(db.DB(ctx)).Model(&models.Environment{}).Unscoped().
+		Where("organization_name = ?", orgName).Pluck("uuid", &envUUIDs).Error; err != nil {
+		return fmt.Errorf("failed to list organization environments: %w", err)
+	}
+
+	// Gateways live in API Platform; remove them before the mappings that reference them so
+	// that a failed cleanup can be retried
+	gatewayIDs, err := s.exclusiveGateways(ctx, envUUIDs)
+	if err != nil {
+		return err
+	}
+	if len(gatewayIDs) > 0 && s.apiPlatformClient == nil {
+		return fmt.Errorf("%w: API Platform is not configured", utils.ErrServiceUnavailable)
+	}
+	for _, gatewayID := range gatewayIDs {
+		if err := s.apiPlatformClient.DeleteGateway(ctx, gatewayID.String()); err != nil && !errors.Is(err, utils.ErrGatewayNotFound) {
+			return fmt.Errorf("failed to delete gateway %s: %w", gatewayID, err)
+		}
+		s.logger.Info("Deleted organization gateway", "orgName", orgName, "gatewayID", gatewayID)
+	}
+
+	err = db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if len(envUUIDs) > 0 {
+			if err := tx.Where("environment_uuid IN ?", envUUIDs).Delete(&models.GatewayEnvironmentMapping{}).Error; err != nil {
+				return fmt.Errorf("failed to delete gateway-environment mappings: %w", err)
+			}
+		}
+		if err := tx.Unscoped().Where("organization_name = ?", orgName).Delete(&models.Environment{}).Error; err != nil {
+			return fmt.Errorf("failed to delete environments: %w", err)
+		}
+		for _, model := range organizationScopedModels {
+			if err := tx.Where("organization_name = ?", orgName).Delete(model).Error; err != nil {
+				return fmt.Errorf("failed to delete organization data: %w", err)
+			}
+		}
+		// Hard delete so that the name and handle can be onboarded again
+		if err := tx.Unscoped().Delete(org).Error; err != nil {
+			return fmt.Errorf("failed to delete organization: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("Failed to delete organization", "orgName", orgName, "error", err)
+		return err
+	}
+
+	s.logger.Info("Organization deleted successfully", "orgName", orgName,
+		"environments", len(envUUIDs), "gateways", len(gatewayIDs))
+	return nil
+}
+
+func (s *organizationService) FindOrganization(ctx context.Context, orgName string) (*models.Organization, error) {
+	org, err := s.getOrganization(db.DB(ctx), orgName)
+	if errors.Is(err, utils.ErrOrganizationNotFound) {
+		return nil, nil
+	}
+	return org, err
+}
+
+// updateOrganization loads the organization on the primary, applies the change and saves it
+func (s *organizationService) updateOrganization(ctx context.Context, orgName string, apply func(org *models.Organization)) (*models.OrganizationDetailsResponse, error) {
+	var org *models.Organization
+	err := db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		existing, err := s.getOrganization(tx, orgName)
+		if err != nil {
+			return err
+		}
+		apply(existing)
+		existing.UpdatedAt = time.Now()
+		if err := tx.Save(existing).Error; err != nil {
+			return fmt.Errorf("failed to update organization: %w", err)
+		}
+		org = existing
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return org.ToDetailsResponse(), nil
+}
+
+func (s *organizationService) getOrganization(tx *gorm.DB, orgName string) (*models.Organization, error) {
+	var org models.Organization
+	if err := tx.Where("name = ?", orgName).First(&org).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, utils.ErrOrganizationNotFound
+		}
+		return nil, fmt.Errorf("failed to get organization: %w", err)
+	}
+	return &org, nil
+}
+
+// exclusiveGateways returns the gateways mapped to the given environments and to no other
+// environment. Trial sandbox gateways are shared, so they are never returned.
+func (s *organizationService) exclusiveGateways(ctx context.Context, envUUIDs []uuid.UUID) ([]uuid.UUID, error) {
+	if len(envUUIDs) == 0 {
+		return nil, nil
+	}
+	var gatewayIDs []uuid.UUID
+	err := db.Primary(db.DB(ctx)).Model(&models.GatewayEnvironmentMapping{}).
+		Distinct("gateway_uuid").
+		Where("environment_uuid IN ?", envUUIDs).
+		Where("gateway_uuid NOT IN (?)", db.DB(ctx).Model(&models.GatewayEnvironmentMapping{}).
+			Select("gateway_uuid").Where("environment_uuid NOT IN ?", envUUIDs)).
+		Where("gateway_uuid NOT IN (?)", db.DB(ctx).Model(&models.TrialSandbox{}).Select("gateway_uuid")).
+		Pluck("gateway_uuid", &gatewayIDs).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organization gateways: %w", err)
+	}
+	return gatewayIDs, nil
+}
+
+func (s *organizationService) createDefaultGateway(ctx context.Context, org *models.Organization, cfg *models.OrganizationGatewayConfig) (*apiplatformclient.GatewayResponse, error) {
+	displayName := cfg.DisplayName
+	if displayName == "" {
+		displayName = org.DisplayName + defaultGatewayDisplayNameSuffix
+	}
+	clientReq := apiplatformclient.CreateGatewayRequest{
+		Name:              cfg.Name,
+		DisplayName:       displayName,
+		Vhost:             cfg.VHost,
+		FunctionalityType: manifestGatewayFunctionalityType(cfg.GatewayType),
+		IsCritical:        &cfg.IsCritical,
+		Properties:        GatewayProperties(org.Name, cfg.AdapterConfig),
+	}
+	if err := CheckGatewayVhost(ctx, s.apiPlatformClient, cfg.VHost); err != nil {
+		return nil, err
+	}
+
+	gateway, err := s.apiPlatformClient.CreateGateway(ctx, clientReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create default gateway: %w", err)
+	}
+	s.logger.Info("Default gateway created", "orgName", org.Name, "gatewayID", gateway.ID)
+	return gateway, nil
+}
+
+// registerInAPIPlatform registers the organization with API Platform. Failures are logged only,
+// the startup organization sync registers it again.
+func (s *organizationService) registerInAPIPlatform(ctx context.Context, org *models.Organization) {
+	_, err := s.apiPlatformClient.RegisterOrganization(ctx, apiplatformclient.RegisterOrganizationRequest{
+		ID:     org.UUID.String(),
+		Name:   org.Name,
+		Handle: org.Handle,
+		Region: org.Region,
+	})
+	if err != nil && !strings.Contains(err.Error(), "409") && !strings.Contains(err.Error(), "conflict") {
+		s.logger.Warn("Failed to register organization in API Platform", "orgName", org.Name, "error", err)
+	}
+}
+
+func newDefaultEnvironment(orgName string, cfg *models.OrganizationEnvironmentConfig, now time.Time) *models.Environment {
+	env := &models.Environment{
+		UUID:             uuid.New(),
+		OrganizationName: orgName,
+		Name:             defaultEnvironmentName,
+		DisplayName:      defaultEnvironmentDisplayName,
+		DataplaneRef:     defaultEnvironmentDataplaneRef,
+		DNSPrefix:        defaultEnvironmentDNSPrefix,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+	if cfg == nil {
+		return env
+	}
+	if cfg.Name != "" {
+		env.Name = cfg.Name
+	}
+	if cfg.DisplayName != "" {
+		env.DisplayName = cfg.DisplayName
+	}
+	if cfg.DataplaneRef != "" {
+		env.DataplaneRef = cfg.DataplaneRef
+	}
+	if cfg.DNSPrefix != "" {
+		env.DNSPrefix = cfg.DNSPrefix
+	}
+	return env
+}
+
+func validateCreateOrganizationRequest(req *models.CreateOrganizationRequest) error {
+	if err := utils.ValidateResourceName(req.Name, "organization"); err != nil {
+		return fmt.Errorf("%w: %s", utils.ErrInvalidInput, err.Error())
+	}
+	if req.DefaultGateway != nil {
+		if req.SkipDefaultEnvironment {
+			return fmt.Errorf("%w: a default gateway requires the default environment", utils.ErrInvalidInput)
+		}
+	}
+	return nil
+}
+
+func isUniqueViolation(err error) bool {
+	msg := strings.ToLower(err.Error())
+	return errors.Is(err, gorm.ErrDuplicatedKey) || strings.Contains(msg, "unique") || strings.Contains(msg, "duplicate")
+}
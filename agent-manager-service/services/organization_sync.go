@@ -120,8 +120,8 @@ func (s *organizationSynchronizer) synchronizeOrganization(ctx context.Context,
 			// Handle unique constraint violation
 			if errors.Is(err, gorm.ErrDuplicatedKey) || strings.Contains(strings.ToLower(err.Error()), "unique") || strings.Contains(strings.ToLower(err.Error()), "duplicate") {
 				s.logger.Debug("Organization already exists in DB", "orgName", ocOrg.Name)
-				// Re-fetch to get the existing org
-				if err := db.DB(ctx).Where("name = ?", ocOrg.Name).First(&org).Error; err != nil {
+				// Re-fetch to get the existing org; a replica may not have it yet
+				if err := db.Primary(db.DB(ctx)).Where("name = ?", ocOrg.Name).First(&org).Error; err != nil {
 					return fmt.Errorf("failed to get existing organization: %w", err)
 				}
 			} else {
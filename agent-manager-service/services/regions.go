@@ -0,0 +1,63 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	apiplatformclient "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/apiplatformsvc/client"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// GatewayRegionProperty is the API Platform gateway property that holds the region of a gateway
+const GatewayRegionProperty = "region"
+
+// ValidateRegion checks that a region is in the regions catalog. An empty region is valid and
+// leaves the resource unconstrained.
+func ValidateRegion(region string) error {
+	if region == "" {
+		return nil
+	}
+	regions := config.GetConfig().Regions
+	if !slices.Contains(regions, region) {
+		return fmt.Errorf("%w: %q is not one of %s", utils.ErrUnknownRegion, region, strings.Join(regions, ", "))
+	}
+	return nil
+}
+
+// GatewayRegion returns the region of an API Platform gateway, or "" for gateways registered
+// without one
+func GatewayRegion(gw *apiplatformclient.GatewayResponse) string {
+	region, _ := gw.Properties[GatewayRegionProperty].(string)
+	return region
+}
+
+// CheckGatewayRegion checks that a gateway in the given region can serve an environment. Gateways
+// can serve environments without a region, and environments in their own region.
+func CheckGatewayRegion(env *models.Environment, gatewayRegion string) error {
+	if env.Region == "" || env.Region == gatewayRegion {
+		return nil
+	}
+	if gatewayRegion == "" {
+		return fmt.Errorf("%w: environment %s is in region %s and the gateway has no region", utils.ErrGatewayRegionMismatch, env.Name, env.Region)
+	}
+	return fmt.Errorf("%w: environment %s is in region %s and the gateway is in region %s", utils.ErrGatewayRegionMismatch, env.Name, env.Region, gatewayRegion)
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+)
+
+// ResourceEventService keeps the event timeline of resources, such as the creation, token
+// rotations and health changes of a gateway
+type ResourceEventService interface {
+	// RecordEvent adds an event to the timeline of a resource. The timeline is informational, so a
+	// failure is logged rather than failing the change it records.
+	RecordEvent(ctx context.Context, orgName, resourceType, resourceID, eventType, actor string, details map[string]interface{})
+	// AppendEvent adds an event to the timeline of a resource and returns an error if it cannot be
+	// stored, for events that are the audit record of the change and must not be lost
+	AppendEvent(ctx context.Context, orgName, resourceType, resourceID, eventType, actor string, details map[string]interface{}) error
+	// LastEvent returns the latest event of a type on the timeline of a resource, or nil if there is none
+	LastEvent(ctx context.Context, orgName, resourceType, resourceID, eventType string) (*models.ResourceEvent, error)
+	// ListEvents returns a page of the timeline of a resource, newest event first
+	ListEvents(ctx context.Context, orgName, resourceType, resourceID string, limit, offset int) (*models.ResourceEventListResponse, error)
+}
+
+type resourceEventService struct {
+	logger *slog.Logger
+}
+
+// NewResourceEventService creates a new resource event service
+func NewResourceEventService(logger *slog.Logger) ResourceEventService {
+	return &resourceEventService{
+		logger: logger,
+	}
+}
+
+func (s *resourceEventService) RecordEvent(ctx context.Context, orgName, resourceType, resourceID, eventType, actor string, details map[string]interface{}) {
+	if err := s.AppendEvent(ctx, orgName, resourceType, resourceID, eventType, actor, details); err != nil {
+		s.logger.Warn("Failed to record resource event",
+			"resourceType", resourceType, "resourceId", resourceID, "eventType", eventType, "error", err)
+	}
+}
+
+func (s *resourceEventService) AppendEvent(ctx context.Context, orgName, resourceType, resourceID, eventType, actor string, details map[string]interface{}) error {
+	event := &models.ResourceEvent{
+		UUID:             uuid.New(),
+		OrganizationName: orgName,
+		ResourceType:     resourceType,
+		ResourceID:       resourceID,
+		EventType:        eventType,
+		Actor:            actor,
+		Details:          details,
+		CreatedAt:        time.Now(),
+	}
+	if err := db.DB(ctx).Create(event).Error; err != nil {
+		return fmt.Errorf("failed to record resource event: %w", err)
+	}
+	return nil
+}
+
+func (s *resourceEventService) LastEvent(ctx context.Context, orgName, resourceType, resourceID, eventType string) (*models.ResourceEvent, error) {
+	var event models.ResourceEvent
+	err := db.DB(ctx).
+		Where("organization_name = ? AND resource_type = ? AND resource_id = ? AND event_type = ?", orgName, resourceType, resourceID, eventType).
+		Order("created_at DESC").
+		First(&event).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last resource event: %w", err)
+	}
+	return &event, nil
+}
+
+func (s *resourceEventService) ListEvents(ctx context.Context, orgName, resourceType, resourceID string, limit, offset int) (*models.ResourceEventListResponse, error) {
+	query := db.DB(ctx).Model(&models.ResourceEvent{}).
+		Where("organization_name = ? AND resource_type = ? AND resource_id = ?", orgName, resourceType, resourceID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, fmt.Errorf("failed to count resource events: %w", err)
+	}
+	var events []models.ResourceEvent
+	if err := query.Order("created_at DESC").Limit(limit).Offset(offset).Find(&events).Error; err != nil {
+		return nil, fmt.Errorf("failed to list resource events: %w", err)
+	}
+
+	response := &models.ResourceEventListResponse{
+		Events: make([]models.ResourceEventResponse, len(events)),
+		Total:  total,
+		Limit:  limit,
+		Offset: offset,
+	}
+	for i, event := range events {
+		response.Events[i] = models.ResourceEventResponse{
+			ID:        event.UUID.String(),
+			Type:      event.EventType,
+			Actor:     event.Actor,
+			Details:   event.Details,
+			CreatedAt: event.CreatedAt,
+		}
+	}
+	return response, nil
+}
@@ -0,0 +1,929 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+const (
+	ScimDefaultCount = 100
+	ScimMaxCount     = 200
+)
+
+// scimFilterPattern matches the single-attribute equality filters identity providers send
+// when looking up a resource before provisioning it, e.g. userName eq "alice@example.com"
+var scimFilterPattern = regexp.MustCompile(`(?i)^\s*([a-z.]+)\s+eq\s+"((?:[^"\\]|\\.)*)"\s*$`)
+
+// scimMemberFilterPattern matches PATCH paths that select a single group member, e.g. members[value eq "id"]
+var scimMemberFilterPattern = regexp.MustCompile(`(?i)^\s*members\s*\[\s*value\s+eq\s+"([^"]*)"\s*\]\s*$`)
+
+// ScimService provisions users and groups pushed by enterprise identity providers over SCIM 2.0.
+// Groups are the subjects that role bindings are granted to.
+type ScimService interface {
+	ListUsers(ctx context.Context, orgName string, query models.ScimListQuery) ([]models.ScimUserResource, int64, error)
+	GetUser(ctx context.Context, orgName string, userID string) (*models.ScimUserResource, error)
+	CreateUser(ctx context.Context, orgName string, user *models.ScimUserResource) (*models.ScimUserResource, error)
+	ReplaceUser(ctx context.Context, orgName string, userID string, user *models.ScimUserResource) (*models.ScimUserResource, error)
+	PatchUser(ctx context.Context, orgName string, userID string, patch *models.ScimPatchRequest) (*models.ScimUserResource, error)
+	DeleteUser(ctx context.Context, orgName string, userID string) error
+
+	ListGroups(ctx context.Context, orgName string, query models.ScimListQuery) ([]models.ScimGroupResource, int64, error)
+	GetGroup(ctx context.Context, orgName string, groupID string) (*models.ScimGroupResource, error)
+	CreateGroup(ctx context.Context, orgName string, group *models.ScimGroupResource) (*models.ScimGroupResource, error)
+	ReplaceGroup(ctx context.Context, orgName string, groupID string, group *models.ScimGroupResource) (*models.ScimGroupResource, error)
+	PatchGroup(ctx context.Context, orgName string, groupID string, patch *models.ScimPatchRequest) (*models.ScimGroupResource, error)
+	DeleteGroup(ctx context.Context, orgName string, groupID string) error
+}
+
+type scimService struct {
+	logger *slog.Logger
+}
+
+// NewScimService creates a new SCIM provisioning service
+func NewScimService(logger *slog.Logger) ScimService {
+	return &scimService{
+		logger: logger,
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Users
+// -----------------------------------------------------------------------------
+
+var scimUserFilterColumns = map[string]string{
+	"username":    "LOWER(user_name) = LOWER(?)",
+	"externalid":  "external_id = ?",
+	"displayname": "display_name = ?",
+}
+
+func (s *scimService) ListUsers(ctx context.Context, orgName string, query models.ScimListQuery) ([]models.ScimUserResource, int64, error) {
+	tx := db.DB(ctx).Model(&models.ScimUser{}).Where("organization_name = ?", orgName)
+	tx, err := applyScimFilter(tx, query.Filter, scimUserFilterColumns)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var total int64
+	if err := tx.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count users: %w", err)
+	}
+	if query.Count == 0 {
+		return []models.ScimUserResource{}, total, nil
+	}
+
+	var users []models.ScimUser
+	if err := tx.Order("created_at, uuid").Offset(query.StartIndex - 1).Limit(query.Count).Find(&users).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	ids := make([]uuid.UUID, len(users))
+	for i := range users {
+		ids[i] = users[i].UUID
+	}
+	groups, err := loadScimUserGroups(db.DB(ctx), ids)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	resources := make([]models.ScimUserResource, len(users))
+	for i := range users {
+		resources[i] = *toScimUserResource(&users[i], groups[users[i].UUID])
+	}
+	return resources, total, nil
+}
+
+func (s *scimService) GetUser(ctx context.Context, orgName string, userID string) (*models.ScimUserResource, error) {
+	user, err := getScimUser(db.DB(ctx), orgName, userID)
+	if err != nil {
+		return nil, err
+	}
+	return userResource(db.DB(ctx), user)
+}
+
+func (s *scimService) CreateUser(ctx context.Context, orgName string, req *models.ScimUserResource) (*models.ScimUserResource, error) {
+	if strings.TrimSpace(req.UserName) == "" {
+		return nil, fmt.Errorf("%w: userName is required", utils.ErrInvalidInput)
+	}
+
+	now := time.Now()
+	user := &models.ScimUser{
+		UUID:             uuid.New(),
+		OrganizationName: orgName,
+		Active:           true,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+	setScimUserAttributes(user, req)
+
+	err := db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&models.ScimUser{}).
+			Where("organization_name = ? AND LOWER(user_name) = LOWER(?)", orgName, user.UserName).
+			Count(&count).Error; err != nil {
+			return fmt.Errorf("failed to check existing user: %w", err)
+		}
+		if count > 0 {
+			return utils.ErrScimUserAlreadyExists
+		}
+		if err := tx.Create(user).Error; err != nil {
+			if isUniqueViolation(err) {
+				return utils.ErrScimUserAlreadyExists
+			}
+			return fmt.Errorf("failed to create user: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("SCIM user provisioned", "orgName", orgName, "userID", user.UUID)
+	return toScimUserResource(user, nil), nil
+}
+
+func (s *scimService) ReplaceUser(ctx context.Context, orgName string, userID string, req *models.ScimUserResource) (*models.ScimUserResource, error) {
+	if strings.TrimSpace(req.UserName) == "" {
+		return nil, fmt.Errorf("%w: userName is required", utils.ErrInvalidInput)
+	}
+	return s.updateUser(ctx, orgName, userID, func(user *models.ScimUser) error {
+		*user = models.ScimUser{
+			UUID:             user.UUID,
+			OrganizationName: user.OrganizationName,
+			Active:           true,
+			CreatedAt:        user.CreatedAt,
+		}
+		setScimUserAttributes(user, req)
+		return nil
+	})
+}
+
+func (s *scimService) PatchUser(ctx context.Context, orgName string, userID string, patch *models.ScimPatchRequest) (*models.ScimUserResource, error) {
+	return s.updateUser(ctx, orgName, userID, func(user *models.ScimUser) error {
+		for _, op := range patch.Operations {
+			if err := applyScimUserPatch(user, op); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *scimService) DeleteUser(ctx context.Context, orgName string, userID string) error {
+	user, err := getScimUser(db.DB(ctx), orgName, userID)
+	if err != nil {
+		return err
+	}
+	err = db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_uuid = ?", user.UUID).Delete(&models.ScimGroupMember{}).Error; err != nil {
+			return fmt.Errorf("failed to delete group memberships: %w", err)
+		}
+		if err := tx.Delete(user).Error; err != nil {
+			return fmt.Errorf("failed to delete user: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	s.logger.Info("SCIM user deprovisioned", "orgName", orgName, "userID", user.UUID)
+	return nil
+}
+
+func (s *scimService) updateUser(ctx context.Context, orgName string, userID string, apply func(user *models.ScimUser) error) (*models.ScimUserResource, error) {
+	var resource *models.ScimUserResource
+	err := db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		user, err := getScimUser(tx, orgName, userID)
+		if err != nil {
+			return err
+		}
+		if err := apply(user); err != nil {
+			return err
+		}
+		if strings.TrimSpace(user.UserName) == "" {
+			return fmt.Errorf("%w: userName is required", utils.ErrInvalidInput)
+		}
+		var count int64
+		if err := tx.Model(&models.ScimUser{}).
+			Where("organization_name = ? AND LOWER(user_name) = LOWER(?) AND uuid <> ?", orgName, user.UserName, user.UUID).
+			Count(&count).Error; err != nil {
+			return fmt.Errorf("failed to check existing user: %w", err)
+		}
+		if count > 0 {
+			return utils.ErrScimUserAlreadyExists
+		}
+		user.UpdatedAt = time.Now()
+		if err := tx.Save(user).Error; err != nil {
+			return fmt.Errorf("failed to update user: %w", err)
+		}
+		resource, err = userResource(tx, user)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resource, nil
+}
+
+func getScimUser(tx *gorm.DB, orgName string, userID string) (*models.ScimUser, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, utils.ErrScimUserNotFound
+	}
+	var user models.ScimUser
+	if err := tx.Where("uuid = ? AND organization_name = ?", id, orgName).First(&user).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, utils.ErrScimUserNotFound
+		}
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	return &user, nil
+}
+
+func userResource(tx *gorm.DB, user *models.ScimUser) (*models.ScimUserResource, error) {
+	groups, err := loadScimUserGroups(tx, []uuid.UUID{user.UUID})
+	if err != nil {
+		return nil, err
+	}
+	return toScimUserResource(user, groups[user.UUID]), nil
+}
+
+func setScimUserAttributes(user *models.ScimUser, req *models.ScimUserResource) {
+	user.UserName = req.UserName
+	user.ExternalID = optionalString(req.ExternalID)
+	user.DisplayName = req.DisplayName
+	if req.Name != nil {
+		user.GivenName = req.Name.GivenName
+		user.FamilyName = req.Name.FamilyName
+	}
+	user.Emails = req.Emails
+	if req.Active != nil {
+		user.Active = *req.Active
+	}
+}
+
+// applyScimUserPatch applies a single PATCH operation. Operations without a path carry a map of
+// attributes, which is how most identity providers deactivate users.
+func applyScimUserPatch(user *models.ScimUser, op models.ScimPatchOperation) error {
+	switch strings.ToLower(op.Op) {
+	case "add", "replace":
+		if op.Path == "" {
+			attrs, ok := op.Value.(map[string]any)
+			if !ok {
+				return fmt.Errorf("%w: value must be an object when path is not set", utils.ErrInvalidInput)
+			}
+			for path, value := range attrs {
+				if err := setScimUserPath(user, path, value); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		return setScimUserPath(user, op.Path, op.Value)
+	case "remove":
+		if op.Path == "" {
+			return fmt.Errorf("%w: path is required for remove operations", utils.ErrInvalidInput)
+		}
+		return setScimUserPath(user, op.Path, nil)
+	default:
+		return fmt.Errorf("%w: unsupported patch operation %q", utils.ErrInvalidInput, op.Op)
+	}
+}
+
+func setScimUserPath(user *models.ScimUser, path string, value any) error {
+	switch strings.ToLower(path) {
+	case "username":
+		userName, err := scimString(path, value)
+		if err != nil {
+			return err
+		}
+		user.UserName = userName
+	case "externalid":
+		externalID, err := scimString(path, value)
+		if err != nil {
+			return err
+		}
+		user.ExternalID = optionalString(externalID)
+	case "displayname":
+		displayName, err := scimString(path, value)
+		if err != nil {
+			return err
+		}
+		user.DisplayName = displayName
+	case "name.givenname":
+		givenName, err := scimString(path, value)
+		if err != nil {
+			return err
+		}
+		user.GivenName = givenName
+	case "name.familyname":
+		familyName, err := scimString(path, value)
+		if err != nil {
+			return err
+		}
+		user.FamilyName = familyName
+	case "name":
+		name, _ := value.(map[string]any)
+		for key, v := range name {
+			if key == "formatted" {
+				continue
+			}
+			if err := setScimUserPath(user, "name."+key, v); err != nil {
+				return err
+			}
+		}
+		if value == nil {
+			user.GivenName, user.FamilyName = "", ""
+		}
+	case "active":
+		active, err := scimBool(value)
+		if err != nil {
+			return err
+		}
+		user.Active = active
+	case "emails":
+		emails, err := scimEmails(value)
+		if err != nil {
+			return err
+		}
+		user.Emails = emails
+	default:
+		return fmt.Errorf("%w: unsupported attribute %q", utils.ErrInvalidInput, path)
+	}
+	return nil
+}
+
+func toScimUserResource(user *models.ScimUser, groups []models.ScimGroupRef) *models.ScimUserResource {
+	active := user.Active
+	resource := &models.ScimUserResource{
+		Schemas:     []string{models.ScimSchemaUser},
+		ID:          user.UUID.String(),
+		UserName:    user.UserName,
+		DisplayName: user.DisplayName,
+		Emails:      user.Emails,
+		Active:      &active,
+		Groups:      groups,
+		Meta: &models.ScimMeta{
+			ResourceType: "User",
+			Created:      user.CreatedAt,
+			LastModified: user.UpdatedAt,
+		},
+	}
+	if user.ExternalID != nil {
+		resource.ExternalID = *user.ExternalID
+	}
+	if user.GivenName != "" || user.FamilyName != "" {
+		resource.Name = &models.ScimName{
+			GivenName:  user.GivenName,
+			FamilyName: user.FamilyName,
+			Formatted:  strings.TrimSpace(user.GivenName + " " + user.FamilyName),
+		}
+	}
+	return resource
+}
+
+func loadScimUserGroups(tx *gorm.DB, userIDs []uuid.UUID) (map[uuid.UUID][]models.ScimGroupRef, error) {
+	result := make(map[uuid.UUID][]models.ScimGroupRef)
+	if len(userIDs) == 0 {
+		return result, nil
+	}
+	var rows []struct {
+		UserUUID    uuid.UUID
+		GroupUUID   uuid.UUID
+		DisplayName string
+	}
+	err := tx.Table("scim_group_members m").
+		Select("m.user_uuid, m.group_uuid, g.display_name").
+		Joins("JOIN scim_groups g ON g.uuid = m.group_uuid").
+		Where("m.user_uuid IN ?", userIDs).
+		Order("g.display_name").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to load user groups: %w", err)
+	}
+	for _, row := range rows {
+		result[row.UserUUID] = append(result[row.UserUUID], models.ScimGroupRef{
+			Value:   row.GroupUUID.String(),
+			Display: row.DisplayName,
+		})
+	}
+	return result, nil
+}
+
+// -----------------------------------------------------------------------------
+// Groups
+// -----------------------------------------------------------------------------
+
+var scimGroupFilterColumns = map[string]string{
+	"displayname": "display_name = ?",
+	"externalid":  "external_id = ?",
+}
+
+func (s *scimService) ListGroups(ctx context.Context, orgName string, query models.ScimListQuery) ([]models.ScimGroupResource, int64, error) {
+	tx := db.DB(ctx).Model(&models.ScimGroup{}).Where("organization_name = ?", orgName)
+	tx, err := applyScimFilter(tx, query.Filter, scimGroupFilterColumns)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var total int64
+	if err := tx.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count groups: %w", err)
+	}
+	if query.Count == 0 {
+		return []models.ScimGroupResource{}, total, nil
+	}
+
+	var groups []models.ScimGroup
+	if err := tx.Order("created_at, uuid").Offset(query.StartIndex - 1).Limit(query.Count).Find(&groups).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list groups: %w", err)
+	}
+
+	ids := make([]uuid.UUID, len(groups))
+	for i := range groups {
+		ids[i] = groups[i].UUID
+	}
+	members, err := loadScimGroupMembers(db.DB(ctx), ids)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	resources := make([]models.ScimGroupResource, len(groups))
+	for i := range groups {
+		resources[i] = *toScimGroupResource(&groups[i], members[groups[i].UUID])
+	}
+	return resources, total, nil
+}
+
+func (s *scimService) GetGroup(ctx context.Context, orgName string, groupID string) (*models.ScimGroupResource, error) {
+	group, err := getScimGroup(db.DB(ctx), orgName, groupID)
+	if err != nil {
+		return nil, err
+	}
+	return groupResource(db.DB(ctx), group)
+}
+
+func (s *scimService) CreateGroup(ctx context.Context, orgName string, req *models.ScimGroupResource) (*models.ScimGroupResource, error) {
+	if strings.TrimSpace(req.DisplayName) == "" {
+		return nil, fmt.Errorf("%w: displayName is required", utils.ErrInvalidInput)
+	}
+
+	now := time.Now()
+	group := &models.ScimGroup{
+		UUID:             uuid.New(),
+		OrganizationName: orgName,
+		DisplayName:      req.DisplayName,
+		ExternalID:       optionalString(req.ExternalID),
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+
+	var resource *models.ScimGroupResource
+	err := db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := checkScimGroupName(tx, group); err != nil {
+			return err
+		}
+		if err := tx.Create(group).Error; err != nil {
+			if isUniqueViolation(err) {
+				return utils.ErrScimGroupAlreadyExists
+			}
+			return fmt.Errorf("failed to create group: %w", err)
+		}
+		if err := addScimGroupMembers(tx, group, req.Members); err != nil {
+			return err
+		}
+		var err error
+		resource, err = groupResource(tx, group)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("SCIM group provisioned", "orgName", orgName, "groupID", group.UUID, "members", len(req.Members))
+	return resource, nil
+}
+
+func (s *scimService) ReplaceGroup(ctx context.Context, orgName string, groupID string, req *models.ScimGroupResource) (*models.ScimGroupResource, error) {
+	if strings.TrimSpace(req.DisplayName) == "" {
+		return nil, fmt.Errorf("%w: displayName is required", utils.ErrInvalidInput)
+	}
+	return s.updateGroup(ctx, orgName, groupID, func(tx *gorm.DB, group *models.ScimGroup) error {
+		group.DisplayName = req.DisplayName
+		group.ExternalID = optionalString(req.ExternalID)
+		if err := removeScimGroupMembers(tx, group, nil); err != nil {
+			return err
+		}
+		return addScimGroupMembers(tx, group, req.Members)
+	})
+}
+
+func (s *scimService) PatchGroup(ctx context.Context, orgName string, groupID string, patch *models.ScimPatchRequest) (*models.ScimGroupResource, error) {
+	return s.updateGroup(ctx, orgName, groupID, func(tx *gorm.DB, group *models.ScimGroup) error {
+		for _, op := range patch.Operations {
+			if err := applyScimGroupPatch(tx, group, op); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *scimService) DeleteGroup(ctx context.Context, orgName string, groupID string) error {
+	group, err := getScimGroup(db.DB(ctx), orgName, groupID)
+	if err != nil {
+		return err
+	}
+	err = db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := removeScimGroupMembers(tx, group, nil); err != nil {
+			return err
+		}
+		if err := tx.Delete(group).Error; err != nil {
+			return fmt.Errorf("failed to delete group: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	s.logger.Info("SCIM group deprovisioned", "orgName", orgName, "groupID", group.UUID)
+	return nil
+}
+
+func (s *scimService) updateGroup(ctx context.Context, orgName string, groupID string, apply func(tx *gorm.DB, group *models.ScimGroup) error) (*models.ScimGroupResource, error) {
+	var resource *models.ScimGroupResource
+	err := db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		group, err := getScimGroup(tx, orgName, groupID)
+		if err != nil {
+			return err
+		}
+		if err := apply(tx, group); err != nil {
+			return err
+		}
+		if strings.TrimSpace(group.DisplayName) == "" {
+			return fmt.Errorf("%w: displayName is required", utils.ErrInvalidInput)
+		}
+		if err := checkScimGroupName(tx, group); err != nil {
+			return err
+		}
+		group.UpdatedAt = time.Now()
+		if err := tx.Save(group).Error; err != nil {
+			return fmt.Errorf("failed to update group: %w", err)
+		}
+		resource, err = groupResource(tx, group)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resource, nil
+}
+
+func applyScimGroupPatch(tx *gorm.DB, group *models.ScimGroup, op models.ScimPatchOperation) error {
+	opName := strings.ToLower(op.Op)
+	if opName != "add" && opName != "replace" && opName != "remove" {
+		return fmt.Errorf("%w: unsupported patch operation %q", utils.ErrInvalidInput, op.Op)
+	}
+
+	if match := scimMemberFilterPattern.FindStringSubmatch(op.Path); match != nil {
+		if opName != "remove" {
+			return fmt.Errorf("%w: filtered member paths are only supported for remove operations", utils.ErrInvalidInput)
+		}
+		return removeScimGroupMembers(tx, group, []models.ScimMemberRef{{Value: match[1]}})
+	}
+
+	if op.Path == "" {
+		if opName == "remove" {
+			return fmt.Errorf("%w: path is required for remove operations", utils.ErrInvalidInput)
+		}
+		attrs, ok := op.Value.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%w: value must be an object when path is not set", utils.ErrInvalidInput)
+		}
+		for path, value := range attrs {
+			if err := applyScimGroupPatch(tx, group, models.ScimPatchOperation{Op: op.Op, Path: path, Value: value}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	switch strings.ToLower(op.Path) {
+	case "displayname":
+		if opName == "remove" {
+			return fmt.Errorf("%w: displayName is required", utils.ErrInvalidInput)
+		}
+		displayName, err := scimString(op.Path, op.Value)
+		if err != nil {
+			return err
+		}
+		group.DisplayName = displayName
+	case "externalid":
+		externalID, err := scimString(op.Path, op.Value)
+		if err != nil {
+			return err
+		}
+		group.ExternalID = optionalString(externalID)
+	case "members":
+		members, err := scimMembers(op.Value)
+		if err != nil {
+			return err
+		}
+		switch opName {
+		case "add":
+			return addScimGroupMembers(tx, group, members)
+		case "remove":
+			// Without a value every member is removed
+			return removeScimGroupMembers(tx, group, members)
+		default:
+			if err := removeScimGroupMembers(tx, group, nil); err != nil {
+				return err
+			}
+			return addScimGroupMembers(tx, group, members)
+		}
+	default:
+		return fmt.Errorf("%w: unsupported attribute %q", utils.ErrInvalidInput, op.Path)
+	}
+	return nil
+}
+
+func getScimGroup(tx *gorm.DB, orgName string, groupID string) (*models.ScimGroup, error) {
+	id, err := uuid.Parse(groupID)
+	if err != nil {
+		return nil, utils.ErrScimGroupNotFound
+	}
+	var group models.ScimGroup
+	if err := tx.Where("uuid = ? AND organization_name = ?", id, orgName).First(&group).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, utils.ErrScimGroupNotFound
+		}
+		return nil, fmt.Errorf("failed to get group: %w", err)
+	}
+	return &group, nil
+}
+
+func checkScimGroupName(tx *gorm.DB, group *models.ScimGroup) error {
+	var count int64
+	if err := tx.Model(&models.ScimGroup{}).
+		Where("organization_name = ? AND display_name = ? AND uuid <> ?", group.OrganizationName, group.DisplayName, group.UUID).
+		Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to check existing group: %w", err)
+	}
+	if count > 0 {
+		return utils.ErrScimGroupAlreadyExists
+	}
+	return nil
+}
+
+// addScimGroupMembers adds users of the group's organization to the group. Existing members are kept.
+func addScimGroupMembers(tx *gorm.DB, group *models.ScimGroup, members []models.ScimMemberRef) error {
+	if len(members) == 0 {
+		return nil
+	}
+	userIDs, err := scimMemberIDs(members)
+	if err != nil {
+		return err
+	}
+	var count int64
+	if err := tx.Model(&models.ScimUser{}).
+		Where("organization_name = ? AND uuid IN ?", group.OrganizationName, userIDs).
+		Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to check group members: %w", err)
+	}
+	if count != int64(len(userIDs)) {
+		return fmt.Errorf("%w: members must reference users of the organization", utils.ErrInvalidInput)
+	}
+
+	now := time.Now()
+	rows := make([]models.ScimGroupMember, len(userIDs))
+	for i, userID := range userIDs {
+		rows[i] = models.ScimGroupMember{GroupUUID: group.UUID, UserUUID: userID, CreatedAt: now}
+	}
+	if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&rows).Error; err != nil {
+		return fmt.Errorf("failed to add group members: %w", err)
+	}
+	return nil
+}
+
+// removeScimGroupMembers removes the given members from the group, or every member when none are given
+func removeScimGroupMembers(tx *gorm.DB, group *models.ScimGroup, members []models.ScimMemberRef) error {
+	query := tx.Where("group_uuid = ?", group.UUID)
+	if len(members) > 0 {
+		userIDs, err := scimMemberIDs(members)
+		if err != nil {
+			return err
+		}
+		query = query.Where("user_uuid IN ?", userIDs)
+	}
+	if err := query.Delete(&models.ScimGroupMember{}).Error; err != nil {
+		return fmt.Errorf("failed to remove group members: %w", err)
+	}
+	return nil
+}
+
+func groupResource(tx *gorm.DB, group *models.ScimGroup) (*models.ScimGroupResource, error) {
+	members, err := loadScimGroupMembers(tx, []uuid.UUID{group.UUID})
+	if err != nil {
+		return nil, err
+	}
+	return toScimGroupResource(group, members[group.UUID]), nil
+}
+
+func toScimGroupResource(group *models.ScimGroup, members []models.ScimMemberRef) *models.ScimGroupResource {
+	resource := &models.ScimGroupResource{
+		Schemas:     []string{models.ScimSchemaGroup},
+		ID:          group.UUID.String(),
+		DisplayName: group.DisplayName,
+		Members:     members,
+		Meta: &models.ScimMeta{
+			ResourceType: "Group",
+			Created:      group.CreatedAt,
+			LastModified: group.UpdatedAt,
+		},
+	}
+	if group.ExternalID != nil {
+		resource.ExternalID = *group.ExternalID
+	}
+	return resource
+}
+
+func loadScimGroupMembers(tx *gorm.DB, groupIDs []uuid.UUID) (map[uuid.UUID][]models.ScimMemberRef, error) {
+	result := make(map[uuid.UUID][]models.ScimMemberRef)
+	if len(groupIDs) == 0 {
+		return result, nil
+	}
+	var rows []struct {
+		GroupUUID   uuid.UUID
+		UserUUID    uuid.UUID
+		UserName    string
+		DisplayName string
+	}
+	err := tx.Table("scim_group_members m").
+		Select("m.group_uuid, m.user_uuid, u.user_name, u.display_name").
+		Joins("JOIN scim_users u ON u.uuid = m.user_uuid").
+		Where("m.group_uuid IN ?", groupIDs).
+		Order("u.user_name").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to load group members: %w", err)
+	}
+	for _, row := range rows {
+		display := row.DisplayName
+		if display == "" {
+			display = row.UserName
+		}
+		result[row.GroupUUID] = append(result[row.GroupUUID], models.ScimMemberRef{
+			Value:   row.UserUUID.String(),
+			Display: display,
+		})
+	}
+	return result, nil
+}
+
+// -----------------------------------------------------------------------------
+// Helpers
+// -----------------------------------------------------------------------------
+
+// applyScimFilter supports the "attribute eq value" filters identity providers use to look up
+// resources. Attribute names are case-insensitive as required by RFC 7644.
+func applyScimFilter(tx *gorm.DB, filter string, columns map[string]string) (*gorm.DB, error) {
+	if strings.TrimSpace(filter) == "" {
+		return tx, nil
+	}
+	match := scimFilterPattern.FindStringSubmatch(filter)
+	if match == nil {
+		return nil, fmt.Errorf("%w: only 'attribute eq \"value\"' filters are supported", utils.ErrScimInvalidFilter)
+	}
+	condition, ok := columns[strings.ToLower(match[1])]
+	if !ok {
+		return nil, fmt.Errorf("%w: filtering on %q is not supported", utils.ErrScimInvalidFilter, match[1])
+	}
+	value, err := strconv.Unquote(`"` + match[2] + `"`)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", utils.ErrScimInvalidFilter, err.Error())
+	}
+	return tx.Where(condition, value), nil
+}
+
+func scimMemberIDs(members []models.ScimMemberRef) ([]uuid.UUID, error) {
+	seen := make(map[uuid.UUID]struct{}, len(members))
+	ids := make([]uuid.UUID, 0, len(members))
+	for _, member := range members {
+		id, err := uuid.Parse(member.Value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid member %q", utils.ErrInvalidInput, member.Value)
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func scimMembers(value any) ([]models.ScimMemberRef, error) {
+	if value == nil {
+		return nil, nil
+	}
+	items, ok := value.([]any)
+	if !ok {
+		items = []any{value}
+	}
+	members := make([]models.ScimMemberRef, 0, len(items))
+	for _, item := range items {
+		attrs, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%w: members must be objects with a value", utils.ErrInvalidInput)
+		}
+		id, _ := attrs["value"].(string)
+		members = append(members, models.ScimMemberRef{Value: id})
+	}
+	return members, nil
+}
+
+func scimEmails(value any) ([]models.ScimEmail, error) {
+	if value == nil {
+		return nil, nil
+	}
+	items, ok := value.([]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: emails must be a list", utils.ErrInvalidInput)
+	}
+	emails := make([]models.ScimEmail, 0, len(items))
+	for _, item := range items {
+		attrs, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%w: emails must be objects with a value", utils.ErrInvalidInput)
+		}
+		email := models.ScimEmail{}
+		email.Value, _ = attrs["value"].(string)
+		email.Type, _ = attrs["type"].(string)
+		email.Primary, _ = attrs["primary"].(bool)
+		emails = append(emails, email)
+	}
+	return emails, nil
+}
+
+func scimString(path string, value any) (string, error) {
+	if value == nil {
+		return "", nil
+	}
+	str, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("%w: %s must be a string", utils.ErrInvalidInput, path)
+	}
+	return str, nil
+}
+
+// scimBool accepts string booleans as well, since some identity providers send "False"
+func scimBool(value any) (bool, error) {
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case string:
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return false, fmt.Errorf("%w: active must be a boolean", utils.ErrInvalidInput)
+		}
+		return b, nil
+	default:
+		return false, fmt.Errorf("%w: active must be a boolean", utils.ErrInvalidInput)
+	}
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,213 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sort"
+	"strings"
+
+	apiplatformclient "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/apiplatformsvc/client"
+	occlient "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/openchoreosvc/client"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+)
+
+// SearchService searches the resources of an organization by name, display name and description
+type SearchService interface {
+	// Search returns up to limit resources of the given types matching query, most relevant first.
+	// All types are searched when types is empty.
+	Search(ctx context.Context, orgName string, query string, types []string, limit int) (*models.SearchResponse, error)
+}
+
+type searchService struct {
+	logger            *slog.Logger
+	ocClient          occlient.OpenChoreoClient
+	apiPlatformClient apiplatformclient.APIPlatformClient
+}
+
+// NewSearchService creates a new search service
+func NewSearchService(logger *slog.Logger, ocClient occlient.OpenChoreoClient, apiPlatformClient apiplatformclient.APIPlatformClient) SearchService {
+	return &searchService{
+		logger:            logger,
+		ocClient:          ocClient,
+		apiPlatformClient: apiPlatformClient,
+	}
+}
+
+// Relevance of a match, lower is more relevant
+const (
+	matchExactName = iota
+	matchNamePrefix
+	matchName
+	matchDescription
+	noMatch
+)
+
+type scoredResult struct {
+	models.SearchResult
+	score int
+}
+
+func (s *searchService) Search(ctx context.Context, orgName string, query string, types []string, limit int) (*models.SearchResponse, error) {
+	if len(types) == 0 {
+		types = models.SearchResultTypes
+	}
+	term := strings.ToLower(strings.TrimSpace(query))
+
+	var matches []scoredResult
+	add := func(result models.SearchResult, extra ...string) {
+		if score := matchScore(term, result, extra...); score != noMatch {
+			matches = append(matches, scoredResult{SearchResult: result, score: score})
+		}
+	}
+
+	// Projects and agents are kept in OpenChoreo and gateways in API Platform, so they are matched
+	// here rather than by the database
+	if slices.Contains(types, models.SearchResultTypeProject) || slices.Contains(types, models.SearchResultTypeAgent) {
+		projects, err := s.ocClient.ListProjects(ctx, orgName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list projects: %w", err)
+		}
+		for _, project := range projects {
+			if slices.Contains(types, models.SearchResultTypeProject) {
+				add(models.SearchResult{
+					Type:        models.SearchResultTypeProject,
+					ID:          project.UUID,
+					Name:        project.Name,
+					DisplayName: project.DisplayName,
+					Description: project.Description,
+				})
+			}
+			if !slices.Contains(types, models.SearchResultTypeAgent) {
+				continue
+			}
+			agents, err := s.ocClient.ListComponents(ctx, orgName, project.Name)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list agents of project %s: %w", project.Name, err)
+			}
+			for _, agent := range agents {
+				add(models.SearchResult{
+					Type:        models.SearchResultTypeAgent,
+					ID:          agent.UUID,
+					Name:        agent.Name,
+					DisplayName: agent.DisplayName,
+					Description: agent.Description,
+					ProjectName: project.Name,
+				})
+			}
+		}
+	}
+
+	if slices.Contains(types, models.SearchResultTypeEnvironment) {
+		var environments []models.Environment
+		if err := db.DB(ctx).Where("organization_name = ?", orgName).Find(&environments).Error; err != nil {
+			return nil, fmt.Errorf("failed to list environments: %w", err)
+		}
+		for _, env := range environments {
+			add(models.SearchResult{
+				Type:        models.SearchResultTypeEnvironment,
+				ID:          env.UUID.String(),
+				Name:        env.Name,
+				DisplayName: env.DisplayName,
+				Description: env.Description,
+			})
+		}
+	}
+
+	if slices.Contains(types, models.SearchResultTypeGateway) {
+		gateways, err := s.apiPlatformClient.ListGateways(ctx, apiplatformclient.GatewayFilters{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list gateways: %w", err)
+		}
+		serving, err := gatewaysServingOrganization(ctx, orgName)
+		if err != nil {
+			return nil, err
+		}
+		// The API Platform lists the gateways of every organization
+		for _, gw := range gateways.Gateways {
+			if !GatewayBelongsToOrganization(gw, orgName, serving[gw.ID]) {
+				continue
+			}
+			add(models.SearchResult{
+				Type:        models.SearchResultTypeGateway,
+				ID:          gw.ID,
+				Name:        gw.Name,
+				DisplayName: gw.DisplayName,
+				Description: gw.Description,
+			}, gw.Vhost)
+		}
+	}
+
+	if slices.Contains(types, models.SearchResultTypeMCPServer) {
+		var servers []models.MCPServer
+		if err := db.DB(ctx).Where("organization_name = ?", orgName).Find(&servers).Error; err != nil {
+			return nil, fmt.Errorf("failed to list MCP servers: %w", err)
+		}
+		for _, server := range servers {
+			add(models.SearchResult{
+				Type:        models.SearchResultTypeMCPServer,
+				ID:          server.UUID.String(),
+				Name:        server.Name,
+				DisplayName: server.DisplayName,
+				Description: server.Description,
+			}, server.URL)
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].score != matches[j].score {
+			return matches[i].score < matches[j].score
+		}
+		if ti, tj := slices.Index(models.SearchResultTypes, matches[i].Type), slices.Index(models.SearchResultTypes, matches[j].Type); ti != tj {
+			return ti < tj
+		}
+		return matches[i].Name < matches[j].Name
+	})
+
+	results := make([]models.SearchResult, 0, min(limit, len(matches)))
+	for _, match := range matches[:min(limit, len(matches))] {
+		results = append(results, match.SearchResult)
+	}
+	s.logger.Debug("Searched resources", "orgName", orgName, "query", query, "types", types, "matches", len(matches))
+	return &models.SearchResponse{Query: query, Results: results, Total: len(matches)}, nil
+}
+
+// matchScore rates how well a resource matches a lowercase search term. Matches on the name rank
+// above matches on the display name, description and the extra fields, such as a gateway vhost.
+func matchScore(term string, result models.SearchResult, extra ...string) int {
+	name := strings.ToLower(result.Name)
+	switch {
+	case name == term:
+		return matchExactName
+	case strings.HasPrefix(name, term):
+		return matchNamePrefix
+	case strings.Contains(name, term), strings.Contains(strings.ToLower(result.DisplayName), term):
+		return matchName
+	case strings.Contains(strings.ToLower(result.Description), term):
+		return matchDescription
+	}
+	for _, field := range extra {
+		if strings.Contains(strings.ToLower(field), term) {
+			return matchDescription
+		}
+	}
+	return noMatch
+}
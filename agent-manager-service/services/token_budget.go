@@ -0,0 +1,480 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	apiplatformclient "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/apiplatformsvc/client"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+const (
+	// defaultTokenBudgetSoftLimitPercent is the share of the limit an event is raised at when a budget does not set one
+	defaultTokenBudgetSoftLimitPercent = 80
+	// tokenBudgetPolicyName is the gateway policy limiting the tokens of an LLM proxy with a hard budget
+	tokenBudgetPolicyName    = "token-based-ratelimit"
+	tokenBudgetPolicyVersion = "v0.1.0"
+)
+
+func (s *observabilityManagerService) CreateAgentTokenBudget(ctx context.Context, orgName, projectName, agentName, createdBy string, req *models.AgentTokenBudgetRequest) (*models.AgentTokenBudgetResponse, error) {
+	if err := s.validateAgentTokenBudgetRequest(req); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	budget := &models.AgentTokenBudget{
+		UUID:             uuid.New(),
+		OrganizationName: orgName,
+		ProjectName:      projectName,
+		AgentName:        agentName,
+		Status:           models.TokenBudgetStatusWithinBudget,
+		CreatedBy:        createdBy,
+		CreatedAt:        now,
+	}
+	applyAgentTokenBudgetRequest(budget, req, now)
+	err := db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := checkTokenBudgetProxy(tx, budget); err != nil {
+			return err
+		}
+		if err := tx.Create(budget).Error; err != nil {
+			return err
+		}
+		if budget.Enforcement == models.TokenBudgetEnforcementHard {
+			return s.syncTokenBudgetPolicy(ctx, tx, budget.LLMProxyID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, tokenBudgetSaveError(err, "failed to save token budget")
+	}
+	s.logger.Info("Created token budget", "agentName", agentName, "budgetId", budget.UUID, "period", budget.Period,
+		"tokenLimit", budget.TokenLimit, "enforcement", budget.Enforcement)
+	return budget.ToResponse(), nil
+}
+
+func (s *observabilityManagerService) ListAgentTokenBudgets(ctx context.Context, orgName, projectName, agentName string) (*models.AgentTokenBudgetListResponse, error) {
+	var budgets []models.AgentTokenBudget
+	if err := db.DB(ctx).
+		Where("organization_name = ? AND project_name = ? AND agent_name = ?", orgName, projectName, agentName).
+		Order("environment_name, period").
+		Find(&budgets).Error; err != nil {
+		return nil, fmt.Errorf("failed to list token budgets: %w", err)
+	}
+	response := &models.AgentTokenBudgetListResponse{Budgets: make([]models.AgentTokenBudgetResponse, 0, len(budgets))}
+	for i := range budgets {
+		response.Budgets = append(response.Budgets, *budgets[i].ToResponse())
+	}
+	return response, nil
+}
+
+// GetAgentTokenBudget returns a token budget with the usage of the current period read back from the traces
+func (s *observabilityManagerService) GetAgentTokenBudget(ctx context.Context, orgName, projectName, agentName, budgetID string) (*models.AgentTokenBudgetResponse, error) {
+	budget, err := getAgentTokenBudget(db.DB(ctx), orgName, projectName, agentName, budgetID)
+	if err != nil {
+		return nil, err
+	}
+	s.checkTokenBudget(ctx, budget)
+	return budget.ToResponse(), nil
+}
+
+func (s *observabilityManagerService) UpdateAgentTokenBudget(ctx context.Context, orgName, projectName, agentName, budgetID string, req *models.AgentTokenBudgetRequest) (*models.AgentTokenBudgetResponse, error) {
+	if err := s.validateAgentTokenBudgetRequest(req); err != nil {
+		return nil, err
+	}
+	var budget *models.AgentTokenBudget
+	err := db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		budget, err = getAgentTokenBudget(tx, orgName, projectName, agentName, budgetID)
+		if err != nil {
+			return err
+		}
+		// The gateway limit only changes for proxies a hard budget was or is on
+		var proxyIDs []string
+		if budget.Enforcement == models.TokenBudgetEnforcementHard {
+			proxyIDs = append(proxyIDs, budget.LLMProxyID)
+		}
+		if budget.Period != req.Period {
+			// Usage of the previous period does not count towards the new one
+			budget.Status = models.TokenBudgetStatusWithinBudget
+			budget.PeriodStart = nil
+			budget.UsedTokens = 0
+		}
+		applyAgentTokenBudgetRequest(budget, req, time.Now())
+		if budget.Enforcement == models.TokenBudgetEnforcementHard && (len(proxyIDs) == 0 || proxyIDs[0] != budget.LLMProxyID) {
+			proxyIDs = append(proxyIDs, budget.LLMProxyID)
+		}
+		if err := checkTokenBudgetProxy(tx, budget); err != nil {
+			return err
+		}
+		if err := tx.Save(budget).Error; err != nil {
+			return err
+		}
+		for _, proxyID := range proxyIDs {
+			if err := s.syncTokenBudgetPolicy(ctx, tx, proxyID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, tokenBudgetSaveError(err, "failed to update token budget")
+	}
+	return budget.ToResponse(), nil
+}
+
+func (s *observabilityManagerService) DeleteAgentTokenBudget(ctx context.Context, orgName, projectName, agentName, budgetID string) error {
+	err := db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		budget, err := getAgentTokenBudget(tx, orgName, projectName, agentName, budgetID)
+		if err != nil {
+			return err
+		}
+		if err := tx.Where("budget_uuid = ?", budget.UUID).Delete(&models.AgentTokenBudgetEvent{}).Error; err != nil {
+			return fmt.Errorf("failed to delete token budget events: %w", err)
+		}
+		if err := tx.Delete(budget).Error; err != nil {
+			return fmt.Errorf("failed to delete token budget: %w", err)
+		}
+		if budget.Enforcement == models.TokenBudgetEnforcementHard {
+			return s.syncTokenBudgetPolicy(ctx, tx, budget.LLMProxyID)
+		}
+		return nil
+	})
+	if err != nil {
+		return tokenBudgetSaveError(err, "failed to delete token budget")
+	}
+	return nil
+}
+
+func (s *observabilityManagerService) ListAgentTokenBudgetEvents(ctx context.Context, orgName, projectName, agentName, budgetID string) (*models.AgentTokenBudgetEventListResponse, error) {
+	budget, err := getAgentTokenBudget(db.DB(ctx), orgName, projectName, agentName, budgetID)
+	if err != nil {
+		return nil, err
+	}
+	var events []models.AgentTokenBudgetEvent
+	if err := db.DB(ctx).Where("budget_uuid = ?", budget.UUID).Order("created_at DESC").Find(&events).Error; err != nil {
+		return nil, fmt.Errorf("failed to list token budget events: %w", err)
+	}
+	response := &models.AgentTokenBudgetEventListResponse{Events: make([]models.AgentTokenBudgetEventResponse, 0, len(events))}
+	for i := range events {
+		response.Events = append(response.Events, *events[i].ToResponse())
+	}
+	return response, nil
+}
+
+func (s *observabilityManagerService) RunTokenBudgetChecker(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.checkTokenBudgets(ctx)
+		}
+	}
+}
+
+// checkTokenBudgets reads back the usage of every token budget
+func (s *observabilityManagerService) checkTokenBudgets(ctx context.Context) {
+	var budgets []models.AgentTokenBudget
+	if err := db.DB(ctx).Order("created_at").Find(&budgets).Error; err != nil {
+		s.logger.Error("Failed to load token budgets", "error", err)
+		return
+	}
+	for i := range budgets {
+		if ctx.Err() != nil {
+			return
+		}
+		s.checkTokenBudget(ctx, &budgets[i])
+	}
+}
+
+// checkTokenBudget updates the usage of a budget in its current period and records an event when the
+// agent reaches the soft limit or exceeds the budget. Failing to read the usage keeps the recorded usage
+// and saves the error instead.
+func (s *observabilityManagerService) checkTokenBudget(ctx context.Context, budget *models.AgentTokenBudget) {
+	now := time.Now().UTC()
+	periodStart := tokenBudgetPeriodStart(budget.Period, now)
+	if budget.PeriodStart == nil || !budget.PeriodStart.Equal(periodStart) {
+		budget.Status = models.TokenBudgetStatusWithinBudget
+		budget.PeriodStart = &periodStart
+		budget.UsedTokens = 0
+	}
+	budget.LastCheckedAt = &now
+	budget.LastCheckError = ""
+
+	var events []models.AgentTokenBudgetEvent
+	usedTokens, err := s.tokenBudgetUsage(ctx, budget, periodStart, now)
+	if err != nil {
+		s.logger.Warn("Failed to read token usage of budget", "budgetId", budget.UUID, "agentName", budget.AgentName, "error", err)
+		budget.LastCheckError = err.Error()
+	} else {
+		status := tokenBudgetStatus(budget, usedTokens)
+		if status != budget.Status {
+			events = tokenBudgetEvents(budget, status, usedTokens, periodStart, now)
+		}
+		budget.Status = status
+		budget.UsedTokens = usedTokens
+	}
+	for _, event := range events {
+		s.logger.Warn("Agent reached token budget", "agentName", budget.AgentName, "environment", budget.EnvironmentName,
+			"budgetId", budget.UUID, "event", event.Type, "usedTokens", event.UsedTokens, "tokenLimit", event.TokenLimit)
+	}
+
+	err = db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(budget).UpdateColumns(map[string]interface{}{
+			"status":           budget.Status,
+			"period_start":     budget.PeriodStart,
+			"used_tokens":      budget.UsedTokens,
+			"last_checked_at":  budget.LastCheckedAt,
+			"last_check_error": budget.LastCheckError,
+		}).Error; err != nil {
+			return err
+		}
+		if len(events) > 0 {
+			return tx.Create(&events).Error
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("Failed to save token budget usage", "budgetId", budget.UUID, "error", err)
+		return
+	}
+	for _, event := range events {
+		notifyByEmail(ctx, s.logger, budget.OrganizationName, models.EmailNotificationBudgetAlerts, emailTemplateBudgetAlert, budgetAlertEmail{
+			OrganizationName: budget.OrganizationName,
+			ProjectName:      budget.ProjectName,
+			AgentName:        budget.AgentName,
+			Environment:      budget.EnvironmentName,
+			Period:           budget.Period,
+			Type:             event.Type,
+			UsedTokens:       event.UsedTokens,
+			TokenLimit:       event.TokenLimit,
+			Enforced:         event.Enforced,
+		})
+	}
+}
+
+// tokenBudgetUsage sums the tokens of the LLM calls the agent made in the budget's environment since the period started
+func (s *observabilityManagerService) tokenBudgetUsage(ctx context.Context, budget *models.AgentTokenBudget, periodStart, now time.Time) (int64, error) {
+	component, err := s.ocClient.GetComponent(ctx, budget.OrganizationName, budget.ProjectName, budget.AgentName)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get agent component: %w", err)
+	}
+	environment, err := s.ocClient.GetEnvironment(ctx, budget.OrganizationName, budget.EnvironmentName)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get environment: %w", err)
+	}
+	usage, err := s.traceObserverClient.GetModelUsage(ctx, traceobserversvc.ModelUsageParams{
+		ComponentUids:  []string{component.UUID},
+		EnvironmentUid: environment.UUID,
+		StartTime:      periodStart.Format(time.RFC3339),
+		EndTime:        now.Format(time.RFC3339),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get model usage: %w", err)
+	}
+	if usage.Truncated {
+		s.logger.Warn("Model usage of token budget is truncated; usage may be undercounted", "budgetId", budget.UUID, "spanCount", usage.SpanCount)
+	}
+	var usedTokens int64
+	for _, model := range usage.Models {
+		usedTokens += int64(model.TotalTokens)
+	}
+	return usedTokens, nil
+}
+
+// syncTokenBudgetPolicy sets the token limit policy of an LLM proxy to the hard budgets on it, or removes
+// the policy once no hard budget is left. It runs in the transaction saving the budget so a failure to
+// update the gateway leaves the budget unchanged.
+func (s *observabilityManagerService) syncTokenBudgetPolicy(ctx context.Context, tx *gorm.DB, proxyID string) error {
+	if s.apiPlatformClient == nil {
+		return fmt.Errorf("%w: hard enforcement requires API Platform to be configured", utils.ErrInvalidInput)
+	}
+	var budgets []models.AgentTokenBudget
+	if err := tx.Where("llm_proxy_id = ? AND enforcement = ?", proxyID, models.TokenBudgetEnforcementHard).
+		Order("period").
+		Find(&budgets).Error; err != nil {
+		return fmt.Errorf("failed to load token budgets of LLM proxy: %w", err)
+	}
+	if len(budgets) == 0 {
+		if err := s.apiPlatformClient.RemoveLLMProxyPolicy(ctx, proxyID, tokenBudgetPolicyName); err != nil {
+			return fmt.Errorf("failed to remove token limit of LLM proxy: %w", err)
+		}
+		return nil
+	}
+
+	limits := make([]interface{}, 0, len(budgets))
+	for _, budget := range budgets {
+		limits = append(limits, map[string]interface{}{
+			"count":    budget.TokenLimit,
+			"duration": tokenBudgetPolicyDuration(budget.Period),
+		})
+	}
+	policy := apiplatformclient.LLMProxyPolicy{
+		Name:    tokenBudgetPolicyName,
+		Version: tokenBudgetPolicyVersion,
+		Params:  map[string]interface{}{"totalTokenLimits": limits},
+	}
+	if err := s.apiPlatformClient.SetLLMProxyPolicy(ctx, proxyID, policy); err != nil {
+		return fmt.Errorf("failed to set token limit of LLM proxy: %w", err)
+	}
+	return nil
+}
+
+// validateAgentTokenBudgetRequest checks the rules of hard enforcement; the fields themselves
+// are validated by the controller
+func (s *observabilityManagerService) validateAgentTokenBudgetRequest(req *models.AgentTokenBudgetRequest) error {
+	if req.Enforcement != models.TokenBudgetEnforcementHard {
+		return nil
+	}
+	if req.LLMProxyID == "" {
+		return fmt.Errorf("%w: llmProxyId is required for hard enforcement", utils.ErrInvalidInput)
+	}
+	if s.apiPlatformClient == nil {
+		return fmt.Errorf("%w: hard enforcement requires API Platform to be configured", utils.ErrInvalidInput)
+	}
+	return nil
+}
+
+func applyAgentTokenBudgetRequest(budget *models.AgentTokenBudget, req *models.AgentTokenBudgetRequest, now time.Time) {
+	budget.EnvironmentName = req.Environment
+	budget.Period = req.Period
+	budget.TokenLimit = req.TokenLimit
+	budget.SoftLimitPercent = req.SoftLimitPercent
+	if budget.SoftLimitPercent == 0 {
+		budget.SoftLimitPercent = defaultTokenBudgetSoftLimitPercent
+	}
+	budget.Enforcement = req.Enforcement
+	if budget.Enforcement == "" {
+		budget.Enforcement = models.TokenBudgetEnforcementSoft
+	}
+	// Soft budgets are not enforced on a proxy, but keep it for reference
+	budget.LLMProxyID = req.LLMProxyID
+	budget.UpdatedAt = now
+}
+
+// checkTokenBudgetProxy rejects a hard budget on an LLM proxy that another agent's hard budget limits,
+// as the gateway limit of the proxy would count the tokens of both agents
+func checkTokenBudgetProxy(tx *gorm.DB, budget *models.AgentTokenBudget) error {
+	if budget.Enforcement != models.TokenBudgetEnforcementHard {
+		return nil
+	}
+	var count int64
+	if err := tx.Model(&models.AgentTokenBudget{}).
+		Where("llm_proxy_id = ? AND enforcement = ? AND NOT (organization_name = ? AND project_name = ? AND agent_name = ?)",
+			budget.LLMProxyID, models.TokenBudgetEnforcementHard, budget.OrganizationName, budget.ProjectName, budget.AgentName).
+		Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to check token budgets of LLM proxy: %w", err)
+	}
+	if count > 0 {
+		return utils.ErrTokenBudgetProxyInUse
+	}
+	return nil
+}
+
+// tokenBudgetSaveError maps the error of a transaction saving a budget to the error returned to the caller
+func tokenBudgetSaveError(err error, message string) error {
+	if isUniqueViolation(err) {
+		return utils.ErrTokenBudgetAlreadyExists
+	}
+	if errors.Is(err, utils.ErrTokenBudgetNotFound) || errors.Is(err, utils.ErrTokenBudgetProxyInUse) ||
+		errors.Is(err, utils.ErrInvalidInput) || errors.Is(err, utils.ErrLLMProxyNotFound) {
+		return err
+	}
+	return fmt.Errorf("%s: %w", message, err)
+}
+
+// tokenBudgetStatus returns the status of a budget at the given usage
+func tokenBudgetStatus(budget *models.AgentTokenBudget, usedTokens int64) string {
+	switch {
+	case usedTokens >= budget.TokenLimit:
+		return models.TokenBudgetStatusExceeded
+	case usedTokens*100 >= budget.TokenLimit*int64(budget.SoftLimitPercent):
+		return models.TokenBudgetStatusSoftLimitReached
+	default:
+		return models.TokenBudgetStatusWithinBudget
+	}
+}
+
+// tokenBudgetEvents returns the events of a budget moving to the given status. A budget exceeded
+// without passing the soft limit first records both.
+func tokenBudgetEvents(budget *models.AgentTokenBudget, status string, usedTokens int64, periodStart, now time.Time) []models.AgentTokenBudgetEvent {
+	newEvent := func(eventType string, enforced bool) models.AgentTokenBudgetEvent {
+		return models.AgentTokenBudgetEvent{
+			UUID:        uuid.New(),
+			BudgetUUID:  budget.UUID,
+			Type:        eventType,
+			PeriodStart: periodStart,
+			UsedTokens:  usedTokens,
+			TokenLimit:  budget.TokenLimit,
+			Enforced:    enforced,
+			CreatedAt:   now,
+		}
+	}
+	var events []models.AgentTokenBudgetEvent
+	if budget.Status == models.TokenBudgetStatusWithinBudget && status != models.TokenBudgetStatusWithinBudget && budget.SoftLimitPercent < 100 {
+		events = append(events, newEvent(models.TokenBudgetEventSoftLimitReached, false))
+	}
+	if status == models.TokenBudgetStatusExceeded {
+		events = append(events, newEvent(models.TokenBudgetEventLimitExceeded, budget.Enforcement == models.TokenBudgetEnforcementHard))
+	}
+	return events
+}
+
+// tokenBudgetPeriodStart returns the start of the UTC day or month containing now
+func tokenBudgetPeriodStart(period string, now time.Time) time.Time {
+	if period == models.TokenBudgetPeriodMonthly {
+		return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// tokenBudgetPolicyDuration returns the window of the gateway limit of a period. The gateway counts tokens
+// over a window of the period's length rather than the calendar day or month, so the checker's events
+// remain the record of the budget.
+func tokenBudgetPolicyDuration(period string) string {
+	if period == models.TokenBudgetPeriodMonthly {
+		return "720h"
+	}
+	return "24h"
+}
+
+func getAgentTokenBudget(tx *gorm.DB, orgName, projectName, agentName, budgetID string) (*models.AgentTokenBudget, error) {
+	id, err := uuid.Parse(budgetID)
+	if err != nil {
+		return nil, utils.ErrTokenBudgetNotFound
+	}
+	var budget models.AgentTokenBudget
+	if err := tx.Where("uuid = ? AND organization_name = ? AND project_name = ? AND agent_name = ?", id, orgName, projectName, agentName).
+		First(&budget).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, utils.ErrTokenBudgetNotFound
+		}
+		return nil, fmt.Errorf("failed to get token budget: %w", err)
+	}
+	return &budget, nil
+}
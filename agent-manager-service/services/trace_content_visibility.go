@@ -0,0 +1,89 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+)
+
+func (s *observabilityManagerService) GetTraceContentVisibility(ctx context.Context, orgName string) (*models.TraceContentVisibilityResponse, error) {
+	setting, err := getTraceContentSetting(db.DB(ctx), orgName)
+	if err != nil {
+		return nil, err
+	}
+	if setting == nil {
+		return &models.TraceContentVisibilityResponse{ContentVisibility: models.TraceContentVisibilityFull}, nil
+	}
+	return setting.ToResponse(), nil
+}
+
+func (s *observabilityManagerService) SetTraceContentVisibility(ctx context.Context, orgName, updatedBy string, req *models.TraceContentVisibilityRequest) (*models.TraceContentVisibilityResponse, error) {
+	var setting *models.TraceContentSetting
+	err := db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		setting, err = getTraceContentSetting(tx, orgName)
+		if err != nil {
+			return err
+		}
+		if setting == nil {
+			setting = &models.TraceContentSetting{OrganizationName: orgName, CreatedAt: time.Now()}
+		}
+		setting.ContentVisibility = req.ContentVisibility
+		setting.UpdatedBy = updatedBy
+		setting.UpdatedAt = time.Now()
+		return tx.Save(setting).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save trace content visibility: %w", err)
+	}
+	s.logger.Info("Set trace content visibility", "orgName", orgName, "contentVisibility", req.ContentVisibility, "updatedBy", updatedBy)
+	return setting.ToResponse(), nil
+}
+
+// traceContentVisibility returns the content visibility traces of an organization are read with.
+// Failing to read it fails the trace request rather than returning content the organization may
+// not allow.
+func (s *observabilityManagerService) traceContentVisibility(ctx context.Context, orgName string) (string, error) {
+	setting, err := getTraceContentSetting(db.DB(ctx), orgName)
+	if err != nil {
+		return "", err
+	}
+	if setting == nil {
+		return models.TraceContentVisibilityFull, nil
+	}
+	return setting.ContentVisibility, nil
+}
+
+// getTraceContentSetting returns the content setting of an organization, or nil if it has none
+func getTraceContentSetting(tx *gorm.DB, orgName string) (*models.TraceContentSetting, error) {
+	var setting models.TraceContentSetting
+	if err := tx.Where("organization_name = ?", orgName).First(&setting).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get trace content visibility: %w", err)
+	}
+	return &setting, nil
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// defaultErasureAttribute is the OpenTelemetry attribute identifying the end user of a request
+const defaultErasureAttribute = "enduser.id"
+
+// erasureAttributePattern matches the span attribute names the trace observer accepts
+var erasureAttributePattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+func (s *observabilityManagerService) CreateTraceErasure(ctx context.Context, orgName string, requestedBy string, req *models.CreateTraceErasureRequest) (*models.TraceErasureResponse, error) {
+	attribute := strings.TrimSpace(req.Attribute)
+	if attribute == "" {
+		attribute = defaultErasureAttribute
+	}
+	if !erasureAttributePattern.MatchString(attribute) {
+		return nil, fmt.Errorf("%w: invalid attribute name", utils.ErrInvalidInput)
+	}
+	if strings.TrimSpace(req.Value) == "" {
+		return nil, fmt.Errorf("%w: value is required", utils.ErrInvalidInput)
+	}
+
+	agents, err := s.listOrgAgents(ctx, orgName)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256([]byte(req.Value))
+	erasure := &models.TraceErasureRequest{
+		UUID:             uuid.New(),
+		OrganizationName: orgName,
+		Attribute:        attribute,
+		ValueHash:        hex.EncodeToString(hash[:]),
+		RequestedBy:      requestedBy,
+		Status:           models.TraceErasureStatusRunning,
+		CreatedAt:        time.Now(),
+	}
+
+	if len(agents) == 0 {
+		// Nothing of the organization can carry the identifier
+		erasure.Status = models.TraceErasureStatusCompleted
+		erasure.CompletedAt = &erasure.CreatedAt
+	} else {
+		params := traceobserversvc.EraseSpansParams{Attribute: attribute, Value: req.Value}
+		for _, agent := range agents {
+			params.ComponentUids = append(params.ComponentUids, agent.ComponentUid)
+		}
+		response, err := s.traceObserverClient.EraseSpans(ctx, params)
+		if err != nil {
+			s.logger.Error("Failed to erase spans", "orgName", orgName, "attribute", attribute, "error", err)
+			return nil, fmt.Errorf("failed to erase spans: %w", err)
+		}
+		erasure.TaskID = response.TaskID
+	}
+
+	if err := db.DB(ctx).Create(erasure).Error; err != nil {
+		return nil, fmt.Errorf("failed to save trace erasure: %w", err)
+	}
+	s.logger.Info("Started trace erasure", "orgName", orgName, "erasureId", erasure.UUID, "attribute", attribute, "requestedBy", requestedBy, "taskId", erasure.TaskID)
+	return erasure.ToResponse(), nil
+}
+
+func (s *observabilityManagerService) GetTraceErasure(ctx context.Context, orgName string, erasureID string) (*models.TraceErasureResponse, error) {
+	id, err := uuid.Parse(erasureID)
+	if err != nil {
+		return nil, utils.ErrTraceErasureNotFound
+	}
+	var erasure models.TraceErasureRequest
+	if err := db.DB(ctx).Where("uuid = ? AND organization_name = ?", id, orgName).First(&erasure).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, utils.ErrTraceErasureNotFound
+		}
+		return nil, fmt.Errorf("failed to get trace erasure: %w", err)
+	}
+	if erasure.Status == models.TraceErasureStatusRunning {
+		s.refreshTraceErasure(ctx, &erasure)
+	}
+	return erasure.ToResponse(), nil
+}
+
+func (s *observabilityManagerService) ListTraceErasures(ctx context.Context, orgName string) (*models.TraceErasureListResponse, error) {
+	var erasures []models.TraceErasureRequest
+	if err := db.DB(ctx).Where("organization_name = ?", orgName).Order("created_at DESC").Find(&erasures).Error; err != nil {
+		return nil, fmt.Errorf("failed to list trace erasures: %w", err)
+	}
+	response := &models.TraceErasureListResponse{Erasures: make([]models.TraceErasureResponse, 0, len(erasures))}
+	for i := range erasures {
+		response.Erasures = append(response.Erasures, *erasures[i].ToResponse())
+	}
+	return response, nil
+}
+
+// refreshTraceErasure updates the progress of a running erasure from its deletion task. Failing to
+// reach the trace observer leaves the recorded progress as is.
+func (s *observabilityManagerService) refreshTraceErasure(ctx context.Context, erasure *models.TraceErasureRequest) {
+	task, err := s.traceObserverClient.GetTask(ctx, erasure.TaskID)
+	if err != nil && !traceobserversvc.IsNotFound(err) {
+		s.logger.Warn("Failed to get trace erasure progress", "erasureId", erasure.UUID, "taskId", erasure.TaskID, "error", err)
+		return
+	}
+
+	now := time.Now()
+	switch {
+	case err != nil:
+		// The trace store no longer knows the task, so its outcome cannot be confirmed
+		erasure.Status = models.TraceErasureStatusFailed
+		erasure.Error = "deletion task not found"
+		erasure.CompletedAt = &now
+	case task.Completed && (task.Error != "" || task.Failures > 0):
+		erasure.Status = models.TraceErasureStatusFailed
+		erasure.Error = task.Error
+		if erasure.Error == "" {
+			erasure.Error = fmt.Sprintf("%d spans could not be deleted", task.Failures)
+		}
+		erasure.CompletedAt = &now
+	case task.Completed:
+		erasure.Status = models.TraceErasureStatusCompleted
+		erasure.CompletedAt = &now
+	}
+	if task != nil {
+		erasure.TotalSpans = task.Total
+		erasure.DeletedSpans = task.Deleted
+	}
+
+	if err := db.DB(ctx).Model(erasure).UpdateColumns(map[string]interface{}{
+		"status":        erasure.Status,
+		"total_spans":   erasure.TotalSpans,
+		"deleted_spans": erasure.DeletedSpans,
+		"error":         erasure.Error,
+		"completed_at":  erasure.CompletedAt,
+	}).Error; err != nil {
+		s.logger.Error("Failed to save trace erasure progress", "erasureId", erasure.UUID, "error", err)
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+)
+
+// ErrTraceLogsNotConfigured is returned when the trace observer service has no log backend to
+// read application logs from
+var ErrTraceLogsNotConfigured = errors.New("log correlation is not configured")
+
+type TraceLogsRequest struct {
+	TraceID     string
+	SpanID      string
+	OrgName     string
+	ProjectName string
+	AgentName   string
+	Environment string
+	Limit       int
+}
+
+// GetTraceLogs retrieves the application logs that carry the trace context of a trace of an agent
+func (s *observabilityManagerService) GetTraceLogs(ctx context.Context, req TraceLogsRequest) (*models.TraceLogsResponse, error) {
+	s.logger.Info("Getting trace logs", "traceId", req.TraceID, "spanId", req.SpanID, "agentName", req.AgentName)
+
+	// Fetch component to get UID
+	component, err := s.ocClient.GetComponent(ctx, req.OrgName, req.ProjectName, req.AgentName)
+	if err != nil {
+		s.logger.Error("Failed to get agent component", "agentName", req.AgentName, "error", err)
+		return nil, fmt.Errorf("failed to get agent component: %w", err)
+	}
+
+	environment, err := s.ocClient.GetEnvironment(ctx, req.OrgName, req.Environment)
+	if err != nil {
+		s.logger.Error("Failed to get environment", "environment", req.Environment, "error", err)
+		return nil, fmt.Errorf("failed to get environment: %w", err)
+	}
+
+	clientResponse, err := s.traceObserverClient.GetTraceLogs(ctx, traceobserversvc.TraceLogsParams{
+		TraceID:        req.TraceID,
+		SpanID:         req.SpanID,
+		ComponentUid:   component.UUID,
+		EnvironmentUid: environment.UUID,
+		Limit:          req.Limit,
+	})
+	if err != nil {
+		switch {
+		case traceobserversvc.IsNotFound(err):
+			s.logger.Warn("Trace not found", "traceId", req.TraceID, "agentName", req.AgentName)
+			return nil, ErrTraceNotFound
+		case traceobserversvc.IsNotImplemented(err):
+			return nil, ErrTraceLogsNotConfigured
+		}
+		s.logger.Error("Failed to get trace logs", "traceId", req.TraceID, "agentName", req.AgentName, "error", err)
+		return nil, fmt.Errorf("failed to get trace logs: %w", err)
+	}
+
+	records := make([]models.LogRecord, len(clientResponse.Logs))
+	for i, record := range clientResponse.Logs {
+		records[i] = models.LogRecord{
+			Timestamp: record.Timestamp,
+			Message:   record.Message,
+			TraceID:   record.TraceID,
+			SpanID:    record.SpanID,
+			Fields:    record.Fields,
+		}
+	}
+
+	return &models.TraceLogsResponse{
+		TraceID:    clientResponse.TraceID,
+		SpanID:     clientResponse.SpanID,
+		Logs:       records,
+		TotalCount: clientResponse.TotalCount,
+		Truncated:  clientResponse.Truncated,
+	}, nil
+}
@@ -0,0 +1,270 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+const (
+	// traceReplayTimeout bounds the time an agent has to respond to a replayed request
+	traceReplayTimeout = 2 * time.Minute
+	// maxTraceReplayResponseBytes bounds the agent response returned with a replay
+	maxTraceReplayResponseBytes = 1 << 20
+	// traceReplayBaggageKey carries the original trace ID to the agent as W3C baggage
+	traceReplayBaggageKey = "amp.replay.original_trace_id"
+)
+
+// traceReplayClient sends replayed requests to agents. It is not instrumented, so that the
+// traceparent of the replay is not replaced by the trace of this service.
+var traceReplayClient = &http.Client{Timeout: traceReplayTimeout}
+
+type TraceReplayRequest struct {
+	TraceID     string
+	OrgName     string
+	ProjectName string
+	AgentName   string
+	Environment string
+	RequestedBy string
+	Endpoint    string
+	Path        string
+}
+
+// ReplayTrace sends the root input of a trace to the agent's endpoint again. The request starts a
+// new trace, whose ID is recorded with the original trace ID so the two can be compared. A replay
+// the agent fails or does not respond to is recorded with its error.
+func (s *observabilityManagerService) ReplayTrace(ctx context.Context, req TraceReplayRequest) (*models.TraceReplayResponse, error) {
+	if err := validateReplayPath(req.Path); err != nil {
+		return nil, err
+	}
+
+	trace, err := s.GetTraceDetails(ctx, TraceDetailsRequest{
+		TraceID:     req.TraceID,
+		OrgName:     req.OrgName,
+		ProjectName: req.ProjectName,
+		AgentName:   req.AgentName,
+		Environment: req.Environment,
+		RawContent:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if trace.Input == nil {
+		return nil, utils.ErrTraceReplayNoInput
+	}
+
+	replay, err := s.replayInput(ctx, req, trace.Input)
+	if err != nil {
+		return nil, err
+	}
+	result := replay.record.ToResponse()
+	if replay.response != nil {
+		result.Response = replay.response.body
+	}
+	return result, nil
+}
+
+// traceReplay is a replayed request and the agent's response to it
+type traceReplay struct {
+	record *models.TraceReplay
+	// parentSpanID is the span ID sent as the parent of the agent's spans
+	parentSpanID string
+	response     *traceReplayResponse
+}
+
+// replayInput sends an input to the agent's endpoint as the start of a new trace and records the
+// replay against req.TraceID
+func (s *observabilityManagerService) replayInput(ctx context.Context, req TraceReplayRequest, input interface{}) (*traceReplay, error) {
+	body, err := traceReplayBody(input)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints, err := s.ocClient.GetComponentEndpoints(ctx, req.OrgName, req.ProjectName, req.AgentName, req.Environment)
+	if err != nil {
+		s.logger.Error("Failed to get agent endpoints", "agentName", req.AgentName, "environment", req.Environment, "error", err)
+		return nil, fmt.Errorf("failed to get agent endpoints: %w", err)
+	}
+	endpointURL, err := selectReplayEndpoint(endpoints, req.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	endpointURL = strings.TrimSuffix(endpointURL, "/") + req.Path
+
+	traceID, spanID, err := newTraceContext()
+	if err != nil {
+		return nil, err
+	}
+	replay := &traceReplay{
+		record: &models.TraceReplay{
+			UUID:             uuid.New(),
+			OrganizationName: req.OrgName,
+			ProjectName:      req.ProjectName,
+			AgentName:        req.AgentName,
+			EnvironmentName:  req.Environment,
+			OriginalTraceID:  req.TraceID,
+			ReplayTraceID:    traceID,
+			EndpointURL:      endpointURL,
+			RequestedBy:      req.RequestedBy,
+			CreatedAt:        time.Now(),
+		},
+		parentSpanID: spanID,
+	}
+
+	replay.response, err = s.sendTraceReplay(ctx, endpointURL, body, req.TraceID, traceID, spanID)
+	if err != nil {
+		s.logger.Warn("Trace replay failed", "traceId", req.TraceID, "agentName", req.AgentName, "error", err)
+		replay.record.Error = err.Error()
+	} else {
+		replay.record.StatusCode = replay.response.statusCode
+	}
+
+	if err := db.DB(ctx).Create(replay.record).Error; err != nil {
+		return nil, fmt.Errorf("failed to save trace replay: %w", err)
+	}
+	s.logger.Info("Replayed trace", "traceId", req.TraceID, "replayTraceId", traceID, "agentName", req.AgentName, "statusCode", replay.record.StatusCode)
+	return replay, nil
+}
+
+// ListTraceReplays returns the replays of a trace of an agent, newest first
+func (s *observabilityManagerService) ListTraceReplays(ctx context.Context, orgName, projectName, agentName, traceID string) (*models.TraceReplayListResponse, error) {
+	var replays []models.TraceReplay
+	if err := db.DB(ctx).
+		Where("organization_name = ? AND project_name = ? AND agent_name = ? AND original_trace_id = ?", orgName, projectName, agentName, traceID).
+		Order("created_at DESC").
+		Find(&replays).Error; err != nil {
+		return nil, fmt.Errorf("failed to list trace replays: %w", err)
+	}
+	response := &models.TraceReplayListResponse{Replays: make([]models.TraceReplayResponse, 0, len(replays))}
+	for i := range replays {
+		response.Replays = append(response.Replays, *replays[i].ToResponse())
+	}
+	return response, nil
+}
+
+// traceReplayResponse is the response of an agent to a replayed request
+type traceReplayResponse struct {
+	statusCode int
+	body       json.RawMessage
+}
+
+// sendTraceReplay posts the replayed input to the agent as part of a new trace
+func (s *observabilityManagerService) sendTraceReplay(ctx context.Context, endpointURL string, body []byte, originalTraceID, traceID, spanID string) (*traceReplayResponse, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, endpointURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("traceparent", fmt.Sprintf("00-%s-%s-01", traceID, spanID))
+	httpReq.Header.Set("baggage", traceReplayBaggageKey+"="+originalTraceID)
+
+	resp, err := traceReplayClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke agent: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	responseBody, err := io.ReadAll(io.LimitReader(resp.Body, maxTraceReplayResponseBytes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read agent response: %w", err)
+	}
+	// Responses that are not JSON are returned as a JSON string
+	if len(responseBody) > 0 && !json.Valid(responseBody) {
+		if responseBody, err = json.Marshal(string(responseBody)); err != nil {
+			return nil, fmt.Errorf("failed to encode agent response: %w", err)
+		}
+	}
+	return &traceReplayResponse{statusCode: resp.StatusCode, body: responseBody}, nil
+}
+
+// validateReplayPath checks that a path appended to an agent endpoint URL stays under it
+func validateReplayPath(path string) error {
+	if path != "" && (!strings.HasPrefix(path, "/") || strings.Contains(path, "..")) {
+		return fmt.Errorf("%w: path must be an absolute path", utils.ErrInvalidInput)
+	}
+	return nil
+}
+
+// traceReplayBody encodes the root input of a trace as the request body. Inputs recorded as JSON
+// text are sent as they are.
+func traceReplayBody(input interface{}) ([]byte, error) {
+	if text, ok := input.(string); ok && json.Valid([]byte(text)) {
+		return []byte(text), nil
+	}
+	body, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode trace input: %w", err)
+	}
+	return body, nil
+}
+
+// selectReplayEndpoint returns the URL of the named endpoint, or of the first endpoint by name
+func selectReplayEndpoint(endpoints map[string]models.EndpointsResponse, name string) (string, error) {
+	_, endpoint, err := selectAgentEndpoint(endpoints, name)
+	if err != nil {
+		return "", err
+	}
+	return endpoint.URL, nil
+}
+
+// selectAgentEndpoint returns the named endpoint of an agent, or the first endpoint by name with a URL
+// when no name is given
+func selectAgentEndpoint(endpoints map[string]models.EndpointsResponse, name string) (string, models.EndpointsResponse, error) {
+	if name != "" {
+		endpoint, ok := endpoints[name]
+		if !ok || endpoint.URL == "" {
+			return "", models.EndpointsResponse{}, utils.ErrAgentEndpointNotFound
+		}
+		return name, endpoint, nil
+	}
+	names := make([]string, 0, len(endpoints))
+	for endpointName, endpoint := range endpoints {
+		if endpoint.URL != "" {
+			names = append(names, endpointName)
+		}
+	}
+	if len(names) == 0 {
+		return "", models.EndpointsResponse{}, utils.ErrAgentEndpointNotFound
+	}
+	sort.Strings(names)
+	return names[0], endpoints[names[0]], nil
+}
+
+// newTraceContext generates the W3C trace and parent span IDs of a replayed request
+func newTraceContext() (traceID string, spanID string, err error) {
+	ids := make([]byte, 24)
+	if _, err := rand.Read(ids); err != nil {
+		return "", "", fmt.Errorf("failed to generate trace ID: %w", err)
+	}
+	return hex.EncodeToString(ids[:16]), hex.EncodeToString(ids[16:]), nil
+}
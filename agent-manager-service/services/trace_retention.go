@@ -0,0 +1,241 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// maxTraceRetentionDays bounds retention periods to ten years
+const maxTraceRetentionDays = 3650
+
+// orgAgent identifies an agent by the component UID its spans carry
+type orgAgent struct {
+	ProjectName  string
+	Name         string
+	ComponentUid string
+}
+
+// listOrgAgents returns the agents of all projects of an organization. Spans only carry component
+// UIDs, so organization wide trace queries resolve the agents first.
+func (s *observabilityManagerService) listOrgAgents(ctx context.Context, orgName string) ([]orgAgent, error) {
+	projects, err := s.ocClient.ListProjects(ctx, orgName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+	var agents []orgAgent
+	for _, project := range projects {
+		components, err := s.ocClient.ListComponents(ctx, orgName, project.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list agents of project %s: %w", project.Name, err)
+		}
+		for _, component := range components {
+			if component.UUID != "" {
+				agents = append(agents, orgAgent{ProjectName: project.Name, Name: component.Name, ComponentUid: component.UUID})
+			}
+		}
+	}
+	return agents, nil
+}
+
+func (s *observabilityManagerService) GetTraceRetention(ctx context.Context, orgName string) (*models.TraceRetentionPolicyResponse, error) {
+	policy, err := getTraceRetentionPolicy(db.DB(ctx), orgName)
+	if err != nil {
+		return nil, err
+	}
+	return policy.ToResponse(), nil
+}
+
+func (s *observabilityManagerService) SetTraceRetention(ctx context.Context, orgName string, req *models.TraceRetentionPolicyRequest) (*models.TraceRetentionPolicyResponse, error) {
+	if req.RetentionDays < 1 || req.RetentionDays > maxTraceRetentionDays {
+		return nil, fmt.Errorf("%w: retentionDays must be between 1 and %d", utils.ErrInvalidInput, maxTraceRetentionDays)
+	}
+	if req.ErrorRetentionDays != 0 && (req.ErrorRetentionDays <= req.RetentionDays || req.ErrorRetentionDays > maxTraceRetentionDays) {
+		return nil, fmt.Errorf("%w: errorRetentionDays must be greater than retentionDays and at most %d", utils.ErrInvalidInput, maxTraceRetentionDays)
+	}
+
+	var policy *models.TraceRetentionPolicy
+	err := db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		policy, err = getTraceRetentionPolicy(tx, orgName)
+		if errors.Is(err, utils.ErrTraceRetentionPolicyNotFound) {
+			policy = &models.TraceRetentionPolicy{OrganizationName: orgName, CreatedAt: time.Now()}
+		} else if err != nil {
+			return err
+		}
+		policy.RetentionDays = req.RetentionDays
+		policy.ErrorRetentionDays = req.ErrorRetentionDays
+		policy.UpdatedAt = time.Now()
+		return tx.Save(policy).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save trace retention policy: %w", err)
+	}
+	s.logger.Info("Set trace retention policy", "orgName", orgName, "retentionDays", req.RetentionDays, "errorRetentionDays", req.ErrorRetentionDays)
+	return policy.ToResponse(), nil
+}
+
+func (s *observabilityManagerService) DeleteTraceRetention(ctx context.Context, orgName string) error {
+	result := db.DB(ctx).Where("organization_name = ?", orgName).Delete(&models.TraceRetentionPolicy{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete trace retention policy: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return utils.ErrTraceRetentionPolicyNotFound
+	}
+	return nil
+}
+
+func (s *observabilityManagerService) GetTraceStorageUsage(ctx context.Context, orgName string) (*models.TraceStorageUsageResponse, error) {
+	agents, err := s.listOrgAgents(ctx, orgName)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &models.TraceStorageUsageResponse{Agents: []models.AgentTraceStorage{}}
+	// Without a component filter the trace observer cannot scope the usage to the organization
+	if len(agents) == 0 {
+		return response, nil
+	}
+
+	agentsByUid := make(map[string]orgAgent, len(agents))
+	componentUids := make([]string, 0, len(agents))
+	for _, agent := range agents {
+		agentsByUid[agent.ComponentUid] = agent
+		componentUids = append(componentUids, agent.ComponentUid)
+	}
+
+	usage, err := s.traceObserverClient.GetStorageUsage(ctx, componentUids)
+	if err != nil {
+		s.logger.Error("Failed to get trace storage usage", "orgName", orgName, "error", err)
+		return nil, fmt.Errorf("failed to get trace storage usage: %w", err)
+	}
+
+	for _, component := range usage.Components {
+		agent, ok := agentsByUid[component.ComponentUid]
+		if !ok {
+			continue
+		}
+		response.Agents = append(response.Agents, models.AgentTraceStorage{
+			ProjectName:        agent.ProjectName,
+			AgentName:          agent.Name,
+			SpanCount:          component.SpanCount,
+			TraceCount:         component.TraceCount,
+			OldestSpanTime:     component.OldestSpanTime,
+			NewestSpanTime:     component.NewestSpanTime,
+			EstimatedSizeBytes: component.EstimatedSizeBytes,
+		})
+		response.SpanCount += component.SpanCount
+		response.EstimatedSizeBytes += component.EstimatedSizeBytes
+	}
+	return response, nil
+}
+
+func (s *observabilityManagerService) RunRetentionEnforcer(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.enforceAllRetentionPolicies(ctx)
+		}
+	}
+}
+
+func (s *observabilityManagerService) enforceAllRetentionPolicies(ctx context.Context) {
+	var policies []models.TraceRetentionPolicy
+	if err := db.DB(ctx).Find(&policies).Error; err != nil {
+		s.logger.Error("Failed to load trace retention policies", "error", err)
+		return
+	}
+	for i := range policies {
+		if ctx.Err() != nil {
+			return
+		}
+		policy := &policies[i]
+		now := time.Now()
+		taskID, err := s.enforceRetentionPolicy(ctx, policy, now)
+		policy.LastEnforcedAt = &now
+		policy.LastEnforcementTask = taskID
+		policy.LastEnforcementError = ""
+		if err != nil {
+			s.logger.Error("Failed to enforce trace retention policy", "orgName", policy.OrganizationName, "error", err)
+			policy.LastEnforcementError = err.Error()
+		}
+		// UpdateColumns leaves updated_at alone, which tracks changes to the policy itself
+		if err := db.DB(ctx).Model(policy).UpdateColumns(map[string]interface{}{
+			"last_enforced_at":       policy.LastEnforcedAt,
+			"last_enforcement_task":  policy.LastEnforcementTask,
+			"last_enforcement_error": policy.LastEnforcementError,
+		}).Error; err != nil {
+			s.logger.Error("Failed to save trace retention enforcement", "orgName", policy.OrganizationName, "error", err)
+		}
+	}
+	s.logger.Debug("Enforced trace retention policies", "policies", len(policies))
+}
+
+// enforceRetentionPolicy starts deleting the spans of the organization's agents that are past the
+// retention period and returns the ID of the deletion task
+func (s *observabilityManagerService) enforceRetentionPolicy(ctx context.Context, policy *models.TraceRetentionPolicy, now time.Time) (string, error) {
+	agents, err := s.listOrgAgents(ctx, policy.OrganizationName)
+	if err != nil {
+		return "", err
+	}
+	if len(agents) == 0 {
+		return "", nil
+	}
+
+	params := traceobserversvc.DeleteSpansParams{
+		Before: now.AddDate(0, 0, -policy.RetentionDays).UTC().Format(time.RFC3339),
+	}
+	if policy.ErrorRetentionDays > 0 {
+		params.ErrorsBefore = now.AddDate(0, 0, -policy.ErrorRetentionDays).UTC().Format(time.RFC3339)
+	}
+	for _, agent := range agents {
+		params.ComponentUids = append(params.ComponentUids, agent.ComponentUid)
+	}
+
+	response, err := s.traceObserverClient.DeleteSpans(ctx, params)
+	if err != nil {
+		return "", fmt.Errorf("failed to delete spans: %w", err)
+	}
+	s.logger.Info("Started deleting spans past the retention period", "orgName", policy.OrganizationName, "agents", len(agents), "before", params.Before, "taskId", response.TaskID)
+	return response.TaskID, nil
+}
+
+func getTraceRetentionPolicy(tx *gorm.DB, orgName string) (*models.TraceRetentionPolicy, error) {
+	var policy models.TraceRetentionPolicy
+	if err := tx.Where("organization_name = ?", orgName).First(&policy).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, utils.ErrTraceRetentionPolicyNotFound
+		}
+		return nil, fmt.Errorf("failed to get trace retention policy: %w", err)
+	}
+	return &policy, nil
+}
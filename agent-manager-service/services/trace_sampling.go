@@ -0,0 +1,147 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// traceSamplingRefreshInterval is how often SDKs and collectors are asked to fetch the sampling
+// verdict of an agent again, bounding how long a policy change takes to apply
+const traceSamplingRefreshInterval = 5 * time.Minute
+
+func (s *observabilityManagerService) GetTraceSamplingPolicy(ctx context.Context, orgName, projectName, agentName string) (*models.TraceSamplingPolicyResponse, error) {
+	policy, err := getTraceSamplingPolicy(db.DB(ctx), orgName, projectName, agentName)
+	if err != nil {
+		return nil, err
+	}
+	return policy.ToResponse(), nil
+}
+
+func (s *observabilityManagerService) SetTraceSamplingPolicy(ctx context.Context, orgName, projectName, agentName, updatedBy string, req *models.TraceSamplingPolicyRequest) (*models.TraceSamplingPolicyResponse, error) {
+	var policy *models.TraceSamplingPolicy
+	err := db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		policy, err = getTraceSamplingPolicy(tx, orgName, projectName, agentName)
+		if errors.Is(err, utils.ErrTraceSamplingPolicyNotFound) {
+			policy = &models.TraceSamplingPolicy{
+				UUID:             uuid.New(),
+				OrganizationName: orgName,
+				ProjectName:      projectName,
+				AgentName:        agentName,
+				CreatedAt:        time.Now(),
+			}
+		} else if err != nil {
+			return err
+		}
+		policy.ErrorSampleRate = *req.ErrorSampleRate
+		policy.SuccessSampleRate = *req.SuccessSampleRate
+		policy.UpdatedBy = updatedBy
+		policy.UpdatedAt = time.Now()
+		return tx.Save(policy).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save trace sampling policy: %w", err)
+	}
+	s.logger.Info("Set trace sampling policy", "orgName", orgName, "projectName", projectName, "agentName", agentName,
+		"errorSampleRate", policy.ErrorSampleRate, "successSampleRate", policy.SuccessSampleRate, "updatedBy", updatedBy)
+	return policy.ToResponse(), nil
+}
+
+func (s *observabilityManagerService) DeleteTraceSamplingPolicy(ctx context.Context, orgName, projectName, agentName string) error {
+	result := db.DB(ctx).
+		Where("organization_name = ? AND project_name = ? AND agent_name = ?", orgName, projectName, agentName).
+		Delete(&models.TraceSamplingPolicy{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete trace sampling policy: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return utils.ErrTraceSamplingPolicyNotFound
+	}
+	s.logger.Info("Deleted trace sampling policy", "orgName", orgName, "projectName", projectName, "agentName", agentName)
+	return nil
+}
+
+func (s *observabilityManagerService) ListTraceSamplingPolicies(ctx context.Context, orgName string) (*models.TraceSamplingPolicyListResponse, error) {
+	var policies []models.TraceSamplingPolicy
+	if err := db.DB(ctx).Where("organization_name = ?", orgName).
+		Order("project_name, agent_name").Find(&policies).Error; err != nil {
+		return nil, fmt.Errorf("failed to list trace sampling policies: %w", err)
+	}
+	response := &models.TraceSamplingPolicyListResponse{Policies: make([]models.TraceSamplingPolicyResponse, 0, len(policies))}
+	for i := range policies {
+		response.Policies = append(response.Policies, *policies[i].ToResponse())
+	}
+	return response, nil
+}
+
+func (s *observabilityManagerService) GetTraceSamplingVerdict(ctx context.Context, orgName, projectName, agentName string) (*models.TraceSamplingVerdictResponse, error) {
+	component, err := s.ocClient.GetComponent(ctx, orgName, projectName, agentName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get agent: %w", err)
+	}
+	verdict := &models.TraceSamplingVerdictResponse{
+		ComponentUid:           component.UUID,
+		ErrorSampleRate:        1,
+		SuccessSampleRate:      1,
+		Source:                 models.TraceSamplingSourceDefault,
+		RefreshIntervalSeconds: int(traceSamplingRefreshInterval.Seconds()),
+	}
+
+	// The policy of the agent takes precedence over that of the organization
+	var policies []models.TraceSamplingPolicy
+	if err := db.DB(ctx).
+		Where("organization_name = ? AND ((project_name = ? AND agent_name = ?) OR (project_name = '' AND agent_name = ''))", orgName, projectName, agentName).
+		Order("agent_name DESC").Limit(1).Find(&policies).Error; err != nil {
+		return nil, fmt.Errorf("failed to get trace sampling policy: %w", err)
+	}
+	if len(policies) == 0 {
+		return verdict, nil
+	}
+	policy := policies[0]
+	verdict.ErrorSampleRate = policy.ErrorSampleRate
+	verdict.SuccessSampleRate = policy.SuccessSampleRate
+	verdict.Source = models.TraceSamplingSourceOrganization
+	if policy.AgentName != "" {
+		verdict.Source = models.TraceSamplingSourceAgent
+	}
+	return verdict, nil
+}
+
+// getTraceSamplingPolicy returns the sampling policy of an agent, or of the organization when the
+// project and agent name are empty
+func getTraceSamplingPolicy(tx *gorm.DB, orgName, projectName, agentName string) (*models.TraceSamplingPolicy, error) {
+	var policy models.TraceSamplingPolicy
+	if err := tx.Where("organization_name = ? AND project_name = ? AND agent_name = ?", orgName, projectName, agentName).
+		First(&policy).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, utils.ErrTraceSamplingPolicyNotFound
+		}
+		return nil, fmt.Errorf("failed to get trace sampling policy: %w", err)
+	}
+	return &policy, nil
+}
@@ -0,0 +1,526 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// traceScoringSettleDelay keeps the scorer away from traces that may still be receiving spans
+const traceScoringSettleDelay = 2 * time.Minute
+
+// traceScoreCriteria are the criteria the judge scores traces on, in the order they are reported
+var traceScoreCriteria = []string{models.TraceScoreHelpfulness, models.TraceScoreHallucination, models.TraceScoreToxicity}
+
+// defaultTraceScoreThresholds are the alert thresholds of criteria a policy does not override
+var defaultTraceScoreThresholds = map[string]float64{
+	models.TraceScoreHelpfulness:   0.6,
+	models.TraceScoreHallucination: 0.3,
+	models.TraceScoreToxicity:      0.1,
+}
+
+type TraceScoreRequest struct {
+	TraceID     string
+	OrgName     string
+	ProjectName string
+	AgentName   string
+	Environment string
+}
+
+func (s *observabilityManagerService) GetTraceScoringPolicy(ctx context.Context, orgName, projectName, agentName string) (*models.TraceScoringPolicyResponse, error) {
+	policy, err := getTraceScoringPolicy(db.DB(ctx), orgName, projectName, agentName)
+	if err != nil {
+		return nil, err
+	}
+	return traceScoringPolicyResponse(policy), nil
+}
+
+func (s *observabilityManagerService) SetTraceScoringPolicy(ctx context.Context, orgName, projectName, agentName string, req *models.TraceScoringPolicyRequest) (*models.TraceScoringPolicyResponse, error) {
+	if req.Environment == "" {
+		return nil, fmt.Errorf("%w: environment is required", utils.ErrInvalidInput)
+	}
+	if req.SampleRate <= 0 || req.SampleRate > 1 {
+		return nil, fmt.Errorf("%w: sampleRate must be greater than 0 and at most 1", utils.ErrInvalidInput)
+	}
+	criteria := req.Criteria
+	if len(criteria) == 0 {
+		criteria = traceScoreCriteria
+	}
+	for _, criterion := range criteria {
+		if _, ok := defaultTraceScoreThresholds[criterion]; !ok {
+			return nil, fmt.Errorf("%w: unknown criterion %q, must be one of %s", utils.ErrInvalidInput, criterion, strings.Join(traceScoreCriteria, ", "))
+		}
+	}
+	for criterion, threshold := range req.Thresholds {
+		if _, ok := defaultTraceScoreThresholds[criterion]; !ok {
+			return nil, fmt.Errorf("%w: unknown criterion %q in thresholds", utils.ErrInvalidInput, criterion)
+		}
+		if threshold < 0 || threshold > 1 {
+			return nil, fmt.Errorf("%w: threshold of %s must be between 0 and 1", utils.ErrInvalidInput, criterion)
+		}
+	}
+	thresholds, err := json.Marshal(req.Thresholds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode thresholds: %w", err)
+	}
+
+	var policy *models.TraceScoringPolicy
+	err = db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		policy, err = getTraceScoringPolicy(tx, orgName, projectName, agentName)
+		if errors.Is(err, utils.ErrTraceScoringPolicyNotFound) {
+			policy = &models.TraceScoringPolicy{
+				UUID:             uuid.New(),
+				OrganizationName: orgName,
+				ProjectName:      projectName,
+				AgentName:        agentName,
+				CreatedAt:        time.Now(),
+			}
+		} else if err != nil {
+			return err
+		}
+		// Traces of another environment are sampled from now on
+		if policy.EnvironmentName != req.Environment {
+			policy.ScoredUntil = nil
+		}
+		policy.EnvironmentName = req.Environment
+		policy.SampleRate = req.SampleRate
+		policy.Criteria = strings.Join(uniqueCriteria(criteria), ",")
+		policy.Thresholds = string(thresholds)
+		policy.UpdatedAt = time.Now()
+		return tx.Save(policy).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save trace scoring policy: %w", err)
+	}
+	s.logger.Info("Set trace scoring policy", "agentName", agentName, "environment", req.Environment, "sampleRate", req.SampleRate, "criteria", policy.Criteria)
+	return traceScoringPolicyResponse(policy), nil
+}
+
+func (s *observabilityManagerService) DeleteTraceScoringPolicy(ctx context.Context, orgName, projectName, agentName string) error {
+	result := db.DB(ctx).
+		Where("organization_name = ? AND project_name = ? AND agent_name = ?", orgName, projectName, agentName).
+		Delete(&models.TraceScoringPolicy{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete trace scoring policy: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return utils.ErrTraceScoringPolicyNotFound
+	}
+	return nil
+}
+
+// ScoreTrace has the judge score a trace on the criteria of the agent's scoring policy, or on all
+// criteria without one, replacing earlier scores
+func (s *observabilityManagerService) ScoreTrace(ctx context.Context, req TraceScoreRequest) (*models.TraceScoresResponse, error) {
+	criteria := traceScoreCriteria
+	policy, err := getTraceScoringPolicy(db.DB(ctx), req.OrgName, req.ProjectName, req.AgentName)
+	if err == nil {
+		criteria = strings.Split(policy.Criteria, ",")
+	} else if !errors.Is(err, utils.ErrTraceScoringPolicyNotFound) {
+		return nil, err
+	}
+
+	scores, err := s.scoreTrace(ctx, req, criteria)
+	if err != nil {
+		return nil, err
+	}
+	response := &models.TraceScoresResponse{TraceID: req.TraceID, Scores: make([]models.TraceScoreResponse, 0, len(scores))}
+	for i := range scores {
+		response.Scores = append(response.Scores, *scores[i].ToResponse())
+	}
+	return response, nil
+}
+
+func (s *observabilityManagerService) GetTraceScores(ctx context.Context, orgName, projectName, agentName, traceID string) (*models.TraceScoresResponse, error) {
+	var scores []models.TraceScore
+	if err := db.DB(ctx).
+		Where("organization_name = ? AND project_name = ? AND agent_name = ? AND trace_id = ?", orgName, projectName, agentName, traceID).
+		Find(&scores).Error; err != nil {
+		return nil, fmt.Errorf("failed to get trace scores: %w", err)
+	}
+	sortTraceScores(scores)
+	response := &models.TraceScoresResponse{TraceID: traceID, Scores: make([]models.TraceScoreResponse, 0, len(scores))}
+	for i := range scores {
+		response.Scores = append(response.Scores, *scores[i].ToResponse())
+	}
+	return response, nil
+}
+
+// GetTraceScoreSummary aggregates the scores of the agent's traces started in a time window and
+// raises an alert for each criterion whose average is past its threshold
+func (s *observabilityManagerService) GetTraceScoreSummary(ctx context.Context, orgName, projectName, agentName string, startTime, endTime time.Time) (*models.TraceScoreSummaryResponse, error) {
+	thresholds := defaultTraceScoreThresholds
+	policy, err := getTraceScoringPolicy(db.DB(ctx), orgName, projectName, agentName)
+	if err == nil {
+		thresholds = policyThresholds(policy)
+	} else if !errors.Is(err, utils.ErrTraceScoringPolicyNotFound) {
+		return nil, err
+	}
+
+	scope := func() *gorm.DB {
+		return db.DB(ctx).Model(&models.TraceScore{}).
+			Where("organization_name = ? AND project_name = ? AND agent_name = ? AND trace_start_time >= ? AND trace_start_time < ?",
+				orgName, projectName, agentName, startTime, endTime)
+	}
+	var rows []struct {
+		Criterion string  `gorm:"column:criterion"`
+		Count     int64   `gorm:"column:score_count"`
+		Average   float64 `gorm:"column:average_score"`
+		Min       float64 `gorm:"column:min_score"`
+		Max       float64 `gorm:"column:max_score"`
+	}
+	if err := scope().
+		Select("criterion, COUNT(*) AS score_count, AVG(score) AS average_score, MIN(score) AS min_score, MAX(score) AS max_score").
+		Group("criterion").
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to aggregate trace scores: %w", err)
+	}
+
+	response := &models.TraceScoreSummaryResponse{StartTime: startTime, EndTime: endTime, Criteria: []models.TraceScoreAggregate{}}
+	for _, row := range rows {
+		threshold := thresholds[row.Criterion]
+		breachCondition := "score > ?"
+		if row.Criterion == models.TraceScoreHelpfulness {
+			breachCondition = "score < ?"
+		}
+		var breaches int64
+		if err := scope().Where("criterion = ?", row.Criterion).Where(breachCondition, threshold).Count(&breaches).Error; err != nil {
+			return nil, fmt.Errorf("failed to count trace score breaches: %w", err)
+		}
+		response.Criteria = append(response.Criteria, models.TraceScoreAggregate{
+			Criterion: row.Criterion,
+			Count:     row.Count,
+			Average:   row.Average,
+			Min:       row.Min,
+			Max:       row.Max,
+			Threshold: threshold,
+			Breaches:  breaches,
+			Alert:     pastTraceScoreThreshold(row.Criterion, row.Average, threshold),
+		})
+	}
+	sort.Slice(response.Criteria, func(i, j int) bool {
+		return criterionOrder(response.Criteria[i].Criterion) < criterionOrder(response.Criteria[j].Criterion)
+	})
+	return response, nil
+}
+
+func (s *observabilityManagerService) RunTraceScorer(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.scoreSampledTraces(ctx, interval)
+		}
+	}
+}
+
+func (s *observabilityManagerService) scoreSampledTraces(ctx context.Context, interval time.Duration) {
+	var policies []models.TraceScoringPolicy
+	if err := db.DB(ctx).Find(&policies).Error; err != nil {
+		s.logger.Error("Failed to load trace scoring policies", "error", err)
+		return
+	}
+	for i := range policies {
+		if ctx.Err() != nil {
+			return
+		}
+		policy := &policies[i]
+		scoredUntil, err := s.scorePolicyTraces(ctx, policy, interval)
+		now := time.Now()
+		policy.LastRunAt = &now
+		policy.LastRunError = ""
+		if err != nil {
+			s.logger.Error("Failed to score sampled traces", "agentName", policy.AgentName, "error", err)
+			policy.LastRunError = err.Error()
+		} else {
+			policy.ScoredUntil = scoredUntil
+		}
+		// UpdateColumns leaves updated_at alone, which tracks changes to the policy itself
+		if err := db.DB(ctx).Model(policy).UpdateColumns(map[string]interface{}{
+			"scored_until":   policy.ScoredUntil,
+			"last_run_at":    policy.LastRunAt,
+			"last_run_error": policy.LastRunError,
+		}).Error; err != nil {
+			s.logger.Error("Failed to save trace scoring run", "agentName", policy.AgentName, "error", err)
+		}
+	}
+}
+
+// scorePolicyTraces scores a sample of the traces started since the last run of a policy and
+// returns the start time up to which traces have been sampled. Traces that fail to be scored are
+// skipped, so that one trace the judge cannot handle does not hold back the others.
+func (s *observabilityManagerService) scorePolicyTraces(ctx context.Context, policy *models.TraceScoringPolicy, interval time.Duration) (*time.Time, error) {
+	end := time.Now().Add(-traceScoringSettleDelay).UTC().Truncate(time.Second)
+	start := end.Add(-interval)
+	if policy.ScoredUntil != nil {
+		start = policy.ScoredUntil.UTC()
+	}
+	if !start.Before(end) {
+		return policy.ScoredUntil, nil
+	}
+
+	limit := config.GetConfig().TraceJudge.MaxTracesPerRun
+	traces, err := s.ListTraces(ctx, ListTracesRequest{
+		OrgName:     policy.OrganizationName,
+		ProjectName: policy.ProjectName,
+		AgentName:   policy.AgentName,
+		Environment: policy.EnvironmentName,
+		StartTime:   start.Format(time.RFC3339),
+		EndTime:     end.Format(time.RFC3339),
+		Limit:       limit,
+		SortOrder:   "asc",
+	})
+	if err != nil {
+		return nil, err
+	}
+	// A full page leaves the later traces to the next run
+	scoredUntil := end
+	if len(traces.Traces) == limit {
+		if last, err := time.Parse(time.RFC3339Nano, traces.Traces[len(traces.Traces)-1].StartTime); err == nil && last.After(start) {
+			scoredUntil = last
+		}
+	}
+
+	criteria := strings.Split(policy.Criteria, ",")
+	var scores []models.TraceScore
+	for _, trace := range traces.Traces {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if trace.Input == nil || trace.Output == nil || !sampleTrace(trace.TraceID, policy.SampleRate) {
+			continue
+		}
+		traceScores, err := s.scoreTrace(ctx, TraceScoreRequest{
+			TraceID:     trace.TraceID,
+			OrgName:     policy.OrganizationName,
+			ProjectName: policy.ProjectName,
+			AgentName:   policy.AgentName,
+			Environment: policy.EnvironmentName,
+		}, criteria)
+		if err != nil {
+			s.logger.Warn("Failed to score trace", "traceId", trace.TraceID, "agentName", policy.AgentName, "error", err)
+			continue
+		}
+		scores = append(scores, traceScores...)
+	}
+	s.alertOnTraceScores(policy, scores)
+	s.logger.Debug("Scored sampled traces", "agentName", policy.AgentName, "traces", len(traces.Traces), "scores", len(scores))
+	return &scoredUntil, nil
+}
+
+// alertOnTraceScores logs a warning for each criterion whose average score in a run is past its threshold
+func (s *observabilityManagerService) alertOnTraceScores(policy *models.TraceScoringPolicy, scores []models.TraceScore) {
+	sums := make(map[string]float64)
+	counts := make(map[string]int)
+	for _, score := range scores {
+		sums[score.Criterion] += score.Score
+		counts[score.Criterion]++
+	}
+	thresholds := policyThresholds(policy)
+	for criterion, count := range counts {
+		average := sums[criterion] / float64(count)
+		if pastTraceScoreThreshold(criterion, average, thresholds[criterion]) {
+			s.logger.Warn("Trace scores past alert threshold", "orgName", policy.OrganizationName, "projectName", policy.ProjectName,
+				"agentName", policy.AgentName, "criterion", criterion, "average", average, "threshold", thresholds[criterion], "traces", count)
+		}
+	}
+}
+
+// scoreTrace has the judge score a trace and saves the scores, replacing earlier scores
+func (s *observabilityManagerService) scoreTrace(ctx context.Context, req TraceScoreRequest, criteria []string) ([]models.TraceScore, error) {
+	cfg := config.GetConfig().TraceJudge
+	if cfg.URL == "" {
+		return nil, ErrTraceJudgeNotConfigured
+	}
+	trace, err := s.GetTraceDetails(ctx, TraceDetailsRequest{
+		TraceID:     req.TraceID,
+		OrgName:     req.OrgName,
+		ProjectName: req.ProjectName,
+		AgentName:   req.AgentName,
+		Environment: req.Environment,
+		RawContent:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if trace.Input == nil || trace.Output == nil {
+		return nil, utils.ErrTraceNotScorable
+	}
+
+	judgements, err := judgeTrace(ctx, cfg, criteria, newTraceJudgeRequest(trace))
+	if err != nil {
+		return nil, err
+	}
+
+	var traceStart time.Time
+	for _, span := range trace.Spans {
+		if traceStart.IsZero() || span.StartTime.Before(traceStart) {
+			traceStart = span.StartTime
+		}
+	}
+	now := time.Now()
+	if traceStart.IsZero() {
+		traceStart = now
+	}
+	scores := make([]models.TraceScore, 0, len(criteria))
+	for _, criterion := range criteria {
+		judgement := judgements[criterion]
+		scores = append(scores, models.TraceScore{
+			UUID:             uuid.New(),
+			OrganizationName: req.OrgName,
+			ProjectName:      req.ProjectName,
+			AgentName:        req.AgentName,
+			EnvironmentName:  req.Environment,
+			TraceID:          req.TraceID,
+			TraceStartTime:   traceStart,
+			Criterion:        criterion,
+			Score:            judgement.Score,
+			Reason:           judgement.Reason,
+			Model:            cfg.Model,
+			CreatedAt:        now,
+		})
+	}
+
+	err = db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("organization_name = ? AND project_name = ? AND agent_name = ? AND trace_id = ? AND criterion IN ?",
+			req.OrgName, req.ProjectName, req.AgentName, req.TraceID, criteria).Delete(&models.TraceScore{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(&scores).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save trace scores: %w", err)
+	}
+	sortTraceScores(scores)
+	return scores, nil
+}
+
+// sampleTrace selects a trace by a hash of its ID, so a trace seen by several runs gets the same decision
+func sampleTrace(traceID string, rate float64) bool {
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(traceID))
+	return float64(hash.Sum64()%1_000_000) < rate*1_000_000
+}
+
+func pastTraceScoreThreshold(criterion string, score, threshold float64) bool {
+	if criterion == models.TraceScoreHelpfulness {
+		return score < threshold
+	}
+	return score > threshold
+}
+
+func criterionOrder(criterion string) int {
+	for i, c := range traceScoreCriteria {
+		if c == criterion {
+			return i
+		}
+	}
+	return len(traceScoreCriteria)
+}
+
+func sortTraceScores(scores []models.TraceScore) {
+	sort.Slice(scores, func(i, j int) bool {
+		return criterionOrder(scores[i].Criterion) < criterionOrder(scores[j].Criterion)
+	})
+}
+
+func uniqueCriteria(criteria []string) []string {
+	seen := make(map[string]bool, len(criteria))
+	unique := make([]string, 0, len(criteria))
+	for _, criterion := range criteria {
+		if !seen[criterion] {
+			seen[criterion] = true
+			unique = append(unique, criterion)
+		}
+	}
+	return unique
+}
+
+// policyThresholds returns the thresholds of a policy merged over the defaults
+func policyThresholds(policy *models.TraceScoringPolicy) map[string]float64 {
+	thresholds := make(map[string]float64, len(defaultTraceScoreThresholds))
+	for criterion, threshold := range defaultTraceScoreThresholds {
+		thresholds[criterion] = threshold
+	}
+	var overrides map[string]float64
+	if err := json.Unmarshal([]byte(policy.Thresholds), &overrides); err == nil {
+		for criterion, threshold := range overrides {
+			thresholds[criterion] = threshold
+		}
+	}
+	return thresholds
+}
+
+func traceScoringPolicyResponse(policy *models.TraceScoringPolicy) *models.TraceScoringPolicyResponse {
+	criteria := strings.Split(policy.Criteria, ",")
+	thresholds := policyThresholds(policy)
+	for criterion := range thresholds {
+		if !containsCriterion(criteria, criterion) {
+			delete(thresholds, criterion)
+		}
+	}
+	return &models.TraceScoringPolicyResponse{
+		Environment:  policy.EnvironmentName,
+		SampleRate:   policy.SampleRate,
+		Criteria:     criteria,
+		Thresholds:   thresholds,
+		ScoredUntil:  policy.ScoredUntil,
+		LastRunAt:    policy.LastRunAt,
+		LastRunError: policy.LastRunError,
+		CreatedAt:    policy.CreatedAt,
+		UpdatedAt:    policy.UpdatedAt,
+	}
+}
+
+func containsCriterion(criteria []string, criterion string) bool {
+	for _, c := range criteria {
+		if c == criterion {
+			return true
+		}
+	}
+	return false
+}
+
+func getTraceScoringPolicy(tx *gorm.DB, orgName, projectName, agentName string) (*models.TraceScoringPolicy, error) {
+	var policy models.TraceScoringPolicy
+	if err := tx.Where("organization_name = ? AND project_name = ? AND agent_name = ?", orgName, projectName, agentName).First(&policy).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, utils.ErrTraceScoringPolicyNotFound
+		}
+		return nil, fmt.Errorf("failed to get trace scoring policy: %w", err)
+	}
+	return &policy, nil
+}
@@ -0,0 +1,267 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	apiplatformclient "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/apiplatformsvc/client"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+const (
+	trialSandboxEnvironmentName        = "sandbox"
+	trialSandboxEnvironmentDisplayName = "Trial Sandbox"
+	trialSandboxEnvironmentDescription = "Trial environment served by a shared managed gateway"
+	trialSandboxEnvironmentDNSPrefix   = "sandbox"
+)
+
+func (s *organizationService) ProvisionTrialSandbox(ctx context.Context, orgName string, actor string) (*models.TrialSandboxResponse, error) {
+	s.logger.Info("Provisioning trial sandbox", "orgName", orgName)
+
+	cfg := config.GetConfig().TrialSandbox
+	if cfg.GatewayID == "" {
+		return nil, utils.ErrTrialSandboxesNotOffered
+	}
+	if s.apiPlatformClient == nil {
+		return nil, fmt.Errorf("%w: API Platform is not configured", utils.ErrServiceUnavailable)
+	}
+	if _, err := s.getOrganization(db.DB(ctx), orgName); err != nil {
+		return nil, err
+	}
+	var count int64
+	if err := db.DB(ctx).Model(&models.TrialSandbox{}).Where("organization_name = ?", orgName).Count(&count).Error; err != nil {
+		return nil, fmt.Errorf("failed to check existing trial sandbox: %w", err)
+	}
+	if count > 0 {
+		return nil, utils.ErrTrialSandboxAlreadyUsed
+	}
+
+	gateway, err := s.apiPlatformClient.GetGateway(ctx, cfg.GatewayID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trial sandbox gateway: %w", err)
+	}
+	gwUUID, err := uuid.Parse(gateway.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid gateway id %q returned by API Platform: %w", gateway.ID, err)
+	}
+	if err := s.ensureTrialRateLimits(ctx, cfg); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	env := newDefaultEnvironment(orgName, &models.OrganizationEnvironmentConfig{
+		Name:        trialSandboxEnvironmentName,
+		DisplayName: trialSandboxEnvironmentDisplayName,
+		DNSPrefix:   trialSandboxEnvironmentDNSPrefix,
+	}, now)
+	env.Description = trialSandboxEnvironmentDescription
+	sandbox := &models.TrialSandbox{
+		UUID:             uuid.New(),
+		OrganizationName: orgName,
+		EnvironmentUUID:  env.UUID,
+		GatewayUUID:      gwUUID,
+		Status:           models.TrialSandboxStatusActive,
+		ExpiresAt:        now.AddDate(0, 0, cfg.DurationDays),
+		CreatedBy:        actor,
+		CreatedAt:        now,
+	}
+
+	err = db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing int64
+		if err := tx.Model(&models.Environment{}).
+			Where("organization_name = ? AND name = ?", orgName, env.Name).Count(&existing).Error; err != nil {
+			return fmt.Errorf("failed to check existing environment: %w", err)
+		}
+		if existing > 0 {
+			return utils.ErrEnvironmentAlreadyExists
+		}
+		if err := tx.Create(env).Error; err != nil {
+			return fmt.Errorf("failed to create trial sandbox environment: %w", err)
+		}
+		mapping := &models.GatewayEnvironmentMapping{
+			GatewayUUID:     gwUUID,
+			EnvironmentUUID: env.UUID,
+			CreatedAt:       now,
+		}
+		if err := tx.Create(mapping).Error; err != nil {
+			return fmt.Errorf("failed to assign trial sandbox gateway to environment: %w", err)
+		}
+		if err := tx.Create(sandbox).Error; err != nil {
+			if isUniqueViolation(err) {
+				return utils.ErrTrialSandboxAlreadyUsed
+			}
+			return fmt.Errorf("failed to create trial sandbox: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Trial sandbox provisioned", "orgName", orgName, "environment", env.UUID, "gatewayID", gateway.ID, "expiresAt", sandbox.ExpiresAt)
+	return trialSandboxResponse(sandbox, cfg), nil
+}
+
+func (s *organizationService) GetTrialSandbox(ctx context.Context, orgName string) (*models.TrialSandboxResponse, error) {
+	sandbox, err := getTrialSandbox(db.DB(ctx), orgName)
+	if err != nil {
+		return nil, err
+	}
+	return trialSandboxResponse(sandbox, config.GetConfig().TrialSandbox), nil
+}
+
+func (s *organizationService) EndTrialSandbox(ctx context.Context, orgName string) (*models.TrialSandboxResponse, error) {
+	s.logger.Info("Ending trial sandbox", "orgName", orgName)
+
+	sandbox, err := getTrialSandbox(db.Primary(db.DB(ctx)), orgName)
+	if err != nil {
+		return nil, err
+	}
+	if err := endTrialSandbox(ctx, sandbox, time.Now()); err != nil {
+		return nil, err
+	}
+	return trialSandboxResponse(sandbox, config.GetConfig().TrialSandbox), nil
+}
+
+func (s *organizationService) RunTrialSandboxCleanup(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.endExpiredTrialSandboxes(ctx)
+		}
+	}
+}
+
+// endExpiredTrialSandboxes removes the environments of the trial sandboxes past their expiry
+func (s *organizationService) endExpiredTrialSandboxes(ctx context.Context) {
+	now := time.Now()
+	var sandboxes []models.TrialSandbox
+	if err := db.Primary(db.DB(ctx)).Where("status = ? AND expires_at <= ?", models.TrialSandboxStatusActive, now).
+		Order("expires_at").Find(&sandboxes).Error; err != nil {
+		s.logger.Error("Failed to load expired trial sandboxes", "error", err)
+		return
+	}
+	for i := range sandboxes {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := endTrialSandbox(ctx, &sandboxes[i], now); err != nil {
+			s.logger.Error("Failed to end expired trial sandbox", "orgName", sandboxes[i].OrganizationName, "error", err)
+			continue
+		}
+		s.logger.Info("Ended expired trial sandbox", "orgName", sandboxes[i].OrganizationName, "expiredAt", sandboxes[i].ExpiresAt)
+	}
+}
+
+// ensureTrialRateLimits sets the default consumer rate limit of the trial LLM provider when it
+// has none. A limit set by an operator is left as it is.
+func (s *organizationService) ensureTrialRateLimits(ctx context.Context, cfg config.TrialSandboxConfig) error {
+	if cfg.LLMProviderID == "" {
+		return nil
+	}
+	policies, err := s.apiPlatformClient.ListLLMProviderRateLimits(ctx, cfg.LLMProviderID)
+	if err != nil {
+		return fmt.Errorf("failed to list trial LLM provider rate limits: %w", err)
+	}
+	for _, policy := range policies {
+		if policy.Level == apiplatformclient.RateLimitLevelConsumer && policy.Resource == "" {
+			return nil
+		}
+	}
+	_, err = s.apiPlatformClient.CreateLLMProviderRateLimit(ctx, cfg.LLMProviderID, apiplatformclient.RateLimitPolicy{
+		Level: apiplatformclient.RateLimitLevelConsumer,
+		Requests: &apiplatformclient.RateLimitCount{
+			Count:  cfg.RequestsPerMinute,
+			Window: apiplatformclient.RateLimitWindow{Duration: 1, Unit: apiplatformclient.RateLimitWindowMinute},
+		},
+		Tokens: &apiplatformclient.RateLimitCount{
+			Count:  cfg.TokensPerDay,
+			Window: apiplatformclient.RateLimitWindow{Duration: 1, Unit: apiplatformclient.RateLimitWindowDay},
+		},
+	})
+	// A concurrent provisioning may have set it first
+	if err != nil && !errors.Is(err, utils.ErrRateLimitConflict) {
+		return fmt.Errorf("failed to set trial LLM provider rate limits: %w", err)
+	}
+	return nil
+}
+
+// endTrialSandbox removes the environment of an active trial sandbox and its mapping to the
+// shared gateway, which is left in place for the other sandboxes
+func endTrialSandbox(ctx context.Context, sandbox *models.TrialSandbox, now time.Time) error {
+	if sandbox.Status != models.TrialSandboxStatusActive {
+		return nil
+	}
+	err := db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.TrialSandbox{}).
+			Where("uuid = ? AND status = ?", sandbox.UUID, models.TrialSandboxStatusActive).
+			Updates(map[string]interface{}{"status": models.TrialSandboxStatusEnded, "ended_at": now})
+		if result.Error != nil {
+			return fmt.Errorf("failed to end trial sandbox: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			// Ended concurrently
+			return nil
+		}
+		if err := tx.Where("environment_uuid = ?", sandbox.EnvironmentUUID).Delete(&models.GatewayEnvironmentMapping{}).Error; err != nil {
+			return fmt.Errorf("failed to delete trial sandbox gateway mapping: %w", err)
+		}
+		if err := tx.Where("uuid = ?", sandbox.EnvironmentUUID).Delete(&models.Environment{}).Error; err != nil {
+			return fmt.Errorf("failed to delete trial sandbox environment: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	sandbox.Status = models.TrialSandboxStatusEnded
+	sandbox.EndedAt = &now
+	return nil
+}
+
+func getTrialSandbox(tx *gorm.DB, orgName string) (*models.TrialSandbox, error) {
+	var sandbox models.TrialSandbox
+	if err := tx.Where("organization_name = ?", orgName).First(&sandbox).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, utils.ErrTrialSandboxNotFound
+		}
+		return nil, fmt.Errorf("failed to get trial sandbox: %w", err)
+	}
+	return &sandbox, nil
+}
+
+func trialSandboxResponse(sandbox *models.TrialSandbox, cfg config.TrialSandboxConfig) *models.TrialSandboxResponse {
+	resp := sandbox.ToResponse()
+	if cfg.LLMProviderID != "" {
+		resp.RateLimits = &models.TrialSandboxRateLimits{RequestsPerMinute: cfg.RequestsPerMinute, TokensPerDay: cfg.TokensPerDay}
+	}
+	return resp
+}
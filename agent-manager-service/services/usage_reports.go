@@ -0,0 +1,445 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+)
+
+// usageReportPeriodLayout formats the month of a usage report
+const usageReportPeriodLayout = "2006-01"
+
+// usageReportColumns are the columns of the CSV and PDF renderings of a usage report
+var usageReportColumns = []string{
+	"Project", "Agent", "Environment", "Traces", "LLM Requests", "Input Tokens", "Output Tokens", "Total Tokens", "Estimated Cost (USD)", "Deployments",
+}
+
+func (s *observabilityManagerService) GenerateUsageReport(ctx context.Context, orgName, requestedBy string, req *models.CreateUsageReportRequest) (*models.UsageReportResponse, error) {
+	start, err := time.Parse(usageReportPeriodLayout, req.Period)
+	if err != nil {
+		return nil, fmt.Errorf("%w: period must be formatted as YYYY-MM", utils.ErrInvalidInput)
+	}
+	if start.After(time.Now().UTC()) {
+		return nil, fmt.Errorf("%w: period must not be in the future", utils.ErrInvalidInput)
+	}
+
+	report := &models.UsageReport{
+		UUID:             uuid.New(),
+		OrganizationName: orgName,
+		Period:           req.Period,
+		Status:           models.UsageReportStatusGenerating,
+		RequestedBy:      requestedBy,
+		CreatedAt:        time.Now(),
+	}
+	err = db.DB(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing models.UsageReport
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("organization_name = ? AND period = ?", orgName, req.Period).First(&existing).Error
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return tx.Create(report).Error
+		case err != nil:
+			return err
+		case existing.Status == models.UsageReportStatusGenerating:
+			return utils.ErrUsageReportGenerating
+		}
+		// Regenerating a month replaces the earlier report, e.g. once late traces have arrived
+		report.UUID = existing.UUID
+		return tx.Model(&existing).UpdateColumns(map[string]interface{}{
+			"status":       report.Status,
+			"requested_by": report.RequestedBy,
+			"error":        "",
+			"created_at":   report.CreatedAt,
+			"completed_at": nil,
+		}).Error
+	})
+	if err != nil {
+		if errors.Is(err, utils.ErrUsageReportGenerating) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to save usage report: %w", err)
+	}
+
+	s.logger.Info("Generating usage report", "orgName", orgName, "period", req.Period, "requestedBy", requestedBy)
+	go s.compileUsageReport(context.WithoutCancel(ctx), report)
+	return report.ToResponse(false), nil
+}
+
+func (s *observabilityManagerService) ListUsageReports(ctx context.Context, orgName string) (*models.UsageReportListResponse, error) {
+	var reports []models.UsageReport
+	if err := db.DB(ctx).Where("organization_name = ?", orgName).Order("period DESC").Find(&reports).Error; err != nil {
+		return nil, fmt.Errorf("failed to list usage reports: %w", err)
+	}
+	response := &models.UsageReportListResponse{Reports: make([]models.UsageReportResponse, 0, len(reports))}
+	for i := range reports {
+		response.Reports = append(response.Reports, *reports[i].ToResponse(false))
+	}
+	return response, nil
+}
+
+func (s *observabilityManagerService) GetUsageReport(ctx context.Context, orgName, period string) (*models.UsageReportResponse, error) {
+	report, err := s.findUsageReport(ctx, orgName, period)
+	if err != nil {
+		return nil, err
+	}
+	return report.ToResponse(true), nil
+}
+
+func (s *observabilityManagerService) RenderUsageReport(ctx context.Context, orgName, period, format string) ([]byte, string, error) {
+	report, err := s.findUsageReport(ctx, orgName, period)
+	if err != nil {
+		return nil, "", err
+	}
+	switch report.Status {
+	case models.UsageReportStatusCompleted:
+	case models.UsageReportStatusGenerating:
+		return nil, "", utils.ErrUsageReportGenerating
+	default:
+		return nil, "", utils.ErrUsageReportNotCompleted
+	}
+
+	fileName := fmt.Sprintf("usage-report-%s-%s.%s", orgName, period, format)
+	switch format {
+	case models.UsageReportFormatCSV:
+		content, err := renderUsageReportCSV(report)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to render usage report: %w", err)
+		}
+		return content, fileName, nil
+	case models.UsageReportFormatPDF:
+		return renderUsageReportPDF(report), fileName, nil
+	default:
+		return nil, "", fmt.Errorf("%w: format must be one of: csv, pdf", utils.ErrInvalidInput)
+	}
+}
+
+func (s *observabilityManagerService) findUsageReport(ctx context.Context, orgName, period string) (*models.UsageReport, error) {
+	var report models.UsageReport
+	if err := db.DB(ctx).Where("organization_name = ? AND period = ?", orgName, period).First(&report).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, utils.ErrUsageReportNotFound
+		}
+		return nil, fmt.Errorf("failed to get usage report: %w", err)
+	}
+	return &report, nil
+}
+
+func (s *observabilityManagerService) RunUsageReportGenerator(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.generateMissingUsageReports(ctx)
+		}
+	}
+}
+
+// generateMissingUsageReports compiles the report of the previous month for each active organization
+// that has none. The reports are compiled one after another to spread the load on the trace observer.
+func (s *observabilityManagerService) generateMissingUsageReports(ctx context.Context) {
+	now := time.Now().UTC()
+	period := time.Date(now.Year(), now.Month()-1, 1, 0, 0, 0, 0, time.UTC).Format(usageReportPeriodLayout)
+
+	var orgNames []string
+	if err := db.DB(ctx).Model(&models.Organization{}).
+		Where("status = ?", models.OrganizationStatusActive).
+		Where("name NOT IN (?)", db.DB(ctx).Model(&models.UsageReport{}).Select("organization_name").Where("period = ?", period)).
+		Order("name").Pluck("name", &orgNames).Error; err != nil {
+		s.logger.Error("Failed to load organizations without a usage report", "period", period, "error", err)
+		return
+	}
+	for _, orgName := range orgNames {
+		if ctx.Err() != nil {
+			return
+		}
+		report := &models.UsageReport{
+			UUID:             uuid.New(),
+			OrganizationName: orgName,
+			Period:           period,
+			Status:           models.UsageReportStatusGenerating,
+			CreatedAt:        time.Now(),
+		}
+		// A report requested meanwhile wins over the scheduled one
+		result := db.DB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(report)
+		if result.Error != nil {
+			s.logger.Error("Failed to save usage report", "orgName", orgName, "period", period, "error", result.Error)
+			continue
+		}
+		if result.RowsAffected == 0 {
+			continue
+		}
+		s.compileUsageReport(ctx, report)
+	}
+}
+
+// compileUsageReport collects the usage of each agent in each environment during the report's month
+// and saves it with the outcome of the report
+func (s *observabilityManagerService) compileUsageReport(ctx context.Context, report *models.UsageReport) {
+	rows, err := s.usageReportRows(ctx, report.OrganizationName, report.Period)
+	now := time.Now()
+	report.CompletedAt = &now
+	if err != nil {
+		s.logger.Error("Failed to generate usage report", "orgName", report.OrganizationName, "period", report.Period, "error", err)
+		report.Status = models.UsageReportStatusFailed
+		report.Error = err.Error()
+	} else {
+		report.Status = models.UsageReportStatusCompleted
+		report.Rows = rows
+		s.logger.Info("Generated usage report", "orgName", report.OrganizationName, "period", report.Period, "rows", len(rows))
+	}
+
+	if err := db.DB(ctx).Model(report).Select("status", "usage_rows", "error", "completed_at").Updates(report).Error; err != nil {
+		s.logger.Error("Failed to save usage report", "orgName", report.OrganizationName, "period", report.Period, "error", err)
+	}
+}
+
+// usageReportKey identifies the row of an agent in an environment
+type usageReportKey struct {
+	projectName string
+	agentName   string
+	environment string
+}
+
+// usageReportRows returns the usage of an organization's agents in a month, leaving out agents that
+// were neither used nor deployed in an environment
+func (s *observabilityManagerService) usageReportRows(ctx context.Context, orgName, period string) ([]models.UsageReportRow, error) {
+	start, err := time.Parse(usageReportPeriodLayout, period)
+	if err != nil {
+		return nil, fmt.Errorf("invalid period %q: %w", period, err)
+	}
+	end := start.AddDate(0, 1, 0)
+	if now := time.Now().UTC(); end.After(now) {
+		end = now
+	}
+
+	deployments, err := usageReportDeployments(ctx, orgName, start, end)
+	if err != nil {
+		return nil, err
+	}
+	agents, err := s.listOrgAgents(ctx, orgName)
+	if err != nil {
+		return nil, err
+	}
+	environments, err := s.ocClient.ListEnvironments(ctx, orgName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list environments: %w", err)
+	}
+
+	rows := make(map[usageReportKey]*models.UsageReportRow)
+	for _, environment := range environments {
+		for _, agent := range agents {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			key := usageReportKey{projectName: agent.ProjectName, agentName: agent.Name, environment: environment.Name}
+			row, err := s.agentUsage(ctx, agent, environment.UUID, start, end)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get usage of agent %s in environment %s: %w", agent.Name, environment.Name, err)
+			}
+			row.Environment = environment.Name
+			row.Deployments = deployments[key]
+			if row.TraceCount > 0 || row.LLMRequests > 0 || row.Deployments > 0 {
+				rows[key] = row
+			}
+		}
+	}
+	// Agents deleted since their deployment still count towards the month
+	for key, count := range deployments {
+		if _, ok := rows[key]; !ok {
+			rows[key] = &models.UsageReportRow{ProjectName: key.projectName, AgentName: key.agentName, Environment: key.environment, Deployments: count}
+		}
+	}
+
+	result := make([]models.UsageReportRow, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, *row)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].ProjectName != result[j].ProjectName {
+			return result[i].ProjectName < result[j].ProjectName
+		}
+		if result[i].AgentName != result[j].AgentName {
+			return result[i].AgentName < result[j].AgentName
+		}
+		return result[i].Environment < result[j].Environment
+	})
+	return result, nil
+}
+
+// agentUsage returns the traces and LLM usage of an agent in an environment between start and end
+func (s *observabilityManagerService) agentUsage(ctx context.Context, agent orgAgent, environmentUid string, start, end time.Time) (*models.UsageReportRow, error) {
+	row := &models.UsageReportRow{ProjectName: agent.ProjectName, AgentName: agent.Name}
+	traces, err := s.traceObserverClient.ListTraces(ctx, traceobserversvc.ListTracesParams{
+		ServiceName:    agent.Name,
+		ComponentUid:   agent.ComponentUid,
+		EnvironmentUid: environmentUid,
+		StartTime:      start.Format(time.RFC3339),
+		EndTime:        end.Format(time.RFC3339),
+		Limit:          1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list traces: %w", err)
+	}
+	row.TraceCount = traces.TotalCount
+
+	usage, err := s.traceObserverClient.GetModelUsage(ctx, traceobserversvc.ModelUsageParams{
+		ComponentUids:  []string{agent.ComponentUid},
+		EnvironmentUid: environmentUid,
+		StartTime:      start.Format(time.RFC3339),
+		EndTime:        end.Format(time.RFC3339),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get model usage: %w", err)
+	}
+	if usage.Truncated {
+		s.logger.Warn("Model usage of usage report is truncated; usage may be undercounted", "agentName", agent.Name, "spanCount", usage.SpanCount)
+	}
+	for _, model := range usage.Models {
+		row.LLMRequests += model.RequestCount
+		row.InputTokens += model.InputTokens
+		row.OutputTokens += model.OutputTokens
+		row.TotalTokens += model.TotalTokens
+		if model.EstimatedCost != nil {
+			cost := *model.EstimatedCost
+			if row.EstimatedCost != nil {
+				cost += *row.EstimatedCost
+			}
+			row.EstimatedCost = &cost
+		}
+	}
+	return row, nil
+}
+
+// usageReportDeployments counts the deployments of each agent in each environment between start and end
+func usageReportDeployments(ctx context.Context, orgName string, start, end time.Time) (map[usageReportKey]int, error) {
+	var counts []struct {
+		ProjectName string
+		AgentName   string
+		Environment string
+		Count       int
+	}
+	if err := db.DB(ctx).Model(&models.AgentDeploymentRevision{}).
+		Select("project_name, agent_name, environment, COUNT(*) AS count").
+		Where("organization_name = ? AND created_at >= ? AND created_at < ?", orgName, start, end).
+		Group("project_name, agent_name, environment").
+		Scan(&counts).Error; err != nil {
+		return nil, fmt.Errorf("failed to count deployments: %w", err)
+	}
+	deployments := make(map[usageReportKey]int, len(counts))
+	for _, count := range counts {
+		deployments[usageReportKey{projectName: count.ProjectName, agentName: count.AgentName, environment: count.Environment}] = count.Count
+	}
+	return deployments, nil
+}
+
+// usageReportRecord returns the cells of a row for the CSV and PDF renderings
+func usageReportRecord(row models.UsageReportRow) []string {
+	cost := ""
+	if row.EstimatedCost != nil {
+		cost = strconv.FormatFloat(*row.EstimatedCost, 'f', 4, 64)
+	}
+	return []string{
+		row.ProjectName, row.AgentName, row.Environment,
+		strconv.Itoa(row.TraceCount), strconv.Itoa(row.LLMRequests), strconv.Itoa(row.InputTokens),
+		strconv.Itoa(row.OutputTokens), strconv.Itoa(row.TotalTokens), cost, strconv.Itoa(row.Deployments),
+	}
+}
+
+func renderUsageReportCSV(report *models.UsageReport) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(usageReportColumns); err != nil {
+		return nil, err
+	}
+	for _, row := range report.Rows {
+		if err := w.Write(usageReportRecord(row)); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+// renderUsageReportPDF lays the report out as a text table, with the totals of the organization below the rows
+func renderUsageReportPDF(report *models.UsageReport) []byte {
+	totals := report.ToResponse(false).Totals
+	totalCost := ""
+	if totals.EstimatedCost != nil {
+		totalCost = strconv.FormatFloat(*totals.EstimatedCost, 'f', 4, 64)
+	}
+	records := [][]string{usageReportColumns}
+	for _, row := range report.Rows {
+		records = append(records, usageReportRecord(row))
+	}
+	records = append(records, []string{
+		"Total", "", "", strconv.Itoa(totals.TraceCount), strconv.Itoa(totals.LLMRequests), "", "",
+		strconv.Itoa(totals.TotalTokens), totalCost, strconv.Itoa(totals.Deployments),
+	})
+
+	widths := make([]int, len(usageReportColumns))
+	for _, record := range records {
+		for i, cell := range record {
+			widths[i] = max(widths[i], len(cell))
+		}
+	}
+	format := func(record []string) string {
+		cells := make([]string, len(record))
+		for i, cell := range record {
+			// Text columns are left aligned and figures right aligned
+			if i < 3 {
+				cells[i] = fmt.Sprintf("%-*s", widths[i], cell)
+			} else {
+				cells[i] = fmt.Sprintf("%*s", widths[i], cell)
+			}
+		}
+		return strings.TrimRight(strings.Join(cells, "  "), " ")
+	}
+	separator := strings.Repeat("-", len(format(usageReportColumns)))
+
+	lines := []string{
+		fmt.Sprintf("Usage report of %s for %s", report.OrganizationName, report.Period),
+		fmt.Sprintf("Generated %s", report.CompletedAt.UTC().Format(time.RFC3339)),
+		"",
+		format(records[0]),
+		separator,
+	}
+	for _, record := range records[1 : len(records)-1] {
+		lines = append(lines, format(record))
+	}
+	lines = append(lines, separator, format(records[len(records)-1]))
+	return utils.WriteTextPDF(lines)
+}
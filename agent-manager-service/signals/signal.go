@@ -44,3 +44,13 @@ func SetupSignalHandler() (stopCh <-chan struct{}) {
 
 	return stop
 }
+
+// SetupReloadHandler returns a channel that receives a value each time the process is asked to
+// reload its configuration with SIGHUP. The channel never receives on platforms without SIGHUP.
+func SetupReloadHandler() <-chan os.Signal {
+	c := make(chan os.Signal, 1)
+	if len(reloadSignals) > 0 {
+		signal.Notify(c, reloadSignals...)
+	}
+	return c
+}
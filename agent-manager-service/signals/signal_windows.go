@@ -23,3 +23,6 @@ import (
 )
 
 var shutdownSignals = []os.Signal{os.Interrupt}
+
+// Windows has no signal to ask for a configuration reload
+var reloadSignals []os.Signal
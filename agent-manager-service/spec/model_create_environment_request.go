@@ -31,6 +31,8 @@ type CreateEnvironmentRequest struct {
 	DnsPrefix string `json:"dnsPrefix"`
 	// Whether this is a production environment
 	IsProduction *bool `json:"isProduction,omitempty"`
+	// Region the environment runs in; only gateways in the region can serve it
+	Region *string `json:"region,omitempty"`
 }
 
 // NewCreateEnvironmentRequest instantiates a new CreateEnvironmentRequest object
@@ -214,6 +216,38 @@ func (o *CreateEnvironmentRequest) SetIsProduction(v bool) {
 	o.IsProduction = &v
 }
 
+// GetRegion returns the Region field value if set, zero value otherwise.
+func (o *CreateEnvironmentRequest) GetRegion() string {
+	if o == nil || IsNil(o.Region) {
+		var ret string
+		return ret
+	}
+	return *o.Region
+}
+
+// GetRegionOk returns a tuple with the Region field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateEnvironmentRequest) GetRegionOk() (*string, bool) {
+	if o == nil || IsNil(o.Region) {
+		return nil, false
+	}
+	return o.Region, true
+}
+
+// HasRegion returns a boolean if a field has been set.
+func (o *CreateEnvironmentRequest) HasRegion() bool {
+	if o != nil && !IsNil(o.Region) {
+		return true
+	}
+
+	return false
+}
+
+// SetRegion gets a reference to the given string and assigns it to the Region field.
+func (o *CreateEnvironmentRequest) SetRegion(v string) {
+	o.Region = &v
+}
+
 func (o CreateEnvironmentRequest) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -234,6 +268,9 @@ func (o CreateEnvironmentRequest) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.IsProduction) {
 		toSerialize["isProduction"] = o.IsProduction
 	}
+	if !IsNil(o.Region) {
+		toSerialize["region"] = o.Region
+	}
 	return toSerialize, nil
 }
 
@@ -23,6 +23,8 @@ type DeploymentResponse struct {
 	ProjectName string `json:"projectName"`
 	ImageId     string `json:"imageId"`
 	Environment string `json:"environment"`
+	// ID of the approval the deployment is waiting for, if it needs one
+	ApprovalId *string `json:"approvalId,omitempty"`
 }
 
 // NewDeploymentResponse instantiates a new DeploymentResponse object
@@ -142,6 +144,38 @@ func (o *DeploymentResponse) SetEnvironment(v string) {
 	o.Environment = v
 }
 
+// GetApprovalId returns the ApprovalId field value if set, zero value otherwise.
+func (o *DeploymentResponse) GetApprovalId() string {
+	if o == nil || IsNil(o.ApprovalId) {
+		var ret string
+		return ret
+	}
+	return *o.ApprovalId
+}
+
+// GetApprovalIdOk returns a tuple with the ApprovalId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *DeploymentResponse) GetApprovalIdOk() (*string, bool) {
+	if o == nil || IsNil(o.ApprovalId) {
+		return nil, false
+	}
+	return o.ApprovalId, true
+}
+
+// HasApprovalId returns a boolean if a field has been set.
+func (o *DeploymentResponse) HasApprovalId() bool {
+	if o != nil && !IsNil(o.ApprovalId) {
+		return true
+	}
+
+	return false
+}
+
+// SetApprovalId gets a reference to the given string and assigns it to the ApprovalId field.
+func (o *DeploymentResponse) SetApprovalId(v string) {
+	o.ApprovalId = &v
+}
+
 func (o DeploymentResponse) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -156,6 +190,9 @@ func (o DeploymentResponse) ToMap() (map[string]interface{}, error) {
 	toSerialize["projectName"] = o.ProjectName
 	toSerialize["imageId"] = o.ImageId
 	toSerialize["environment"] = o.Environment
+	if !IsNil(o.ApprovalId) {
+		toSerialize["approvalId"] = o.ApprovalId
+	}
 	return toSerialize, nil
 }
 
@@ -19,10 +19,16 @@ var _ MappedNullable = &ErrorResponse{}
 
 // ErrorResponse struct for ErrorResponse
 type ErrorResponse struct {
+	// Machine-readable error code
+	Code *string `json:"code,omitempty"`
 	// Human-readable error message
 	Message string `json:"message"`
 	// Error description
-	Description    *string                `json:"description,omitempty"`
+	Description *string `json:"description,omitempty"`
+	// Validation errors of individual request fields
+	FieldErrors []FieldError `json:"fieldErrors,omitempty"`
+	// Correlation ID of the request, for matching the error with server logs
+	CorrelationId  *string                `json:"correlationId,omitempty"`
 	AdditionalData map[string]interface{} `json:"additionalData,omitempty"`
 }
 
@@ -44,6 +50,38 @@ func NewErrorResponseWithDefaults() *ErrorResponse {
 	return &this
 }
 
+// GetCode returns the Code field value if set, zero value otherwise.
+func (o *ErrorResponse) GetCode() string {
+	if o == nil || IsNil(o.Code) {
+		var ret string
+		return ret
+	}
+	return *o.Code
+}
+
+// GetCodeOk returns a tuple with the Code field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ErrorResponse) GetCodeOk() (*string, bool) {
+	if o == nil || IsNil(o.Code) {
+		return nil, false
+	}
+	return o.Code, true
+}
+
+// HasCode returns a boolean if a field has been set.
+func (o *ErrorResponse) HasCode() bool {
+	if o != nil && !IsNil(o.Code) {
+		return true
+	}
+
+	return false
+}
+
+// SetCode gets a reference to the given string and assigns it to the Code field.
+func (o *ErrorResponse) SetCode(v string) {
+	o.Code = &v
+}
+
 // GetMessage returns the Message field value
 func (o *ErrorResponse) GetMessage() string {
 	if o == nil {
@@ -100,6 +138,70 @@ func (o *ErrorResponse) SetDescription(v string) {
 	o.Description = &v
 }
 
+// GetFieldErrors returns the FieldErrors field value if set, zero value otherwise.
+func (o *ErrorResponse) GetFieldErrors() []FieldError {
+	if o == nil || IsNil(o.FieldErrors) {
+		var ret []FieldError
+		return ret
+	}
+	return o.FieldErrors
+}
+
+// GetFieldErrorsOk returns a tuple with the FieldErrors field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ErrorResponse) GetFieldErrorsOk() ([]FieldError, bool) {
+	if o == nil || IsNil(o.FieldErrors) {
+		return nil, false
+	}
+	return o.FieldErrors, true
+}
+
+// HasFieldErrors returns a boolean if a field has been set.
+func (o *ErrorResponse) HasFieldErrors() bool {
+	if o != nil && !IsNil(o.FieldErrors) {
+		return true
+	}
+
+	return false
+}
+
+// SetFieldErrors gets a reference to the given []FieldError and assigns it to the FieldErrors field.
+func (o *ErrorResponse) SetFieldErrors(v []FieldError) {
+	o.FieldErrors = v
+}
+
+// GetCorrelationId returns the CorrelationId field value if set, zero value otherwise.
+func (o *ErrorResponse) GetCorrelationId() string {
+	if o == nil || IsNil(o.CorrelationId) {
+		var ret string
+		return ret
+	}
+	return *o.CorrelationId
+}
+
+// GetCorrelationIdOk returns a tuple with the CorrelationId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ErrorResponse) GetCorrelationIdOk() (*string, bool) {
+	if o == nil || IsNil(o.CorrelationId) {
+		return nil, false
+	}
+	return o.CorrelationId, true
+}
+
+// HasCorrelationId returns a boolean if a field has been set.
+func (o *ErrorResponse) HasCorrelationId() bool {
+	if o != nil && !IsNil(o.CorrelationId) {
+		return true
+	}
+
+	return false
+}
+
+// SetCorrelationId gets a reference to the given string and assigns it to the CorrelationId field.
+func (o *ErrorResponse) SetCorrelationId(v string) {
+	o.CorrelationId = &v
+}
+
 // GetAdditionalData returns the AdditionalData field value if set, zero value otherwise.
 func (o *ErrorResponse) GetAdditionalData() map[string]interface{} {
 	if o == nil || IsNil(o.AdditionalData) {
@@ -142,10 +244,19 @@ func (o ErrorResponse) MarshalJSON() ([]byte, error) {
 
 func (o ErrorResponse) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.Code) {
+		toSerialize["code"] = o.Code
+	}
 	toSerialize["message"] = o.Message
 	if !IsNil(o.Description) {
 		toSerialize["description"] = o.Description
 	}
+	if !IsNil(o.FieldErrors) {
+		toSerialize["fieldErrors"] = o.FieldErrors
+	}
+	if !IsNil(o.CorrelationId) {
+		toSerialize["correlationId"] = o.CorrelationId
+	}
 	if !IsNil(o.AdditionalData) {
 		toSerialize["additionalData"] = o.AdditionalData
 	}
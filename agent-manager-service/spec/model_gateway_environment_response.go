@@ -36,6 +36,8 @@ type GatewayEnvironmentResponse struct {
 	DnsPrefix string `json:"dnsPrefix"`
 	// Whether this is a production environment
 	IsProduction bool `json:"isProduction"`
+	// Region the environment runs in
+	Region *string `json:"region,omitempty"`
 	// Timestamp when the environment was created
 	CreatedAt time.Time `json:"createdAt"`
 	// Timestamp when the environment was last updated
@@ -316,6 +318,38 @@ func (o *GatewayEnvironmentResponse) SetUpdatedAt(v time.Time) {
 	o.UpdatedAt = v
 }
 
+// GetRegion returns the Region field value if set, zero value otherwise.
+func (o *GatewayEnvironmentResponse) GetRegion() string {
+	if o == nil || IsNil(o.Region) {
+		var ret string
+		return ret
+	}
+	return *o.Region
+}
+
+// GetRegionOk returns a tuple with the Region field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *GatewayEnvironmentResponse) GetRegionOk() (*string, bool) {
+	if o == nil || IsNil(o.Region) {
+		return nil, false
+	}
+	return o.Region, true
+}
+
+// HasRegion returns a boolean if a field has been set.
+func (o *GatewayEnvironmentResponse) HasRegion() bool {
+	if o != nil && !IsNil(o.Region) {
+		return true
+	}
+
+	return false
+}
+
+// SetRegion gets a reference to the given string and assigns it to the Region field.
+func (o *GatewayEnvironmentResponse) SetRegion(v string) {
+	o.Region = &v
+}
+
 func (o GatewayEnvironmentResponse) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -336,6 +370,9 @@ func (o GatewayEnvironmentResponse) ToMap() (map[string]interface{}, error) {
 	toSerialize["dataplaneRef"] = o.DataplaneRef
 	toSerialize["dnsPrefix"] = o.DnsPrefix
 	toSerialize["isProduction"] = o.IsProduction
+	if !IsNil(o.Region) {
+		toSerialize["region"] = o.Region
+	}
 	toSerialize["createdAt"] = o.CreatedAt
 	toSerialize["updatedAt"] = o.UpdatedAt
 	return toSerialize, nil
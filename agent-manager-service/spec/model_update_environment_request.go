@@ -23,6 +23,8 @@ type UpdateEnvironmentRequest struct {
 	DisplayName *string `json:"displayName,omitempty"`
 	// Updated description
 	Description NullableString `json:"description,omitempty"`
+	// Updated region; every gateway of the environment must be in the region
+	Region *string `json:"region,omitempty"`
 }
 
 // NewUpdateEnvironmentRequest instantiates a new UpdateEnvironmentRequest object
@@ -117,6 +119,38 @@ func (o *UpdateEnvironmentRequest) UnsetDescription() {
 	o.Description.Unset()
 }
 
+// GetRegion returns the Region field value if set, zero value otherwise.
+func (o *UpdateEnvironmentRequest) GetRegion() string {
+	if o == nil || IsNil(o.Region) {
+		var ret string
+		return ret
+	}
+	return *o.Region
+}
+
+// GetRegionOk returns a tuple with the Region field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *UpdateEnvironmentRequest) GetRegionOk() (*string, bool) {
+	if o == nil || IsNil(o.Region) {
+		return nil, false
+	}
+	return o.Region, true
+}
+
+// HasRegion returns a boolean if a field has been set.
+func (o *UpdateEnvironmentRequest) HasRegion() bool {
+	if o != nil && !IsNil(o.Region) {
+		return true
+	}
+
+	return false
+}
+
+// SetRegion gets a reference to the given string and assigns it to the Region field.
+func (o *UpdateEnvironmentRequest) SetRegion(v string) {
+	o.Region = &v
+}
+
 func (o UpdateEnvironmentRequest) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -133,6 +167,9 @@ func (o UpdateEnvironmentRequest) ToMap() (map[string]interface{}, error) {
 	if o.Description.IsSet() {
 		toSerialize["description"] = o.Description.Get()
 	}
+	if !IsNil(o.Region) {
+		toSerialize["region"] = o.Region
+	}
 	return toSerialize, nil
 }
 
@@ -0,0 +1,129 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+func TestAgentCards(t *testing.T) {
+	orgName := fmt.Sprintf("card-org-%s", uuid.New().String()[:5])
+	projName := fmt.Sprintf("card-project-%s", uuid.New().String()[:5])
+	agentName := fmt.Sprintf("card-agent-%s", uuid.New().String()[:5])
+	deployedAt := time.Date(2026, 10, 1, 9, 30, 0, 0, time.UTC)
+	visibility := "Public"
+
+	openChoreoClient := apitestutils.CreateMockOpenChoreoClient()
+	openChoreoClient.GetComponentFunc = func(ctx context.Context, namespaceName, projectName, componentName string) (*models.AgentResponse, error) {
+		return &models.AgentResponse{
+			Name:        componentName,
+			DisplayName: "Support Agent",
+			Description: "Answers support questions",
+			ProjectName: projectName,
+			Type:        models.AgentType{Type: string(utils.AgentTypeAPI), SubType: string(utils.AgentSubTypeChatAPI)},
+		}, nil
+	}
+	openChoreoClient.GetDeploymentsFunc = func(ctx context.Context, namespaceName, pipelineName, projectName, componentName string) ([]*models.DeploymentResponse, error) {
+		return []*models.DeploymentResponse{
+			{Environment: "development", Status: "active", LastDeployedAt: deployedAt},
+			{Environment: "production", Status: "not-deployed"},
+		}, nil
+	}
+	openChoreoClient.GetComponentEndpointsFunc = func(ctx context.Context, namespaceName, projectName, componentName, environment string) (map[string]models.EndpointsResponse, error) {
+		return map[string]models.EndpointsResponse{
+			"chat": {
+				Endpoint: models.Endpoint{URL: "http://" + environment + ".agent.example.com", Name: "chat", Visibility: visibility},
+				Schema:   models.EndpointSchema{Content: agentPublicationOpenAPI},
+			},
+		}, nil
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{
+		OpenChoreoClient: openChoreoClient,
+	}, jwtassertion.NewMockMiddleware(t))
+
+	get := func(t *testing.T, url string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		return rr
+	}
+	cardURL := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/agent-card", orgName, projName, agentName)
+	wellKnownURL := fmt.Sprintf("/a2a/%s/%s/%s/.well-known/agent-card.json", orgName, projName, agentName)
+
+	t.Run("The card should describe the active deployment", func(t *testing.T) {
+		rr := get(t, cardURL)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var card models.AgentCard
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&card))
+		require.Equal(t, models.A2AProtocolVersion, card.ProtocolVersion)
+		require.Equal(t, "Support Agent", card.Name)
+		require.Equal(t, "Answers support questions", card.Description)
+		require.Equal(t, "http://development.agent.example.com", card.URL)
+		require.Equal(t, models.A2ATransportHTTPJSON, card.PreferredTransport)
+		require.Equal(t, "2026.10.01.093000", card.Version)
+		require.Equal(t, []string{"text/plain", "application/json"}, card.DefaultInputModes)
+		require.Empty(t, card.SecuritySchemes)
+		require.Len(t, card.Skills, 2)
+		require.Equal(t, "post-chat", card.Skills[0].ID)
+		require.Equal(t, "POST /chat", card.Skills[0].Name)
+		require.Equal(t, "get-health", card.Skills[1].ID)
+	})
+
+	t.Run("An environment without an active deployment should not have a card", func(t *testing.T) {
+		rr := get(t, cardURL+"?environment=production")
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+	})
+
+	t.Run("The well-known card should be served without authentication", func(t *testing.T) {
+		rr := get(t, wellKnownURL)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		require.Equal(t, "max-age=60", rr.Header().Get("Cache-Control"))
+		var card models.AgentCard
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&card))
+		require.Equal(t, "Support Agent", card.Name)
+	})
+
+	t.Run("An agent without a public endpoint should require bearer tokens and not be discoverable", func(t *testing.T) {
+		visibility = "Organization"
+		defer func() { visibility = "Public" }()
+
+		rr := get(t, cardURL)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var card models.AgentCard
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&card))
+		require.Contains(t, card.SecuritySchemes, "bearer")
+		require.Equal(t, []map[string][]string{{"bearer": {}}}, card.Security)
+
+		rr = get(t, wellKnownURL)
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+	})
+}
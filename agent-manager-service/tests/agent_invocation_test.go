@@ -0,0 +1,114 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+func TestAgentInvocation(t *testing.T) {
+	orgName := fmt.Sprintf("invoke-org-%s", uuid.New().String()[:5])
+	projName := fmt.Sprintf("invoke-project-%s", uuid.New().String()[:5])
+	agentName := fmt.Sprintf("invoke-agent-%s", uuid.New().String()[:5])
+
+	var received *http.Request
+	var receivedBody string
+	agent := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received, receivedBody = r, string(body)
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		for _, event := range []string{"Hello", "world"} {
+			_, _ = fmt.Fprintf(w, "data: %s\n\n", event)
+			w.(http.Flusher).Flush()
+		}
+	}))
+	defer agent.Close()
+
+	openChoreoClient := apitestutils.CreateMockOpenChoreoClient()
+	openChoreoClient.GetComponentEndpointsFunc = func(ctx context.Context, namespaceName, projectName, componentName, environment string) (map[string]models.EndpointsResponse, error) {
+		return map[string]models.EndpointsResponse{
+			"default": {Endpoint: models.Endpoint{URL: agent.URL, Name: "default"}},
+		}, nil
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{
+		OpenChoreoClient: openChoreoClient,
+	}, jwtassertion.NewMockMiddleware(t))
+
+	invokeURL := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/invoke", orgName, projName, agentName)
+	invoke := func(t *testing.T, query string, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, invokeURL+query, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("Invoking should stream the agent response with the trace ID", func(t *testing.T) {
+		rr := invoke(t, "?environment=Development&path=/chat", `{"message":"hi","session_id":"s1"}`)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		require.Equal(t, "data: Hello\n\ndata: world\n\n", rr.Body.String())
+		require.Equal(t, "text/event-stream", rr.Header().Get("Content-Type"))
+
+		traceID := rr.Header().Get("x-trace-id")
+		require.Len(t, traceID, 32)
+		require.NotNil(t, received)
+		require.Equal(t, "/chat", received.URL.Path)
+		require.Equal(t, `{"message":"hi","session_id":"s1"}`, receivedBody)
+		require.True(t, strings.HasPrefix(received.Header.Get("traceparent"), "00-"+traceID+"-"))
+		require.True(t, strings.HasPrefix(received.Header.Get("Authorization"), "Bearer "))
+	})
+
+	t.Run("An environment is required", func(t *testing.T) {
+		rr := invoke(t, "", `{}`)
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+	})
+
+	t.Run("A path outside the endpoint should be rejected", func(t *testing.T) {
+		rr := invoke(t, "?environment=Development&path=/../admin", `{}`)
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+	})
+
+	t.Run("An unknown endpoint should not be found", func(t *testing.T) {
+		rr := invoke(t, "?environment=Development&endpoint=missing", `{}`)
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+	})
+
+	t.Run("An unreachable agent should be a bad gateway", func(t *testing.T) {
+		openChoreoClient.GetComponentEndpointsFunc = func(ctx context.Context, namespaceName, projectName, componentName, environment string) (map[string]models.EndpointsResponse, error) {
+			return map[string]models.EndpointsResponse{
+				"default": {Endpoint: models.Endpoint{URL: "http://127.0.0.1:1", Name: "default"}},
+			}, nil
+		}
+		rr := invoke(t, "?environment=Development", `{}`)
+		require.Equal(t, http.StatusBadGateway, rr.Code, rr.Body.String())
+	})
+}
@@ -0,0 +1,232 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	apiplatformclient "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/apiplatformsvc/client"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/clientmocks"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+const agentPublicationOpenAPI = `
+openapi: 3.0.0
+info:
+  title: Support agent
+  version: 1.0.0
+paths:
+  /chat:
+    post:
+      responses:
+        "200":
+          description: OK
+  /health:
+    get:
+      responses:
+        "200":
+          description: OK
+`
+
+func TestAgentPublications(t *testing.T) {
+	orgName := fmt.Sprintf("publish-org-%s", uuid.New().String()[:5])
+	projName := fmt.Sprintf("publish-project-%s", uuid.New().String()[:5])
+	agentName := fmt.Sprintf("publish-agent-%s", uuid.New().String()[:5])
+	apiID := uuid.New().String()
+	devPortalID := uuid.New().String()
+
+	openChoreoClient := apitestutils.CreateMockOpenChoreoClient()
+	openChoreoClient.GetComponentEndpointsFunc = func(ctx context.Context, namespaceName, projectName, componentName, environment string) (map[string]models.EndpointsResponse, error) {
+		return map[string]models.EndpointsResponse{
+			"default": {
+				Endpoint: models.Endpoint{URL: "http://agent.example.com", Name: "default"},
+				Schema:   models.EndpointSchema{Content: agentPublicationOpenAPI},
+			},
+		}, nil
+	}
+	apiPlatformClient := &clientmocks.APIPlatformClientMock{
+		ListProjectsFunc: func(ctx context.Context) ([]*apiplatformclient.ProjectResponse, error) {
+			return []*apiplatformclient.ProjectResponse{}, nil
+		},
+		CreateProjectFunc: func(ctx context.Context, req apiplatformclient.CreateProjectRequest) (*apiplatformclient.ProjectResponse, error) {
+			return &apiplatformclient.ProjectResponse{ID: uuid.New().String(), Name: req.Name}, nil
+		},
+		CreateAPIFunc: func(ctx context.Context, req apiplatformclient.CreateAPIRequest) (*apiplatformclient.APIResponse, error) {
+			return &apiplatformclient.APIResponse{ID: apiID, Name: req.Name, Version: req.Version, Context: req.Context, ProjectID: req.ProjectID}, nil
+		},
+		GetDefaultDevPortalFunc: func(ctx context.Context) (*apiplatformclient.DevPortalResponse, error) {
+			return &apiplatformclient.DevPortalResponse{ID: devPortalID, Name: "Default", IsDefault: true, IsEnabled: true}, nil
+		},
+		PublishAPIToDevPortalFunc: func(ctx context.Context, id string, req apiplatformclient.PublishAPIRequest) error {
+			return nil
+		},
+		UnpublishAPIFromDevPortalFunc: func(ctx context.Context, id string, portalID string) error {
+			return nil
+		},
+		UpdateAPILifeCycleStatusFunc: func(ctx context.Context, id string, status apiplatformclient.APILifeCycleStatus) (*apiplatformclient.APIResponse, error) {
+			return &apiplatformclient.APIResponse{ID: id, LifeCycleStatus: status}, nil
+		},
+		DeleteAPIFunc: func(ctx context.Context, id string) error {
+			return nil
+		},
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{
+		OpenChoreoClient:  openChoreoClient,
+		APIPlatformClient: apiPlatformClient,
+	}, jwtassertion.NewMockMiddleware(t))
+
+	publicationURL := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/publication", orgName, projName, agentName)
+	changeLifecycle := func(t *testing.T, status string) *httptest.ResponseRecorder {
+		return apitestutils.SendJSON(t, app, http.MethodPost, publicationURL+"/lifecycle", models.AgentPublicationLifecycleRequest{Status: status})
+	}
+
+	t.Run("Restricted visibility without groups should be rejected", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, publicationURL, models.CreateAgentPublicationRequest{
+			Environment: "Development",
+			Visibility:  models.AgentPublicationVisibilityRestricted,
+		})
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+	})
+
+	t.Run("An invalid A2A agent card should be rejected", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, publicationURL, models.CreateAgentPublicationRequest{
+			Environment:    "Development",
+			DefinitionType: models.AgentDefinitionTypeA2A,
+			Definition:     `{"description": "no name"}`,
+		})
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+		require.Empty(t, apiPlatformClient.CreateAPICalls())
+	})
+
+	t.Run("Registering should create an API from the endpoint schema", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, publicationURL, models.CreateAgentPublicationRequest{
+			Environment:   "Development",
+			Documentation: "Answers support questions",
+			Tags:          []string{"support"},
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var publication models.AgentPublicationResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&publication))
+		require.Equal(t, models.AgentPublicationStatusCreated, publication.LifecycleStatus)
+		require.Equal(t, models.AgentDefinitionTypeOpenAPI, publication.DefinitionType)
+		require.Equal(t, "default", publication.Endpoint)
+		require.Equal(t, apiID, publication.APIID)
+		require.Equal(t, devPortalID, publication.DevPortalID)
+		require.Equal(t, models.AgentPublicationVisibilityPublic, publication.Visibility)
+
+		calls := apiPlatformClient.CreateAPICalls()
+		require.Len(t, calls, 1)
+		require.Equal(t, "http://agent.example.com", calls[0].Req.UpstreamURL)
+		require.Equal(t, fmt.Sprintf("/%s/%s", projName, agentName), calls[0].Req.Context)
+		require.Equal(t, []apiplatformclient.APIOperation{
+			{Method: "POST", Path: "/chat"},
+			{Method: "GET", Path: "/health"},
+		}, calls[0].Req.Operations)
+		require.Len(t, apiPlatformClient.CreateProjectCalls(), 1)
+		require.Empty(t, apiPlatformClient.PublishAPIToDevPortalCalls())
+	})
+
+	t.Run("Registering an agent twice should conflict", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, publicationURL, models.CreateAgentPublicationRequest{Environment: "Development"})
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+		require.Len(t, apiPlatformClient.CreateAPICalls(), 1)
+	})
+
+	t.Run("A created agent cannot be deprecated", func(t *testing.T) {
+		rr := changeLifecycle(t, models.AgentPublicationStatusDeprecated)
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+	})
+
+	t.Run("Publishing should list the agent in the developer portal", func(t *testing.T) {
+		rr := changeLifecycle(t, models.AgentPublicationStatusPublished)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var publication models.AgentPublicationResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&publication))
+		require.Equal(t, models.AgentPublicationStatusPublished, publication.LifecycleStatus)
+		require.NotNil(t, publication.PublishedAt)
+
+		calls := apiPlatformClient.PublishAPIToDevPortalCalls()
+		require.Len(t, calls, 1)
+		require.Equal(t, devPortalID, calls[0].Req.DevPortalID)
+		require.Equal(t, "Answers support questions", calls[0].Req.Description)
+		require.Equal(t, []string{"support"}, calls[0].Req.Tags)
+		statusCalls := apiPlatformClient.UpdateAPILifeCycleStatusCalls()
+		require.Equal(t, apiplatformclient.APILifeCycleStatusPublished, statusCalls[len(statusCalls)-1].Status)
+	})
+
+	t.Run("Updating a published agent should republish it", func(t *testing.T) {
+		documentation := "Answers billing and support questions"
+		rr := apitestutils.SendJSON(t, app, http.MethodPut, publicationURL, models.UpdateAgentPublicationRequest{Documentation: &documentation})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		calls := apiPlatformClient.PublishAPIToDevPortalCalls()
+		require.Len(t, calls, 2)
+		require.Equal(t, documentation, calls[1].Req.Description)
+	})
+
+	t.Run("A published agent cannot be deleted", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodDelete, publicationURL, nil)
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+	})
+
+	t.Run("The catalog should list the agent without its definition", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodGet, fmt.Sprintf("/api/v1/orgs/%s/agent-publications?status=published", orgName), nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var catalog models.AgentPublicationListResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&catalog))
+		require.Len(t, catalog.Publications, 1)
+		require.Equal(t, agentName, catalog.Publications[0].AgentName)
+		require.Empty(t, catalog.Publications[0].Definition)
+
+		rr = apitestutils.SendJSON(t, app, http.MethodGet, fmt.Sprintf("/api/v1/orgs/%s/agent-publications?status=retired", orgName), nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&catalog))
+		require.Empty(t, catalog.Publications)
+	})
+
+	t.Run("Deprecating and retiring should unpublish the agent", func(t *testing.T) {
+		rr := changeLifecycle(t, models.AgentPublicationStatusDeprecated)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		require.Empty(t, apiPlatformClient.UnpublishAPIFromDevPortalCalls())
+
+		rr = changeLifecycle(t, models.AgentPublicationStatusRetired)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		require.Len(t, apiPlatformClient.UnpublishAPIFromDevPortalCalls(), 1)
+
+		rr = changeLifecycle(t, models.AgentPublicationStatusPublished)
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+	})
+
+	t.Run("Deleting a retired agent should delete its API", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodDelete, publicationURL, nil)
+		require.Equal(t, http.StatusNoContent, rr.Code, rr.Body.String())
+		require.Len(t, apiPlatformClient.DeleteAPICalls(), 1)
+
+		rr = apitestutils.SendJSON(t, app, http.MethodGet, publicationURL, nil)
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+	})
+}
@@ -0,0 +1,209 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/clientmocks"
+	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+func TestAgentSLOs(t *testing.T) {
+	orgName := fmt.Sprintf("slo-org-%s", uuid.New().String()[:5])
+	projName := fmt.Sprintf("slo-project-%s", uuid.New().String()[:5])
+	agentName := fmt.Sprintf("slo-agent-%s", uuid.New().String()[:5])
+	windowEnd := time.Now().UTC().Truncate(time.Hour)
+	windowStart := windowEnd.Add(-28 * 24 * time.Hour)
+	compliance := 96.5
+	observed := 2400.0
+	burnRate := 0.7
+
+	traceObserverClient := &clientmocks.TraceObserverClientMock{
+		GetSLOStatusFunc: func(ctx context.Context, params traceobserversvc.SLOParams) (*traceobserversvc.SLOStatusResponse, error) {
+			return &traceobserversvc.SLOStatusResponse{
+				WindowStart:   windowStart,
+				WindowEnd:     windowEnd,
+				TotalCount:    1000,
+				GoodCount:     965,
+				Compliance:    &compliance,
+				ObservedValue: &observed,
+				ErrorBudget:   traceobserversvc.SLOErrorBudget{Allowed: 50, Consumed: 35, Remaining: 0.3},
+				BurnRates:     []traceobserversvc.SLOBurnRate{{Window: "1h", BurnRate: &burnRate}, {Window: "6h"}},
+				Status:        "met",
+			}, nil
+		},
+		GetSLOHistoryFunc: func(ctx context.Context, params traceobserversvc.SLOParams) (*traceobserversvc.SLOHistoryResponse, error) {
+			return &traceobserversvc.SLOHistoryResponse{
+				WindowStart: windowStart,
+				WindowEnd:   windowEnd,
+				Interval:    params.Interval,
+				Points: []traceobserversvc.SLOHistoryPoint{
+					{Time: windowStart, TotalCount: 40, GoodCount: 39, Compliance: &compliance, BudgetRemaining: 0.5},
+				},
+			}, nil
+		},
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{
+		OpenChoreoClient:    apitestutils.CreateMockOpenChoreoClient(),
+		TraceObserverClient: traceObserverClient,
+	}, jwtassertion.NewMockMiddleware(t))
+
+	slosURL := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/slos", orgName, projName, agentName)
+	latencySLO := models.AgentSLORequest{
+		Name:        "p95-latency",
+		Environment: "Development",
+		Indicator:   models.AgentSLOIndicatorLatency,
+		ThresholdMs: 3000,
+		Target:      95,
+	}
+
+	var created models.AgentSLOResponse
+	t.Run("Creating an SLO should default the window to 28 days", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, slosURL, latencySLO)
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&created))
+		require.Equal(t, "p95-latency", created.Name)
+		require.Equal(t, models.AgentSLOIndicatorLatency, created.Indicator)
+		require.Equal(t, 3000.0, created.ThresholdMs)
+		require.Equal(t, 95.0, created.Target)
+		require.Equal(t, 28, created.WindowDays)
+	})
+
+	t.Run("Creating an SLO with a taken name should return 409", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, slosURL, latencySLO)
+		require.Equal(t, http.StatusConflict, rr.Code)
+	})
+
+	t.Run("Creating an invalid SLO should return 400", func(t *testing.T) {
+		for name, req := range map[string]models.AgentSLORequest{
+			"unknown indicator":      {Name: "a", Environment: "Development", Indicator: "throughput", Target: 99},
+			"latency threshold":      {Name: "a", Environment: "Development", Indicator: models.AgentSLOIndicatorLatency, Target: 99},
+			"target of 100":          {Name: "a", Environment: "Development", Indicator: models.AgentSLOIndicatorAvailability, Target: 100},
+			"window longer than 90d": {Name: "a", Environment: "Development", Indicator: models.AgentSLOIndicatorAvailability, Target: 99, WindowDays: 91},
+			"missing environment":    {Name: "a", Indicator: models.AgentSLOIndicatorAvailability, Target: 99},
+		} {
+			rr := apitestutils.SendJSON(t, app, http.MethodPost, slosURL, req)
+			require.Equal(t, http.StatusBadRequest, rr.Code, name)
+		}
+	})
+
+	t.Run("Updating an SLO should replace its objective", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, slosURL, models.AgentSLORequest{
+			Name: "errors", Environment: "Development", Indicator: models.AgentSLOIndicatorAvailability, Target: 99,
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var availability models.AgentSLOResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&availability))
+
+		rr = apitestutils.SendJSON(t, app, http.MethodPut, slosURL+"/"+availability.ID, models.AgentSLORequest{
+			Name: "errors", Environment: "Development", Indicator: models.AgentSLOIndicatorAvailability, Target: 99.9, WindowDays: 7,
+		})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var updated models.AgentSLOResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&updated))
+		require.Equal(t, 99.9, updated.Target)
+		require.Equal(t, 7, updated.WindowDays)
+
+		rr = apitestutils.SendJSON(t, app, http.MethodPut, slosURL+"/"+availability.ID, latencySLO)
+		require.Equal(t, http.StatusConflict, rr.Code)
+
+		rr = apitestutils.SendJSON(t, app, http.MethodGet, slosURL, nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var list models.AgentSLOListResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&list))
+		require.Len(t, list.SLOs, 2)
+		require.Equal(t, "errors", list.SLOs[0].Name)
+		require.Equal(t, "p95-latency", list.SLOs[1].Name)
+	})
+
+	t.Run("Getting the status of an SLO should compute it over its window", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodGet, slosURL+"/"+created.ID+"/status", nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var response models.AgentSLOStatusResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Equal(t, created.ID, response.SLO.ID)
+		require.Equal(t, int64(965), response.GoodCount)
+		require.Equal(t, compliance, *response.Compliance)
+		require.Equal(t, observed, *response.ObservedValue)
+		require.Equal(t, 0.3, response.ErrorBudget.Remaining)
+		require.Len(t, response.BurnRates, 2)
+		require.Nil(t, response.BurnRates[1].BurnRate)
+		require.Equal(t, "met", response.Status)
+
+		calls := traceObserverClient.GetSLOStatusCalls()
+		require.Len(t, calls, 1)
+		require.Equal(t, traceobserversvc.SLOParams{
+			ComponentUid:   "component-uid-123",
+			EnvironmentUid: "environment-uid-123",
+			Indicator:      models.AgentSLOIndicatorLatency,
+			ThresholdMs:    3000,
+			Target:         95,
+			WindowDays:     28,
+		}, calls[0].Params)
+	})
+
+	t.Run("Getting the history of an SLO should pass the interval", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodGet, slosURL+"/"+created.ID+"/history?interval=6h", nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var response models.AgentSLOHistoryResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Equal(t, "6h", response.Interval)
+		require.Len(t, response.Points, 1)
+		require.Equal(t, int64(39), response.Points[0].GoodCount)
+
+		rr = apitestutils.SendJSON(t, app, http.MethodGet, slosURL+"/"+created.ID+"/history", nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		calls := traceObserverClient.GetSLOHistoryCalls()
+		require.Len(t, calls, 2)
+		require.Equal(t, "6h", calls[0].Params.Interval)
+		require.Equal(t, "24h", calls[1].Params.Interval)
+	})
+
+	t.Run("Getting the history with an invalid interval should return 400", func(t *testing.T) {
+		for _, interval := range []string{"90m", "30m", "weekly", "700h"} {
+			rr := apitestutils.SendJSON(t, app, http.MethodGet, slosURL+"/"+created.ID+"/history?interval="+interval, nil)
+			require.Equal(t, http.StatusBadRequest, rr.Code, interval)
+		}
+		require.Len(t, traceObserverClient.GetSLOHistoryCalls(), 2)
+	})
+
+	t.Run("Deleting an SLO should remove it", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodDelete, slosURL+"/"+created.ID, nil)
+		require.Equal(t, http.StatusNoContent, rr.Code)
+
+		rr = apitestutils.SendJSON(t, app, http.MethodGet, slosURL+"/"+created.ID, nil)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+		rr = apitestutils.SendJSON(t, app, http.MethodGet, slosURL+"/"+created.ID+"/status", nil)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+		rr = apitestutils.SendJSON(t, app, http.MethodGet, slosURL+"/not-a-uuid", nil)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
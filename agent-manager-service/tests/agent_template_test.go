@@ -0,0 +1,81 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+func TestAgentTemplates(t *testing.T) {
+	authMiddleware := jwtassertion.NewMockMiddleware(t)
+	testClients := wiring.TestClients{
+		OpenChoreoClient: apitestutils.CreateMockOpenChoreoClient(),
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+	t.Run("Listing agent templates should return the supported runtimes", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodGet, "/api/v1/orgs/default/agent-templates", nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var templates []utils.AgentTemplate
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &templates))
+		names := make([]string, len(templates))
+		for i, template := range templates {
+			names[i] = template.Name
+		}
+		require.ElementsMatch(t, []string{"langgraph-python", "crewai-python", "langchain-js"}, names)
+	})
+
+	t.Run("Scaffolding from an unknown template should return 404", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, "/api/v1/orgs/default/agent-templates/unknown/scaffold", models.ScaffoldAgentRepositoryRequest{
+			Owner: "acme",
+			Name:  "my-agent",
+		})
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+	})
+
+	t.Run("Scaffolding with an invalid request should return 400", func(t *testing.T) {
+		testCases := []struct {
+			name string
+			req  models.ScaffoldAgentRepositoryRequest
+		}{
+			{name: "missing owner", req: models.ScaffoldAgentRepositoryRequest{Name: "my-agent"}},
+			{name: "invalid repository name", req: models.ScaffoldAgentRepositoryRequest{Owner: "acme", Name: "my agent"}},
+			{name: "reserved secret name", req: models.ScaffoldAgentRepositoryRequest{
+				Owner:   "acme",
+				Name:    "my-agent",
+				Secrets: map[string]string{"GITHUB_TOKEN": "value"},
+			}},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				rr := apitestutils.SendJSON(t, app, http.MethodPost, "/api/v1/orgs/default/agent-templates/langgraph-python/scaffold", tc.req)
+				require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+			})
+		}
+	})
+}
@@ -17,9 +17,16 @@
 package apitestutils
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
 
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/api"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
@@ -43,3 +50,38 @@ func MakeAppClientWithDeps(t *testing.T, testClients wiring.TestClients, authMid
 	// Return the handler instance
 	return handler
 }
+
+// NewPlatformAdminMiddleware creates a mock JWT middleware whose token also carries the platform admin
+// scope, for tests that create, suspend or delete organizations
+func NewPlatformAdminMiddleware(t *testing.T) jwtassertion.Middleware {
+	t.Helper()
+
+	return jwtassertion.NewMockMiddlewareWithClaims(t, &jwtassertion.TokenClaims{
+		Scope: "scopes " + config.GetConfig().PlatformAdminScope,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	})
+}
+
+// NewJSONRequest creates a request to the app with body, if not nil, encoded as JSON
+func NewJSONRequest(t *testing.T, method, url string, body any) *http.Request {
+	t.Helper()
+
+	var reqBody bytes.Buffer
+	if body != nil {
+		require.NoError(t, json.NewEncoder(&reqBody).Encode(body))
+	}
+	req := httptest.NewRequest(method, url, &reqBody)
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+// SendJSON serves a request with body, if not nil, encoded as JSON and returns the recorded response
+func SendJSON(t *testing.T, app http.Handler, method, url string, body any) *httptest.ResponseRecorder {
+	t.Helper()
+
+	rr := httptest.NewRecorder()
+	app.ServeHTTP(rr, NewJSONRequest(t, method, url, body))
+	return rr
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	apiplatformclient "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/apiplatformsvc/client"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+func TestApplyOrganizationGateways(t *testing.T) {
+	ownerOrgName := fmt.Sprintf("apply-owner-%s", uuid.New().String()[:5])
+	otherOrgName := fmt.Sprintf("apply-other-%s", uuid.New().String()[:5])
+	apiPlatformClient := apiplatformclient.NewInMemoryAPIPlatformClient()
+	testClients := wiring.TestClients{
+		OpenChoreoClient:  apitestutils.CreateMockOpenChoreoClient(),
+		APIPlatformClient: apiPlatformClient,
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, testClients, apitestutils.NewPlatformAdminMiddleware(t))
+
+	apply := func(orgName, query string, manifest models.ApplyManifest) models.ApplyPlan {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, fmt.Sprintf("/api/v1/orgs/%s/apply?%s", orgName, query), manifest)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var plan models.ApplyPlan
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &plan))
+		return plan
+	}
+	gatewayChanges := func(plan models.ApplyPlan) map[string]models.ApplyAction {
+		changes := make(map[string]models.ApplyAction)
+		for _, change := range plan.Changes {
+			if change.ResourceType == models.ApplyResourceTypeGateway {
+				changes[change.Name] = change.Action
+			}
+		}
+		return changes
+	}
+	for _, orgName := range []string{ownerOrgName, otherOrgName} {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, "/api/v1/orgs", models.CreateOrganizationRequest{Name: orgName})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	}
+
+	rr := apitestutils.SendJSON(t, app, http.MethodPost, fmt.Sprintf("/api/v1/orgs/%s/gateways", ownerOrgName), spec.CreateGatewayRequest{
+		Name: "owner-gw", DisplayName: "Owner", GatewayType: spec.AI, Vhost: "owner.example.com",
+	})
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+	t.Run("Gateways created by apply are managed by later applies", func(t *testing.T) {
+		manifest := models.ApplyManifest{
+			Gateways: []models.GatewayManifest{{Name: "applied-gw", DisplayName: "Applied", Vhost: "applied.example.com"}},
+		}
+		plan := apply(ownerOrgName, "", manifest)
+		require.Equal(t, models.ApplyActionCreate, gatewayChanges(plan)["applied-gw"])
+
+		plan = apply(ownerOrgName, "dryRun=true", manifest)
+		require.Equal(t, models.ApplyActionNoop, gatewayChanges(plan)["applied-gw"])
+	})
+
+	t.Run("Pruning does not delete the gateways of other organizations", func(t *testing.T) {
+		plan := apply(otherOrgName, "dryRun=true&prune=true", models.ApplyManifest{})
+		require.Empty(t, gatewayChanges(plan))
+
+		plan = apply(ownerOrgName, "dryRun=true&prune=true", models.ApplyManifest{})
+		require.Equal(t, map[string]models.ApplyAction{
+			"owner-gw":   models.ApplyActionDelete,
+			"applied-gw": models.ApplyActionDelete,
+		}, gatewayChanges(plan))
+	})
+
+	t.Run("Gateways of other organizations still reserve their vhost", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, fmt.Sprintf("/api/v1/orgs/%s/apply", otherOrgName), models.ApplyManifest{
+			Gateways: []models.GatewayManifest{{Name: "other-gw", DisplayName: "Other", Vhost: "owner.example.com"}},
+		})
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+		require.Contains(t, rr.Body.String(), "GATEWAY_VHOST_CONFLICT")
+	})
+
+	t.Run("Gateways registered before their organization was recorded belong to the organization they serve", func(t *testing.T) {
+		created, err := apiPlatformClient.CreateGateway(context.Background(), apiplatformclient.CreateGatewayRequest{
+			Name: "unowned-gw", DisplayName: "Unowned", Vhost: "unowned.example.com", FunctionalityType: apiplatformclient.FunctionalityTypeAI,
+		})
+		require.NoError(t, err)
+
+		// Without environments the gateway belongs to no organization and is left alone
+		plan := apply(ownerOrgName, "dryRun=true&prune=true", models.ApplyManifest{})
+		require.NotContains(t, gatewayChanges(plan), "unowned-gw")
+
+		var env models.Environment
+		require.NoError(t, db.DB(context.Background()).Where("organization_name = ?", ownerOrgName).First(&env).Error)
+		require.NoError(t, db.DB(context.Background()).Create(&models.GatewayEnvironmentMapping{
+			GatewayUUID: uuid.MustParse(created.ID), EnvironmentUUID: env.UUID, CreatedAt: time.Now(),
+		}).Error)
+
+		plan = apply(ownerOrgName, "dryRun=true&prune=true", models.ApplyManifest{})
+		require.Equal(t, models.ApplyActionDelete, gatewayChanges(plan)["unowned-gw"])
+		plan = apply(otherOrgName, "dryRun=true&prune=true", models.ApplyManifest{})
+		require.Empty(t, gatewayChanges(plan))
+	})
+}
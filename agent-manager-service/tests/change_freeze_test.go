@@ -0,0 +1,240 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	apiplatformclient "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/apiplatformsvc/client"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+var (
+	freezeTestOrgName   = fmt.Sprintf("freeze-test-org-%s", uuid.New().String()[:5])
+	freezeTestProjName  = fmt.Sprintf("freeze-test-project-%s", uuid.New().String()[:5])
+	freezeTestAgentName = fmt.Sprintf("freeze-test-agent-%s", uuid.New().String()[:5])
+)
+
+func TestChangeFreezes(t *testing.T) {
+	// Bulk token rotations need the credential encryption key
+	cfg := config.GetConfig()
+	previousKey := cfg.CredentialsEncryptionKey
+	cfg.CredentialsEncryptionKey = base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{7}, 32))
+	t.Cleanup(func() { cfg.CredentialsEncryptionKey = previousKey })
+
+	openChoreoClient := apitestutils.CreateMockOpenChoreoClient()
+	openChoreoClient.ComponentExistsFunc = func(ctx context.Context, orgName string, projName string, agentName string, verifyProject bool) (bool, error) {
+		return true, nil
+	}
+	testClients := wiring.TestClients{
+		OpenChoreoClient:  openChoreoClient,
+		APIPlatformClient: apiplatformclient.NewInMemoryAPIPlatformClient(),
+	}
+	newApp := func(scope string) http.Handler {
+		return apitestutils.MakeAppClientWithDeps(t, testClients, jwtassertion.NewMockMiddlewareWithClaims(t, &jwtassertion.TokenClaims{
+			Scope: scope,
+			RegisteredClaims: jwt.RegisteredClaims{
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+		}))
+	}
+	app := newApp("scopes " + cfg.PlatformAdminScope + " " + cfg.ChangeFreeze.AdminScope)
+
+	sendBreakGlass := func(method, url string, body any, reason string) *httptest.ResponseRecorder {
+		req := apitestutils.NewJSONRequest(t, method, url, body)
+		req.Header.Set(utils.HeaderBreakGlassReason, reason)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		return rr
+	}
+	orgURL := fmt.Sprintf("/api/v1/orgs/%s", freezeTestOrgName)
+	freezesURL := orgURL + "/change-freezes"
+	deploymentsURL := fmt.Sprintf("%s/projects/%s/agents/%s/deployments", orgURL, freezeTestProjName, freezeTestAgentName)
+	deployment := map[string]any{"imageId": "registry.example.com/myapp:v1.0.0"}
+
+	rr := apitestutils.SendJSON(t, app, http.MethodPost, "/api/v1/orgs", models.CreateOrganizationRequest{Name: freezeTestOrgName})
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	rr = apitestutils.SendJSON(t, app, http.MethodPost, orgURL+"/gateways", spec.CreateGatewayRequest{
+		Name: "freeze-gw", DisplayName: "Freeze", GatewayType: spec.AI, Vhost: "freeze.example.com",
+	})
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	var gateway models.GatewayResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &gateway))
+
+	now := time.Now()
+	t.Run("Windows must end after they start and in the future", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, freezesURL, map[string]any{
+			"name": "Backwards", "startTime": now.Add(time.Hour), "endTime": now,
+		})
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+
+		rr = apitestutils.SendJSON(t, app, http.MethodPost, freezesURL, map[string]any{
+			"name": "Past", "startTime": now.Add(-2 * time.Hour), "endTime": now.Add(-time.Hour),
+		})
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+	})
+
+	t.Run("A future window does not block changes", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, freezesURL, map[string]any{
+			"name": "Next quarter", "startTime": now.Add(24 * time.Hour), "endTime": now.Add(48 * time.Hour),
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+		rr = apitestutils.SendJSON(t, app, http.MethodPost, deploymentsURL, deployment)
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+		require.Len(t, openChoreoClient.DeployCalls(), 1)
+	})
+
+	var window models.ChangeFreezeWindowResponse
+	t.Run("An active window is listed as active", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, freezesURL, map[string]any{
+			"name": "Black Friday week", "reason": "Peak traffic", "startTime": now.Add(-time.Hour), "endTime": now.Add(time.Hour),
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &window))
+		require.True(t, window.Active)
+
+		rr = apitestutils.SendJSON(t, app, http.MethodGet, freezesURL, nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var list models.ChangeFreezeWindowListResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &list))
+		require.Len(t, list.Windows, 2)
+		require.Equal(t, window.ID, list.Windows[0].ID)
+		require.False(t, list.Windows[1].Active)
+	})
+
+	t.Run("Members without the change freeze admin scope cannot change windows", func(t *testing.T) {
+		member := newApp("scopes")
+		rr := apitestutils.SendJSON(t, member, http.MethodPost, freezesURL, map[string]any{
+			"name": "Sneaky", "startTime": now.Add(time.Hour), "endTime": now.Add(2 * time.Hour),
+		})
+		require.Equal(t, http.StatusForbidden, rr.Code, rr.Body.String())
+		require.Contains(t, rr.Body.String(), "NOT_CHANGE_FREEZE_ADMIN")
+
+		rr = apitestutils.SendJSON(t, member, http.MethodDelete, freezesURL+"/"+window.ID, nil)
+		require.Equal(t, http.StatusForbidden, rr.Code, rr.Body.String())
+		require.Contains(t, rr.Body.String(), "NOT_CHANGE_FREEZE_ADMIN")
+
+		rr = apitestutils.SendJSON(t, member, http.MethodGet, freezesURL, nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var list models.ChangeFreezeWindowListResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &list))
+		require.Len(t, list.Windows, 2)
+	})
+
+	t.Run("Changes are blocked during a freeze", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, deploymentsURL, deployment)
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+		require.Contains(t, rr.Body.String(), "CHANGE_FREEZE_ACTIVE")
+		require.Len(t, openChoreoClient.DeployCalls(), 1)
+
+		rr = apitestutils.SendJSON(t, app, http.MethodPost, orgURL+"/gateways/"+gateway.UUID+"/tokens", nil)
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+
+		rr = apitestutils.SendJSON(t, app, http.MethodPost, orgURL+"/gateway-bulk-operations", models.CreateGatewayBulkOperationRequest{
+			Action: models.GatewayBulkActionRotateToken, GatewayIDs: []string{gateway.UUID},
+		})
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+
+		rr = apitestutils.SendJSON(t, app, http.MethodDelete, orgURL+"/gateways/"+gateway.UUID+"/tokens/"+uuid.New().String(), nil)
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+
+		rr = apitestutils.SendJSON(t, app, http.MethodDelete, fmt.Sprintf("%s/projects/%s/agents/%s", orgURL, freezeTestProjName, freezeTestAgentName), nil)
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+		require.Empty(t, openChoreoClient.DeleteComponentCalls())
+	})
+
+	t.Run("Break-glass changes are made and audited", func(t *testing.T) {
+		rr := sendBreakGlass(http.MethodPost, deploymentsURL, deployment, "Hotfix for checkout outage")
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+		require.Len(t, openChoreoClient.DeployCalls(), 2)
+
+		rr = sendBreakGlass(http.MethodPost, orgURL+"/gateways/"+gateway.UUID+"/tokens", nil, "Leaked token")
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		rr = apitestutils.SendJSON(t, app, http.MethodGet, freezesURL+"/"+window.ID+"/events", nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var events models.ResourceEventListResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &events))
+		require.Equal(t, int64(2), events.Total)
+		require.Equal(t, models.ResourceEventBreakGlass, events.Events[0].Type)
+		require.Equal(t, models.ChangeOperationTokenRotation, events.Events[0].Details["operation"])
+		require.Equal(t, "Leaked token", events.Events[0].Details["reason"])
+		require.Equal(t, models.ChangeOperationDeploy, events.Events[1].Details["operation"])
+		require.Equal(t, freezeTestProjName+"/"+freezeTestAgentName, events.Events[1].Details["resource"])
+		require.Equal(t, "Hotfix for checkout outage", events.Events[1].Details["reason"])
+	})
+
+	t.Run("Deleting a window lifts the freeze", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodDelete, freezesURL+"/"+window.ID, nil)
+		require.Equal(t, http.StatusNoContent, rr.Code, rr.Body.String())
+
+		rr = apitestutils.SendJSON(t, app, http.MethodDelete, freezesURL+"/"+window.ID, nil)
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+
+		rr = apitestutils.SendJSON(t, app, http.MethodPost, deploymentsURL, deployment)
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+		require.Len(t, openChoreoClient.DeployCalls(), 3)
+	})
+}
+
+// failingEventService fails every event write
+type failingEventService struct {
+	services.ResourceEventService
+}
+
+func (failingEventService) AppendEvent(ctx context.Context, orgName, resourceType, resourceID, eventType, actor string, details map[string]interface{}) error {
+	return errors.New("event store unavailable")
+}
+
+func TestChangeFreezeOverrideNeedsAudit(t *testing.T) {
+	ctx := context.Background()
+	orgName := fmt.Sprintf("freeze-audit-org-%s", uuid.New().String()[:5])
+	freezeService := services.NewChangeFreezeService(slog.Default(), failingEventService{})
+
+	window, err := freezeService.CreateWindow(ctx, orgName, "admin", &models.CreateChangeFreezeWindowRequest{
+		Name: "Release week", StartTime: time.Now().Add(-time.Hour), EndTime: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = freezeService.DeleteWindow(ctx, orgName, window.ID) })
+
+	err = freezeService.CheckChangeAllowed(ctx, orgName, models.ChangeOperationDeploy, "project/agent", "user", "Hotfix")
+	require.Error(t, err)
+	require.NotErrorIs(t, err, utils.ErrChangeFreezeActive)
+	require.ErrorContains(t, err, "failed to audit change freeze override")
+}
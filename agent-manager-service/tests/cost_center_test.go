@@ -0,0 +1,148 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/clientmocks"
+	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+var costCenterOrgName = fmt.Sprintf("cost-center-org-%s", uuid.New().String()[:5])
+
+func TestCostCenters(t *testing.T) {
+	authMiddleware := jwtassertion.NewMockMiddleware(t)
+	cost := 1.5
+	// Each agent calls an OpenAI model and an Anthropic model
+	traceObserverClient := &clientmocks.TraceObserverClientMock{
+		GetModelUsageFunc: func(ctx context.Context, params traceobserversvc.ModelUsageParams) (*traceobserversvc.ModelUsageResponse, error) {
+			return &traceobserversvc.ModelUsageResponse{
+				Models: []traceobserversvc.ModelUsage{
+					{Model: "gpt-4o", Provider: "OpenAI", UsageStats: traceobserversvc.UsageStats{RequestCount: 2, TotalTokens: 100, EstimatedCost: &cost}},
+					{Model: "claude", Provider: "anthropic", UsageStats: traceobserversvc.UsageStats{RequestCount: 1, TotalTokens: 50}},
+				},
+			}, nil
+		},
+	}
+	openChoreoClient := apitestutils.CreateMockOpenChoreoClient()
+	openChoreoClient.ListProjectsFunc = func(ctx context.Context, namespaceName string) ([]*models.ProjectResponse, error) {
+		return []*models.ProjectResponse{{Name: "shop"}}, nil
+	}
+	openChoreoClient.ListComponentsFunc = func(ctx context.Context, namespaceName, projectName string) ([]*models.AgentResponse, error) {
+		return []*models.AgentResponse{{UUID: "checkout-uid", Name: "checkout"}, {UUID: "support-uid", Name: "support"}}, nil
+	}
+	testClients := wiring.TestClients{
+		OpenChoreoClient:    openChoreoClient,
+		TraceObserverClient: traceObserverClient,
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+	baseURL := fmt.Sprintf("/api/v1/orgs/%s/cost-centers", costCenterOrgName)
+	setCostCenter := func(t *testing.T, req models.CostCenterMappingRequest) models.CostCenterMappingResponse {
+		rr := apitestutils.SendJSON(t, app, http.MethodPut, baseURL+"/mappings", req)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var mapping models.CostCenterMappingResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&mapping))
+		return mapping
+	}
+
+	t.Run("Mapping a resource again should replace its cost center", func(t *testing.T) {
+		first := setCostCenter(t, models.CostCenterMappingRequest{ResourceType: models.CostCenterResourceAgent, ProjectName: "shop", ResourceName: "checkout", CostCenter: "sales"})
+		second := setCostCenter(t, models.CostCenterMappingRequest{ResourceType: models.CostCenterResourceAgent, ProjectName: "shop", ResourceName: "checkout", CostCenter: "commerce"})
+		require.Equal(t, first.ID, second.ID)
+		require.Equal(t, "commerce", second.CostCenter)
+
+		provider := setCostCenter(t, models.CostCenterMappingRequest{ResourceType: models.CostCenterResourceProvider, ResourceName: "openai", CostCenter: "platform"})
+		require.Empty(t, provider.ProjectName)
+
+		rr := apitestutils.SendJSON(t, app, http.MethodGet, baseURL+"/mappings", nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var list models.CostCenterMappingListResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&list))
+		require.Len(t, list.Mappings, 2)
+	})
+
+	t.Run("Usage should be grouped by the cost center of the agent, then of the provider", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodGet, baseURL+"/usage?environment=Development", nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var usage models.CostCenterUsageResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&usage))
+		require.Len(t, usage.CostCenters, 2)
+
+		commerce := usage.CostCenters[0]
+		require.Equal(t, "commerce", commerce.CostCenter)
+		require.Equal(t, []string{"shop/checkout"}, commerce.Agents)
+		require.Equal(t, 150, commerce.TotalTokens)
+		require.InDelta(t, 1.5, *commerce.EstimatedCost, 1e-9)
+
+		platform := usage.CostCenters[1]
+		require.Equal(t, "platform", platform.CostCenter)
+		require.Equal(t, []string{"shop/support"}, platform.Agents)
+		require.Equal(t, 100, platform.TotalTokens)
+
+		require.Equal(t, []string{"shop/support"}, usage.Unassigned.Agents)
+		require.Equal(t, 50, usage.Unassigned.TotalTokens)
+		require.Nil(t, usage.Unassigned.EstimatedCost)
+	})
+
+	t.Run("Mapping an agent without a project should return 400", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPut, baseURL+"/mappings", models.CostCenterMappingRequest{ResourceType: models.CostCenterResourceAgent, ResourceName: "checkout", CostCenter: "sales"})
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+		require.Contains(t, rr.Body.String(), "projectName is required")
+
+		rr = apitestutils.SendJSON(t, app, http.MethodPut, baseURL+"/mappings", models.CostCenterMappingRequest{ResourceType: "team", ResourceName: "checkout", CostCenter: "sales"})
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+	})
+
+	t.Run("Mapping an unknown agent should return 404", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPut, baseURL+"/mappings", models.CostCenterMappingRequest{ResourceType: models.CostCenterResourceAgent, ProjectName: "shop", ResourceName: "nonexistent-agent", CostCenter: "sales"})
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+	})
+
+	t.Run("Deleting a mapping should charge the usage elsewhere", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodGet, baseURL+"/mappings", nil)
+		var list models.CostCenterMappingListResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&list))
+		for _, mapping := range list.Mappings {
+			rr := apitestutils.SendJSON(t, app, http.MethodDelete, baseURL+"/mappings/"+mapping.ID, nil)
+			require.Equal(t, http.StatusNoContent, rr.Code, rr.Body.String())
+		}
+
+		rr = apitestutils.SendJSON(t, app, http.MethodDelete, baseURL+"/mappings/"+list.Mappings[0].ID, nil)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+		require.Contains(t, rr.Body.String(), "COST_CENTER_MAPPING_NOT_FOUND")
+
+		rr = apitestutils.SendJSON(t, app, http.MethodGet, baseURL+"/usage?environment=Development", nil)
+		var usage models.CostCenterUsageResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&usage))
+		require.Empty(t, usage.CostCenters)
+		require.Equal(t, 300, usage.Unassigned.TotalTokens)
+	})
+}
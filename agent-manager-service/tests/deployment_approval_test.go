@@ -0,0 +1,294 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+var (
+	approvalTestOrgName   = fmt.Sprintf("approval-test-org-%s", uuid.New().String()[:5])
+	approvalTestProjName  = fmt.Sprintf("approval-test-project-%s", uuid.New().String()[:5])
+	approvalTestAgentName = fmt.Sprintf("approval-test-agent-%s", uuid.New().String()[:5])
+)
+
+func TestDeploymentApprovals(t *testing.T) {
+	notifications := make(chan map[string]interface{}, 10)
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var notification map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&notification)
+		notifications <- notification
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer webhook.Close()
+
+	cfg := config.GetConfig()
+	previousApproval := cfg.DeploymentApproval
+	cfg.DeploymentApproval.WebhookURL = webhook.URL
+	t.Cleanup(func() { cfg.DeploymentApproval = previousApproval })
+
+	production := true
+	openChoreoClient := apitestutils.CreateMockOpenChoreoClient()
+	openChoreoClient.ComponentExistsFunc = func(ctx context.Context, orgName string, projName string, agentName string, verifyProject bool) (bool, error) {
+		return true, nil
+	}
+	openChoreoClient.GetEnvironmentFunc = func(ctx context.Context, namespaceName, environmentName string) (*models.EnvironmentResponse, error) {
+		return &models.EnvironmentResponse{UUID: "environment-uid-123", Name: environmentName, IsProduction: production}, nil
+	}
+	testClients := wiring.TestClients{OpenChoreoClient: openChoreoClient}
+	appFor := func(subject, scope string) http.Handler {
+		return apitestutils.MakeAppClientWithDeps(t, testClients, jwtassertion.NewMockMiddlewareWithClaims(t, &jwtassertion.TokenClaims{
+			Sub:   subject,
+			Scope: scope,
+			RegisteredClaims: jwt.RegisteredClaims{
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+		}))
+	}
+	developer := appFor("developer", "scopes")
+	reviewer := appFor("release-manager", "scopes")
+	outsider := appFor("intern", "scopes")
+	admin := appFor("platform-admin", "scopes "+cfg.DeploymentApproval.AdminScope)
+
+	approvalsURL := fmt.Sprintf("/api/v1/orgs/%s/deployment-approvals", approvalTestOrgName)
+	deploymentsURL := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/deployments",
+		approvalTestOrgName, approvalTestProjName, approvalTestAgentName)
+	deploy := func(imageID string) spec.DeploymentResponse {
+		rr := apitestutils.SendJSON(t, developer, http.MethodPost, deploymentsURL, map[string]interface{}{
+			"imageId": imageID,
+			"env":     []map[string]interface{}{{"key": "LOG_LEVEL", "value": "INFO"}},
+		})
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+		var response spec.DeploymentResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		return response
+	}
+	review := func(app http.Handler, approvalID, action string, body interface{}) *httptest.ResponseRecorder {
+		return apitestutils.SendJSON(t, app, http.MethodPost, approvalsURL+"/"+approvalID+"/"+action, body)
+	}
+	// Notifications are sent in the background, so those of one request may arrive in any order
+	nextNotifications := func(count int) map[string]map[string]interface{} {
+		received := make(map[string]map[string]interface{})
+		for len(received) < count {
+			select {
+			case notification := <-notifications:
+				received[notification["event"].(string)] = notification
+			case <-time.After(5 * time.Second):
+				t.Fatalf("%d of %d deployment approval notifications were sent", len(received), count)
+			}
+		}
+		return received
+	}
+
+	t.Run("Deployments proceed without approval when the organization has no policy", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, developer, http.MethodGet, approvalsURL+"/policy", nil)
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+
+		response := deploy("registry.example.com/myapp:v1.0.0")
+		require.False(t, response.HasApprovalId())
+		require.Len(t, openChoreoClient.DeployCalls(), 1)
+	})
+
+	t.Run("Setting the policy validates the reviewers", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, admin, http.MethodPut, approvalsURL+"/policy", map[string]interface{}{"reviewers": []string{}})
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+
+		rr = apitestutils.SendJSON(t, admin, http.MethodPut, approvalsURL+"/policy", map[string]interface{}{"reviewers": []string{"release-manager", "developer"}})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var policy models.DeploymentApprovalPolicyResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &policy))
+		require.Equal(t, []string{"release-manager", "developer"}, policy.Reviewers)
+		require.Equal(t, "platform-admin", policy.UpdatedBy)
+	})
+
+	t.Run("Only admins can change the policy", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, developer, http.MethodPut, approvalsURL+"/policy", map[string]interface{}{"reviewers": []string{"developer"}})
+		require.Equal(t, http.StatusForbidden, rr.Code, rr.Body.String())
+		require.Contains(t, rr.Body.String(), "NOT_DEPLOYMENT_APPROVAL_ADMIN")
+		rr = apitestutils.SendJSON(t, reviewer, http.MethodDelete, approvalsURL+"/policy", nil)
+		require.Equal(t, http.StatusForbidden, rr.Code, rr.Body.String())
+	})
+
+	var approvalID string
+	t.Run("A production deployment waits for approval", func(t *testing.T) {
+		response := deploy("registry.example.com/myapp:v2.0.0")
+		require.True(t, response.HasApprovalId())
+		require.Equal(t, "Development", response.Environment)
+		require.Len(t, openChoreoClient.DeployCalls(), 1, "the deployment must not reach OpenChoreo before it is approved")
+		approvalID = response.GetApprovalId()
+
+		notification := nextNotifications(1)[models.ResourceEventApprovalRequested]
+		require.NotNil(t, notification)
+		require.Equal(t, approvalID, notification["approvalId"])
+		require.Equal(t, "developer", notification["requestedBy"])
+		require.NotContains(t, notification, "env")
+
+		rr := apitestutils.SendJSON(t, reviewer, http.MethodGet, approvalsURL+"?status=pending", nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var list models.DeploymentApprovalListResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &list))
+		require.Len(t, list.Approvals, 1)
+		require.Equal(t, approvalID, list.Approvals[0].ID)
+		require.Equal(t, "registry.example.com/myapp:v2.0.0", list.Approvals[0].ImageID)
+		require.Equal(t, []models.EnvVars{{Key: "LOG_LEVEL", Value: "INFO"}}, list.Approvals[0].Env)
+	})
+
+	t.Run("Only other designated reviewers can review a deployment", func(t *testing.T) {
+		rr := review(developer, approvalID, "approve", nil)
+		require.Equal(t, http.StatusForbidden, rr.Code, rr.Body.String())
+		require.Contains(t, rr.Body.String(), "DEPLOYMENT_SELF_APPROVAL")
+
+		rr = review(outsider, approvalID, "approve", nil)
+		require.Equal(t, http.StatusForbidden, rr.Code, rr.Body.String())
+		require.Contains(t, rr.Body.String(), "NOT_DEPLOYMENT_APPROVER")
+		require.Len(t, openChoreoClient.DeployCalls(), 1)
+	})
+
+	t.Run("A requester cannot get their deployment approved by editing the policy", func(t *testing.T) {
+		// Making an accomplice the only reviewer is refused, so the accomplice still cannot approve
+		rr := apitestutils.SendJSON(t, developer, http.MethodPut, approvalsURL+"/policy", map[string]interface{}{"reviewers": []string{"intern"}})
+		require.Equal(t, http.StatusForbidden, rr.Code, rr.Body.String())
+		rr = review(outsider, approvalID, "approve", nil)
+		require.Equal(t, http.StatusForbidden, rr.Code, rr.Body.String())
+		require.Contains(t, rr.Body.String(), "NOT_DEPLOYMENT_APPROVER")
+
+		rr = apitestutils.SendJSON(t, developer, http.MethodDelete, approvalsURL+"/policy", nil)
+		require.Equal(t, http.StatusForbidden, rr.Code, rr.Body.String())
+
+		rr = apitestutils.SendJSON(t, developer, http.MethodGet, approvalsURL+"/policy", nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var policy models.DeploymentApprovalPolicyResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &policy))
+		require.Equal(t, []string{"release-manager", "developer"}, policy.Reviewers)
+		require.Len(t, openChoreoClient.DeployCalls(), 1)
+	})
+
+	t.Run("An approved deployment is deployed", func(t *testing.T) {
+		rr := review(reviewer, approvalID, "approve", map[string]interface{}{"comment": "Release 2.0"})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var approval models.DeploymentApprovalResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &approval))
+		require.Equal(t, models.DeploymentApprovalStatusApproved, approval.Status)
+		require.Equal(t, "release-manager", approval.ReviewedBy)
+		require.Equal(t, "Release 2.0", approval.ReviewComment)
+		require.NotNil(t, approval.Revision)
+		require.Equal(t, 2, *approval.Revision)
+
+		require.Len(t, openChoreoClient.DeployCalls(), 2)
+		deployCall := openChoreoClient.DeployCalls()[1]
+		require.Equal(t, "registry.example.com/myapp:v2.0.0", deployCall.Req.ImageID)
+		require.Equal(t, "LOG_LEVEL", deployCall.Req.Env[0].Key)
+
+		received := nextNotifications(2)
+		require.Contains(t, received, models.ResourceEventApproved)
+		require.Contains(t, received, models.ResourceEventDeployed)
+
+		rr = review(reviewer, approvalID, "reject", nil)
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+	})
+
+	t.Run("The review is recorded on the approval's timeline", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, reviewer, http.MethodGet, approvalsURL+"/"+approvalID+"/events", nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var events models.ResourceEventListResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &events))
+		require.Len(t, events.Events, 3)
+		types := map[string]string{}
+		for _, event := range events.Events {
+			types[event.Type] = event.Actor
+		}
+		require.Equal(t, map[string]string{
+			models.ResourceEventApprovalRequested: "developer",
+			models.ResourceEventApproved:          "release-manager",
+			models.ResourceEventDeployed:          "release-manager",
+		}, types)
+	})
+
+	t.Run("A rejected deployment is not deployed", func(t *testing.T) {
+		response := deploy("registry.example.com/myapp:v3.0.0")
+		require.True(t, response.HasApprovalId())
+		nextNotifications(1)
+
+		rr := review(reviewer, response.GetApprovalId(), "reject", map[string]interface{}{"comment": "Not during the sale"})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var approval models.DeploymentApprovalResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &approval))
+		require.Equal(t, models.DeploymentApprovalStatusRejected, approval.Status)
+		require.Nil(t, approval.Revision)
+		require.Len(t, openChoreoClient.DeployCalls(), 2)
+
+		notification := nextNotifications(1)[models.ResourceEventRejected]
+		require.NotNil(t, notification)
+		require.Equal(t, "Not during the sale", notification["reviewComment"])
+	})
+
+	t.Run("A rollback to production waits for approval", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, developer, http.MethodPost, deploymentsURL+"/revisions/1/rollback", nil)
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+		var approval models.DeploymentApprovalResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &approval))
+		require.Equal(t, models.DeploymentApprovalStatusPending, approval.Status)
+		require.Equal(t, 1, *approval.RollbackOf)
+		require.Equal(t, "registry.example.com/myapp:v1.0.0", approval.ImageID)
+		require.Len(t, openChoreoClient.DeployCalls(), 2)
+		nextNotifications(1)
+	})
+
+	t.Run("Deployments to other environments do not need approval", func(t *testing.T) {
+		production = false
+		defer func() { production = true }()
+
+		response := deploy("registry.example.com/myapp:v4.0.0")
+		require.False(t, response.HasApprovalId())
+		require.Len(t, openChoreoClient.DeployCalls(), 3)
+	})
+
+	t.Run("Deleting the policy turns approvals off", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, admin, http.MethodDelete, approvalsURL+"/policy", nil)
+		require.Equal(t, http.StatusNoContent, rr.Code, rr.Body.String())
+		rr = apitestutils.SendJSON(t, admin, http.MethodDelete, approvalsURL+"/policy", nil)
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+
+		response := deploy("registry.example.com/myapp:v5.0.0")
+		require.False(t, response.HasApprovalId())
+		require.Len(t, openChoreoClient.DeployCalls(), 4)
+	})
+
+	t.Run("Unknown approvals and statuses are rejected", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, reviewer, http.MethodGet, approvalsURL+"/"+uuid.New().String(), nil)
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+		rr = apitestutils.SendJSON(t, reviewer, http.MethodGet, approvalsURL+"?status=waiting", nil)
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+	})
+}
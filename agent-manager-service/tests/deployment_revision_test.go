@@ -0,0 +1,135 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+var (
+	revisionTestOrgName   = fmt.Sprintf("revision-test-org-%s", uuid.New().String()[:5])
+	revisionTestProjName  = fmt.Sprintf("revision-test-project-%s", uuid.New().String()[:5])
+	revisionTestAgentName = fmt.Sprintf("revision-test-agent-%s", uuid.New().String()[:5])
+)
+
+func TestDeploymentRevisions(t *testing.T) {
+	authMiddleware := jwtassertion.NewMockMiddleware(t)
+	openChoreoClient := apitestutils.CreateMockOpenChoreoClient()
+	openChoreoClient.ComponentExistsFunc = func(ctx context.Context, orgName string, projName string, agentName string, verifyProject bool) (bool, error) {
+		return true, nil
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{OpenChoreoClient: openChoreoClient}, authMiddleware)
+
+	baseURL := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/deployments",
+		revisionTestOrgName, revisionTestProjName, revisionTestAgentName)
+
+	deploy := func(t *testing.T, body map[string]interface{}) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, baseURL, body)
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+	}
+
+	listRevisions := func(t *testing.T) []models.DeploymentRevisionResponse {
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, baseURL+"/revisions", nil))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var revisions []models.DeploymentRevisionResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &revisions))
+		return revisions
+	}
+
+	t.Run("Each deployment should be recorded as a new revision", func(t *testing.T) {
+		deploy(t, map[string]interface{}{
+			"imageId": "registry.example.com/myapp:v1.0.0",
+			"env":     []map[string]interface{}{{"key": "LOG_LEVEL", "value": "DEBUG"}},
+		})
+		deploy(t, map[string]interface{}{"imageId": "registry.example.com/myapp:v2.0.0"})
+
+		revisions := listRevisions(t)
+		require.Len(t, revisions, 2)
+		require.Equal(t, 2, revisions[0].Revision)
+		require.Equal(t, "registry.example.com/myapp:v2.0.0", revisions[0].ImageID)
+		require.Empty(t, revisions[0].Env)
+		require.Equal(t, 1, revisions[1].Revision)
+		require.Equal(t, "Development", revisions[1].Environment)
+		require.Equal(t, []models.EnvVars{{Key: "LOG_LEVEL", Value: "DEBUG"}}, revisions[1].Env)
+	})
+
+	t.Run("Getting a revision should return its config snapshot", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, baseURL+"/revisions/1", nil))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var revision models.DeploymentRevisionResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &revision))
+		require.Equal(t, 1, revision.Revision)
+		require.Equal(t, "registry.example.com/myapp:v1.0.0", revision.ImageID)
+		require.Nil(t, revision.RollbackOf)
+	})
+
+	t.Run("Rolling back should redeploy the revision as a new revision", func(t *testing.T) {
+		deployCalls := len(openChoreoClient.DeployCalls())
+
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, baseURL+"/revisions/1/rollback", nil))
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+
+		var revision models.DeploymentRevisionResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &revision))
+		require.Equal(t, 3, revision.Revision)
+		require.NotNil(t, revision.RollbackOf)
+		require.Equal(t, 1, *revision.RollbackOf)
+
+		require.Len(t, openChoreoClient.DeployCalls(), deployCalls+1)
+		deployCall := openChoreoClient.DeployCalls()[deployCalls]
+		require.Equal(t, "registry.example.com/myapp:v1.0.0", deployCall.Req.ImageID)
+		require.Len(t, deployCall.Req.Env, 1)
+		require.Equal(t, "LOG_LEVEL", deployCall.Req.Env[0].Key)
+		require.Equal(t, "DEBUG", deployCall.Req.Env[0].Value)
+
+		require.Len(t, listRevisions(t), 3)
+	})
+
+	t.Run("Unknown revision should return 404", func(t *testing.T) {
+		for _, r := range []*http.Request{
+			httptest.NewRequest(http.MethodGet, baseURL+"/revisions/99", nil),
+			httptest.NewRequest(http.MethodPost, baseURL+"/revisions/99/rollback", nil),
+		} {
+			rr := httptest.NewRecorder()
+			app.ServeHTTP(rr, r)
+			require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+		}
+	})
+
+	t.Run("Non-numeric revision should return 400", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, baseURL+"/revisions/latest", nil))
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+	})
+}
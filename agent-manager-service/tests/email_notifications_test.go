@@ -0,0 +1,380 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/quotedprintable"
+	"net"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/clientmocks"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/openchoreosvc/client"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+// receivedEmail is a message accepted by fakeSMTPServer, with its body decoded
+type receivedEmail struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// fakeSMTPServer accepts plain SMTP sessions on localhost and keeps the messages it receives.
+// Recipients in refused are rejected.
+type fakeSMTPServer struct {
+	listener net.Listener
+	mu       sync.Mutex
+	emails   []receivedEmail
+	refused  map[string]bool
+}
+
+func newFakeSMTPServer(t *testing.T) *fakeSMTPServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := &fakeSMTPServer{listener: listener, refused: map[string]bool{}}
+	t.Cleanup(func() { _ = listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go server.serve(conn)
+		}
+	}()
+	return server
+}
+
+func (s *fakeSMTPServer) port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *fakeSMTPServer) serve(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = fmt.Fprintf(conn, "%s\r\n", line) }
+	reply("220 localhost ESMTP")
+	var to string
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		command := strings.ToUpper(strings.TrimSpace(line))
+		switch {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(command, "MAIL FROM:"):
+			reply("250 OK")
+		case strings.HasPrefix(command, "RCPT TO:"):
+			to = strings.Trim(strings.TrimSpace(line)[len("RCPT TO:"):], "<>")
+			s.mu.Lock()
+			refused := s.refused[to]
+			s.mu.Unlock()
+			if refused {
+				reply("550 mailbox unavailable")
+			} else {
+				reply("250 OK")
+			}
+		case command == "DATA":
+			reply("354 end data with <CR><LF>.<CR><LF>")
+			var data bytes.Buffer
+			for {
+				dataLine, err := reader.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if dataLine == ".\r\n" {
+					break
+				}
+				data.WriteString(dataLine)
+			}
+			s.keep(to, data.String())
+			reply("250 OK")
+		case command == "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
+
+func (s *fakeSMTPServer) keep(to, data string) {
+	header, body, _ := strings.Cut(data, "\r\n\r\n")
+	email := receivedEmail{To: to}
+	for _, line := range strings.Split(header, "\r\n") {
+		if subject, ok := strings.CutPrefix(line, "Subject: "); ok {
+			email.Subject = subject
+		}
+	}
+	decoded, _ := io.ReadAll(quotedprintable.NewReader(strings.NewReader(body)))
+	email.Body = string(decoded)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.emails = append(s.emails, email)
+}
+
+// waitForEmail returns the first message to the address whose subject contains the given text
+func (s *fakeSMTPServer) waitForEmail(t *testing.T, to, subject string) receivedEmail {
+	var found receivedEmail
+	require.Eventually(t, func() bool {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		for _, email := range s.emails {
+			if email.To == to && strings.Contains(email.Subject, subject) {
+				found = email
+				return true
+			}
+		}
+		return false
+	}, 5*time.Second, 20*time.Millisecond, "no email to %s with subject %q", to, subject)
+	return found
+}
+
+func (s *fakeSMTPServer) count(to string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := 0
+	for _, email := range s.emails {
+		if email.To == to {
+			n++
+		}
+	}
+	return n
+}
+
+var verificationCodePattern = regexp.MustCompile(`\b\d{6}\b`)
+
+func TestEmailNotifications(t *testing.T) {
+	orgName := fmt.Sprintf("email-org-%s", uuid.New().String()[:5])
+	projName := fmt.Sprintf("email-project-%s", uuid.New().String()[:5])
+	agentName := fmt.Sprintf("email-agent-%s", uuid.New().String()[:5])
+
+	openChoreoClient := apitestutils.CreateMockOpenChoreoClient()
+	openChoreoClient.ComponentExistsFunc = func(ctx context.Context, orgName string, projName string, agentName string, verifyProject bool) (bool, error) {
+		return true, nil
+	}
+	openChoreoClient.ListProjectsFunc = func(ctx context.Context, namespaceName string) ([]*models.ProjectResponse, error) {
+		return []*models.ProjectResponse{}, nil
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{
+		OpenChoreoClient:    openChoreoClient,
+		TraceObserverClient: &clientmocks.TraceObserverClientMock{},
+	}, jwtassertion.NewMockMiddleware(t))
+
+	recipientsURL := fmt.Sprintf("/api/v1/orgs/%s/email-recipients", orgName)
+	address := "ops@example.com"
+
+	t.Run("Adding a recipient without an SMTP server should return 404", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, recipientsURL, models.CreateEmailRecipientRequest{
+			Address: address, Notifications: []string{models.EmailNotificationDeploymentResults},
+		})
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+		require.Contains(t, rr.Body.String(), "EMAIL_NOT_CONFIGURED")
+	})
+
+	smtpServer := newFakeSMTPServer(t)
+	cfg := config.GetConfig()
+	previous := cfg.Email
+	cfg.Email = config.EmailConfig{
+		SMTPHost:               "127.0.0.1",
+		SMTPPort:               smtpServer.port(),
+		From:                   "Agent Manager <noreply@example.com>",
+		TokenExpiryWarningDays: 14,
+	}
+	t.Cleanup(func() { cfg.Email = previous })
+
+	var recipient models.EmailRecipientResponse
+	var code string
+	t.Run("Adding a recipient should email it a verification code", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, recipientsURL, models.CreateEmailRecipientRequest{
+			Address:       "Ops@Example.com",
+			Notifications: []string{models.EmailNotificationDeploymentResults, models.EmailNotificationDigests},
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&recipient))
+		require.Equal(t, address, recipient.Address, "the address should be stored in lower case")
+		require.False(t, recipient.Verified)
+		require.NotNil(t, recipient.VerificationSentAt)
+
+		email := smtpServer.waitForEmail(t, address, "Verify your email address for "+orgName)
+		code = verificationCodePattern.FindString(email.Body)
+		require.NotEmpty(t, code, email.Body)
+	})
+
+	t.Run("Adding an invalid or duplicate recipient should fail", func(t *testing.T) {
+		for name, req := range map[string]models.CreateEmailRecipientRequest{
+			"invalid address":      {Address: "not-an-address", Notifications: []string{models.EmailNotificationDigests}},
+			"unknown notification": {Address: "dev@example.com", Notifications: []string{"pager"}},
+			"no notifications":     {Address: "dev@example.com"},
+		} {
+			rr := apitestutils.SendJSON(t, app, http.MethodPost, recipientsURL, req)
+			require.Equal(t, http.StatusBadRequest, rr.Code, name)
+		}
+
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, recipientsURL, models.CreateEmailRecipientRequest{
+			Address: address, Notifications: []string{models.EmailNotificationDigests},
+		})
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+	})
+
+	t.Run("An address the SMTP server refuses should not be added", func(t *testing.T) {
+		smtpServer.mu.Lock()
+		smtpServer.refused["gone@example.com"] = true
+		smtpServer.mu.Unlock()
+
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, recipientsURL, models.CreateEmailRecipientRequest{
+			Address: "gone@example.com", Notifications: []string{models.EmailNotificationDigests},
+		})
+		require.Equal(t, http.StatusBadGateway, rr.Code, rr.Body.String())
+
+		rr = apitestutils.SendJSON(t, app, http.MethodGet, recipientsURL, nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var list models.EmailRecipientListResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&list))
+		require.Len(t, list.Recipients, 1)
+	})
+
+	deploy := func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/deployments", orgName, projName, agentName),
+			map[string]interface{}{"imageId": "registry.example.com/agent:v1"})
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+	}
+
+	t.Run("Unverified recipients should not be notified", func(t *testing.T) {
+		deploy(t)
+		time.Sleep(200 * time.Millisecond)
+		require.Equal(t, 1, smtpServer.count(address), "only the verification email should be sent")
+	})
+
+	t.Run("A wrong verification code should be rejected", func(t *testing.T) {
+		wrong := "000000"
+		if code == wrong {
+			wrong = "111111"
+		}
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, recipientsURL+"/"+recipient.ID+"/verify", models.VerifyEmailRecipientRequest{Code: wrong})
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+		require.Contains(t, rr.Body.String(), "INVALID_VERIFICATION_CODE")
+	})
+
+	t.Run("The emailed code should verify the recipient", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, recipientsURL+"/"+recipient.ID+"/verify", models.VerifyEmailRecipientRequest{Code: code})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&recipient))
+		require.True(t, recipient.Verified)
+		require.NotNil(t, recipient.VerifiedAt)
+
+		rr = apitestutils.SendJSON(t, app, http.MethodPost, recipientsURL+"/"+recipient.ID+"/verify", models.VerifyEmailRecipientRequest{Code: code})
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+		rr = apitestutils.SendJSON(t, app, http.MethodPost, recipientsURL+"/"+recipient.ID+"/send-verification", nil)
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+	})
+
+	t.Run("Deployment results should be emailed to verified recipients", func(t *testing.T) {
+		deploy(t)
+		email := smtpServer.waitForEmail(t, address, fmt.Sprintf("Deployed %s/%s to Development", projName, agentName))
+		require.Contains(t, email.Body, "registry.example.com/agent:v1")
+		require.Contains(t, email.Body, "as revision 2")
+
+		openChoreoClient.DeployFunc = func(ctx context.Context, namespaceName, projectName, componentName string, req client.DeployRequest) error {
+			return errors.New("image pull failed")
+		}
+		t.Cleanup(func() {
+			openChoreoClient.DeployFunc = func(ctx context.Context, namespaceName, projectName, componentName string, req client.DeployRequest) error {
+				return nil
+			}
+		})
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/deployments", orgName, projName, agentName),
+			map[string]interface{}{"imageId": "registry.example.com/agent:v2"})
+		require.NotEqual(t, http.StatusAccepted, rr.Code, rr.Body.String())
+		email = smtpServer.waitForEmail(t, address, "Failed to deploy")
+		require.Contains(t, email.Body, "image pull failed")
+	})
+
+	t.Run("Email digests should be sent to recipients subscribed to digests", func(t *testing.T) {
+		digestsURL := fmt.Sprintf("/api/v1/orgs/%s/notification-digests", orgName)
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, digestsURL, models.NotificationDigestRequest{
+			Name:      "Weekly summary",
+			Frequency: models.NotificationDigestFrequencyWeekly,
+			Channel:   models.NotificationChannelEmail,
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var digest models.NotificationDigestResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&digest))
+		require.Empty(t, digest.URLHost)
+
+		rr = apitestutils.SendJSON(t, app, http.MethodPost, digestsURL+"/"+digest.ID+"/send", nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		email := smtpServer.waitForEmail(t, address, "Weekly summary for "+orgName)
+		require.Contains(t, email.Body, "New issues: 0")
+		require.Contains(t, email.Body, "Deployments:")
+	})
+
+	t.Run("A digest no recipient subscribes to should fail to send", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPut, recipientsURL+"/"+recipient.ID, models.UpdateEmailRecipientRequest{
+			Notifications: []string{models.EmailNotificationBudgetAlerts},
+		})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var updated models.EmailRecipientResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&updated))
+		require.Equal(t, []string{models.EmailNotificationBudgetAlerts}, updated.Notifications)
+
+		digestsURL := fmt.Sprintf("/api/v1/orgs/%s/notification-digests", orgName)
+		rr = apitestutils.SendJSON(t, app, http.MethodPost, digestsURL, models.NotificationDigestRequest{
+			Name:      "Daily summary",
+			Frequency: models.NotificationDigestFrequencyDaily,
+			Channel:   models.NotificationChannelEmail,
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var digest models.NotificationDigestResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&digest))
+
+		rr = apitestutils.SendJSON(t, app, http.MethodPost, digestsURL+"/"+digest.ID+"/send", nil)
+		require.Equal(t, http.StatusBadGateway, rr.Code, rr.Body.String())
+		require.Contains(t, rr.Body.String(), "no verified email recipient")
+	})
+
+	t.Run("Deleting a recipient should remove it", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodDelete, recipientsURL+"/"+recipient.ID, nil)
+		require.Equal(t, http.StatusNoContent, rr.Code, rr.Body.String())
+
+		rr = apitestutils.SendJSON(t, app, http.MethodGet, recipientsURL+"/"+recipient.ID, nil)
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+		rr = apitestutils.SendJSON(t, app, http.MethodDelete, recipientsURL+"/"+recipient.ID, nil)
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+	})
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	apiplatformclient "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/apiplatformsvc/client"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+var testBulkOrgName = fmt.Sprintf("bulk-org-%s", uuid.New().String()[:5])
+
+func TestGatewayBulkOperations(t *testing.T) {
+	authMiddleware := apitestutils.NewPlatformAdminMiddleware(t)
+	testClients := wiring.TestClients{
+		OpenChoreoClient:  apitestutils.CreateMockOpenChoreoClient(),
+		APIPlatformClient: apiplatformclient.NewInMemoryAPIPlatformClient(),
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+	orgURL := fmt.Sprintf("/api/v1/orgs/%s", testBulkOrgName)
+	rr := apitestutils.SendJSON(t, app, http.MethodPost, "/api/v1/orgs", models.CreateOrganizationRequest{Name: testBulkOrgName})
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+	rr = apitestutils.SendJSON(t, app, http.MethodPost, orgURL+"/gateways", spec.CreateGatewayRequest{
+		Name: "bulk-gw", DisplayName: "Bulk", GatewayType: spec.AI, Vhost: "bulk.example.com",
+	})
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	var gateway models.GatewayResponse
+	require.NoError(t, json.NewDecoder(rr.Body).Decode(&gateway))
+
+	t.Run("Deleting gateways should report the result of each gateway", func(t *testing.T) {
+		missingID := uuid.NewString()
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, orgURL+"/gateway-bulk-operations", models.CreateGatewayBulkOperationRequest{
+			Action:     models.GatewayBulkActionDelete,
+			GatewayIDs: []string{gateway.UUID, missingID},
+		})
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+		var operation models.GatewayBulkOperationResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&operation))
+		require.Len(t, operation.Items, 2)
+
+		require.Eventually(t, func() bool {
+			rr := apitestutils.SendJSON(t, app, http.MethodGet, orgURL+"/gateway-bulk-operations/"+operation.ID, nil)
+			require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&operation))
+			return operation.Status == models.GatewayBulkOperationStatusCompleted
+		}, 5*time.Second, 50*time.Millisecond)
+
+		require.Equal(t, 1, operation.Succeeded)
+		require.Equal(t, 1, operation.Failed)
+		require.Equal(t, models.GatewayBulkItemStatusSucceeded, operation.Items[0].Status)
+		require.Equal(t, models.GatewayBulkItemStatusFailed, operation.Items[1].Status)
+		require.Contains(t, operation.Items[1].Error, missingID)
+
+		rr = apitestutils.SendJSON(t, app, http.MethodGet, orgURL+"/gateways/"+gateway.UUID, nil)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("An empty list of gateways should return 400", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, orgURL+"/gateway-bulk-operations", models.CreateGatewayBulkOperationRequest{
+			Action:     models.GatewayBulkActionDelete,
+			GatewayIDs: []string{},
+		})
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+		require.Contains(t, rr.Body.String(), "must not be empty")
+	})
+
+	t.Run("An unknown operation should return 404", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodGet, orgURL+"/gateway-bulk-operations/"+uuid.NewString(), nil)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+		require.Contains(t, rr.Body.String(), "GATEWAY_BULK_OPERATION_NOT_FOUND")
+	})
+}
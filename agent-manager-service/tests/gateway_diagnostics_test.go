@@ -0,0 +1,127 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	apiplatformclient "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/apiplatformsvc/client"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+var testDiagnosticsOrgName = fmt.Sprintf("diagnostics-org-%s", uuid.New().String()[:5])
+
+type staticAuthProvider struct{}
+
+func (staticAuthProvider) GetToken(context.Context) (string, error) { return "token", nil }
+func (staticAuthProvider) InvalidateToken()                         {}
+
+func TestDiagnoseGateway(t *testing.T) {
+	authMiddleware := apitestutils.NewPlatformAdminMiddleware(t)
+	testClients := wiring.TestClients{
+		OpenChoreoClient:  apitestutils.CreateMockOpenChoreoClient(),
+		APIPlatformClient: apiplatformclient.NewInMemoryAPIPlatformClient(),
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+	orgURL := fmt.Sprintf("/api/v1/orgs/%s", testDiagnosticsOrgName)
+	rr := apitestutils.SendJSON(t, app, http.MethodPost, "/api/v1/orgs", models.CreateOrganizationRequest{Name: testDiagnosticsOrgName})
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+	rr = apitestutils.SendJSON(t, app, http.MethodPost, orgURL+"/gateways", spec.CreateGatewayRequest{
+		Name: "diagnostics-gw", DisplayName: "Diagnostics", GatewayType: spec.AI, Vhost: "diagnostics.example.com",
+	})
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	var gateway models.GatewayResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &gateway))
+
+	t.Run("A connected gateway should be diagnosed as healthy", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, orgURL+"/gateways/"+gateway.UUID+"/diagnose", nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var report models.GatewayDiagnosticsResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &report))
+		require.Equal(t, gateway.UUID, report.GatewayID)
+		require.Equal(t, models.GatewayDiagnosisHealthy, report.Status)
+		require.Len(t, report.Checks, 7)
+	})
+
+	t.Run("Diagnosing a missing gateway should return 404", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, orgURL+"/gateways/"+uuid.NewString()+"/diagnose", nil)
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+	})
+}
+
+func TestAPIPlatformClientDiagnoseGateway(t *testing.T) {
+	gatewayID := uuid.NewString()
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/gateways/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if r.PathValue("id") != gatewayID {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": gatewayID, "name": "diagnosed", "isActive": false})
+	})
+	mux.HandleFunc("GET /health", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("GET /api/internal/v1/ws/gateways/connect", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Upgrade") != "websocket" {
+			w.WriteHeader(http.StatusUpgradeRequired)
+			return
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+	server := httptest.NewTLSServer(mux)
+	defer server.Close()
+
+	client, err := apiplatformclient.NewAPIPlatformClient(&apiplatformclient.Config{
+		BaseURL:      server.URL + "/api/v1",
+		AuthProvider: staticAuthProvider{},
+	})
+	require.NoError(t, err)
+
+	report, err := client.DiagnoseGateway(context.Background(), gatewayID)
+	require.NoError(t, err)
+	require.Equal(t, server.URL, report.ControlPlaneURL)
+
+	statuses := map[string]string{}
+	for _, check := range report.Checks {
+		statuses[check.Name] = check.Status
+	}
+	require.Equal(t, map[string]string{
+		// The test server listens on an IP address with a self-signed certificate
+		apiplatformclient.DiagnosticCheckDNS:               apiplatformclient.DiagnosticStatusSkipped,
+		apiplatformclient.DiagnosticCheckTLS:               apiplatformclient.DiagnosticStatusWarn,
+		apiplatformclient.DiagnosticCheckAuth:              apiplatformclient.DiagnosticStatusPass,
+		apiplatformclient.DiagnosticCheckGatewayConnection: apiplatformclient.DiagnosticStatusFail,
+		apiplatformclient.DiagnosticCheckHealth:            apiplatformclient.DiagnosticStatusPass,
+		apiplatformclient.DiagnosticCheckClockSkew:         apiplatformclient.DiagnosticStatusPass,
+		apiplatformclient.DiagnosticCheckWebSocket:         apiplatformclient.DiagnosticStatusPass,
+	}, statuses)
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	apiplatformclient "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/apiplatformsvc/client"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+var testEventsOrgName = fmt.Sprintf("events-org-%s", uuid.New().String()[:5])
+
+func TestGatewayEvents(t *testing.T) {
+	authMiddleware := apitestutils.NewPlatformAdminMiddleware(t)
+	testClients := wiring.TestClients{
+		OpenChoreoClient:  apitestutils.CreateMockOpenChoreoClient(),
+		APIPlatformClient: apiplatformclient.NewInMemoryAPIPlatformClient(),
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+	orgURL := fmt.Sprintf("/api/v1/orgs/%s", testEventsOrgName)
+	rr := apitestutils.SendJSON(t, app, http.MethodPost, "/api/v1/orgs", models.CreateOrganizationRequest{Name: testEventsOrgName})
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+	rr = apitestutils.SendJSON(t, app, http.MethodPost, orgURL+"/gateways", spec.CreateGatewayRequest{
+		Name: "events-gw", DisplayName: "Events", GatewayType: spec.AI, Vhost: "events.example.com",
+	})
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	var gateway models.GatewayResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &gateway))
+	gatewayURL := orgURL + "/gateways/" + gateway.UUID
+
+	listEvents := func(query string) models.ResourceEventListResponse {
+		rr := apitestutils.SendJSON(t, app, http.MethodGet, gatewayURL+"/events"+query, nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var events models.ResourceEventListResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &events))
+		return events
+	}
+	eventTypes := func(events models.ResourceEventListResponse) []string {
+		types := make([]string, len(events.Events))
+		for i, event := range events.Events {
+			types[i] = event.Type
+		}
+		return types
+	}
+
+	t.Run("Changes to a gateway should be listed newest first", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, gatewayURL+"/tokens", nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var token spec.GatewayTokenResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &token))
+
+		// Only the first health check is a change
+		for range 2 {
+			rr = apitestutils.SendJSON(t, app, http.MethodGet, gatewayURL+"/health", nil)
+			require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		}
+
+		events := listEvents("")
+		require.Equal(t, []string{
+			models.ResourceEventHealthChanged, models.ResourceEventTokenRotated, models.ResourceEventCreated,
+		}, eventTypes(events))
+		require.Equal(t, token.TokenId, events.Events[1].Details["tokenId"])
+		require.Equal(t, "events-gw", events.Events[2].Details["name"])
+
+		page := listEvents("?limit=1&offset=1")
+		require.Equal(t, int64(3), page.Total)
+		require.Equal(t, []string{models.ResourceEventTokenRotated}, eventTypes(page))
+	})
+
+	t.Run("The timeline of a deleted gateway should still be listed", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodDelete, gatewayURL, nil)
+		require.Equal(t, http.StatusNoContent, rr.Code, rr.Body.String())
+
+		events := listEvents("")
+		require.Equal(t, models.ResourceEventDeleted, events.Events[0].Type)
+	})
+
+	t.Run("Invalid pagination should return 400", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodGet, gatewayURL+"/events?limit=0", nil)
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
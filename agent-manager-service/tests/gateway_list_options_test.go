@@ -0,0 +1,113 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	apiplatformclient "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/apiplatformsvc/client"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+var testListOptionsOrgName = fmt.Sprintf("list-org-%s", uuid.New().String()[:5])
+
+func TestGatewayListOptions(t *testing.T) {
+	authMiddleware := apitestutils.NewPlatformAdminMiddleware(t)
+	testClients := wiring.TestClients{
+		OpenChoreoClient:  apitestutils.CreateMockOpenChoreoClient(),
+		APIPlatformClient: apiplatformclient.NewInMemoryAPIPlatformClient(),
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+	orgURL := fmt.Sprintf("/api/v1/orgs/%s", testListOptionsOrgName)
+	rr := apitestutils.SendJSON(t, app, http.MethodPost, "/api/v1/orgs", models.CreateOrganizationRequest{Name: testListOptionsOrgName})
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+	rr = apitestutils.SendJSON(t, app, http.MethodPost, orgURL+"/environments", map[string]any{
+		"name": "list-dev", "displayName": "Dev", "dataplaneRef": "default", "dnsPrefix": "dev",
+	})
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	var env spec.GatewayEnvironmentResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &env))
+
+	for _, name := range []string{"list-b", "list-c", "list-a"} {
+		req := spec.CreateGatewayRequest{Name: name, DisplayName: name, GatewayType: spec.AI, Vhost: name + ".example.com"}
+		req.EnvironmentIds = []string{env.Id}
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, orgURL+"/gateways", req)
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	}
+
+	list := func(query string) []map[string]any {
+		rr := apitestutils.SendJSON(t, app, http.MethodGet, orgURL+"/gateways?"+query, nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var response struct {
+			Gateways []map[string]any `json:"gateways"`
+		}
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		// Only the gateways registered by this test are checked
+		var gateways []map[string]any
+		for _, gw := range response.Gateways {
+			if name, _ := gw["name"].(string); strings.HasPrefix(name, "list-") {
+				gateways = append(gateways, gw)
+			}
+		}
+		return gateways
+	}
+
+	t.Run("Gateways should be sorted by the sort field", func(t *testing.T) {
+		gateways := list("sort=name:desc")
+
+		require.Len(t, gateways, 3)
+		require.Equal(t, "list-c", gateways[0]["name"])
+		require.Equal(t, "list-b", gateways[1]["name"])
+		require.Equal(t, "list-a", gateways[2]["name"])
+	})
+
+	t.Run("Only the selected fields should be returned", func(t *testing.T) {
+		gateways := list("sort=name&fields=name")
+
+		require.Len(t, gateways, 3)
+		require.ElementsMatch(t, []string{"uuid", "name"}, slices.Collect(maps.Keys(gateways[0])))
+	})
+
+	t.Run("Expanded relations should be added to the selected fields", func(t *testing.T) {
+		gateways := list("fields=name,vhost&expand=environments")
+
+		require.Len(t, gateways, 3)
+		require.ElementsMatch(t, []string{"uuid", "name", "vhost", "environments"}, slices.Collect(maps.Keys(gateways[0])))
+		require.Len(t, gateways[0]["environments"], 1)
+	})
+
+	t.Run("Invalid list options should return 400", func(t *testing.T) {
+		for _, query := range []string{"sort=vhost", "sort=name:up", "fields=apiKey", "expand=tokens"} {
+			rr := apitestutils.SendJSON(t, app, http.MethodGet, orgURL+"/gateways?"+query, nil)
+			require.Equal(t, http.StatusBadRequest, rr.Code, query)
+		}
+	})
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	apiplatformclient "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/apiplatformsvc/client"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+var testVhostOrgName = fmt.Sprintf("vhost-org-%s", uuid.New().String()[:5])
+
+func TestGatewayVhostConflicts(t *testing.T) {
+	authMiddleware := apitestutils.NewPlatformAdminMiddleware(t)
+	testClients := wiring.TestClients{
+		OpenChoreoClient:  apitestutils.CreateMockOpenChoreoClient(),
+		APIPlatformClient: apiplatformclient.NewInMemoryAPIPlatformClient(),
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+	orgURL := fmt.Sprintf("/api/v1/orgs/%s", testVhostOrgName)
+	rr := apitestutils.SendJSON(t, app, http.MethodPost, "/api/v1/orgs", models.CreateOrganizationRequest{Name: testVhostOrgName})
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+	gatewayReq := func(name, vhost string) spec.CreateGatewayRequest {
+		return spec.CreateGatewayRequest{Name: name, DisplayName: name, GatewayType: spec.AI, Vhost: vhost}
+	}
+
+	t.Run("Registering a gateway with a vhost in use should return 409", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, orgURL+"/gateways", gatewayReq("first-gw", "ai.example.com"))
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+		rr = apitestutils.SendJSON(t, app, http.MethodPost, orgURL+"/gateways", gatewayReq("second-gw", "AI.example.com"))
+		require.Equal(t, http.StatusConflict, rr.Code)
+		require.Contains(t, rr.Body.String(), "GATEWAY_VHOST_CONFLICT")
+		require.Contains(t, rr.Body.String(), "first-gw")
+	})
+
+	t.Run("Applying a manifest with a vhost in use should return 409", func(t *testing.T) {
+		manifest := models.ApplyManifest{
+			Gateways: []models.GatewayManifest{{Name: "third-gw", DisplayName: "Third", Vhost: "ai.example.com"}},
+		}
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, orgURL+"/apply", manifest)
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+		require.Contains(t, rr.Body.String(), "GATEWAY_VHOST_CONFLICT")
+	})
+}
@@ -201,4 +201,39 @@ func TestGetTrace(t *testing.T) {
 		// Validate service was called
 		require.Len(t, traceObserverClient.TraceDetailsByIdCalls(), 1)
 	})
+
+	t.Run("Getting trace details with archived=true should search the trace archive", func(t *testing.T) {
+		traceObserverClient := createMockTraceObserverClientWithDetails()
+		testClients := wiring.TestClients{
+			OpenChoreoClient:    apitestutils.CreateMockOpenChoreoClient(),
+			TraceObserverClient: traceObserverClient,
+		}
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		url := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/trace/%s?environment=Development&archived=true",
+			traceDetailsOrgName, traceDetailsProjName, traceDetailsAgentName, "trace-id-123")
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, url, nil))
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Len(t, traceObserverClient.TraceDetailsByIdCalls(), 1)
+		require.True(t, traceObserverClient.TraceDetailsByIdCalls()[0].Params.Archived)
+	})
+
+	t.Run("Getting trace details with an invalid archived flag should return 400", func(t *testing.T) {
+		traceObserverClient := createMockTraceObserverClientWithDetails()
+		testClients := wiring.TestClients{
+			OpenChoreoClient:    apitestutils.CreateMockOpenChoreoClient(),
+			TraceObserverClient: traceObserverClient,
+		}
+		app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+		url := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/trace/%s?environment=Development&archived=maybe",
+			traceDetailsOrgName, traceDetailsProjName, traceDetailsAgentName, "trace-id-123")
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, url, nil))
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		require.Empty(t, traceObserverClient.TraceDetailsByIdCalls())
+	})
 }
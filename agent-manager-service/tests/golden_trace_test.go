@@ -0,0 +1,268 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/clientmocks"
+	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+// goldenTraceSpans returns the spans of an agent request that calls a model and, unless skipped, a tool
+func goldenTraceSpans(traceID, parentSpanID, answer string, skipTool bool) []traceobserversvc.Span {
+	start := time.Now()
+	spans := []traceobserversvc.Span{
+		{
+			TraceID: traceID, SpanID: traceID[:8] + "00000001", ParentSpanID: parentSpanID, Name: "invoke_agent", StartTime: start,
+			AmpAttributes: &traceobserversvc.AmpAttributes{Kind: "agent", Output: answer},
+		},
+		{
+			TraceID: traceID, SpanID: traceID[:8] + "00000002", ParentSpanID: traceID[:8] + "00000001", Name: "chat gpt-4o", StartTime: start.Add(time.Millisecond),
+			AmpAttributes: &traceobserversvc.AmpAttributes{Kind: "llm", Output: answer},
+		},
+	}
+	if !skipTool {
+		spans = append(spans, traceobserversvc.Span{
+			TraceID: traceID, SpanID: traceID[:8] + "00000003", ParentSpanID: traceID[:8] + "00000001", Name: "search", StartTime: start.Add(2 * time.Millisecond),
+			AmpAttributes: &traceobserversvc.AmpAttributes{Kind: "tool", Output: fmt.Sprintf("Found 3 results at %s", start.UTC().Format(time.RFC3339))},
+		})
+	}
+	return spans
+}
+
+func TestGoldenTraces(t *testing.T) {
+	orgName := fmt.Sprintf("golden-org-%s", uuid.New().String()[:5])
+	projName := fmt.Sprintf("golden-project-%s", uuid.New().String()[:5])
+	agentName := fmt.Sprintf("golden-agent-%s", uuid.New().String()[:5])
+	const sourceTraceID = "a1b2c3d4e5f60718293a4b5c6d7e8f90"
+
+	authMiddleware := jwtassertion.NewMockMiddlewareWithClaims(t, &jwtassertion.TokenClaims{
+		Sub:   "developer",
+		Scope: "scopes",
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	})
+
+	// The agent records the trace context of each request; the trace observer serves the spans the
+	// agent would have recorded for it, in the behaviour the test selects
+	type recordedReplay struct {
+		parentSpanID string
+		answer       string
+		skipTool     bool
+		notRecorded  bool
+	}
+	var mu sync.Mutex
+	replays := map[string]recordedReplay{}
+	behaviour := recordedReplay{answer: "The answer is 42"}
+	agent := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		parts := strings.Split(r.Header.Get("traceparent"), "-")
+		require.Len(t, parts, 4)
+		mu.Lock()
+		replay := behaviour
+		replay.parentSpanID = parts[2]
+		replays[parts[1]] = replay
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"answer":"ok"}`))
+	}))
+	defer agent.Close()
+
+	traceObserverClient := &clientmocks.TraceObserverClientMock{
+		TraceDetailsByIdFunc: func(ctx context.Context, params traceobserversvc.TraceDetailsByIdParams) (*traceobserversvc.TraceResponse, error) {
+			if params.TraceID == sourceTraceID {
+				return &traceobserversvc.TraceResponse{
+					Spans:  goldenTraceSpans(sourceTraceID, "", "The answer is 42", false),
+					Input:  `{"question":"What is the answer?"}`,
+					Output: "The answer is 42",
+				}, nil
+			}
+			mu.Lock()
+			replay, ok := replays[params.TraceID]
+			mu.Unlock()
+			if !ok || replay.notRecorded {
+				return nil, &traceobserversvc.HTTPError{StatusCode: http.StatusNotFound, Message: "Trace not found"}
+			}
+			return &traceobserversvc.TraceResponse{
+				Spans:  goldenTraceSpans(params.TraceID, replay.parentSpanID, replay.answer, replay.skipTool),
+				Output: replay.answer,
+			}, nil
+		},
+	}
+	openChoreoClient := apitestutils.CreateMockOpenChoreoClient()
+	openChoreoClient.GetComponentEndpointsFunc = func(ctx context.Context, namespaceName, projectName, componentName, environment string) (map[string]models.EndpointsResponse, error) {
+		return map[string]models.EndpointsResponse{
+			"default": {Endpoint: models.Endpoint{URL: agent.URL, Name: "default"}},
+		}, nil
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{
+		OpenChoreoClient:    openChoreoClient,
+		TraceObserverClient: traceObserverClient,
+	}, authMiddleware)
+
+	goldenTracesURL := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/golden-traces", orgName, projName, agentName)
+	startRun := func(t *testing.T, goldenTraceID string) models.GoldenTraceRunResponse {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, goldenTracesURL+"/"+goldenTraceID+"/runs", nil)
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+		var run models.GoldenTraceRunResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&run))
+		require.Equal(t, models.GoldenTraceRunStatusPending, run.Status)
+		require.Len(t, run.ReplayTraceID, 32)
+		return run
+	}
+	getRun := func(t *testing.T, goldenTraceID, runID string) models.GoldenTraceRunResponse {
+		rr := apitestutils.SendJSON(t, app, http.MethodGet, goldenTracesURL+"/"+goldenTraceID+"/runs/"+runID, nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var run models.GoldenTraceRunResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&run))
+		return run
+	}
+
+	var goldenTraceID string
+
+	t.Run("Marking a trace as golden should store its normalized snapshot", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, goldenTracesURL, map[string]interface{}{
+			"traceId":     sourceTraceID,
+			"name":        "answers the question",
+			"environment": "Development",
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+		var golden models.GoldenTraceResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&golden))
+		require.Equal(t, sourceTraceID, golden.TraceID)
+		require.Equal(t, 0.8, golden.Threshold)
+		require.Equal(t, "developer", golden.CreatedBy)
+		require.Nil(t, golden.NextRunAt)
+		require.NotNil(t, golden.Snapshot)
+		require.Equal(t, "The answer is 42", golden.Snapshot.Output)
+		require.Equal(t, []models.GoldenTraceStep{
+			{Depth: 0, Name: "invoke_agent", Kind: "agent", Output: "The answer is 42"},
+			{Depth: 1, Name: "chat gpt-4o", Kind: "llm", Output: "The answer is 42"},
+			{Depth: 1, Name: "search", Kind: "tool", Output: "Found 3 results at <timestamp>"},
+		}, golden.Snapshot.Steps)
+		goldenTraceID = golden.ID
+	})
+
+	t.Run("Marking a trace as golden with a taken name should return 409", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, goldenTracesURL, map[string]interface{}{
+			"traceId":     sourceTraceID,
+			"name":        "answers the question",
+			"environment": "Development",
+		})
+		require.Equal(t, http.StatusConflict, rr.Code)
+	})
+
+	t.Run("Marking a trace as golden with invalid settings should return 400", func(t *testing.T) {
+		for _, body := range []map[string]interface{}{
+			{"traceId": sourceTraceID, "name": "", "environment": "Development"},
+			{"traceId": sourceTraceID, "name": "threshold", "environment": "Development", "threshold": 1.5},
+			{"traceId": sourceTraceID, "name": "interval", "environment": "Development", "replayIntervalMinutes": 1},
+		} {
+			rr := apitestutils.SendJSON(t, app, http.MethodPost, goldenTracesURL, body)
+			require.Equal(t, http.StatusBadRequest, rr.Code, body)
+		}
+	})
+
+	t.Run("Listing golden traces should leave out snapshots", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodGet, goldenTracesURL, nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response models.GoldenTraceListResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Len(t, response.GoldenTraces, 1)
+		require.Nil(t, response.GoldenTraces[0].Snapshot)
+	})
+
+	t.Run("A replay behaving like the golden trace should pass", func(t *testing.T) {
+		run := startRun(t, goldenTraceID)
+		run = getRun(t, goldenTraceID, run.ID)
+		require.Equal(t, models.GoldenTraceRunStatusPassed, run.Status, run.Error)
+		require.Equal(t, 1.0, *run.StructuralScore)
+		require.Equal(t, 1.0, *run.SemanticScore)
+		require.Empty(t, run.Diff)
+	})
+
+	t.Run("A replay skipping a step and changing its answer should fail with a diff", func(t *testing.T) {
+		mu.Lock()
+		behaviour = recordedReplay{answer: "I do not know", skipTool: true}
+		mu.Unlock()
+
+		run := startRun(t, goldenTraceID)
+		run = getRun(t, goldenTraceID, run.ID)
+		require.Equal(t, models.GoldenTraceRunStatusFailed, run.Status)
+		require.InDelta(t, 0.8, *run.StructuralScore, 0.001)
+		require.Less(t, *run.SemanticScore, 0.5)
+
+		changes := map[string]string{}
+		for _, diff := range run.Diff {
+			changes[diff.Step] = diff.Change
+		}
+		require.Equal(t, map[string]string{
+			"invoke_agent (agent)": models.GoldenTraceStepChanged,
+			"chat gpt-4o (llm)":    models.GoldenTraceStepChanged,
+			"search (tool)":        models.GoldenTraceStepMissing,
+		}, changes)
+	})
+
+	t.Run("A run should stay pending until the replayed trace is recorded", func(t *testing.T) {
+		mu.Lock()
+		behaviour = recordedReplay{answer: "The answer is 42", notRecorded: true}
+		mu.Unlock()
+
+		run := startRun(t, goldenTraceID)
+		run = getRun(t, goldenTraceID, run.ID)
+		require.Equal(t, models.GoldenTraceRunStatusPending, run.Status)
+		require.Nil(t, run.StructuralScore)
+	})
+
+	t.Run("Listing runs should return them newest first", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodGet, goldenTracesURL+"/"+goldenTraceID+"/runs", nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response models.GoldenTraceRunListResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Len(t, response.Runs, 3)
+		require.Equal(t, models.GoldenTraceRunStatusPending, response.Runs[0].Status)
+		require.Equal(t, models.GoldenTraceRunStatusPassed, response.Runs[2].Status)
+	})
+
+	t.Run("Deleting a golden trace should delete it with its runs", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodDelete, goldenTracesURL+"/"+goldenTraceID, nil)
+		require.Equal(t, http.StatusNoContent, rr.Code)
+
+		rr = apitestutils.SendJSON(t, app, http.MethodGet, goldenTracesURL+"/"+goldenTraceID, nil)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+		rr = apitestutils.SendJSON(t, app, http.MethodGet, goldenTracesURL+"/"+goldenTraceID+"/runs", nil)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	apiplatformclient "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/apiplatformsvc/client"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/grpcapi"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	agentmanagerv1 "github.com/wso2/ai-agent-management-platform/agent-manager-service/proto/agentmanager/v1"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+// dialTestGRPCServer serves the gRPC API over an in-memory listener and returns a connection to it
+func dialTestGRPCServer(t *testing.T, testClients wiring.TestClients, authMiddleware jwtassertion.Middleware) *grpc.ClientConn {
+	t.Helper()
+	appParams, err := wiring.InitializeTestAppParamsWithClientMocks(config.GetConfig(), db.DB(context.Background()), authMiddleware, testClients)
+	require.NoError(t, err)
+	server, err := grpcapi.NewGRPCServer(config.GRPCConfig{}, appParams)
+	require.NoError(t, err)
+
+	listener := bufconn.Listen(1024 * 1024)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func TestGRPCOrgContext(t *testing.T) {
+	memberOrg := fmt.Sprintf("grpc-org-%s", uuid.New().String()[:5])
+	otherOrg := fmt.Sprintf("grpc-other-%s", uuid.New().String()[:5])
+	testClients := wiring.TestClients{
+		OpenChoreoClient:  apitestutils.CreateMockOpenChoreoClient(),
+		APIPlatformClient: apiplatformclient.NewInMemoryAPIPlatformClient(),
+	}
+
+	// Onboard and suspend the organization through the REST API
+	app := apitestutils.MakeAppClientWithDeps(t, testClients, apitestutils.NewPlatformAdminMiddleware(t))
+	gatewayIDs := make(map[string]string)
+	for _, orgName := range []string{memberOrg, otherOrg} {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, "/api/v1/orgs", models.CreateOrganizationRequest{Name: orgName})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		t.Cleanup(func() { apitestutils.SendJSON(t, app, http.MethodDelete, "/api/v1/orgs/"+orgName, nil) })
+
+		rr = apitestutils.SendJSON(t, app, http.MethodPost, "/api/v1/orgs/"+orgName+"/gateways", spec.CreateGatewayRequest{
+			Name: orgName + "-gw", DisplayName: orgName, GatewayType: spec.AI, Vhost: orgName + ".example.com",
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var gateway models.GatewayResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &gateway))
+		gatewayIDs[orgName] = gateway.UUID
+	}
+
+	conn := dialTestGRPCServer(t, testClients, jwtassertion.NewMockMiddlewareWithClaims(t, &jwtassertion.TokenClaims{
+		Scope:   "scopes",
+		OrgName: memberOrg,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	}))
+	gateways := agentmanagerv1.NewGatewayServiceClient(conn)
+	deployments := agentmanagerv1.NewDeploymentServiceClient(conn)
+
+	t.Run("Calls for the organization of the token should be allowed", func(t *testing.T) {
+		_, err := gateways.ListGateways(t.Context(), &agentmanagerv1.ListGatewaysRequest{OrgName: memberOrg})
+		require.NoError(t, err)
+	})
+
+	t.Run("Calls without an organization should return InvalidArgument", func(t *testing.T) {
+		_, err := gateways.ListGateways(t.Context(), &agentmanagerv1.ListGatewaysRequest{})
+		require.Equal(t, codes.InvalidArgument, status.Code(err), err)
+
+		_, err = deployments.DeployAgent(t.Context(), &agentmanagerv1.DeployAgentRequest{ProjectName: "default", AgentName: "agent", ImageId: "image"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err), err)
+	})
+
+	t.Run("Only the gateways of the organization should be listed and fetched", func(t *testing.T) {
+		resp, err := gateways.ListGateways(t.Context(), &agentmanagerv1.ListGatewaysRequest{OrgName: memberOrg})
+		require.NoError(t, err)
+		require.Len(t, resp.GetGateways(), 1)
+		require.Equal(t, gatewayIDs[memberOrg], resp.GetGateways()[0].GetUuid())
+
+		_, err = gateways.GetGateway(t.Context(), &agentmanagerv1.GetGatewayRequest{OrgName: memberOrg, GatewayId: gatewayIDs[memberOrg]})
+		require.NoError(t, err)
+
+		_, err = gateways.GetGateway(t.Context(), &agentmanagerv1.GetGatewayRequest{OrgName: memberOrg, GatewayId: gatewayIDs[otherOrg]})
+		require.Equal(t, codes.NotFound, status.Code(err), err)
+	})
+
+	t.Run("Calls for another organization should return PermissionDenied", func(t *testing.T) {
+		_, err := gateways.ListGateways(t.Context(), &agentmanagerv1.ListGatewaysRequest{OrgName: otherOrg})
+		require.Equal(t, codes.PermissionDenied, status.Code(err), err)
+
+		_, err = gateways.GetGateway(t.Context(), &agentmanagerv1.GetGatewayRequest{OrgName: otherOrg, GatewayId: gatewayIDs[otherOrg]})
+		require.Equal(t, codes.PermissionDenied, status.Code(err), err)
+
+		_, err = deployments.DeployAgent(t.Context(), &agentmanagerv1.DeployAgentRequest{
+			OrgName:     otherOrg,
+			ProjectName: "default",
+			AgentName:   "agent",
+			ImageId:     "image",
+		})
+		require.Equal(t, codes.PermissionDenied, status.Code(err), err)
+	})
+
+	t.Run("Changes to a suspended organization should return PermissionDenied", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, "/api/v1/orgs/"+memberOrg+"/suspend", nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		t.Cleanup(func() { apitestutils.SendJSON(t, app, http.MethodPost, "/api/v1/orgs/"+memberOrg+"/resume", nil) })
+
+		_, err := deployments.DeployAgent(t.Context(), &agentmanagerv1.DeployAgentRequest{
+			OrgName:     memberOrg,
+			ProjectName: "default",
+			AgentName:   "agent",
+			ImageId:     "image",
+		})
+		require.Equal(t, codes.PermissionDenied, status.Code(err), err)
+
+		// Reads are still allowed
+		_, err = gateways.ListGateways(t.Context(), &agentmanagerv1.ListGatewaysRequest{OrgName: memberOrg})
+		require.NoError(t, err)
+	})
+}
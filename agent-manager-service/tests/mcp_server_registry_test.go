@@ -0,0 +1,153 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+var testMCPOrgName = fmt.Sprintf("mcp-org-%s", uuid.New().String()[:5])
+
+// newFakeMCPServer serves the initialize handshake and a tools/list response over an event stream,
+// rejecting requests without the expected API key
+func newFakeMCPServer(t *testing.T, apiKey string, tools []string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-API-Key") != apiKey {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var msg struct {
+			ID     *int   `json:"id"`
+			Method string `json:"method"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&msg))
+		if msg.ID == nil {
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+
+		var result any
+		switch msg.Method {
+		case "initialize":
+			w.Header().Set("Mcp-Session-Id", "session-1")
+			result = map[string]any{"protocolVersion": "2025-03-26", "capabilities": map[string]any{"tools": map[string]any{}}}
+		case "tools/list":
+			require.Equal(t, "session-1", r.Header.Get("Mcp-Session-Id"))
+			list := make([]map[string]any, len(tools))
+			for i, name := range tools {
+				list[i] = map[string]any{"name": name, "inputSchema": map[string]any{"type": "object"}}
+			}
+			result = map[string]any{"tools": list}
+		}
+		body, err := json.Marshal(map[string]any{"jsonrpc": "2.0", "id": *msg.ID, "result": result})
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = fmt.Fprintf(w, "event: message\ndata: %s\n\n", body)
+	}))
+}
+
+func TestMCPServerRegistry(t *testing.T) {
+	cfg := config.GetConfig()
+	previousKey := cfg.CredentialsEncryptionKey
+	cfg.CredentialsEncryptionKey = base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{7}, 32))
+	t.Cleanup(func() { cfg.CredentialsEncryptionKey = previousKey })
+
+	mcpServer := newFakeMCPServer(t, "secret-key", []string{"search", "fetch"})
+	defer mcpServer.Close()
+
+	authMiddleware := jwtassertion.NewMockMiddleware(t)
+	testClients := wiring.TestClients{
+		OpenChoreoClient: apitestutils.CreateMockOpenChoreoClient(),
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+	serversURL := fmt.Sprintf("/api/v1/orgs/%s/mcp-servers", testMCPOrgName)
+
+	t.Run("Registering an MCP server should fetch and cache its tools", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, serversURL, models.CreateMCPServerRequest{
+			Name:       "search-tools",
+			URL:        mcpServer.URL,
+			GatewayURL: "https://gw.example.com/mcp/search-tools",
+			Auth:       &models.MCPServerAuth{Type: models.MCPAuthTypeHeader, Header: "X-API-Key", Credential: "secret-key"},
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+		var server models.MCPServerResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &server))
+		require.Empty(t, server.LastFetchError)
+		require.NotNil(t, server.ToolsFetchedAt)
+		require.Len(t, server.Tools, 2)
+		require.Equal(t, "search", server.Tools[0].Name)
+		require.NotContains(t, rr.Body.String(), "secret-key")
+	})
+
+	t.Run("Registering a duplicate MCP server should return 409", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, serversURL, models.CreateMCPServerRequest{Name: "search-tools", URL: mcpServer.URL})
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+	})
+
+	t.Run("A failed tool refresh should keep the cached tools", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPut, serversURL+"/search-tools", models.UpdateMCPServerRequest{
+			Auth: &models.MCPServerAuth{Type: models.MCPAuthTypeHeader, Header: "X-API-Key", Credential: "wrong-key"},
+		})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var server models.MCPServerResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &server))
+		require.NotEmpty(t, server.LastFetchError)
+		require.Len(t, server.Tools, 2)
+	})
+
+	t.Run("Binding MCP servers to an agent should list them", func(t *testing.T) {
+		agentURL := fmt.Sprintf("/api/v1/orgs/%s/projects/default/agents/my-agent/mcp-servers", testMCPOrgName)
+		rr := apitestutils.SendJSON(t, app, http.MethodPut, agentURL, models.AgentMCPServersRequest{MCPServers: []string{"search-tools"}})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		rr = apitestutils.SendJSON(t, app, http.MethodGet, agentURL, nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var list models.MCPServerListResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &list))
+		require.Len(t, list.MCPServers, 1)
+		require.Equal(t, "search-tools", list.MCPServers[0].Name)
+
+		rr = apitestutils.SendJSON(t, app, http.MethodPut, agentURL, models.AgentMCPServersRequest{MCPServers: []string{"unknown"}})
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+	})
+
+	t.Run("Deleting an MCP server should remove it", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodDelete, serversURL+"/search-tools", nil)
+		require.Equal(t, http.StatusNoContent, rr.Code, rr.Body.String())
+
+		rr = apitestutils.SendJSON(t, app, http.MethodGet, serversURL+"/search-tools", nil)
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+	})
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/clientmocks"
+	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+func TestGetModelUsage(t *testing.T) {
+	usageOrgName := fmt.Sprintf("usage-org-%s", uuid.New().String()[:5])
+	authMiddleware := jwtassertion.NewMockMiddleware(t)
+
+	newApp := func(t *testing.T, agentUids []string) (http.Handler, *clientmocks.TraceObserverClientMock) {
+		cost := 0.42
+		traceObserverClient := &clientmocks.TraceObserverClientMock{
+			GetModelUsageFunc: func(ctx context.Context, params traceobserversvc.ModelUsageParams) (*traceobserversvc.ModelUsageResponse, error) {
+				return &traceobserversvc.ModelUsageResponse{
+					Models: []traceobserversvc.ModelUsage{{
+						Model:      "gpt-4o",
+						Provider:   "openai",
+						UsageStats: traceobserversvc.UsageStats{RequestCount: 10, ErrorCount: 1, ErrorRate: 0.1, P95LatencyMs: 1200, EstimatedCost: &cost},
+					}},
+					Providers: []traceobserversvc.ProviderUsage{{
+						Provider:   "openai",
+						UsageStats: traceobserversvc.UsageStats{RequestCount: 10, ErrorCount: 1, ErrorRate: 0.1},
+					}},
+				}, nil
+			},
+		}
+		openChoreoClient := apitestutils.CreateMockOpenChoreoClient()
+		openChoreoClient.ListProjectsFunc = func(ctx context.Context, namespaceName string) ([]*models.ProjectResponse, error) {
+			return []*models.ProjectResponse{{Name: "default"}}, nil
+		}
+		openChoreoClient.ListComponentsFunc = func(ctx context.Context, namespaceName, projectName string) ([]*models.AgentResponse, error) {
+			agents := make([]*models.AgentResponse, len(agentUids))
+			for i, uid := range agentUids {
+				agents[i] = &models.AgentResponse{UUID: uid, Name: fmt.Sprintf("agent-%d", i)}
+			}
+			return agents, nil
+		}
+		testClients := wiring.TestClients{
+			OpenChoreoClient:    openChoreoClient,
+			TraceObserverClient: traceObserverClient,
+		}
+		return apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware), traceObserverClient
+	}
+
+	t.Run("Getting model usage should aggregate over the agents of the org", func(t *testing.T) {
+		app, traceObserverClient := newApp(t, []string{"agent-uid-1", "agent-uid-2"})
+
+		url := fmt.Sprintf("/api/v1/orgs/%s/analytics/models?environment=Development&startTime=2025-12-16T00:00:00Z&endTime=2025-12-17T00:00:00Z", usageOrgName)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, url, nil))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var response models.ModelUsageResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Equal(t, 2, response.AgentCount)
+		require.Len(t, response.Models, 1)
+		require.Equal(t, "gpt-4o", response.Models[0].Model)
+		require.Equal(t, 10, response.Models[0].RequestCount)
+		require.InDelta(t, 0.42, *response.Models[0].EstimatedCost, 1e-9)
+		require.Len(t, response.Providers, 1)
+
+		require.Len(t, traceObserverClient.GetModelUsageCalls(), 1)
+		params := traceObserverClient.GetModelUsageCalls()[0].Params
+		require.ElementsMatch(t, []string{"agent-uid-1", "agent-uid-2"}, params.ComponentUids)
+		require.Equal(t, "environment-uid-123", params.EnvironmentUid)
+		require.Equal(t, "2025-12-16T00:00:00Z", params.StartTime)
+	})
+
+	t.Run("Getting model usage of an org without agents should not query traces", func(t *testing.T) {
+		app, traceObserverClient := newApp(t, nil)
+
+		url := fmt.Sprintf("/api/v1/orgs/%s/analytics/models?environment=Development", usageOrgName)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, url, nil))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var response models.ModelUsageResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		require.Empty(t, response.Models)
+		require.Empty(t, traceObserverClient.GetModelUsageCalls())
+	})
+
+	t.Run("Getting model usage without an environment should return 400", func(t *testing.T) {
+		app, _ := newApp(t, nil)
+
+		url := fmt.Sprintf("/api/v1/orgs/%s/analytics/models", usageOrgName)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, url, nil))
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("Getting model usage with only a start time should return 400", func(t *testing.T) {
+		app, _ := newApp(t, nil)
+
+		url := fmt.Sprintf("/api/v1/orgs/%s/analytics/models?environment=Development&startTime=2025-12-16T00:00:00Z", usageOrgName)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, url, nil))
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
@@ -0,0 +1,204 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/clientmocks"
+	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+func TestNotificationDigests(t *testing.T) {
+	orgName := fmt.Sprintf("digest-org-%s", uuid.New().String()[:5])
+	projName := fmt.Sprintf("digest-project-%s", uuid.New().String()[:5])
+	agentName := fmt.Sprintf("digest-agent-%s", uuid.New().String()[:5])
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	traceObserverClient := &clientmocks.TraceObserverClientMock{
+		ListIssuesFunc: func(ctx context.Context, params traceobserversvc.ListIssuesParams) (*traceobserversvc.IssueListResponse, error) {
+			return &traceobserversvc.IssueListResponse{Issues: []traceobserversvc.Issue{
+				{ID: "new-issue", ComponentUid: params.ComponentUid, ErrorType: "TimeoutError", ToolName: "search", SpanName: "search",
+					Occurrences: 7, FirstSeen: today.Add(-12 * time.Hour), LastSeen: today.Add(-time.Hour)},
+				{ID: "old-issue", ComponentUid: params.ComponentUid, ErrorType: "KeyError", SpanName: "plan",
+					Occurrences: 40, FirstSeen: today.Add(-72 * time.Hour), LastSeen: today.Add(-2 * time.Hour)},
+			}, TotalCount: 2}, nil
+		},
+	}
+	openChoreoClient := apitestutils.CreateMockOpenChoreoClient()
+	openChoreoClient.ListProjectsFunc = func(ctx context.Context, namespaceName string) ([]*models.ProjectResponse, error) {
+		return []*models.ProjectResponse{{Name: projName}}, nil
+	}
+	openChoreoClient.ListComponentsFunc = func(ctx context.Context, namespaceName, projectName string) ([]*models.AgentResponse, error) {
+		return []*models.AgentResponse{{UUID: "digest-agent-uid", Name: agentName}}, nil
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{
+		OpenChoreoClient:    openChoreoClient,
+		TraceObserverClient: traceObserverClient,
+	}, jwtassertion.NewMockMiddleware(t))
+
+	var (
+		mu       sync.Mutex
+		received [][]byte
+		status   = http.StatusOK
+	)
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		defer mu.Unlock()
+		received = append(received, body)
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(receiver.Close)
+	lastReceived := func() []byte {
+		mu.Lock()
+		defer mu.Unlock()
+		require.NotEmpty(t, received)
+		return received[len(received)-1]
+	}
+
+	digestsURL := fmt.Sprintf("/api/v1/orgs/%s/notification-digests", orgName)
+
+	rr := apitestutils.SendJSON(t, app, http.MethodPost, fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/token-budgets", orgName, projName, agentName),
+		models.AgentTokenBudgetRequest{Environment: "Development", Period: models.TokenBudgetPeriodMonthly, TokenLimit: 5000})
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+	var webhook models.NotificationDigestResponse
+	t.Run("Creating a digest should schedule it from the current period", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, digestsURL, models.NotificationDigestRequest{
+			Name:      "Daily summary",
+			Frequency: models.NotificationDigestFrequencyDaily,
+			Channel:   models.NotificationChannelWebhook,
+			URL:       receiver.URL + "/hooks/secret-path",
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&webhook))
+		require.True(t, webhook.Enabled)
+		require.Equal(t, receiver.URL, webhook.URLHost, "the path of the URL should not be returned")
+		require.True(t, webhook.LastPeriodEnd.Equal(today))
+		require.Nil(t, webhook.LastSentAt)
+	})
+
+	t.Run("Creating an invalid digest should return 400", func(t *testing.T) {
+		for name, req := range map[string]models.NotificationDigestRequest{
+			"unknown frequency": {Name: "d", Frequency: "hourly", Channel: models.NotificationChannelWebhook, URL: receiver.URL},
+			"unknown channel":   {Name: "d", Frequency: models.NotificationDigestFrequencyDaily, Channel: "pager", URL: receiver.URL},
+			"missing URL":       {Name: "d", Frequency: models.NotificationDigestFrequencyDaily, Channel: models.NotificationChannelWebhook},
+			"non-HTTP URL":      {Name: "d", Frequency: models.NotificationDigestFrequencyDaily, Channel: models.NotificationChannelWebhook, URL: "ftp://example.com/hook"},
+			"missing name":      {Frequency: models.NotificationDigestFrequencyDaily, Channel: models.NotificationChannelWebhook, URL: receiver.URL},
+		} {
+			rr := apitestutils.SendJSON(t, app, http.MethodPost, digestsURL, req)
+			require.Equal(t, http.StatusBadRequest, rr.Code, name)
+		}
+	})
+
+	t.Run("Sending a digest should post the activity of the last period as JSON", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, digestsURL+"/"+webhook.ID+"/send", nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var payload struct {
+			Event string `json:"event"`
+			models.NotificationDigestReport
+		}
+		require.NoError(t, json.Unmarshal(lastReceived(), &payload))
+		require.Equal(t, "notification_digest", payload.Event)
+		require.Equal(t, orgName, payload.OrganizationName)
+		require.True(t, payload.PeriodEnd.Equal(today))
+		require.True(t, payload.PeriodStart.Equal(today.AddDate(0, 0, -1)))
+		require.Len(t, payload.NewIssues, 1, "issues first seen before the period should be left out")
+		require.Equal(t, "new-issue", payload.NewIssues[0].IssueID)
+		require.Equal(t, agentName, payload.NewIssues[0].AgentName)
+		require.Len(t, payload.Budgets, 1)
+		require.Equal(t, int64(5000), payload.Budgets[0].TokenLimit)
+		require.Empty(t, payload.Alerts)
+
+		rr = apitestutils.SendJSON(t, app, http.MethodGet, digestsURL+"/"+webhook.ID, nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var digest models.NotificationDigestResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&digest))
+		require.NotNil(t, digest.LastSentAt)
+		require.True(t, digest.LastPeriodEnd.Equal(today), "sending a digest right away should not change its schedule")
+	})
+
+	t.Run("Slack digests should be posted as text", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPut, digestsURL+"/"+webhook.ID, models.NotificationDigestRequest{
+			Name:      "Daily summary",
+			Frequency: models.NotificationDigestFrequencyDaily,
+			Channel:   models.NotificationChannelSlack,
+			URL:       receiver.URL + "/services/T000/B000/secret",
+		})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		rr = apitestutils.SendJSON(t, app, http.MethodPost, digestsURL+"/"+webhook.ID+"/send", nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var message map[string]string
+		require.NoError(t, json.Unmarshal(lastReceived(), &message))
+		require.Contains(t, message["text"], "*New issues:* 1")
+		require.Contains(t, message["text"], "TimeoutError in search (7 occurrences)")
+		require.Contains(t, message["text"], "0 of 5000 monthly tokens")
+	})
+
+	t.Run("A failed delivery should return 502 and be recorded", func(t *testing.T) {
+		mu.Lock()
+		status = http.StatusInternalServerError
+		mu.Unlock()
+
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, digestsURL+"/"+webhook.ID+"/send", nil)
+		require.Equal(t, http.StatusBadGateway, rr.Code, rr.Body.String())
+
+		rr = apitestutils.SendJSON(t, app, http.MethodGet, digestsURL+"/"+webhook.ID, nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var digest models.NotificationDigestResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&digest))
+		require.Contains(t, digest.LastError, "status 500")
+	})
+
+	t.Run("Listing digests should return those of the organization", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodGet, digestsURL, nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var list models.NotificationDigestListResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&list))
+		require.Len(t, list.Digests, 1)
+		require.Equal(t, webhook.ID, list.Digests[0].ID)
+	})
+
+	t.Run("Deleting a digest should remove it", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodDelete, digestsURL+"/"+webhook.ID, nil)
+		require.Equal(t, http.StatusNoContent, rr.Code, rr.Body.String())
+
+		rr = apitestutils.SendJSON(t, app, http.MethodGet, digestsURL+"/"+webhook.ID, nil)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+		rr = apitestutils.SendJSON(t, app, http.MethodDelete, digestsURL+"/"+webhook.ID, nil)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	apiplatformclient "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/apiplatformsvc/client"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+var testLifecycleOrgName = fmt.Sprintf("lifecycle-org-%s", uuid.New().String()[:5])
+
+func TestOrganizationLifecycle(t *testing.T) {
+	authMiddleware := apitestutils.NewPlatformAdminMiddleware(t)
+	apiPlatformClient := apiplatformclient.NewInMemoryAPIPlatformClient()
+	testClients := wiring.TestClients{
+		OpenChoreoClient:  apitestutils.CreateMockOpenChoreoClient(),
+		APIPlatformClient: apiPlatformClient,
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+	orgURL := fmt.Sprintf("/api/v1/orgs/%s", testLifecycleOrgName)
+	var defaultEnvURL string
+
+	t.Run("Creating an organization should create the default environment and gateway", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, "/api/v1/orgs", models.CreateOrganizationRequest{
+			Name:     testLifecycleOrgName,
+			Metadata: map[string]string{"tier": "trial"},
+			DefaultGateway: &models.OrganizationGatewayConfig{
+				Name:  testLifecycleOrgName + "-gw",
+				VHost: "gw.example.com",
+			},
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+		var org models.OrganizationDetailsResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &org))
+		require.Equal(t, string(models.OrganizationStatusActive), org.Status)
+		require.Equal(t, "trial", org.Metadata["tier"])
+		require.NotNil(t, org.DefaultEnvironment)
+		require.Equal(t, "default", org.DefaultEnvironment.Name)
+		defaultEnvURL = fmt.Sprintf("%s/environments/%s", orgURL, org.DefaultEnvironment.UUID)
+		require.NotEmpty(t, org.DefaultGatewayID)
+	})
+
+	t.Run("Creating a duplicate organization should return 409", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, "/api/v1/orgs", models.CreateOrganizationRequest{Name: testLifecycleOrgName})
+		require.Equal(t, http.StatusConflict, rr.Code)
+	})
+
+	t.Run("Updating metadata should replace it", func(t *testing.T) {
+		displayName := "Lifecycle Org"
+		rr := apitestutils.SendJSON(t, app, http.MethodPut, orgURL, models.UpdateOrganizationRequest{
+			DisplayName: &displayName,
+			Metadata:    map[string]string{"tier": "enterprise"},
+		})
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var org models.OrganizationDetailsResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &org))
+		require.Equal(t, displayName, org.DisplayName)
+		require.Equal(t, map[string]string{"tier": "enterprise"}, org.Metadata)
+	})
+
+	t.Run("Members without the platform admin scope should not manage organizations", func(t *testing.T) {
+		member := apitestutils.MakeAppClientWithDeps(t, testClients, jwtassertion.NewMockMiddleware(t))
+		for _, call := range []struct {
+			method, url string
+			body        any
+		}{
+			{http.MethodPost, "/api/v1/orgs", models.CreateOrganizationRequest{Name: testLifecycleOrgName + "-member"}},
+			{http.MethodPut, orgURL, models.UpdateOrganizationRequest{Metadata: map[string]string{"tier": "free"}}},
+			{http.MethodPost, orgURL + "/suspend", nil},
+			{http.MethodPost, orgURL + "/resume", nil},
+			{http.MethodPost, orgURL + "/trial-sandbox", nil},
+			{http.MethodPost, orgURL + "/trial-sandbox/end", nil},
+			{http.MethodDelete, orgURL, nil},
+		} {
+			rr := apitestutils.SendJSON(t, member, call.method, call.url, call.body)
+			require.Equal(t, http.StatusForbidden, rr.Code, "%s %s", call.method, call.url)
+			require.Contains(t, rr.Body.String(), "NOT_PLATFORM_ADMIN")
+		}
+
+		rr := apitestutils.SendJSON(t, app, http.MethodGet, orgURL, nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("A suspended organization should reject changes until it is resumed", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, orgURL+"/suspend", nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		envReq := map[string]any{"name": "dev", "displayName": "Dev", "dataplaneRef": "default", "dnsPrefix": "dev"}
+		rr = apitestutils.SendJSON(t, app, http.MethodPost, orgURL+"/environments", envReq)
+		require.Equal(t, http.StatusForbidden, rr.Code)
+
+		rr = apitestutils.SendJSON(t, app, http.MethodGet, defaultEnvURL, nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		rr = apitestutils.SendJSON(t, app, http.MethodPost, orgURL+"/resume", nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		rr = apitestutils.SendJSON(t, app, http.MethodPost, orgURL+"/environments", envReq)
+		require.Equal(t, http.StatusCreated, rr.Code)
+	})
+
+	t.Run("Deleting an organization should remove its environments and gateways", func(t *testing.T) {
+		// Data of other features that must not be inherited by an organization onboarded again under the same name
+		ctx := t.Context()
+		now := time.Now()
+		require.NoError(t, db.DB(ctx).Create(&models.ScimUser{UUID: uuid.New(), OrganizationName: testLifecycleOrgName, UserName: "alice", Emails: []models.ScimEmail{}, Active: true, CreatedAt: now, UpdatedAt: now}).Error)
+		require.NoError(t, db.DB(ctx).Create(&models.DeploymentApprovalPolicy{OrganizationName: testLifecycleOrgName, Reviewers: []string{"alice"}, UpdatedAt: now}).Error)
+		require.NoError(t, db.DB(ctx).Create(&models.EmailRecipient{UUID: uuid.New(), OrganizationName: testLifecycleOrgName, Address: "ops@example.com", Notifications: []string{}, CreatedAt: now, UpdatedAt: now}).Error)
+		require.NoError(t, db.DB(ctx).Create(&models.ChangeFreezeWindow{UUID: uuid.New(), OrganizationName: testLifecycleOrgName, Name: "Launch", StartTime: now.Add(time.Hour), EndTime: now.Add(2 * time.Hour), CreatedAt: now}).Error)
+
+		rr := apitestutils.SendJSON(t, app, http.MethodDelete, orgURL, nil)
+		require.Equal(t, http.StatusNoContent, rr.Code)
+
+		tables, err := db.DB(ctx).Migrator().GetTables()
+		require.NoError(t, err)
+		for _, table := range tables {
+			if !db.DB(ctx).Migrator().HasColumn(table, "organization_name") {
+				continue
+			}
+			var count int64
+			require.NoError(t, db.DB(ctx).Table(table).Where("organization_name = ?", testLifecycleOrgName).Count(&count).Error)
+			require.Zero(t, count, "rows of the deleted organization are left in %s", table)
+		}
+
+		gateways, err := apiPlatformClient.ListGateways(t.Context(), apiplatformclient.GatewayFilters{})
+		require.NoError(t, err)
+		for _, gw := range gateways.Gateways {
+			require.NotEqual(t, testLifecycleOrgName+"-gw", gw.Name)
+		}
+
+		rr = apitestutils.SendJSON(t, app, http.MethodGet, defaultEnvURL, nil)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+
+		rr = apitestutils.SendJSON(t, app, http.MethodDelete, orgURL, nil)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	apiplatformclient "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/apiplatformsvc/client"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+var testRegionsOrgName = fmt.Sprintf("regions-org-%s", uuid.New().String()[:5])
+
+func TestRegions(t *testing.T) {
+	authMiddleware := apitestutils.NewPlatformAdminMiddleware(t)
+	testClients := wiring.TestClients{
+		OpenChoreoClient:  apitestutils.CreateMockOpenChoreoClient(),
+		APIPlatformClient: apiplatformclient.NewInMemoryAPIPlatformClient(),
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+	orgURL := fmt.Sprintf("/api/v1/orgs/%s", testRegionsOrgName)
+	rr := apitestutils.SendJSON(t, app, http.MethodPost, "/api/v1/orgs", models.CreateOrganizationRequest{Name: testRegionsOrgName})
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+	var usEnvID string
+
+	t.Run("Environments should only be placed in regions of the catalog", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, orgURL+"/environments", map[string]any{
+			"name": "eu-prod", "displayName": "EU Prod", "dataplaneRef": "default", "dnsPrefix": "eu", "region": "EU",
+		})
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		require.Contains(t, rr.Body.String(), "UNKNOWN_REGION")
+
+		rr = apitestutils.SendJSON(t, app, http.MethodPost, orgURL+"/environments", map[string]any{
+			"name": "us-prod", "displayName": "US Prod", "dataplaneRef": "default", "dnsPrefix": "us", "region": "US",
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+		var env spec.GatewayEnvironmentResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &env))
+		require.Equal(t, "US", env.GetRegion())
+		usEnvID = env.Id
+	})
+
+	t.Run("Listing regions should count the environments in each region", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodGet, orgURL+"/regions", nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var regions models.RegionListResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &regions))
+		require.Equal(t, []models.Region{{Name: "US", EnvironmentCount: 1}}, regions.Regions)
+	})
+
+	t.Run("Gateways should only serve environments of their region", func(t *testing.T) {
+		gatewayReq := func(name string, region *string) spec.CreateGatewayRequest {
+			return spec.CreateGatewayRequest{
+				Name: name, DisplayName: name, GatewayType: spec.AI, Vhost: name + ".example.com", Region: region,
+			}
+		}
+
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, orgURL+"/gateways", gatewayReq("anywhere-gw", nil))
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var anywhere models.GatewayResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &anywhere))
+
+		rr = apitestutils.SendJSON(t, app, http.MethodPost, fmt.Sprintf("%s/gateways/%s/environments/%s", orgURL, anywhere.UUID, usEnvID), nil)
+		require.Equal(t, http.StatusConflict, rr.Code)
+		require.Contains(t, rr.Body.String(), "GATEWAY_REGION_MISMATCH")
+
+		us := "US"
+		req := gatewayReq("us-gw", &us)
+		req.EnvironmentIds = []string{usEnvID}
+		rr = apitestutils.SendJSON(t, app, http.MethodPost, orgURL+"/gateways", req)
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var usGateway models.GatewayResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &usGateway))
+		require.Equal(t, "US", usGateway.Region)
+		require.Len(t, usGateway.Environments, 1)
+	})
+
+	t.Run("Listing gateways by region should only return gateways of the region", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodGet, orgURL+"/gateways?region=US", nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var gateways struct {
+			Gateways []models.GatewayResponse `json:"gateways"`
+			Total    int32                    `json:"total"`
+		}
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &gateways))
+		require.Equal(t, int32(1), gateways.Total)
+		require.Equal(t, "us-gw", gateways.Gateways[0].Name)
+
+		rr = apitestutils.SendJSON(t, app, http.MethodGet, orgURL+"/gateways?region=EU", nil)
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
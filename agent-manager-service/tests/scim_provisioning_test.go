@@ -0,0 +1,139 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+var testScimOrgName = fmt.Sprintf("scim-org-%s", uuid.New().String()[:5])
+
+func TestScimProvisioning(t *testing.T) {
+	authMiddleware := jwtassertion.NewMockMiddleware(t)
+	testClients := wiring.TestClients{
+		OpenChoreoClient: apitestutils.CreateMockOpenChoreoClient(),
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+	baseURL := fmt.Sprintf("/api/v1/orgs/%s/scim/v2", testScimOrgName)
+
+	var userID, groupID string
+
+	t.Run("Provisioning a user should return 201 with a location", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, baseURL+"/Users", map[string]any{
+			"schemas":  []string{models.ScimSchemaUser},
+			"userName": "alice@example.com",
+			"name":     map[string]string{"givenName": "Alice", "familyName": "Smith"},
+			"emails":   []map[string]any{{"value": "alice@example.com", "primary": true}},
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		require.Equal(t, "application/scim+json", rr.Header().Get("Content-Type"))
+
+		var user models.ScimUserResource
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &user))
+		require.NotEmpty(t, user.ID)
+		require.True(t, *user.Active)
+		require.Equal(t, rr.Header().Get("Location"), user.Meta.Location)
+		userID = user.ID
+
+		rr = apitestutils.SendJSON(t, app, http.MethodPost, baseURL+"/Users", map[string]any{"userName": "ALICE@example.com"})
+		require.Equal(t, http.StatusConflict, rr.Code)
+		require.Contains(t, rr.Body.String(), `"scimType":"uniqueness"`)
+	})
+
+	t.Run("Filtering users by userName should find the user", func(t *testing.T) {
+		filter := url.QueryEscape(`userName eq "alice@example.com"`)
+		rr := apitestutils.SendJSON(t, app, http.MethodGet, baseURL+"/Users?filter="+filter, nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var list struct {
+			TotalResults int                       `json:"totalResults"`
+			Resources    []models.ScimUserResource `json:"Resources"`
+		}
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &list))
+		require.Equal(t, 1, list.TotalResults)
+		require.Equal(t, userID, list.Resources[0].ID)
+
+		rr = apitestutils.SendJSON(t, app, http.MethodGet, baseURL+"/Users?filter="+url.QueryEscape(`title co "x"`), nil)
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		require.Contains(t, rr.Body.String(), `"scimType":"invalidFilter"`)
+	})
+
+	t.Run("Provisioning a group should add its members", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, baseURL+"/Groups", map[string]any{
+			"schemas":     []string{models.ScimSchemaGroup},
+			"displayName": "agent-admins",
+			"members":     []map[string]string{{"value": userID}},
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+		var group models.ScimGroupResource
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &group))
+		require.Len(t, group.Members, 1)
+		groupID = group.ID
+
+		rr = apitestutils.SendJSON(t, app, http.MethodGet, baseURL+"/Users/"+userID, nil)
+		var user models.ScimUserResource
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &user))
+		require.Len(t, user.Groups, 1)
+		require.Equal(t, "agent-admins", user.Groups[0].Display)
+	})
+
+	t.Run("Patching should deactivate users and remove members", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPatch, baseURL+"/Users/"+userID, models.ScimPatchRequest{
+			Schemas:    []string{models.ScimSchemaPatchOp},
+			Operations: []models.ScimPatchOperation{{Op: "Replace", Value: map[string]any{"active": "False"}}},
+		})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var user models.ScimUserResource
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &user))
+		require.False(t, *user.Active)
+
+		rr = apitestutils.SendJSON(t, app, http.MethodPatch, baseURL+"/Groups/"+groupID, models.ScimPatchRequest{
+			Schemas:    []string{models.ScimSchemaPatchOp},
+			Operations: []models.ScimPatchOperation{{Op: "remove", Path: fmt.Sprintf(`members[value eq "%s"]`, userID)}},
+		})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var group models.ScimGroupResource
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &group))
+		require.Empty(t, group.Members)
+	})
+
+	t.Run("Deleting resources should return 204 and then 404", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodDelete, baseURL+"/Users/"+userID, nil)
+		require.Equal(t, http.StatusNoContent, rr.Code)
+		rr = apitestutils.SendJSON(t, app, http.MethodGet, baseURL+"/Users/"+userID, nil)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+
+		rr = apitestutils.SendJSON(t, app, http.MethodDelete, baseURL+"/Groups/"+groupID, nil)
+		require.Equal(t, http.StatusNoContent, rr.Code)
+		rr = apitestutils.SendJSON(t, app, http.MethodGet, baseURL+"/Groups/"+groupID, nil)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
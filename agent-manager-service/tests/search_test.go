@@ -0,0 +1,121 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	apiplatformclient "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/apiplatformsvc/client"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+var testSearchOrgName = fmt.Sprintf("search-org-%s", uuid.New().String()[:5])
+
+func TestSearch(t *testing.T) {
+	authMiddleware := apitestutils.NewPlatformAdminMiddleware(t)
+	openChoreoClient := apitestutils.CreateMockOpenChoreoClient()
+	openChoreoClient.ListProjectsFunc = func(ctx context.Context, namespaceName string) ([]*models.ProjectResponse, error) {
+		return []*models.ProjectResponse{{UUID: uuid.NewString(), Name: "support", OrgName: namespaceName}}, nil
+	}
+	openChoreoClient.ListComponentsFunc = func(ctx context.Context, namespaceName string, projectName string) ([]*models.AgentResponse, error) {
+		return []*models.AgentResponse{
+			{UUID: uuid.NewString(), Name: "triage", Description: "Routes support tickets", ProjectName: projectName},
+			{UUID: uuid.NewString(), Name: "support-bot", ProjectName: projectName},
+			{UUID: uuid.NewString(), Name: "billing", ProjectName: projectName},
+		}, nil
+	}
+	testClients := wiring.TestClients{
+		OpenChoreoClient:  openChoreoClient,
+		APIPlatformClient: apiplatformclient.NewInMemoryAPIPlatformClient(),
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+	orgURL := fmt.Sprintf("/api/v1/orgs/%s", testSearchOrgName)
+	rr := apitestutils.SendJSON(t, app, http.MethodPost, "/api/v1/orgs", models.CreateOrganizationRequest{Name: testSearchOrgName})
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	rr = apitestutils.SendJSON(t, app, http.MethodPost, orgURL+"/gateways", spec.CreateGatewayRequest{
+		Name: "support-gw", DisplayName: "Support", GatewayType: spec.AI, Vhost: "support.example.com",
+	})
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+	// A gateway of another organization, which the API Platform lists together with the others
+	otherOrgName := fmt.Sprintf("search-other-%s", uuid.New().String()[:5])
+	rr = apitestutils.SendJSON(t, app, http.MethodPost, "/api/v1/orgs", models.CreateOrganizationRequest{Name: otherOrgName})
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+	rr = apitestutils.SendJSON(t, app, http.MethodPost, "/api/v1/orgs/"+otherOrgName+"/gateways", spec.CreateGatewayRequest{
+		Name: "support-other-gw", DisplayName: "Other support", GatewayType: spec.AI, Vhost: "support.other.example.com",
+	})
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+	search := func(query string) models.SearchResponse {
+		rr := apitestutils.SendJSON(t, app, http.MethodGet, orgURL+"/search?"+query, nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var response models.SearchResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		return response
+	}
+
+	t.Run("Results should be ordered by relevance", func(t *testing.T) {
+		response := search("q=Support")
+
+		require.Equal(t, 4, response.Total)
+		names := make([]string, len(response.Results))
+		for i, result := range response.Results {
+			names[i] = result.Type + "/" + result.Name
+		}
+		require.Equal(t, []string{"project/support", "agent/support-bot", "gateway/support-gw", "agent/triage"}, names)
+		require.Equal(t, "support", response.Results[1].ProjectName)
+	})
+
+	t.Run("Results should be limited to the given types", func(t *testing.T) {
+		response := search("q=support&types=gateway,mcpServer")
+
+		require.Len(t, response.Results, 1)
+		require.Equal(t, models.SearchResultTypeGateway, response.Results[0].Type)
+	})
+
+	t.Run("Gateways of other organizations should not be found", func(t *testing.T) {
+		response := search("q=support-other&types=gateway")
+
+		require.Empty(t, response.Results)
+		require.Equal(t, 0, response.Total)
+	})
+
+	t.Run("The limit should not change the total", func(t *testing.T) {
+		response := search("q=support&limit=1")
+
+		require.Len(t, response.Results, 1)
+		require.Equal(t, 4, response.Total)
+	})
+
+	t.Run("Invalid parameters should return 400", func(t *testing.T) {
+		for _, query := range []string{"", "q=%20", "q=support&types=provider", "q=support&limit=0"} {
+			rr := apitestutils.SendJSON(t, app, http.MethodGet, orgURL+"/search?"+query, nil)
+			require.Equal(t, http.StatusBadRequest, rr.Code, query)
+		}
+	})
+}
@@ -0,0 +1,255 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	apiplatformclient "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/apiplatformsvc/client"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/clientmocks"
+	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+func TestAgentTokenBudgets(t *testing.T) {
+	orgName := fmt.Sprintf("budget-org-%s", uuid.New().String()[:5])
+	projName := fmt.Sprintf("budget-project-%s", uuid.New().String()[:5])
+	agentName := fmt.Sprintf("budget-agent-%s", uuid.New().String()[:5])
+	proxyID := uuid.New().String()
+	var usedTokens int
+
+	traceObserverClient := &clientmocks.TraceObserverClientMock{
+		GetModelUsageFunc: func(ctx context.Context, params traceobserversvc.ModelUsageParams) (*traceobserversvc.ModelUsageResponse, error) {
+			return &traceobserversvc.ModelUsageResponse{
+				Models: []traceobserversvc.ModelUsage{
+					{Model: "gpt-4o", UsageStats: traceobserversvc.UsageStats{TotalTokens: usedTokens / 2}},
+					{Model: "gpt-4o-mini", UsageStats: traceobserversvc.UsageStats{TotalTokens: usedTokens - usedTokens/2}},
+				},
+			}, nil
+		},
+	}
+	apiPlatformClient := &clientmocks.APIPlatformClientMock{
+		SetLLMProxyPolicyFunc: func(ctx context.Context, id string, policy apiplatformclient.LLMProxyPolicy) error {
+			if id != proxyID {
+				return utils.ErrLLMProxyNotFound
+			}
+			return nil
+		},
+		RemoveLLMProxyPolicyFunc: func(ctx context.Context, id string, policyName string) error {
+			return nil
+		},
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{
+		OpenChoreoClient:    apitestutils.CreateMockOpenChoreoClient(),
+		TraceObserverClient: traceObserverClient,
+		APIPlatformClient:   apiPlatformClient,
+	}, jwtassertion.NewMockMiddleware(t))
+
+	budgetsURL := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/token-budgets", orgName, projName, agentName)
+	getBudget := func(t *testing.T, id string) models.AgentTokenBudgetResponse {
+		rr := apitestutils.SendJSON(t, app, http.MethodGet, budgetsURL+"/"+id, nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var budget models.AgentTokenBudgetResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&budget))
+		return budget
+	}
+	dailyBudget := models.AgentTokenBudgetRequest{
+		Environment: "Development",
+		Period:      models.TokenBudgetPeriodDaily,
+		TokenLimit:  1000,
+	}
+
+	var daily models.AgentTokenBudgetResponse
+	t.Run("Creating a budget should default to soft enforcement at 80%", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, budgetsURL, dailyBudget)
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&daily))
+		require.Equal(t, int64(1000), daily.TokenLimit)
+		require.Equal(t, 80, daily.SoftLimitPercent)
+		require.Equal(t, models.TokenBudgetEnforcementSoft, daily.Enforcement)
+		require.Equal(t, models.TokenBudgetStatusWithinBudget, daily.Status)
+		require.Empty(t, apiPlatformClient.SetLLMProxyPolicyCalls())
+	})
+
+	t.Run("Creating a second budget for the same period should return 409", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, budgetsURL, dailyBudget)
+		require.Equal(t, http.StatusConflict, rr.Code)
+	})
+
+	t.Run("Creating an invalid budget should return 400", func(t *testing.T) {
+		for name, req := range map[string]models.AgentTokenBudgetRequest{
+			"unknown period":       {Environment: "Development", Period: "weekly", TokenLimit: 1000},
+			"missing limit":        {Environment: "Development", Period: models.TokenBudgetPeriodMonthly},
+			"soft limit over 100":  {Environment: "Development", Period: models.TokenBudgetPeriodMonthly, TokenLimit: 1000, SoftLimitPercent: 120},
+			"unknown enforcement":  {Environment: "Development", Period: models.TokenBudgetPeriodMonthly, TokenLimit: 1000, Enforcement: "block"},
+			"hard without a proxy": {Environment: "Development", Period: models.TokenBudgetPeriodMonthly, TokenLimit: 1000, Enforcement: models.TokenBudgetEnforcementHard},
+			"missing environment":  {Period: models.TokenBudgetPeriodMonthly, TokenLimit: 1000},
+		} {
+			rr := apitestutils.SendJSON(t, app, http.MethodPost, budgetsURL, req)
+			require.Equal(t, http.StatusBadRequest, rr.Code, name)
+		}
+	})
+
+	t.Run("Getting a budget should read back the usage and record events", func(t *testing.T) {
+		usedTokens = 500
+		budget := getBudget(t, daily.ID)
+		require.Equal(t, int64(500), budget.UsedTokens)
+		require.Equal(t, models.TokenBudgetStatusWithinBudget, budget.Status)
+		require.NotNil(t, budget.PeriodStart)
+		require.NotNil(t, budget.LastCheckedAt)
+
+		calls := traceObserverClient.GetModelUsageCalls()
+		require.Len(t, calls, 1)
+		require.Equal(t, []string{"component-uid-123"}, calls[0].Params.ComponentUids)
+		require.Equal(t, "environment-uid-123", calls[0].Params.EnvironmentUid)
+		require.Equal(t, budget.PeriodStart.Format("2006-01-02T15:04:05Z07:00"), calls[0].Params.StartTime)
+
+		usedTokens = 850
+		budget = getBudget(t, daily.ID)
+		require.Equal(t, models.TokenBudgetStatusSoftLimitReached, budget.Status)
+
+		usedTokens = 1200
+		budget = getBudget(t, daily.ID)
+		require.Equal(t, models.TokenBudgetStatusExceeded, budget.Status)
+		budget = getBudget(t, daily.ID)
+		require.Equal(t, int64(1200), budget.UsedTokens)
+
+		rr := apitestutils.SendJSON(t, app, http.MethodGet, budgetsURL+"/"+daily.ID+"/events", nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var events models.AgentTokenBudgetEventListResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&events))
+		require.Len(t, events.Events, 2)
+		types := []string{events.Events[0].Type, events.Events[1].Type}
+		require.ElementsMatch(t, []string{models.TokenBudgetEventSoftLimitReached, models.TokenBudgetEventLimitExceeded}, types)
+		for _, event := range events.Events {
+			require.False(t, event.Enforced)
+			require.Equal(t, int64(1000), event.TokenLimit)
+		}
+	})
+
+	var monthly models.AgentTokenBudgetResponse
+	t.Run("Creating a hard budget should limit the tokens of the LLM proxy", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, budgetsURL, models.AgentTokenBudgetRequest{
+			Environment: "Development",
+			Period:      models.TokenBudgetPeriodMonthly,
+			TokenLimit:  20000,
+			Enforcement: models.TokenBudgetEnforcementHard,
+			LLMProxyID:  proxyID,
+		})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&monthly))
+
+		calls := apiPlatformClient.SetLLMProxyPolicyCalls()
+		require.Len(t, calls, 1)
+		require.Equal(t, proxyID, calls[0].ProxyID)
+		require.Equal(t, "token-based-ratelimit", calls[0].Policy.Name)
+		limits := calls[0].Policy.Params["totalTokenLimits"].([]interface{})
+		require.Len(t, limits, 1)
+		require.Equal(t, map[string]interface{}{"count": int64(20000), "duration": "720h"}, limits[0])
+	})
+
+	t.Run("Making a budget hard should add it to the limit of the LLM proxy", func(t *testing.T) {
+		req := dailyBudget
+		req.Enforcement = models.TokenBudgetEnforcementHard
+		req.LLMProxyID = proxyID
+		rr := apitestutils.SendJSON(t, app, http.MethodPut, budgetsURL+"/"+daily.ID, req)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		calls := apiPlatformClient.SetLLMProxyPolicyCalls()
+		require.Len(t, calls, 2)
+		limits := calls[1].Policy.Params["totalTokenLimits"].([]interface{})
+		require.Equal(t, []interface{}{
+			map[string]interface{}{"count": int64(1000), "duration": "24h"},
+			map[string]interface{}{"count": int64(20000), "duration": "720h"},
+		}, limits)
+	})
+
+	t.Run("A hard budget on an unknown LLM proxy should return 404", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, budgetsURL, models.AgentTokenBudgetRequest{
+			Environment: "Production",
+			Period:      models.TokenBudgetPeriodDaily,
+			TokenLimit:  1000,
+			Enforcement: models.TokenBudgetEnforcementHard,
+			LLMProxyID:  "unknown-proxy",
+		})
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+
+		rr = apitestutils.SendJSON(t, app, http.MethodGet, budgetsURL, nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var list models.AgentTokenBudgetListResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&list))
+		require.Len(t, list.Budgets, 2)
+	})
+
+	t.Run("A hard budget on the LLM proxy of another agent should return 409", func(t *testing.T) {
+		otherURL := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/other-%s/token-budgets", orgName, projName, agentName)
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, otherURL, models.AgentTokenBudgetRequest{
+			Environment: "Development",
+			Period:      models.TokenBudgetPeriodDaily,
+			TokenLimit:  1000,
+			Enforcement: models.TokenBudgetEnforcementHard,
+			LLMProxyID:  proxyID,
+		})
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+	})
+
+	t.Run("Exceeding a hard budget should record an enforced event", func(t *testing.T) {
+		usedTokens = 25000
+		budget := getBudget(t, monthly.ID)
+		require.Equal(t, models.TokenBudgetStatusExceeded, budget.Status)
+
+		rr := apitestutils.SendJSON(t, app, http.MethodGet, budgetsURL+"/"+monthly.ID+"/events", nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var events models.AgentTokenBudgetEventListResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&events))
+		require.Len(t, events.Events, 2)
+		for _, event := range events.Events {
+			require.Equal(t, event.Type == models.TokenBudgetEventLimitExceeded, event.Enforced)
+		}
+	})
+
+	t.Run("Deleting the hard budgets should remove the limit of the LLM proxy", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodDelete, budgetsURL+"/"+monthly.ID, nil)
+		require.Equal(t, http.StatusNoContent, rr.Code)
+		require.Len(t, apiPlatformClient.SetLLMProxyPolicyCalls(), 4)
+		require.Empty(t, apiPlatformClient.RemoveLLMProxyPolicyCalls())
+
+		rr = apitestutils.SendJSON(t, app, http.MethodDelete, budgetsURL+"/"+daily.ID, nil)
+		require.Equal(t, http.StatusNoContent, rr.Code)
+		calls := apiPlatformClient.RemoveLLMProxyPolicyCalls()
+		require.Len(t, calls, 1)
+		require.Equal(t, proxyID, calls[0].ProxyID)
+
+		rr = apitestutils.SendJSON(t, app, http.MethodGet, budgetsURL+"/"+daily.ID, nil)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+		rr = apitestutils.SendJSON(t, app, http.MethodGet, budgetsURL+"/"+daily.ID+"/events", nil)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+		rr = apitestutils.SendJSON(t, app, http.MethodGet, budgetsURL+"/not-a-uuid", nil)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/clientmocks"
+	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+func TestTraceContentVisibility(t *testing.T) {
+	visibilityOrgName := fmt.Sprintf("visibility-org-%s", uuid.New().String()[:5])
+	authMiddleware := jwtassertion.NewMockMiddleware(t)
+
+	var listedWith, detailedWith string
+	traceObserverClient := &clientmocks.TraceObserverClientMock{
+		ListTracesFunc: func(ctx context.Context, params traceobserversvc.ListTracesParams) (*traceobserversvc.TraceOverviewResponse, error) {
+			listedWith = params.ContentVisibility
+			return &traceobserversvc.TraceOverviewResponse{}, nil
+		},
+		TraceDetailsByIdFunc: func(ctx context.Context, params traceobserversvc.TraceDetailsByIdParams) (*traceobserversvc.TraceResponse, error) {
+			detailedWith = params.ContentVisibility
+			return &traceobserversvc.TraceResponse{}, nil
+		},
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{
+		OpenChoreoClient:    apitestutils.CreateMockOpenChoreoClient(),
+		TraceObserverClient: traceObserverClient,
+	}, authMiddleware)
+
+	visibilityURL := fmt.Sprintf("/api/v1/orgs/%s/traces/content-visibility", visibilityOrgName)
+	agentURL := fmt.Sprintf("/api/v1/orgs/%s/projects/default/agents/agent-a", visibilityOrgName)
+
+	getVisibility := func(t *testing.T) models.TraceContentVisibilityResponse {
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, visibilityURL, nil))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var response models.TraceContentVisibilityResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		return response
+	}
+	setVisibility := func(t *testing.T, visibility string) *httptest.ResponseRecorder {
+		return apitestutils.SendJSON(t, app, http.MethodPut, visibilityURL, map[string]string{"contentVisibility": visibility})
+	}
+	readTraces := func(t *testing.T) {
+		for _, url := range []string{
+			agentURL + "/traces?environment=Development",
+			agentURL + "/trace/trace-1?environment=Development",
+		} {
+			rr := httptest.NewRecorder()
+			app.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, url, nil))
+			require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		}
+	}
+
+	t.Run("Organizations without a setting should get full content", func(t *testing.T) {
+		response := getVisibility(t)
+		require.Equal(t, models.TraceContentVisibilityFull, response.ContentVisibility)
+		require.Nil(t, response.UpdatedAt)
+
+		readTraces(t)
+		require.Equal(t, models.TraceContentVisibilityFull, listedWith)
+		require.Equal(t, models.TraceContentVisibilityFull, detailedWith)
+	})
+
+	t.Run("Setting the content visibility should apply it to trace reads", func(t *testing.T) {
+		rr := setVisibility(t, models.TraceContentVisibilityMetadataOnly)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		rr = setVisibility(t, models.TraceContentVisibilityRedacted)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		response := getVisibility(t)
+		require.Equal(t, models.TraceContentVisibilityRedacted, response.ContentVisibility)
+		require.NotNil(t, response.UpdatedAt)
+
+		readTraces(t)
+		require.Equal(t, models.TraceContentVisibilityRedacted, listedWith)
+		require.Equal(t, models.TraceContentVisibilityRedacted, detailedWith)
+	})
+
+	t.Run("Setting an unknown content visibility should return 400", func(t *testing.T) {
+		for _, visibility := range []string{"", "none", "FULL"} {
+			rr := setVisibility(t, visibility)
+			require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+		}
+		require.Equal(t, models.TraceContentVisibilityRedacted, getVisibility(t).ContentVisibility)
+	})
+}
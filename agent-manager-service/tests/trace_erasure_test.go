@@ -0,0 +1,161 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/clientmocks"
+	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+func TestTraceErasure(t *testing.T) {
+	erasureOrgName := fmt.Sprintf("erasure-org-%s", uuid.New().String()[:5])
+	authMiddleware := jwtassertion.NewMockMiddlewareWithClaims(t, &jwtassertion.TokenClaims{
+		Sub:   "privacy-officer",
+		Scope: "scopes",
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	})
+
+	taskCompleted := false
+	traceObserverClient := &clientmocks.TraceObserverClientMock{
+		EraseSpansFunc: func(ctx context.Context, params traceobserversvc.EraseSpansParams) (*traceobserversvc.DeleteSpansResponse, error) {
+			return &traceobserversvc.DeleteSpansResponse{TaskID: "erase-task-1"}, nil
+		},
+		GetTaskFunc: func(ctx context.Context, taskID string) (*traceobserversvc.TaskStatus, error) {
+			return &traceobserversvc.TaskStatus{TaskID: taskID, Completed: taskCompleted, Total: 12, Deleted: 12}, nil
+		},
+	}
+	openChoreoClient := apitestutils.CreateMockOpenChoreoClient()
+	openChoreoClient.ListProjectsFunc = func(ctx context.Context, namespaceName string) ([]*models.ProjectResponse, error) {
+		return []*models.ProjectResponse{{Name: "default"}}, nil
+	}
+	openChoreoClient.ListComponentsFunc = func(ctx context.Context, namespaceName, projectName string) ([]*models.AgentResponse, error) {
+		return []*models.AgentResponse{
+			{UUID: "agent-uid-1", Name: "agent-a"},
+			{UUID: "agent-uid-2", Name: "agent-b"},
+		}, nil
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{
+		OpenChoreoClient:    openChoreoClient,
+		TraceObserverClient: traceObserverClient,
+	}, authMiddleware)
+
+	erasuresURL := fmt.Sprintf("/api/v1/orgs/%s/traces/erasures", erasureOrgName)
+
+	createErasure := func(t *testing.T, body map[string]interface{}) *httptest.ResponseRecorder {
+		return apitestutils.SendJSON(t, app, http.MethodPost, erasuresURL, body)
+	}
+
+	var erasureID string
+
+	t.Run("Creating an erasure should start deleting the spans of the org's agents", func(t *testing.T) {
+		rr := createErasure(t, map[string]interface{}{"value": "user-42"})
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+
+		var erasure models.TraceErasureResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &erasure))
+		hash := sha256.Sum256([]byte("user-42"))
+		require.Equal(t, "enduser.id", erasure.Attribute)
+		require.Equal(t, hex.EncodeToString(hash[:]), erasure.ValueHash)
+		require.Equal(t, "privacy-officer", erasure.RequestedBy)
+		require.Equal(t, "erase-task-1", erasure.TaskID)
+		require.Equal(t, models.TraceErasureStatusRunning, erasure.Status)
+		require.NotContains(t, rr.Body.String(), "user-42")
+		erasureID = erasure.ID
+
+		calls := traceObserverClient.EraseSpansCalls()
+		require.Len(t, calls, 1)
+		require.Equal(t, "enduser.id", calls[0].Params.Attribute)
+		require.Equal(t, "user-42", calls[0].Params.Value)
+		require.ElementsMatch(t, []string{"agent-uid-1", "agent-uid-2"}, calls[0].Params.ComponentUids)
+	})
+
+	t.Run("Creating an invalid erasure should return 400", func(t *testing.T) {
+		for _, body := range []map[string]interface{}{
+			{"value": ""},
+			{"attribute": "enduser id", "value": "user-42"},
+		} {
+			rr := createErasure(t, body)
+			require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+		}
+	})
+
+	t.Run("Getting an erasure should report the progress of its task", func(t *testing.T) {
+		erasureURL := fmt.Sprintf("%s/%s", erasuresURL, erasureID)
+
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, erasureURL, nil))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var erasure models.TraceErasureResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &erasure))
+		require.Equal(t, models.TraceErasureStatusRunning, erasure.Status)
+
+		taskCompleted = true
+		rr = httptest.NewRecorder()
+		app.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, erasureURL, nil))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &erasure))
+		require.Equal(t, models.TraceErasureStatusCompleted, erasure.Status)
+		require.Equal(t, int64(12), erasure.DeletedSpans)
+		require.NotNil(t, erasure.CompletedAt)
+
+		// A completed erasure is not refreshed again
+		calls := len(traceObserverClient.GetTaskCalls())
+		rr = httptest.NewRecorder()
+		app.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, erasureURL, nil))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		require.Len(t, traceObserverClient.GetTaskCalls(), calls)
+	})
+
+	t.Run("Listing erasures should return the audit records of the org", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, erasuresURL, nil))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var list models.TraceErasureListResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &list))
+		require.Len(t, list.Erasures, 1)
+		require.Equal(t, erasureID, list.Erasures[0].ID)
+	})
+
+	t.Run("Getting an unknown erasure should return 404", func(t *testing.T) {
+		for _, id := range []string{uuid.New().String(), "not-a-uuid"} {
+			rr := httptest.NewRecorder()
+			app.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, fmt.Sprintf("%s/%s", erasuresURL, id), nil))
+			require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+		}
+	})
+}
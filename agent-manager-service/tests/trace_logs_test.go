@@ -0,0 +1,151 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/clientmocks"
+	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+func TestGetTraceLogs(t *testing.T) {
+	orgName := fmt.Sprintf("trace-logs-org-%s", uuid.New().String()[:5])
+	projName := fmt.Sprintf("trace-logs-project-%s", uuid.New().String()[:5])
+	agentName := fmt.Sprintf("trace-logs-agent-%s", uuid.New().String()[:5])
+
+	authMiddleware := jwtassertion.NewMockMiddleware(t)
+
+	makeApp := func(t *testing.T, traceObserverClient *clientmocks.TraceObserverClientMock) http.Handler {
+		testClients := wiring.TestClients{
+			OpenChoreoClient:    apitestutils.CreateMockOpenChoreoClient(),
+			TraceObserverClient: traceObserverClient,
+		}
+		return apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+	}
+	logsURL := func(traceID, query string) string {
+		return fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/trace/%s/logs?%s", orgName, projName, agentName, traceID, query)
+	}
+
+	t.Run("Getting the logs of a trace should return 200", func(t *testing.T) {
+		traceObserverClient := &clientmocks.TraceObserverClientMock{
+			GetTraceLogsFunc: func(ctx context.Context, params traceobserversvc.TraceLogsParams) (*traceobserversvc.TraceLogsResponse, error) {
+				return &traceobserversvc.TraceLogsResponse{
+					TraceID: params.TraceID,
+					SpanID:  params.SpanID,
+					Logs: []traceobserversvc.LogRecord{
+						{
+							Timestamp: time.Date(2025, 12, 16, 10, 0, 1, 0, time.UTC),
+							Message:   "Calling tool search_flights",
+							TraceID:   params.TraceID,
+							SpanID:    params.SpanID,
+							Fields:    map[string]interface{}{"pod": "agent-0"},
+						},
+					},
+					TotalCount: 1,
+					Truncated:  true,
+				}, nil
+			},
+		}
+		app := makeApp(t, traceObserverClient)
+
+		req := httptest.NewRequest(http.MethodGet, logsURL("trace-id-123", "environment=Development&spanId=span-1&limit=1"), nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusOK, rr.Code)
+		b, err := io.ReadAll(rr.Body)
+		require.NoError(t, err)
+
+		var response models.TraceLogsResponse
+		require.NoError(t, json.Unmarshal(b, &response))
+		require.Equal(t, "trace-id-123", response.TraceID)
+		require.Equal(t, 1, response.TotalCount)
+		require.True(t, response.Truncated)
+		require.Len(t, response.Logs, 1)
+		require.Equal(t, "Calling tool search_flights", response.Logs[0].Message)
+		require.Equal(t, "span-1", response.Logs[0].SpanID)
+		require.Equal(t, "agent-0", response.Logs[0].Fields["pod"])
+
+		calls := traceObserverClient.GetTraceLogsCalls()
+		require.Len(t, calls, 1)
+		require.Equal(t, "trace-id-123", calls[0].Params.TraceID)
+		require.Equal(t, "span-1", calls[0].Params.SpanID)
+		require.Equal(t, 1, calls[0].Params.Limit)
+		require.NotEmpty(t, calls[0].Params.ComponentUid)
+		require.NotEmpty(t, calls[0].Params.EnvironmentUid)
+	})
+
+	t.Run("Getting logs without an environment should return 400", func(t *testing.T) {
+		traceObserverClient := &clientmocks.TraceObserverClientMock{}
+		app := makeApp(t, traceObserverClient)
+
+		req := httptest.NewRequest(http.MethodGet, logsURL("trace-id-123", ""), nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+		require.Empty(t, traceObserverClient.GetTraceLogsCalls())
+	})
+
+	t.Run("Getting logs of a non-existent trace should return 404", func(t *testing.T) {
+		traceObserverClient := &clientmocks.TraceObserverClientMock{
+			GetTraceLogsFunc: func(ctx context.Context, params traceobserversvc.TraceLogsParams) (*traceobserversvc.TraceLogsResponse, error) {
+				return nil, &traceobserversvc.HTTPError{StatusCode: http.StatusNotFound, Message: "Trace not found"}
+			},
+		}
+		app := makeApp(t, traceObserverClient)
+
+		req := httptest.NewRequest(http.MethodGet, logsURL("missing-trace", "environment=Development"), nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Getting logs without a log backend should return 501", func(t *testing.T) {
+		traceObserverClient := &clientmocks.TraceObserverClientMock{
+			GetTraceLogsFunc: func(ctx context.Context, params traceobserversvc.TraceLogsParams) (*traceobserversvc.TraceLogsResponse, error) {
+				return nil, &traceobserversvc.HTTPError{StatusCode: http.StatusNotImplemented, Message: "Log correlation is not configured"}
+			},
+		}
+		app := makeApp(t, traceObserverClient)
+
+		req := httptest.NewRequest(http.MethodGet, logsURL("trace-id-123", "environment=Development"), nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+
+		require.Equal(t, http.StatusNotImplemented, rr.Code)
+		b, err := io.ReadAll(rr.Body)
+		require.NoError(t, err)
+		require.Contains(t, string(b), "Log correlation is not configured")
+	})
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/clientmocks"
+	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+func TestTraceReplay(t *testing.T) {
+	orgName := fmt.Sprintf("replay-org-%s", uuid.New().String()[:5])
+	projName := fmt.Sprintf("replay-project-%s", uuid.New().String()[:5])
+	agentName := fmt.Sprintf("replay-agent-%s", uuid.New().String()[:5])
+
+	authMiddleware := jwtassertion.NewMockMiddlewareWithClaims(t, &jwtassertion.TokenClaims{
+		Sub:   "developer",
+		Scope: "scopes",
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+	})
+
+	// The deployed agent records the requests it receives
+	var mu sync.Mutex
+	var agentRequests []*http.Request
+	var agentBodies []string
+	agent := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		agentRequests = append(agentRequests, r)
+		agentBodies = append(agentBodies, string(body))
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"answer":"42"}`))
+	}))
+	defer agent.Close()
+
+	traceInputs := map[string]interface{}{
+		"trace-with-input":    `{"message":"What is the answer?"}`,
+		"trace-without-input": nil,
+	}
+	traceObserverClient := &clientmocks.TraceObserverClientMock{
+		TraceDetailsByIdFunc: func(ctx context.Context, params traceobserversvc.TraceDetailsByIdParams) (*traceobserversvc.TraceResponse, error) {
+			input, ok := traceInputs[params.TraceID]
+			if !ok {
+				return nil, &traceobserversvc.HTTPError{StatusCode: http.StatusNotFound, Message: "Trace not found"}
+			}
+			return &traceobserversvc.TraceResponse{
+				Spans:      []traceobserversvc.Span{{TraceID: params.TraceID, SpanID: "root"}},
+				TotalCount: 1,
+				Input:      input,
+			}, nil
+		},
+	}
+	openChoreoClient := apitestutils.CreateMockOpenChoreoClient()
+	openChoreoClient.GetComponentEndpointsFunc = func(ctx context.Context, namespaceName, projectName, componentName, environment string) (map[string]models.EndpointsResponse, error) {
+		return map[string]models.EndpointsResponse{
+			"default": {Endpoint: models.Endpoint{URL: agent.URL, Name: "default"}},
+		}, nil
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{
+		OpenChoreoClient:    openChoreoClient,
+		TraceObserverClient: traceObserverClient,
+	}, authMiddleware)
+
+	replaysURL := func(traceID string) string {
+		return fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/trace/%s/replays?environment=Development", orgName, projName, agentName, traceID)
+	}
+	replay := func(t *testing.T, traceID string, body map[string]interface{}) *httptest.ResponseRecorder {
+		return apitestutils.SendJSON(t, app, http.MethodPost, replaysURL(traceID), body)
+	}
+
+	var replayTraceID string
+
+	t.Run("Replaying a trace should send its root input to the agent", func(t *testing.T) {
+		rr := replay(t, "trace-with-input", map[string]interface{}{"path": "/chat"})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+		var response models.TraceReplayResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Equal(t, "trace-with-input", response.OriginalTraceID)
+		require.Len(t, response.ReplayTraceID, 32)
+		require.Equal(t, agent.URL+"/chat", response.EndpointURL)
+		require.Equal(t, http.StatusOK, response.StatusCode)
+		require.Equal(t, "developer", response.RequestedBy)
+		require.JSONEq(t, `{"answer":"42"}`, string(response.Response))
+		replayTraceID = response.ReplayTraceID
+
+		mu.Lock()
+		defer mu.Unlock()
+		require.Len(t, agentRequests, 1)
+		require.Equal(t, "/chat", agentRequests[0].URL.Path)
+		require.JSONEq(t, `{"message":"What is the answer?"}`, agentBodies[0])
+		require.True(t, strings.HasPrefix(agentRequests[0].Header.Get("traceparent"), "00-"+response.ReplayTraceID+"-"))
+		require.Equal(t, "amp.replay.original_trace_id=trace-with-input", agentRequests[0].Header.Get("baggage"))
+	})
+
+	t.Run("Listing the replays of a trace should return the linked trace", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, replaysURL("trace-with-input"), nil)
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var response models.TraceReplayListResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Len(t, response.Replays, 1)
+		require.Equal(t, replayTraceID, response.Replays[0].ReplayTraceID)
+		require.Equal(t, "Development", response.Replays[0].Environment)
+		require.Empty(t, response.Replays[0].Response)
+	})
+
+	t.Run("Replaying a trace without a root input should return 422", func(t *testing.T) {
+		rr := replay(t, "trace-without-input", nil)
+		require.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	})
+
+	t.Run("Replaying a non-existent trace should return 404", func(t *testing.T) {
+		rr := replay(t, "missing-trace", nil)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Replaying against an unknown endpoint should return 404", func(t *testing.T) {
+		rr := replay(t, "trace-with-input", map[string]interface{}{"endpoint": "unknown"})
+		require.Equal(t, http.StatusNotFound, rr.Code)
+		require.Contains(t, rr.Body.String(), "Agent endpoint not found")
+	})
+
+	t.Run("Replaying with a relative path should return 400", func(t *testing.T) {
+		rr := replay(t, "trace-with-input", map[string]interface{}{"path": "../admin"})
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/clientmocks"
+	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+func TestTraceRetention(t *testing.T) {
+	retentionOrgName := fmt.Sprintf("retention-org-%s", uuid.New().String()[:5])
+	authMiddleware := jwtassertion.NewMockMiddleware(t)
+
+	oldest := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+	traceObserverClient := &clientmocks.TraceObserverClientMock{
+		GetStorageUsageFunc: func(ctx context.Context, componentUids []string) (*traceobserversvc.StorageUsageResponse, error) {
+			return &traceobserversvc.StorageUsageResponse{
+				Components: []traceobserversvc.ComponentStorageUsage{
+					{ComponentUid: "agent-uid-1", SpanCount: 300, TraceCount: 20, OldestSpanTime: &oldest, EstimatedSizeBytes: 3000},
+					{ComponentUid: "agent-uid-2", SpanCount: 100, TraceCount: 5, EstimatedSizeBytes: 1000},
+				},
+				TotalSpanCount: 10000,
+				TotalSizeBytes: 100000,
+			}, nil
+		},
+	}
+	openChoreoClient := apitestutils.CreateMockOpenChoreoClient()
+	openChoreoClient.ListProjectsFunc = func(ctx context.Context, namespaceName string) ([]*models.ProjectResponse, error) {
+		return []*models.ProjectResponse{{Name: "default"}}, nil
+	}
+	openChoreoClient.ListComponentsFunc = func(ctx context.Context, namespaceName, projectName string) ([]*models.AgentResponse, error) {
+		return []*models.AgentResponse{
+			{UUID: "agent-uid-1", Name: "agent-a"},
+			{UUID: "agent-uid-2", Name: "agent-b"},
+		}, nil
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{
+		OpenChoreoClient:    openChoreoClient,
+		TraceObserverClient: traceObserverClient,
+	}, authMiddleware)
+
+	retentionURL := fmt.Sprintf("/api/v1/orgs/%s/traces/retention", retentionOrgName)
+
+	setRetention := func(t *testing.T, body map[string]interface{}) *httptest.ResponseRecorder {
+		return apitestutils.SendJSON(t, app, http.MethodPut, retentionURL, body)
+	}
+
+	t.Run("Getting the retention policy of an org without one should return 404", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, retentionURL, nil))
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+	})
+
+	t.Run("Setting the retention policy should store it", func(t *testing.T) {
+		rr := setRetention(t, map[string]interface{}{"retentionDays": 30, "errorRetentionDays": 90})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		rr = setRetention(t, map[string]interface{}{"retentionDays": 7, "errorRetentionDays": 30})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		rr = httptest.NewRecorder()
+		app.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, retentionURL, nil))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var policy models.TraceRetentionPolicyResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &policy))
+		require.Equal(t, 7, policy.RetentionDays)
+		require.Equal(t, 30, policy.ErrorRetentionDays)
+		require.Nil(t, policy.LastEnforcedAt)
+	})
+
+	t.Run("Setting an invalid retention policy should return 400", func(t *testing.T) {
+		for _, body := range []map[string]interface{}{
+			{"retentionDays": 0},
+			{"retentionDays": 5000},
+			{"retentionDays": 30, "errorRetentionDays": 7},
+		} {
+			rr := setRetention(t, body)
+			require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+		}
+	})
+
+	t.Run("Getting storage usage should report the agents of the org", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/orgs/%s/traces/storage", retentionOrgName), nil))
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var usage models.TraceStorageUsageResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &usage))
+		require.Len(t, usage.Agents, 2)
+		require.Equal(t, "agent-a", usage.Agents[0].AgentName)
+		require.Equal(t, "default", usage.Agents[0].ProjectName)
+		require.Equal(t, oldest, *usage.Agents[0].OldestSpanTime)
+		require.Equal(t, int64(400), usage.SpanCount)
+		require.Equal(t, int64(4000), usage.EstimatedSizeBytes)
+
+		calls := traceObserverClient.GetStorageUsageCalls()
+		require.Len(t, calls, 1)
+		require.ElementsMatch(t, []string{"agent-uid-1", "agent-uid-2"}, calls[0].ComponentUids)
+	})
+
+	t.Run("Deleting the retention policy should remove it", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		app.ServeHTTP(rr, httptest.NewRequest(http.MethodDelete, retentionURL, nil))
+		require.Equal(t, http.StatusNoContent, rr.Code, rr.Body.String())
+
+		rr = httptest.NewRecorder()
+		app.ServeHTTP(rr, httptest.NewRequest(http.MethodDelete, retentionURL, nil))
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+	})
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/clientmocks"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+func TestTraceSampling(t *testing.T) {
+	orgName := fmt.Sprintf("sampling-org-%s", uuid.New().String()[:5])
+	projName := fmt.Sprintf("sampling-project-%s", uuid.New().String()[:5])
+	agentName := fmt.Sprintf("sampling-agent-%s", uuid.New().String()[:5])
+
+	app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{
+		OpenChoreoClient:    apitestutils.CreateMockOpenChoreoClient(),
+		TraceObserverClient: &clientmocks.TraceObserverClientMock{},
+	}, jwtassertion.NewMockMiddleware(t))
+
+	orgURL := fmt.Sprintf("/api/v1/orgs/%s/traces/sampling", orgName)
+	agentURL := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/trace-sampling", orgName, projName, agentName)
+	getVerdict := func(t *testing.T) models.TraceSamplingVerdictResponse {
+		rr := apitestutils.SendJSON(t, app, http.MethodGet, agentURL+"/verdict", nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		require.Equal(t, "private, max-age=300", rr.Header().Get("Cache-Control"))
+		var verdict models.TraceSamplingVerdictResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&verdict))
+		return verdict
+	}
+	rate := func(v float64) *float64 { return &v }
+
+	t.Run("Agents without a policy should keep all traces", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodGet, orgURL, nil)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+
+		verdict := getVerdict(t)
+		require.Equal(t, models.TraceSamplingSourceDefault, verdict.Source)
+		require.Equal(t, 1.0, verdict.ErrorSampleRate)
+		require.Equal(t, 1.0, verdict.SuccessSampleRate)
+		require.NotEmpty(t, verdict.ComponentUid)
+		require.Equal(t, 300, verdict.RefreshIntervalSeconds)
+	})
+
+	t.Run("The policy of the organization should apply to its agents", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPut, orgURL, models.TraceSamplingPolicyRequest{ErrorSampleRate: rate(1), SuccessSampleRate: rate(0.05)})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		verdict := getVerdict(t)
+		require.Equal(t, models.TraceSamplingSourceOrganization, verdict.Source)
+		require.Equal(t, 1.0, verdict.ErrorSampleRate)
+		require.Equal(t, 0.05, verdict.SuccessSampleRate)
+	})
+
+	t.Run("The policy of an agent should override that of the organization", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPut, agentURL, models.TraceSamplingPolicyRequest{ErrorSampleRate: rate(0.5), SuccessSampleRate: rate(0)})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		verdict := getVerdict(t)
+		require.Equal(t, models.TraceSamplingSourceAgent, verdict.Source)
+		require.Equal(t, 0.5, verdict.ErrorSampleRate)
+		require.Equal(t, 0.0, verdict.SuccessSampleRate)
+
+		rr = apitestutils.SendJSON(t, app, http.MethodGet, orgURL+"/policies", nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		var list models.TraceSamplingPolicyListResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&list))
+		require.Len(t, list.Policies, 2)
+		require.Empty(t, list.Policies[0].AgentName, "the policy of the organization should be listed first")
+		require.Equal(t, agentName, list.Policies[1].AgentName)
+	})
+
+	t.Run("Invalid sample rates should return 400", func(t *testing.T) {
+		for name, req := range map[string]models.TraceSamplingPolicyRequest{
+			"missing error rate":   {SuccessSampleRate: rate(0.1)},
+			"missing success rate": {ErrorSampleRate: rate(1)},
+			"rate above 1":         {ErrorSampleRate: rate(1.5), SuccessSampleRate: rate(0.1)},
+			"negative rate":        {ErrorSampleRate: rate(1), SuccessSampleRate: rate(-0.1)},
+		} {
+			rr := apitestutils.SendJSON(t, app, http.MethodPut, agentURL, req)
+			require.Equal(t, http.StatusBadRequest, rr.Code, name)
+		}
+	})
+
+	t.Run("Deleting the policy of an agent should fall back to the organization", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodDelete, agentURL, nil)
+		require.Equal(t, http.StatusNoContent, rr.Code, rr.Body.String())
+		require.Equal(t, models.TraceSamplingSourceOrganization, getVerdict(t).Source)
+
+		rr = apitestutils.SendJSON(t, app, http.MethodDelete, orgURL, nil)
+		require.Equal(t, http.StatusNoContent, rr.Code, rr.Body.String())
+		require.Equal(t, models.TraceSamplingSourceDefault, getVerdict(t).Source)
+
+		rr = apitestutils.SendJSON(t, app, http.MethodDelete, orgURL, nil)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
@@ -0,0 +1,240 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/clientmocks"
+	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+func TestTraceScoring(t *testing.T) {
+	orgName := fmt.Sprintf("scoring-org-%s", uuid.New().String()[:5])
+	projName := fmt.Sprintf("scoring-project-%s", uuid.New().String()[:5])
+	agentName := fmt.Sprintf("scoring-agent-%s", uuid.New().String()[:5])
+	traceStart := time.Now().Add(-time.Hour).UTC()
+
+	// The judge answers with fixed scores, or with text that is not JSON once it is told to
+	var mu sync.Mutex
+	var judgeRequests []map[string]interface{}
+	var judgeAuth string
+	judgeContent := `{"helpfulness":{"score":0.9,"reason":"Answers the question"},"hallucination":{"score":0.2,"reason":"Mostly grounded"},"toxicity":{"score":0.05,"reason":"Polite"}}`
+	judge := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/chat/completions", r.URL.Path)
+		var body map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		mu.Lock()
+		judgeRequests = append(judgeRequests, body)
+		judgeAuth = r.Header.Get("Authorization")
+		content := judgeContent
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"choices": []map[string]interface{}{{"message": map[string]string{"role": "assistant", "content": content}}},
+		})
+	}))
+	defer judge.Close()
+
+	cfg := config.GetConfig()
+	previousJudge := cfg.TraceJudge
+	cfg.TraceJudge.URL = ""
+	t.Cleanup(func() { cfg.TraceJudge = previousJudge })
+
+	traceObserverClient := &clientmocks.TraceObserverClientMock{
+		TraceDetailsByIdFunc: func(ctx context.Context, params traceobserversvc.TraceDetailsByIdParams) (*traceobserversvc.TraceResponse, error) {
+			spans := []traceobserversvc.Span{
+				{TraceID: params.TraceID, SpanID: "root", Name: "invoke_agent", StartTime: traceStart},
+				{
+					TraceID: params.TraceID, SpanID: "tool", ParentSpanID: "root", Name: "weather", StartTime: traceStart.Add(time.Millisecond),
+					AmpAttributes: &traceobserversvc.AmpAttributes{Kind: "tool", Output: "Sunny, 24 degrees in Colombo"},
+				},
+			}
+			switch params.TraceID {
+			case "answered-trace":
+				return &traceobserversvc.TraceResponse{Spans: spans, Input: "What is the weather in Colombo?", Output: "It is sunny and 24 degrees."}, nil
+			case "unanswered-trace":
+				return &traceobserversvc.TraceResponse{Spans: spans, Input: "What is the weather in Colombo?"}, nil
+			}
+			return nil, &traceobserversvc.HTTPError{StatusCode: http.StatusNotFound, Message: "Trace not found"}
+		},
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, wiring.TestClients{
+		OpenChoreoClient:    apitestutils.CreateMockOpenChoreoClient(),
+		TraceObserverClient: traceObserverClient,
+	}, jwtassertion.NewMockMiddleware(t))
+
+	agentURL := fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s", orgName, projName, agentName)
+	scoreURL := func(traceID string) string {
+		return agentURL + "/trace/" + traceID + "/scores?environment=Development"
+	}
+
+	t.Run("Scoring a trace without a judge should return 501", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, scoreURL("answered-trace"), nil)
+		require.Equal(t, http.StatusNotImplemented, rr.Code)
+	})
+
+	cfg.TraceJudge.URL = judge.URL + "/v1"
+	cfg.TraceJudge.APIKey = "judge-key"
+	cfg.TraceJudge.Model = "judge-model"
+
+	t.Run("Scoring a trace should store the judge's scores on all criteria", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, scoreURL("answered-trace"), nil)
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+		var response models.TraceScoresResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Len(t, response.Scores, 3)
+		require.Equal(t, models.TraceScoreHelpfulness, response.Scores[0].Criterion)
+		require.Equal(t, 0.9, response.Scores[0].Score)
+		require.Equal(t, "Answers the question", response.Scores[0].Reason)
+		require.Equal(t, "judge-model", response.Scores[0].Model)
+		require.Equal(t, models.TraceScoreHallucination, response.Scores[1].Criterion)
+		require.Equal(t, models.TraceScoreToxicity, response.Scores[2].Criterion)
+
+		mu.Lock()
+		defer mu.Unlock()
+		require.Equal(t, "Bearer judge-key", judgeAuth)
+		require.Equal(t, "judge-model", judgeRequests[0]["model"])
+		messages := judgeRequests[0]["messages"].([]interface{})
+		prompt := messages[1].(map[string]interface{})["content"].(string)
+		require.Contains(t, prompt, "What is the weather in Colombo?")
+		require.Contains(t, prompt, "It is sunny and 24 degrees.")
+		require.Contains(t, prompt, "weather: Sunny, 24 degrees in Colombo")
+	})
+
+	t.Run("Getting the scores of a trace should return the stored scores", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodGet, agentURL+"/trace/answered-trace/scores", nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var response models.TraceScoresResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Len(t, response.Scores, 3)
+	})
+
+	t.Run("Scoring a trace without an output should return 422", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, scoreURL("unanswered-trace"), nil)
+		require.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+	})
+
+	t.Run("Scoring a non-existent trace should return 404", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, scoreURL("missing-trace"), nil)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Setting an invalid scoring policy should return 400", func(t *testing.T) {
+		for _, body := range []map[string]interface{}{
+			{"environment": "Development", "sampleRate": 0},
+			{"environment": "Development", "sampleRate": 0.5, "criteria": []string{"relevance"}},
+			{"environment": "Development", "sampleRate": 0.5, "thresholds": map[string]float64{"toxicity": 2}},
+			{"sampleRate": 0.5},
+		} {
+			rr := apitestutils.SendJSON(t, app, http.MethodPut, agentURL+"/trace-scoring", body)
+			require.Equal(t, http.StatusBadRequest, rr.Code, body)
+		}
+	})
+
+	t.Run("Setting a scoring policy should merge its thresholds over the defaults", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPut, agentURL+"/trace-scoring", map[string]interface{}{
+			"environment": "Development",
+			"sampleRate":  0.25,
+			"criteria":    []string{models.TraceScoreHelpfulness, models.TraceScoreToxicity},
+			"thresholds":  map[string]float64{models.TraceScoreHelpfulness: 0.95},
+		})
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var policy models.TraceScoringPolicyResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&policy))
+		require.Equal(t, 0.25, policy.SampleRate)
+		require.Equal(t, []string{models.TraceScoreHelpfulness, models.TraceScoreToxicity}, policy.Criteria)
+		require.Equal(t, map[string]float64{models.TraceScoreHelpfulness: 0.95, models.TraceScoreToxicity: 0.1}, policy.Thresholds)
+	})
+
+	t.Run("Scoring a trace should use the criteria of the policy", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, scoreURL("answered-trace"), nil)
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var response models.TraceScoresResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&response))
+		require.Len(t, response.Scores, 2)
+	})
+
+	t.Run("The score summary should raise alerts for criteria past their threshold", func(t *testing.T) {
+		query := url.Values{
+			"startTime": {traceStart.Add(-time.Minute).Format(time.RFC3339)},
+			"endTime":   {traceStart.Add(time.Minute).Format(time.RFC3339)},
+		}
+		rr := apitestutils.SendJSON(t, app, http.MethodGet, agentURL+"/trace-scoring/summary?"+query.Encode(), nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var summary models.TraceScoreSummaryResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&summary))
+		require.Len(t, summary.Criteria, 3)
+		helpfulness, hallucination, toxicity := summary.Criteria[0], summary.Criteria[1], summary.Criteria[2]
+		require.Equal(t, models.TraceScoreHelpfulness, helpfulness.Criterion)
+		require.Equal(t, int64(1), helpfulness.Count)
+		require.Equal(t, 0.95, helpfulness.Threshold)
+		require.Equal(t, int64(1), helpfulness.Breaches)
+		require.True(t, helpfulness.Alert)
+		require.Equal(t, models.TraceScoreHallucination, hallucination.Criterion)
+		require.False(t, hallucination.Alert)
+		require.Equal(t, models.TraceScoreToxicity, toxicity.Criterion)
+		require.Equal(t, int64(0), toxicity.Breaches)
+		require.False(t, toxicity.Alert)
+	})
+
+	t.Run("The score summary should be empty outside the scored traces", func(t *testing.T) {
+		query := url.Values{
+			"startTime": {traceStart.Add(-48 * time.Hour).Format(time.RFC3339)},
+			"endTime":   {traceStart.Add(-24 * time.Hour).Format(time.RFC3339)},
+		}
+		rr := apitestutils.SendJSON(t, app, http.MethodGet, agentURL+"/trace-scoring/summary?"+query.Encode(), nil)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var summary models.TraceScoreSummaryResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&summary))
+		require.Empty(t, summary.Criteria)
+	})
+
+	t.Run("A judge answer that is not JSON should fail the scoring", func(t *testing.T) {
+		mu.Lock()
+		judgeContent = "The answer is helpful."
+		mu.Unlock()
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, scoreURL("answered-trace"), nil)
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+
+	t.Run("Deleting the scoring policy should remove it", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodDelete, agentURL+"/trace-scoring", nil)
+		require.Equal(t, http.StatusNoContent, rr.Code)
+		rr = apitestutils.SendJSON(t, app, http.MethodGet, agentURL+"/trace-scoring", nil)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
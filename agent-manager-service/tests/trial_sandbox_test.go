@@ -0,0 +1,139 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	apiplatformclient "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/apiplatformsvc/client"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/db"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+var testTrialOrgName = fmt.Sprintf("trial-org-%s", uuid.New().String()[:5])
+
+func TestTrialSandbox(t *testing.T) {
+	apiPlatformClient := apiplatformclient.NewInMemoryAPIPlatformClient()
+	testClients := wiring.TestClients{
+		OpenChoreoClient:  apitestutils.CreateMockOpenChoreoClient(),
+		APIPlatformClient: apiPlatformClient,
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, testClients, apitestutils.NewPlatformAdminMiddleware(t))
+
+	orgURL := fmt.Sprintf("/api/v1/orgs/%s", testTrialOrgName)
+	sandboxURL := orgURL + "/trial-sandbox"
+	rr := apitestutils.SendJSON(t, app, http.MethodPost, "/api/v1/orgs", models.CreateOrganizationRequest{Name: testTrialOrgName})
+	require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+	gateway, err := apiPlatformClient.CreateGateway(context.Background(), apiplatformclient.CreateGatewayRequest{
+		Name: "trial-gw", DisplayName: "Trial", Vhost: "trial.example.com",
+	})
+	require.NoError(t, err)
+
+	t.Run("Provisioning should fail when trial sandboxes are not offered", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, sandboxURL, nil)
+		require.Equal(t, http.StatusNotFound, rr.Code, rr.Body.String())
+	})
+
+	cfg := config.GetConfig()
+	previous := cfg.TrialSandbox
+	cfg.TrialSandbox = config.TrialSandboxConfig{
+		GatewayID:         gateway.ID,
+		LLMProviderID:     "trial-provider",
+		RequestsPerMinute: 10,
+		TokensPerDay:      5000,
+		DurationDays:      7,
+	}
+	t.Cleanup(func() { cfg.TrialSandbox = previous })
+
+	var sandbox models.TrialSandboxResponse
+	t.Run("Provisioning should map a sandbox environment to the shared gateway", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, sandboxURL, nil)
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &sandbox))
+		require.Equal(t, models.TrialSandboxStatusActive, sandbox.Status)
+		require.Equal(t, gateway.ID, sandbox.GatewayID)
+		require.Equal(t, &models.TrialSandboxRateLimits{RequestsPerMinute: 10, TokensPerDay: 5000}, sandbox.RateLimits)
+		require.WithinDuration(t, time.Now().AddDate(0, 0, 7), sandbox.ExpiresAt, time.Minute)
+
+		var mappings int64
+		require.NoError(t, db.DB(context.Background()).Model(&models.GatewayEnvironmentMapping{}).
+			Where("environment_uuid = ? AND gateway_uuid = ?", sandbox.EnvironmentID, gateway.ID).Count(&mappings).Error)
+		require.Equal(t, int64(1), mappings)
+
+		policies, err := apiPlatformClient.ListLLMProviderRateLimits(context.Background(), "trial-provider")
+		require.NoError(t, err)
+		require.Len(t, policies, 1)
+		require.Equal(t, apiplatformclient.RateLimitLevelConsumer, policies[0].Level)
+		require.Equal(t, 10, policies[0].Requests.Count)
+		require.Equal(t, 5000, policies[0].Tokens.Count)
+	})
+
+	t.Run("An organization should get one trial sandbox", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, sandboxURL, nil)
+		require.Equal(t, http.StatusConflict, rr.Code, rr.Body.String())
+	})
+
+	t.Run("An expired trial sandbox should be removed by the cleanup", func(t *testing.T) {
+		require.NoError(t, db.DB(context.Background()).Model(&models.TrialSandbox{}).
+			Where("organization_name = ?", testTrialOrgName).Update("expires_at", time.Now().Add(-time.Minute)).Error)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go services.NewOrganizationService(slog.Default(), apiPlatformClient).RunTrialSandboxCleanup(ctx, 10*time.Millisecond)
+
+		require.Eventually(t, func() bool {
+			rr := apitestutils.SendJSON(t, app, http.MethodGet, sandboxURL, nil)
+			var current models.TrialSandboxResponse
+			return rr.Code == http.StatusOK && json.Unmarshal(rr.Body.Bytes(), &current) == nil &&
+				current.Status == models.TrialSandboxStatusEnded
+		}, 5*time.Second, 20*time.Millisecond)
+
+		var environments int64
+		require.NoError(t, db.DB(context.Background()).Model(&models.Environment{}).
+			Where("uuid = ?", sandbox.EnvironmentID).Count(&environments).Error)
+		require.Zero(t, environments)
+		_, err := apiPlatformClient.GetGateway(context.Background(), gateway.ID)
+		require.NoError(t, err, "the shared gateway must be kept")
+	})
+
+	t.Run("Deleting the organization should keep the shared gateway", func(t *testing.T) {
+		otherOrg := fmt.Sprintf("trial-org-%s", uuid.New().String()[:5])
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, "/api/v1/orgs", models.CreateOrganizationRequest{Name: otherOrg})
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		rr = apitestutils.SendJSON(t, app, http.MethodPost, "/api/v1/orgs/"+otherOrg+"/trial-sandbox", nil)
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+
+		rr = apitestutils.SendJSON(t, app, http.MethodDelete, "/api/v1/orgs/"+otherOrg, nil)
+		require.Equal(t, http.StatusNoContent, rr.Code, rr.Body.String())
+		_, err := apiPlatformClient.GetGateway(context.Background(), gateway.ID)
+		require.NoError(t, err)
+	})
+}
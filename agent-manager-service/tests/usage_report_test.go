@@ -0,0 +1,173 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/clientmocks"
+	traceobserversvc "github.com/wso2/ai-agent-management-platform/agent-manager-service/clients/traceobserversvc"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/tests/apitestutils"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/wiring"
+)
+
+var (
+	usageReportOrgName   = fmt.Sprintf("usage-report-org-%s", uuid.New().String()[:5])
+	usageReportProjName  = fmt.Sprintf("usage-report-project-%s", uuid.New().String()[:5])
+	usageReportAgentName = fmt.Sprintf("usage-report-agent-%s", uuid.New().String()[:5])
+)
+
+func TestUsageReports(t *testing.T) {
+	authMiddleware := jwtassertion.NewMockMiddleware(t)
+	cost := 0.25
+	traceObserverClient := &clientmocks.TraceObserverClientMock{
+		ListTracesFunc: func(ctx context.Context, params traceobserversvc.ListTracesParams) (*traceobserversvc.TraceOverviewResponse, error) {
+			if params.EnvironmentUid != "development-uid" {
+				return &traceobserversvc.TraceOverviewResponse{}, nil
+			}
+			return &traceobserversvc.TraceOverviewResponse{TotalCount: 12}, nil
+		},
+		GetModelUsageFunc: func(ctx context.Context, params traceobserversvc.ModelUsageParams) (*traceobserversvc.ModelUsageResponse, error) {
+			if params.EnvironmentUid != "development-uid" {
+				return &traceobserversvc.ModelUsageResponse{}, nil
+			}
+			return &traceobserversvc.ModelUsageResponse{
+				Models: []traceobserversvc.ModelUsage{
+					{Model: "gpt-4o", UsageStats: traceobserversvc.UsageStats{RequestCount: 4, InputTokens: 300, OutputTokens: 100, TotalTokens: 400, EstimatedCost: &cost}},
+					{Model: "gpt-4o-mini", UsageStats: traceobserversvc.UsageStats{RequestCount: 2, InputTokens: 80, OutputTokens: 20, TotalTokens: 100, EstimatedCost: &cost}},
+				},
+			}, nil
+		},
+	}
+	openChoreoClient := apitestutils.CreateMockOpenChoreoClient()
+	openChoreoClient.ComponentExistsFunc = func(ctx context.Context, orgName string, projName string, agentName string, verifyProject bool) (bool, error) {
+		return true, nil
+	}
+	openChoreoClient.ListProjectsFunc = func(ctx context.Context, namespaceName string) ([]*models.ProjectResponse, error) {
+		return []*models.ProjectResponse{{Name: usageReportProjName}}, nil
+	}
+	openChoreoClient.ListComponentsFunc = func(ctx context.Context, namespaceName, projectName string) ([]*models.AgentResponse, error) {
+		return []*models.AgentResponse{{UUID: "usage-agent-uid", Name: usageReportAgentName}}, nil
+	}
+	openChoreoClient.ListEnvironmentsFunc = func(ctx context.Context, namespaceName string) ([]*models.EnvironmentResponse, error) {
+		return []*models.EnvironmentResponse{
+			{UUID: "development-uid", Name: "Development"},
+			{UUID: "production-uid", Name: "Production"},
+		}, nil
+	}
+	testClients := wiring.TestClients{
+		OpenChoreoClient:    openChoreoClient,
+		TraceObserverClient: traceObserverClient,
+	}
+	app := apitestutils.MakeAppClientWithDeps(t, testClients, authMiddleware)
+
+	reportsURL := fmt.Sprintf("/api/v1/orgs/%s/usage-reports", usageReportOrgName)
+	period := time.Now().UTC().Format("2006-01")
+
+	rr := apitestutils.SendJSON(t, app, http.MethodPost, fmt.Sprintf("/api/v1/orgs/%s/projects/%s/agents/%s/deployments",
+		usageReportOrgName, usageReportProjName, usageReportAgentName), map[string]any{"imageId": "registry.example.com/agent:v1"})
+	require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+
+	t.Run("Generating a report should compile the usage of each agent per environment", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, reportsURL, models.CreateUsageReportRequest{Period: period})
+		require.Equal(t, http.StatusAccepted, rr.Code, rr.Body.String())
+
+		var report models.UsageReportResponse
+		require.Eventually(t, func() bool {
+			rr := apitestutils.SendJSON(t, app, http.MethodGet, reportsURL+"/"+period, nil)
+			require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+			require.NoError(t, json.NewDecoder(rr.Body).Decode(&report))
+			return report.Status != models.UsageReportStatusGenerating
+		}, 5*time.Second, 50*time.Millisecond)
+
+		require.Equal(t, models.UsageReportStatusCompleted, report.Status, report.Error)
+		require.Len(t, report.Rows, 1, "agents without usage in an environment should be left out")
+		row := report.Rows[0]
+		require.Equal(t, "Development", row.Environment)
+		require.Equal(t, usageReportAgentName, row.AgentName)
+		require.Equal(t, 12, row.TraceCount)
+		require.Equal(t, 6, row.LLMRequests)
+		require.Equal(t, 500, row.TotalTokens)
+		require.InDelta(t, 0.5, *row.EstimatedCost, 1e-9)
+		require.Equal(t, 1, row.Deployments)
+		require.Equal(t, 500, report.Totals.TotalTokens)
+	})
+
+	t.Run("Listing reports should leave out the rows", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodGet, reportsURL, nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		var list models.UsageReportListResponse
+		require.NoError(t, json.NewDecoder(rr.Body).Decode(&list))
+		require.Len(t, list.Reports, 1)
+		require.Empty(t, list.Reports[0].Rows)
+		require.Equal(t, 12, list.Reports[0].Totals.TraceCount)
+	})
+
+	t.Run("Downloading a report as CSV should return a row per agent and environment", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodGet, reportsURL+"/"+period+"/download?format=csv", nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		require.Equal(t, "text/csv; charset=utf-8", rr.Header().Get("Content-Type"))
+		require.Contains(t, rr.Header().Get("Content-Disposition"), fmt.Sprintf("usage-report-%s-%s.csv", usageReportOrgName, period))
+
+		records, err := csv.NewReader(rr.Body).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		require.Equal(t, []string{usageReportProjName, usageReportAgentName, "Development", "12", "6", "380", "120", "500", "0.5000", "1"}, records[1])
+	})
+
+	t.Run("Downloading a report as PDF should return a PDF document", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodGet, reportsURL+"/"+period+"/download?format=pdf", nil)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		require.Equal(t, "application/pdf", rr.Header().Get("Content-Type"))
+		require.True(t, bytes.HasPrefix(rr.Body.Bytes(), []byte("%PDF-")))
+		require.Contains(t, rr.Body.String(), usageReportAgentName)
+	})
+
+	t.Run("Downloading in an unknown format should return 400", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodGet, reportsURL+"/"+period+"/download?format=xlsx", nil)
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+	})
+
+	t.Run("A malformed or future period should return 400", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodPost, reportsURL, models.CreateUsageReportRequest{Period: "2026/01"})
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+		require.Contains(t, rr.Body.String(), "must be formatted as YYYY-MM")
+
+		future := time.Now().UTC().AddDate(0, 2, 0).Format("2006-01")
+		rr = apitestutils.SendJSON(t, app, http.MethodPost, reportsURL, models.CreateUsageReportRequest{Period: future})
+		require.Equal(t, http.StatusBadRequest, rr.Code, rr.Body.String())
+	})
+
+	t.Run("An unknown report should return 404", func(t *testing.T) {
+		rr := apitestutils.SendJSON(t, app, http.MethodGet, reportsURL+"/2001-01", nil)
+		require.Equal(t, http.StatusNotFound, rr.Code)
+		require.Contains(t, rr.Body.String(), "USAGE_REPORT_NOT_FOUND")
+	})
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package utils
+
+// AgentTemplate describes a supported agent runtime and the template repository used to scaffold it
+type AgentTemplate struct {
+	Name            string `json:"name"`
+	DisplayName     string `json:"displayName"`
+	Description     string `json:"description"`
+	Framework       string `json:"framework"`
+	Language        string `json:"language"`
+	LanguageVersion string `json:"languageVersion"`
+	// TemplateRepository is the name of the template repository under the configured template owner
+	TemplateRepository string `json:"templateRepository"`
+	// RunCommand is the command used to start the agent, matching the build parameters of an agent
+	RunCommand string `json:"runCommand"`
+}
+
+// AgentTemplates contains all supported agent runtime templates
+var AgentTemplates = []AgentTemplate{
+	{
+		Name:               "langgraph-python",
+		DisplayName:        "LangGraph (Python)",
+		Description:        "A stateful LangGraph agent served over HTTP with FastAPI",
+		Framework:          "LangGraph",
+		Language:           string(LanguagePython),
+		LanguageVersion:    "3.12.x",
+		TemplateRepository: "amp-agent-template-langgraph-python",
+		RunCommand:         "uvicorn app:app --host 0.0.0.0 --port 8000",
+	},
+	{
+		Name:               "crewai-python",
+		DisplayName:        "CrewAI (Python)",
+		Description:        "A CrewAI multi-agent crew served over HTTP with FastAPI",
+		Framework:          "CrewAI",
+		Language:           string(LanguagePython),
+		LanguageVersion:    "3.12.x",
+		TemplateRepository: "amp-agent-template-crewai-python",
+		RunCommand:         "uvicorn app:app --host 0.0.0.0 --port 8000",
+	},
+	{
+		Name:               "langchain-js",
+		DisplayName:        "LangChain (JavaScript)",
+		Description:        "A LangChain.js agent served over HTTP with Express",
+		Framework:          "LangChain",
+		Language:           string(LanguageNodeJS),
+		LanguageVersion:    "22.x.x",
+		TemplateRepository: "amp-agent-template-langchain-js",
+		RunCommand:         "npm start",
+	},
+}
+
+// FindAgentTemplate returns the agent template with the given name
+func FindAgentTemplate(name string) (AgentTemplate, bool) {
+	for _, t := range AgentTemplates {
+		if t.Name == name {
+			return t, true
+		}
+	}
+	return AgentTemplate{}, false
+}
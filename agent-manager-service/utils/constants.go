@@ -41,11 +41,25 @@ const (
 
 // Path parameter names used in HTTP routes
 const (
-	PathParamOrgName   = "orgName"
-	PathParamProjName  = "projName"
-	PathParamAgentName = "agentName"
-	PathParamBuildName = "buildName"
-	PathParamTraceId   = "traceId"
+	PathParamOrgName       = "orgName"
+	PathParamProjName      = "projName"
+	PathParamAgentName     = "agentName"
+	PathParamBuildName     = "buildName"
+	PathParamTraceId       = "traceId"
+	PathParamTemplateName  = "templateName"
+	PathParamMCPServerName = "mcpServerName"
+	PathParamRevision      = "revision"
+	PathParamErasureId     = "erasureId"
+	PathParamGoldenTraceId = "goldenTraceId"
+	PathParamRunId         = "runId"
+	PathParamSLOId         = "sloId"
+	PathParamBudgetId      = "budgetId"
+	PathParamPeriod        = "period"
+	PathParamMappingId     = "mappingId"
+	PathParamApprovalId    = "approvalId"
+	PathParamFreezeId      = "freezeId"
+	PathParamDigestId      = "digestId"
+	PathParamRecipientId   = "recipientId"
 )
 
 // Pagination constants
@@ -74,3 +88,6 @@ const (
 	LogLevelWarn  = "WARN"
 	LogLevelError = "ERROR"
 )
+
+// HeaderBreakGlassReason carries the justification for a change made during an active change freeze
+const HeaderBreakGlassReason = "X-Break-Glass-Reason"
@@ -61,16 +61,39 @@ func EncryptCredentials(creds *models.GatewayCredentials, key []byte) ([]byte, e
 		return nil, ErrInvalidCredentials
 	}
 
-	if len(key) != KeySize {
-		return nil, ErrInvalidKeySize
-	}
-
 	// Marshal credentials to JSON
 	plaintext, err := json.Marshal(creds)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal credentials: %w", err)
 	}
 
+	return EncryptSecret(plaintext, key)
+}
+
+// DecryptCredentials decrypts gateway credentials that were encrypted with EncryptCredentials.
+// The input should contain the nonce prepended to the ciphertext.
+func DecryptCredentials(encrypted []byte, key []byte) (*models.GatewayCredentials, error) {
+	plaintext, err := DecryptSecret(encrypted, key)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal credentials
+	var creds models.GatewayCredentials
+	if err := json.Unmarshal(plaintext, &creds); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal credentials: %w", err)
+	}
+
+	return &creds, nil
+}
+
+// EncryptSecret encrypts a secret using AES-256-GCM.
+// The encrypted data includes the nonce prepended to the ciphertext.
+func EncryptSecret(plaintext []byte, key []byte) ([]byte, error) {
+	if len(key) != KeySize {
+		return nil, ErrInvalidKeySize
+	}
+
 	// Create cipher block
 	block, err := aes.NewCipher(key)
 	if err != nil {
@@ -90,14 +113,11 @@ func EncryptCredentials(creds *models.GatewayCredentials, key []byte) ([]byte, e
 	}
 
 	// Encrypt and authenticate
-	ciphertext := gcm.Seal(nonce, nonce, plaintext, nil)
-
-	return ciphertext, nil
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
 }
 
-// DecryptCredentials decrypts gateway credentials that were encrypted with EncryptCredentials.
-// The input should contain the nonce prepended to the ciphertext.
-func DecryptCredentials(encrypted []byte, key []byte) (*models.GatewayCredentials, error) {
+// DecryptSecret decrypts a secret that was encrypted with EncryptSecret.
+func DecryptSecret(encrypted []byte, key []byte) ([]byte, error) {
 	if len(key) != KeySize {
 		return nil, ErrInvalidKeySize
 	}
@@ -127,14 +147,7 @@ func DecryptCredentials(encrypted []byte, key []byte) (*models.GatewayCredential
 	if err != nil {
 		return nil, ErrInvalidCiphertext
 	}
-
-	// Unmarshal credentials
-	var creds models.GatewayCredentials
-	if err := json.Unmarshal(plaintext, &creds); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal credentials: %w", err)
-	}
-
-	return &creds, nil
+	return plaintext, nil
 }
 
 // GenerateEncryptionKey generates a cryptographically secure random key for AES-256-GCM.
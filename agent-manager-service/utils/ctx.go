@@ -18,8 +18,12 @@ package utils
 
 import (
 	"context"
+	"net/http"
 )
 
+// CorrelationIDHeader is the header that carries the correlation ID of a request and its response
+const CorrelationIDHeader = "x-correlation-id"
+
 type ctxKey struct{}
 
 var correlationId ctxKey
@@ -35,3 +39,15 @@ func GetCorrelationId(ctx context.Context) string {
 	}
 	return "-"
 }
+
+// SetCorrelationIDHeader passes the correlation ID of the request context on to an outgoing
+// request, so that the logs of the called service can be matched with ours. A correlation ID
+// the request already carries is kept.
+func SetCorrelationIDHeader(req *http.Request) {
+	if req.Header.Get(CorrelationIDHeader) != "" {
+		return
+	}
+	if id, ok := req.Context().Value(correlationId).(string); ok && id != "" {
+		req.Header.Set(CorrelationIDHeader, id)
+	}
+}
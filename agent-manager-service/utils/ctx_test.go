@@ -0,0 +1,48 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package utils
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetCorrelationIDHeader(t *testing.T) {
+	ctx := context.WithValue(context.Background(), CorrelationIdCtxKey(), "abc-123")
+
+	t.Run("Correlation ID of the context is set", func(t *testing.T) {
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "http://example.com", nil)
+		SetCorrelationIDHeader(req)
+		assert.Equal(t, "abc-123", req.Header.Get(CorrelationIDHeader))
+	})
+
+	t.Run("Correlation ID of the request is kept", func(t *testing.T) {
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "http://example.com", nil)
+		req.Header.Set(CorrelationIDHeader, "xyz-789")
+		SetCorrelationIDHeader(req)
+		assert.Equal(t, "xyz-789", req.Header.Get(CorrelationIDHeader))
+	})
+
+	t.Run("Nothing is set without a correlation ID", func(t *testing.T) {
+		req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, "http://example.com", nil)
+		SetCorrelationIDHeader(req)
+		assert.Empty(t, req.Header.Get(CorrelationIDHeader))
+	})
+}
@@ -0,0 +1,228 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package utils
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+)
+
+// ErrorCode is the machine-readable code of an error response. Clients branch on the code,
+// while the message is meant for people and may change.
+type ErrorCode string
+
+// Generic error codes, used when an error has no more specific code
+const (
+	ErrorCodeBadRequest         ErrorCode = "BAD_REQUEST"
+	ErrorCodeValidationFailed   ErrorCode = "VALIDATION_FAILED"
+	ErrorCodeUnauthorized       ErrorCode = "UNAUTHORIZED"
+	ErrorCodeForbidden          ErrorCode = "FORBIDDEN"
+	ErrorCodeNotFound           ErrorCode = "NOT_FOUND"
+	ErrorCodeMethodNotAllowed   ErrorCode = "METHOD_NOT_ALLOWED"
+	ErrorCodeConflict           ErrorCode = "CONFLICT"
+	ErrorCodePreconditionFailed ErrorCode = "PRECONDITION_FAILED"
+	ErrorCodeUnprocessable      ErrorCode = "UNPROCESSABLE_ENTITY"
+	ErrorCodeRateLimited        ErrorCode = "RATE_LIMITED"
+	ErrorCodeInternal           ErrorCode = "INTERNAL_ERROR"
+	ErrorCodeNotImplemented     ErrorCode = "NOT_IMPLEMENTED"
+	ErrorCodeUpstream           ErrorCode = "UPSTREAM_ERROR"
+	ErrorCodeServiceUnavailable ErrorCode = "SERVICE_UNAVAILABLE"
+)
+
+// ErrorDefinition describes how an internal error is reported to API clients
+type ErrorDefinition struct {
+	Err    error
+	Status int
+	Code   ErrorCode
+	// Message is returned instead of the error text, unless ExposeError is set
+	Message string
+	// ExposeError returns the error text as the message, for errors that carry user-facing detail
+	ExposeError bool
+}
+
+// message returns the response message of err under this definition
+func (d ErrorDefinition) message(err error) string {
+	if d.ExposeError {
+		return err.Error()
+	}
+	return d.Message
+}
+
+var (
+	errorRegistryMu sync.RWMutex
+	// errorRegistry is matched in order with errors.Is, so more specific errors come first
+	errorRegistry = []ErrorDefinition{
+		// Not found errors
+		{Err: ErrOrganizationNotFound, Status: http.StatusNotFound, Code: "ORGANIZATION_NOT_FOUND", Message: "Organization not found"},
+		{Err: ErrProjectNotFound, Status: http.StatusNotFound, Code: "PROJECT_NOT_FOUND", Message: "Project not found"},
+		{Err: ErrAgentNotFound, Status: http.StatusNotFound, Code: "AGENT_NOT_FOUND", Message: "Agent not found"},
+		{Err: ErrBuildNotFound, Status: http.StatusNotFound, Code: "BUILD_NOT_FOUND", Message: "Build not found"},
+		{Err: ErrEnvironmentNotFound, Status: http.StatusNotFound, Code: "ENVIRONMENT_NOT_FOUND", Message: "Environment not found"},
+		{Err: ErrDeploymentRevisionNotFound, Status: http.StatusNotFound, Code: "DEPLOYMENT_REVISION_NOT_FOUND", Message: "Deployment revision not found"},
+		{Err: ErrGatewayNotFound, Status: http.StatusNotFound, Code: "GATEWAY_NOT_FOUND", Message: "Gateway not found"},
+		{Err: ErrGatewayBulkOperationNotFound, Status: http.StatusNotFound, Code: "GATEWAY_BULK_OPERATION_NOT_FOUND", Message: "Gateway bulk operation not found"},
+		{Err: ErrMCPServerNotFound, Status: http.StatusNotFound, Code: "MCP_SERVER_NOT_FOUND", Message: "MCP server not found"},
+		{Err: ErrTraceRetentionPolicyNotFound, Status: http.StatusNotFound, Code: "TRACE_RETENTION_POLICY_NOT_FOUND", Message: "Trace retention policy not found"},
+		{Err: ErrTraceSamplingPolicyNotFound, Status: http.StatusNotFound, Code: "TRACE_SAMPLING_POLICY_NOT_FOUND", Message: "Trace sampling policy not found"},
+		{Err: ErrTraceErasureNotFound, Status: http.StatusNotFound, Code: "TRACE_ERASURE_NOT_FOUND", Message: "Trace erasure not found"},
+		{Err: ErrUsageReportNotFound, Status: http.StatusNotFound, Code: "USAGE_REPORT_NOT_FOUND", Message: "Usage report not found"},
+		{Err: ErrCostCenterMappingNotFound, Status: http.StatusNotFound, Code: "COST_CENTER_MAPPING_NOT_FOUND", Message: "Cost center mapping not found"},
+		{Err: ErrDeploymentApprovalNotFound, Status: http.StatusNotFound, Code: "DEPLOYMENT_APPROVAL_NOT_FOUND", Message: "Deployment approval not found"},
+		{Err: ErrChangeFreezeWindowNotFound, Status: http.StatusNotFound, Code: "CHANGE_FREEZE_WINDOW_NOT_FOUND", Message: "Change freeze window not found"},
+		{Err: ErrTrialSandboxNotFound, Status: http.StatusNotFound, Code: "TRIAL_SANDBOX_NOT_FOUND", Message: "Trial sandbox not found"},
+		{Err: ErrTrialSandboxesNotOffered, Status: http.StatusNotFound, Code: "TRIAL_SANDBOXES_NOT_OFFERED", Message: "Trial sandboxes are not offered"},
+		{Err: ErrNotificationDigestNotFound, Status: http.StatusNotFound, Code: "NOTIFICATION_DIGEST_NOT_FOUND", Message: "Notification digest not found"},
+		{Err: ErrEmailRecipientNotFound, Status: http.StatusNotFound, Code: "EMAIL_RECIPIENT_NOT_FOUND", Message: "Email recipient not found"},
+		{Err: ErrEmailNotConfigured, Status: http.StatusNotFound, Code: "EMAIL_NOT_CONFIGURED", Message: "Email notifications are not configured"},
+		{Err: ErrDeploymentApprovalPolicyNotFound, Status: http.StatusNotFound, Code: "DEPLOYMENT_APPROVAL_POLICY_NOT_FOUND", Message: "Deployment approval policy not found"},
+		{Err: ErrAgentEndpointNotFound, Status: http.StatusNotFound, Code: "AGENT_ENDPOINT_NOT_FOUND", Message: "Agent endpoint not found"},
+		{Err: ErrAgentNotDeployed, Status: http.StatusNotFound, Code: "AGENT_NOT_DEPLOYED", Message: "Agent is not deployed"},
+		{Err: ErrGoldenTraceNotFound, Status: http.StatusNotFound, Code: "GOLDEN_TRACE_NOT_FOUND", Message: "Golden trace not found"},
+		{Err: ErrGoldenTraceRunNotFound, Status: http.StatusNotFound, Code: "GOLDEN_TRACE_RUN_NOT_FOUND", Message: "Golden trace run not found"},
+		{Err: ErrTraceScoringPolicyNotFound, Status: http.StatusNotFound, Code: "TRACE_SCORING_POLICY_NOT_FOUND", Message: "Trace scoring policy not found"},
+		{Err: ErrAgentSLONotFound, Status: http.StatusNotFound, Code: "SLO_NOT_FOUND", Message: "SLO not found"},
+		{Err: ErrTokenBudgetNotFound, Status: http.StatusNotFound, Code: "TOKEN_BUDGET_NOT_FOUND", Message: "Token budget not found"},
+		{Err: ErrAgentPublicationNotFound, Status: http.StatusNotFound, Code: "AGENT_PUBLICATION_NOT_FOUND", Message: "Agent publication not found"},
+		{Err: ErrAgentTemplateNotFound, Status: http.StatusNotFound, Code: "AGENT_TEMPLATE_NOT_FOUND", Message: "Agent template not found"},
+		{Err: ErrProviderNotFound, Status: http.StatusNotFound, Code: "PROVIDER_NOT_FOUND", Message: "Provider not found"},
+		{Err: ErrDeploymentNotFound, Status: http.StatusNotFound, Code: "DEPLOYMENT_NOT_FOUND", Message: "Deployment not found"},
+		{Err: ErrRateLimitNotFound, Status: http.StatusNotFound, Code: "RATE_LIMIT_NOT_FOUND", Message: "Rate limit policy not found"},
+		{Err: ErrLLMProxyNotFound, Status: http.StatusNotFound, Code: "LLM_PROXY_NOT_FOUND", Message: "LLM proxy not found"},
+		{Err: ErrAPINotFound, Status: http.StatusNotFound, Code: "API_NOT_FOUND", Message: "API not found"},
+		{Err: ErrDevPortalNotFound, Status: http.StatusNotFound, Code: "DEVPORTAL_NOT_FOUND", Message: "Developer portal not found"},
+
+		// Conflict errors
+		{Err: ErrUsageReportGenerating, Status: http.StatusConflict, Code: "USAGE_REPORT_GENERATING", Message: "Usage report is being generated"},
+		{Err: ErrUsageReportNotCompleted, Status: http.StatusConflict, Code: "USAGE_REPORT_NOT_COMPLETED", Message: "Usage report is not completed"},
+		{Err: ErrChangeFreezeActive, Status: http.StatusConflict, Code: "CHANGE_FREEZE_ACTIVE", ExposeError: true},
+		{Err: ErrEmailRecipientAlreadyExists, Status: http.StatusConflict, Code: "EMAIL_RECIPIENT_ALREADY_EXISTS", Message: "Email recipient already exists"},
+		{Err: ErrEmailRecipientAlreadyVerified, Status: http.StatusConflict, Code: "EMAIL_RECIPIENT_ALREADY_VERIFIED", Message: "Email recipient is already verified"},
+		{Err: ErrTrialSandboxAlreadyUsed, Status: http.StatusConflict, Code: "TRIAL_SANDBOX_ALREADY_USED", Message: "Organization already has or had a trial sandbox"},
+		{Err: ErrDeploymentApprovalNotPending, Status: http.StatusConflict, Code: "DEPLOYMENT_APPROVAL_NOT_PENDING", Message: "Deployment approval has already been reviewed"},
+		{Err: ErrAgentAlreadyExists, Status: http.StatusConflict, Code: "AGENT_ALREADY_EXISTS", Message: "Agent already exists"},
+		{Err: ErrOrganizationAlreadyExists, Status: http.StatusConflict, Code: "ORGANIZATION_ALREADY_EXISTS", Message: "Organization already exists"},
+		{Err: ErrProjectAlreadyExists, Status: http.StatusConflict, Code: "PROJECT_ALREADY_EXISTS", Message: "Project already exists"},
+		{Err: ErrProjectHasAssociatedAgents, Status: http.StatusConflict, Code: "PROJECT_HAS_AGENTS", Message: "Project has associated agents"},
+		{Err: ErrGatewayAlreadyExists, Status: http.StatusConflict, Code: "GATEWAY_ALREADY_EXISTS", Message: "Gateway already exists"},
+		{Err: ErrEnvironmentAlreadyExists, Status: http.StatusConflict, Code: "ENVIRONMENT_ALREADY_EXISTS", Message: "Environment already exists"},
+		{Err: ErrEnvironmentHasGateways, Status: http.StatusConflict, Code: "ENVIRONMENT_HAS_GATEWAYS", Message: "Environment has associated gateways"},
+		{Err: ErrGatewayRegionMismatch, Status: http.StatusConflict, Code: "GATEWAY_REGION_MISMATCH", ExposeError: true},
+		{Err: ErrGatewayVhostConflict, Status: http.StatusConflict, Code: "GATEWAY_VHOST_CONFLICT", ExposeError: true},
+		{Err: ErrMCPServerAlreadyExists, Status: http.StatusConflict, Code: "MCP_SERVER_ALREADY_EXISTS", Message: "MCP server already exists"},
+		{Err: ErrGoldenTraceAlreadyExists, Status: http.StatusConflict, Code: "GOLDEN_TRACE_ALREADY_EXISTS", Message: "A golden trace with this name already exists"},
+		{Err: ErrAgentSLOAlreadyExists, Status: http.StatusConflict, Code: "SLO_ALREADY_EXISTS", Message: "An SLO with this name already exists"},
+		{Err: ErrTokenBudgetAlreadyExists, Status: http.StatusConflict, Code: "TOKEN_BUDGET_ALREADY_EXISTS", Message: "A token budget for this environment and period already exists"},
+		{Err: ErrTokenBudgetProxyInUse, Status: http.StatusConflict, Code: "TOKEN_BUDGET_PROXY_IN_USE", Message: "The LLM proxy is limited by the token budget of another agent"},
+		{Err: ErrAgentPublicationAlreadyExists, Status: http.StatusConflict, Code: "AGENT_PUBLICATION_ALREADY_EXISTS", Message: "Agent is already registered for publishing"},
+		{Err: ErrAgentPublicationInvalidTransition, Status: http.StatusConflict, Code: "AGENT_PUBLICATION_INVALID_TRANSITION", ExposeError: true},
+		{Err: ErrAgentPublicationActive, Status: http.StatusConflict, Code: "AGENT_PUBLICATION_ACTIVE", Message: "Agent publication must be retired before it is deleted"},
+		{Err: ErrProviderAlreadyExists, Status: http.StatusConflict, Code: "PROVIDER_ALREADY_EXISTS", Message: "Provider already exists"},
+		{Err: ErrProviderHasDeployments, Status: http.StatusConflict, Code: "PROVIDER_HAS_DEPLOYMENTS", Message: "Provider has active deployments"},
+		{Err: ErrRateLimitConflict, Status: http.StatusConflict, Code: "RATE_LIMIT_CONFLICT", ExposeError: true},
+		{Err: ErrOrganizationSuspended, Status: http.StatusForbidden, Code: "ORGANIZATION_SUSPENDED", Message: "Organization is suspended"},
+
+		// Bad request errors
+		{Err: ErrDeploymentPipelineNotFound, Status: http.StatusBadRequest, Code: "DEPLOYMENT_PIPELINE_NOT_FOUND", Message: "Deployment pipeline not found"},
+		{Err: ErrImmutableFieldChange, Status: http.StatusBadRequest, Code: "IMMUTABLE_FIELD_CHANGE", ExposeError: true},
+		{Err: ErrInvalidAdapterType, Status: http.StatusBadRequest, Code: "INVALID_ADAPTER_TYPE", ExposeError: true},
+		{Err: ErrInvalidGatewayConfig, Status: http.StatusBadRequest, Code: "INVALID_GATEWAY_CONFIG", ExposeError: true},
+		{Err: ErrUnknownRegion, Status: http.StatusBadRequest, Code: "UNKNOWN_REGION", ExposeError: true},
+		{Err: ErrInvalidProviderConfig, Status: http.StatusBadRequest, Code: "INVALID_PROVIDER_CONFIG", ExposeError: true},
+		{Err: ErrPolicyNotSupported, Status: http.StatusBadRequest, Code: "POLICY_NOT_SUPPORTED", ExposeError: true},
+		{Err: ErrInvalidVerificationCode, Status: http.StatusBadRequest, Code: "INVALID_VERIFICATION_CODE", Message: "Verification code is invalid or expired"},
+		{Err: ErrInvalidInput, Status: http.StatusBadRequest, Code: ErrorCodeBadRequest, ExposeError: true},
+		{Err: ErrBadRequest, Status: http.StatusBadRequest, Code: ErrorCodeBadRequest, ExposeError: true},
+		{Err: ErrPreconditionFailed, Status: http.StatusPreconditionFailed, Code: ErrorCodePreconditionFailed, Message: "Resource has been modified"},
+
+		// Errors about the content of a resource
+		{Err: ErrTraceReplayNoInput, Status: http.StatusUnprocessableEntity, Code: "TRACE_NOT_REPLAYABLE", Message: "Trace has no root input to replay"},
+		{Err: ErrTraceNotScorable, Status: http.StatusUnprocessableEntity, Code: "TRACE_NOT_SCORABLE", Message: "Trace has no root input and output to score"},
+
+		// Authorization errors
+		{Err: ErrUnauthorized, Status: http.StatusUnauthorized, Code: ErrorCodeUnauthorized, ExposeError: true},
+		{Err: ErrNotDeploymentApprover, Status: http.StatusForbidden, Code: "NOT_DEPLOYMENT_APPROVER", Message: "User is not a reviewer of deployment approvals"},
+		{Err: ErrDeploymentSelfApproval, Status: http.StatusForbidden, Code: "DEPLOYMENT_SELF_APPROVAL", Message: "A deployment cannot be reviewed by its requester"},
+		{Err: ErrNotChangeFreezeAdmin, Status: http.StatusForbidden, Code: "NOT_CHANGE_FREEZE_ADMIN", Message: "User cannot change the change freeze windows"},
+		{Err: ErrNotPlatformAdmin, Status: http.StatusForbidden, Code: "NOT_PLATFORM_ADMIN", Message: "User cannot manage organizations"},
+		{Err: ErrNotDeploymentApprovalAdmin, Status: http.StatusForbidden, Code: "NOT_DEPLOYMENT_APPROVAL_ADMIN", Message: "User cannot change the deployment approval policy"},
+		{Err: ErrForbidden, Status: http.StatusForbidden, Code: ErrorCodeForbidden, ExposeError: true},
+
+		// Upstream and server errors
+		{Err: ErrAgentInvocationFailed, Status: http.StatusBadGateway, Code: "AGENT_INVOCATION_FAILED", Message: "Agent did not respond"},
+		{Err: ErrGatewayUnreachable, Status: http.StatusBadGateway, Code: "GATEWAY_UNREACHABLE", Message: "Gateway unreachable"},
+		{Err: ErrDeploymentFailed, Status: http.StatusBadGateway, Code: "DEPLOYMENT_FAILED", ExposeError: true},
+		{Err: ErrNotificationDeliveryFailed, Status: http.StatusBadGateway, Code: "NOTIFICATION_DELIVERY_FAILED", ExposeError: true},
+		{Err: ErrCredentialStoreUnavailable, Status: http.StatusServiceUnavailable, Code: "CREDENTIAL_STORE_UNAVAILABLE", Message: "Credential storage is not configured"},
+		{Err: ErrServiceUnavailable, Status: http.StatusServiceUnavailable, Code: ErrorCodeServiceUnavailable, ExposeError: true},
+	}
+)
+
+// RegisterError adds error definitions to the registry, for errors declared outside this package
+func RegisterError(defs ...ErrorDefinition) {
+	errorRegistryMu.Lock()
+	defer errorRegistryMu.Unlock()
+
+	errorRegistry = append(errorRegistry, defs...)
+}
+
+// LookupError returns the definition of the first registered error that err matches
+func LookupError(err error) (ErrorDefinition, bool) {
+	errorRegistryMu.RLock()
+	defer errorRegistryMu.RUnlock()
+
+	for _, def := range errorRegistry {
+		if errors.Is(err, def.Err) {
+			return def, true
+		}
+	}
+	return ErrorDefinition{}, false
+}
+
+// ErrorCodeForStatus returns the generic error code of an HTTP status
+func ErrorCodeForStatus(status int) ErrorCode {
+	switch status {
+	case http.StatusBadRequest:
+		return ErrorCodeBadRequest
+	case http.StatusUnauthorized:
+		return ErrorCodeUnauthorized
+	case http.StatusForbidden:
+		return ErrorCodeForbidden
+	case http.StatusNotFound:
+		return ErrorCodeNotFound
+	case http.StatusMethodNotAllowed:
+		return ErrorCodeMethodNotAllowed
+	case http.StatusConflict:
+		return ErrorCodeConflict
+	case http.StatusPreconditionFailed:
+		return ErrorCodePreconditionFailed
+	case http.StatusUnprocessableEntity:
+		return ErrorCodeUnprocessable
+	case http.StatusTooManyRequests:
+		return ErrorCodeRateLimited
+	case http.StatusNotImplemented:
+		return ErrorCodeNotImplemented
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return ErrorCodeUpstream
+	case http.StatusServiceUnavailable:
+		return ErrorCodeServiceUnavailable
+	}
+	if status >= http.StatusInternalServerError {
+		return ErrorCodeInternal
+	}
+	return ErrorCodeBadRequest
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+)
+
+func decodeErrorResponse(t *testing.T, rec *httptest.ResponseRecorder) spec.ErrorResponse {
+	t.Helper()
+	var body spec.ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	return body
+}
+
+func TestWriteError(t *testing.T) {
+	t.Run("Registered error is written with its status, code and message", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		WriteError(rec, fmt.Errorf("get agent: %w", ErrAgentNotFound), "Failed to get agent")
+
+		body := decodeErrorResponse(t, rec)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Equal(t, "AGENT_NOT_FOUND", body.GetCode())
+		assert.Equal(t, "Agent not found", body.Message)
+	})
+
+	t.Run("Exposed error is written with the error text", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		WriteError(rec, fmt.Errorf("%w: name is required", ErrInvalidInput), "Failed to create agent")
+
+		body := decodeErrorResponse(t, rec)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, string(ErrorCodeBadRequest), body.GetCode())
+		assert.Equal(t, "invalid input: name is required", body.Message)
+	})
+
+	t.Run("Unknown error is written as an internal error with the fallback message", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		WriteError(rec, errors.New("connection refused"), "Failed to get agent")
+
+		body := decodeErrorResponse(t, rec)
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Equal(t, string(ErrorCodeInternal), body.GetCode())
+		assert.Equal(t, "Failed to get agent", body.Message)
+	})
+
+	t.Run("Registered errors of other packages are matched", func(t *testing.T) {
+		errCustom := errors.New("custom")
+		RegisterError(ErrorDefinition{Err: errCustom, Status: http.StatusTeapot, Code: "CUSTOM", Message: "Custom error"})
+
+		rec := httptest.NewRecorder()
+		WriteError(rec, errCustom, "Failed")
+
+		body := decodeErrorResponse(t, rec)
+		assert.Equal(t, http.StatusTeapot, rec.Code)
+		assert.Equal(t, "CUSTOM", body.GetCode())
+	})
+}
+
+func TestWriteErrorResponse(t *testing.T) {
+	t.Run("Code is derived from the status", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		WriteErrorResponse(rec, http.StatusConflict, "Already exists")
+
+		body := decodeErrorResponse(t, rec)
+		assert.Equal(t, string(ErrorCodeConflict), body.GetCode())
+		assert.False(t, body.HasCorrelationId())
+	})
+
+	t.Run("Correlation ID of the response is included", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		rec.Header().Set(CorrelationIDHeader, "abc-123")
+		WriteErrorResponse(rec, http.StatusNotFound, "Not found")
+
+		body := decodeErrorResponse(t, rec)
+		assert.Equal(t, "abc-123", body.GetCorrelationId())
+	})
+}
+
+func TestWriteValidationError(t *testing.T) {
+	rec := httptest.NewRecorder()
+	WriteValidationError(rec, "Invalid request", []spec.FieldError{*spec.NewFieldError("name", "is required")})
+
+	body := decodeErrorResponse(t, rec)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, string(ErrorCodeValidationFailed), body.GetCode())
+	require.Len(t, body.FieldErrors, 1)
+	assert.Equal(t, "name", body.FieldErrors[0].Field)
+}
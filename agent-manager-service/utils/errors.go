@@ -27,6 +27,8 @@ var (
 	ErrBuildNotFound              = errors.New("build not found")
 	ErrEnvironmentNotFound        = errors.New("environment not found")
 	ErrOrganizationAlreadyExists  = errors.New("organization already exists")
+	ErrOrganizationSuspended      = errors.New("organization is suspended")
+	ErrNotPlatformAdmin           = errors.New("user cannot manage organizations")
 	ErrProjectAlreadyExists       = errors.New("project already exists")
 	ErrDeploymentPipelineNotFound = errors.New("deployment pipeline not found")
 	ErrProjectHasAssociatedAgents = errors.New("project has associated agents")
@@ -34,7 +36,8 @@ var (
 	ErrImmutableFieldChange       = errors.New("cannot change immutable field")
 
 	// Request errors
-	ErrBadRequest = errors.New("bad request")
+	ErrBadRequest         = errors.New("bad request")
+	ErrPreconditionFailed = errors.New("precondition failed")
 
 	// Authorization errors
 	ErrUnauthorized = errors.New("unauthorized")
@@ -44,13 +47,115 @@ var (
 	ErrServiceUnavailable = errors.New("service unavailable")
 
 	// Gateway-related errors
-	ErrGatewayNotFound          = errors.New("gateway not found")
-	ErrGatewayAlreadyExists     = errors.New("gateway already exists")
-	ErrInvalidAdapterType       = errors.New("invalid adapter type")
-	ErrGatewayUnreachable       = errors.New("gateway unreachable")
-	ErrInvalidGatewayConfig     = errors.New("invalid gateway configuration")
-	ErrEnvironmentAlreadyExists = errors.New("environment already exists")
-	ErrEnvironmentHasGateways   = errors.New("environment has associated gateways")
+	ErrGatewayNotFound              = errors.New("gateway not found")
+	ErrGatewayAlreadyExists         = errors.New("gateway already exists")
+	ErrInvalidAdapterType           = errors.New("invalid adapter type")
+	ErrGatewayUnreachable           = errors.New("gateway unreachable")
+	ErrInvalidGatewayConfig         = errors.New("invalid gateway configuration")
+	ErrEnvironmentAlreadyExists     = errors.New("environment already exists")
+	ErrEnvironmentHasGateways       = errors.New("environment has associated gateways")
+	ErrUnknownRegion                = errors.New("unknown region")
+	ErrGatewayRegionMismatch        = errors.New("gateway region does not match the environment region")
+	ErrGatewayVhostConflict         = errors.New("vhost is already used by another gateway")
+	ErrGatewayBulkOperationNotFound = errors.New("gateway bulk operation not found")
+
+	// SCIM provisioning errors
+	ErrScimUserNotFound       = errors.New("user not found")
+	ErrScimUserAlreadyExists  = errors.New("user already exists")
+	ErrScimGroupNotFound      = errors.New("group not found")
+	ErrScimGroupAlreadyExists = errors.New("group already exists")
+	ErrScimInvalidFilter      = errors.New("invalid filter")
+
+	// MCP server registry errors
+	ErrMCPServerNotFound          = errors.New("MCP server not found")
+	ErrMCPServerAlreadyExists     = errors.New("MCP server already exists")
+	ErrCredentialStoreUnavailable = errors.New("credential encryption key is not configured")
+
+	// Trace retention errors
+	ErrTraceRetentionPolicyNotFound = errors.New("trace retention policy not found")
+
+	// Trace sampling errors
+	ErrTraceSamplingPolicyNotFound = errors.New("trace sampling policy not found")
+
+	// Trace erasure errors
+	ErrTraceErasureNotFound = errors.New("trace erasure not found")
+
+	// Usage report errors
+	ErrUsageReportNotFound     = errors.New("usage report not found")
+	ErrUsageReportGenerating   = errors.New("usage report is being generated")
+	ErrUsageReportNotCompleted = errors.New("usage report is not completed")
+
+	// Cost center errors
+	ErrCostCenterMappingNotFound = errors.New("cost center mapping not found")
+
+	// Deployment approval errors
+	ErrDeploymentApprovalNotFound       = errors.New("deployment approval not found")
+	ErrDeploymentApprovalPolicyNotFound = errors.New("deployment approval policy not found")
+	ErrDeploymentApprovalNotPending     = errors.New("deployment approval has already been reviewed")
+	ErrNotDeploymentApprover            = errors.New("user is not a reviewer of deployment approvals")
+	ErrDeploymentSelfApproval           = errors.New("a deployment cannot be reviewed by its requester")
+	ErrNotDeploymentApprovalAdmin       = errors.New("user cannot change the deployment approval policy")
+
+	// Change freeze errors
+	ErrChangeFreezeWindowNotFound = errors.New("change freeze window not found")
+	ErrChangeFreezeActive         = errors.New("changes are frozen")
+	ErrNotChangeFreezeAdmin       = errors.New("user cannot change the change freeze windows")
+
+	// Trial sandbox errors
+	ErrTrialSandboxNotFound     = errors.New("trial sandbox not found")
+	ErrTrialSandboxesNotOffered = errors.New("trial sandboxes are not offered")
+	ErrTrialSandboxAlreadyUsed  = errors.New("organization already has a trial sandbox")
+
+	// Notification digest errors
+	ErrNotificationDigestNotFound = errors.New("notification digest not found")
+	ErrNotificationDeliveryFailed = errors.New("failed to deliver notification")
+
+	// Email recipient errors
+	ErrEmailNotConfigured            = errors.New("email notifications are not configured")
+	ErrEmailRecipientNotFound        = errors.New("email recipient not found")
+	ErrEmailRecipientAlreadyExists   = errors.New("email recipient already exists")
+	ErrEmailRecipientAlreadyVerified = errors.New("email recipient is already verified")
+	ErrInvalidVerificationCode       = errors.New("verification code is invalid or expired")
+
+	// Trace replay errors
+	ErrTraceReplayNoInput    = errors.New("trace has no root input to replay")
+	ErrAgentEndpointNotFound = errors.New("agent endpoint not found")
+
+	// Agent card errors
+	ErrAgentNotDeployed = errors.New("agent is not deployed")
+
+	// Agent invocation errors
+	ErrAgentInvocationFailed = errors.New("failed to invoke agent")
+
+	// Golden trace errors
+	ErrGoldenTraceNotFound      = errors.New("golden trace not found")
+	ErrGoldenTraceAlreadyExists = errors.New("golden trace already exists")
+	ErrGoldenTraceRunNotFound   = errors.New("golden trace run not found")
+
+	// Trace scoring errors
+	ErrTraceScoringPolicyNotFound = errors.New("trace scoring policy not found")
+	ErrTraceNotScorable           = errors.New("trace has no root input and output to score")
+
+	// Agent SLO errors
+	ErrAgentSLONotFound      = errors.New("agent SLO not found")
+	ErrAgentSLOAlreadyExists = errors.New("agent SLO already exists")
+
+	// Token budget errors
+	ErrTokenBudgetNotFound      = errors.New("token budget not found")
+	ErrTokenBudgetAlreadyExists = errors.New("token budget already exists for the environment and period")
+	ErrTokenBudgetProxyInUse    = errors.New("LLM proxy is used by the token budget of another agent")
+
+	// Agent publication errors
+	ErrAgentPublicationNotFound          = errors.New("agent publication not found")
+	ErrAgentPublicationAlreadyExists     = errors.New("agent is already registered for publishing")
+	ErrAgentPublicationInvalidTransition = errors.New("invalid agent publication lifecycle transition")
+	ErrAgentPublicationActive            = errors.New("agent publication must be retired before it is deleted")
+
+	// Deployment revision errors
+	ErrDeploymentRevisionNotFound = errors.New("deployment revision not found")
+
+	// Agent template errors
+	ErrAgentTemplateNotFound = errors.New("agent template not found")
 
 	// LLM Provider-related errors (Phase 7)
 	ErrProviderNotFound       = errors.New("provider not found")
@@ -60,4 +165,11 @@ var (
 	ErrDeploymentFailed       = errors.New("deployment failed")
 	ErrPolicyNotSupported     = errors.New("policy not supported by gateway")
 	ErrInvalidProviderConfig  = errors.New("invalid provider configuration")
+	ErrRateLimitNotFound      = errors.New("rate limit policy not found")
+	ErrRateLimitConflict      = errors.New("conflicting rate limit policy")
+	ErrLLMProxyNotFound       = errors.New("LLM proxy not found")
+
+	// API Platform API and DevPortal errors
+	ErrAPINotFound       = errors.New("API not found")
+	ErrDevPortalNotFound = errors.New("DevPortal not found")
 )
@@ -0,0 +1,81 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	HeaderETag    = "ETag"
+	HeaderIfMatch = "If-Match"
+)
+
+// ComputeETag returns a strong ETag for the JSON representation of a resource
+func ComputeETag(v interface{}) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("failed to compute etag: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`, nil
+}
+
+// SetETagHeader sets the ETag response header for a resource. Failing to compute the ETag
+// only means clients cannot make conditional requests, so the error is ignored.
+func SetETagHeader(w http.ResponseWriter, v interface{}) {
+	if etag, err := ComputeETag(v); err == nil {
+		w.Header().Set(HeaderETag, etag)
+	}
+}
+
+// ETagMatches reports whether an If-Match header value allows a change to a resource with
+// the given ETag. An empty header places no precondition on the request.
+func ETagMatches(ifMatch string, etag string) bool {
+	ifMatch = strings.TrimSpace(ifMatch)
+	if ifMatch == "" || ifMatch == "*" {
+		return true
+	}
+	for _, candidate := range strings.Split(ifMatch, ",") {
+		// Weak validators never match for If-Match (RFC 9110, section 13.1.1)
+		if strings.TrimSpace(candidate) == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckIfMatch returns ErrPreconditionFailed when the If-Match header does not match the
+// current ETag of the resource
+func CheckIfMatch(ifMatch string, current interface{}) error {
+	if strings.TrimSpace(ifMatch) == "" {
+		return nil
+	}
+	etag, err := ComputeETag(current)
+	if err != nil {
+		return err
+	}
+	if !ETagMatches(ifMatch, etag) {
+		return ErrPreconditionFailed
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeETag(t *testing.T) {
+	type resource struct {
+		Name        string `json:"name"`
+		DisplayName string `json:"displayName"`
+	}
+
+	t.Run("Same content produces the same quoted ETag", func(t *testing.T) {
+		first, err := ComputeETag(resource{Name: "dev", DisplayName: "Development"})
+		require.NoError(t, err)
+		second, err := ComputeETag(resource{Name: "dev", DisplayName: "Development"})
+		require.NoError(t, err)
+
+		assert.Equal(t, first, second)
+		assert.True(t, len(first) > 2 && first[0] == '"' && first[len(first)-1] == '"')
+	})
+
+	t.Run("Different content produces a different ETag", func(t *testing.T) {
+		first, err := ComputeETag(resource{Name: "dev", DisplayName: "Development"})
+		require.NoError(t, err)
+		second, err := ComputeETag(resource{Name: "dev", DisplayName: "Dev"})
+		require.NoError(t, err)
+
+		assert.NotEqual(t, first, second)
+	})
+}
+
+func TestETagMatches(t *testing.T) {
+	etag := `"abc123"`
+
+	tests := []struct {
+		name    string
+		ifMatch string
+		want    bool
+	}{
+		{name: "empty header", ifMatch: "", want: true},
+		{name: "wildcard", ifMatch: "*", want: true},
+		{name: "exact match", ifMatch: `"abc123"`, want: true},
+		{name: "match in list", ifMatch: `"other", "abc123"`, want: true},
+		{name: "mismatch", ifMatch: `"other"`, want: false},
+		{name: "weak validator never matches", ifMatch: `W/"abc123"`, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ETagMatches(tt.ifMatch, etag))
+		})
+	}
+}
+
+func TestCheckIfMatch(t *testing.T) {
+	current := map[string]string{"name": "dev"}
+	etag, err := ComputeETag(current)
+	require.NoError(t, err)
+
+	assert.NoError(t, CheckIfMatch("", current))
+	assert.NoError(t, CheckIfMatch(etag, current))
+	assert.ErrorIs(t, CheckIfMatch(`"stale"`, current), ErrPreconditionFailed)
+}
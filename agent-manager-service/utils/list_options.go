@@ -0,0 +1,79 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package utils
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// SortOption is the field and direction to sort a list by, read from a sort=field:asc query parameter
+type SortOption struct {
+	Field      string
+	Descending bool
+}
+
+// ParseSortParam reads a sort query parameter of the form field, field:asc or field:desc.
+// It returns nil when value is empty.
+func ParseSortParam(value string, allowed []string) (*SortOption, error) {
+	if value == "" {
+		return nil, nil
+	}
+	field, direction, _ := strings.Cut(value, ":")
+	if !slices.Contains(allowed, field) {
+		return nil, fmt.Errorf("invalid sort parameter: field must be one of %s", strings.Join(allowed, ", "))
+	}
+	switch strings.ToLower(direction) {
+	case "", "asc":
+		return &SortOption{Field: field}, nil
+	case "desc":
+		return &SortOption{Field: field, Descending: true}, nil
+	default:
+		return nil, fmt.Errorf("invalid sort parameter: direction must be asc or desc")
+	}
+}
+
+// ParseListParam reads a comma-separated query parameter, such as fields or expand, whose values
+// must be in allowed. It returns nil when value is empty.
+func ParseListParam(name string, value string, allowed []string) ([]string, error) {
+	if value == "" {
+		return nil, nil
+	}
+	var values []string
+	for _, v := range strings.Split(value, ",") {
+		v = strings.TrimSpace(v)
+		if !slices.Contains(allowed, v) {
+			return nil, fmt.Errorf("invalid %s parameter: %q is not one of %s", name, v, strings.Join(allowed, ", "))
+		}
+		if !slices.Contains(values, v) {
+			values = append(values, v)
+		}
+	}
+	return values, nil
+}
+
+// SelectFields returns the entries of item named in fields, for sparse fieldset responses
+func SelectFields(item map[string]interface{}, fields []string) map[string]interface{} {
+	selected := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		if value, ok := item[field]; ok {
+			selected[field] = value
+		}
+	}
+	return selected
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSortParam(t *testing.T) {
+	allowed := []string{"name", "createdAt"}
+
+	t.Run("Direction defaults to ascending", func(t *testing.T) {
+		opt, err := ParseSortParam("name", allowed)
+		require.NoError(t, err)
+		assert.Equal(t, &SortOption{Field: "name"}, opt)
+
+		opt, err = ParseSortParam("createdAt:desc", allowed)
+		require.NoError(t, err)
+		assert.Equal(t, &SortOption{Field: "createdAt", Descending: true}, opt)
+	})
+
+	t.Run("Unknown fields and directions are rejected", func(t *testing.T) {
+		_, err := ParseSortParam("vhost:asc", allowed)
+		assert.ErrorContains(t, err, "field must be one of name, createdAt")
+
+		_, err = ParseSortParam("name:up", allowed)
+		assert.ErrorContains(t, err, "direction must be asc or desc")
+	})
+
+	t.Run("An empty value sorts nothing", func(t *testing.T) {
+		opt, err := ParseSortParam("", allowed)
+		require.NoError(t, err)
+		assert.Nil(t, opt)
+	})
+}
+
+func TestParseListParam(t *testing.T) {
+	values, err := ParseListParam("fields", "name, uuid,name", []string{"uuid", "name"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"name", "uuid"}, values)
+
+	_, err = ParseListParam("expand", "projects", []string{"environments"})
+	assert.ErrorContains(t, err, `invalid expand parameter: "projects" is not one of environments`)
+}
+
+func TestSelectFields(t *testing.T) {
+	item := map[string]interface{}{"uuid": "1", "name": "gw", "vhost": "gw.example.com"}
+	assert.Equal(t, map[string]interface{}{"uuid": "1", "name": "gw"}, SelectFields(item, []string{"uuid", "name", "region"}))
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteTextPDF(t *testing.T) {
+	t.Run("Lines are split into pages", func(t *testing.T) {
+		lines := make([]string, PDFLinesPerPage+1)
+		for i := range lines {
+			lines[i] = fmt.Sprintf("line %d", i)
+		}
+		pdf := string(WriteTextPDF(lines))
+
+		assert.True(t, strings.HasPrefix(pdf, "%PDF-1.4\n"))
+		assert.True(t, strings.HasSuffix(pdf, "%%EOF\n"))
+		assert.Contains(t, pdf, "/Count 2")
+		assert.Contains(t, pdf, fmt.Sprintf("(line %d) '", PDFLinesPerPage))
+	})
+
+	t.Run("Cross reference offsets point at the objects", func(t *testing.T) {
+		pdf := string(WriteTextPDF([]string{"usage"}))
+
+		startxref := regexp.MustCompile(`startxref\n(\d+)\n`).FindStringSubmatch(pdf)
+		require.Len(t, startxref, 2)
+		xref, err := strconv.Atoi(startxref[1])
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(pdf[xref:], "xref\n"))
+
+		entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllStringSubmatch(pdf, -1)
+		require.Len(t, entries, 5)
+		for i, entry := range entries {
+			offset, err := strconv.Atoi(entry[1])
+			require.NoError(t, err)
+			assert.True(t, strings.HasPrefix(pdf[offset:], fmt.Sprintf("%d 0 obj\n", i+1)))
+		}
+	})
+
+	t.Run("Text is escaped", func(t *testing.T) {
+		pdf := string(WriteTextPDF([]string{`cost (est.) \ café`}))
+
+		assert.Contains(t, pdf, `(cost \(est.\) \\ caf?) '`)
+	})
+
+	t.Run("No lines still make a page", func(t *testing.T) {
+		assert.Contains(t, string(WriteTextPDF(nil)), "/Count 1")
+	})
+}
@@ -478,13 +478,41 @@ func WriteSuccessResponse[T any](w http.ResponseWriter, statusCode int, data T)
 	_ = json.NewEncoder(w).Encode(data) // Ignore encoding errors for response
 }
 
-// WriteErrorResponse writes an error API response
+// WriteErrorResponse writes an error API response with the generic error code of the status
 func WriteErrorResponse(w http.ResponseWriter, statusCode int, message string) {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(statusCode)
+	writeErrorEnvelope(w, statusCode, ErrorCodeForStatus(statusCode), message, nil)
+}
+
+// WriteError writes the error API response registered for err. Errors that are not registered
+// are written as an internal server error with the fallback message, so that internal details
+// do not reach the client.
+func WriteError(w http.ResponseWriter, err error, fallbackMsg string) {
+	def, ok := LookupError(err)
+	if !ok {
+		writeErrorEnvelope(w, http.StatusInternalServerError, ErrorCodeInternal, fallbackMsg, nil)
+		return
+	}
+	writeErrorEnvelope(w, def.Status, def.Code, def.message(err), nil)
+}
+
+// WriteValidationError writes a bad request API response listing the invalid request fields
+func WriteValidationError(w http.ResponseWriter, message string, fieldErrors []spec.FieldError) {
+	writeErrorEnvelope(w, http.StatusBadRequest, ErrorCodeValidationFailed, message, fieldErrors)
+}
+
+// writeErrorEnvelope writes the error envelope shared by all error API responses. The
+// correlation ID is taken from the response header set by the correlation ID middleware.
+func writeErrorEnvelope(w http.ResponseWriter, statusCode int, code ErrorCode, message string, fieldErrors []spec.FieldError) {
 	errPayload := &spec.ErrorResponse{
-		Message: message,
+		Code:        StrAsStrPointer(string(code)),
+		Message:     message,
+		FieldErrors: fieldErrors,
 	}
+	if correlationID := w.Header().Get(CorrelationIDHeader); correlationID != "" {
+		errPayload.CorrelationId = &correlationID
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
 	_ = json.NewEncoder(w).Encode(errPayload) // Ignore encoding errors for response
 }
 
@@ -0,0 +1,190 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+)
+
+// requestValidator validates request bodies against their `validate` struct tags. The request
+// types generated from the OpenAPI spec cannot carry tags, so their rules are registered in
+// specRequestRules instead.
+var requestValidator = newRequestValidator()
+
+// resourceNamePattern matches lowercase names that start with a letter and end with a letter or digit
+var resourceNamePattern = regexp.MustCompile(`^[a-z]([a-z0-9-]*[a-z0-9])?$`)
+
+// specRequestRules holds the validation rules of generated request types, keyed by Go field name
+var specRequestRules = []struct {
+	rules map[string]string
+	types []any
+}{
+	{
+		rules: map[string]string{
+			"Name":           "required,max=64,resourcename",
+			"DisplayName":    "required,max=128",
+			"GatewayType":    "required,oneof=AI REGULAR",
+			"Vhost":          "required,max=253,hostname_rfc1123|ip",
+			"Region":         "omitnil,max=64",
+			"EnvironmentIds": "omitempty,dive,uuid",
+		},
+		types: []any{spec.CreateGatewayRequest{}},
+	},
+	{
+		rules: map[string]string{
+			"DisplayName": "omitnil,min=1,max=128",
+			"Status":      "omitnil,oneof=ACTIVE INACTIVE PROVISIONING ERROR",
+		},
+		types: []any{spec.UpdateGatewayRequest{}},
+	},
+	{
+		rules: map[string]string{
+			"Name":         "required,max=64,resourcename",
+			"DisplayName":  "required,max=128",
+			"Description":  "omitnil,max=1024",
+			"DataplaneRef": "required,max=100",
+			"DnsPrefix":    "required,max=100",
+			"Region":       "omitnil,max=64",
+		},
+		types: []any{spec.CreateEnvironmentRequest{}},
+	},
+	{
+		rules: map[string]string{
+			"DisplayName": "omitnil,min=1,max=128",
+			"Region":      "omitnil,max=64",
+		},
+		types: []any{spec.UpdateEnvironmentRequest{}},
+	},
+}
+
+func newRequestValidator() *validator.Validate {
+	v := validator.New(validator.WithRequiredStructEnabled())
+	// Report fields by their JSON names, which is what API clients know them as
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		if name == "" {
+			return field.Name
+		}
+		return name
+	})
+	_ = v.RegisterValidation("resourcename", func(fl validator.FieldLevel) bool {
+		return resourceNamePattern.MatchString(fl.Field().String())
+	})
+	_ = v.RegisterValidation("notblank", func(fl validator.FieldLevel) bool {
+		return strings.TrimSpace(fl.Field().String()) != ""
+	})
+	for _, r := range specRequestRules {
+		v.RegisterStructValidationMapRules(r.rules, r.types...)
+	}
+	return v
+}
+
+// ValidateRequest validates a decoded request body and returns an error for each invalid
+// field, or nil when the request is valid
+func ValidateRequest(req any) []spec.FieldError {
+	err := requestValidator.Struct(req)
+	if err == nil {
+		return nil
+	}
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return []spec.FieldError{{Field: "", Message: err.Error()}}
+	}
+	fieldErrors := make([]spec.FieldError, 0, len(validationErrors))
+	for _, fe := range validationErrors {
+		fieldErrors = append(fieldErrors, spec.FieldError{
+			Field:   fieldPath(fe),
+			Message: fieldErrorMessage(fe),
+		})
+	}
+	return fieldErrors
+}
+
+// fieldPath returns the JSON path of an invalid field, without the name of the request type
+func fieldPath(fe validator.FieldError) string {
+	_, path, found := strings.Cut(fe.Namespace(), ".")
+	if !found {
+		return fe.Field()
+	}
+	return path
+}
+
+// fieldErrorMessage describes why a field failed the given validation tag
+func fieldErrorMessage(fe validator.FieldError) string {
+	isString := fe.Kind() == reflect.String
+	isList := fe.Kind() == reflect.Slice
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "notblank":
+		return "must not be blank"
+	case "min":
+		if (isString || isList) && fe.Param() == "1" {
+			return "must not be empty"
+		}
+		if isString {
+			return fmt.Sprintf("must be at least %s characters", fe.Param())
+		}
+		if isList {
+			return fmt.Sprintf("must have at least %s items", fe.Param())
+		}
+		return fmt.Sprintf("must be at least %s", fe.Param())
+	case "max":
+		if isString {
+			return fmt.Sprintf("must be at most %s characters", fe.Param())
+		}
+		if isList {
+			return fmt.Sprintf("must have at most %s items", fe.Param())
+		}
+		return fmt.Sprintf("must be at most %s", fe.Param())
+	case "gt":
+		return fmt.Sprintf("must be greater than %s", fe.Param())
+	case "gte":
+		return fmt.Sprintf("must be greater than or equal to %s", fe.Param())
+	case "lt":
+		return fmt.Sprintf("must be less than %s", fe.Param())
+	case "lte":
+		return fmt.Sprintf("must be less than or equal to %s", fe.Param())
+	case "oneof":
+		return fmt.Sprintf("must be one of: %s", strings.Join(strings.Fields(fe.Param()), ", "))
+	case "uuid":
+		return "must be a UUID"
+	case "unique":
+		return "must not contain duplicates"
+	case "url", "http_url":
+		return "must be an absolute URL"
+	case "hostname_rfc1123|ip":
+		return "must be a host name or IP address"
+	case "datetime":
+		return fmt.Sprintf("must be formatted as %s", strings.NewReplacer("2006", "YYYY", "01", "MM", "02", "DD").Replace(fe.Param()))
+	case "resourcename":
+		return "must contain only lowercase alphanumeric characters or '-', start with a letter and end with a letter or digit"
+	default:
+		return "is invalid"
+	}
+}
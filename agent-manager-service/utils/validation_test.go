@@ -0,0 +1,96 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/models"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/spec"
+)
+
+func fieldErrorMessages(fieldErrors []spec.FieldError) map[string]string {
+	messages := make(map[string]string, len(fieldErrors))
+	for _, fe := range fieldErrors {
+		messages[fe.Field] = fe.Message
+	}
+	return messages
+}
+
+func TestValidateRequest(t *testing.T) {
+	t.Run("Valid generated request has no field errors", func(t *testing.T) {
+		req := spec.NewCreateGatewayRequest("prod-gateway", "Production", spec.AI, "gw.example.com")
+		assert.Nil(t, ValidateRequest(req))
+	})
+
+	t.Run("Rules of generated requests are reported by JSON name", func(t *testing.T) {
+		req := spec.CreateGatewayRequest{
+			Name:           "Prod_Gateway",
+			Vhost:          "not a host",
+			EnvironmentIds: []string{"not-a-uuid"},
+		}
+
+		messages := fieldErrorMessages(ValidateRequest(&req))
+		assert.Contains(t, messages["name"], "lowercase alphanumeric")
+		assert.Equal(t, "is required", messages["displayName"])
+		assert.Equal(t, "is required", messages["gatewayType"])
+		assert.Equal(t, "must be a host name or IP address", messages["vhost"])
+		assert.Equal(t, "must be a UUID", messages["environmentIds[0]"])
+	})
+
+	t.Run("Optional fields are only validated when set", func(t *testing.T) {
+		assert.Nil(t, ValidateRequest(&spec.UpdateGatewayRequest{}))
+
+		empty := ""
+		messages := fieldErrorMessages(ValidateRequest(&spec.UpdateGatewayRequest{DisplayName: &empty}))
+		assert.Equal(t, "must not be empty", messages["displayName"])
+	})
+
+	t.Run("Struct tags of nested requests are validated", func(t *testing.T) {
+		blank := "  "
+		req := models.CreateOrganizationRequest{
+			Name:           "acme",
+			DefaultGateway: &models.OrganizationGatewayConfig{Name: "default"},
+		}
+		messages := fieldErrorMessages(ValidateRequest(&req))
+		assert.Equal(t, map[string]string{"defaultGateway.vhost": "is required"}, messages)
+
+		messages = fieldErrorMessages(ValidateRequest(&models.UpdateOrganizationRequest{DisplayName: &blank}))
+		assert.Equal(t, "must not be blank", messages["displayName"])
+	})
+
+	t.Run("Numeric bounds are described", func(t *testing.T) {
+		req := models.AgentSLORequest{
+			Name:        "latency",
+			Environment: "development",
+			Indicator:   "throughput",
+			Target:      100,
+			WindowDays:  120,
+		}
+		messages := fieldErrorMessages(ValidateRequest(&req))
+		assert.Equal(t, "must be one of: latency, availability", messages["indicator"])
+		assert.Equal(t, "must be less than 100", messages["target"])
+		assert.Equal(t, "must be less than or equal to 90", messages["windowDays"])
+	})
+
+	t.Run("Date layouts are described", func(t *testing.T) {
+		messages := fieldErrorMessages(ValidateRequest(&models.CreateUsageReportRequest{Period: "2026-1"}))
+		assert.Equal(t, "must be formatted as YYYY-MM", messages["period"])
+	})
+}
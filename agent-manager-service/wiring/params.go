@@ -28,6 +28,7 @@ import (
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/config"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/controllers"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
 )
 
 // AppParams contains all wired application dependencies
@@ -37,13 +38,31 @@ type AppParams struct {
 	Logger         *slog.Logger
 
 	// Controllers
-	AgentController         controllers.AgentController
-	InfraResourceController controllers.InfraResourceController
-	ObservabilityController controllers.ObservabilityController
-	AgentTokenController    controllers.AgentTokenController
-	RepositoryController    controllers.RepositoryController
-	EnvironmentController   controllers.EnvironmentController
-	GatewayController       controllers.GatewayController
+	AgentController                controllers.AgentController
+	InfraResourceController        controllers.InfraResourceController
+	ObservabilityController        controllers.ObservabilityController
+	AgentTokenController           controllers.AgentTokenController
+	RepositoryController           controllers.RepositoryController
+	EnvironmentController          controllers.EnvironmentController
+	GatewayController              controllers.GatewayController
+	ApplyController                controllers.ApplyController
+	GatewayBulkOperationController controllers.GatewayBulkOperationController
+	SearchController               controllers.SearchController
+	OrganizationController         controllers.OrganizationController
+	ScimController                 controllers.ScimController
+	AgentTemplateController        controllers.AgentTemplateController
+	MCPServerController            controllers.MCPServerController
+	AgentPublicationController     controllers.AgentPublicationController
+	AgentCardController            controllers.AgentCardController
+	AgentInvocationController      controllers.AgentInvocationController
+	DeploymentApprovalController   controllers.DeploymentApprovalController
+	ChangeFreezeController         controllers.ChangeFreezeController
+
+	// Services
+	AgentManagerService         services.AgentManagerService
+	OrganizationService         services.OrganizationService
+	MCPServerService            services.MCPServerService
+	ObservabilityManagerService services.ObservabilityManagerService
 
 	// Clients
 	APIPlatformClient apiplatformclient.APIPlatformClient
@@ -65,7 +84,7 @@ func ProvideConfigFromPtr(config *config.Config) config.Config {
 }
 
 func ProvideAuthMiddleware(config config.Config) jwtassertion.Middleware {
-	return jwtassertion.JWTAuthMiddleware(config.AuthHeader)
+	return jwtassertion.JWTAuthMiddleware(config.AuthHeader, jwtassertion.NewValidator(config.KeyManagerConfigurations))
 }
 
 func ProvideJWTSigningConfig(config config.Config) config.JWTSigningConfig {
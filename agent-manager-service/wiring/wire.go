@@ -20,7 +20,9 @@
 package wiring
 
 import (
+	"context"
 	"log/slog"
+	"time"
 
 	"github.com/google/wire"
 	"gorm.io/gorm"
@@ -35,6 +37,7 @@ import (
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/controllers"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
 )
 
 // Provider sets
@@ -44,7 +47,7 @@ var configProviderSet = wire.NewSet(
 
 var clientProviderSet = wire.NewSet(
 	ProvideObservabilitySvcClient,
-	traceobserversvc.NewTraceObserverClient,
+	ProvideTraceObserverClient,
 	ProvideOCAuthProvider,
 	ProvideOCClient,
 	ProvideAPIPlatformAuthProvider,
@@ -59,6 +62,18 @@ var serviceProviderSet = wire.NewSet(
 	services.NewAgentTokenManagerService,
 	services.NewRepositoryService,
 	services.NewEnvironmentService,
+	services.NewApplyService,
+	services.NewGatewayBulkOperationService,
+	services.NewSearchService,
+	services.NewResourceEventService,
+	services.NewChangeFreezeService,
+	services.NewOrganizationService,
+	services.NewScimService,
+	services.NewAgentTemplateService,
+	services.NewMCPServerService,
+	services.NewAgentPublicationService,
+	services.NewAgentCardService,
+	services.NewAgentInvocationService,
 )
 
 var controllerProviderSet = wire.NewSet(
@@ -69,6 +84,18 @@ var controllerProviderSet = wire.NewSet(
 	controllers.NewRepositoryController,
 	controllers.NewEnvironmentController,
 	controllers.NewGatewayController,
+	controllers.NewApplyController,
+	controllers.NewGatewayBulkOperationController,
+	controllers.NewSearchController,
+	controllers.NewOrganizationController,
+	controllers.NewScimController,
+	controllers.NewAgentTemplateController,
+	controllers.NewMCPServerController,
+	controllers.NewAgentPublicationController,
+	controllers.NewAgentCardController,
+	controllers.NewAgentInvocationController,
+	controllers.NewDeploymentApprovalController,
+	controllers.NewChangeFreezeController,
 )
 
 var testClientProviderSet = wire.NewSet(
@@ -112,6 +139,28 @@ var loggerProviderSet = wire.NewSet(
 	ProvideLogger,
 )
 
+// ProvideTraceObserverClient creates the trace observer client, forwarding the caller's token
+// and correlation ID.
+// Background jobs have no caller, so they use the service's own IDP token instead.
+func ProvideTraceObserverClient(authProvider occlient.AuthProvider) traceobserversvc.TraceObserverClient {
+	return traceobserversvc.NewTraceObserverClient(func(ctx context.Context) string {
+		if token := jwtassertion.GetJWTFromContext(ctx); token != "" {
+			return token
+		}
+		token, err := authProvider.GetToken(ctx)
+		if err != nil {
+			slog.Warn("Failed to get service token for trace observer", "error", err)
+			return ""
+		}
+		return token
+	}, func(ctx context.Context) string {
+		if id := utils.GetCorrelationId(ctx); id != "-" {
+			return id
+		}
+		return ""
+	})
+}
+
 // ProvideAPIPlatformAuthProvider creates an auth provider for API Platform
 func ProvideAPIPlatformAuthProvider(cfg config.Config) apiplatformclient.AuthProvider {
 	// Only create auth provider if OAuth2 credentials are configured
@@ -134,12 +183,18 @@ func ProvideAPIPlatformConfig(cfg config.Config, authProvider apiplatformclient.
 	return &apiplatformclient.Config{
 		BaseURL:      baseUrl,
 		AuthProvider: authProvider,
+		InMemory:     cfg.APIPlatform.InMemory,
+		CacheTTL:     time.Duration(cfg.APIPlatform.CacheTTLSeconds) * time.Second,
 	}
 }
 
 // ProvideAPIPlatformClient creates a new API Platform client
 // Returns nil if the client cannot be created (will be checked at runtime)
 func ProvideAPIPlatformClient(cfg *apiplatformclient.Config) apiplatformclient.APIPlatformClient {
+	if cfg.InMemory {
+		slog.Warn("Using in-memory API Platform client; gateways are not persisted")
+		return apiplatformclient.NewInMemoryAPIPlatformClient()
+	}
 	if cfg.BaseURL == "" || cfg.AuthProvider == nil {
 		// Return nil if not configured - services should handle nil client gracefully
 		return nil
@@ -7,6 +7,7 @@
 package wiring
 
 import (
+	"context"
 	"log/slog"
 
 	"github.com/google/wire"
@@ -22,6 +23,7 @@ import (
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/controllers"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/middleware/jwtassertion"
 	"github.com/wso2/ai-agent-management-platform/agent-manager-service/services"
+	"github.com/wso2/ai-agent-management-platform/agent-manager-service/utils"
 )
 
 // Injectors from wire.go:
@@ -46,33 +48,73 @@ func InitializeAppParams(cfg *config.Config, db *gorm.DB) (*AppParams, error) {
 	if err != nil {
 		return nil, err
 	}
-	agentManagerService := services.NewAgentManagerService(openChoreoClient, observabilitySvcClient, repositoryService, agentTokenManagerService, logger)
+	mcpServerService := services.NewMCPServerService(logger)
+	resourceEventService := services.NewResourceEventService(logger)
+	changeFreezeService := services.NewChangeFreezeService(logger, resourceEventService)
+	agentManagerService := services.NewAgentManagerService(openChoreoClient, observabilitySvcClient, repositoryService, agentTokenManagerService, mcpServerService, resourceEventService, changeFreezeService, logger)
 	agentController := controllers.NewAgentController(agentManagerService)
 	infraResourceManager := services.NewInfraResourceManager(openChoreoClient, logger)
 	infraResourceController := controllers.NewInfraResourceController(infraResourceManager)
-	traceObserverClient := traceobserversvc.NewTraceObserverClient()
-	observabilityManagerService := services.NewObservabilityManager(traceObserverClient, openChoreoClient, logger)
-	observabilityController := controllers.NewObservabilityController(observabilityManagerService)
-	agentTokenController := controllers.NewAgentTokenController(agentTokenManagerService)
-	repositoryController := controllers.NewRepositoryController(repositoryService)
+	traceObserverClient := ProvideTraceObserverClient(authProvider)
 	clientAuthProvider := ProvideAPIPlatformAuthProvider(configConfig)
 	clientConfig := ProvideAPIPlatformConfig(configConfig, clientAuthProvider)
 	apiPlatformClient := ProvideAPIPlatformClient(clientConfig)
+	observabilityManagerService := services.NewObservabilityManager(traceObserverClient, openChoreoClient, apiPlatformClient, logger)
+	observabilityController := controllers.NewObservabilityController(observabilityManagerService)
+	agentTokenController := controllers.NewAgentTokenController(agentTokenManagerService)
+	repositoryController := controllers.NewRepositoryController(repositoryService)
 	environmentService := services.NewEnvironmentService(logger, apiPlatformClient, openChoreoClient)
 	environmentController := controllers.NewEnvironmentController(environmentService)
-	gatewayController := controllers.NewGatewayController(apiPlatformClient, db)
+	gatewayController := controllers.NewGatewayController(apiPlatformClient, db, resourceEventService, changeFreezeService)
+	applyService := services.NewApplyService(logger, apiPlatformClient)
+	applyController := controllers.NewApplyController(applyService)
+	gatewayBulkOperationService := services.NewGatewayBulkOperationService(logger, apiPlatformClient, resourceEventService, changeFreezeService)
+	gatewayBulkOperationController := controllers.NewGatewayBulkOperationController(gatewayBulkOperationService)
+	searchService := services.NewSearchService(logger, openChoreoClient, apiPlatformClient)
+	searchController := controllers.NewSearchController(searchService)
+	organizationService := services.NewOrganizationService(logger, apiPlatformClient)
+	organizationController := controllers.NewOrganizationController(organizationService)
+	scimService := services.NewScimService(logger)
+	scimController := controllers.NewScimController(scimService)
+	agentTemplateService := services.NewAgentTemplateService(logger)
+	agentTemplateController := controllers.NewAgentTemplateController(agentTemplateService)
+	mcpServerController := controllers.NewMCPServerController(mcpServerService)
+	agentPublicationService := services.NewAgentPublicationService(logger, openChoreoClient, apiPlatformClient)
+	agentPublicationController := controllers.NewAgentPublicationController(agentPublicationService)
+	agentCardService := services.NewAgentCardService(logger, openChoreoClient)
+	agentCardController := controllers.NewAgentCardController(agentCardService)
+	agentInvocationService := services.NewAgentInvocationService(logger, openChoreoClient, agentTokenManagerService)
+	agentInvocationController := controllers.NewAgentInvocationController(agentInvocationService)
+	deploymentApprovalController := controllers.NewDeploymentApprovalController(agentManagerService, resourceEventService)
+	changeFreezeController := controllers.NewChangeFreezeController(changeFreezeService, resourceEventService)
 	appParams := &AppParams{
-		AuthMiddleware:          middleware,
-		Logger:                  logger,
-		AgentController:         agentController,
-		InfraResourceController: infraResourceController,
-		ObservabilityController: observabilityController,
-		AgentTokenController:    agentTokenController,
-		RepositoryController:    repositoryController,
-		EnvironmentController:   environmentController,
-		GatewayController:       gatewayController,
-		APIPlatformClient:       apiPlatformClient,
-		DB:                      db,
+		AuthMiddleware:                 middleware,
+		Logger:                         logger,
+		AgentController:                agentController,
+		InfraResourceController:        infraResourceController,
+		ObservabilityController:        observabilityController,
+		AgentTokenController:           agentTokenController,
+		RepositoryController:           repositoryController,
+		EnvironmentController:          environmentController,
+		GatewayController:              gatewayController,
+		ApplyController:                applyController,
+		GatewayBulkOperationController: gatewayBulkOperationController,
+		SearchController:               searchController,
+		OrganizationController:         organizationController,
+		ScimController:                 scimController,
+		AgentTemplateController:        agentTemplateController,
+		MCPServerController:            mcpServerController,
+		AgentPublicationController:     agentPublicationController,
+		AgentCardController:            agentCardController,
+		AgentInvocationController:      agentInvocationController,
+		DeploymentApprovalController:   deploymentApprovalController,
+		ChangeFreezeController:         changeFreezeController,
+		AgentManagerService:            agentManagerService,
+		OrganizationService:            organizationService,
+		MCPServerService:               mcpServerService,
+		ObservabilityManagerService:    observabilityManagerService,
+		APIPlatformClient:              apiPlatformClient,
+		DB:                             db,
 	}
 	return appParams, nil
 }
@@ -89,31 +131,71 @@ func InitializeTestAppParamsWithClientMocks(cfg *config.Config, db *gorm.DB, aut
 	if err != nil {
 		return nil, err
 	}
-	agentManagerService := services.NewAgentManagerService(openChoreoClient, observabilitySvcClient, repositoryService, agentTokenManagerService, logger)
+	mcpServerService := services.NewMCPServerService(logger)
+	resourceEventService := services.NewResourceEventService(logger)
+	changeFreezeService := services.NewChangeFreezeService(logger, resourceEventService)
+	agentManagerService := services.NewAgentManagerService(openChoreoClient, observabilitySvcClient, repositoryService, agentTokenManagerService, mcpServerService, resourceEventService, changeFreezeService, logger)
 	agentController := controllers.NewAgentController(agentManagerService)
 	infraResourceManager := services.NewInfraResourceManager(openChoreoClient, logger)
 	infraResourceController := controllers.NewInfraResourceController(infraResourceManager)
 	traceObserverClient := ProvideTestTraceObserverClient(testClients)
-	observabilityManagerService := services.NewObservabilityManager(traceObserverClient, openChoreoClient, logger)
+	apiPlatformClient := ProvideTestAPIPlatformClient(testClients)
+	observabilityManagerService := services.NewObservabilityManager(traceObserverClient, openChoreoClient, apiPlatformClient, logger)
 	observabilityController := controllers.NewObservabilityController(observabilityManagerService)
 	agentTokenController := controllers.NewAgentTokenController(agentTokenManagerService)
 	repositoryController := controllers.NewRepositoryController(repositoryService)
-	apiPlatformClient := ProvideTestAPIPlatformClient(testClients)
 	environmentService := services.NewEnvironmentService(logger, apiPlatformClient, openChoreoClient)
 	environmentController := controllers.NewEnvironmentController(environmentService)
-	gatewayController := controllers.NewGatewayController(apiPlatformClient, db)
+	gatewayController := controllers.NewGatewayController(apiPlatformClient, db, resourceEventService, changeFreezeService)
+	applyService := services.NewApplyService(logger, apiPlatformClient)
+	applyController := controllers.NewApplyController(applyService)
+	gatewayBulkOperationService := services.NewGatewayBulkOperationService(logger, apiPlatformClient, resourceEventService, changeFreezeService)
+	gatewayBulkOperationController := controllers.NewGatewayBulkOperationController(gatewayBulkOperationService)
+	searchService := services.NewSearchService(logger, openChoreoClient, apiPlatformClient)
+	searchController := controllers.NewSearchController(searchService)
+	organizationService := services.NewOrganizationService(logger, apiPlatformClient)
+	organizationController := controllers.NewOrganizationController(organizationService)
+	scimService := services.NewScimService(logger)
+	scimController := controllers.NewScimController(scimService)
+	agentTemplateService := services.NewAgentTemplateService(logger)
+	agentTemplateController := controllers.NewAgentTemplateController(agentTemplateService)
+	mcpServerController := controllers.NewMCPServerController(mcpServerService)
+	agentPublicationService := services.NewAgentPublicationService(logger, openChoreoClient, apiPlatformClient)
+	agentPublicationController := controllers.NewAgentPublicationController(agentPublicationService)
+	agentCardService := services.NewAgentCardService(logger, openChoreoClient)
+	agentCardController := controllers.NewAgentCardController(agentCardService)
+	agentInvocationService := services.NewAgentInvocationService(logger, openChoreoClient, agentTokenManagerService)
+	agentInvocationController := controllers.NewAgentInvocationController(agentInvocationService)
+	deploymentApprovalController := controllers.NewDeploymentApprovalController(agentManagerService, resourceEventService)
+	changeFreezeController := controllers.NewChangeFreezeController(changeFreezeService, resourceEventService)
 	appParams := &AppParams{
-		AuthMiddleware:          authMiddleware,
-		Logger:                  logger,
-		AgentController:         agentController,
-		InfraResourceController: infraResourceController,
-		ObservabilityController: observabilityController,
-		AgentTokenController:    agentTokenController,
-		RepositoryController:    repositoryController,
-		EnvironmentController:   environmentController,
-		GatewayController:       gatewayController,
-		APIPlatformClient:       apiPlatformClient,
-		DB:                      db,
+		AuthMiddleware:                 authMiddleware,
+		Logger:                         logger,
+		AgentController:                agentController,
+		InfraResourceController:        infraResourceController,
+		ObservabilityController:        observabilityController,
+		AgentTokenController:           agentTokenController,
+		RepositoryController:           repositoryController,
+		EnvironmentController:          environmentController,
+		GatewayController:              gatewayController,
+		ApplyController:                applyController,
+		GatewayBulkOperationController: gatewayBulkOperationController,
+		SearchController:               searchController,
+		OrganizationController:         organizationController,
+		ScimController:                 scimController,
+		AgentTemplateController:        agentTemplateController,
+		MCPServerController:            mcpServerController,
+		AgentPublicationController:     agentPublicationController,
+		AgentCardController:            agentCardController,
+		AgentInvocationController:      agentInvocationController,
+		DeploymentApprovalController:   deploymentApprovalController,
+		ChangeFreezeController:         changeFreezeController,
+		AgentManagerService:            agentManagerService,
+		OrganizationService:            organizationService,
+		MCPServerService:               mcpServerService,
+		ObservabilityManagerService:    observabilityManagerService,
+		APIPlatformClient:              apiPlatformClient,
+		DB:                             db,
 	}
 	return appParams, nil
 }
@@ -126,16 +208,18 @@ var configProviderSet = wire.NewSet(
 )
 
 var clientProviderSet = wire.NewSet(
-	ProvideObservabilitySvcClient, traceobserversvc.NewTraceObserverClient, ProvideOCAuthProvider,
+	ProvideObservabilitySvcClient,
+	ProvideTraceObserverClient,
+	ProvideOCAuthProvider,
 	ProvideOCClient,
 	ProvideAPIPlatformAuthProvider,
 	ProvideAPIPlatformConfig,
 	ProvideAPIPlatformClient,
 )
 
-var serviceProviderSet = wire.NewSet(services.NewAgentManagerService, services.NewInfraResourceManager, services.NewObservabilityManager, services.NewAgentTokenManagerService, services.NewRepositoryService, services.NewEnvironmentService)
+var serviceProviderSet = wire.NewSet(services.NewAgentManagerService, services.NewInfraResourceManager, services.NewObservabilityManager, services.NewAgentTokenManagerService, services.NewRepositoryService, services.NewEnvironmentService, services.NewApplyService, services.NewGatewayBulkOperationService, services.NewSearchService, services.NewResourceEventService, services.NewChangeFreezeService, services.NewOrganizationService, services.NewScimService, services.NewAgentTemplateService, services.NewMCPServerService, services.NewAgentPublicationService, services.NewAgentCardService, services.NewAgentInvocationService)
 
-var controllerProviderSet = wire.NewSet(controllers.NewAgentController, controllers.NewInfraResourceController, controllers.NewObservabilityController, controllers.NewAgentTokenController, controllers.NewRepositoryController, controllers.NewEnvironmentController, controllers.NewGatewayController)
+var controllerProviderSet = wire.NewSet(controllers.NewAgentController, controllers.NewInfraResourceController, controllers.NewObservabilityController, controllers.NewAgentTokenController, controllers.NewRepositoryController, controllers.NewEnvironmentController, controllers.NewGatewayController, controllers.NewApplyController, controllers.NewGatewayBulkOperationController, controllers.NewSearchController, controllers.NewOrganizationController, controllers.NewScimController, controllers.NewAgentTemplateController, controllers.NewMCPServerController, controllers.NewAgentPublicationController, controllers.NewAgentCardController, controllers.NewAgentInvocationController, controllers.NewDeploymentApprovalController, controllers.NewChangeFreezeController)
 
 var testClientProviderSet = wire.NewSet(
 	ProvideTestOpenChoreoClient,
@@ -178,6 +262,28 @@ var loggerProviderSet = wire.NewSet(
 	ProvideLogger,
 )
 
+// ProvideTraceObserverClient creates the trace observer client, forwarding the caller's token
+// and correlation ID.
+// Background jobs have no caller, so they use the service's own IDP token instead.
+func ProvideTraceObserverClient(authProvider client.AuthProvider) traceobserversvc.TraceObserverClient {
+	return traceobserversvc.NewTraceObserverClient(func(ctx context.Context) string {
+		if token := jwtassertion.GetJWTFromContext(ctx); token != "" {
+			return token
+		}
+		token, err := authProvider.GetToken(ctx)
+		if err != nil {
+			slog.Warn("Failed to get service token for trace observer", "error", err)
+			return ""
+		}
+		return token
+	}, func(ctx context.Context) string {
+		if id := utils.GetCorrelationId(ctx); id != "-" {
+			return id
+		}
+		return ""
+	})
+}
+
 // ProvideAPIPlatformAuthProvider creates an auth provider for API Platform
 func ProvideAPIPlatformAuthProvider(cfg config.Config) client2.AuthProvider {
 
@@ -200,12 +306,17 @@ func ProvideAPIPlatformConfig(cfg config.Config, authProvider client2.AuthProvid
 	return &client2.Config{
 		BaseURL:      baseUrl,
 		AuthProvider: authProvider,
+		InMemory:     cfg.APIPlatform.InMemory,
 	}
 }
 
 // ProvideAPIPlatformClient creates a new API Platform client
 // Returns nil if the client cannot be created (will be checked at runtime)
 func ProvideAPIPlatformClient(cfg *client2.Config) client2.APIPlatformClient {
+	if cfg.InMemory {
+		slog.Warn("Using in-memory API Platform client; gateways are not persisted")
+		return client2.NewInMemoryAPIPlatformClient()
+	}
 	if cfg.BaseURL == "" || cfg.AuthProvider == nil {
 
 		return nil
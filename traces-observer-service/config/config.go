@@ -17,16 +17,50 @@
 package config
 
 import (
+	"errors"
 	"fmt"
-	"os"
-	"strconv"
+	"net"
+	"strings"
 )
 
 // Config holds all configuration for the tracing service
 type Config struct {
 	Server     ServerConfig
 	OpenSearch OpenSearchConfig
-	LogLevel   string
+	Tracing    TracingConfig
+	Auth       AuthConfig
+	Logs       LogsConfig
+	// TraceSummaries configures the rollup of traces into the summary documents of the traces list
+	TraceSummaries TraceSummaryConfig
+	// IssueGrouping configures the grouping of error spans into issues
+	IssueGrouping IssueGroupingConfig
+	// TraceArchive configures the move of old span indices to a snapshot repository
+	TraceArchive TraceArchiveConfig
+	// UsageMetrics configures the usage counters sent to a metrics store as traces are rolled up
+	UsageMetrics UsageMetricsConfig
+	LogLevel     string
+	// BodyLogging logs redacted request and response bodies at DEBUG level for troubleshooting
+	BodyLogging BodyLoggingConfig
+	// ModelPricing holds token prices used to estimate the cost of LLM calls
+	ModelPricing []ModelPrice
+}
+
+// ModelPrice is the token price of a model. Model matches the reported model name exactly or
+// as a prefix, so that "gpt-4o" also prices dated versions such as "gpt-4o-2024-08-06".
+type ModelPrice struct {
+	Model string `json:"model"`
+	// Provider restricts the price to a provider (gen_ai.system); empty matches any provider
+	Provider                   string  `json:"provider,omitempty"`
+	InputCostPerMillionTokens  float64 `json:"inputCostPerMillionTokens"`
+	OutputCostPerMillionTokens float64 `json:"outputCostPerMillionTokens"`
+}
+
+// BodyLoggingConfig holds the settings of request and response body logging
+type BodyLoggingConfig struct {
+	// Enabled turns body logging on; bodies are only logged when LOG_LEVEL is DEBUG as well
+	Enabled bool
+	// MaxBytes caps how much of each body is logged
+	MaxBytes int
 }
 
 // ServerConfig holds HTTP server configuration
@@ -39,23 +73,234 @@ type OpenSearchConfig struct {
 	Address  string
 	Username string
 	Password string
+	// MaxRetries is how many times a request failing with 429, 502, 503 or 504 or a network error is retried
+	MaxRetries int
+	// RetryBackoffMillis is the delay before the first retry, doubled for each further retry
+	RetryBackoffMillis int
+	// RequestTimeoutSeconds bounds how long to wait for the response headers of a request
+	RequestTimeoutSeconds  int
+	MaxIdleConnsPerHost    int
+	IdleConnTimeoutSeconds int
+	// CircuitBreakerThreshold is the number of consecutive 5xx responses or network errors after
+	// which requests fail fast for CircuitBreakerCooldownSeconds; 0 disables the circuit breaker
+	CircuitBreakerThreshold       int
+	CircuitBreakerCooldownSeconds int
+	// SlowQueryThresholdMillis logs requests taking at least this long; 0 disables the logging
+	SlowQueryThresholdMillis int
 }
 
-// Load loads configuration from environment variables with defaults
+// TracingConfig holds OpenTelemetry configuration for tracing this service itself
+type TracingConfig struct {
+	Enabled     bool
+	ServiceName string
+	// OTLPEndpoint is the host:port of an OTLP gRPC collector
+	OTLPEndpoint  string
+	Insecure      bool
+	SamplingRatio float64
+}
+
+// AuthConfig holds JWT validation configuration. It uses the same KEY_MANAGER_* variables as
+// agent-manager-service, so that both services trust the same issuers and audiences.
+type AuthConfig struct {
+	Enabled   bool
+	Header    string
+	Issuers   []string
+	Audiences []string
+	JWKSUrl   string
+	// TrustedIssuers lists additional identity providers whose tokens are accepted, each with its own JWKS
+	TrustedIssuers []TrustedIssuer
+	// ClockSkewSeconds is the leeway applied to exp, nbf and iat checks
+	ClockSkewSeconds int
+	// JWKSCacheTTLSeconds is how long fetched signing keys are used before they are refreshed
+	JWKSCacheTTLSeconds int
+	// JWKSMinRefreshIntervalSeconds limits refreshes triggered by tokens signed with an unknown key
+	JWKSMinRefreshIntervalSeconds int
+	// ProjectAccessEnabled restricts callers to the traces of the projects listed in the ProjectsClaim
+	// of their token
+	ProjectAccessEnabled bool
+	ProjectsClaim        string
+	// ContentVisibility is the most span content API requests are given: full, redacted or
+	// metadata-only. Callers can ask for less with the x-content-visibility header, never for more.
+	ContentVisibility string
+	// ContentScope gives tokens that carry it full span content; empty gives it to no token
+	ContentScope string
+}
+
+// Log backends that application logs can be correlated with
+const (
+	LogsBackendOpenSearch = "opensearch"
+	LogsBackendLoki       = "loki"
+)
+
+// LogsConfig holds the configuration of the backend application logs are read from to correlate
+// them with traces. Log correlation is disabled when Backend is empty.
+type LogsConfig struct {
+	Backend string
+	// OpenSearchIndex is the index pattern of log records in the OpenSearch of the traces
+	OpenSearchIndex string
+	// TraceIDField, SpanIDField, TimestampField and MessageField name the fields of OpenSearch log records
+	TraceIDField   string
+	SpanIDField    string
+	TimestampField string
+	MessageField   string
+	LokiURL        string
+	// LokiSelector is the stream selector of the LogQL query; the trace ID is matched as a line filter
+	LokiSelector string
+	// LokiTenant is sent as X-Scope-OrgID to multi-tenant Loki deployments
+	LokiTenant string
+	// MaxRecords bounds the number of log records returned for a trace
+	MaxRecords int
+	// TimePaddingSeconds widens the time range of a trace when searching its logs, to allow for clock skew
+	TimePaddingSeconds int
+}
+
+// TraceSummaryConfig holds the configuration of the job that rolls traces up into summary documents,
+// which the traces list reads instead of aggregating spans. The job is disabled when Enabled is false.
+type TraceSummaryConfig struct {
+	Enabled bool
+	// IntervalSeconds is how often ended traces are rolled up
+	IntervalSeconds int
+	// SettleSeconds is how long after its spans end a trace is rolled up, to let the spans be indexed
+	SettleSeconds int
+	// BackfillSeconds is how far back traces are rolled up when the service starts
+	BackfillSeconds int
+}
+
+// IssueGroupingConfig holds the configuration of the job that groups error spans into issues by
+// fingerprint. The job is disabled when Enabled is false.
+type IssueGroupingConfig struct {
+	Enabled bool
+	// IntervalSeconds is how often error spans are grouped
+	IntervalSeconds int
+	// SettleSeconds is how long after it ends a span is grouped, to let it be indexed
+	SettleSeconds int
+	// BackfillSeconds is how far back error spans are grouped when the service starts
+	BackfillSeconds int
+}
+
+// TraceArchiveConfig holds the configuration of the job that moves daily span indices to a
+// snapshot repository, where they are searched as searchable snapshots. The job is disabled when
+// Enabled is false.
+type TraceArchiveConfig struct {
+	Enabled bool
+	// Repository is the name of the snapshot repository registered in OpenSearch, such as an S3
+	// bucket registered with the repository-s3 plugin
+	Repository string
+	// AfterDays is how many days after they end the spans of a day are archived
+	AfterDays int
+	// IntervalSeconds is how often indices to archive are looked for and advanced
+	IntervalSeconds int
+}
+
+// UsageMetricsConfig holds the configuration of the token, cost and latency counters sent to a
+// StatsD server by the trace summary rollup. Counters are not sent when StatsDAddress is empty.
+type UsageMetricsConfig struct {
+	// StatsDAddress is the host:port of the StatsD server, such as a Prometheus statsd_exporter
+	StatsDAddress string
+	// Prefix is prepended to the name of each counter
+	Prefix string
+}
+
+// TrustedIssuer is an identity provider whose tokens are accepted
+type TrustedIssuer struct {
+	Issuer  string `json:"issuer"`
+	JWKSUrl string `json:"jwksUrl"`
+	// Audiences overrides KEY_MANAGER_AUDIENCE for tokens from this issuer
+	Audiences []string `json:"audiences,omitempty"`
+}
+
+// Load loads configuration from environment variables with defaults. It fails listing every
+// setting that cannot be parsed, or with the first setting that is invalid.
 func Load() (*Config, error) {
+	r := &envReader{}
 	cfg := &Config{
 		Server: ServerConfig{
-			Port: getEnvAsInt("TRACES_OBSERVER_PORT", 9098),
+			Port: r.getEnvAsInt("TRACES_OBSERVER_PORT", 9098),
 		},
 		OpenSearch: OpenSearchConfig{
-			Address:  getEnv("OPENSEARCH_ADDRESS", "https://localhost:9200"),
-			Username: getEnv("OPENSEARCH_USERNAME", ""),
-			Password: getEnv("OPENSEARCH_PASSWORD", ""),
+			Address:                       r.getEnv("OPENSEARCH_ADDRESS", "https://localhost:9200"),
+			Username:                      r.getEnv("OPENSEARCH_USERNAME", ""),
+			Password:                      r.getEnv("OPENSEARCH_PASSWORD", ""),
+			MaxRetries:                    r.getEnvAsInt("OPENSEARCH_MAX_RETRIES", 3),
+			RetryBackoffMillis:            r.getEnvAsInt("OPENSEARCH_RETRY_BACKOFF_MS", 200),
+			RequestTimeoutSeconds:         r.getEnvAsInt("OPENSEARCH_REQUEST_TIMEOUT_SECONDS", 30),
+			MaxIdleConnsPerHost:           r.getEnvAsInt("OPENSEARCH_MAX_IDLE_CONNS_PER_HOST", 10),
+			IdleConnTimeoutSeconds:        r.getEnvAsInt("OPENSEARCH_IDLE_CONN_TIMEOUT_SECONDS", 90),
+			CircuitBreakerThreshold:       r.getEnvAsInt("OPENSEARCH_CIRCUIT_BREAKER_THRESHOLD", 5),
+			CircuitBreakerCooldownSeconds: r.getEnvAsInt("OPENSEARCH_CIRCUIT_BREAKER_COOLDOWN_SECONDS", 30),
+			SlowQueryThresholdMillis:      r.getEnvAsInt("OPENSEARCH_SLOW_QUERY_THRESHOLD_MS", 1000),
+		},
+		Tracing: TracingConfig{
+			Enabled:       r.getEnvAsBool("TRACING_ENABLED", false),
+			ServiceName:   r.getEnv("TRACING_SERVICE_NAME", "traces-observer-service"),
+			OTLPEndpoint:  r.getEnv("TRACING_OTLP_ENDPOINT", "localhost:4317"),
+			Insecure:      r.getEnvAsBool("TRACING_OTLP_INSECURE", true),
+			SamplingRatio: r.getEnvAsFloat("TRACING_SAMPLING_RATIO", 1.0),
+		},
+		Auth: AuthConfig{
+			Enabled:                       r.getEnvAsBool("AUTH_ENABLED", false),
+			Header:                        r.getEnv("AUTH_HEADER", "Authorization"),
+			Issuers:                       r.getEnvAsList("KEY_MANAGER_ISSUER", nil),
+			Audiences:                     r.getEnvAsList("KEY_MANAGER_AUDIENCE", nil),
+			JWKSUrl:                       r.getEnv("KEY_MANAGER_JWKS_URL", ""),
+			ClockSkewSeconds:              r.getEnvAsInt("KEY_MANAGER_CLOCK_SKEW_SECONDS", 60),
+			JWKSCacheTTLSeconds:           r.getEnvAsInt("KEY_MANAGER_JWKS_CACHE_TTL_SECONDS", 3600),
+			JWKSMinRefreshIntervalSeconds: r.getEnvAsInt("KEY_MANAGER_JWKS_MIN_REFRESH_SECONDS", 30),
+			ProjectAccessEnabled:          r.getEnvAsBool("TRACE_ACCESS_CONTROL_ENABLED", false),
+			ProjectsClaim:                 r.getEnv("AUTH_PROJECTS_CLAIM", "projects"),
+			ContentVisibility:             r.getEnv("TRACE_CONTENT_VISIBILITY", "redacted"),
+			ContentScope:                  r.getEnv("AUTH_TRACE_CONTENT_SCOPE", ""),
+		},
+		Logs: LogsConfig{
+			Backend:            r.getEnv("LOGS_BACKEND", ""),
+			OpenSearchIndex:    r.getEnv("LOGS_OPENSEARCH_INDEX", "container-logs-*"),
+			TraceIDField:       r.getEnv("LOGS_TRACE_ID_FIELD", "traceId"),
+			SpanIDField:        r.getEnv("LOGS_SPAN_ID_FIELD", "spanId"),
+			TimestampField:     r.getEnv("LOGS_TIMESTAMP_FIELD", "@timestamp"),
+			MessageField:       r.getEnv("LOGS_MESSAGE_FIELD", "log"),
+			LokiURL:            r.getEnv("LOGS_LOKI_URL", ""),
+			LokiSelector:       r.getEnv("LOGS_LOKI_SELECTOR", `{namespace=~".+"}`),
+			LokiTenant:         r.getEnv("LOGS_LOKI_TENANT", ""),
+			MaxRecords:         r.getEnvAsInt("LOGS_MAX_RECORDS", 1000),
+			TimePaddingSeconds: r.getEnvAsInt("LOGS_TIME_PADDING_SECONDS", 60),
+		},
+		TraceSummaries: TraceSummaryConfig{
+			Enabled:         r.getEnvAsBool("TRACE_SUMMARY_ENABLED", false),
+			IntervalSeconds: r.getEnvAsInt("TRACE_SUMMARY_INTERVAL_SECONDS", 30),
+			SettleSeconds:   r.getEnvAsInt("TRACE_SUMMARY_SETTLE_SECONDS", 30),
+			BackfillSeconds: r.getEnvAsInt("TRACE_SUMMARY_BACKFILL_SECONDS", 86400),
+		},
+		IssueGrouping: IssueGroupingConfig{
+			Enabled:         r.getEnvAsBool("ISSUE_GROUPING_ENABLED", false),
+			IntervalSeconds: r.getEnvAsInt("ISSUE_GROUPING_INTERVAL_SECONDS", 60),
+			SettleSeconds:   r.getEnvAsInt("ISSUE_GROUPING_SETTLE_SECONDS", 30),
+			BackfillSeconds: r.getEnvAsInt("ISSUE_GROUPING_BACKFILL_SECONDS", 86400),
+		},
+		TraceArchive: TraceArchiveConfig{
+			Enabled:         r.getEnvAsBool("TRACE_ARCHIVE_ENABLED", false),
+			Repository:      r.getEnv("TRACE_ARCHIVE_REPOSITORY", ""),
+			AfterDays:       r.getEnvAsInt("TRACE_ARCHIVE_AFTER_DAYS", 30),
+			IntervalSeconds: r.getEnvAsInt("TRACE_ARCHIVE_INTERVAL_SECONDS", 600),
+		},
+		UsageMetrics: UsageMetricsConfig{
+			StatsDAddress: r.getEnv("USAGE_METRICS_STATSD_ADDRESS", ""),
+			Prefix:        r.getEnv("USAGE_METRICS_PREFIX", "amp"),
+		},
+		LogLevel: strings.ToUpper(r.getEnv("LOG_LEVEL", "INFO")),
+		BodyLogging: BodyLoggingConfig{
+			Enabled:  r.getEnvAsBool("LOG_HTTP_BODIES", false),
+			MaxBytes: r.getEnvAsInt("LOG_HTTP_BODY_MAX_BYTES", 4096),
 		},
-		LogLevel: getEnv("LOG_LEVEL", "INFO"),
 	}
 
-	// Validate
+	r.getEnvAsJSON("KEY_MANAGER_TRUSTED_ISSUERS", &cfg.Auth.TrustedIssuers)
+	r.getEnvAsJSON("MODEL_PRICING", &cfg.ModelPricing)
+	schema = r.settings
+
+	// Settings that could not be parsed are reported together, before the first invalid one
+	if err := errors.Join(r.errors...); err != nil {
+		return nil, err
+	}
 	if err := cfg.validate(); err != nil {
 		return nil, err
 	}
@@ -64,6 +309,11 @@ func Load() (*Config, error) {
 }
 
 func (c *Config) validate() error {
+	switch c.LogLevel {
+	case "DEBUG", "INFO", "WARN", "ERROR":
+	default:
+		return fmt.Errorf("LOG_LEVEL must be one of DEBUG, INFO, WARN or ERROR, got %q", c.LogLevel)
+	}
 	if c.OpenSearch.Username == "" || c.OpenSearch.Password == "" {
 		return fmt.Errorf("opensearch username and password are required")
 	}
@@ -73,22 +323,100 @@ func (c *Config) validate() error {
 	if c.OpenSearch.Address == "" {
 		return fmt.Errorf("opensearch address is required")
 	}
-	return nil
-}
-
-// Helper functions
-func getEnv(key, defaultValue string) string {
-	if value := os.Getenv(key); value != "" {
-		return value
+	if c.OpenSearch.MaxRetries < 0 || c.OpenSearch.RetryBackoffMillis < 0 {
+		return fmt.Errorf("invalid opensearch retry settings: maxRetries=%d, backoff=%dms", c.OpenSearch.MaxRetries, c.OpenSearch.RetryBackoffMillis)
 	}
-	return defaultValue
-}
-
-func getEnvAsInt(key string, defaultValue int) int {
-	if value := os.Getenv(key); value != "" {
-		if intVal, err := strconv.Atoi(value); err == nil {
-			return intVal
+	if c.OpenSearch.RequestTimeoutSeconds <= 0 {
+		return fmt.Errorf("invalid opensearch request timeout: %d", c.OpenSearch.RequestTimeoutSeconds)
+	}
+	if c.OpenSearch.MaxIdleConnsPerHost <= 0 || c.OpenSearch.IdleConnTimeoutSeconds <= 0 {
+		return fmt.Errorf("invalid opensearch connection pool settings: maxIdleConnsPerHost=%d, idleConnTimeout=%ds", c.OpenSearch.MaxIdleConnsPerHost, c.OpenSearch.IdleConnTimeoutSeconds)
+	}
+	if c.OpenSearch.CircuitBreakerThreshold < 0 || (c.OpenSearch.CircuitBreakerThreshold > 0 && c.OpenSearch.CircuitBreakerCooldownSeconds <= 0) {
+		return fmt.Errorf("invalid opensearch circuit breaker settings: threshold=%d, cooldown=%ds", c.OpenSearch.CircuitBreakerThreshold, c.OpenSearch.CircuitBreakerCooldownSeconds)
+	}
+	if c.Tracing.SamplingRatio < 0 || c.Tracing.SamplingRatio > 1 {
+		return fmt.Errorf("invalid tracing sampling ratio: %v", c.Tracing.SamplingRatio)
+	}
+	if c.Auth.Enabled {
+		if c.Auth.JWKSUrl == "" && len(c.Auth.TrustedIssuers) == 0 {
+			return fmt.Errorf("KEY_MANAGER_JWKS_URL or KEY_MANAGER_TRUSTED_ISSUERS is required when auth is enabled")
+		}
+		if c.Auth.JWKSUrl != "" && len(c.Auth.Issuers) == 0 {
+			return fmt.Errorf("KEY_MANAGER_ISSUER is required when KEY_MANAGER_JWKS_URL is set")
+		}
+		for i, issuer := range c.Auth.TrustedIssuers {
+			if issuer.Issuer == "" || issuer.JWKSUrl == "" {
+				return fmt.Errorf("KEY_MANAGER_TRUSTED_ISSUERS[%d] requires both issuer and jwksUrl", i)
+			}
+		}
+		if c.Auth.ClockSkewSeconds < 0 {
+			return fmt.Errorf("invalid clock skew: %d", c.Auth.ClockSkewSeconds)
 		}
+		if c.Auth.JWKSCacheTTLSeconds <= 0 {
+			return fmt.Errorf("invalid JWKS cache TTL: %d", c.Auth.JWKSCacheTTLSeconds)
+		}
+		if c.Auth.ProjectAccessEnabled && c.Auth.ProjectsClaim == "" {
+			return fmt.Errorf("AUTH_PROJECTS_CLAIM is required when trace access control is enabled")
+		}
+	} else if c.Auth.ProjectAccessEnabled {
+		return fmt.Errorf("TRACE_ACCESS_CONTROL_ENABLED requires AUTH_ENABLED")
+	} else if c.Auth.ContentScope != "" {
+		return fmt.Errorf("AUTH_TRACE_CONTENT_SCOPE requires AUTH_ENABLED")
 	}
-	return defaultValue
+	switch c.Auth.ContentVisibility {
+	case "full", "redacted", "metadata-only":
+	default:
+		return fmt.Errorf("invalid trace content visibility: %s", c.Auth.ContentVisibility)
+	}
+	switch c.Logs.Backend {
+	case "":
+	case LogsBackendOpenSearch:
+		if c.Logs.OpenSearchIndex == "" || c.Logs.TraceIDField == "" || c.Logs.TimestampField == "" {
+			return fmt.Errorf("LOGS_OPENSEARCH_INDEX, LOGS_TRACE_ID_FIELD and LOGS_TIMESTAMP_FIELD are required for the opensearch logs backend")
+		}
+	case LogsBackendLoki:
+		if c.Logs.LokiURL == "" || c.Logs.LokiSelector == "" {
+			return fmt.Errorf("LOGS_LOKI_URL and LOGS_LOKI_SELECTOR are required for the loki logs backend")
+		}
+	default:
+		return fmt.Errorf("invalid logs backend: %s", c.Logs.Backend)
+	}
+	if c.Logs.MaxRecords <= 0 || c.Logs.TimePaddingSeconds < 0 {
+		return fmt.Errorf("invalid logs settings: maxRecords=%d, timePadding=%ds", c.Logs.MaxRecords, c.Logs.TimePaddingSeconds)
+	}
+	if c.TraceSummaries.Enabled && (c.TraceSummaries.IntervalSeconds <= 0 || c.TraceSummaries.SettleSeconds < 0 || c.TraceSummaries.BackfillSeconds < 0) {
+		return fmt.Errorf("invalid trace summary settings: interval=%ds, settle=%ds, backfill=%ds", c.TraceSummaries.IntervalSeconds, c.TraceSummaries.SettleSeconds, c.TraceSummaries.BackfillSeconds)
+	}
+	if c.IssueGrouping.Enabled && (c.IssueGrouping.IntervalSeconds <= 0 || c.IssueGrouping.SettleSeconds < 0 || c.IssueGrouping.BackfillSeconds < 0) {
+		return fmt.Errorf("invalid issue grouping settings: interval=%ds, settle=%ds, backfill=%ds", c.IssueGrouping.IntervalSeconds, c.IssueGrouping.SettleSeconds, c.IssueGrouping.BackfillSeconds)
+	}
+	if c.TraceArchive.Enabled {
+		if c.TraceArchive.Repository == "" {
+			return fmt.Errorf("TRACE_ARCHIVE_REPOSITORY is required when the trace archive is enabled")
+		}
+		if c.TraceArchive.AfterDays < 1 || c.TraceArchive.IntervalSeconds <= 0 {
+			return fmt.Errorf("invalid trace archive settings: afterDays=%d, interval=%ds", c.TraceArchive.AfterDays, c.TraceArchive.IntervalSeconds)
+		}
+	}
+	if c.UsageMetrics.StatsDAddress != "" {
+		if !c.TraceSummaries.Enabled {
+			return fmt.Errorf("USAGE_METRICS_STATSD_ADDRESS requires TRACE_SUMMARY_ENABLED, as usage metrics are sent by the trace summary rollup")
+		}
+		if _, _, err := net.SplitHostPort(c.UsageMetrics.StatsDAddress); err != nil {
+			return fmt.Errorf("invalid USAGE_METRICS_STATSD_ADDRESS: %w", err)
+		}
+	}
+	if c.BodyLogging.Enabled && c.BodyLogging.MaxBytes <= 0 {
+		return fmt.Errorf("invalid body logging max bytes: %d", c.BodyLogging.MaxBytes)
+	}
+	for i, price := range c.ModelPricing {
+		if price.Model == "" {
+			return fmt.Errorf("MODEL_PRICING[%d] requires a model", i)
+		}
+		if price.InputCostPerMillionTokens < 0 || price.OutputCostPerMillionTokens < 0 {
+			return fmt.Errorf("MODEL_PRICING[%d] has a negative price", i)
+		}
+	}
+	return nil
 }
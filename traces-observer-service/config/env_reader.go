@@ -0,0 +1,118 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// envReader reads settings from environment variables, collecting the values that cannot be
+// parsed and describing each variable read for the settings schema
+type envReader struct {
+	errors   []error
+	settings []Setting
+}
+
+func (r *envReader) describe(key, valueType, defaultValue string) {
+	r.settings = append(r.settings, Setting{
+		Name:       key,
+		Type:       valueType,
+		Default:    defaultValue,
+		Reloadable: isReloadable(key),
+	})
+}
+
+func (r *envReader) getEnv(key, defaultValue string) string {
+	r.describe(key, settingTypeString, defaultValue)
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+func (r *envReader) getEnvAsInt(key string, defaultValue int) int {
+	r.describe(key, settingTypeInteger, strconv.Itoa(defaultValue))
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	intVal, err := strconv.Atoi(value)
+	if err != nil {
+		r.errors = append(r.errors, fmt.Errorf("%s must be an integer, got %q", key, value))
+		return defaultValue
+	}
+	return intVal
+}
+
+func (r *envReader) getEnvAsBool(key string, defaultValue bool) bool {
+	r.describe(key, settingTypeBoolean, strconv.FormatBool(defaultValue))
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	boolVal, err := strconv.ParseBool(value)
+	if err != nil {
+		r.errors = append(r.errors, fmt.Errorf("%s must be true or false, got %q", key, value))
+		return defaultValue
+	}
+	return boolVal
+}
+
+func (r *envReader) getEnvAsFloat(key string, defaultValue float64) float64 {
+	r.describe(key, settingTypeNumber, strconv.FormatFloat(defaultValue, 'f', -1, 64))
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	floatVal, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		r.errors = append(r.errors, fmt.Errorf("%s must be a number, got %q", key, value))
+		return defaultValue
+	}
+	return floatVal
+}
+
+func (r *envReader) getEnvAsList(key string, defaultValue []string) []string {
+	r.describe(key, settingTypeStringList, strings.Join(defaultValue, ","))
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// getEnvAsJSON decodes a JSON encoded setting into target, leaving it unchanged when the variable is not set
+func (r *envReader) getEnvAsJSON(key string, target any) {
+	r.describe(key, settingTypeJSON, "")
+	value := os.Getenv(key)
+	if value == "" {
+		return
+	}
+	if err := json.Unmarshal([]byte(value), target); err != nil {
+		r.errors = append(r.errors, fmt.Errorf("invalid %s: %w", key, err))
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package config
+
+import "sync/atomic"
+
+// ModelPriceTable holds the model prices costs are estimated with, which a configuration reload
+// replaces while requests read them
+type ModelPriceTable struct {
+	prices atomic.Pointer[[]ModelPrice]
+}
+
+// NewModelPriceTable returns a table holding the given prices
+func NewModelPriceTable(prices []ModelPrice) *ModelPriceTable {
+	t := &ModelPriceTable{}
+	t.Set(prices)
+	return t
+}
+
+// Prices returns the current prices, which callers must not modify
+func (t *ModelPriceTable) Prices() []ModelPrice {
+	return *t.prices.Load()
+}
+
+// Set replaces the prices
+func (t *ModelPriceTable) Set(prices []ModelPrice) {
+	t.prices.Store(&prices)
+}
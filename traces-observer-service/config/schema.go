@@ -0,0 +1,95 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package config
+
+import "reflect"
+
+// Types of the values of settings
+const (
+	settingTypeString     = "string"
+	settingTypeStringList = "string list"
+	settingTypeInteger    = "integer"
+	settingTypeNumber     = "number"
+	settingTypeBoolean    = "boolean"
+	settingTypeJSON       = "json"
+)
+
+// Setting describes an environment variable the service is configured with
+type Setting struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Default string `json:"default,omitempty"`
+	// Reloadable settings are applied on SIGHUP without restarting the service
+	Reloadable bool `json:"reloadable,omitempty"`
+}
+
+// schema describes the settings read by the last Load
+var schema []Setting
+
+// Schema returns the settings of the service in the order they are read
+func Schema() []Setting {
+	return schema
+}
+
+// reloadableSettings are the settings ApplyReloadable copies, each reporting whether it changed.
+// Settings that are only read when the service starts, such as the OpenSearch connection, are not
+// listed.
+var reloadableSettings = []struct {
+	name  string
+	apply func(dst, src *Config) bool
+}{
+	{"LOG_LEVEL", func(dst, src *Config) bool { return update(&dst.LogLevel, src.LogLevel) }},
+	{"LOG_HTTP_BODIES", func(dst, src *Config) bool { return update(&dst.BodyLogging.Enabled, src.BodyLogging.Enabled) }},
+	{"LOG_HTTP_BODY_MAX_BYTES", func(dst, src *Config) bool { return update(&dst.BodyLogging.MaxBytes, src.BodyLogging.MaxBytes) }},
+	{"MODEL_PRICING", func(dst, src *Config) bool {
+		if reflect.DeepEqual(dst.ModelPricing, src.ModelPricing) {
+			return false
+		}
+		dst.ModelPricing = src.ModelPricing
+		return true
+	}},
+}
+
+// ApplyReloadable copies the settings that can change while the service runs from src to dst and
+// returns the names of the ones that changed
+func ApplyReloadable(dst, src *Config) []string {
+	var changed []string
+	for _, setting := range reloadableSettings {
+		if setting.apply(dst, src) {
+			changed = append(changed, setting.name)
+		}
+	}
+	return changed
+}
+
+func isReloadable(key string) bool {
+	for _, setting := range reloadableSettings {
+		if setting.name == key {
+			return true
+		}
+	}
+	return false
+}
+
+// update sets dst to value and reports whether that changed it
+func update[T comparable](dst *T, value T) bool {
+	if *dst == value {
+		return false
+	}
+	*dst = value
+	return true
+}
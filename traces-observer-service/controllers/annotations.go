@@ -0,0 +1,147 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/opensearch"
+)
+
+// ErrAnnotationNotFound is returned when an annotation does not exist on a trace
+var ErrAnnotationNotFound = errors.New("annotation not found")
+
+// ErrNotAnnotationAuthor is returned when an annotation is changed by someone other than its author
+var ErrNotAnnotationAuthor = errors.New("annotation was written by another author")
+
+// CreateAnnotation attaches an annotation to a trace, or to a span of it. The trace must exist and be
+// readable by the caller; the annotation takes its component and project from the annotated span.
+func (s *TracingController) CreateAnnotation(ctx context.Context, params opensearch.AnnotationParams) (*opensearch.Annotation, error) {
+	log := logger.GetLogger(ctx)
+
+	response, err := s.osClient.Search(ctx, []string{opensearch.TracesIndexPattern},
+		opensearch.BuildAnnotationTargetQuery(params.TraceID, params.SpanID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to search annotated span: %w", err)
+	}
+	spans := opensearch.ParseSpans(ctx, response)
+	if len(spans) == 0 {
+		return nil, ErrTraceNotFound
+	}
+
+	if err := s.ensureAnnotationIndexTemplate(ctx); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339Nano)
+	annotation := &opensearch.Annotation{
+		ID:        uuid.NewString(),
+		TraceID:   params.TraceID,
+		SpanID:    params.SpanID,
+		Text:      params.Text,
+		Author:    params.Author,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	annotation.ComponentUid, _ = opensearch.GetString(spans[0].Resource, "openchoreo.dev/component-uid")
+	annotation.ProjectUid, _ = opensearch.GetString(spans[0].Resource, "openchoreo.dev/project-uid")
+
+	if err := s.osClient.IndexDocument(ctx, opensearch.TraceAnnotationIndex, annotation.ID, annotation); err != nil {
+		return nil, fmt.Errorf("failed to write annotation: %w", err)
+	}
+	log.Info("Created annotation", "traceId", annotation.TraceID, "spanId", annotation.SpanID, "annotationId", annotation.ID)
+	return annotation, nil
+}
+
+// ListAnnotations returns the annotations of a trace, oldest first
+func (s *TracingController) ListAnnotations(ctx context.Context, traceID string) ([]opensearch.Annotation, error) {
+	response, err := s.osClient.Search(ctx, []string{opensearch.TraceAnnotationIndex}, opensearch.BuildTraceAnnotationsQuery(traceID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to search annotations: %w", err)
+	}
+	return opensearch.ParseAnnotations(response)
+}
+
+// UpdateAnnotation replaces the text of an annotation. When author is set, only the author of the
+// annotation may change it.
+func (s *TracingController) UpdateAnnotation(ctx context.Context, traceID, annotationID, text, author string) (*opensearch.Annotation, error) {
+	annotation, err := s.getAnnotation(ctx, traceID, annotationID, author)
+	if err != nil {
+		return nil, err
+	}
+	annotation.Text = text
+	annotation.UpdatedAt = time.Now().UTC().Format(time.RFC3339Nano)
+	if err := s.osClient.IndexDocument(ctx, opensearch.TraceAnnotationIndex, annotation.ID, annotation); err != nil {
+		return nil, fmt.Errorf("failed to write annotation: %w", err)
+	}
+	return annotation, nil
+}
+
+// DeleteAnnotation deletes an annotation. When author is set, only the author of the annotation may
+// delete it.
+func (s *TracingController) DeleteAnnotation(ctx context.Context, traceID, annotationID, author string) error {
+	if _, err := s.getAnnotation(ctx, traceID, annotationID, author); err != nil {
+		return err
+	}
+	err := s.osClient.DeleteDocument(ctx, opensearch.TraceAnnotationIndex, annotationID)
+	if errors.Is(err, opensearch.ErrDocumentNotFound) {
+		return ErrAnnotationNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete annotation: %w", err)
+	}
+	return nil
+}
+
+// getAnnotation reads an annotation of a trace through a search, so that callers restricted to
+// projects cannot reach the annotations of other projects
+func (s *TracingController) getAnnotation(ctx context.Context, traceID, annotationID, author string) (*opensearch.Annotation, error) {
+	response, err := s.osClient.Search(ctx, []string{opensearch.TraceAnnotationIndex}, opensearch.BuildAnnotationQuery(traceID, annotationID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to search annotation: %w", err)
+	}
+	annotations, err := opensearch.ParseAnnotations(response)
+	if err != nil {
+		return nil, err
+	}
+	if len(annotations) == 0 {
+		return nil, ErrAnnotationNotFound
+	}
+	if author != "" && annotations[0].Author != author {
+		return nil, ErrNotAnnotationAuthor
+	}
+	return &annotations[0], nil
+}
+
+// ensureAnnotationIndexTemplate creates the index template of the annotation index before the first
+// annotation is written, and again on later writes until it succeeds
+func (s *TracingController) ensureAnnotationIndexTemplate(ctx context.Context) error {
+	if s.annotationTemplateReady.Load() {
+		return nil
+	}
+	if err := s.osClient.PutIndexTemplate(ctx, opensearch.TraceAnnotationIndex, opensearch.TraceAnnotationIndexTemplateSource()); err != nil {
+		return fmt.Errorf("failed to create the annotation index template: %w", err)
+	}
+	s.annotationTemplateReady.Store(true)
+	return nil
+}
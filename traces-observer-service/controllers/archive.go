@@ -0,0 +1,76 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/opensearch"
+)
+
+// RunTraceArchive moves the daily span indices older than AfterDays to the snapshot repository
+// until ctx is done. Each run advances every index to archive by one stage, so an index is
+// archived over a few runs and the job resumes after a restart.
+func (s *TracingController) RunTraceArchive(ctx context.Context) {
+	log := logger.GetLogger(ctx)
+	interval := time.Duration(s.traceArchive.IntervalSeconds) * time.Second
+
+	log.Info("Starting trace archive", "interval", interval, "repository", s.traceArchive.Repository, "afterDays", s.traceArchive.AfterDays)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		s.archiveSpanIndices(ctx)
+
+		select {
+		case <-ctx.Done():
+			log.Info("Stopped trace archive")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// archiveSpanIndices advances the archiving of each span index that is old enough. A failure is
+// logged and the index is tried again on the next run.
+func (s *TracingController) archiveSpanIndices(ctx context.Context) {
+	log := logger.GetLogger(ctx)
+	sizes, err := s.osClient.IndexSizes(ctx, opensearch.TracesIndexPattern)
+	if err != nil {
+		log.Error("Failed to list span indices to archive", "error", err)
+		return
+	}
+	names := make([]string, len(sizes))
+	for i, size := range sizes {
+		names[i] = size.Index
+	}
+
+	for _, index := range opensearch.SpanIndicesToArchive(names, time.Now().UTC(), s.traceArchive.AfterDays) {
+		stage, err := s.osClient.ArchiveSpanIndex(ctx, s.traceArchive.Repository, index)
+		if err != nil {
+			log.Error("Failed to archive span index", "index", index, "error", err)
+			continue
+		}
+		if stage == opensearch.ArchiveStageArchived {
+			log.Info("Archived span index", "index", index, "repository", s.traceArchive.Repository)
+		} else {
+			log.Debug("Archiving span index", "index", index, "stage", stage)
+		}
+	}
+}
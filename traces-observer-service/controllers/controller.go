@@ -21,8 +21,13 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/config"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/logs"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/metrics"
 	"github.com/wso2/ai-agent-management-platform/traces-observer-service/middleware/logger"
 	"github.com/wso2/ai-agent-management-platform/traces-observer-service/opensearch"
 )
@@ -30,24 +35,69 @@ import (
 // ErrTraceNotFound is returned when a trace is not found
 var ErrTraceNotFound = errors.New("trace not found")
 
+// ErrSessionNotFound is returned when no traces carry a session ID
+var ErrSessionNotFound = errors.New("session not found")
+
+// ErrLogsNotConfigured is returned when logs are requested but no log backend is configured
+var ErrLogsNotConfigured = errors.New("log correlation is not configured")
+
 const (
 	// MaxSpansPerRequest is the maximum number of spans that can be fetched in a single query
 	MaxSpansPerRequest = 10000
+	// MaxSpansPerTrace is the maximum number of spans of a single trace that are returned. Traces
+	// are read page by page, so this is not bound by MaxSpansPerRequest.
+	MaxSpansPerTrace = 100000
 	// MaxTracesPerRequest is the maximum number of traces that can be requested at once
 	MaxTracesPerRequest = 1000
 	// DefaultTracesLimit is the default number of traces to return when no limit is specified
 	DefaultTracesLimit = 10
+	// traceOverviewBatchSize is the number of traces whose spans are fetched in one query when
+	// listing traces
+	traceOverviewBatchSize = 10
+	// traceOverviewWorkers bounds the span queries of a trace listing that run at the same time
+	traceOverviewWorkers = 4
+	// maxJaegerServices is the maximum number of services listed to Jaeger clients
+	maxJaegerServices = 1000
+	// DefaultAttributeExamples is the number of example values returned per attribute by default
+	DefaultAttributeExamples = 5
+	// MaxAttributeExamples is the maximum number of example values returned per attribute
+	MaxAttributeExamples = 50
+	// maxAttributeKeys is the maximum number of attribute keys aggregated in a single query
+	maxAttributeKeys = 300
+	// jaegerSpansPerTrace is the number of matching spans fetched per requested trace when
+	// searching for Jaeger traces
+	jaegerSpansPerTrace = 100
 )
 
 // TracingController provides tracing functionality
 type TracingController struct {
-	osClient *opensearch.Client
+	osClient     *opensearch.Client
+	modelPricing *config.ModelPriceTable
+	logSource    logs.Source // nil when log correlation is disabled
+	logsConfig   config.LogsConfig
+	// traceSummaries configures the summary documents the traces list is read from when enabled
+	traceSummaries config.TraceSummaryConfig
+	// issueGrouping configures the job that groups error spans into issues
+	issueGrouping config.IssueGroupingConfig
+	// traceArchive configures the job that moves old span indices to a snapshot repository
+	traceArchive config.TraceArchiveConfig
+	// usageMetrics receives the usage counters of rolled up traces; nil when they are not sent
+	usageMetrics *metrics.StatsD
+	// annotationTemplateReady is set once the index template of the annotation index is in place
+	annotationTemplateReady atomic.Bool
 }
 
 // NewTracingController creates a new tracing service
-func NewTracingController(osClient *opensearch.Client) *TracingController {
+func NewTracingController(osClient *opensearch.Client, modelPricing *config.ModelPriceTable, logsConfig config.LogsConfig, traceSummaries config.TraceSummaryConfig, issueGrouping config.IssueGroupingConfig, traceArchive config.TraceArchiveConfig, usageMetrics *metrics.StatsD) *TracingController {
 	return &TracingController{
-		osClient: osClient,
+		osClient:       osClient,
+		modelPricing:   modelPricing,
+		logSource:      logs.NewSource(logsConfig, osClient),
+		logsConfig:     logsConfig,
+		traceSummaries: traceSummaries,
+		issueGrouping:  issueGrouping,
+		traceArchive:   traceArchive,
+		usageMetrics:   usageMetrics,
 	}
 }
 
@@ -81,7 +131,7 @@ func (s *TracingController) retrieveAndGroupTraces(ctx context.Context, params o
 
 	// Build query
 	query := opensearch.BuildTraceQuery(params)
-	log.Info("Built query", "query", query)
+	log.Debug("Built query", "query", query.Source())
 
 	// Generate indices based on time range
 	indices, err := opensearch.GetIndicesForTimeRange(params.StartTime, params.EndTime)
@@ -106,7 +156,7 @@ func (s *TracingController) retrieveAndGroupTraces(ctx context.Context, params o
 	}
 
 	// Parse all spans
-	spans := opensearch.ParseSpans(response)
+	spans := opensearch.ParseSpans(ctx, response)
 	log.Debug("Parsed spans from OpenSearch", "spanCount", len(spans))
 
 	if len(spans) == 0 {
@@ -129,74 +179,17 @@ func (s *TracingController) retrieveAndGroupTraces(ctx context.Context, params o
 	allTraces := []map[string]interface{}{}
 	skippedTraces := 0
 	for traceID, traceSpans := range traceMap {
-		// Find root span (span with no parentSpanId)
-		var rootSpan *opensearch.Span
-		for i := range traceSpans {
-			if traceSpans[i].ParentSpanID == "" {
-				rootSpan = &traceSpans[i]
-				break
-			}
-		}
-
+		traceData := buildTraceData(traceID, traceSpans)
 		// Skip this trace if no root span found
-		if rootSpan == nil {
+		if traceData == nil {
 			log.Warn("No root span found for trace, skipping",
 				"traceId", traceID,
 				"spanCount", len(traceSpans))
 			skippedTraces++
 			continue
 		}
-
-		// Extract token usage from GenAI spans
-		tokenUsage := opensearch.ExtractTokenUsage(traceSpans)
-
-		// Extract trace status and error information
-		traceStatus := opensearch.ExtractTraceStatus(traceSpans)
-
-		// Extract input and output from root span
-		var input, output interface{}
-		if opensearch.IsCrewAISpan(rootSpan.Attributes) {
-			input, output = opensearch.ExtractCrewAIRootSpanInputOutput(rootSpan)
-		} else {
-			input, output = opensearch.ExtractRootSpanInputOutput(rootSpan)
-		}
-
-		// Extract task.id and trial.id from OpenTelemetry baggage attributes
-		// These are propagated from the experiment framework for trace-to-task matching
-		// Using dotted notation following OpenTelemetry semantic conventions
-		var taskId, trialId string
-
-		if taskIdVal, ok := rootSpan.Attributes["task.id"]; ok {
-			if taskIdStr, ok := taskIdVal.(string); ok {
-				taskId = taskIdStr
-			}
-		}
-		if trialIdVal, ok := rootSpan.Attributes["trial.id"]; ok {
-			if trialIdStr, ok := trialIdVal.(string); ok {
-				trialId = trialIdStr
-			}
-		}
-
-		// Store trace data as a map with all necessary fields
-		traceData := map[string]interface{}{
-			"traceID":         traceID,
-			"rootSpanID":      rootSpan.SpanID,
-			"spans":           traceSpans,
-			"tokenUsage":      tokenUsage,
-			"status":          traceStatus,
-			"input":           input,
-			"output":          output,
-			"taskId":          taskId,  // Task ID from baggage
-			"trialId":         trialId, // Trial ID from baggage
-			"rootSpanName":    rootSpan.Name,
-			"rootSpanKind":    string(opensearch.DetermineSpanType(*rootSpan)),
-			"startTime":       rootSpan.StartTime.Format(time.RFC3339Nano),
-			"endTime":         rootSpan.EndTime.Format(time.RFC3339Nano),
-			"durationInNanos": rootSpan.DurationInNanos,
-			"spanCount":       len(traceSpans),
-			"originalLimit":   originalLimit,
-			"originalOffset":  originalOffset,
-		}
+		traceData["originalLimit"] = originalLimit
+		traceData["originalOffset"] = originalOffset
 
 		allTraces = append(allTraces, traceData)
 	}
@@ -222,7 +215,88 @@ func (s *TracingController) retrieveAndGroupTraces(ctx context.Context, params o
 	return allTraces, len(spans), nil
 }
 
-// GetTraceOverviews retrieves unique trace IDs with root span information
+// buildTraceData summarizes the spans of a trace: its root span, token usage, status, input and
+// output. It returns nil for a trace without a root span.
+func buildTraceData(traceID string, traceSpans []opensearch.Span) map[string]interface{} {
+	// Find root span (span with no parentSpanId)
+	var rootSpan *opensearch.Span
+	for i := range traceSpans {
+		if traceSpans[i].ParentSpanID == "" {
+			rootSpan = &traceSpans[i]
+			break
+		}
+	}
+
+	// An agent called by another agent has no root span of its own; its part of the
+	// trace starts at the span whose parent belongs to the calling agent
+	remoteParentSpanID := ""
+	if rootSpan == nil {
+		if rootSpan = opensearch.FindEntrySpan(traceSpans); rootSpan != nil {
+			remoteParentSpanID = rootSpan.ParentSpanID
+		}
+	}
+
+	if rootSpan == nil {
+		return nil
+	}
+
+	// Extract token usage from GenAI spans
+	tokenUsage := opensearch.ExtractTokenUsage(traceSpans)
+
+	// Extract trace status and error information
+	traceStatus := opensearch.ExtractTraceStatus(traceSpans)
+
+	// Extract input and output from root span
+	var input, output interface{}
+	if opensearch.IsCrewAISpan(rootSpan.Attributes) {
+		input, output = opensearch.ExtractCrewAIRootSpanInputOutput(rootSpan)
+	} else {
+		input, output = opensearch.ExtractRootSpanInputOutput(rootSpan)
+	}
+
+	// Extract task.id and trial.id from OpenTelemetry baggage attributes
+	// These are propagated from the experiment framework for trace-to-task matching
+	// Using dotted notation following OpenTelemetry semantic conventions
+	var taskId, trialId string
+
+	if taskIdVal, ok := rootSpan.Attributes["task.id"]; ok {
+		if taskIdStr, ok := taskIdVal.(string); ok {
+			taskId = taskIdStr
+		}
+	}
+	if trialIdVal, ok := rootSpan.Attributes["trial.id"]; ok {
+		if trialIdStr, ok := trialIdVal.(string); ok {
+			trialId = trialIdStr
+		}
+	}
+
+	// Store trace data as a map with all necessary fields
+	traceData := map[string]interface{}{
+		"traceID":            traceID,
+		"rootSpanID":         rootSpan.SpanID,
+		"spans":              traceSpans,
+		"tokenUsage":         tokenUsage,
+		"status":             traceStatus,
+		"input":              input,
+		"output":             output,
+		"taskId":             taskId,  // Task ID from baggage
+		"trialId":            trialId, // Trial ID from baggage
+		"rootSpanName":       rootSpan.Name,
+		"rootSpanKind":       string(opensearch.DetermineSpanType(*rootSpan)),
+		"startTime":          rootSpan.StartTime.Format(time.RFC3339Nano),
+		"endTime":            rootSpan.EndTime.Format(time.RFC3339Nano),
+		"durationInNanos":    rootSpan.DurationInNanos,
+		"spanCount":          len(traceSpans),
+		"remoteParentSpanID": remoteParentSpanID,
+	}
+
+	return traceData
+}
+
+// GetTraceOverviews retrieves a page of traces with their root span information. The IDs of the
+// traces on the page are aggregated first, and only their spans are then fetched, in batches run
+// concurrently, so that the cost of a page does not grow with the number of spans in the time range.
+// When trace summaries are enabled, the page is read from the summary documents instead.
 func (s *TracingController) GetTraceOverviews(ctx context.Context, params opensearch.TraceQueryParams) (*opensearch.TraceOverviewResponse, error) {
 	log := logger.GetLogger(ctx)
 	log.Info("Getting trace overviews",
@@ -231,67 +305,146 @@ func (s *TracingController) GetTraceOverviews(ctx context.Context, params opense
 		"startTime", params.StartTime,
 		"endTime", params.EndTime)
 
-	// Retrieve and group traces using shared function
-	// Use 100x multiplier to ensure we discover all traces
-	allTraces, totalSpans, err := s.retrieveAndGroupTraces(ctx, params)
-	if err != nil {
-		return nil, err
+	if params.Limit == 0 {
+		params.Limit = DefaultTracesLimit
 	}
-
-	// Convert trace data maps to TraceOverview structs
-	allOverviews := make([]opensearch.TraceOverview, 0, len(allTraces))
-	for _, traceData := range allTraces {
-		allOverviews = append(allOverviews, opensearch.TraceOverview{
-			TraceID:         traceData["traceID"].(string),
-			RootSpanID:      traceData["rootSpanID"].(string), // Use stored ID instead of pointer
-			RootSpanName:    traceData["rootSpanName"].(string),
-			RootSpanKind:    traceData["rootSpanKind"].(string),
-			StartTime:       traceData["startTime"].(string),
-			EndTime:         traceData["endTime"].(string),
-			DurationInNanos: traceData["durationInNanos"].(int64),
-			SpanCount:       traceData["spanCount"].(int),
-			TokenUsage:      traceData["tokenUsage"].(*opensearch.TokenUsage),
-			Status:          traceData["status"].(*opensearch.TraceStatus),
-			Input:           traceData["input"],
-			Output:          traceData["output"],
-		})
+	if params.Offset < 0 {
+		params.Offset = 0
 	}
-
-	// Apply pagination to the trace overviews
-	totalCount := len(allOverviews)
-
-	// Get pagination params from first trace (they're all the same)
-	var originalLimit, originalOffset int
-	if len(allTraces) > 0 {
-		originalLimit = allTraces[0]["originalLimit"].(int)
-		originalOffset = allTraces[0]["originalOffset"].(int)
+	if s.traceSummaries.Enabled {
+		return s.getTraceSummaries(ctx, params)
 	}
 
-	start := originalOffset
-	end := originalOffset + originalLimit
+	indices, err := opensearch.GetIndicesForTimeRange(params.StartTime, params.EndTime)
+	if err != nil {
+		log.Error("Failed to generate indices for time range",
+			"startTime", params.StartTime,
+			"endTime", params.EndTime,
+			"error", err)
+		return nil, fmt.Errorf("failed to generate indices: %w", err)
+	}
 
-	if start >= len(allOverviews) {
-		start = len(allOverviews)
+	// Terms aggregations have no offset, so the traces before the page are aggregated too
+	response, err := s.osClient.Search(ctx, indices, opensearch.BuildTraceOverviewPageQuery(params, params.Offset+params.Limit))
+	if err != nil {
+		log.Error("OpenSearch query failed",
+			"indices", indices,
+			"component", params.ComponentUid,
+			"environment", params.EnvironmentUid,
+			"error", err)
+		return nil, fmt.Errorf("failed to search traces: %w", err)
+	}
+	traceIDs, totalCount, err := opensearch.ParseTraceOverviewPage(response.Aggregations)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse traces: %w", err)
 	}
-	if end > len(allOverviews) {
-		end = len(allOverviews)
+	if params.Offset >= len(traceIDs) {
+		return &opensearch.TraceOverviewResponse{Traces: []opensearch.TraceOverview{}, TotalCount: totalCount}, nil
 	}
+	traceIDs = traceIDs[params.Offset:]
 
-	paginatedOverviews := allOverviews[start:end]
+	traceSpans, err := s.fetchTraceOverviewSpans(ctx, indices, params, traceIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	overviews := make([]opensearch.TraceOverview, 0, len(traceIDs))
+	totalSpans := 0
+	for _, traceID := range traceIDs {
+		totalSpans += len(traceSpans[traceID])
+		traceData := buildTraceData(traceID, traceSpans[traceID])
+		if traceData == nil {
+			log.Warn("No root span found for trace, skipping",
+				"traceId", traceID,
+				"spanCount", len(traceSpans[traceID]))
+			continue
+		}
+		overviews = append(overviews, toTraceOverview(traceData))
+	}
 
 	log.Info("Retrieved trace overviews",
-		"unique_traces", len(allOverviews),
+		"traces", len(overviews),
 		"total_spans", totalSpans,
-		"showing_start", start,
-		"showing_end", end,
+		"offset", params.Offset,
 		"total_count", totalCount)
 
 	return &opensearch.TraceOverviewResponse{
-		Traces:     paginatedOverviews,
+		Traces:     overviews,
 		TotalCount: totalCount,
 	}, nil
 }
 
+// fetchTraceOverviewSpans fetches the spans of traces in batches of traceOverviewBatchSize, with
+// at most traceOverviewWorkers batches in flight, and returns them by trace ID
+func (s *TracingController) fetchTraceOverviewSpans(ctx context.Context, indices []string, params opensearch.TraceQueryParams, traceIDs []string) (map[string][]opensearch.Span, error) {
+	log := logger.GetLogger(ctx)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var batches [][]string
+	for start := 0; start < len(traceIDs); start += traceOverviewBatchSize {
+		batches = append(batches, traceIDs[start:min(start+traceOverviewBatchSize, len(traceIDs))])
+	}
+
+	results := make([][]opensearch.Span, len(batches))
+	errs := make([]error, len(batches))
+	workers := make(chan struct{}, traceOverviewWorkers)
+	var wg sync.WaitGroup
+	for i, batch := range batches {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			workers <- struct{}{}
+			defer func() { <-workers }()
+
+			spans, truncated, err := s.osClient.SearchAllSpans(ctx, indices,
+				opensearch.BuildTraceOverviewSpansQuery(params, batch), opensearch.SortAsc, MaxSpansPerTrace)
+			if err != nil {
+				errs[i] = err
+				// The page cannot be assembled, so the other batches are abandoned
+				cancel()
+				return
+			}
+			if truncated {
+				log.Warn("Trace overview batch reached the span limit", "traces", len(batch), "limit", MaxSpansPerTrace)
+			}
+			results[i] = spans
+		}()
+	}
+	wg.Wait()
+	if err := errors.Join(errs...); err != nil {
+		log.Error("Failed to fetch spans of traces", "traces", len(traceIDs), "error", err)
+		return nil, fmt.Errorf("failed to search trace spans: %w", err)
+	}
+
+	traceSpans := make(map[string][]opensearch.Span, len(traceIDs))
+	for _, spans := range results {
+		for _, span := range spans {
+			traceSpans[span.TraceID] = append(traceSpans[span.TraceID], span)
+		}
+	}
+	return traceSpans, nil
+}
+
+// toTraceOverview converts the summary of a trace built by buildTraceData to a trace overview
+func toTraceOverview(traceData map[string]interface{}) opensearch.TraceOverview {
+	return opensearch.TraceOverview{
+		TraceID:            traceData["traceID"].(string),
+		RootSpanID:         traceData["rootSpanID"].(string), // Use stored ID instead of pointer
+		RootSpanName:       traceData["rootSpanName"].(string),
+		RootSpanKind:       traceData["rootSpanKind"].(string),
+		StartTime:          traceData["startTime"].(string),
+		EndTime:            traceData["endTime"].(string),
+		DurationInNanos:    traceData["durationInNanos"].(int64),
+		SpanCount:          traceData["spanCount"].(int),
+		TokenUsage:         traceData["tokenUsage"].(*opensearch.TokenUsage),
+		Status:             traceData["status"].(*opensearch.TraceStatus),
+		Input:              traceData["input"],
+		Output:             traceData["output"],
+		RemoteParentSpanID: traceData["remoteParentSpanID"].(string),
+	}
+}
+
 // GetTraceByIdAndService retrieves spans for a specific trace ID and component UID
 func (s *TracingController) GetTraceByIdAndService(ctx context.Context, params opensearch.TraceByIdAndServiceParams) (*opensearch.TraceResponse, error) {
 	log := logger.GetLogger(ctx)
@@ -301,7 +454,16 @@ func (s *TracingController) GetTraceByIdAndService(ctx context.Context, params o
 		"environment", params.EnvironmentUid)
 
 	// Build query
-	query := opensearch.BuildTraceByIdAndServiceQuery(params)
+	query := opensearch.BuildTraceSpansQuery(params)
+
+	limit := params.Limit
+	if limit <= 0 || limit > MaxSpansPerTrace {
+		limit = MaxSpansPerTrace
+	}
+	sortOrder := params.SortOrder
+	if sortOrder == "" {
+		sortOrder = opensearch.SortAsc
+	}
 
 	// For trace by ID queries, we need to search across a broader time range
 	// Use current day and previous 7 days as default
@@ -317,14 +479,11 @@ func (s *TracingController) GetTraceByIdAndService(ctx context.Context, params o
 	log.Debug("Searching indices for trace ID", "indices", indices)
 
 	// Execute search
-	response, err := s.osClient.Search(ctx, indices, query)
+	spans, truncated, err := s.osClient.SearchAllSpans(ctx, indices, query, sortOrder, limit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to search traces: %w", err)
 	}
 
-	// Parse spans
-	spans := opensearch.ParseSpans(response)
-
 	if len(spans) == 0 {
 		log.Warn("No spans found for trace",
 			"traceId", params.TraceID,
@@ -339,17 +498,232 @@ func (s *TracingController) GetTraceByIdAndService(ctx context.Context, params o
 	// Extract trace status and error information
 	traceStatus := opensearch.ExtractTraceStatus(spans)
 
+	// Extract input and output from the root span, which a limited result may not include
+	input, output := opensearch.ExtractTraceInputOutput(spans)
+
 	log.Info("Retrieved trace spans",
 		"span_count", len(spans),
+		"truncated", truncated,
 		"traceId", params.TraceID,
 		"component", params.ComponentUid,
 		"environment", params.EnvironmentUid)
 
+	// Annotations are secondary to the spans, so the trace is returned without them when they cannot be read
+	annotations, err := s.ListAnnotations(ctx, params.TraceID)
+	if err != nil {
+		log.Warn("Failed to read trace annotations", "traceId", params.TraceID, "error", err)
+	}
+
 	return &opensearch.TraceResponse{
+		Spans:       spans,
+		TotalCount:  len(spans),
+		TokenUsage:  tokenUsage,
+		Status:      traceStatus,
+		Truncated:   truncated,
+		Input:       input,
+		Output:      output,
+		Annotations: annotations,
+	}, nil
+}
+
+// GetFederatedTrace retrieves the spans of a trace from all agent components that took part in it
+// and correlates the hand-offs between them
+func (s *TracingController) GetFederatedTrace(ctx context.Context, params opensearch.FederatedTraceParams) (*opensearch.FederatedTraceResponse, error) {
+	log := logger.GetLogger(ctx)
+	log.Info("Getting federated trace",
+		"traceId", params.TraceID,
+		"environment", params.EnvironmentUid)
+
+	// No component filter, so that the spans of every agent in the trace are returned
+	query := opensearch.BuildTraceSpansQuery(opensearch.TraceByIdAndServiceParams{
+		TraceID:        params.TraceID,
+		EnvironmentUid: params.EnvironmentUid,
+	})
+
+	// Use the same search window as trace by ID queries
+	endTime := time.Now()
+	startTime := endTime.AddDate(0, 0, -7)
+	indices, err := opensearch.GetIndicesForTimeRange(
+		startTime.Format(time.RFC3339),
+		endTime.Format(time.RFC3339),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate indices: %w", err)
+	}
+
+	spans, truncated, err := s.osClient.SearchAllSpans(ctx, indices, query, opensearch.SortAsc, MaxSpansPerTrace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search traces: %w", err)
+	}
+
+	if len(spans) == 0 {
+		log.Warn("No spans found for federated trace",
+			"traceId", params.TraceID,
+			"environment", params.EnvironmentUid)
+		return nil, ErrTraceNotFound
+	}
+
+	// The called spans are tagged with their hand-offs, which the response returns with them
+	handOffs := opensearch.CorrelateHandOffs(spans)
+	agents := opensearch.SummarizeTraceAgents(spans)
+
+	log.Info("Retrieved federated trace",
+		"traceId", params.TraceID,
+		"span_count", len(spans),
+		"agents", len(agents),
+		"handOffs", len(handOffs))
+
+	return &opensearch.FederatedTraceResponse{
+		TraceID:    params.TraceID,
 		Spans:      spans,
 		TotalCount: len(spans),
-		TokenUsage: tokenUsage,
-		Status:     traceStatus,
+		Agents:     agents,
+		HandOffs:   handOffs,
+		TokenUsage: opensearch.ExtractTokenUsage(spans),
+		Status:     opensearch.ExtractTraceStatus(spans),
+		Truncated:  truncated,
+	}, nil
+}
+
+// GetSessionConversation reconstructs the conversation of a chat session from the traces that
+// carry its session ID
+func (s *TracingController) GetSessionConversation(ctx context.Context, params opensearch.SessionConversationParams) (*opensearch.ConversationResponse, error) {
+	log := logger.GetLogger(ctx)
+	log.Info("Getting session conversation",
+		"sessionId", params.SessionID,
+		"component", params.ComponentUid,
+		"environment", params.EnvironmentUid,
+		"startTime", params.StartTime,
+		"endTime", params.EndTime)
+
+	indices, err := opensearch.GetIndicesForTimeRange(params.StartTime, params.EndTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate indices: %w", err)
+	}
+
+	search := opensearch.NewSearch().
+		Query(opensearch.BuildSessionSpansQuery(params)).
+		Size(MaxSpansPerRequest).
+		Sort("startTime", opensearch.SortAsc)
+	response, err := s.osClient.Search(ctx, indices, search)
+	if err != nil {
+		log.Error("OpenSearch query failed", "indices", indices, "error", err)
+		return nil, fmt.Errorf("failed to search spans: %w", err)
+	}
+
+	traceIDs := opensearch.CollectTraceIDs(opensearch.ParseSpans(ctx, response), MaxTracesPerRequest)
+	if len(traceIDs) == 0 {
+		return nil, ErrSessionNotFound
+	}
+
+	// Only the spans of the component, when given, so that the LLM calls of other agents in the
+	// traces are not mixed into the conversation
+	query := opensearch.BuildSessionTracesSpansQuery(traceIDs, params.ComponentUid)
+	spans, truncated, err := s.osClient.SearchAllSpans(ctx, indices, query, opensearch.SortAsc, MaxSpansPerTrace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search traces: %w", err)
+	}
+	if truncated {
+		log.Warn("Session conversation reached the span limit", "sessionId", params.SessionID, "limit", MaxSpansPerTrace)
+	}
+
+	messages := opensearch.BuildConversation(spans)
+	log.Info("Reconstructed session conversation",
+		"sessionId", params.SessionID,
+		"traces", len(traceIDs),
+		"messages", len(messages))
+
+	return &opensearch.ConversationResponse{
+		SessionID:  params.SessionID,
+		TraceIDs:   traceIDs,
+		Messages:   messages,
+		TotalCount: len(messages),
+		Truncated:  truncated,
+	}, nil
+}
+
+// GetTraceLogs returns the application logs carrying the trace context of a trace, or of one of
+// its spans. The logs are searched within the time range of the spans, widened by the configured
+// padding.
+func (s *TracingController) GetTraceLogs(ctx context.Context, params logs.TraceLogsParams) (*logs.TraceLogsResponse, error) {
+	log := logger.GetLogger(ctx)
+	log.Info("Getting trace logs",
+		"traceId", params.TraceID,
+		"spanId", params.SpanID,
+		"component", params.ComponentUid,
+		"environment", params.EnvironmentUid)
+
+	if s.logSource == nil {
+		return nil, ErrLogsNotConfigured
+	}
+
+	// Use the same search window as trace by ID queries
+	endTime := time.Now()
+	startTime := endTime.AddDate(0, 0, -7)
+	indices, err := opensearch.GetIndicesForTimeRange(
+		startTime.Format(time.RFC3339),
+		endTime.Format(time.RFC3339),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate indices: %w", err)
+	}
+
+	query := opensearch.BuildTraceTimeRangeQuery(opensearch.TraceByIdAndServiceParams{
+		TraceID:        params.TraceID,
+		ComponentUid:   params.ComponentUid,
+		EnvironmentUid: params.EnvironmentUid,
+	}, params.SpanID)
+	response, err := s.osClient.Search(ctx, indices, query)
+	if err != nil {
+		log.Error("OpenSearch query failed", "indices", indices, "error", err)
+		return nil, fmt.Errorf("failed to search traces: %w", err)
+	}
+	traceStart, traceEnd, err := opensearch.ParseTimeRange(response.Aggregations)
+	if err != nil {
+		return nil, err
+	}
+	if traceStart == nil {
+		return nil, ErrTraceNotFound
+	}
+	if traceEnd == nil || traceEnd.Before(*traceStart) {
+		traceEnd = traceStart
+	}
+
+	limit := params.Limit
+	if limit <= 0 || limit > s.logsConfig.MaxRecords {
+		limit = s.logsConfig.MaxRecords
+	}
+	padding := time.Duration(s.logsConfig.TimePaddingSeconds) * time.Second
+
+	// One more record than returned is read to tell whether the logs were truncated
+	records, err := s.logSource.Search(ctx, logs.Query{
+		TraceID:   params.TraceID,
+		SpanID:    params.SpanID,
+		StartTime: traceStart.Add(-padding),
+		EndTime:   traceEnd.Add(padding),
+		Limit:     limit + 1,
+	})
+	if err != nil {
+		log.Error("Log query failed", "backend", s.logsConfig.Backend, "error", err)
+		return nil, fmt.Errorf("failed to search logs: %w", err)
+	}
+
+	truncated := len(records) > limit
+	if truncated {
+		records = records[:limit]
+	}
+
+	log.Info("Retrieved trace logs",
+		"traceId", params.TraceID,
+		"records", len(records),
+		"truncated", truncated)
+
+	return &logs.TraceLogsResponse{
+		TraceID:    params.TraceID,
+		SpanID:     params.SpanID,
+		Logs:       records,
+		TotalCount: len(records),
+		Truncated:  truncated,
 	}, nil
 }
 
@@ -425,6 +799,531 @@ func (s *TracingController) ExportTraces(ctx context.Context, params opensearch.
 	}, nil
 }
 
+// GetToolCatalog builds the tool inventory of agents from their spans in the given time range
+func (s *TracingController) GetToolCatalog(ctx context.Context, params opensearch.ToolCatalogParams) (*opensearch.ToolCatalogResponse, error) {
+	log := logger.GetLogger(ctx)
+	log.Info("Getting tool catalog",
+		"component", params.ComponentUid,
+		"environment", params.EnvironmentUid,
+		"startTime", params.StartTime,
+		"endTime", params.EndTime)
+
+	// The most recent spans are aggregated, capped at MaxSpansPerRequest
+	query := opensearch.BuildTraceQuery(opensearch.TraceQueryParams{
+		ComponentUid:   params.ComponentUid,
+		EnvironmentUid: params.EnvironmentUid,
+		StartTime:      params.StartTime,
+		EndTime:        params.EndTime,
+		Limit:          MaxSpansPerRequest,
+		SortOrder:      "desc",
+	})
+
+	indices, err := opensearch.GetIndicesForTimeRange(params.StartTime, params.EndTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate indices: %w", err)
+	}
+
+	response, err := s.osClient.Search(ctx, indices, query)
+	if err != nil {
+		log.Error("OpenSearch query failed",
+			"indices", indices,
+			"component", params.ComponentUid,
+			"environment", params.EnvironmentUid,
+			"error", err)
+		return nil, fmt.Errorf("failed to search spans: %w", err)
+	}
+
+	spans := opensearch.ParseSpans(ctx, response)
+	catalog := opensearch.AggregateToolCatalog(spans)
+
+	truncated := len(spans) >= MaxSpansPerRequest
+	log.Info("Built tool catalog",
+		"agents", len(catalog),
+		"spanCount", len(spans),
+		"truncated", truncated,
+		"component", params.ComponentUid,
+		"environment", params.EnvironmentUid)
+
+	return &opensearch.ToolCatalogResponse{
+		Agents:     catalog,
+		TotalCount: len(catalog),
+		SpanCount:  len(spans),
+		Truncated:  truncated,
+	}, nil
+}
+
+// GetModelUsage breaks down LLM and embedding calls of the given components by model and provider
+func (s *TracingController) GetModelUsage(ctx context.Context, params opensearch.ModelUsageParams) (*opensearch.ModelUsageResponse, error) {
+	log := logger.GetLogger(ctx)
+	log.Info("Getting model usage",
+		"components", len(params.ComponentUids),
+		"environment", params.EnvironmentUid,
+		"startTime", params.StartTime,
+		"endTime", params.EndTime)
+
+	// The most recent spans are aggregated, capped at MaxSpansPerRequest
+	params.Limit = MaxSpansPerRequest
+	query := opensearch.BuildModelUsageQuery(params)
+
+	indices, err := opensearch.GetIndicesForTimeRange(params.StartTime, params.EndTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate indices: %w", err)
+	}
+
+	response, err := s.osClient.Search(ctx, indices, query)
+	if err != nil {
+		log.Error("OpenSearch query failed",
+			"indices", indices,
+			"environment", params.EnvironmentUid,
+			"error", err)
+		return nil, fmt.Errorf("failed to search spans: %w", err)
+	}
+
+	spans := opensearch.ParseSpans(ctx, response)
+	models, providers := opensearch.AggregateModelUsage(spans, s.modelPricing.Prices())
+
+	log.Info("Computed model usage",
+		"models", len(models),
+		"providers", len(providers),
+		"spanCount", len(spans))
+
+	return &opensearch.ModelUsageResponse{
+		Models:    models,
+		Providers: providers,
+		SpanCount: len(spans),
+		Truncated: len(spans) >= params.Limit,
+	}, nil
+}
+
+// GetPromptAnalytics ranks the most frequent prompts of LLM calls of the given components by their
+// hashes, together with the duplicate input rate and the calls a cache could have answered
+func (s *TracingController) GetPromptAnalytics(ctx context.Context, params opensearch.PromptAnalyticsParams) (*opensearch.PromptAnalyticsResponse, error) {
+	log := logger.GetLogger(ctx)
+	log.Info("Getting prompt analytics",
+		"components", len(params.ComponentUids),
+		"environment", params.EnvironmentUid,
+		"startTime", params.StartTime,
+		"endTime", params.EndTime)
+
+	// The most recent spans are aggregated, capped at MaxSpansPerRequest
+	query := opensearch.BuildModelUsageQuery(opensearch.ModelUsageParams{
+		ComponentUids:  params.ComponentUids,
+		EnvironmentUid: params.EnvironmentUid,
+		StartTime:      params.StartTime,
+		EndTime:        params.EndTime,
+		Limit:          MaxSpansPerRequest,
+	})
+
+	indices, err := opensearch.GetIndicesForTimeRange(params.StartTime, params.EndTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate indices: %w", err)
+	}
+
+	response, err := s.osClient.Search(ctx, indices, query)
+	if err != nil {
+		log.Error("OpenSearch query failed",
+			"indices", indices,
+			"environment", params.EnvironmentUid,
+			"error", err)
+		return nil, fmt.Errorf("failed to search spans: %w", err)
+	}
+
+	spans := opensearch.ParseSpans(ctx, response)
+	result := opensearch.AggregatePromptAnalytics(spans, params.Limit)
+	result.SpanCount = len(spans)
+	result.Truncated = len(spans) >= MaxSpansPerRequest
+
+	log.Info("Computed prompt analytics",
+		"llmCalls", result.LLMCallCount,
+		"uniqueInputs", result.UniqueInputCount,
+		"spanCount", len(spans))
+
+	return result, nil
+}
+
+// GetTimeSeries computes metrics of the given components over time
+func (s *TracingController) GetTimeSeries(ctx context.Context, params opensearch.TimeSeriesParams) (*opensearch.TimeSeriesResponse, error) {
+	log := logger.GetLogger(ctx)
+	log.Info("Getting time series",
+		"components", len(params.ComponentUids),
+		"environment", params.EnvironmentUid,
+		"from", params.From,
+		"to", params.To,
+		"interval", params.Interval,
+		"metrics", params.Metrics)
+
+	// The most recent spans are aggregated, capped at MaxSpansPerRequest
+	spanParams := opensearch.ModelUsageParams{
+		ComponentUids:  params.ComponentUids,
+		EnvironmentUid: params.EnvironmentUid,
+		StartTime:      params.From.UTC().Format(time.RFC3339Nano),
+		EndTime:        params.To.UTC().Format(time.RFC3339Nano),
+		Limit:          MaxSpansPerRequest,
+	}
+	query := opensearch.BuildModelUsageQuery(spanParams)
+
+	indices, err := opensearch.GetIndicesForTimeRange(spanParams.StartTime, spanParams.EndTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate indices: %w", err)
+	}
+
+	response, err := s.osClient.Search(ctx, indices, query)
+	if err != nil {
+		log.Error("OpenSearch query failed",
+			"indices", indices,
+			"environment", params.EnvironmentUid,
+			"error", err)
+		return nil, fmt.Errorf("failed to search spans: %w", err)
+	}
+
+	spans := opensearch.ParseSpans(ctx, response)
+	series := make([]opensearch.TimeSeries, 0, len(params.Metrics))
+	for _, metric := range params.Metrics {
+		series = append(series, opensearch.TimeSeries{
+			Metric: metric,
+			Points: opensearch.AggregateTimeSeries(spans, metric, params.From, params.To, params.Interval),
+		})
+	}
+
+	log.Info("Computed time series",
+		"series", len(series),
+		"spanCount", len(spans))
+
+	return &opensearch.TimeSeriesResponse{
+		Series:    series,
+		SpanCount: len(spans),
+		Truncated: len(spans) >= spanParams.Limit,
+	}, nil
+}
+
+// DeleteSpans starts deleting the spans of the given components that are past their retention period
+func (s *TracingController) DeleteSpans(ctx context.Context, params opensearch.SpanDeletionParams) (*opensearch.SpanDeletionResponse, error) {
+	log := logger.GetLogger(ctx)
+	log.Info("Deleting spans",
+		"components", len(params.ComponentUids),
+		"before", params.Before,
+		"errorsBefore", params.ErrorsBefore)
+
+	query := opensearch.BuildSpanDeletionQuery(params)
+	taskID, err := s.osClient.DeleteByQuery(ctx, []string{opensearch.TracesIndexPattern}, query)
+	if err != nil {
+		log.Error("OpenSearch delete by query failed", "error", err)
+		return nil, fmt.Errorf("failed to delete spans: %w", err)
+	}
+
+	log.Info("Started span deletion", "taskId", taskID)
+	return &opensearch.SpanDeletionResponse{TaskID: taskID}, nil
+}
+
+// EraseSpans starts deleting the spans of the given components that carry a personal identifier
+func (s *TracingController) EraseSpans(ctx context.Context, params opensearch.SpanErasureParams) (*opensearch.SpanDeletionResponse, error) {
+	log := logger.GetLogger(ctx)
+	// The identifier itself is personal data, so it is not logged
+	log.Info("Erasing spans",
+		"components", len(params.ComponentUids),
+		"attribute", params.Attribute)
+
+	query := opensearch.BuildSpanErasureQuery(params)
+	taskID, err := s.osClient.DeleteByQuery(ctx, []string{opensearch.TracesIndexPattern}, query)
+	if err != nil {
+		log.Error("OpenSearch delete by query failed", "error", err)
+		return nil, fmt.Errorf("failed to erase spans: %w", err)
+	}
+
+	log.Info("Started span erasure", "taskId", taskID)
+	return &opensearch.SpanDeletionResponse{TaskID: taskID}, nil
+}
+
+// GetTask returns the progress of a span deletion task
+func (s *TracingController) GetTask(ctx context.Context, taskID string) (*opensearch.TaskStatus, error) {
+	return s.osClient.GetTask(ctx, taskID)
+}
+
+// GetAttributeStats lists the span attribute keys recorded by a component, with the number of
+// values, the approximate number of distinct values and the most frequent values of each
+func (s *TracingController) GetAttributeStats(ctx context.Context, params opensearch.AttributeStatsParams) (*opensearch.AttributeStatsResponse, error) {
+	log := logger.GetLogger(ctx)
+	log.Info("Getting attribute stats",
+		"component", params.ComponentUid,
+		"environment", params.EnvironmentUid,
+		"prefix", params.Prefix,
+		"startTime", params.StartTime,
+		"endTime", params.EndTime)
+
+	indices, err := opensearch.GetIndicesForTimeRange(params.StartTime, params.EndTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate indices: %w", err)
+	}
+
+	// Field capabilities cover the spans of every component, so keys the component never
+	// recorded are dropped by their value count below
+	caps, err := s.osClient.FieldCaps(ctx, indices, opensearch.AttributesFieldPattern)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get field capabilities: %w", err)
+	}
+	fields := opensearch.AttributeFields(caps, params.Prefix)
+	truncated := len(fields) > maxAttributeKeys
+	if truncated {
+		log.Warn("Attribute keys exceed the aggregation limit", "keys", len(fields), "limit", maxAttributeKeys)
+		fields = fields[:maxAttributeKeys]
+	}
+
+	attributes := []opensearch.AttributeStats{}
+	if len(fields) > 0 {
+		response, err := s.osClient.Search(ctx, indices, opensearch.BuildAttributeStatsQuery(params, fields))
+		if err != nil {
+			log.Error("OpenSearch query failed", "indices", indices, "error", err)
+			return nil, fmt.Errorf("failed to search attributes: %w", err)
+		}
+		attributes, err = opensearch.ParseAttributeStats(response.Aggregations, fields)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	log.Info("Retrieved attribute stats", "component", params.ComponentUid, "attributes", len(attributes))
+	return &opensearch.AttributeStatsResponse{
+		ComponentUid: params.ComponentUid,
+		Attributes:   attributes,
+		TotalCount:   len(attributes),
+		Truncated:    truncated,
+	}, nil
+}
+
+// GetSLOStatus computes the compliance of a component with an objective over its rolling window,
+// with the error budget left and the rate it is burning at
+func (s *TracingController) GetSLOStatus(ctx context.Context, params opensearch.SLOParams) (*opensearch.SLOStatusResponse, error) {
+	// Hourly buckets, so that the burn rates of the trailing windows can be summed up
+	params.Interval = time.Hour
+	response, err := s.searchSLO(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return opensearch.ParseSLOStatus(params, response.Aggregations)
+}
+
+// GetSLOHistory computes the compliance of a component with an objective per interval of its
+// rolling window
+func (s *TracingController) GetSLOHistory(ctx context.Context, params opensearch.SLOParams) (*opensearch.SLOHistoryResponse, error) {
+	response, err := s.searchSLO(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return opensearch.ParseSLOHistory(params, response.Aggregations)
+}
+
+// searchSLO aggregates the traces of a component in the window of an objective
+func (s *TracingController) searchSLO(ctx context.Context, params opensearch.SLOParams) (*opensearch.SearchResponse, error) {
+	log := logger.GetLogger(ctx)
+	log.Info("Computing SLO",
+		"component", params.ComponentUid,
+		"environment", params.EnvironmentUid,
+		"indicator", params.Objective.Indicator,
+		"target", params.Objective.Target,
+		"window", params.Window,
+		"interval", params.Interval)
+
+	indices, err := opensearch.GetIndicesForTimeRange(
+		params.End.Add(-params.Window).Format(time.RFC3339),
+		params.End.Format(time.RFC3339),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate indices: %w", err)
+	}
+
+	response, err := s.osClient.Search(ctx, indices, opensearch.BuildSLOQuery(params))
+	if err != nil {
+		log.Error("OpenSearch query failed", "indices", indices, "error", err)
+		return nil, fmt.Errorf("failed to search traces: %w", err)
+	}
+	return response, nil
+}
+
+// GetStorageUsage returns the stored span data of the given components
+func (s *TracingController) GetStorageUsage(ctx context.Context, componentUids []string) (*opensearch.StorageUsageResponse, error) {
+	log := logger.GetLogger(ctx)
+	log.Info("Getting storage usage", "components", len(componentUids))
+
+	totalDocs, totalSizeBytes, err := s.osClient.IndexStats(ctx, opensearch.TracesIndexPattern)
+	if err != nil {
+		log.Error("OpenSearch index stats failed", "error", err)
+		return nil, fmt.Errorf("failed to get index stats: %w", err)
+	}
+
+	response, err := s.osClient.Search(ctx, []string{opensearch.TracesIndexPattern}, opensearch.BuildStorageUsageQuery(componentUids))
+	if err != nil {
+		log.Error("OpenSearch query failed", "error", err)
+		return nil, fmt.Errorf("failed to aggregate spans: %w", err)
+	}
+
+	components, err := opensearch.ParseStorageUsage(response.Aggregations, totalDocs, totalSizeBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &opensearch.StorageUsageResponse{
+		Components:     components,
+		TotalSpanCount: totalDocs,
+		TotalSizeBytes: totalSizeBytes,
+	}, nil
+}
+
+// GetVolume returns the spans and traces ingested per component and day within a time range,
+// along with the sizes of the daily trace indices
+func (s *TracingController) GetVolume(ctx context.Context, params opensearch.VolumeParams) (*opensearch.VolumeResponse, error) {
+	log := logger.GetLogger(ctx)
+	log.Info("Getting ingest volume",
+		"components", len(params.ComponentUids),
+		"environment", params.EnvironmentUid,
+		"startTime", params.StartTime,
+		"endTime", params.EndTime)
+
+	indexSizes, err := s.osClient.IndexSizes(ctx, opensearch.TracesIndexPattern)
+	if err != nil {
+		log.Error("OpenSearch index stats failed", "error", err)
+		return nil, fmt.Errorf("failed to get index stats: %w", err)
+	}
+
+	indices, err := opensearch.GetIndicesForTimeRange(params.StartTime.UTC().Format(time.RFC3339), params.EndTime.UTC().Format(time.RFC3339))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate indices: %w", err)
+	}
+
+	response, err := s.osClient.Search(ctx, indices, opensearch.BuildVolumeQuery(params))
+	if err != nil {
+		log.Error("OpenSearch query failed", "indices", indices, "error", err)
+		return nil, fmt.Errorf("failed to aggregate spans: %w", err)
+	}
+
+	return opensearch.ParseVolume(params, int64(response.Hits.Total.Value), response.Aggregations, indexSizes)
+}
+
+// JaegerServices returns the components that recorded spans within the Jaeger lookback window
+func (s *TracingController) JaegerServices(ctx context.Context) ([]string, error) {
+	log := logger.GetLogger(ctx)
+
+	startTime, endTime := jaegerLookbackWindow()
+	response, err := s.osClient.Search(ctx, []string{opensearch.TracesIndexPattern},
+		opensearch.BuildServiceListQuery(startTime, endTime, maxJaegerServices))
+	if err != nil {
+		log.Error("OpenSearch query failed", "error", err)
+		return nil, fmt.Errorf("failed to aggregate services: %w", err)
+	}
+	return opensearch.ParseJaegerServices(response.Aggregations)
+}
+
+// JaegerOperations returns the span names of a component, optionally restricted to a Jaeger span
+// kind. They are collected from the newest spans, capped at MaxSpansPerRequest.
+func (s *TracingController) JaegerOperations(ctx context.Context, componentUid, spanKind string) ([]opensearch.JaegerOperation, error) {
+	log := logger.GetLogger(ctx)
+
+	startTime, endTime := jaegerLookbackWindow()
+	indices, err := opensearch.GetIndicesForTimeRange(startTime, endTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate indices: %w", err)
+	}
+
+	response, err := s.osClient.Search(ctx, indices,
+		opensearch.BuildRecentSpansQuery(componentUid, startTime, endTime, MaxSpansPerRequest))
+	if err != nil {
+		log.Error("OpenSearch query failed", "component", componentUid, "error", err)
+		return nil, fmt.Errorf("failed to search spans: %w", err)
+	}
+	return opensearch.CollectJaegerOperations(opensearch.ParseSpans(ctx, response), spanKind), nil
+}
+
+// JaegerSearchTraces returns the newest traces with a span matching the search criteria
+func (s *TracingController) JaegerSearchTraces(ctx context.Context, params opensearch.JaegerSearchParams) ([]opensearch.JaegerTrace, error) {
+	log := logger.GetLogger(ctx)
+	log.Info("Searching Jaeger traces",
+		"component", params.ComponentUid,
+		"operation", params.Operation,
+		"tags", len(params.Tags),
+		"startTime", params.StartTime,
+		"endTime", params.EndTime,
+		"limit", params.Limit)
+
+	indices, err := opensearch.GetIndicesForTimeRange(params.StartTime, params.EndTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate indices: %w", err)
+	}
+
+	// Several matching spans may belong to one trace, so more spans than traces are fetched
+	spanLimit := min(params.Limit*jaegerSpansPerTrace, MaxSpansPerRequest)
+	search := opensearch.NewSearch().
+		Query(opensearch.BuildJaegerSearchQuery(params)).
+		Size(spanLimit).
+		Sort("startTime", opensearch.SortDesc)
+	response, err := s.osClient.Search(ctx, indices, search)
+	if err != nil {
+		log.Error("OpenSearch query failed", "indices", indices, "error", err)
+		return nil, fmt.Errorf("failed to search spans: %w", err)
+	}
+
+	traceIDs := opensearch.CollectTraceIDs(opensearch.ParseSpans(ctx, response), params.Limit)
+	if len(traceIDs) == 0 {
+		return []opensearch.JaegerTrace{}, nil
+	}
+
+	spans, truncated, err := s.osClient.SearchAllSpans(ctx, indices,
+		opensearch.BuildTracesSpansQuery(traceIDs), opensearch.SortAsc, MaxSpansPerTrace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search traces: %w", err)
+	}
+	if truncated {
+		log.Warn("Jaeger trace search reached the span limit", "traces", len(traceIDs), "limit", MaxSpansPerTrace)
+	}
+
+	// Order the traces like the search results, newest first
+	byID := make(map[string]opensearch.JaegerTrace)
+	for _, trace := range opensearch.ToJaegerTraces(spans) {
+		byID[trace.TraceID] = trace
+	}
+	traces := make([]opensearch.JaegerTrace, 0, len(traceIDs))
+	for _, traceID := range traceIDs {
+		if trace, ok := byID[traceID]; ok {
+			traces = append(traces, trace)
+		}
+	}
+
+	log.Info("Found Jaeger traces", "traces", len(traces), "spanCount", len(spans))
+	return traces, nil
+}
+
+// JaegerGetTrace returns all spans of a trace, from every component that took part in it
+func (s *TracingController) JaegerGetTrace(ctx context.Context, traceID string) (*opensearch.JaegerTrace, error) {
+	log := logger.GetLogger(ctx)
+	log.Info("Getting Jaeger trace", "traceId", traceID)
+
+	startTime, endTime := jaegerLookbackWindow()
+	indices, err := opensearch.GetIndicesForTimeRange(startTime, endTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate indices: %w", err)
+	}
+
+	query := opensearch.BuildTraceSpansQuery(opensearch.TraceByIdAndServiceParams{TraceID: traceID})
+	spans, truncated, err := s.osClient.SearchAllSpans(ctx, indices, query, opensearch.SortAsc, MaxSpansPerTrace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search traces: %w", err)
+	}
+	if len(spans) == 0 {
+		return nil, ErrTraceNotFound
+	}
+
+	trace := opensearch.ToJaegerTraces(spans)[0]
+	if truncated {
+		trace.Warnings = append(trace.Warnings, fmt.Sprintf("trace truncated to %d spans", MaxSpansPerTrace))
+	}
+	return &trace, nil
+}
+
+// jaegerLookbackWindow returns the time range (RFC3339) of Jaeger queries without one
+func jaegerLookbackWindow() (string, string) {
+	endTime := time.Now()
+	startTime := endTime.AddDate(0, 0, -7)
+	return startTime.Format(time.RFC3339), endTime.Format(time.RFC3339)
+}
+
 // HealthCheck checks if the service is healthy
 func (s *TracingController) HealthCheck(ctx context.Context) error {
 	return s.osClient.HealthCheck(ctx)
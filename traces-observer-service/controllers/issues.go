@@ -0,0 +1,169 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/opensearch"
+)
+
+const (
+	// issueGroupingMaxWindow bounds the time range of ended spans grouped in one run, so that a
+	// backfill catches up in steps
+	issueGroupingMaxWindow = time.Hour
+	// maxIssueSpansPerRun bounds the error spans read in one run
+	maxIssueSpansPerRun = 10000
+)
+
+// ErrIssueNotFound is returned when an issue does not exist
+var ErrIssueNotFound = errors.New("issue not found")
+
+// RunIssueGrouping groups the error spans that ended since the previous run into issues until ctx
+// is done. A failed run is retried with the same time range on the next tick.
+func (s *TracingController) RunIssueGrouping(ctx context.Context) {
+	log := logger.GetLogger(ctx)
+	interval := time.Duration(s.issueGrouping.IntervalSeconds) * time.Second
+	settle := time.Duration(s.issueGrouping.SettleSeconds) * time.Second
+	watermark := time.Now().Add(-time.Duration(s.issueGrouping.BackfillSeconds) * time.Second)
+
+	log.Info("Starting issue grouping", "interval", interval, "settle", settle, "from", watermark)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	templateReady := false
+	for {
+		if !templateReady {
+			if err := s.osClient.PutIndexTemplate(ctx, opensearch.IssueIndex, opensearch.IssueIndexTemplateSource()); err != nil {
+				log.Error("Failed to create the issue index template", "error", err)
+			} else {
+				templateReady = true
+			}
+		}
+
+		if templateReady {
+			end := time.Now().Add(-settle)
+			if end.Sub(watermark) > issueGroupingMaxWindow {
+				end = watermark.Add(issueGroupingMaxWindow)
+			}
+			if end.After(watermark) {
+				if count, err := s.groupIssues(ctx, watermark, end); err != nil {
+					log.Error("Failed to group issues", "from", watermark, "to", end, "error", err)
+				} else {
+					log.Debug("Grouped issues", "from", watermark, "to", end, "issues", count)
+					watermark = end
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Info("Stopped issue grouping")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// groupIssues adds the error spans that ended in [start, end) to their issues and returns the
+// number of issues that occurred
+func (s *TracingController) groupIssues(ctx context.Context, start, end time.Time) (int, error) {
+	indices, err := opensearch.GetIndicesForTimeRange(
+		start.Add(-traceSummaryMaxTraceDuration).UTC().Format(time.RFC3339), end.UTC().Format(time.RFC3339))
+	if err != nil {
+		return 0, fmt.Errorf("failed to generate indices: %w", err)
+	}
+
+	spans, truncated, err := s.osClient.SearchAllSpans(ctx, indices, opensearch.BuildIssueSpansQuery(start, end), opensearch.SortAsc, maxIssueSpansPerRun)
+	if err != nil {
+		return 0, fmt.Errorf("failed to search error spans: %w", err)
+	}
+	if truncated {
+		logger.GetLogger(ctx).Warn("Too many error spans to group, the rest are not counted",
+			"from", start, "to", end, "limit", maxIssueSpansPerRun)
+	}
+
+	issues := opensearch.GroupIssueOccurrences(spans)
+	now := time.Now()
+	updates := make([]opensearch.BulkUpdate, 0, len(issues))
+	for _, issue := range issues {
+		updates = append(updates, opensearch.IssueUpdate(issue, now))
+	}
+	if err := s.osClient.BulkUpdate(ctx, updates); err != nil {
+		return 0, fmt.Errorf("failed to write issues: %w", err)
+	}
+	return len(issues), nil
+}
+
+// ListIssues returns a page of issues, most recently seen first
+func (s *TracingController) ListIssues(ctx context.Context, params opensearch.IssueQueryParams) (*opensearch.IssueListResponse, error) {
+	response, err := s.osClient.Search(ctx, []string{opensearch.IssueIndex}, opensearch.BuildIssuesQuery(params))
+	if err != nil {
+		return nil, fmt.Errorf("failed to search issues: %w", err)
+	}
+	issues, err := opensearch.ParseIssues(response)
+	if err != nil {
+		return nil, err
+	}
+	return &opensearch.IssueListResponse{Issues: issues, TotalCount: response.Hits.Total.Value}, nil
+}
+
+// GetIssue returns an issue. It is read through a search, so that callers restricted to projects
+// cannot reach the issues of other projects.
+func (s *TracingController) GetIssue(ctx context.Context, issueID string) (*opensearch.Issue, error) {
+	response, err := s.osClient.Search(ctx, []string{opensearch.IssueIndex}, opensearch.BuildIssueQuery(issueID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to search issue: %w", err)
+	}
+	issues, err := opensearch.ParseIssues(response)
+	if err != nil {
+		return nil, err
+	}
+	if len(issues) == 0 {
+		return nil, ErrIssueNotFound
+	}
+	return &issues[0], nil
+}
+
+// UpdateIssueStatus sets the status of an issue, recording who changed it
+func (s *TracingController) UpdateIssueStatus(ctx context.Context, issueID string, status opensearch.IssueStatus, updatedBy string) (*opensearch.Issue, error) {
+	issue, err := s.GetIssue(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+	issue.Status = status
+	issue.StatusUpdatedAt = opensearch.FormatIssueTime(time.Now())
+	issue.StatusUpdatedBy = updatedBy
+
+	err = s.osClient.UpdateDocument(ctx, opensearch.IssueIndex, issue.ID, map[string]interface{}{
+		"status":          issue.Status,
+		"statusUpdatedAt": issue.StatusUpdatedAt,
+		"statusUpdatedBy": issue.StatusUpdatedBy,
+	})
+	if errors.Is(err, opensearch.ErrDocumentNotFound) {
+		return nil, ErrIssueNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update issue: %w", err)
+	}
+	logger.GetLogger(ctx).Info("Updated issue status", "issueId", issue.ID, "status", issue.Status)
+	return issue, nil
+}
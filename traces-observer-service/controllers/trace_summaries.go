@@ -0,0 +1,242 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/metrics"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/opensearch"
+)
+
+const (
+	// traceSummaryMaxWindow bounds the time range of ended spans rolled up in one run, so that a
+	// backfill catches up in steps
+	traceSummaryMaxWindow = time.Hour
+	// traceSummaryMaxTraceDuration is how long before its spans end a trace may have started; spans
+	// are searched in the indices of this period
+	traceSummaryMaxTraceDuration = 24 * time.Hour
+	// traceSummaryPageSize is the number of traces summarized together
+	traceSummaryPageSize = 500
+)
+
+// RunTraceSummaries rolls ended traces up into summary documents until ctx is done. Each run
+// summarizes the traces with spans that ended since the previous run, up to SettleSeconds ago, so
+// that a trace is summarized again, with all of its spans, whenever more of them end. A failed run
+// is retried with the same time range on the next tick.
+func (s *TracingController) RunTraceSummaries(ctx context.Context) {
+	log := logger.GetLogger(ctx)
+	interval := time.Duration(s.traceSummaries.IntervalSeconds) * time.Second
+	settle := time.Duration(s.traceSummaries.SettleSeconds) * time.Second
+	watermark := time.Now().Add(-time.Duration(s.traceSummaries.BackfillSeconds) * time.Second)
+
+	log.Info("Starting trace summary rollup", "interval", interval, "settle", settle, "from", watermark)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	templateReady := false
+	for {
+		if !templateReady {
+			if err := s.osClient.PutIndexTemplate(ctx, opensearch.TraceSummaryIndexTemplate, opensearch.TraceSummaryIndexTemplateSource()); err != nil {
+				log.Error("Failed to create the trace summary index template", "error", err)
+			} else {
+				templateReady = true
+			}
+		}
+
+		if templateReady {
+			end := time.Now().Add(-settle)
+			if end.Sub(watermark) > traceSummaryMaxWindow {
+				end = watermark.Add(traceSummaryMaxWindow)
+			}
+			if end.After(watermark) {
+				counters := &metrics.Counters{}
+				if count, err := s.rollupTraceSummaries(ctx, watermark, end, counters); err != nil {
+					log.Error("Failed to roll up trace summaries", "from", watermark, "to", end, "error", err)
+				} else {
+					log.Debug("Rolled up trace summaries", "from", watermark, "to", end, "summaries", count)
+					s.sendUsageMetrics(ctx, counters)
+					watermark = end
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Info("Stopped trace summary rollup")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// rollupTraceSummaries writes the summaries of the traces with spans that ended in [start, end) and
+// returns the number of summaries written. When usage metrics are enabled, the usage of the spans
+// is added to counters, which are only sent once the whole range has been rolled up so that a
+// retried range is not counted twice.
+func (s *TracingController) rollupTraceSummaries(ctx context.Context, start, end time.Time, counters *metrics.Counters) (int, error) {
+	indices, err := opensearch.GetIndicesForTimeRange(
+		start.Add(-traceSummaryMaxTraceDuration).UTC().Format(time.RFC3339), end.UTC().Format(time.RFC3339))
+	if err != nil {
+		return 0, fmt.Errorf("failed to generate indices: %w", err)
+	}
+
+	written := 0
+	var after map[string]interface{}
+	for {
+		response, err := s.osClient.Search(ctx, indices, opensearch.BuildEndedTracesQuery(start, end, traceSummaryPageSize, after))
+		if err != nil {
+			return written, fmt.Errorf("failed to search ended traces: %w", err)
+		}
+		var traceIDs []string
+		traceIDs, after, err = opensearch.ParseEndedTraces(response.Aggregations)
+		if err != nil {
+			return written, err
+		}
+		if len(traceIDs) == 0 {
+			return written, nil
+		}
+
+		traceSpans, err := s.fetchTraceOverviewSpans(ctx, indices, opensearch.TraceQueryParams{}, traceIDs)
+		if err != nil {
+			return written, err
+		}
+		var documents []opensearch.BulkDocument
+		for _, traceID := range traceIDs {
+			if s.usageMetrics != nil {
+				addUsageMetrics(counters, traceSpans[traceID], start, end)
+			}
+			for _, summary := range buildTraceSummaries(traceID, traceSpans[traceID]) {
+				startTime, err := time.Parse(time.RFC3339Nano, summary.StartTime)
+				if err != nil {
+					return written, fmt.Errorf("invalid start time of trace %s: %w", traceID, err)
+				}
+				documents = append(documents, opensearch.BulkDocument{
+					Index:  opensearch.TraceSummaryIndex(startTime),
+					ID:     traceID + ":" + summary.ComponentUid,
+					Source: summary,
+				})
+			}
+		}
+		if err := s.osClient.BulkIndex(ctx, documents); err != nil {
+			return written, fmt.Errorf("failed to write trace summaries: %w", err)
+		}
+		written += len(documents)
+
+		if after == nil {
+			return written, nil
+		}
+	}
+}
+
+// buildTraceSummaries builds a summary of a trace for each component it has spans of, in the same
+// way as the traces list of the component builds the trace from its spans
+func buildTraceSummaries(traceID string, spans []opensearch.Span) []opensearch.TraceSummary {
+	byComponent := make(map[string][]opensearch.Span)
+	var components []string
+	for _, span := range spans {
+		if _, ok := byComponent[span.Service]; !ok {
+			components = append(components, span.Service)
+		}
+		byComponent[span.Service] = append(byComponent[span.Service], span)
+	}
+
+	summaries := make([]opensearch.TraceSummary, 0, len(components))
+	for _, componentUid := range components {
+		componentSpans := byComponent[componentUid]
+		traceData := buildTraceData(traceID, componentSpans)
+		if traceData == nil {
+			continue
+		}
+		summary := opensearch.TraceSummary{
+			TraceOverview: toTraceOverview(traceData),
+			ComponentUid:  componentUid,
+			EstimatedCost: traceEstimatedCost(componentSpans),
+		}
+		summary.EnvironmentUid, _ = opensearch.GetString(componentSpans[0].Resource, "openchoreo.dev/environment-uid")
+		summary.ProjectUid, _ = opensearch.GetString(componentSpans[0].Resource, "openchoreo.dev/project-uid")
+		summaries = append(summaries, summary)
+	}
+	return summaries
+}
+
+// traceEstimatedCost sums the estimated cost of the LLM and embedding calls of a trace, which is
+// nil when no call has a known model price
+func traceEstimatedCost(spans []opensearch.Span) *float64 {
+	var total *float64
+	for _, span := range spans {
+		if span.AmpAttributes == nil {
+			continue
+		}
+		var cost *float64
+		switch data := span.AmpAttributes.Data.(type) {
+		case opensearch.LLMData:
+			cost = data.EstimatedCost
+		case opensearch.EmbeddingData:
+			cost = data.EstimatedCost
+		}
+		if cost == nil {
+			continue
+		}
+		if total == nil {
+			total = new(float64)
+		}
+		*total += *cost
+	}
+	return total
+}
+
+// getTraceSummaries reads a page of the traces list from the trace summaries
+func (s *TracingController) getTraceSummaries(ctx context.Context, params opensearch.TraceQueryParams) (*opensearch.TraceOverviewResponse, error) {
+	log := logger.GetLogger(ctx)
+
+	indices, err := opensearch.GetTraceSummaryIndicesForTimeRange(params.StartTime, params.EndTime)
+	if err != nil {
+		log.Error("Failed to generate summary indices for time range",
+			"startTime", params.StartTime,
+			"endTime", params.EndTime,
+			"error", err)
+		return nil, fmt.Errorf("failed to generate indices: %w", err)
+	}
+
+	response, err := s.osClient.Search(ctx, indices, opensearch.BuildTraceSummariesQuery(params))
+	if err != nil {
+		log.Error("OpenSearch query failed",
+			"indices", indices,
+			"component", params.ComponentUid,
+			"environment", params.EnvironmentUid,
+			"error", err)
+		return nil, fmt.Errorf("failed to search trace summaries: %w", err)
+	}
+	overviews, err := opensearch.ParseTraceSummaries(response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse trace summaries: %w", err)
+	}
+
+	log.Info("Retrieved trace overviews from summaries",
+		"traces", len(overviews),
+		"offset", params.Offset,
+		"total_count", response.Hits.Total.Value)
+
+	return &opensearch.TraceOverviewResponse{
+		Traces:     overviews,
+		TotalCount: response.Hits.Total.Value,
+	}, nil
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/metrics"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/opensearch"
+)
+
+// addUsageMetrics counts the traces, LLM and embedding calls, tokens, cost and durations of the
+// spans of a trace that ended in [start, end). A trace is rolled up again whenever more of its
+// spans end, so only the spans ending in the rolled up range are counted, each of them once.
+func addUsageMetrics(counters *metrics.Counters, spans []opensearch.Span, start, end time.Time) {
+	for _, span := range spans {
+		if span.EndTime.Before(start) || !span.EndTime.Before(end) {
+			continue
+		}
+		environmentUid, _ := opensearch.GetString(span.Resource, "openchoreo.dev/environment-uid")
+		projectUid, _ := opensearch.GetString(span.Resource, "openchoreo.dev/project-uid")
+		status := "ok"
+		if span.AmpAttributes != nil && span.AmpAttributes.Status != nil && span.AmpAttributes.Status.Error {
+			status = "error"
+		}
+		tags := []metrics.Tag{
+			{Key: "component", Value: span.Service},
+			{Key: "environment", Value: environmentUid},
+			{Key: "project", Value: projectUid},
+			{Key: "status", Value: status},
+		}
+		durationMillis := float64(span.DurationInNanos) / float64(time.Millisecond)
+
+		if span.ParentSpanID == "" {
+			counters.Add("traces", 1, tags...)
+			counters.Add("trace.duration_ms", durationMillis, tags...)
+		}
+		if span.AmpAttributes == nil {
+			continue
+		}
+
+		var model, vendor string
+		var usage *opensearch.LLMTokenUsage
+		var cost *float64
+		switch data := span.AmpAttributes.Data.(type) {
+		case opensearch.LLMData:
+			model, vendor, usage, cost = data.Model, data.Vendor, data.TokenUsage, data.EstimatedCost
+		case opensearch.EmbeddingData:
+			model, vendor, usage, cost = data.Model, data.Vendor, data.TokenUsage, data.EstimatedCost
+		default:
+			continue
+		}
+		callTags := append(tags,
+			metrics.Tag{Key: "kind", Value: span.AmpAttributes.Kind},
+			metrics.Tag{Key: "model", Value: model},
+			metrics.Tag{Key: "provider", Value: vendor},
+		)
+		counters.Add("llm.calls", 1, callTags...)
+		counters.Add("llm.duration_ms", durationMillis, callTags...)
+		if usage != nil {
+			counters.Add("llm.tokens.input", float64(usage.InputTokens), callTags...)
+			counters.Add("llm.tokens.output", float64(usage.OutputTokens), callTags...)
+		}
+		if cost != nil {
+			counters.Add("llm.cost", *cost, callTags...)
+		}
+	}
+}
+
+// sendUsageMetrics sends the counters of a rollup. Metrics are best effort: a failure is logged
+// and the rollup is not repeated for it.
+func (s *TracingController) sendUsageMetrics(ctx context.Context, counters *metrics.Counters) {
+	if s.usageMetrics == nil || counters.Len() == 0 {
+		return
+	}
+	if err := s.usageMetrics.Send(counters); err != nil {
+		logger.GetLogger(ctx).Warn("Failed to send usage metrics", "series", counters.Len(), "error", err)
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/controllers"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/middleware/auth"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/opensearch"
+)
+
+// maxAnnotationLength is the longest annotation text, in characters
+const maxAnnotationLength = 10000
+
+// AnnotationRequest represents the request body for creating or updating an annotation
+type AnnotationRequest struct {
+	SpanID string `json:"spanId,omitempty"`
+	Text   string `json:"text"`
+	// Author names the author when authentication is disabled; otherwise the subject of the token
+	// is the author
+	Author string `json:"author,omitempty"`
+}
+
+// AnnotationListResponse represents the annotations of a trace
+type AnnotationListResponse struct {
+	Annotations []opensearch.Annotation `json:"annotations"`
+	TotalCount  int                     `json:"totalCount"`
+}
+
+// CreateAnnotation handles POST /api/v1/traces/{traceId}/annotations
+func (h *Handler) CreateAnnotation(w http.ResponseWriter, r *http.Request) {
+	// Get logger from context
+	log := logger.GetLogger(r.Context())
+
+	traceID := r.PathValue("traceId")
+	req, ok := h.decodeAnnotationRequest(w, r)
+	if !ok {
+		return
+	}
+	author, authenticated := auth.SubjectFromContext(r.Context())
+	if !authenticated {
+		author = strings.TrimSpace(req.Author)
+	}
+
+	// Execute write
+	ctx := r.Context()
+	result, err := h.controllers.CreateAnnotation(ctx, opensearch.AnnotationParams{
+		TraceID: traceID,
+		SpanID:  req.SpanID,
+		Text:    req.Text,
+		Author:  author,
+	})
+	if err != nil {
+		if errors.Is(err, controllers.ErrTraceNotFound) {
+			h.writeError(w, http.StatusNotFound, "Trace not found")
+			return
+		}
+		log.Error("Failed to create annotation", "traceId", traceID, "error", err)
+		h.writeServerError(w, err, "Failed to create annotation")
+		return
+	}
+
+	// Write response
+	h.writeJSON(w, http.StatusCreated, result)
+}
+
+// ListAnnotations handles GET /api/v1/traces/{traceId}/annotations
+func (h *Handler) ListAnnotations(w http.ResponseWriter, r *http.Request) {
+	// Get logger from context
+	log := logger.GetLogger(r.Context())
+
+	traceID := r.PathValue("traceId")
+
+	// Execute query
+	ctx := r.Context()
+	annotations, err := h.controllers.ListAnnotations(ctx, traceID)
+	if err != nil {
+		log.Error("Failed to list annotations", "traceId", traceID, "error", err)
+		h.writeServerError(w, err, "Failed to retrieve annotations")
+		return
+	}
+
+	// Write response
+	h.writeJSON(w, http.StatusOK, AnnotationListResponse{Annotations: annotations, TotalCount: len(annotations)})
+}
+
+// UpdateAnnotation handles PUT /api/v1/traces/{traceId}/annotations/{annotationId}
+func (h *Handler) UpdateAnnotation(w http.ResponseWriter, r *http.Request) {
+	// Get logger from context
+	log := logger.GetLogger(r.Context())
+
+	traceID := r.PathValue("traceId")
+	annotationID := r.PathValue("annotationId")
+	req, ok := h.decodeAnnotationRequest(w, r)
+	if !ok {
+		return
+	}
+	author, _ := auth.SubjectFromContext(r.Context())
+
+	// Execute write
+	ctx := r.Context()
+	result, err := h.controllers.UpdateAnnotation(ctx, traceID, annotationID, req.Text, author)
+	if err != nil {
+		if h.writeAnnotationError(w, err) {
+			return
+		}
+		log.Error("Failed to update annotation", "traceId", traceID, "annotationId", annotationID, "error", err)
+		h.writeServerError(w, err, "Failed to update annotation")
+		return
+	}
+
+	// Write response
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// DeleteAnnotation handles DELETE /api/v1/traces/{traceId}/annotations/{annotationId}
+func (h *Handler) DeleteAnnotation(w http.ResponseWriter, r *http.Request) {
+	// Get logger from context
+	log := logger.GetLogger(r.Context())
+
+	traceID := r.PathValue("traceId")
+	annotationID := r.PathValue("annotationId")
+	author, _ := auth.SubjectFromContext(r.Context())
+
+	// Execute delete
+	ctx := r.Context()
+	if err := h.controllers.DeleteAnnotation(ctx, traceID, annotationID, author); err != nil {
+		if h.writeAnnotationError(w, err) {
+			return
+		}
+		log.Error("Failed to delete annotation", "traceId", traceID, "annotationId", annotationID, "error", err)
+		h.writeServerError(w, err, "Failed to delete annotation")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// decodeAnnotationRequest reads and validates the body of an annotation request, writing an error
+// response when it is invalid
+func (h *Handler) decodeAnnotationRequest(w http.ResponseWriter, r *http.Request) (AnnotationRequest, bool) {
+	var req AnnotationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid request body")
+		return req, false
+	}
+	if strings.TrimSpace(req.Text) == "" {
+		h.writeError(w, http.StatusBadRequest, "text is required")
+		return req, false
+	}
+	if utf8.RuneCountInString(req.Text) > maxAnnotationLength {
+		h.writeError(w, http.StatusBadRequest, fmt.Sprintf("text must be at most %d characters", maxAnnotationLength))
+		return req, false
+	}
+	return req, true
+}
+
+// writeAnnotationError writes the response of the errors of changing an annotation that are not
+// server errors, and returns whether it did
+func (h *Handler) writeAnnotationError(w http.ResponseWriter, err error) bool {
+	switch {
+	case errors.Is(err, controllers.ErrAnnotationNotFound):
+		h.writeError(w, http.StatusNotFound, "Annotation not found")
+	case errors.Is(err, controllers.ErrNotAnnotationAuthor):
+		h.writeError(w, http.StatusForbidden, "Only the author of an annotation can change it")
+	default:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package handlers
+
+import "net/http"
+
+// Error codes of error responses. These are the generic codes of the agent manager service, so
+// that clients of both services branch on the same codes.
+const (
+	ErrorCodeBadRequest         = "BAD_REQUEST"
+	ErrorCodeUnauthorized       = "UNAUTHORIZED"
+	ErrorCodeForbidden          = "FORBIDDEN"
+	ErrorCodeNotFound           = "NOT_FOUND"
+	ErrorCodeMethodNotAllowed   = "METHOD_NOT_ALLOWED"
+	ErrorCodeConflict           = "CONFLICT"
+	ErrorCodeUnprocessable      = "UNPROCESSABLE_ENTITY"
+	ErrorCodeRateLimited        = "RATE_LIMITED"
+	ErrorCodeInternal           = "INTERNAL_ERROR"
+	ErrorCodeNotImplemented     = "NOT_IMPLEMENTED"
+	ErrorCodeUpstream           = "UPSTREAM_ERROR"
+	ErrorCodeServiceUnavailable = "SERVICE_UNAVAILABLE"
+)
+
+// ErrorCodeForStatus returns the error code of an HTTP status
+func ErrorCodeForStatus(status int) string {
+	switch status {
+	case http.StatusBadRequest:
+		return ErrorCodeBadRequest
+	case http.StatusUnauthorized:
+		return ErrorCodeUnauthorized
+	case http.StatusForbidden:
+		return ErrorCodeForbidden
+	case http.StatusNotFound:
+		return ErrorCodeNotFound
+	case http.StatusMethodNotAllowed:
+		return ErrorCodeMethodNotAllowed
+	case http.StatusConflict:
+		return ErrorCodeConflict
+	case http.StatusUnprocessableEntity:
+		return ErrorCodeUnprocessable
+	case http.StatusTooManyRequests:
+		return ErrorCodeRateLimited
+	case http.StatusNotImplemented:
+		return ErrorCodeNotImplemented
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return ErrorCodeUpstream
+	case http.StatusServiceUnavailable:
+		return ErrorCodeServiceUnavailable
+	}
+	if status >= http.StatusInternalServerError {
+		return ErrorCodeInternal
+	}
+	return ErrorCodeBadRequest
+}
@@ -0,0 +1,251 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/opensearch"
+)
+
+// These handlers implement the API of the Grafana JSON datasource plugin
+// (simpod-json-datasource), including the /search endpoint of its SimpleJSON predecessor.
+// Each query target names a metric and selects the spans through its payload.
+
+const (
+	// minGrafanaInterval is the smallest interval of a time series
+	minGrafanaInterval = time.Second
+	// maxGrafanaDataPoints bounds the points of a series when Grafana does not send maxDataPoints
+	maxGrafanaDataPoints = 10000
+)
+
+// GrafanaMetric describes a metric to the metric picker of the datasource
+type GrafanaMetric struct {
+	Label    string                 `json:"label"`
+	Value    string                 `json:"value"`
+	Payloads []GrafanaMetricPayload `json:"payloads"`
+}
+
+// GrafanaMetricPayload describes a payload field a query target can set
+type GrafanaMetricPayload struct {
+	Label       string `json:"label"`
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Placeholder string `json:"placeholder,omitempty"`
+}
+
+// GrafanaQueryRequest is the body of a datasource query
+type GrafanaQueryRequest struct {
+	Range struct {
+		From string `json:"from"`
+		To   string `json:"to"`
+	} `json:"range"`
+	IntervalMs    int64                `json:"intervalMs"`
+	MaxDataPoints int64                `json:"maxDataPoints"`
+	Targets       []GrafanaQueryTarget `json:"targets"`
+}
+
+// GrafanaQueryTarget is a metric requested by a panel. The SimpleJSON plugin sends the payload as data.
+type GrafanaQueryTarget struct {
+	RefID   string                `json:"refId"`
+	Target  string                `json:"target"`
+	Hide    bool                  `json:"hide,omitempty"`
+	Payload *GrafanaTargetPayload `json:"payload,omitempty"`
+	Data    *GrafanaTargetPayload `json:"data,omitempty"`
+}
+
+// GrafanaTargetPayload selects the spans a metric is computed from
+type GrafanaTargetPayload struct {
+	EnvironmentUid string `json:"environmentUid"`
+	// ComponentUids is a comma separated list; all components of the environment are included when empty
+	ComponentUids string `json:"componentUids,omitempty"`
+}
+
+// GrafanaTimeSeries is a series of [value, unix milliseconds] points
+type GrafanaTimeSeries struct {
+	Target     string           `json:"target"`
+	RefID      string           `json:"refId,omitempty"`
+	Datapoints [][2]interface{} `json:"datapoints"`
+}
+
+var grafanaMetricPayloads = []GrafanaMetricPayload{
+	{Label: "Environment UID", Name: "environmentUid", Type: "input"},
+	{Label: "Component UIDs", Name: "componentUids", Type: "input", Placeholder: "All components of the environment"},
+}
+
+// GrafanaHealth handles GET /api/grafana/, which the datasource calls to test the connection
+func (h *Handler) GrafanaHealth(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+}
+
+// GrafanaMetrics handles POST /api/grafana/metrics
+func (h *Handler) GrafanaMetrics(w http.ResponseWriter, r *http.Request) {
+	metrics := make([]GrafanaMetric, 0, len(opensearch.TimeSeriesMetrics))
+	for _, metric := range opensearch.TimeSeriesMetrics {
+		metrics = append(metrics, GrafanaMetric{
+			Label:    metric.Description,
+			Value:    metric.Name,
+			Payloads: grafanaMetricPayloads,
+		})
+	}
+	h.writeJSON(w, http.StatusOK, metrics)
+}
+
+// GrafanaMetricPayloadOptions handles POST /api/grafana/metric-payload-options. All payloads are
+// free text inputs, so there are no options to offer.
+func (h *Handler) GrafanaMetricPayloadOptions(w http.ResponseWriter, r *http.Request) {
+	h.writeJSON(w, http.StatusOK, []struct{}{})
+}
+
+// GrafanaSearch handles POST /api/grafana/search of the SimpleJSON datasource
+func (h *Handler) GrafanaSearch(w http.ResponseWriter, r *http.Request) {
+	names := make([]string, 0, len(opensearch.TimeSeriesMetrics))
+	for _, metric := range opensearch.TimeSeriesMetrics {
+		names = append(names, metric.Name)
+	}
+	h.writeJSON(w, http.StatusOK, names)
+}
+
+// GrafanaQuery handles POST /api/grafana/query
+func (h *Handler) GrafanaQuery(w http.ResponseWriter, r *http.Request) {
+	// Get logger from context
+	log := logger.GetLogger(r.Context())
+
+	var req GrafanaQueryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	from, err := time.Parse(time.RFC3339, req.Range.From)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "range.from must be in RFC3339 format")
+		return
+	}
+	to, err := time.Parse(time.RFC3339, req.Range.To)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "range.to must be in RFC3339 format")
+		return
+	}
+	if !to.After(from) {
+		h.writeError(w, http.StatusBadRequest, "range.from must be before range.to")
+		return
+	}
+	interval := grafanaInterval(to.Sub(from), req.IntervalMs, req.MaxDataPoints)
+
+	// Targets selecting the same spans share one query
+	type spanSelection struct {
+		environmentUid string
+		componentUids  string
+	}
+	var selections []spanSelection
+	metricsBySelection := make(map[spanSelection][]GrafanaQueryTarget)
+	for _, target := range req.Targets {
+		if target.Hide || target.Target == "" {
+			continue
+		}
+		if !opensearch.IsTimeSeriesMetric(target.Target) {
+			h.writeError(w, http.StatusBadRequest, fmt.Sprintf("unknown metric: %s", target.Target))
+			return
+		}
+		payload := target.Payload
+		if payload == nil {
+			payload = target.Data
+		}
+		if payload == nil || payload.EnvironmentUid == "" {
+			h.writeError(w, http.StatusBadRequest, fmt.Sprintf("environmentUid is required in the payload of %s", target.Target))
+			return
+		}
+		selection := spanSelection{environmentUid: payload.EnvironmentUid, componentUids: payload.ComponentUids}
+		if _, ok := metricsBySelection[selection]; !ok {
+			selections = append(selections, selection)
+		}
+		metricsBySelection[selection] = append(metricsBySelection[selection], target)
+	}
+
+	ctx := r.Context()
+	result := []GrafanaTimeSeries{}
+	for _, selection := range selections {
+		targets := metricsBySelection[selection]
+		metrics := make([]string, 0, len(targets))
+		for _, target := range targets {
+			metrics = append(metrics, target.Target)
+		}
+
+		var componentUids []string
+		for _, uid := range strings.Split(selection.componentUids, ",") {
+			if uid = strings.TrimSpace(uid); uid != "" {
+				componentUids = append(componentUids, uid)
+			}
+		}
+
+		response, err := h.controllers.GetTimeSeries(ctx, opensearch.TimeSeriesParams{
+			ComponentUids:  componentUids,
+			EnvironmentUid: selection.environmentUid,
+			From:           from,
+			To:             to,
+			Interval:       interval,
+			Metrics:        metrics,
+		})
+		if err != nil {
+			log.Error("Failed to get time series", "error", err)
+			h.writeServerError(w, err, "Failed to retrieve time series")
+			return
+		}
+
+		for i, series := range response.Series {
+			datapoints := make([][2]interface{}, 0, len(series.Points))
+			for _, point := range series.Points {
+				var value interface{}
+				if point.Value != nil {
+					value = *point.Value
+				}
+				datapoints = append(datapoints, [2]interface{}{value, point.Time.UnixMilli()})
+			}
+			result = append(result, GrafanaTimeSeries{
+				Target:     series.Metric,
+				RefID:      targets[i].RefID,
+				Datapoints: datapoints,
+			})
+		}
+	}
+
+	// Write response
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// grafanaInterval returns the interval Grafana asked for, widened to at least a second and so that
+// the series has no more points than requested
+func grafanaInterval(window time.Duration, intervalMs, maxDataPoints int64) time.Duration {
+	interval := max(time.Duration(intervalMs)*time.Millisecond, minGrafanaInterval)
+	if maxDataPoints <= 0 || maxDataPoints > maxGrafanaDataPoints {
+		maxDataPoints = maxGrafanaDataPoints
+	}
+	if minInterval := time.Duration(math.Ceil(float64(window) / float64(maxDataPoints))); interval < minInterval {
+		interval = minInterval.Round(time.Second)
+		if interval < minInterval {
+			interval += time.Second
+		}
+	}
+	return interval
+}
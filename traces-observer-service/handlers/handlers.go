@@ -17,15 +17,20 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
+	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/wso2/ai-agent-management-platform/traces-observer-service/controllers"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/logs"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/middleware"
 	"github.com/wso2/ai-agent-management-platform/traces-observer-service/middleware/logger"
 	"github.com/wso2/ai-agent-management-platform/traces-observer-service/opensearch"
 )
@@ -61,10 +66,43 @@ type TraceByIdAndServiceRequest struct {
 	Limit          int    `json:"limit,omitempty"`
 }
 
-// ErrorResponse represents an error response
+// ErrorResponse represents an error response. Code is one of the error codes shared with the
+// agent manager service and CorrelationID matches the error with the server logs.
 type ErrorResponse struct {
-	Error   string `json:"error"`
-	Message string `json:"message"`
+	Error         string `json:"error"`
+	Code          string `json:"code"`
+	Message       string `json:"message"`
+	CorrelationID string `json:"correlationId,omitempty"`
+}
+
+// defaultAggregationWindow is the time range aggregated by the tool catalog, model usage, prompt
+// analytics, attribute and volume endpoints when none is given
+const defaultAggregationWindow = 7 * 24 * time.Hour
+
+// maxVolumeWindow bounds the time range of the volume endpoint, which reports one entry per day
+const maxVolumeWindow = 90 * 24 * time.Hour
+
+// Number of prompts listed in each ranking of the prompt analytics endpoint
+const (
+	defaultPromptAnalyticsLimit = 10
+	maxPromptAnalyticsLimit     = 100
+)
+
+// readinessCheckTimeout bounds the time spent probing dependencies in /readyz
+const readinessCheckTimeout = 5 * time.Second
+
+// DependencyStatus represents the readiness of a single dependency
+type DependencyStatus struct {
+	Status    string `json:"status"`
+	LatencyMs int64  `json:"latencyMs"`
+	Error     string `json:"error,omitempty"`
+}
+
+// ReadinessResponse represents the response of the readiness probe
+type ReadinessResponse struct {
+	Status       string                      `json:"status"`
+	Dependencies map[string]DependencyStatus `json:"dependencies"`
+	Timestamp    string                      `json:"timestamp"`
 }
 
 // GetTraceOverviews handles GET /api/traces with query parameters
@@ -138,7 +176,7 @@ func (h *Handler) GetTraceOverviews(w http.ResponseWriter, r *http.Request) {
 	result, err := h.controllers.GetTraceOverviews(ctx, params)
 	if err != nil {
 		log.Error("Failed to get trace overviews", "error", err)
-		h.writeError(w, http.StatusInternalServerError, "Failed to retrieve trace overviews")
+		h.writeServerError(w, err, "Failed to retrieve trace overviews")
 		return
 	}
 
@@ -213,7 +251,145 @@ func (h *Handler) GetTraceByIdAndService(w http.ResponseWriter, r *http.Request)
 		}
 		// Other errors are internal server errors
 		log.Error("Failed to get trace by ID and service", "error", err)
-		h.writeError(w, http.StatusInternalServerError, "Failed to retrieve traces")
+		h.writeServerError(w, err, "Failed to retrieve traces")
+		return
+	}
+
+	// Write response
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// GetSessionConversation handles GET /api/v1/sessions/{id}/conversation with query parameters
+func (h *Handler) GetSessionConversation(w http.ResponseWriter, r *http.Request) {
+	// Get logger from context
+	log := logger.GetLogger(r.Context())
+
+	// Parse query parameters
+	query := r.URL.Query()
+	startTime, endTime := aggregationWindow(query.Get("startTime"), query.Get("endTime"))
+
+	params := opensearch.SessionConversationParams{
+		SessionID:      r.PathValue("id"),
+		ComponentUid:   query.Get("componentUid"),
+		EnvironmentUid: query.Get("environmentUid"),
+		StartTime:      startTime,
+		EndTime:        endTime,
+	}
+
+	// Execute query
+	ctx := r.Context()
+	result, err := h.controllers.GetSessionConversation(ctx, params)
+	if err != nil {
+		if errors.Is(err, controllers.ErrSessionNotFound) {
+			h.writeError(w, http.StatusNotFound, "Session not found")
+			return
+		}
+		log.Error("Failed to get session conversation", "error", err)
+		h.writeServerError(w, err, "Failed to retrieve session conversation")
+		return
+	}
+
+	// Write response
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// GetTraceLogs handles GET /api/v1/trace/logs with query parameters
+func (h *Handler) GetTraceLogs(w http.ResponseWriter, r *http.Request) {
+	// Get logger from context
+	log := logger.GetLogger(r.Context())
+
+	// Parse query parameters
+	query := r.URL.Query()
+
+	traceID := query.Get("traceId")
+	if traceID == "" {
+		h.writeError(w, http.StatusBadRequest, "traceId is required")
+		return
+	}
+
+	componentUid := query.Get("componentUid")
+	if componentUid == "" {
+		h.writeError(w, http.StatusBadRequest, "componentUid is required")
+		return
+	}
+
+	environmentUid := query.Get("environmentUid")
+	if environmentUid == "" {
+		h.writeError(w, http.StatusBadRequest, "environmentUid is required")
+		return
+	}
+
+	// Parse limit (default: the configured maximum)
+	limit := 0
+	if limitStr := query.Get("limit"); limitStr != "" {
+		parsedLimit, err := strconv.Atoi(limitStr)
+		if err != nil || parsedLimit <= 0 {
+			h.writeError(w, http.StatusBadRequest, "limit must be a positive integer")
+			return
+		}
+		limit = parsedLimit
+	}
+
+	params := logs.TraceLogsParams{
+		TraceID:        traceID,
+		SpanID:         query.Get("spanId"),
+		ComponentUid:   componentUid,
+		EnvironmentUid: environmentUid,
+		Limit:          limit,
+	}
+
+	// Execute query
+	result, err := h.controllers.GetTraceLogs(r.Context(), params)
+	if err != nil {
+		switch {
+		case errors.Is(err, controllers.ErrLogsNotConfigured):
+			h.writeError(w, http.StatusNotImplemented, "Log correlation is not configured")
+		case errors.Is(err, controllers.ErrTraceNotFound):
+			h.writeError(w, http.StatusNotFound, "Trace not found")
+		default:
+			log.Error("Failed to get trace logs", "error", err)
+			h.writeServerError(w, err, "Failed to retrieve logs")
+		}
+		return
+	}
+
+	// Write response
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// GetFederatedTrace handles GET /api/v1/trace/federated with query parameters
+func (h *Handler) GetFederatedTrace(w http.ResponseWriter, r *http.Request) {
+	// Get logger from context
+	log := logger.GetLogger(r.Context())
+
+	// Parse query parameters
+	query := r.URL.Query()
+
+	traceID := query.Get("traceId")
+	if traceID == "" {
+		h.writeError(w, http.StatusBadRequest, "traceId is required")
+		return
+	}
+
+	environmentUid := query.Get("environmentUid")
+	if environmentUid == "" {
+		h.writeError(w, http.StatusBadRequest, "environmentUid is required")
+		return
+	}
+
+	// Execute query
+	ctx := r.Context()
+	result, err := h.controllers.GetFederatedTrace(ctx, opensearch.FederatedTraceParams{
+		TraceID:        traceID,
+		EnvironmentUid: environmentUid,
+	})
+	if err != nil {
+		if errors.Is(err, controllers.ErrTraceNotFound) {
+			h.writeError(w, http.StatusNotFound, "Trace not found")
+			return
+		}
+		log.Error("Failed to get federated trace", "error", err)
+		h.writeServerError(w, err, "Failed to retrieve trace")
 		return
 	}
 
@@ -296,7 +472,7 @@ func (h *Handler) ExportTraces(w http.ResponseWriter, r *http.Request) {
 	result, err := h.controllers.ExportTraces(ctx, params)
 	if err != nil {
 		log.Error("Failed to export traces", "error", err)
-		h.writeError(w, http.StatusInternalServerError, "Failed to export traces")
+		h.writeServerError(w, err, "Failed to export traces")
 		return
 	}
 
@@ -312,6 +488,417 @@ func (h *Handler) ExportTraces(w http.ResponseWriter, r *http.Request) {
 	h.writeJSON(w, http.StatusOK, result)
 }
 
+// GetToolCatalog handles GET /api/v1/tools with query parameters
+func (h *Handler) GetToolCatalog(w http.ResponseWriter, r *http.Request) {
+	// Get logger from context
+	log := logger.GetLogger(r.Context())
+
+	// Parse query parameters
+	query := r.URL.Query()
+
+	environmentUid := query.Get("environmentUid")
+	if environmentUid == "" {
+		h.writeError(w, http.StatusBadRequest, "environmentUid is required")
+		return
+	}
+
+	startTime, endTime := aggregationWindow(query.Get("startTime"), query.Get("endTime"))
+
+	// Build query parameters
+	params := opensearch.ToolCatalogParams{
+		ComponentUid:   query.Get("componentUid"),
+		EnvironmentUid: environmentUid,
+		StartTime:      startTime,
+		EndTime:        endTime,
+	}
+
+	// Execute query
+	ctx := r.Context()
+	result, err := h.controllers.GetToolCatalog(ctx, params)
+	if err != nil {
+		log.Error("Failed to get tool catalog", "error", err)
+		h.writeServerError(w, err, "Failed to retrieve tool catalog")
+		return
+	}
+
+	// Write response
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// GetModelUsage handles GET /api/v1/models/usage with query parameters
+func (h *Handler) GetModelUsage(w http.ResponseWriter, r *http.Request) {
+	// Get logger from context
+	log := logger.GetLogger(r.Context())
+
+	// Parse query parameters
+	query := r.URL.Query()
+
+	environmentUid := query.Get("environmentUid")
+	if environmentUid == "" {
+		h.writeError(w, http.StatusBadRequest, "environmentUid is required")
+		return
+	}
+
+	var componentUids []string
+	for _, uid := range strings.Split(query.Get("componentUids"), ",") {
+		if uid = strings.TrimSpace(uid); uid != "" {
+			componentUids = append(componentUids, uid)
+		}
+	}
+
+	startTime, endTime := aggregationWindow(query.Get("startTime"), query.Get("endTime"))
+
+	// Execute query
+	ctx := r.Context()
+	result, err := h.controllers.GetModelUsage(ctx, opensearch.ModelUsageParams{
+		ComponentUids:  componentUids,
+		EnvironmentUid: environmentUid,
+		StartTime:      startTime,
+		EndTime:        endTime,
+	})
+	if err != nil {
+		log.Error("Failed to get model usage", "error", err)
+		h.writeServerError(w, err, "Failed to retrieve model usage")
+		return
+	}
+
+	// Write response
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// GetPromptAnalytics handles GET /api/v1/analytics/prompts with query parameters
+func (h *Handler) GetPromptAnalytics(w http.ResponseWriter, r *http.Request) {
+	// Get logger from context
+	log := logger.GetLogger(r.Context())
+
+	// Parse query parameters
+	query := r.URL.Query()
+
+	environmentUid := query.Get("environmentUid")
+	if environmentUid == "" {
+		h.writeError(w, http.StatusBadRequest, "environmentUid is required")
+		return
+	}
+
+	var componentUids []string
+	for _, uid := range strings.Split(query.Get("componentUids"), ",") {
+		if uid = strings.TrimSpace(uid); uid != "" {
+			componentUids = append(componentUids, uid)
+		}
+	}
+
+	limit := defaultPromptAnalyticsLimit
+	if limitStr := query.Get("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil || parsed < 1 || parsed > maxPromptAnalyticsLimit {
+			h.writeError(w, http.StatusBadRequest, fmt.Sprintf("limit must be between 1 and %d", maxPromptAnalyticsLimit))
+			return
+		}
+		limit = parsed
+	}
+
+	startTime, endTime := aggregationWindow(query.Get("startTime"), query.Get("endTime"))
+
+	// Execute query
+	ctx := r.Context()
+	result, err := h.controllers.GetPromptAnalytics(ctx, opensearch.PromptAnalyticsParams{
+		ComponentUids:  componentUids,
+		EnvironmentUid: environmentUid,
+		StartTime:      startTime,
+		EndTime:        endTime,
+		Limit:          limit,
+	})
+	if err != nil {
+		log.Error("Failed to get prompt analytics", "error", err)
+		h.writeServerError(w, err, "Failed to retrieve prompt analytics")
+		return
+	}
+
+	// Write response
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// SpanDeletionRequest represents the request body for deleting spans past their retention period
+type SpanDeletionRequest struct {
+	ComponentUids []string `json:"componentUids"`
+	Before        string   `json:"before"`
+	ErrorsBefore  string   `json:"errorsBefore,omitempty"`
+}
+
+// DeleteSpans handles POST /api/v1/spans/delete
+func (h *Handler) DeleteSpans(w http.ResponseWriter, r *http.Request) {
+	// Get logger from context
+	log := logger.GetLogger(r.Context())
+
+	var req SpanDeletionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Without a component filter the spans of every organization would be deleted
+	if len(req.ComponentUids) == 0 {
+		h.writeError(w, http.StatusBadRequest, "componentUids is required")
+		return
+	}
+
+	before, err := time.Parse(time.RFC3339, req.Before)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "before must be an RFC3339 time")
+		return
+	}
+	if req.ErrorsBefore != "" {
+		errorsBefore, err := time.Parse(time.RFC3339, req.ErrorsBefore)
+		if err != nil {
+			h.writeError(w, http.StatusBadRequest, "errorsBefore must be an RFC3339 time")
+			return
+		}
+		if !errorsBefore.Before(before) {
+			h.writeError(w, http.StatusBadRequest, "errorsBefore must be earlier than before")
+			return
+		}
+	}
+
+	// Execute deletion
+	ctx := r.Context()
+	result, err := h.controllers.DeleteSpans(ctx, opensearch.SpanDeletionParams{
+		ComponentUids: req.ComponentUids,
+		Before:        req.Before,
+		ErrorsBefore:  req.ErrorsBefore,
+	})
+	if err != nil {
+		log.Error("Failed to delete spans", "error", err)
+		h.writeServerError(w, err, "Failed to delete spans")
+		return
+	}
+
+	// Write response
+	h.writeJSON(w, http.StatusAccepted, result)
+}
+
+// SpanErasureRequest represents the request body for erasing the spans of a personal identifier
+type SpanErasureRequest struct {
+	ComponentUids []string `json:"componentUids"`
+	Attribute     string   `json:"attribute,omitempty"`
+	Value         string   `json:"value"`
+}
+
+// defaultErasureAttribute is the OpenTelemetry attribute identifying the end user
+const defaultErasureAttribute = "enduser.id"
+
+// attributeNamePattern restricts erasure attributes to plain attribute names, so that the field
+// path cannot be widened with wildcards
+var attributeNamePattern = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9_.-]*$`)
+
+// EraseSpans handles POST /api/v1/spans/erase
+func (h *Handler) EraseSpans(w http.ResponseWriter, r *http.Request) {
+	// Get logger from context
+	log := logger.GetLogger(r.Context())
+
+	var req SpanErasureRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Without a component filter the spans of every organization would be searched
+	if len(req.ComponentUids) == 0 {
+		h.writeError(w, http.StatusBadRequest, "componentUids is required")
+		return
+	}
+	if strings.TrimSpace(req.Value) == "" {
+		h.writeError(w, http.StatusBadRequest, "value is required")
+		return
+	}
+	if req.Attribute == "" {
+		req.Attribute = defaultErasureAttribute
+	}
+	if !attributeNamePattern.MatchString(req.Attribute) {
+		h.writeError(w, http.StatusBadRequest, "attribute must be a span attribute name")
+		return
+	}
+
+	// Execute erasure
+	ctx := r.Context()
+	result, err := h.controllers.EraseSpans(ctx, opensearch.SpanErasureParams{
+		ComponentUids: req.ComponentUids,
+		Attribute:     req.Attribute,
+		Value:         req.Value,
+	})
+	if err != nil {
+		log.Error("Failed to erase spans", "error", err)
+		h.writeServerError(w, err, "Failed to erase spans")
+		return
+	}
+
+	// Write response
+	h.writeJSON(w, http.StatusAccepted, result)
+}
+
+// GetTask handles GET /api/v1/tasks/{taskId}
+func (h *Handler) GetTask(w http.ResponseWriter, r *http.Request) {
+	// Get logger from context
+	log := logger.GetLogger(r.Context())
+
+	taskID := r.PathValue("taskId")
+
+	// Execute query
+	ctx := r.Context()
+	result, err := h.controllers.GetTask(ctx, taskID)
+	if err != nil {
+		if errors.Is(err, opensearch.ErrTaskNotFound) {
+			h.writeError(w, http.StatusNotFound, "Task not found")
+			return
+		}
+		log.Error("Failed to get task", "taskId", taskID, "error", err)
+		h.writeServerError(w, err, "Failed to retrieve task")
+		return
+	}
+
+	// Write response
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// GetAttributeStats handles GET /api/v1/attributes with query parameters
+func (h *Handler) GetAttributeStats(w http.ResponseWriter, r *http.Request) {
+	// Get logger from context
+	log := logger.GetLogger(r.Context())
+
+	// Parse query parameters
+	query := r.URL.Query()
+
+	componentUid := query.Get("componentUid")
+	if componentUid == "" {
+		h.writeError(w, http.StatusBadRequest, "componentUid is required")
+		return
+	}
+
+	examples := controllers.DefaultAttributeExamples
+	if examplesStr := query.Get("examples"); examplesStr != "" {
+		parsedExamples, err := strconv.Atoi(examplesStr)
+		if err != nil || parsedExamples <= 0 || parsedExamples > controllers.MaxAttributeExamples {
+			h.writeError(w, http.StatusBadRequest, fmt.Sprintf("examples must be between 1 and %d", controllers.MaxAttributeExamples))
+			return
+		}
+		examples = parsedExamples
+	}
+
+	startTime, endTime := aggregationWindow(query.Get("startTime"), query.Get("endTime"))
+
+	// Build query parameters
+	params := opensearch.AttributeStatsParams{
+		ComponentUid:   componentUid,
+		EnvironmentUid: query.Get("environmentUid"),
+		StartTime:      startTime,
+		EndTime:        endTime,
+		Prefix:         query.Get("prefix"),
+		Examples:       examples,
+	}
+
+	// Execute query
+	ctx := r.Context()
+	result, err := h.controllers.GetAttributeStats(ctx, params)
+	if err != nil {
+		log.Error("Failed to get attribute stats", "error", err)
+		h.writeServerError(w, err, "Failed to retrieve attributes")
+		return
+	}
+
+	// Write response
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// GetStorageUsage handles GET /api/v1/storage with query parameters
+func (h *Handler) GetStorageUsage(w http.ResponseWriter, r *http.Request) {
+	// Get logger from context
+	log := logger.GetLogger(r.Context())
+
+	var componentUids []string
+	for _, uid := range strings.Split(r.URL.Query().Get("componentUids"), ",") {
+		if uid = strings.TrimSpace(uid); uid != "" {
+			componentUids = append(componentUids, uid)
+		}
+	}
+	if len(componentUids) == 0 {
+		h.writeError(w, http.StatusBadRequest, "componentUids is required")
+		return
+	}
+
+	// Execute query
+	ctx := r.Context()
+	result, err := h.controllers.GetStorageUsage(ctx, componentUids)
+	if err != nil {
+		log.Error("Failed to get storage usage", "error", err)
+		h.writeServerError(w, err, "Failed to retrieve storage usage")
+		return
+	}
+
+	// Write response
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// GetVolume handles GET /api/v1/analytics/volume with query parameters
+func (h *Handler) GetVolume(w http.ResponseWriter, r *http.Request) {
+	// Get logger from context
+	log := logger.GetLogger(r.Context())
+
+	// Parse query parameters
+	query := r.URL.Query()
+
+	var componentUids []string
+	for _, uid := range strings.Split(query.Get("componentUids"), ",") {
+		if uid = strings.TrimSpace(uid); uid != "" {
+			componentUids = append(componentUids, uid)
+		}
+	}
+
+	startParam, endParam := aggregationWindow(query.Get("startTime"), query.Get("endTime"))
+	startTime, err := time.Parse(time.RFC3339, startParam)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "startTime must be in RFC3339 format")
+		return
+	}
+	endTime, err := time.Parse(time.RFC3339, endParam)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, "endTime must be in RFC3339 format")
+		return
+	}
+	if !endTime.After(startTime) {
+		h.writeError(w, http.StatusBadRequest, "endTime must be after startTime")
+		return
+	}
+	if endTime.Sub(startTime) > maxVolumeWindow {
+		h.writeError(w, http.StatusBadRequest, "time range must not exceed 90 days")
+		return
+	}
+
+	// Execute query
+	ctx := r.Context()
+	result, err := h.controllers.GetVolume(ctx, opensearch.VolumeParams{
+		ComponentUids:  componentUids,
+		EnvironmentUid: query.Get("environmentUid"),
+		StartTime:      startTime,
+		EndTime:        endTime,
+	})
+	if err != nil {
+		log.Error("Failed to get ingest volume", "error", err)
+		h.writeServerError(w, err, "Failed to retrieve ingest volume")
+		return
+	}
+
+	// Write response
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// aggregationWindow defaults to the last 7 days when no time range is given
+func aggregationWindow(startTime, endTime string) (string, string) {
+	if startTime == "" && endTime == "" {
+		now := time.Now().UTC()
+		return now.Add(-defaultAggregationWindow).Format(time.RFC3339), now.Format(time.RFC3339)
+	}
+	return startTime, endTime
+}
+
 // Health handles GET /health
 func (h *Handler) Health(w http.ResponseWriter, r *http.Request) {
 	// Get logger from context
@@ -333,7 +920,84 @@ func (h *Handler) Health(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// Healthz handles GET /healthz. It is a liveness probe and does not check dependencies.
+func (h *Handler) Healthz(w http.ResponseWriter, r *http.Request) {
+	h.writeJSON(w, http.StatusOK, map[string]string{
+		"status":    "alive",
+		"timestamp": time.Now().Format(time.RFC3339),
+	})
+}
+
+// Readyz handles GET /readyz. It reports the status of each dependency and returns
+// 503 when any of them is unreachable.
+func (h *Handler) Readyz(w http.ResponseWriter, r *http.Request) {
+	log := logger.GetLogger(r.Context())
+
+	ctx, cancel := context.WithTimeout(r.Context(), readinessCheckTimeout)
+	defer cancel()
+
+	start := time.Now()
+	openSearch := DependencyStatus{Status: "up"}
+	if err := h.controllers.HealthCheck(ctx); err != nil {
+		log.Error("OpenSearch readiness check failed", "error", err)
+		openSearch.Status = "down"
+		openSearch.Error = err.Error()
+	}
+	openSearch.LatencyMs = time.Since(start).Milliseconds()
+
+	status := http.StatusOK
+	response := ReadinessResponse{
+		Status:       "ready",
+		Dependencies: map[string]DependencyStatus{"opensearch": openSearch},
+		Timestamp:    time.Now().Format(time.RFC3339),
+	}
+	if openSearch.Status != "up" {
+		status = http.StatusServiceUnavailable
+		response.Status = "not_ready"
+	}
+	h.writeJSON(w, status, response)
+}
+
 // Helper functions
+// ContentVisibility sets the content visibility of API requests in their context, so that the spans
+// they read are redacted as they are parsed. allowed returns the most content the caller may see;
+// the content visibility header can only narrow it, so that agent-manager-service can apply the
+// setting of an organization without letting callers widen it.
+func (h *Handler) ContentVisibility(allowed func(ctx context.Context) opensearch.ContentVisibility) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			visibility := allowed(r.Context())
+			if value := r.Header.Get(middleware.ContentVisibilityHeader); value != "" {
+				requested, ok := opensearch.ParseContentVisibility(value)
+				if !ok {
+					h.writeError(w, http.StatusBadRequest, middleware.ContentVisibilityHeader+" must be one of full, redacted or metadata-only")
+					return
+				}
+				visibility = opensearch.RestrictContentVisibility(visibility, requested)
+			}
+			next.ServeHTTP(w, r.WithContext(opensearch.WithContentVisibility(r.Context(), visibility)))
+		})
+	}
+}
+
+// ArchivedTraces reads the archived query parameter of API requests into their context, so that
+// the span searches they make also read the span indices moved to the trace archive. Archived
+// spans are fetched from the snapshot repository as they are searched, so these requests are
+// slower.
+func (h *Handler) ArchivedTraces(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		archived := r.URL.Query().Get("archived")
+		switch archived {
+		case "", "false":
+			next.ServeHTTP(w, r)
+		case "true":
+			next.ServeHTTP(w, r.WithContext(opensearch.WithArchivedTraces(r.Context())))
+		default:
+			h.writeError(w, http.StatusBadRequest, "archived must be true or false")
+		}
+	})
+}
+
 func (h *Handler) writeJSON(w http.ResponseWriter, status int, data interface{}) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(status)
@@ -342,9 +1006,20 @@ func (h *Handler) writeJSON(w http.ResponseWriter, status int, data interface{})
 	}
 }
 
+// writeServerError writes a 503 while the OpenSearch circuit breaker is open and a 500 otherwise
+func (h *Handler) writeServerError(w http.ResponseWriter, err error, message string) {
+	if errors.Is(err, opensearch.ErrUnavailable) {
+		h.writeError(w, http.StatusServiceUnavailable, "Trace store is temporarily unavailable")
+		return
+	}
+	h.writeError(w, http.StatusInternalServerError, message)
+}
+
 func (h *Handler) writeError(w http.ResponseWriter, status int, message string) {
 	h.writeJSON(w, status, ErrorResponse{
-		Error:   "error",
-		Message: message,
+		Error:         "error",
+		Code:          ErrorCodeForStatus(status),
+		Message:       message,
+		CorrelationID: w.Header().Get(middleware.CorrelationIDHeader),
 	})
 }
@@ -0,0 +1,159 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/controllers"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/middleware/auth"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/opensearch"
+)
+
+const (
+	// defaultIssueLimit is the page size of the issue list when none is given
+	defaultIssueLimit = 50
+	// maxIssueLimit is the largest page of the issue list
+	maxIssueLimit = 500
+)
+
+// IssueStatusRequest represents the request body for changing the status of an issue
+type IssueStatusRequest struct {
+	Status string `json:"status"`
+}
+
+// ListIssues handles GET /api/v1/issues with query parameters
+func (h *Handler) ListIssues(w http.ResponseWriter, r *http.Request) {
+	// Get logger from context
+	log := logger.GetLogger(r.Context())
+
+	// Parse query parameters
+	query := r.URL.Query()
+
+	var status opensearch.IssueStatus
+	if value := query.Get("status"); value != "" {
+		var ok bool
+		if status, ok = opensearch.ParseIssueStatus(value); !ok {
+			h.writeError(w, http.StatusBadRequest, "status must be 'open', 'acknowledged' or 'resolved'")
+			return
+		}
+	}
+
+	// Parse limit (default: 50)
+	limit := defaultIssueLimit
+	if limitStr := query.Get("limit"); limitStr != "" {
+		parsedLimit, err := strconv.Atoi(limitStr)
+		if err != nil || parsedLimit <= 0 || parsedLimit > maxIssueLimit {
+			h.writeError(w, http.StatusBadRequest, fmt.Sprintf("limit must be between 1 and %d", maxIssueLimit))
+			return
+		}
+		limit = parsedLimit
+	}
+
+	// Parse offset for pagination (default: 0)
+	offset := 0
+	if offsetStr := query.Get("offset"); offsetStr != "" {
+		parsedOffset, err := strconv.Atoi(offsetStr)
+		if err != nil || parsedOffset < 0 {
+			h.writeError(w, http.StatusBadRequest, "offset must be a non-negative integer")
+			return
+		}
+		offset = parsedOffset
+	}
+
+	// Execute query
+	ctx := r.Context()
+	result, err := h.controllers.ListIssues(ctx, opensearch.IssueQueryParams{
+		ComponentUid: query.Get("componentUid"),
+		Status:       status,
+		Limit:        limit,
+		Offset:       offset,
+	})
+	if err != nil {
+		log.Error("Failed to list issues", "error", err)
+		h.writeServerError(w, err, "Failed to retrieve issues")
+		return
+	}
+
+	// Write response
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// GetIssue handles GET /api/v1/issues/{issueId}
+func (h *Handler) GetIssue(w http.ResponseWriter, r *http.Request) {
+	// Get logger from context
+	log := logger.GetLogger(r.Context())
+
+	issueID := r.PathValue("issueId")
+
+	// Execute query
+	ctx := r.Context()
+	result, err := h.controllers.GetIssue(ctx, issueID)
+	if err != nil {
+		if errors.Is(err, controllers.ErrIssueNotFound) {
+			h.writeError(w, http.StatusNotFound, "Issue not found")
+			return
+		}
+		log.Error("Failed to get issue", "issueId", issueID, "error", err)
+		h.writeServerError(w, err, "Failed to retrieve issue")
+		return
+	}
+
+	// Write response
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// UpdateIssueStatus handles PUT /api/v1/issues/{issueId}/status
+func (h *Handler) UpdateIssueStatus(w http.ResponseWriter, r *http.Request) {
+	// Get logger from context
+	log := logger.GetLogger(r.Context())
+
+	issueID := r.PathValue("issueId")
+
+	var req IssueStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.writeError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	status, ok := opensearch.ParseIssueStatus(req.Status)
+	if !ok {
+		h.writeError(w, http.StatusBadRequest, "status must be 'open', 'acknowledged' or 'resolved'")
+		return
+	}
+	updatedBy, _ := auth.SubjectFromContext(r.Context())
+
+	// Execute update
+	ctx := r.Context()
+	result, err := h.controllers.UpdateIssueStatus(ctx, issueID, status, updatedBy)
+	if err != nil {
+		if errors.Is(err, controllers.ErrIssueNotFound) {
+			h.writeError(w, http.StatusNotFound, "Issue not found")
+			return
+		}
+		log.Error("Failed to update issue status", "issueId", issueID, "error", err)
+		h.writeServerError(w, err, "Failed to update issue")
+		return
+	}
+
+	// Write response
+	h.writeJSON(w, http.StatusOK, result)
+}
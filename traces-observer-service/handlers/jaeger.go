@@ -0,0 +1,262 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/controllers"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/opensearch"
+)
+
+// These handlers implement the HTTP API of the Jaeger query service that the Jaeger UI reads
+// traces from. The component UID of a span is its Jaeger service. Queries are not restricted to an
+// environment.
+
+const (
+	// defaultJaegerTraceLimit is the number of traces a search returns when no limit is given
+	defaultJaegerTraceLimit = 20
+	// defaultJaegerLookback is the time range searched when no start time is given
+	defaultJaegerLookback = time.Hour
+)
+
+// JaegerResponse is the envelope of every Jaeger query API response
+type JaegerResponse struct {
+	Data   interface{}   `json:"data"`
+	Total  int           `json:"total"`
+	Limit  int           `json:"limit"`
+	Offset int           `json:"offset"`
+	Errors []JaegerError `json:"errors"`
+}
+
+// JaegerError is an error of a Jaeger query API response
+type JaegerError struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+// JaegerServices handles GET /api/jaeger/api/services
+func (h *Handler) JaegerServices(w http.ResponseWriter, r *http.Request) {
+	// Get logger from context
+	log := logger.GetLogger(r.Context())
+
+	services, err := h.controllers.JaegerServices(r.Context())
+	if err != nil {
+		log.Error("Failed to list Jaeger services", "error", err)
+		h.writeJaegerServerError(w, err, "Failed to list services")
+		return
+	}
+	h.writeJaegerData(w, services, len(services))
+}
+
+// JaegerServiceOperations handles GET /api/jaeger/api/services/{service}/operations, which lists
+// operation names only
+func (h *Handler) JaegerServiceOperations(w http.ResponseWriter, r *http.Request) {
+	// Get logger from context
+	log := logger.GetLogger(r.Context())
+
+	operations, err := h.controllers.JaegerOperations(r.Context(), r.PathValue("service"), "")
+	if err != nil {
+		log.Error("Failed to list Jaeger operations", "error", err)
+		h.writeJaegerServerError(w, err, "Failed to list operations")
+		return
+	}
+
+	names := []string{}
+	seen := make(map[string]bool)
+	for _, operation := range operations {
+		if !seen[operation.Name] {
+			seen[operation.Name] = true
+			names = append(names, operation.Name)
+		}
+	}
+	h.writeJaegerData(w, names, len(names))
+}
+
+// JaegerOperations handles GET /api/jaeger/api/operations?service=&spanKind=
+func (h *Handler) JaegerOperations(w http.ResponseWriter, r *http.Request) {
+	// Get logger from context
+	log := logger.GetLogger(r.Context())
+
+	query := r.URL.Query()
+	service := query.Get("service")
+	if service == "" {
+		h.writeJaegerError(w, http.StatusBadRequest, "parameter 'service' is required")
+		return
+	}
+
+	operations, err := h.controllers.JaegerOperations(r.Context(), service, query.Get("spanKind"))
+	if err != nil {
+		log.Error("Failed to list Jaeger operations", "error", err)
+		h.writeJaegerServerError(w, err, "Failed to list operations")
+		return
+	}
+	h.writeJaegerData(w, operations, len(operations))
+}
+
+// JaegerSearchTraces handles GET /api/jaeger/api/traces. Times are in microseconds since the epoch
+// and durations are Go durations such as 100ms, as sent by the Jaeger UI.
+func (h *Handler) JaegerSearchTraces(w http.ResponseWriter, r *http.Request) {
+	// Get logger from context
+	log := logger.GetLogger(r.Context())
+
+	params, err := parseJaegerSearchParams(r)
+	if err != nil {
+		h.writeJaegerError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	traces, err := h.controllers.JaegerSearchTraces(r.Context(), *params)
+	if err != nil {
+		log.Error("Failed to search Jaeger traces", "error", err)
+		h.writeJaegerServerError(w, err, "Failed to search traces")
+		return
+	}
+	h.writeJaegerData(w, traces, len(traces))
+}
+
+// JaegerGetTrace handles GET /api/jaeger/api/traces/{traceId}
+func (h *Handler) JaegerGetTrace(w http.ResponseWriter, r *http.Request) {
+	// Get logger from context
+	log := logger.GetLogger(r.Context())
+
+	trace, err := h.controllers.JaegerGetTrace(r.Context(), r.PathValue("traceId"))
+	if err != nil {
+		if errors.Is(err, controllers.ErrTraceNotFound) {
+			h.writeJaegerError(w, http.StatusNotFound, "trace not found")
+			return
+		}
+		log.Error("Failed to get Jaeger trace", "error", err)
+		h.writeJaegerServerError(w, err, "Failed to get trace")
+		return
+	}
+	h.writeJaegerData(w, []opensearch.JaegerTrace{*trace}, 1)
+}
+
+// parseJaegerSearchParams reads the query parameters of a Jaeger trace search
+func parseJaegerSearchParams(r *http.Request) (*opensearch.JaegerSearchParams, error) {
+	query := r.URL.Query()
+
+	params := &opensearch.JaegerSearchParams{
+		ComponentUid: query.Get("service"),
+		Operation:    query.Get("operation"),
+		Limit:        defaultJaegerTraceLimit,
+	}
+	if params.ComponentUid == "" {
+		return nil, fmt.Errorf("parameter 'service' is required")
+	}
+
+	if limitStr := query.Get("limit"); limitStr != "" {
+		limit, err := strconv.Atoi(limitStr)
+		if err != nil || limit <= 0 || limit > controllers.MaxTracesPerRequest {
+			return nil, fmt.Errorf("limit must be between 1 and %d", controllers.MaxTracesPerRequest)
+		}
+		params.Limit = limit
+	}
+
+	endTime := time.Now()
+	if endStr := query.Get("end"); endStr != "" {
+		end, err := strconv.ParseInt(endStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("end must be in microseconds since the epoch")
+		}
+		endTime = time.UnixMicro(end)
+	}
+	lookback := defaultJaegerLookback
+	if lookbackStr := query.Get("lookback"); lookbackStr != "" && lookbackStr != "custom" {
+		parsed, err := time.ParseDuration(lookbackStr)
+		if err != nil || parsed <= 0 {
+			return nil, fmt.Errorf("lookback must be a positive duration")
+		}
+		lookback = parsed
+	}
+	startTime := endTime.Add(-lookback)
+	if startStr := query.Get("start"); startStr != "" {
+		start, err := strconv.ParseInt(startStr, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("start must be in microseconds since the epoch")
+		}
+		startTime = time.UnixMicro(start)
+	}
+	if startTime.After(endTime) {
+		return nil, fmt.Errorf("start must be before end")
+	}
+	params.StartTime = startTime.UTC().Format(time.RFC3339)
+	params.EndTime = endTime.UTC().Format(time.RFC3339)
+
+	for name, target := range map[string]*int64{"minDuration": &params.MinDuration, "maxDuration": &params.MaxDuration} {
+		if durationStr := query.Get(name); durationStr != "" {
+			duration, err := time.ParseDuration(durationStr)
+			if err != nil || duration < 0 {
+				return nil, fmt.Errorf("%s must be a duration such as 100ms", name)
+			}
+			*target = duration.Nanoseconds()
+		}
+	}
+	if params.MinDuration > 0 && params.MaxDuration > 0 && params.MinDuration > params.MaxDuration {
+		return nil, fmt.Errorf("minDuration must not exceed maxDuration")
+	}
+
+	// The Jaeger UI sends tags as a JSON object; each tag parameter adds a key:value pair
+	if tagsStr := query.Get("tags"); tagsStr != "" {
+		if err := json.Unmarshal([]byte(tagsStr), &params.Tags); err != nil {
+			return nil, fmt.Errorf("tags must be a JSON object of strings")
+		}
+	}
+	for _, tag := range query["tag"] {
+		key, value, ok := strings.Cut(tag, ":")
+		if !ok {
+			return nil, fmt.Errorf("tag must be in key:value format")
+		}
+		if params.Tags == nil {
+			params.Tags = make(map[string]string)
+		}
+		params.Tags[key] = value
+	}
+	for key := range params.Tags {
+		if !attributeNamePattern.MatchString(key) {
+			return nil, fmt.Errorf("invalid tag: %s", key)
+		}
+	}
+
+	return params, nil
+}
+
+// writeJaegerData writes a successful Jaeger query API response
+func (h *Handler) writeJaegerData(w http.ResponseWriter, data interface{}, total int) {
+	h.writeJSON(w, http.StatusOK, JaegerResponse{Data: data, Total: total})
+}
+
+// writeJaegerServerError is writeServerError in the Jaeger response format
+func (h *Handler) writeJaegerServerError(w http.ResponseWriter, err error, message string) {
+	if errors.Is(err, opensearch.ErrUnavailable) {
+		h.writeJaegerError(w, http.StatusServiceUnavailable, "Trace store is temporarily unavailable")
+		return
+	}
+	h.writeJaegerError(w, http.StatusInternalServerError, message)
+}
+
+func (h *Handler) writeJaegerError(w http.ResponseWriter, status int, message string) {
+	h.writeJSON(w, status, JaegerResponse{Errors: []JaegerError{{Code: status, Msg: message}}})
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/middleware/logger"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/opensearch"
+)
+
+const (
+	// defaultSLOWindowDays is the rolling window of an objective when none is given
+	defaultSLOWindowDays = 28
+	// maxSLOWindowDays is the longest rolling window of an objective
+	maxSLOWindowDays = 90
+	// defaultSLOHistoryInterval is the interval of SLO history points when none is given
+	defaultSLOHistoryInterval = 24 * time.Hour
+)
+
+// GetSLOStatus handles GET /api/v1/slo/status with query parameters
+func (h *Handler) GetSLOStatus(w http.ResponseWriter, r *http.Request) {
+	// Get logger from context
+	log := logger.GetLogger(r.Context())
+
+	params, err := parseSLOParams(r)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	result, err := h.controllers.GetSLOStatus(r.Context(), *params)
+	if err != nil {
+		log.Error("Failed to get SLO status", "error", err)
+		h.writeServerError(w, err, "Failed to compute SLO status")
+		return
+	}
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// GetSLOHistory handles GET /api/v1/slo/history with query parameters
+func (h *Handler) GetSLOHistory(w http.ResponseWriter, r *http.Request) {
+	// Get logger from context
+	log := logger.GetLogger(r.Context())
+
+	params, err := parseSLOParams(r)
+	if err != nil {
+		h.writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	params.Interval = defaultSLOHistoryInterval
+	if intervalStr := r.URL.Query().Get("interval"); intervalStr != "" {
+		interval, err := time.ParseDuration(intervalStr)
+		if err != nil || interval < time.Hour || interval%time.Hour != 0 || interval > params.Window {
+			h.writeError(w, http.StatusBadRequest, "interval must be a whole number of hours, at least 1h and at most the window")
+			return
+		}
+		params.Interval = interval
+	}
+
+	result, err := h.controllers.GetSLOHistory(r.Context(), *params)
+	if err != nil {
+		log.Error("Failed to get SLO history", "error", err)
+		h.writeServerError(w, err, "Failed to compute SLO history")
+		return
+	}
+	h.writeJSON(w, http.StatusOK, result)
+}
+
+// parseSLOParams reads the component, objective and window of an SLO query
+func parseSLOParams(r *http.Request) (*opensearch.SLOParams, error) {
+	query := r.URL.Query()
+
+	params := &opensearch.SLOParams{
+		ComponentUid:   query.Get("componentUid"),
+		EnvironmentUid: query.Get("environmentUid"),
+		Objective:      opensearch.SLOObjective{Indicator: query.Get("indicator")},
+		Window:         defaultSLOWindowDays * 24 * time.Hour,
+		End:            time.Now().UTC(),
+	}
+	if params.ComponentUid == "" {
+		return nil, fmt.Errorf("componentUid is required")
+	}
+	if params.EnvironmentUid == "" {
+		return nil, fmt.Errorf("environmentUid is required")
+	}
+
+	switch params.Objective.Indicator {
+	case opensearch.SLOIndicatorLatency:
+		thresholdMs, err := strconv.ParseFloat(query.Get("thresholdMs"), 64)
+		if err != nil || thresholdMs <= 0 {
+			return nil, fmt.Errorf("thresholdMs must be a positive number for the latency indicator")
+		}
+		params.Objective.ThresholdMs = thresholdMs
+	case opensearch.SLOIndicatorAvailability:
+	default:
+		return nil, fmt.Errorf("indicator must be %s or %s", opensearch.SLOIndicatorLatency, opensearch.SLOIndicatorAvailability)
+	}
+
+	target, err := strconv.ParseFloat(query.Get("target"), 64)
+	if err != nil || target <= 0 || target >= 100 {
+		return nil, fmt.Errorf("target must be a percentage greater than 0 and less than 100")
+	}
+	params.Objective.Target = target
+
+	if windowStr := query.Get("windowDays"); windowStr != "" {
+		windowDays, err := strconv.Atoi(windowStr)
+		if err != nil || windowDays <= 0 || windowDays > maxSLOWindowDays {
+			return nil, fmt.Errorf("windowDays must be between 1 and %d", maxSLOWindowDays)
+		}
+		params.Window = time.Duration(windowDays) * 24 * time.Hour
+	}
+	return params, nil
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package logs
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/config"
+)
+
+func TestLokiSourceSearch(t *testing.T) {
+	var gotQuery, gotTenant string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/loki/api/v1/query_range" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		gotQuery = r.URL.Query().Get("query")
+		gotTenant = r.Header.Get("X-Scope-OrgID")
+		_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"streams","result":[
+			{"stream":{"pod":"agent-b"},"values":[["1735725602000000000","second"]]},
+			{"stream":{"pod":"agent-a"},"values":[["1735725601000000000","first"],["1735725603000000000","third"]]}
+		]}}`))
+	}))
+	defer server.Close()
+
+	source := NewSource(config.LogsConfig{
+		Backend:      config.LogsBackendLoki,
+		LokiURL:      server.URL + "/",
+		LokiSelector: `{namespace="agents"}`,
+		LokiTenant:   "tenant-1",
+	}, nil)
+	start := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	records, err := source.Search(context.Background(), Query{
+		TraceID:   "trace-1",
+		SpanID:    "span-1",
+		StartTime: start,
+		EndTime:   start.Add(time.Minute),
+		Limit:     2,
+	})
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+
+	if want := `{namespace="agents"} |= "trace-1" |= "span-1"`; gotQuery != want {
+		t.Errorf("query = %s, want %s", gotQuery, want)
+	}
+	if gotTenant != "tenant-1" {
+		t.Errorf("tenant = %q, want tenant-1", gotTenant)
+	}
+	// Records of all streams are merged oldest first and limited
+	if len(records) != 2 || records[0].Message != "first" || records[1].Message != "second" {
+		t.Fatalf("unexpected records: %+v", records)
+	}
+	if records[1].Fields["pod"] != "agent-b" || records[0].TraceID != "trace-1" {
+		t.Errorf("unexpected record: %+v", records[1])
+	}
+}
+
+func TestLokiSourceSearchError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "parse error", http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	source := NewSource(config.LogsConfig{Backend: config.LogsBackendLoki, LokiURL: server.URL, LokiSelector: "{}"}, nil)
+	if _, err := source.Search(context.Background(), Query{TraceID: "trace-1", Limit: 1}); err == nil {
+		t.Fatal("expected an error for a failed query")
+	}
+}
+
+func TestBuildLogQuery(t *testing.T) {
+	cfg := config.LogsConfig{TraceIDField: "traceId", SpanIDField: "spanId", TimestampField: "@timestamp"}
+	start := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	search := buildLogQuery(cfg, Query{TraceID: "trace-1", SpanID: "span-1", StartTime: start, EndTime: start.Add(time.Minute), Limit: 10})
+
+	actual, err := json.Marshal(search.Source())
+	if err != nil {
+		t.Fatalf("failed to marshal query: %v", err)
+	}
+	expected := `{"query":{"bool":{"must":[` +
+		`{"term":{"traceId":"trace-1"}},` +
+		`{"range":{"@timestamp":{"gte":"2025-01-01T10:00:00Z","lte":"2025-01-01T10:01:00Z"}}},` +
+		`{"term":{"spanId":"span-1"}}]}},` +
+		`"size":10,"sort":[{"@timestamp":{"order":"asc"}}]}`
+	if string(actual) != expected {
+		t.Errorf("query =\n%s\nwant\n%s", actual, expected)
+	}
+}
+
+func TestParseOpenSearchRecord(t *testing.T) {
+	source := newOpenSearchSource(config.LogsConfig{
+		TraceIDField:   "trace.id",
+		SpanIDField:    "spanId",
+		TimestampField: "@timestamp",
+		MessageField:   "log",
+	}, nil)
+	record := source.parseRecord(map[string]interface{}{
+		"@timestamp": "2025-01-01T10:00:00.5Z",
+		"log":        "hello",
+		"trace":      map[string]interface{}{"id": "trace-1"},
+		"spanId":     "span-1",
+	})
+	if record.Message != "hello" || record.TraceID != "trace-1" || record.SpanID != "span-1" {
+		t.Errorf("unexpected record: %+v", record)
+	}
+	if want := time.Date(2025, 1, 1, 10, 0, 0, 500000000, time.UTC); !record.Timestamp.Equal(want) {
+		t.Errorf("timestamp = %v, want %v", record.Timestamp, want)
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package logs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/config"
+)
+
+// lokiRequestTimeout bounds the time spent on a Loki query
+const lokiRequestTimeout = 30 * time.Second
+
+// lokiSource reads log records from Loki. Loki does not index the trace context, so records are
+// found by the trace ID appearing in the log line.
+type lokiSource struct {
+	httpClient *http.Client
+	cfg        config.LogsConfig
+}
+
+func newLokiSource(cfg config.LogsConfig) *lokiSource {
+	return &lokiSource{
+		httpClient: &http.Client{Timeout: lokiRequestTimeout},
+		cfg:        cfg,
+	}
+}
+
+// lokiQueryResponse is the response of a Loki query_range request for a log query
+type lokiQueryResponse struct {
+	Data struct {
+		Result []struct {
+			Stream map[string]string `json:"stream"`
+			Values [][2]string       `json:"values"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// Search returns the log lines containing the trace ID of the query
+func (s *lokiSource) Search(ctx context.Context, query Query) ([]Record, error) {
+	params := url.Values{}
+	params.Set("query", buildLogQL(s.cfg.LokiSelector, query))
+	params.Set("start", strconv.FormatInt(query.StartTime.UnixNano(), 10))
+	params.Set("end", strconv.FormatInt(query.EndTime.UnixNano(), 10))
+	params.Set("limit", strconv.Itoa(query.Limit))
+	params.Set("direction", "forward")
+	requestURL := fmt.Sprintf("%s/loki/api/v1/query_range?%s", strings.TrimSuffix(s.cfg.LokiURL, "/"), params.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	if s.cfg.LokiTenant != "" {
+		req.Header.Set("X-Scope-OrgID", s.cfg.LokiTenant)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("loki query failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("loki query failed with status %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	var response lokiQueryResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("failed to decode loki response: %w", err)
+	}
+
+	records := []Record{}
+	for _, stream := range response.Data.Result {
+		labels := make(map[string]interface{}, len(stream.Stream))
+		for key, value := range stream.Stream {
+			labels[key] = value
+		}
+		for _, value := range stream.Values {
+			nanos, err := strconv.ParseInt(value[0], 10, 64)
+			if err != nil {
+				continue
+			}
+			records = append(records, Record{
+				Timestamp: time.Unix(0, nanos).UTC(),
+				Message:   value[1],
+				TraceID:   query.TraceID,
+				SpanID:    query.SpanID,
+				Fields:    labels,
+			})
+		}
+	}
+
+	// Loki returns the records grouped by stream
+	sort.SliceStable(records, func(i, j int) bool { return records[i].Timestamp.Before(records[j].Timestamp) })
+	if len(records) > query.Limit {
+		records = records[:query.Limit]
+	}
+	return records, nil
+}
+
+// buildLogQL builds the LogQL query for the log lines of a trace
+func buildLogQL(selector string, query Query) string {
+	logQL := selector + " |= " + strconv.Quote(query.TraceID)
+	if query.SpanID != "" {
+		logQL += " |= " + strconv.Quote(query.SpanID)
+	}
+	return logQL
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package logs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/config"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/opensearch"
+)
+
+// openSearchSource reads log records indexed in OpenSearch, e.g. by Fluent Bit or an OpenTelemetry
+// collector, with the trace context in their own fields
+type openSearchSource struct {
+	client *opensearch.Client
+	cfg    config.LogsConfig
+}
+
+func newOpenSearchSource(cfg config.LogsConfig, client *opensearch.Client) *openSearchSource {
+	return &openSearchSource{client: client, cfg: cfg}
+}
+
+// Search returns the log records with the trace ID of the query
+func (s *openSearchSource) Search(ctx context.Context, query Query) ([]Record, error) {
+	response, err := s.client.Search(ctx, []string{s.cfg.OpenSearchIndex}, buildLogQuery(s.cfg, query))
+	if err != nil {
+		return nil, fmt.Errorf("failed to search logs: %w", err)
+	}
+
+	records := make([]Record, 0, len(response.Hits.Hits))
+	for _, hit := range response.Hits.Hits {
+		records = append(records, s.parseRecord(hit.Source))
+	}
+	return records, nil
+}
+
+// buildLogQuery builds the search for the log records of a trace, oldest first
+func buildLogQuery(cfg config.LogsConfig, query Query) *opensearch.SearchSource {
+	filter := opensearch.Bool().Must(
+		opensearch.Term(cfg.TraceIDField, query.TraceID),
+		opensearch.Range(cfg.TimestampField).
+			Gte(query.StartTime.UTC().Format(time.RFC3339Nano)).
+			Lte(query.EndTime.UTC().Format(time.RFC3339Nano)),
+	)
+	if query.SpanID != "" && cfg.SpanIDField != "" {
+		filter.Must(opensearch.Term(cfg.SpanIDField, query.SpanID))
+	}
+	return opensearch.NewSearch().
+		Query(filter).
+		Size(query.Limit).
+		Sort(cfg.TimestampField, opensearch.SortAsc)
+}
+
+func (s *openSearchSource) parseRecord(source map[string]interface{}) Record {
+	record := Record{Fields: source}
+	if timestamp, ok := lookupField(source, s.cfg.TimestampField).(string); ok {
+		if t, err := time.Parse(time.RFC3339Nano, timestamp); err == nil {
+			record.Timestamp = t
+		}
+	}
+	if message, ok := lookupField(source, s.cfg.MessageField).(string); ok {
+		record.Message = message
+	}
+	if traceID, ok := lookupField(source, s.cfg.TraceIDField).(string); ok {
+		record.TraceID = traceID
+	}
+	if spanID, ok := lookupField(source, s.cfg.SpanIDField).(string); ok {
+		record.SpanID = spanID
+	}
+	return record
+}
+
+// lookupField returns the value of a field, which may be stored under its full name or as an
+// object path such as kubernetes.pod_name
+func lookupField(source map[string]interface{}, name string) interface{} {
+	if name == "" {
+		return nil
+	}
+	if value, ok := source[name]; ok {
+		return value
+	}
+	head, rest, ok := strings.Cut(name, ".")
+	if !ok {
+		return nil
+	}
+	if nested, ok := source[head].(map[string]interface{}); ok {
+		return lookupField(nested, rest)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package logs reads application log records that carry the trace context of a span, so that
+// they can be shown next to the trace.
+package logs
+
+import (
+	"context"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/config"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/opensearch"
+)
+
+// Record is an application log record
+type Record struct {
+	Timestamp time.Time `json:"timestamp"`
+	Message   string    `json:"message"`
+	TraceID   string    `json:"traceId,omitempty"`
+	SpanID    string    `json:"spanId,omitempty"`
+	// Fields holds the stored fields of an OpenSearch record or the stream labels of a Loki record
+	Fields map[string]interface{} `json:"fields,omitempty"`
+}
+
+// Query selects the log records of a trace, or of one of its spans when SpanID is set
+type Query struct {
+	TraceID   string
+	SpanID    string
+	StartTime time.Time
+	EndTime   time.Time
+	Limit     int
+}
+
+// Source is a log backend
+type Source interface {
+	// Search returns up to Limit records matching the query, oldest first
+	Search(ctx context.Context, query Query) ([]Record, error)
+}
+
+// TraceLogsParams selects the logs of a trace of a component, or of one of its spans
+type TraceLogsParams struct {
+	TraceID        string
+	SpanID         string // Optional
+	ComponentUid   string
+	EnvironmentUid string
+	Limit          int // Defaults to, and is capped at, the configured maximum
+}
+
+// TraceLogsResponse represents the response of a log correlation request
+type TraceLogsResponse struct {
+	TraceID    string   `json:"traceId"`
+	SpanID     string   `json:"spanId,omitempty"`
+	Logs       []Record `json:"logs"`
+	TotalCount int      `json:"totalCount"`
+	// Truncated reports that more records matched than the configured maximum
+	Truncated bool `json:"truncated"`
+}
+
+// NewSource creates the configured log backend, or returns nil when log correlation is disabled.
+// The OpenSearch backend reads logs through the client of the traces.
+func NewSource(cfg config.LogsConfig, osClient *opensearch.Client) Source {
+	switch cfg.Backend {
+	case config.LogsBackendOpenSearch:
+		return newOpenSearchSource(cfg, osClient)
+	case config.LogsBackendLoki:
+		return newLokiSource(cfg)
+	default:
+		return nil
+	}
+}
@@ -18,62 +18,126 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"flag"
 	"fmt"
 	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
+	"sync/atomic"
 	"syscall"
 	"time"
 
 	"github.com/wso2/ai-agent-management-platform/traces-observer-service/config"
 	"github.com/wso2/ai-agent-management-platform/traces-observer-service/controllers"
 	"github.com/wso2/ai-agent-management-platform/traces-observer-service/handlers"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/metrics"
 	"github.com/wso2/ai-agent-management-platform/traces-observer-service/middleware"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/middleware/auth"
 	"github.com/wso2/ai-agent-management-platform/traces-observer-service/middleware/logger"
 	"github.com/wso2/ai-agent-management-platform/traces-observer-service/opensearch"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/tracing"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
+// logLevel is the level of the default logger, which a configuration reload can change
+var logLevel = new(slog.LevelVar)
+
 func setupLogger(cfg *config.Config) {
-	var level slog.Level
-	switch cfg.LogLevel {
-	case "DEBUG":
-		level = slog.LevelDebug
-	case "INFO":
-		level = slog.LevelInfo
-	case "WARN":
-		level = slog.LevelWarn
-	case "ERROR":
-		level = slog.LevelError
-	default:
-		level = slog.LevelInfo // default to INFO
-	}
+	logLevel.Set(parseLogLevel(cfg.LogLevel))
 
 	// Create handler options
 	opts := &slog.HandlerOptions{
-		Level: level,
+		Level: logLevel,
 	}
 	handler := slog.NewJSONHandler(os.Stdout, opts)
 	slogger := slog.New(handler)
 	slog.SetDefault(slogger)
 
 	slog.Info("Logger configured",
-		"level", level.String())
+		"level", logLevel.Level().String())
+}
+
+func parseLogLevel(name string) slog.Level {
+	switch name {
+	case "DEBUG":
+		return slog.LevelDebug
+	case "INFO":
+		return slog.LevelInfo
+	case "WARN":
+		return slog.LevelWarn
+	case "ERROR":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo // default to INFO
+	}
+}
+
+// reloadConfigOnSignal applies the reloadable settings each time the process receives SIGHUP.
+// An invalid configuration is reported and the current one is kept.
+func reloadConfigOnSignal(current *atomic.Pointer[config.Config], priceTable *config.ModelPriceTable) {
+	reloadCh := make(chan os.Signal, 1)
+	signal.Notify(reloadCh, syscall.SIGHUP)
+	for range reloadCh {
+		loaded, err := config.Load()
+		if err != nil {
+			slog.Error("Configuration reload failed, keeping the current configuration", "error", err)
+			continue
+		}
+		next := *current.Load()
+		changed := config.ApplyReloadable(&next, loaded)
+		current.Store(&next)
+		logLevel.Set(parseLogLevel(next.LogLevel))
+		priceTable.Set(next.ModelPricing)
+		slog.Info("Configuration reloaded", "changed", changed)
+	}
 }
 
 func main() {
+	validateConfig := flag.Bool("validate-config", false, "validate the configuration and exit")
+	configSchema := flag.Bool("config-schema", false, "print the settings the service reads as JSON and exit")
+	flag.Parse()
+
 	// Load configuration
 	cfg, err := config.Load()
 	if err != nil {
 		slog.Error("Failed to load config", "error", err)
 		os.Exit(1)
 	}
+	if *validateConfig {
+		slog.Info("Configuration is valid")
+		return
+	}
+	if *configSchema {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(config.Schema()); err != nil {
+			slog.Error("Failed to print the configuration schema", "error", err)
+			os.Exit(1)
+		}
+		return
+	}
 
 	// Setup structured logging
 	setupLogger(cfg)
 
+	// Settings that can be reloaded are read from current rather than cfg
+	var current atomic.Pointer[config.Config]
+	current.Store(cfg)
+	priceTable := config.NewModelPriceTable(cfg.ModelPricing)
+	go reloadConfigOnSignal(&current, priceTable)
+
 	slog.Info("Starting tracing service", "port", cfg.Server.Port)
 
+	opensearch.RegisterSpanEnricher(opensearch.NewCostEnricher(priceTable))
+
+	shutdownTracing, err := tracing.InitTracing(context.Background(), cfg.Tracing)
+	if err != nil {
+		slog.Error("Failed to initialize tracing", "error", err)
+		os.Exit(1)
+	}
+
 	// Initialize OpenSearch client
 	osClient, err := opensearch.NewClient(&cfg.OpenSearch)
 	if err != nil {
@@ -81,28 +145,128 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Usage counters are sent to StatsD as traces are rolled up
+	var usageMetrics *metrics.StatsD
+	if cfg.UsageMetrics.StatsDAddress != "" {
+		usageMetrics, err = metrics.NewStatsD(cfg.UsageMetrics.StatsDAddress, cfg.UsageMetrics.Prefix)
+		if err != nil {
+			slog.Error("Failed to create the usage metrics client", "error", err)
+			os.Exit(1)
+		}
+		defer usageMetrics.Close()
+	}
+
 	// Initialize service
-	tracingController := controllers.NewTracingController(osClient)
+	tracingController := controllers.NewTracingController(osClient, priceTable, cfg.Logs, cfg.TraceSummaries, cfg.IssueGrouping, cfg.TraceArchive, usageMetrics)
+
+	// Roll traces up into the summaries the traces list is read from, group error spans into issues
+	// and archive old span indices
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	if cfg.TraceSummaries.Enabled {
+		go tracingController.RunTraceSummaries(jobsCtx)
+	}
+	if cfg.IssueGrouping.Enabled {
+		go tracingController.RunIssueGrouping(jobsCtx)
+	}
+	if cfg.TraceArchive.Enabled {
+		go tracingController.RunTraceArchive(jobsCtx)
+	}
 
 	// Initialize handlers
 	handler := handlers.NewHandler(tracingController)
 
 	// Setup routes
+	apiMux := http.NewServeMux()
+	apiMux.HandleFunc("/api/v1/traces", handler.GetTraceOverviews)
+	apiMux.HandleFunc("/api/v1/traces/export", handler.ExportTraces)
+	apiMux.HandleFunc("/api/v1/trace", handler.GetTraceByIdAndService)
+	apiMux.HandleFunc("/api/v1/trace/federated", handler.GetFederatedTrace)
+	apiMux.HandleFunc("GET /api/v1/trace/logs", handler.GetTraceLogs)
+	apiMux.HandleFunc("GET /api/v1/sessions/{id}/conversation", handler.GetSessionConversation)
+	apiMux.HandleFunc("/api/v1/tools", handler.GetToolCatalog)
+	apiMux.HandleFunc("/api/v1/models/usage", handler.GetModelUsage)
+	apiMux.HandleFunc("GET /api/v1/attributes", handler.GetAttributeStats)
+	apiMux.HandleFunc("GET /api/v1/slo/status", handler.GetSLOStatus)
+	apiMux.HandleFunc("GET /api/v1/slo/history", handler.GetSLOHistory)
+	apiMux.HandleFunc("GET /api/v1/storage", handler.GetStorageUsage)
+	apiMux.HandleFunc("GET /api/v1/analytics/volume", handler.GetVolume)
+	apiMux.HandleFunc("GET /api/v1/analytics/prompts", handler.GetPromptAnalytics)
+	apiMux.HandleFunc("POST /api/v1/spans/delete", handler.DeleteSpans)
+	apiMux.HandleFunc("POST /api/v1/spans/erase", handler.EraseSpans)
+	apiMux.HandleFunc("GET /api/v1/tasks/{taskId}", handler.GetTask)
+	apiMux.HandleFunc("POST /api/v1/traces/{traceId}/annotations", handler.CreateAnnotation)
+	apiMux.HandleFunc("GET /api/v1/traces/{traceId}/annotations", handler.ListAnnotations)
+	apiMux.HandleFunc("PUT /api/v1/traces/{traceId}/annotations/{annotationId}", handler.UpdateAnnotation)
+	apiMux.HandleFunc("DELETE /api/v1/traces/{traceId}/annotations/{annotationId}", handler.DeleteAnnotation)
+	apiMux.HandleFunc("GET /api/v1/issues", handler.ListIssues)
+	apiMux.HandleFunc("GET /api/v1/issues/{issueId}", handler.GetIssue)
+	apiMux.HandleFunc("PUT /api/v1/issues/{issueId}/status", handler.UpdateIssueStatus)
+
+	// Grafana JSON datasource; the datasource URL is <host>/api/grafana
+	apiMux.HandleFunc("GET /api/grafana", handler.GrafanaHealth)
+	apiMux.HandleFunc("GET /api/grafana/{$}", handler.GrafanaHealth)
+	apiMux.HandleFunc("POST /api/grafana/metrics", handler.GrafanaMetrics)
+	apiMux.HandleFunc("POST /api/grafana/metric-payload-options", handler.GrafanaMetricPayloadOptions)
+	apiMux.HandleFunc("POST /api/grafana/search", handler.GrafanaSearch)
+	apiMux.HandleFunc("POST /api/grafana/query", handler.GrafanaQuery)
+
+	// Jaeger query API; Jaeger UI instances use <host>/api/jaeger as their query base path
+	apiMux.HandleFunc("GET /api/jaeger/api/services", handler.JaegerServices)
+	apiMux.HandleFunc("GET /api/jaeger/api/services/{service}/operations", handler.JaegerServiceOperations)
+	apiMux.HandleFunc("GET /api/jaeger/api/operations", handler.JaegerOperations)
+	apiMux.HandleFunc("GET /api/jaeger/api/traces", handler.JaegerSearchTraces)
+	apiMux.HandleFunc("GET /api/jaeger/api/traces/{traceId}", handler.JaegerGetTrace)
+
+	// Callers get the configured span content visibility, or full content when their token carries
+	// the content scope
+	maxContentVisibility := opensearch.ContentVisibility(cfg.Auth.ContentVisibility)
+	var apiHandler http.Handler = handler.ContentVisibility(func(ctx context.Context) opensearch.ContentVisibility {
+		if cfg.Auth.ContentScope != "" && auth.HasScope(ctx, cfg.Auth.ContentScope) {
+			return opensearch.ContentVisibilityFull
+		}
+		return maxContentVisibility
+	})(apiMux)
+
+	// API routes require a token when auth is enabled, health probes are always open
+	if cfg.Auth.Enabled {
+		projectsClaim := ""
+		if cfg.Auth.ProjectAccessEnabled {
+			projectsClaim = cfg.Auth.ProjectsClaim
+			osClient.RestrictToProjects(auth.ProjectsFromContext)
+			slog.Info("Trace access restricted to the projects of the caller", "claim", projectsClaim)
+		}
+		apiHandler = auth.JWTAuth(cfg.Auth.Header, auth.NewValidator(cfg.Auth), projectsClaim)(apiHandler)
+		slog.Info("JWT authentication enabled for API routes")
+	}
+
+	apiHandler = handler.ArchivedTraces(apiHandler)
+
 	mux := http.NewServeMux()
-	mux.HandleFunc("/api/v1/traces", handler.GetTraceOverviews)
-	mux.HandleFunc("/api/v1/traces/export", handler.ExportTraces)
-	mux.HandleFunc("/api/v1/trace", handler.GetTraceByIdAndService)
+	mux.Handle("/api/", apiHandler)
 	mux.HandleFunc("/health", handler.Health)
+	mux.HandleFunc("/healthz", handler.Healthz)
+	mux.HandleFunc("/readyz", handler.Readyz)
 
-	// Apply middleware: Request Logger -> CORS
+	// Apply middleware: Correlation ID -> Request Logger -> Body Logger -> CORS
 	corsConfig := middleware.DefaultCORSConfig()
 	corsHandler := middleware.CORS(corsConfig)(mux)
-	loggerHandler := logger.RequestLogger()(corsHandler)
+	bodyLoggerHandler := logger.BodyLogger(func() config.BodyLoggingConfig { return current.Load().BodyLogging })(corsHandler)
+	loggerHandler := logger.RequestLogger()(bodyLoggerHandler)
+	correlationHandler := middleware.CorrelationID()(loggerHandler)
+	tracingHandler := otelhttp.NewHandler(correlationHandler, "traces-observer-service",
+		otelhttp.WithFilter(func(r *http.Request) bool {
+			return r.URL.Path != "/health" && r.URL.Path != "/healthz" && r.URL.Path != "/readyz"
+		}),
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return r.Method + " " + r.URL.Path
+		}),
+	)
 
 	// Create server
 	server := &http.Server{
 		Addr:         fmt.Sprintf(":%d", cfg.Server.Port),
-		Handler:      loggerHandler,
+		Handler:      tracingHandler,
 		ReadTimeout:  30 * time.Second,
 		WriteTimeout: 30 * time.Second,
 	}
@@ -122,6 +286,7 @@ func main() {
 	<-quit
 
 	slog.Info("Shutting down server...")
+	stopJobs()
 
 	// Graceful shutdown
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -132,5 +297,9 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err := shutdownTracing(ctx); err != nil {
+		slog.Error("Failed to flush traces", "error", err)
+	}
+
 	slog.Info("Server exited")
 }
@@ -0,0 +1,164 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package metrics sends usage counters derived from traces to a metrics store, so that long-range
+// dashboards do not have to aggregate raw spans.
+package metrics
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// maxPacketSize keeps each UDP packet within the MTU of common networks
+const maxPacketSize = 1432
+
+// Tag is a dimension of a counter. Tags with an empty value are left out.
+type Tag struct {
+	Key   string
+	Value string
+}
+
+// Counters accumulates increments by counter name and tags, so that a batch of spans is sent as
+// one increment per series. The zero value is ready to use.
+type Counters struct {
+	series map[string]*series
+	keys   []string
+}
+
+type series struct {
+	name  string
+	tags  []Tag
+	value float64
+}
+
+// Add increments the counter with the given name and tags
+func (c *Counters) Add(name string, value float64, tags ...Tag) {
+	var kept []Tag
+	for _, tag := range tags {
+		if tag.Value != "" {
+			kept = append(kept, tag)
+		}
+	}
+	sort.Slice(kept, func(i, j int) bool { return kept[i].Key < kept[j].Key })
+
+	key := name
+	for _, tag := range kept {
+		key += "|" + tag.Key + "=" + tag.Value
+	}
+	if c.series == nil {
+		c.series = make(map[string]*series)
+	}
+	if s, ok := c.series[key]; ok {
+		s.value += value
+		return
+	}
+	c.series[key] = &series{name: name, tags: kept, value: value}
+	c.keys = append(c.keys, key)
+}
+
+// Len returns the number of series with increments
+func (c *Counters) Len() int {
+	return len(c.keys)
+}
+
+// StatsD sends counters to a StatsD server over UDP. Tags are written in the DogStatsD format,
+// which Datadog, Telegraf and the Prometheus statsd_exporter read.
+type StatsD struct {
+	conn   net.Conn
+	prefix string
+}
+
+// NewStatsD creates a client of the StatsD server at address (host:port), naming counters with
+// the given prefix
+func NewStatsD(address, prefix string) (*StatsD, error) {
+	conn, err := net.Dial("udp", address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to statsd at %s: %w", address, err)
+	}
+	if prefix != "" && !strings.HasSuffix(prefix, ".") {
+		prefix += "."
+	}
+	return &StatsD{conn: conn, prefix: prefix}, nil
+}
+
+// Send writes the increments of counters, packing as many lines into each packet as fit
+func (s *StatsD) Send(counters *Counters) error {
+	var packet []byte
+	for _, key := range counters.keys {
+		line := s.line(counters.series[key])
+		if len(packet) > 0 && len(packet)+1+len(line) > maxPacketSize {
+			if err := s.write(packet); err != nil {
+				return err
+			}
+			packet = packet[:0]
+		}
+		if len(packet) > 0 {
+			packet = append(packet, '\n')
+		}
+		packet = append(packet, line...)
+	}
+	if len(packet) > 0 {
+		return s.write(packet)
+	}
+	return nil
+}
+
+// Close closes the connection to the server
+func (s *StatsD) Close() error {
+	return s.conn.Close()
+}
+
+func (s *StatsD) write(packet []byte) error {
+	if _, err := s.conn.Write(packet); err != nil {
+		return fmt.Errorf("failed to send metrics to statsd: %w", err)
+	}
+	return nil
+}
+
+// line formats a counter increment as name:value|c|#key:value,...
+func (s *StatsD) line(counter *series) string {
+	var b strings.Builder
+	b.WriteString(sanitize(s.prefix + counter.name))
+	b.WriteByte(':')
+	b.WriteString(strconv.FormatFloat(counter.value, 'f', -1, 64))
+	b.WriteString("|c")
+	for i, tag := range counter.tags {
+		if i == 0 {
+			b.WriteString("|#")
+		} else {
+			b.WriteByte(',')
+		}
+		b.WriteString(sanitize(tag.Key))
+		b.WriteByte(':')
+		b.WriteString(sanitize(tag.Value))
+	}
+	return b.String()
+}
+
+// sanitize replaces the characters that delimit the parts of a StatsD line
+func sanitize(value string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ':', '|', ',', '#', '@', '\n', '\r', ' ':
+			return '_'
+		}
+		return r
+	}, value)
+}
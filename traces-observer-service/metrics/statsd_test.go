@@ -0,0 +1,104 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package metrics
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStatsDSend(t *testing.T) {
+	listener, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	defer listener.Close()
+
+	client, err := NewStatsD(listener.LocalAddr().String(), "amp")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer client.Close()
+
+	counters := &Counters{}
+	counters.Add("llm.tokens.input", 120, Tag{Key: "model", Value: "gpt-4o"}, Tag{Key: "component", Value: "c1"})
+	counters.Add("llm.tokens.input", 30, Tag{Key: "component", Value: "c1"}, Tag{Key: "model", Value: "gpt-4o"})
+	counters.Add("llm.cost", 0.0125, Tag{Key: "component", Value: "c1"}, Tag{Key: "project", Value: ""})
+	counters.Add("traces", 1, Tag{Key: "component", Value: "a,b|c"})
+	if counters.Len() != 3 {
+		t.Fatalf("expected increments of the same series to be merged, got %d series", counters.Len())
+	}
+	if err := client.Send(counters); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	buf := make([]byte, maxPacketSize)
+	_ = listener.SetReadDeadline(time.Now().Add(5 * time.Second))
+	n, _, err := listener.ReadFrom(buf)
+	if err != nil {
+		t.Fatalf("failed to read the packet: %v", err)
+	}
+	expected := []string{
+		"amp.llm.tokens.input:150|c|#component:c1,model:gpt-4o",
+		"amp.llm.cost:0.0125|c|#component:c1",
+		"amp.traces:1|c|#component:a_b_c",
+	}
+	if got := string(buf[:n]); got != strings.Join(expected, "\n") {
+		t.Errorf("unexpected packet:\n%s", got)
+	}
+}
+
+func TestStatsDSendSplitsPackets(t *testing.T) {
+	listener, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	defer listener.Close()
+
+	client, err := NewStatsD(listener.LocalAddr().String(), "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer client.Close()
+
+	counters := &Counters{}
+	for i := 0; i < 100; i++ {
+		counters.Add("llm.calls", 1, Tag{Key: "model", Value: strings.Repeat("m", 20) + string(rune('a'+i%26)) + string(rune('a'+i/26))})
+	}
+	if err := client.Send(counters); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	lines := 0
+	buf := make([]byte, 65536)
+	for lines < 100 {
+		_ = listener.SetReadDeadline(time.Now().Add(5 * time.Second))
+		n, _, err := listener.ReadFrom(buf)
+		if err != nil {
+			t.Fatalf("failed to read a packet after %d lines: %v", lines, err)
+		}
+		if n > maxPacketSize {
+			t.Errorf("packet of %d bytes exceeds the maximum size", n)
+		}
+		lines += len(strings.Split(string(buf[:n]), "\n"))
+	}
+	if lines != 100 {
+		t.Errorf("expected 100 lines, got %d", lines)
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+// jwks represents a JSON Web Key Set
+type jwks struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+// jsonWebKey represents a single key in a JWKS
+type jsonWebKey struct {
+	Kty string   `json:"kty"`
+	Kid string   `json:"kid"`
+	Use string   `json:"use"`
+	N   string   `json:"n"`
+	E   string   `json:"e"`
+	Alg string   `json:"alg"`
+	X5c []string `json:"x5c,omitempty"`
+}
+
+// jwksCache holds the signing keys of one issuer. Keys are refreshed when the TTL expires and when
+// a token references an unknown key id, which is how key rotation shows up. Refreshes triggered by
+// unknown key ids are rate limited so that forged kids cannot be used to hammer the JWKS endpoint.
+type jwksCache struct {
+	url                string
+	ttl                time.Duration
+	minRefreshInterval time.Duration
+	httpClient         *http.Client
+
+	mu          sync.Mutex
+	keys        map[string]*rsa.PublicKey
+	fetchedAt   time.Time
+	lastAttempt time.Time
+}
+
+func newJWKSCache(url string, ttl, minRefreshInterval time.Duration) *jwksCache {
+	return &jwksCache{
+		url:                url,
+		ttl:                ttl,
+		minRefreshInterval: minRefreshInterval,
+		httpClient: &http.Client{
+			Timeout:   10 * time.Second,
+			Transport: otelhttp.NewTransport(http.DefaultTransport),
+		},
+	}
+}
+
+// key returns the public key with the given key id
+func (c *jwksCache) key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key, found := c.keys[kid]
+	expired := time.Since(c.fetchedAt) >= c.ttl
+	if found && !expired {
+		return key, nil
+	}
+
+	if time.Since(c.lastAttempt) >= c.minRefreshInterval || c.keys == nil || expired {
+		c.lastAttempt = time.Now()
+		keys, err := c.fetch(ctx)
+		if err != nil {
+			// Keep serving the previous keys so that an IdP outage does not reject every request
+			if !found {
+				return nil, err
+			}
+			slog.Warn("Failed to refresh JWKS, using cached keys", "url", c.url, "error", err)
+			return key, nil
+		}
+		c.keys = keys
+		c.fetchedAt = time.Now()
+		key, found = c.keys[kid]
+	}
+
+	if !found {
+		return nil, fmt.Errorf("unable to find key with kid: %s", kid)
+	}
+	return key, nil
+}
+
+func (c *jwksCache) fetch(ctx context.Context) (map[string]*rsa.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create JWKS request: %w", err)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch JWKS: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("JWKS endpoint returned status: %d", resp.StatusCode)
+	}
+
+	var set jwks
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return nil, fmt.Errorf("failed to decode JWKS: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for i := range set.Keys {
+		jwk := &set.Keys[i]
+		if jwk.Kty != "RSA" || (jwk.Use != "" && jwk.Use != "sig") {
+			continue
+		}
+		key, err := convertJWKToPublicKey(jwk)
+		if err != nil {
+			slog.Warn("Skipping invalid JWKS key", "url", c.url, "kid", jwk.Kid, "error", err)
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	return keys, nil
+}
+
+// convertJWKToPublicKey converts a JWK to an RSA public key
+func convertJWKToPublicKey(jwk *jsonWebKey) (*rsa.PublicKey, error) {
+	// Decode the modulus (n)
+	nBytes, err := base64.RawURLEncoding.DecodeString(jwk.N)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode modulus: %w", err)
+	}
+
+	// Decode the exponent (e)
+	eBytes, err := base64.RawURLEncoding.DecodeString(jwk.E)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode exponent: %w", err)
+	}
+
+	// Convert bytes to big.Int for modulus
+	n := new(big.Int).SetBytes(nBytes)
+
+	// Convert bytes to int for exponent
+	var e int
+	for _, b := range eBytes {
+		e = e<<8 + int(b)
+	}
+
+	return &rsa.PublicKey{
+		N: n,
+		E: e,
+	}, nil
+}
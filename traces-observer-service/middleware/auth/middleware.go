@@ -0,0 +1,85 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auth
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/middleware"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/middleware/logger"
+)
+
+// JWTAuth returns a middleware that rejects requests without a valid bearer token in the given header.
+// When projectsClaim is set, the caller is restricted to the projects listed in that claim of the token.
+func JWTAuth(header string, validator *Validator, projectsClaim string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// Let CORS preflight requests through, they never carry credentials
+			if r.Method == http.MethodOptions {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			token := strings.TrimSpace(strings.TrimPrefix(r.Header.Get(header), "Bearer "))
+			if token == "" {
+				writeUnauthorized(w, "missing authorization token")
+				return
+			}
+			claims, err := validator.Validate(r.Context(), token)
+			if err != nil {
+				logger.GetLogger(r.Context()).Warn("Rejected request with invalid token", "error", err)
+				writeUnauthorized(w, "invalid authorization token")
+				return
+			}
+			r = r.WithContext(withSubject(r.Context(), claims.Subject))
+			scopes, err := claimValues(token, "scope")
+			if err != nil {
+				logger.GetLogger(r.Context()).Warn("Rejected request with invalid scope claim", "error", err)
+				writeUnauthorized(w, "invalid authorization token")
+				return
+			}
+			r = r.WithContext(withScopes(r.Context(), scopes))
+			if projectsClaim != "" {
+				projects, err := claimValues(token, projectsClaim)
+				if err != nil {
+					logger.GetLogger(r.Context()).Warn("Rejected request with invalid projects claim", "error", err)
+					writeUnauthorized(w, "invalid authorization token")
+					return
+				}
+				r = r.WithContext(withProjects(r.Context(), projects))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func writeUnauthorized(w http.ResponseWriter, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("WWW-Authenticate", "Bearer")
+	w.WriteHeader(http.StatusUnauthorized)
+	body := map[string]string{
+		"error":   "unauthorized",
+		"code":    "UNAUTHORIZED",
+		"message": message,
+	}
+	if correlationID := w.Header().Get(middleware.CorrelationIDHeader); correlationID != "" {
+		body["correlationId"] = correlationID
+	}
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type projectsCtxKey struct{}
+
+// withProjects records the projects the caller of a request is restricted to
+func withProjects(ctx context.Context, projects []string) context.Context {
+	return context.WithValue(ctx, projectsCtxKey{}, projects)
+}
+
+// ProjectsFromContext returns the project UIDs the caller of a request may read traces of, and
+// false when the caller is not restricted to projects
+func ProjectsFromContext(ctx context.Context) ([]string, bool) {
+	projects, ok := ctx.Value(projectsCtxKey{}).([]string)
+	return projects, ok
+}
+
+// claimValues reads a claim of a verified token as a list of values. A string claim is split on
+// spaces and commas, as identity providers that cannot issue array claims join the values.
+func claimValues(tokenString, name string) ([]string, error) {
+	parts := strings.Split(tokenString, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid jwt, found %d parts", len(parts))
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode jwt payload: %w", err)
+	}
+	var claims map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal jwt claims: %w", err)
+	}
+	raw, ok := claims[name]
+	if !ok {
+		return []string{}, nil
+	}
+	var values []string
+	if err := json.Unmarshal(raw, &values); err == nil {
+		return values, nil
+	}
+	var joined string
+	if err := json.Unmarshal(raw, &joined); err != nil {
+		return nil, fmt.Errorf("claim %s must be a string or a list of strings", name)
+	}
+	return strings.FieldsFunc(joined, func(r rune) bool { return r == ' ' || r == ',' }), nil
+}
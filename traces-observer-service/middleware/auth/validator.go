@@ -0,0 +1,155 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/config"
+)
+
+// trustedIssuer is an issuer whose tokens are verified against its JWKS
+type trustedIssuer struct {
+	audiences []string
+	keys      *jwksCache
+}
+
+// Validator validates bearer tokens issued by any of the configured identity providers. It checks the
+// signature, issuer, audience and time based claims like agent-manager-service, but unlike it does not
+// accept unsigned tokens when no JWKS is configured. There is no organization claim check, as requests
+// carry no organization to check it against; trace access control restricts callers to projects instead.
+type Validator struct {
+	issuers map[string]*trustedIssuer
+	leeway  time.Duration
+}
+
+// NewValidator creates a validator from the auth configuration
+func NewValidator(cfg config.AuthConfig) *Validator {
+	ttl := time.Duration(cfg.JWKSCacheTTLSeconds) * time.Second
+	minRefresh := time.Duration(cfg.JWKSMinRefreshIntervalSeconds) * time.Second
+
+	v := &Validator{
+		issuers: make(map[string]*trustedIssuer),
+		leeway:  time.Duration(cfg.ClockSkewSeconds) * time.Second,
+	}
+
+	caches := make(map[string]*jwksCache)
+	cacheFor := func(url string) *jwksCache {
+		if c, ok := caches[url]; ok {
+			return c
+		}
+		c := newJWKSCache(url, ttl, minRefresh)
+		caches[url] = c
+		return c
+	}
+
+	if cfg.JWKSUrl != "" {
+		for _, issuer := range cfg.Issuers {
+			v.issuers[strings.TrimSpace(issuer)] = &trustedIssuer{
+				audiences: cfg.Audiences,
+				keys:      cacheFor(cfg.JWKSUrl),
+			}
+		}
+	}
+	for _, issuer := range cfg.TrustedIssuers {
+		audiences := issuer.Audiences
+		if len(audiences) == 0 {
+			audiences = cfg.Audiences
+		}
+		v.issuers[strings.TrimSpace(issuer.Issuer)] = &trustedIssuer{
+			audiences: audiences,
+			keys:      cacheFor(issuer.JWKSUrl),
+		}
+	}
+	return v
+}
+
+// Validate verifies the token signature, issuer, audience and time based claims and returns its claims
+func (v *Validator) Validate(ctx context.Context, tokenString string) (*jwt.RegisteredClaims, error) {
+	issuerName, err := unverifiedIssuer(tokenString)
+	if err != nil {
+		return nil, err
+	}
+	issuer, ok := v.issuers[strings.TrimSpace(issuerName)]
+	if !ok {
+		return nil, fmt.Errorf("untrusted issuer: %s", issuerName)
+	}
+
+	token, err := jwt.ParseWithClaims(tokenString, &jwt.RegisteredClaims{}, func(token *jwt.Token) (interface{}, error) {
+		kid, ok := token.Header["kid"].(string)
+		if !ok {
+			return nil, fmt.Errorf("kid not found in token header")
+		}
+		return issuer.keys.key(ctx, kid)
+	},
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512"}),
+		jwt.WithLeeway(v.leeway),
+		jwt.WithIssuer(issuerName),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse token: %w", err)
+	}
+	claims, ok := token.Claims.(*jwt.RegisteredClaims)
+	if !ok || !token.Valid {
+		return nil, fmt.Errorf("token is not valid")
+	}
+	if err := validateAudience(claims.Audience, issuer.audiences); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// unverifiedIssuer reads the iss claim so that the token can be matched to its issuer before verification
+func unverifiedIssuer(tokenString string) (string, error) {
+	parts := strings.Split(tokenString, ".")
+	if len(parts) != 3 {
+		return "", fmt.Errorf("invalid jwt, found %d parts", len(parts))
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", fmt.Errorf("failed to decode jwt payload: %w", err)
+	}
+	var claims struct {
+		Issuer string `json:"iss"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return "", fmt.Errorf("failed to unmarshal jwt claims: %w", err)
+	}
+	return claims.Issuer, nil
+}
+
+// validateAudience validates the audience claim against allowed audiences
+func validateAudience(audiences jwt.ClaimStrings, allowedAudiences []string) error {
+	if len(allowedAudiences) == 0 {
+		return fmt.Errorf("no allowed audiences configured")
+	}
+	for _, aud := range audiences {
+		for _, allowed := range allowedAudiences {
+			if strings.TrimSpace(aud) == strings.TrimSpace(allowed) {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("invalid audience: got %v", audiences)
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// CorrelationIDHeader is the header that carries the correlation ID of a request and its response
+const CorrelationIDHeader = "x-correlation-id"
+
+// ContentVisibilityHeader is the header that narrows how much of the content of spans a request
+// returns: full, redacted or metadata-only. Callers that enforce a per-organization setting, such as
+// the agent manager, send it on every request. It never widens the visibility the caller is allowed.
+const ContentVisibilityHeader = "x-content-visibility"
+
+// CorrelationID echoes the correlation ID of a request on its response, or generates one when the
+// caller sent none, so that error responses and logs of a request can be matched
+func CorrelationID() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			correlationID := r.Header.Get(CorrelationIDHeader)
+			if correlationID == "" {
+				correlationID = uuid.New().String()
+			}
+			w.Header().Set(CorrelationIDHeader, correlationID)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -36,8 +36,8 @@ func DefaultCORSConfig() CORSConfig {
 	return CORSConfig{
 		AllowedOrigins:   []string{"*"},
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Content-Type", "Content-Length", "Authorization"},
-		ExposedHeaders:   []string{},
+		AllowedHeaders:   []string{"Accept", "Content-Type", "Content-Length", "Authorization", CorrelationIDHeader},
+		ExposedHeaders:   []string{CorrelationIDHeader},
 		AllowCredentials: false,
 		MaxAge:           3600,
 	}
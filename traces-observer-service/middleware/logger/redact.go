@@ -0,0 +1,154 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package logger
+
+import (
+	"encoding/json"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// redacted replaces the values of sensitive fields in logged bodies
+const redacted = "[REDACTED]"
+
+// sensitiveKeyParts are matched against field names with case, '-' and '_' ignored, so that
+// "apiKey", "api_key" and "X-API-Key" are all redacted
+var sensitiveKeyParts = []string{
+	"token",
+	"password",
+	"passphrase",
+	"secret",
+	"apikey",
+	"authorization",
+	"credential",
+	"privatekey",
+	"cookie",
+}
+
+// sensitiveValuePatterns match secrets in bodies that cannot be parsed, such as truncated JSON
+// or plain text
+var sensitiveValuePatterns = []struct {
+	pattern     *regexp.Regexp
+	replacement string
+}{
+	{
+		// "name": "value" pairs of JSON whose name looks sensitive
+		pattern:     regexp.MustCompile(`(?i)("[^"]*(?:token|password|passphrase|secret|api[-_]?key|authorization|credential|private[-_]?key|cookie)[^"]*"\s*:\s*)"(?:[^"\\]|\\.)*"?`),
+		replacement: `$1"` + redacted + `"`,
+	},
+	{
+		pattern:     regexp.MustCompile(`(?i)\b(bearer|basic)\s+[A-Za-z0-9._~+/=-]+`),
+		replacement: "$1 " + redacted,
+	},
+	{
+		// API keys of LLM providers, such as OpenAI and Anthropic keys
+		pattern:     regexp.MustCompile(`\bsk-[A-Za-z0-9_-]{16,}`),
+		replacement: redacted,
+	},
+	{
+		pattern:     regexp.MustCompile(`\beyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`),
+		replacement: redacted,
+	},
+}
+
+// isSensitiveKey reports whether a field of the given name holds a secret
+func isSensitiveKey(key string) bool {
+	normalized := strings.NewReplacer("-", "", "_", "").Replace(strings.ToLower(key))
+	for _, part := range sensitiveKeyParts {
+		if strings.Contains(normalized, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactBody returns a body of the given content type with its tokens, credentials and API keys
+// replaced. Complete JSON and form bodies are redacted by field name; anything else, including
+// bodies that were cut off, is redacted by pattern.
+func redactBody(contentType string, body []byte, truncated bool) string {
+	if !truncated {
+		switch {
+		case strings.Contains(contentType, "json"):
+			var value any
+			if err := json.Unmarshal(body, &value); err == nil {
+				if redactedBody, err := json.Marshal(redactValue(value)); err == nil {
+					return string(redactedBody)
+				}
+			}
+		case strings.HasPrefix(contentType, "application/x-www-form-urlencoded"):
+			if values, err := url.ParseQuery(string(body)); err == nil {
+				for key := range values {
+					if isSensitiveKey(key) {
+						values[key] = []string{redacted}
+					}
+				}
+				return values.Encode()
+			}
+		}
+	}
+	return redactText(string(body))
+}
+
+// redactValue replaces the sensitive fields of a decoded JSON value. Strings of sensitive fields
+// are replaced, while numbers and booleans are kept, since fields such as "maxTokens" hold limits
+// rather than secrets. Objects of sensitive fields are redacted field by field, unless they hold
+// credentials or secrets as a whole.
+func redactValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if !isSensitiveKey(key) {
+				v[key] = redactValue(field)
+				continue
+			}
+			switch field.(type) {
+			case string:
+				v[key] = redacted
+			case map[string]any, []any:
+				if holdsSecrets(key) {
+					v[key] = redacted
+				} else {
+					v[key] = redactValue(field)
+				}
+			}
+		}
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+		return v
+	case string:
+		return redactText(v)
+	default:
+		return v
+	}
+}
+
+// holdsSecrets reports whether an object of the given name holds secrets in all of its fields
+func holdsSecrets(key string) bool {
+	key = strings.ToLower(key)
+	return strings.Contains(key, "credential") || strings.Contains(key, "secret")
+}
+
+func redactText(text string) string {
+	for _, p := range sensitiveValuePatterns {
+		text = p.pattern.ReplaceAllString(text, p.replacement)
+	}
+	return text
+}
@@ -21,6 +21,8 @@ import (
 	"log/slog"
 	"net/http"
 	"time"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/middleware"
 )
 
 type loggerKey struct{}
@@ -67,6 +69,10 @@ func RequestLogger() func(http.Handler) http.Handler {
 				slog.String("path", r.URL.Path),
 				slog.String("remote_addr", r.RemoteAddr),
 			)
+			// Set by the correlation ID middleware, which runs before this one
+			if correlationID := w.Header().Get(middleware.CorrelationIDHeader); correlationID != "" {
+				reqLogger = reqLogger.With(slog.String("correlation_id", correlationID))
+			}
 			ctx := WithLogger(r.Context(), reqLogger)
 
 			// Call the next handler
@@ -0,0 +1,116 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"context"
+	"strings"
+)
+
+// Fields that spans, and the trace summaries, annotations and issues derived from them, are
+// restricted to the caller's projects on
+const (
+	projectUidField        = "resource.openchoreo.dev/project-uid"
+	summaryProjectUidField = "projectUid"
+)
+
+// AllProjects is the project claim value that grants access to the traces of every project
+const AllProjects = "*"
+
+// ProjectAccess returns the project UIDs the caller of a request may read traces of, and false
+// when the caller is not restricted to projects
+type ProjectAccess func(ctx context.Context) ([]string, bool)
+
+// RestrictToProjects limits every search and delete by query of the client to the spans, trace
+// summaries, annotations and issues of the projects the caller of the request has access to.
+// Searches of other indices, such as application logs, are not restricted; logs are only read for
+// traces that a restricted search found.
+func (c *Client) RestrictToProjects(access ProjectAccess) {
+	c.projectAccess = access
+}
+
+// restrict returns the query limited to the projects of the caller, or the query itself when the
+// caller is not restricted or the indices hold no trace data
+func (c *Client) restrict(ctx context.Context, indices []string, query Query) Query {
+	if c.projectAccess == nil {
+		return query
+	}
+	projects, restricted := c.projectAccess(ctx)
+	if !restricted {
+		return query
+	}
+	return restrictToProjects(indices, query, projects)
+}
+
+func restrictToProjects(indices []string, query Query, projects []string) Query {
+	for _, project := range projects {
+		if project == AllProjects {
+			return query
+		}
+	}
+	fields := projectFieldsOf(indices)
+	if len(fields) == 0 {
+		return query
+	}
+	// No project matches no document, so callers without projects see no traces
+	if projects == nil {
+		projects = []string{}
+	}
+	filters := make([]Query, len(fields))
+	for i, field := range fields {
+		filters[i] = Terms(field, projects)
+	}
+	restricted := Bool()
+	if len(filters) == 1 {
+		restricted.Filter(filters[0])
+	} else {
+		restricted.Filter(Bool().Should(filters...).MinimumShouldMatch(1))
+	}
+	if query != nil {
+		restricted.Must(query)
+	}
+	return restricted
+}
+
+// projectFieldsOf returns the fields holding the project of the documents of the indices, or none
+// when an index holds no trace data. A point in time search names no indices;
+// points in time are only opened over span indices.
+func projectFieldsOf(indices []string) []string {
+	if len(indices) == 0 {
+		return []string{projectUidField}
+	}
+	var spans, summaries bool
+	for _, index := range indices {
+		switch {
+		// Annotations and issues record their project in the same field as summaries
+		case strings.HasPrefix(index, traceSummaryIndexPrefix), index == TraceAnnotationIndex, index == IssueIndex:
+			summaries = true
+		case strings.HasPrefix(index, spanIndexPrefix), strings.HasPrefix(index, archivedSpanIndexPrefix):
+			spans = true
+		default:
+			return nil
+		}
+	}
+	var fields []string
+	if spans {
+		fields = append(fields, projectUidField)
+	}
+	if summaries {
+		fields = append(fields, summaryProjectUidField)
+	}
+	return fields
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"context"
+	"testing"
+)
+
+func TestRestrictToProjects(t *testing.T) {
+	query := Term("traceId", "abc")
+	tests := []struct {
+		name     string
+		indices  []string
+		projects []string
+		expected string
+	}{
+		{
+			name:     "spans are filtered on the project resource attribute",
+			indices:  []string{"otel-traces-2026-01-01", "otel-traces-2026-01-02"},
+			projects: []string{"p1", "p2"},
+			expected: `{"bool":{"filter":[{"terms":{"resource.openchoreo.dev/project-uid":["p1","p2"]}}],"must":[{"term":{"traceId":"abc"}}]}}`,
+		},
+		{
+			name:     "trace summaries are filtered on their project",
+			indices:  []string{"otel-trace-summaries-2026-01-01"},
+			projects: []string{"p1"},
+			expected: `{"bool":{"filter":[{"terms":{"projectUid":["p1"]}}],"must":[{"term":{"traceId":"abc"}}]}}`,
+		},
+		{
+			name:     "annotations are filtered on their project",
+			indices:  []string{TraceAnnotationIndex},
+			projects: []string{"p1"},
+			expected: `{"bool":{"filter":[{"terms":{"projectUid":["p1"]}}],"must":[{"term":{"traceId":"abc"}}]}}`,
+		},
+		{
+			name:     "archived spans are filtered as spans",
+			indices:  []string{"otel-traces-2026-01-01", "archived-otel-traces-2026-01-01"},
+			projects: []string{"p1"},
+			expected: `{"bool":{"filter":[{"terms":{"resource.openchoreo.dev/project-uid":["p1"]}}],"must":[{"term":{"traceId":"abc"}}]}}`,
+		},
+		{
+			name:     "point in time searches are filtered as spans",
+			projects: []string{"p1"},
+			expected: `{"bool":{"filter":[{"terms":{"resource.openchoreo.dev/project-uid":["p1"]}}],"must":[{"term":{"traceId":"abc"}}]}}`,
+		},
+		{
+			name:     "callers without projects match nothing",
+			indices:  []string{"otel-traces-2026-01-01"},
+			expected: `{"bool":{"filter":[{"terms":{"resource.openchoreo.dev/project-uid":[]}}],"must":[{"term":{"traceId":"abc"}}]}}`,
+		},
+		{
+			name:     "the all projects value is not restricted",
+			indices:  []string{"otel-traces-2026-01-01"},
+			projects: []string{"p1", AllProjects},
+			expected: `{"term":{"traceId":"abc"}}`,
+		},
+		{
+			name:     "other indices are not restricted",
+			indices:  []string{"container-logs-*"},
+			projects: []string{"p1"},
+			expected: `{"term":{"traceId":"abc"}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireJSON(t, tt.expected, restrictToProjects(tt.indices, query, tt.projects).Source())
+		})
+	}
+
+	t.Run("unrestricted callers keep the query", func(t *testing.T) {
+		client := &Client{}
+		client.RestrictToProjects(func(ctx context.Context) ([]string, bool) { return nil, false })
+		requireJSON(t, `{"term":{"traceId":"abc"}}`, client.restrict(context.Background(), []string{"otel-traces-2026-01-01"}, query).Source())
+	})
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// decodeDocuments decodes the sources of the hits of a search into documents of type T
+func decodeDocuments[T any](response *SearchResponse) ([]T, error) {
+	documents := make([]T, 0, len(response.Hits.Hits))
+	for _, hit := range response.Hits.Hits {
+		source, err := json.Marshal(hit.Source)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode document: %w", err)
+		}
+		var document T
+		if err := json.Unmarshal(source, &document); err != nil {
+			return nil, fmt.Errorf("failed to decode document: %w", err)
+		}
+		documents = append(documents, document)
+	}
+	return documents, nil
+}
+
+// GetString returns the value of the first of keys set in m that can be read as a string. Numbers
+// and booleans are formatted, so that attributes indexed with another type are not lost.
+func GetString(m map[string]interface{}, keys ...string) (string, bool) {
+	for _, key := range keys {
+		if value, ok := extractStringValue(m[key]); ok {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// GetFloat returns the value of the first of keys set in m that can be read as a number,
+// including numbers stored as strings
+func GetFloat(m map[string]interface{}, keys ...string) (float64, bool) {
+	for _, key := range keys {
+		if value, ok := extractFloatValue(m[key]); ok {
+			return value, true
+		}
+	}
+	return 0, false
+}
+
+// GetInt returns the value of the first of keys set in m that can be read as an integer,
+// including integers stored as strings. Fractions are truncated.
+func GetInt(m map[string]interface{}, keys ...string) (int, bool) {
+	for _, key := range keys {
+		if value, ok := extractIntValue(m[key]); ok {
+			return value, true
+		}
+	}
+	return 0, false
+}
+
+// extractStringValue extracts a string value from an interface{} that could be a string, number or boolean
+func extractStringValue(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case json.Number:
+		return v.String(), true
+	case int:
+		return strconv.Itoa(v), true
+	case int64:
+		return strconv.FormatInt(v, 10), true
+	case bool:
+		return strconv.FormatBool(v), true
+	default:
+		return "", false
+	}
+}
+
+// extractIntValue extracts an integer value from an interface{} that could be int, float64, or string
+func extractIntValue(value interface{}) (int, bool) {
+	switch v := value.(type) {
+	case int:
+		return v, true
+	case int64:
+		return int(v), true
+	case float64:
+		return int(v), true
+	case json.Number:
+		return extractIntValue(v.String())
+	case string:
+		v = strings.TrimSpace(v)
+		if parsed, err := strconv.Atoi(v); err == nil {
+			return parsed, true
+		}
+		// Integers may be stored as floats, such as "200.0"
+		if parsed, err := strconv.ParseFloat(v, 64); err == nil {
+			return int(parsed), true
+		}
+		return 0, false
+	default:
+		return 0, false
+	}
+}
+
+// extractFloatValue extracts a float64 value from an interface{} that could be int, float64, or string
+func extractFloatValue(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case json.Number:
+		return extractFloatValue(v.String())
+	case string:
+		if parsed, err := strconv.ParseFloat(strings.TrimSpace(v), 64); err == nil {
+			return parsed, true
+		}
+		return 0, false
+	default:
+		return 0, false
+	}
+}
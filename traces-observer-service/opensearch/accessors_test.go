@@ -0,0 +1,128 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import "testing"
+
+func TestGetString(t *testing.T) {
+	attrs := map[string]interface{}{
+		"gen_ai.request.model": "gpt-4o",
+		"gen_ai.conversation":  float64(42),
+		"streaming":            true,
+		"tool.arguments":       map[string]interface{}{"city": "Colombo"},
+	}
+
+	tests := []struct {
+		name   string
+		keys   []string
+		want   string
+		wantOk bool
+	}{
+		{"first key set", []string{"gen_ai.response.model", "gen_ai.request.model"}, "gpt-4o", true},
+		{"number", []string{"gen_ai.conversation"}, "42", true},
+		{"boolean", []string{"streaming"}, "true", true},
+		{"object is skipped", []string{"tool.arguments", "gen_ai.request.model"}, "gpt-4o", true},
+		{"missing", []string{"gen_ai.system"}, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := GetString(attrs, tt.keys...)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("GetString(%v) = %q, %v, want %q, %v", tt.keys, got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+
+	if _, ok := GetString(nil, "gen_ai.system"); ok {
+		t.Error("expected no value from nil attributes")
+	}
+}
+
+func TestGetInt(t *testing.T) {
+	attrs := map[string]interface{}{
+		"gen_ai.usage.prompt_tokens": "120",
+		"gen_ai.usage.output_tokens": float64(30),
+		"http.status_code":           "404.0",
+		"gen_ai.usage.input_tokens":  "many",
+	}
+
+	tests := []struct {
+		name   string
+		keys   []string
+		want   int
+		wantOk bool
+	}{
+		{"number", []string{"gen_ai.usage.output_tokens"}, 30, true},
+		{"number stored as string", []string{"gen_ai.usage.prompt_tokens"}, 120, true},
+		{"float stored as string", []string{"http.status_code"}, 404, true},
+		{"unparsable value falls back", []string{"gen_ai.usage.input_tokens", "gen_ai.usage.prompt_tokens"}, 120, true},
+		{"missing", []string{"gen_ai.usage.cache_read_input_tokens"}, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := GetInt(attrs, tt.keys...)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("GetInt(%v) = %d, %v, want %d, %v", tt.keys, got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestGetFloat(t *testing.T) {
+	attrs := map[string]interface{}{
+		"gen_ai.request.temperature": " 0.7 ",
+		"gen_ai.request.top_p":       float64(1),
+	}
+	if got, ok := GetFloat(attrs, "gen_ai.request.temperature"); !ok || got != 0.7 {
+		t.Errorf("expected 0.7 from a string, got %v, %v", got, ok)
+	}
+	if got, ok := GetFloat(attrs, "gen_ai.request.top_k", "gen_ai.request.top_p"); !ok || got != 1 {
+		t.Errorf("expected the fallback key, got %v, %v", got, ok)
+	}
+	if _, ok := GetFloat(attrs, "gen_ai.request.top_k"); ok {
+		t.Error("expected no value for a missing key")
+	}
+}
+
+func TestParseSpanNumbersStoredAsStrings(t *testing.T) {
+	span := parseSpan(map[string]interface{}{
+		"traceId":         "t1",
+		"spanId":          "s1",
+		"durationInNanos": "1500000",
+		"status":          map[string]interface{}{"code": float64(2)},
+		"attributes": map[string]interface{}{
+			"gen_ai.operation.name":      "chat",
+			"gen_ai.request.model":       "gpt-4o",
+			"gen_ai.usage.input_tokens":  "100",
+			"gen_ai.usage.output_tokens": "20",
+			"http.status_code":           "500",
+		},
+	})
+	if span.DurationInNanos != 1500000 {
+		t.Errorf("expected a duration of 1500000, got %d", span.DurationInNanos)
+	}
+	if span.Status != "2" {
+		t.Errorf("expected status 2, got %q", span.Status)
+	}
+	data, ok := span.AmpAttributes.Data.(LLMData)
+	if !ok || data.TokenUsage == nil || data.TokenUsage.TotalTokens != 120 {
+		t.Errorf("expected 120 tokens, got %+v", span.AmpAttributes.Data)
+	}
+	if status := span.AmpAttributes.Status; status == nil || !status.Error || status.ErrorType != "500" {
+		t.Errorf("expected an HTTP 500 error status, got %+v", status)
+	}
+}
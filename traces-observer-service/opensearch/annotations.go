@@ -0,0 +1,108 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+// Annotations are notes that users attach to a trace or to one of its spans, for example while
+// reviewing an incident. They are kept in a single index, apart from the spans, so that they outlive
+// the retention of the span indices and are never changed by span ingestion.
+const (
+	// TraceAnnotationIndex is the index annotations are stored in, which is also the name of its
+	// index template
+	TraceAnnotationIndex = "otel-trace-annotations"
+	// MaxAnnotationsPerTrace bounds the number of annotations returned for a trace
+	MaxAnnotationsPerTrace   = 500
+	annotationCreatedAtField = "createdAt"
+)
+
+// Annotation is a note on a trace, or on a span of it when SpanID is set
+type Annotation struct {
+	ID      string `json:"id"`
+	TraceID string `json:"traceId"`
+	SpanID  string `json:"spanId,omitempty"`
+	Text    string `json:"text"`
+	// Author is the subject of the token the annotation was written with, or the author named in
+	// the request when authentication is disabled
+	Author       string `json:"author,omitempty"`
+	ComponentUid string `json:"componentUid,omitempty"`
+	// ProjectUid is the project of the annotated trace, which callers restricted to projects are
+	// filtered on
+	ProjectUid string `json:"projectUid,omitempty"`
+	CreatedAt  string `json:"createdAt"`
+	UpdatedAt  string `json:"updatedAt"`
+}
+
+// AnnotationParams holds the parameters of a new annotation
+type AnnotationParams struct {
+	TraceID string
+	SpanID  string
+	Text    string
+	Author  string
+}
+
+// TraceAnnotationIndexTemplateSource returns the index template of the annotation index. The text of
+// annotations is kept in the source without being indexed.
+func TraceAnnotationIndexTemplateSource() map[string]interface{} {
+	keyword := map[string]interface{}{"type": "keyword"}
+	return map[string]interface{}{
+		"index_patterns": []string{TraceAnnotationIndex},
+		"template": map[string]interface{}{
+			"mappings": map[string]interface{}{
+				"dynamic": false,
+				"properties": map[string]interface{}{
+					"id":           keyword,
+					"traceId":      keyword,
+					"spanId":       keyword,
+					"author":       keyword,
+					"componentUid": keyword,
+					"projectUid":   keyword,
+					"createdAt":    map[string]interface{}{"type": "date"},
+					"updatedAt":    map[string]interface{}{"type": "date"},
+				},
+			},
+		},
+	}
+}
+
+// BuildAnnotationTargetQuery builds a search for a span of a trace, or for the span with spanID when
+// it is given, which checks that the trace exists before it is annotated
+func BuildAnnotationTargetQuery(traceID, spanID string) *SearchSource {
+	query := Bool().Must(Term(traceIdField, traceID))
+	if spanID != "" {
+		query.Must(Term("spanId", spanID))
+	}
+	return NewSearch().Size(1).Query(query)
+}
+
+// BuildTraceAnnotationsQuery builds a search for the annotations of a trace, oldest first
+func BuildTraceAnnotationsQuery(traceID string) *SearchSource {
+	return NewSearch().
+		Size(MaxAnnotationsPerTrace).
+		Query(Term("traceId", traceID)).
+		Sort(annotationCreatedAtField, SortAsc)
+}
+
+// BuildAnnotationQuery builds a search for an annotation of a trace
+func BuildAnnotationQuery(traceID, annotationID string) *SearchSource {
+	return NewSearch().
+		Size(1).
+		Query(Bool().Must(Term("traceId", traceID), Term("id", annotationID)))
+}
+
+// ParseAnnotations reads the annotations of a search response
+func ParseAnnotations(response *SearchResponse) ([]Annotation, error) {
+	return decodeDocuments[Annotation](response)
+}
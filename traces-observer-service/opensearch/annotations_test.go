@@ -0,0 +1,62 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBuildAnnotationQueries(t *testing.T) {
+	requireJSON(t, `{
+		"query":{"bool":{"must":[{"term":{"traceId":"t1"}},{"term":{"spanId":"s1"}}]}},
+		"size":1
+	}`, BuildAnnotationTargetQuery("t1", "s1").Source())
+
+	requireJSON(t, `{
+		"query":{"term":{"traceId":"t1"}},
+		"size":500,
+		"sort":[{"createdAt":{"order":"asc"}}]
+	}`, BuildTraceAnnotationsQuery("t1").Source())
+
+	requireJSON(t, `{
+		"query":{"bool":{"must":[{"term":{"traceId":"t1"}},{"term":{"id":"a1"}}]}},
+		"size":1
+	}`, BuildAnnotationQuery("t1", "a1").Source())
+}
+
+func TestParseAnnotations(t *testing.T) {
+	var response SearchResponse
+	if err := json.Unmarshal([]byte(`{"hits":{"total":{"value":1},"hits":[{"_source":{
+		"id":"a1","traceId":"t1","spanId":"s1","text":"Timed out on the search tool","author":"alice",
+		"projectUid":"p1","createdAt":"2026-01-01T10:00:00Z","updatedAt":"2026-01-01T10:05:00Z"
+	}}]}}`), &response); err != nil {
+		t.Fatalf("invalid response: %v", err)
+	}
+
+	annotations, err := ParseAnnotations(&response)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := Annotation{
+		ID: "a1", TraceID: "t1", SpanID: "s1", Text: "Timed out on the search tool", Author: "alice",
+		ProjectUid: "p1", CreatedAt: "2026-01-01T10:00:00Z", UpdatedAt: "2026-01-01T10:05:00Z",
+	}
+	if len(annotations) != 1 || annotations[0] != expected {
+		t.Errorf("unexpected annotations: %+v", annotations)
+	}
+}
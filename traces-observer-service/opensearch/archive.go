@@ -0,0 +1,269 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/opensearch-project/opensearch-go/opensearchapi"
+)
+
+// archivedSpanIndexPrefix is the prefix of the searchable snapshots daily span indices are
+// archived to. The rest of the name is the name of the span index.
+const archivedSpanIndexPrefix = "archived-" + spanIndexPrefix
+
+// Stages of the archiving of a span index, which advances by at most one stage per call of
+// ArchiveSpanIndex so that no call waits on a long-running snapshot
+const (
+	// ArchiveStageSnapshotting is reported while the snapshot of the index is being taken
+	ArchiveStageSnapshotting = "snapshotting"
+	// ArchiveStageMounting is reported while the snapshot is being mounted as a searchable snapshot
+	ArchiveStageMounting = "mounting"
+	// ArchiveStageArchived is reported once the archived index holds every span and the index is deleted
+	ArchiveStageArchived = "archived"
+)
+
+type archivedTracesKey struct{}
+
+// WithArchivedTraces returns a context whose span searches also read the archived span indices
+func WithArchivedTraces(ctx context.Context) context.Context {
+	return context.WithValue(ctx, archivedTracesKey{}, true)
+}
+
+// ArchivedTracesFromContext reports whether the context was created with WithArchivedTraces
+func ArchivedTracesFromContext(ctx context.Context) bool {
+	archived, _ := ctx.Value(archivedTracesKey{}).(bool)
+	return archived
+}
+
+// withArchivedIndices adds the archived counterpart of each span index to the indices searched
+// when the context asks for archived traces. Searches ignore indices that do not exist, so the
+// days that are not archived cost nothing.
+func withArchivedIndices(ctx context.Context, indices []string) []string {
+	if !ArchivedTracesFromContext(ctx) {
+		return indices
+	}
+	extended := make([]string, 0, 2*len(indices))
+	extended = append(extended, indices...)
+	for _, index := range indices {
+		if strings.HasPrefix(index, spanIndexPrefix) {
+			extended = append(extended, archivedIndexName(index))
+		}
+	}
+	return extended
+}
+
+func archivedIndexName(index string) string {
+	return "archived-" + index
+}
+
+// SpanIndicesToArchive returns the daily span indices among names holding days that ended more
+// than afterDays days before now
+func SpanIndicesToArchive(names []string, now time.Time, afterDays int) []string {
+	cutoff := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, -afterDays)
+	var indices []string
+	for _, name := range names {
+		if !strings.HasPrefix(name, spanIndexPrefix) {
+			continue
+		}
+		day, err := time.Parse("2006-01-02", strings.TrimPrefix(name, spanIndexPrefix))
+		if err != nil {
+			continue
+		}
+		if day.Before(cutoff) {
+			indices = append(indices, name)
+		}
+	}
+	return indices
+}
+
+// ArchiveSpanIndex moves a daily span index to a snapshot repository, such as an S3 bucket
+// registered with the repository-s3 plugin, and returns the stage it reached. Each call advances
+// one stage: the index is snapshotted, the snapshot is mounted as a searchable snapshot under the
+// archived name, and the index is deleted once the archived index holds all of its spans. The
+// snapshot is named after the index, so a call after a restart resumes where the last one left off.
+func (c *Client) ArchiveSpanIndex(ctx context.Context, repository, index string) (string, error) {
+	archived := archivedIndexName(index)
+	sizes, err := c.IndexSizes(ctx, archived+"*")
+	if err != nil {
+		return "", err
+	}
+	if len(sizes) > 0 {
+		hot, err := c.IndexSizes(ctx, index+"*")
+		if err != nil {
+			return "", err
+		}
+		if len(hot) > 0 {
+			if hot[0].DocCount != sizes[0].DocCount {
+				return ArchiveStageMounting, nil
+			}
+			if err := c.deleteIndex(ctx, index); err != nil {
+				return "", err
+			}
+		}
+		return ArchiveStageArchived, nil
+	}
+
+	state, err := c.snapshotState(ctx, repository, index)
+	if err != nil {
+		return "", err
+	}
+	switch state {
+	case "":
+		return ArchiveStageSnapshotting, c.createSnapshot(ctx, repository, index)
+	case "IN_PROGRESS":
+		return ArchiveStageSnapshotting, nil
+	case "SUCCESS":
+		return ArchiveStageMounting, c.mountSnapshot(ctx, repository, index, archived)
+	default:
+		// A failed or partial snapshot is removed, so that the next call takes it again
+		if err := c.deleteSnapshot(ctx, repository, index); err != nil {
+			return "", err
+		}
+		return "", fmt.Errorf("snapshot of %s ended with state %s", index, state)
+	}
+}
+
+// snapshotState returns the state of a snapshot, which is empty when it does not exist
+func (c *Client) snapshotState(ctx context.Context, repository, snapshot string) (string, error) {
+	req := opensearchapi.SnapshotGetRequest{
+		Repository: repository,
+		Snapshot:   []string{snapshot},
+	}
+	res, err := req.Do(ctx, c.client)
+	if err != nil {
+		return "", fmt.Errorf("get snapshot request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return "", nil
+	}
+	if res.IsError() {
+		return "", fmt.Errorf("get snapshot request failed with status: %s", res.Status())
+	}
+
+	var response struct {
+		Snapshots []struct {
+			State string `json:"state"`
+		} `json:"snapshots"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return "", fmt.Errorf("failed to decode response: %w", err)
+	}
+	if len(response.Snapshots) == 0 {
+		return "", nil
+	}
+	return response.Snapshots[0].State, nil
+}
+
+// createSnapshot starts a snapshot of a single index without waiting for it to complete
+func (c *Client) createSnapshot(ctx context.Context, repository, index string) error {
+	body, err := json.Marshal(map[string]interface{}{
+		"indices":              index,
+		"include_global_state": false,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode snapshot request: %w", err)
+	}
+	req := opensearchapi.SnapshotCreateRequest{
+		Repository:        repository,
+		Snapshot:          index,
+		Body:              bytes.NewReader(body),
+		WaitForCompletion: opensearchapi.BoolPtr(false),
+	}
+	res, err := req.Do(ctx, c.client)
+	if err != nil {
+		return fmt.Errorf("create snapshot request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return fmt.Errorf("create snapshot request failed with status: %s", res.Status())
+	}
+	return nil
+}
+
+// mountSnapshot restores the index of a snapshot as a searchable snapshot, whose data stays in the
+// repository and is fetched as it is searched
+func (c *Client) mountSnapshot(ctx context.Context, repository, index, archived string) error {
+	body, err := json.Marshal(map[string]interface{}{
+		"indices":              index,
+		"storage_type":         "remote_snapshot",
+		"rename_pattern":       "(.+)",
+		"rename_replacement":   archived,
+		"include_global_state": false,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode restore request: %w", err)
+	}
+	req := opensearchapi.SnapshotRestoreRequest{
+		Repository:        repository,
+		Snapshot:          index,
+		Body:              bytes.NewReader(body),
+		WaitForCompletion: opensearchapi.BoolPtr(false),
+	}
+	res, err := req.Do(ctx, c.client)
+	if err != nil {
+		return fmt.Errorf("restore snapshot request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return fmt.Errorf("restore snapshot request failed with status: %s", res.Status())
+	}
+	return nil
+}
+
+func (c *Client) deleteSnapshot(ctx context.Context, repository, snapshot string) error {
+	req := opensearchapi.SnapshotDeleteRequest{
+		Repository: repository,
+		Snapshot:   snapshot,
+	}
+	res, err := req.Do(ctx, c.client)
+	if err != nil {
+		return fmt.Errorf("delete snapshot request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() && res.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("delete snapshot request failed with status: %s", res.Status())
+	}
+	return nil
+}
+
+func (c *Client) deleteIndex(ctx context.Context, index string) error {
+	req := opensearchapi.IndicesDeleteRequest{
+		Index: []string{index},
+	}
+	res, err := req.Do(ctx, c.client)
+	if err != nil {
+		return fmt.Errorf("delete index request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() && res.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("delete index request failed with status: %s", res.Status())
+	}
+	return nil
+}
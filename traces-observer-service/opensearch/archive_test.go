@@ -0,0 +1,58 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSpanIndicesToArchive(t *testing.T) {
+	now := time.Date(2026, 3, 31, 15, 0, 0, 0, time.UTC)
+	names := []string{
+		"otel-traces-2026-02-28",
+		"otel-traces-2026-03-01",
+		"otel-traces-2026-03-02",
+		"otel-traces-2026-03-31",
+		"otel-traces-invalid",
+		"otel-trace-summaries-2026-01-01",
+	}
+	indices := SpanIndicesToArchive(names, now, 30)
+	want := []string{"otel-traces-2026-02-28"}
+	if !reflect.DeepEqual(indices, want) {
+		t.Errorf("expected %v, got %v", want, indices)
+	}
+}
+
+func TestWithArchivedIndices(t *testing.T) {
+	indices := []string{"otel-traces-2026-01-01", TraceAnnotationIndex}
+
+	if got := withArchivedIndices(context.Background(), indices); !reflect.DeepEqual(got, indices) {
+		t.Errorf("expected the indices to be unchanged, got %v", got)
+	}
+
+	got := withArchivedIndices(WithArchivedTraces(context.Background()), indices)
+	want := []string{"otel-traces-2026-01-01", TraceAnnotationIndex, "archived-otel-traces-2026-01-01"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if len(indices) != 2 {
+		t.Errorf("expected the given indices not to be modified, got %v", indices)
+	}
+}
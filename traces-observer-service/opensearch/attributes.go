@@ -0,0 +1,200 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// AttributesFieldPattern matches the fields span attributes are stored in
+const AttributesFieldPattern = "attributes.*"
+
+const attributesFieldPrefix = "attributes."
+
+// AttributeFields returns the span attribute keys with a prefix among field capabilities, sorted
+// by key. Text attributes are aggregated on their keyword subfield; attributes that cannot be
+// aggregated, and object fields, are left out.
+func AttributeFields(caps map[string]map[string]FieldCapability, prefix string) []AttributeField {
+	fields := []AttributeField{}
+	for name, types := range caps {
+		key, ok := strings.CutPrefix(name, attributesFieldPrefix)
+		if !ok || !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		// Keyword subfields are reported as their text attribute
+		if parent, isSubfield := strings.CutSuffix(name, ".keyword"); isSubfield {
+			if _, ok := caps[parent]; ok {
+				continue
+			}
+		}
+
+		capability, ok := aggregatableCapability(types)
+		field := name
+		if !ok {
+			mapping := mappingType(types)
+			if _, hasKeyword := aggregatableCapability(caps[name+".keyword"]); !hasKeyword || mapping == "" {
+				continue
+			}
+			field = name + ".keyword"
+			capability.Type = mapping
+		}
+		fields = append(fields, AttributeField{Key: key, Field: field, Type: capability.Type})
+	}
+	sort.Slice(fields, func(i, j int) bool { return fields[i].Key < fields[j].Key })
+	return fields
+}
+
+// aggregatableCapability returns the aggregatable mapping type of a field, preferring the type
+// that sorts first when the field is mapped differently across indices
+func aggregatableCapability(types map[string]FieldCapability) (FieldCapability, bool) {
+	names := make([]string, 0, len(types))
+	for name := range types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		capability := types[name]
+		if capability.Aggregatable && !isObjectType(name) {
+			capability.Type = name
+			return capability, true
+		}
+	}
+	return FieldCapability{}, false
+}
+
+// mappingType returns the value mapping type of a field, or an empty string for object fields
+func mappingType(types map[string]FieldCapability) string {
+	names := make([]string, 0, len(types))
+	for name := range types {
+		if !isObjectType(name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	if len(names) == 0 {
+		return ""
+	}
+	return names[0]
+}
+
+func isObjectType(mappingType string) bool {
+	return mappingType == "object" || mappingType == "nested"
+}
+
+// BuildAttributeStatsQuery builds an aggregation of the value count, cardinality and most
+// frequent values of attribute fields, over the spans of a component in a time range
+func BuildAttributeStatsQuery(params AttributeStatsParams, fields []AttributeField) *SearchSource {
+	query := Bool().
+		Must(Range(startTimeField).Gte(params.StartTime).Lte(params.EndTime)).
+		Must(resourceFilters(params.ComponentUid, params.EnvironmentUid)...)
+
+	// Aggregations are named by position, as attribute keys may hold characters OpenSearch
+	// does not allow in aggregation names
+	search := NewSearch().Size(0).Query(query)
+	for i, field := range fields {
+		search.Aggregation(fmt.Sprintf("count_%d", i), ValueCount(field.Field)).
+			Aggregation(fmt.Sprintf("cardinality_%d", i), Cardinality(field.Field)).
+			Aggregation(fmt.Sprintf("values_%d", i), TermsAgg(field.Field, params.Examples))
+	}
+	return search
+}
+
+// ParseAttributeStats reads the result of BuildAttributeStatsQuery. Attributes without values
+// in the spans are left out, and the rest are ordered by count, highest first.
+func ParseAttributeStats(aggregations json.RawMessage, fields []AttributeField) ([]AttributeStats, error) {
+	stats := []AttributeStats{}
+	if len(aggregations) == 0 {
+		return stats, nil
+	}
+
+	type metric struct {
+		Value float64 `json:"value"`
+	}
+	type terms struct {
+		Buckets []struct {
+			Key         interface{} `json:"key"`
+			KeyAsString string      `json:"key_as_string"`
+			DocCount    int64       `json:"doc_count"`
+		} `json:"buckets"`
+	}
+	var aggs map[string]json.RawMessage
+	if err := json.Unmarshal(aggregations, &aggs); err != nil {
+		return nil, fmt.Errorf("failed to decode aggregations: %w", err)
+	}
+
+	for i, field := range fields {
+		var count, cardinality metric
+		var values terms
+		if err := unmarshalAggregation(aggs, fmt.Sprintf("count_%d", i), &count); err != nil {
+			return nil, err
+		}
+		if count.Value == 0 {
+			continue
+		}
+		if err := unmarshalAggregation(aggs, fmt.Sprintf("cardinality_%d", i), &cardinality); err != nil {
+			return nil, err
+		}
+		if err := unmarshalAggregation(aggs, fmt.Sprintf("values_%d", i), &values); err != nil {
+			return nil, err
+		}
+
+		examples := make([]AttributeValue, 0, len(values.Buckets))
+		for _, bucket := range values.Buckets {
+			value := bucket.KeyAsString
+			if value == "" {
+				value = aggregationKeyString(bucket.Key)
+			}
+			examples = append(examples, AttributeValue{Value: value, Count: bucket.DocCount})
+		}
+		stats = append(stats, AttributeStats{
+			Key:         field.Key,
+			Type:        field.Type,
+			Count:       int64(count.Value),
+			Cardinality: int64(cardinality.Value),
+			Examples:    examples,
+		})
+	}
+
+	sort.SliceStable(stats, func(i, j int) bool { return stats[i].Count > stats[j].Count })
+	return stats, nil
+}
+
+func unmarshalAggregation(aggs map[string]json.RawMessage, name string, target interface{}) error {
+	raw, ok := aggs[name]
+	if !ok {
+		return nil
+	}
+	if err := json.Unmarshal(raw, target); err != nil {
+		return fmt.Errorf("failed to decode aggregation %s: %w", name, err)
+	}
+	return nil
+}
+
+func aggregationKeyString(key interface{}) string {
+	switch v := key.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAttributeFields(t *testing.T) {
+	caps := map[string]map[string]FieldCapability{
+		"attributes.gen_ai.request.model":       {"keyword": {Aggregatable: true}},
+		"attributes.gen_ai.usage.input_tokens":  {"long": {Aggregatable: true}},
+		"attributes.gen_ai.prompt":              {"text": {}},
+		"attributes.gen_ai.prompt.keyword":      {"keyword": {Aggregatable: true}},
+		"attributes.gen_ai.completion":          {"text": {}},
+		"attributes.gen_ai":                     {"object": {}},
+		"attributes.user.id":                    {"keyword": {Aggregatable: true}},
+		"resource.openchoreo.dev/component-uid": {"keyword": {Aggregatable: true}},
+	}
+
+	fields := AttributeFields(caps, "gen_ai.")
+	want := []AttributeField{
+		{Key: "gen_ai.prompt", Field: "attributes.gen_ai.prompt.keyword", Type: "text"},
+		{Key: "gen_ai.request.model", Field: "attributes.gen_ai.request.model", Type: "keyword"},
+		{Key: "gen_ai.usage.input_tokens", Field: "attributes.gen_ai.usage.input_tokens", Type: "long"},
+	}
+	if len(fields) != len(want) {
+		t.Fatalf("got %+v, want %+v", fields, want)
+	}
+	for i := range want {
+		if fields[i] != want[i] {
+			t.Errorf("field %d: got %+v, want %+v", i, fields[i], want[i])
+		}
+	}
+
+	if all := AttributeFields(caps, ""); len(all) != 4 {
+		t.Errorf("got %d fields without a prefix, want 4: %+v", len(all), all)
+	}
+}
+
+func TestBuildAttributeStatsQuery(t *testing.T) {
+	search := BuildAttributeStatsQuery(AttributeStatsParams{
+		ComponentUid: "comp-1",
+		StartTime:    "2025-01-01T00:00:00Z",
+		EndTime:      "2025-01-02T00:00:00Z",
+		Examples:     3,
+	}, []AttributeField{{Key: "user.id", Field: "attributes.user.id", Type: "keyword"}})
+	requireJSON(t, `{
+		"query":{"bool":{"must":[
+			{"range":{"startTime":{"gte":"2025-01-01T00:00:00Z","lte":"2025-01-02T00:00:00Z"}}},
+			{"term":{"resource.openchoreo.dev/component-uid":"comp-1"}}
+		]}},
+		"size":0,
+		"aggs":{
+			"count_0":{"value_count":{"field":"attributes.user.id"}},
+			"cardinality_0":{"cardinality":{"field":"attributes.user.id"}},
+			"values_0":{"terms":{"field":"attributes.user.id","size":3}}
+		}
+	}`, search.Source())
+}
+
+func TestParseAttributeStats(t *testing.T) {
+	fields := []AttributeField{
+		{Key: "user.id", Field: "attributes.user.id", Type: "keyword"},
+		{Key: "gen_ai.usage.input_tokens", Field: "attributes.gen_ai.usage.input_tokens", Type: "long"},
+		{Key: "unused", Field: "attributes.unused", Type: "keyword"},
+	}
+	aggregations := json.RawMessage(`{
+		"count_0":{"value":4},
+		"cardinality_0":{"value":2},
+		"values_0":{"buckets":[{"key":"alice","doc_count":3},{"key":"bob","doc_count":1}]},
+		"count_1":{"value":10},
+		"cardinality_1":{"value":7},
+		"values_1":{"buckets":[{"key":120,"doc_count":2}]},
+		"count_2":{"value":0},
+		"cardinality_2":{"value":0},
+		"values_2":{"buckets":[]}
+	}`)
+
+	stats, err := ParseAttributeStats(aggregations, fields)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(stats) != 2 {
+		t.Fatalf("got %d attributes, want 2: %+v", len(stats), stats)
+	}
+	tokens, user := stats[0], stats[1]
+	if tokens.Key != "gen_ai.usage.input_tokens" || tokens.Count != 10 || tokens.Cardinality != 7 ||
+		len(tokens.Examples) != 1 || tokens.Examples[0].Value != "120" {
+		t.Errorf("unexpected stats: %+v", tokens)
+	}
+	if user.Key != "user.id" || user.Type != "keyword" || user.Count != 4 || user.Cardinality != 2 ||
+		len(user.Examples) != 2 || user.Examples[0] != (AttributeValue{Value: "alice", Count: 3}) {
+		t.Errorf("unexpected stats: %+v", user)
+	}
+
+	if stats, err := ParseAttributeStats(nil, fields); err != nil || len(stats) != 0 {
+		t.Errorf("got %+v and %v without aggregations", stats, err)
+	}
+}
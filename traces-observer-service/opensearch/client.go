@@ -21,19 +21,38 @@ import (
 	"context"
 	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
+	"sort"
+	"time"
 
 	"github.com/opensearch-project/opensearch-go"
 	"github.com/opensearch-project/opensearch-go/opensearchapi"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.38.0"
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/wso2/ai-agent-management-platform/traces-observer-service/config"
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/tracing"
 )
 
+// ErrTaskNotFound is returned when an OpenSearch task does not exist
+var ErrTaskNotFound = errors.New("task not found")
+
+// ErrDocumentNotFound is returned when a document to update or delete does not exist
+var ErrDocumentNotFound = errors.New("document not found")
+
+// updateRetries is how many times an update is retried when the document changed concurrently
+const updateRetries = 3
+
 // Client wraps the OpenSearch client
 type Client struct {
-	client *opensearch.Client
-	config *config.OpenSearchConfig
+	client        *opensearch.Client
+	config        *config.OpenSearchConfig
+	projectAccess ProjectAccess
 }
 
 // NewClient creates a new OpenSearch client
@@ -43,16 +62,13 @@ func NewClient(cfg *config.OpenSearchConfig) (*Client, error) {
 		TLSClientConfig: &tls.Config{
 			InsecureSkipVerify: true,
 		},
+		MaxIdleConns:          cfg.MaxIdleConnsPerHost,
+		MaxIdleConnsPerHost:   cfg.MaxIdleConnsPerHost,
+		IdleConnTimeout:       time.Duration(cfg.IdleConnTimeoutSeconds) * time.Second,
+		ResponseHeaderTimeout: time.Duration(cfg.RequestTimeoutSeconds) * time.Second,
 	}
 
-	opensearchConfig := opensearch.Config{
-		Addresses: []string{cfg.Address},
-		Transport: transport,
-		Username:  cfg.Username,
-		Password:  cfg.Password,
-	}
-
-	client, err := opensearch.NewClient(opensearchConfig)
+	client, err := opensearch.NewClient(newClientConfig(cfg, transport))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create OpenSearch client: %w", err)
 	}
@@ -71,19 +87,64 @@ func NewClient(cfg *config.OpenSearchConfig) (*Client, error) {
 	}, nil
 }
 
+// newClientConfig returns the OpenSearch client configuration sending requests through next,
+// guarded by the circuit breaker and retried on the statuses in retryOnStatus
+func newClientConfig(cfg *config.OpenSearchConfig, next http.RoundTripper) opensearch.Config {
+	return opensearch.Config{
+		Addresses: []string{cfg.Address},
+		Transport: &resilientTransport{
+			next:               next,
+			breaker:            newCircuitBreaker(cfg.CircuitBreakerThreshold, time.Duration(cfg.CircuitBreakerCooldownSeconds)*time.Second),
+			slowQueryThreshold: time.Duration(cfg.SlowQueryThresholdMillis) * time.Millisecond,
+		},
+		Username:      cfg.Username,
+		Password:      cfg.Password,
+		RetryOnStatus: retryOnStatus,
+		MaxRetries:    cfg.MaxRetries,
+		DisableRetry:  cfg.MaxRetries == 0,
+		RetryBackoff:  retryBackoff(time.Duration(cfg.RetryBackoffMillis) * time.Millisecond),
+	}
+}
+
 // Search executes a search query against one or more indices
-func (c *Client) Search(ctx context.Context, indices []string, query map[string]interface{}) (*SearchResponse, error) {
+func (c *Client) Search(ctx context.Context, indices []string, search *SearchSource) (_ *SearchResponse, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "opensearch.search",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemNameKey.String("opensearch"),
+			semconv.DBOperationName("search"),
+			attribute.StringSlice("opensearch.indices", indices),
+		),
+	)
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	indices = withArchivedIndices(ctx, indices)
+	if c.projectAccess != nil {
+		restricted := *search
+		restricted.query = c.restrict(ctx, indices, search.query)
+		search = &restricted
+	}
+
 	// Convert query to JSON
 	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(query); err != nil {
+	if err := json.NewEncoder(&buf).Encode(search.Source()); err != nil {
 		return nil, fmt.Errorf("failed to encode query: %w", err)
 	}
 
-	// Create search request with IgnoreUnavailable option
+	// Create search request with IgnoreUnavailable option. A point in time search names no
+	// indices, and OpenSearch rejects index options for it.
 	req := opensearchapi.SearchRequest{
-		Index:             indices,
-		Body:              &buf,
-		IgnoreUnavailable: opensearchapi.BoolPtr(true),
+		Index: indices,
+		Body:  &buf,
+	}
+	if len(indices) > 0 {
+		req.IgnoreUnavailable = opensearchapi.BoolPtr(true)
 	}
 
 	// Execute search
@@ -106,12 +167,459 @@ func (c *Client) Search(ctx context.Context, indices []string, query map[string]
 	}
 
 	log.Printf("Search completed: total_hits=%d, returned_hits=%d", response.Hits.Total.Value, len(response.Hits.Hits))
+	span.SetAttributes(
+		attribute.Int("opensearch.hits.total", response.Hits.Total.Value),
+		attribute.Int("opensearch.hits.returned", len(response.Hits.Hits)),
+	)
 
 	return &response, nil
 }
 
+// DeleteByQuery starts deleting the documents matching a query as a background task in
+// OpenSearch and returns the ID of the task. Deleting a large number of spans can take far
+// longer than an HTTP request is allowed to, so the call does not wait for it to complete.
+func (c *Client) DeleteByQuery(ctx context.Context, indices []string, query Query) (_ string, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "opensearch.delete_by_query",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemNameKey.String("opensearch"),
+			semconv.DBOperationName("delete_by_query"),
+			attribute.StringSlice("opensearch.indices", indices),
+		),
+	)
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	var buf bytes.Buffer
+	query = c.restrict(ctx, indices, query)
+	if err := json.NewEncoder(&buf).Encode(map[string]interface{}{"query": query.Source()}); err != nil {
+		return "", fmt.Errorf("failed to encode query: %w", err)
+	}
+
+	// Spans are written concurrently, so version conflicts are skipped instead of aborting the task
+	req := opensearchapi.DeleteByQueryRequest{
+		Index:             indices,
+		Body:              &buf,
+		Conflicts:         "proceed",
+		IgnoreUnavailable: opensearchapi.BoolPtr(true),
+		WaitForCompletion: opensearchapi.BoolPtr(false),
+	}
+
+	res, err := req.Do(ctx, c.client)
+	if err != nil {
+		return "", fmt.Errorf("delete by query request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return "", fmt.Errorf("delete by query request failed with status: %s", res.Status())
+	}
+
+	var response struct {
+		Task string `json:"task"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return "", fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	log.Printf("Delete by query started: task=%s", response.Task)
+	return response.Task, nil
+}
+
+// GetTask returns the progress of a background task such as a delete by query
+func (c *Client) GetTask(ctx context.Context, taskID string) (*TaskStatus, error) {
+	req := opensearchapi.TasksGetRequest{TaskID: taskID}
+
+	res, err := req.Do(ctx, c.client)
+	if err != nil {
+		return nil, fmt.Errorf("get task request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, ErrTaskNotFound
+	}
+	if res.IsError() {
+		return nil, fmt.Errorf("get task request failed with status: %s", res.Status())
+	}
+
+	type byQueryStatus struct {
+		Total    int64             `json:"total"`
+		Deleted  int64             `json:"deleted"`
+		Failures []json.RawMessage `json:"failures"`
+	}
+	var response struct {
+		Completed bool `json:"completed"`
+		Task      struct {
+			Status byQueryStatus `json:"status"`
+		} `json:"task"`
+		// Response is the final status of a completed task
+		Response *byQueryStatus `json:"response"`
+		Error    *struct {
+			Reason string `json:"reason"`
+		} `json:"error"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	status := response.Task.Status
+	if response.Response != nil {
+		status = *response.Response
+	}
+	task := &TaskStatus{
+		TaskID:    taskID,
+		Completed: response.Completed,
+		Total:     status.Total,
+		Deleted:   status.Deleted,
+		Failures:  len(status.Failures),
+	}
+	if response.Error != nil {
+		task.Error = response.Error.Reason
+	}
+	return task, nil
+}
+
+// FieldCaps returns the capabilities of the fields matching a pattern in the given indices, by
+// field name and mapping type
+func (c *Client) FieldCaps(ctx context.Context, indices []string, fields string) (map[string]map[string]FieldCapability, error) {
+	req := opensearchapi.FieldCapsRequest{
+		Index:             indices,
+		Fields:            []string{fields},
+		IgnoreUnavailable: opensearchapi.BoolPtr(true),
+	}
+
+	res, err := req.Do(ctx, c.client)
+	if err != nil {
+		return nil, fmt.Errorf("field caps request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return nil, fmt.Errorf("field caps request failed with status: %s", res.Status())
+	}
+
+	var response struct {
+		Fields map[string]map[string]FieldCapability `json:"fields"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return response.Fields, nil
+}
+
+// IndexStats returns the number of documents and the primary store size in bytes of the
+// indices matching a pattern
+func (c *Client) IndexStats(ctx context.Context, pattern string) (docs int64, sizeInBytes int64, err error) {
+	req := opensearchapi.IndicesStatsRequest{
+		Index:  []string{pattern},
+		Metric: []string{"docs", "store"},
+	}
+
+	res, err := req.Do(ctx, c.client)
+	if err != nil {
+		return 0, 0, fmt.Errorf("index stats request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return 0, 0, fmt.Errorf("index stats request failed with status: %s", res.Status())
+	}
+
+	var response struct {
+		All struct {
+			Primaries struct {
+				Docs struct {
+					Count int64 `json:"count"`
+				} `json:"docs"`
+				Store struct {
+					SizeInBytes int64 `json:"size_in_bytes"`
+				} `json:"store"`
+			} `json:"primaries"`
+		} `json:"_all"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return 0, 0, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return response.All.Primaries.Docs.Count, response.All.Primaries.Store.SizeInBytes, nil
+}
+
+// IndexSizes returns the number of documents and the primary store size of each index matching
+// a pattern, ordered by name
+func (c *Client) IndexSizes(ctx context.Context, pattern string) ([]IndexSize, error) {
+	req := opensearchapi.IndicesStatsRequest{
+		Index:  []string{pattern},
+		Metric: []string{"docs", "store"},
+	}
+
+	res, err := req.Do(ctx, c.client)
+	if err != nil {
+		return nil, fmt.Errorf("index stats request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return nil, fmt.Errorf("index stats request failed with status: %s", res.Status())
+	}
+
+	var response struct {
+		Indices map[string]struct {
+			Primaries struct {
+				Docs struct {
+					Count int64 `json:"count"`
+				} `json:"docs"`
+				Store struct {
+					SizeInBytes int64 `json:"size_in_bytes"`
+				} `json:"store"`
+			} `json:"primaries"`
+		} `json:"indices"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	sizes := make([]IndexSize, 0, len(response.Indices))
+	for name, stats := range response.Indices {
+		sizes = append(sizes, IndexSize{
+			Index:     name,
+			DocCount:  stats.Primaries.Docs.Count,
+			SizeBytes: stats.Primaries.Store.SizeInBytes,
+		})
+	}
+	sort.Slice(sizes, func(i, j int) bool { return sizes[i].Index < sizes[j].Index })
+	return sizes, nil
+}
+
+// PutIndexTemplate creates or replaces an index template, which applies to indices created later
+func (c *Client) PutIndexTemplate(ctx context.Context, name string, template map[string]interface{}) error {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(template); err != nil {
+		return fmt.Errorf("failed to encode index template: %w", err)
+	}
+
+	req := opensearchapi.IndicesPutIndexTemplateRequest{
+		Name: name,
+		Body: &buf,
+	}
+
+	res, err := req.Do(ctx, c.client)
+	if err != nil {
+		return fmt.Errorf("put index template request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return fmt.Errorf("put index template request failed with status: %s", res.Status())
+	}
+	return nil
+}
+
+// BulkIndex writes documents in a single request, replacing documents with the same ID
+func (c *Client) BulkIndex(ctx context.Context, documents []BulkDocument) error {
+	if len(documents) == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, document := range documents {
+		action := map[string]interface{}{
+			"index": map[string]interface{}{"_index": document.Index, "_id": document.ID},
+		}
+		if err := encoder.Encode(action); err != nil {
+			return fmt.Errorf("failed to encode bulk action: %w", err)
+		}
+		if err := encoder.Encode(document.Source); err != nil {
+			return fmt.Errorf("failed to encode document %s: %w", document.ID, err)
+		}
+	}
+	return c.bulk(ctx, &buf, len(documents))
+}
+
+// BulkUpdate changes documents in a single request, each with its script, creating the documents
+// that do not exist from their upsert. Updates that conflict with a concurrent change are retried.
+func (c *Client) BulkUpdate(ctx context.Context, updates []BulkUpdate) error {
+	if len(updates) == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, update := range updates {
+		action := map[string]interface{}{
+			"update": map[string]interface{}{"_index": update.Index, "_id": update.ID, "retry_on_conflict": updateRetries},
+		}
+		if err := encoder.Encode(action); err != nil {
+			return fmt.Errorf("failed to encode bulk action: %w", err)
+		}
+		body := map[string]interface{}{
+			"script": map[string]interface{}{"source": update.Script, "lang": "painless", "params": update.Params},
+			"upsert": update.Upsert,
+		}
+		if err := encoder.Encode(body); err != nil {
+			return fmt.Errorf("failed to encode update %s: %w", update.ID, err)
+		}
+	}
+	return c.bulk(ctx, &buf, len(updates))
+}
+
+// bulk sends a bulk request of count operations
+func (c *Client) bulk(ctx context.Context, body *bytes.Buffer, count int) (err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "opensearch.bulk",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemNameKey.String("opensearch"),
+			semconv.DBOperationName("bulk"),
+			attribute.Int("opensearch.documents", count),
+		),
+	)
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	req := opensearchapi.BulkRequest{Body: body}
+	res, err := req.Do(ctx, c.client)
+	if err != nil {
+		return fmt.Errorf("bulk request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return fmt.Errorf("bulk request failed with status: %s", res.Status())
+	}
+
+	// A bulk request succeeds as a whole even when some of its documents fail
+	var response struct {
+		Errors bool `json:"errors"`
+		Items  []map[string]struct {
+			ID    string `json:"_id"`
+			Error *struct {
+				Type   string `json:"type"`
+				Reason string `json:"reason"`
+			} `json:"error,omitempty"`
+		} `json:"items"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	if !response.Errors {
+		return nil
+	}
+	failed := 0
+	var firstError string
+	for _, item := range response.Items {
+		for _, result := range item {
+			if result.Error != nil {
+				if failed == 0 {
+					firstError = fmt.Sprintf("%s: %s: %s", result.ID, result.Error.Type, result.Error.Reason)
+				}
+				failed++
+			}
+		}
+	}
+	return fmt.Errorf("failed to write %d of %d documents, first error: %s", failed, count, firstError)
+}
+
+// IndexDocument writes a document, replacing the document with the same ID. The call waits for
+// the document to become searchable, so that it is listed by the searches that follow.
+func (c *Client) IndexDocument(ctx context.Context, index, id string, source interface{}) error {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(source); err != nil {
+		return fmt.Errorf("failed to encode document %s: %w", id, err)
+	}
+
+	req := opensearchapi.IndexRequest{
+		Index:      index,
+		DocumentID: id,
+		Body:       &buf,
+		Refresh:    "wait_for",
+	}
+
+	res, err := req.Do(ctx, c.client)
+	if err != nil {
+		return fmt.Errorf("index request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return fmt.Errorf("index request failed with status: %s", res.Status())
+	}
+	return nil
+}
+
+// DeleteDocument deletes a document by ID and waits for the deletion to become searchable
+func (c *Client) DeleteDocument(ctx context.Context, index, id string) error {
+	req := opensearchapi.DeleteRequest{
+		Index:      index,
+		DocumentID: id,
+		Refresh:    "wait_for",
+	}
+
+	res, err := req.Do(ctx, c.client)
+	if err != nil {
+		return fmt.Errorf("delete request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return ErrDocumentNotFound
+	}
+	if res.IsError() {
+		return fmt.Errorf("delete request failed with status: %s", res.Status())
+	}
+	return nil
+}
+
+// UpdateDocument sets fields of a document and waits for the change to become searchable
+func (c *Client) UpdateDocument(ctx context.Context, index, id string, fields map[string]interface{}) error {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(map[string]interface{}{"doc": fields}); err != nil {
+		return fmt.Errorf("failed to encode update of document %s: %w", id, err)
+	}
+
+	req := opensearchapi.UpdateRequest{
+		Index:           index,
+		DocumentID:      id,
+		Body:            &buf,
+		Refresh:         "wait_for",
+		RetryOnConflict: opensearchapi.IntPtr(updateRetries),
+	}
+
+	res, err := req.Do(ctx, c.client)
+	if err != nil {
+		return fmt.Errorf("update request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return ErrDocumentNotFound
+	}
+	if res.IsError() {
+		return fmt.Errorf("update request failed with status: %s", res.Status())
+	}
+	return nil
+}
+
 // HealthCheck checks if OpenSearch is accessible
 func (c *Client) HealthCheck(ctx context.Context) error {
-	_, err := c.client.Info()
-	return err
+	res, err := c.client.Info(c.client.Info.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return fmt.Errorf("opensearch returned status: %s", res.Status())
+	}
+	return nil
 }
@@ -0,0 +1,195 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// SessionIDAttributes are the span attributes instrumentation libraries record a chat session ID in
+var SessionIDAttributes = []string{
+	"session.id",
+	"gen_ai.conversation.id",
+	"traceloop.association.properties.session_id",
+	"langfuse.session.id",
+}
+
+// BuildConversation reconstructs the conversation of a session from the spans of its traces.
+// The prompt and completion messages of the LLM spans are merged in chronological order: the
+// history an LLM call repeats from earlier calls is added only once, and a system prompt only
+// when it differs from the previous one. Traces without LLM messages contribute the input and
+// output of their root span as a user and an assistant message.
+func BuildConversation(spans []Span) []ConversationMessage {
+	traces := groupSpansByTrace(spans)
+
+	builder := &conversationBuilder{messages: []ConversationMessage{}}
+	for _, traceSpans := range traces {
+		if !builder.addLLMSpans(traceSpans) {
+			builder.addTraceInputOutput(traceSpans)
+		}
+	}
+	return builder.messages
+}
+
+// groupSpansByTrace groups spans by trace, in order of the first span of each trace. The spans
+// of a trace are ordered by start time.
+func groupSpansByTrace(spans []Span) [][]Span {
+	ordered := make([]Span, len(spans))
+	copy(ordered, spans)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].StartTime.Before(ordered[j].StartTime)
+	})
+
+	indexByTrace := make(map[string]int)
+	traces := [][]Span{}
+	for _, span := range ordered {
+		index, ok := indexByTrace[span.TraceID]
+		if !ok {
+			index = len(traces)
+			indexByTrace[span.TraceID] = index
+			traces = append(traces, nil)
+		}
+		traces[index] = append(traces[index], span)
+	}
+	return traces
+}
+
+type conversationBuilder struct {
+	messages     []ConversationMessage
+	lastSystem   *PromptMessage
+	conversation []PromptMessage // Non-system messages, to detect repeated history
+}
+
+// addLLMSpans adds the messages of the LLM spans of a trace, and reports whether there were any
+func (b *conversationBuilder) addLLMSpans(spans []Span) bool {
+	added := false
+	for _, span := range spans {
+		if span.AmpAttributes == nil || span.AmpAttributes.Kind != string(SpanTypeLLM) {
+			continue
+		}
+		prompt, _ := span.AmpAttributes.Input.([]PromptMessage)
+		completion, _ := span.AmpAttributes.Output.([]PromptMessage)
+		if len(prompt) == 0 && len(completion) == 0 {
+			continue
+		}
+		added = true
+
+		var history []PromptMessage
+		for _, message := range prompt {
+			if message.Role != "system" {
+				history = append(history, message)
+				continue
+			}
+			if b.lastSystem == nil || !samePromptMessage(*b.lastSystem, message) {
+				systemMessage := message
+				b.lastSystem = &systemMessage
+				b.add(message, span, span.StartTime)
+			}
+		}
+
+		// The prompt repeats the earlier conversation, or its most recent part
+		overlap := historyOverlap(b.conversation, history)
+		for _, message := range history[overlap:] {
+			b.add(message, span, span.StartTime)
+		}
+		for _, message := range completion {
+			b.add(message, span, span.EndTime)
+		}
+	}
+	return added
+}
+
+// addTraceInputOutput adds the input and output of the root span of a trace
+func (b *conversationBuilder) addTraceInputOutput(spans []Span) {
+	input, output := ExtractTraceInputOutput(spans)
+	root := spans[0]
+	for i := range spans {
+		if spans[i].ParentSpanID == "" {
+			root = spans[i]
+			break
+		}
+	}
+	if content := conversationContent(input); content != "" {
+		b.add(PromptMessage{Role: "user", Content: content}, root, root.StartTime)
+	}
+	if content := conversationContent(output); content != "" {
+		b.add(PromptMessage{Role: "assistant", Content: content}, root, root.EndTime)
+	}
+}
+
+func (b *conversationBuilder) add(message PromptMessage, span Span, timestamp time.Time) {
+	if message.Role != "system" {
+		b.conversation = append(b.conversation, message)
+	}
+	b.messages = append(b.messages, ConversationMessage{
+		Role:      message.Role,
+		Content:   message.Content,
+		ToolCalls: message.ToolCalls,
+		TraceID:   span.TraceID,
+		SpanID:    span.SpanID,
+		Timestamp: timestamp,
+	})
+}
+
+// historyOverlap returns the length of the longest prefix of history that the conversation ends with
+func historyOverlap(conversation, history []PromptMessage) int {
+	for length := min(len(conversation), len(history)); length > 0; length-- {
+		tail := conversation[len(conversation)-length:]
+		matches := true
+		for i := 0; i < length; i++ {
+			if !samePromptMessage(tail[i], history[i]) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return length
+		}
+	}
+	return 0
+}
+
+func samePromptMessage(a, b PromptMessage) bool {
+	if a.Role != b.Role || a.Content != b.Content || len(a.ToolCalls) != len(b.ToolCalls) {
+		return false
+	}
+	for i := range a.ToolCalls {
+		if a.ToolCalls[i].Name != b.ToolCalls[i].Name || a.ToolCalls[i].Arguments != b.ToolCalls[i].Arguments {
+			return false
+		}
+	}
+	return true
+}
+
+// conversationContent renders a root span input or output as message text
+func conversationContent(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(data)
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"testing"
+	"time"
+)
+
+func llmSpan(traceID, spanID string, start time.Time, prompt, completion []PromptMessage) Span {
+	return Span{
+		TraceID:       traceID,
+		SpanID:        spanID,
+		ParentSpanID:  "root-" + traceID,
+		StartTime:     start,
+		EndTime:       start.Add(time.Second),
+		AmpAttributes: &AmpAttributes{Kind: string(SpanTypeLLM), Input: prompt, Output: completion},
+	}
+}
+
+func TestBuildConversation(t *testing.T) {
+	start := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	system := PromptMessage{Role: "system", Content: "You are helpful."}
+	user1 := PromptMessage{Role: "user", Content: "What is the weather?"}
+	toolCall := PromptMessage{Role: "assistant", ToolCalls: []ToolCall{{ID: "c1", Name: "weather", Arguments: `{"city":"Colombo"}`}}}
+	toolResult := PromptMessage{Role: "tool", Content: "30C"}
+	answer1 := PromptMessage{Role: "assistant", Content: "It is 30C."}
+	user2 := PromptMessage{Role: "user", Content: "Thanks!"}
+	answer2 := PromptMessage{Role: "assistant", Content: "You're welcome."}
+
+	spans := []Span{
+		// The second trace is listed first, to check that traces are ordered by time
+		llmSpan("t2", "s3", start.Add(time.Minute), []PromptMessage{system, user1, toolCall, toolResult, answer1, user2}, []PromptMessage{answer2}),
+		{TraceID: "t1", SpanID: "root-t1", StartTime: start},
+		llmSpan("t1", "s1", start.Add(time.Second), []PromptMessage{system, user1}, []PromptMessage{toolCall}),
+		{TraceID: "t1", SpanID: "tool", ParentSpanID: "root-t1", StartTime: start.Add(2 * time.Second), AmpAttributes: &AmpAttributes{Kind: string(SpanTypeTool)}},
+		llmSpan("t1", "s2", start.Add(3*time.Second), []PromptMessage{system, user1, toolCall, toolResult}, []PromptMessage{answer1}),
+	}
+
+	messages := BuildConversation(spans)
+	want := []struct {
+		role, content, spanID string
+	}{
+		{"system", "You are helpful.", "s1"},
+		{"user", "What is the weather?", "s1"},
+		{"assistant", "", "s1"},
+		{"tool", "30C", "s2"},
+		{"assistant", "It is 30C.", "s2"},
+		{"user", "Thanks!", "s3"},
+		{"assistant", "You're welcome.", "s3"},
+	}
+	if len(messages) != len(want) {
+		t.Fatalf("got %d messages, want %d: %+v", len(messages), len(want), messages)
+	}
+	for i, w := range want {
+		if messages[i].Role != w.role || messages[i].Content != w.content || messages[i].SpanID != w.spanID {
+			t.Errorf("message %d: got %s %q from %s, want %s %q from %s",
+				i, messages[i].Role, messages[i].Content, messages[i].SpanID, w.role, w.content, w.spanID)
+		}
+	}
+	if len(messages[2].ToolCalls) != 1 || messages[2].ToolCalls[0].Name != "weather" {
+		t.Errorf("tool call not kept: %+v", messages[2])
+	}
+	if !messages[4].Timestamp.Equal(start.Add(4*time.Second)) || messages[5].TraceID != "t2" {
+		t.Errorf("unexpected timestamp or trace: %+v, %+v", messages[4], messages[5])
+	}
+}
+
+func TestBuildConversationChangedSystemPromptAndWindowedHistory(t *testing.T) {
+	start := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	spans := []Span{
+		llmSpan("t1", "s1", start,
+			[]PromptMessage{{Role: "system", Content: "v1"}, {Role: "user", Content: "a"}},
+			[]PromptMessage{{Role: "assistant", Content: "b"}}),
+		// Only the most recent message of the history is repeated
+		llmSpan("t2", "s2", start.Add(time.Minute),
+			[]PromptMessage{{Role: "system", Content: "v2"}, {Role: "assistant", Content: "b"}, {Role: "user", Content: "c"}},
+			[]PromptMessage{{Role: "assistant", Content: "d"}}),
+	}
+
+	var got []string
+	for _, message := range BuildConversation(spans) {
+		got = append(got, message.Role+":"+message.Content)
+	}
+	want := []string{"system:v1", "user:a", "assistant:b", "system:v2", "user:c", "assistant:d"}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+}
+
+func TestBuildConversationFallsBackToTraceInputOutput(t *testing.T) {
+	start := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	spans := []Span{{
+		TraceID:   "t1",
+		SpanID:    "root",
+		StartTime: start,
+		EndTime:   start.Add(time.Second),
+		Attributes: map[string]interface{}{
+			"traceloop.entity.input":  `{"inputs":{"question":"hi"}}`,
+			"traceloop.entity.output": `{"outputs":"hello"}`,
+		},
+	}}
+
+	messages := BuildConversation(spans)
+	if len(messages) != 2 || messages[0].Role != "user" || messages[1].Role != "assistant" {
+		t.Fatalf("unexpected messages: %+v", messages)
+	}
+	if messages[0].Content == "" || messages[1].Content == "" || messages[0].SpanID != "root" {
+		t.Errorf("unexpected messages: %+v", messages)
+	}
+}
@@ -29,7 +29,7 @@ func IsCrewAISpan(attrs map[string]interface{}) bool {
 	}
 
 	// Check if gen_ai.system is "crewai"
-	if val, ok := attrs["gen_ai.system"].(string); ok && strings.ToLower(val) == "crewai" {
+	if val, ok := GetString(attrs, "gen_ai.system"); ok && strings.ToLower(val) == "crewai" {
 		return true
 	}
 
@@ -96,9 +96,9 @@ func PopulateCrewAIAgentAttributes(ampAttrs *AmpAttributes, attrs map[string]int
 
 	// Extract workflow/agent name
 	// First try crewai.agent.role (for individual agent spans)
-	if name, ok := attrs["crewai.agent.role"].(string); ok {
+	if name, ok := GetString(attrs, "crewai.agent.role"); ok {
 		agentData.Name = strings.TrimSpace(name)
-	} else if name, ok := attrs["crewai.crew.name"].(string); ok {
+	} else if name, ok := GetString(attrs, "crewai.crew.name"); ok {
 		// Fallback to crewai.crew.name (for crew/workflow spans)
 		agentData.Name = name
 	}
@@ -110,13 +110,13 @@ func PopulateCrewAIAgentAttributes(ampAttrs *AmpAttributes, attrs map[string]int
 	agentData.SystemPrompt = extractCrewAISystemPrompt(attrs)
 
 	// Extract max iterations from crewai.agent.max_iter
-	if maxIter, ok := attrs["crewai.agent.max_iter"].(float64); ok {
-		agentData.MaxIter = int(maxIter)
+	if maxIter, ok := GetInt(attrs, "crewai.agent.max_iter"); ok {
+		agentData.MaxIter = maxIter
 	}
 
 	// Extract token usage from crewai.crew.token_usage
 	// Format: "total_tokens=57062 prompt_tokens=46376 cached_prompt_tokens=0 completion_tokens=10686 successful_requests=10"
-	if tokenUsageStr, ok := attrs["crewai.crew.token_usage"].(string); ok {
+	if tokenUsageStr, ok := GetString(attrs, "crewai.crew.token_usage"); ok {
 		agentData.TokenUsage = parseCrewAITokenUsage(tokenUsageStr)
 	}
 
@@ -129,7 +129,7 @@ func PopulateCrewAIAgentAttributes(ampAttrs *AmpAttributes, attrs map[string]int
 // - JSON array of tool objects: [{"name": "tool1", "description": "...", "parameters": "..."}]
 // Returns array of ToolDefinition objects
 func extractCrewAIAgentTools(attrs map[string]interface{}) []ToolDefinition {
-	toolsJSON, ok := attrs["crewai.agent.tools"].(string)
+	toolsJSON, ok := GetString(attrs, "crewai.agent.tools")
 	if !ok || toolsJSON == "" {
 		return nil
 	}
@@ -144,17 +144,17 @@ func extractCrewAISystemPrompt(attrs map[string]interface{}) string {
 	var role, goal, backstory string
 
 	// Extract role
-	if r, ok := attrs["crewai.agent.role"].(string); ok {
+	if r, ok := GetString(attrs, "crewai.agent.role"); ok {
 		role = strings.TrimSpace(r)
 	}
 
 	// Extract goal
-	if g, ok := attrs["crewai.agent.goal"].(string); ok {
+	if g, ok := GetString(attrs, "crewai.agent.goal"); ok {
 		goal = strings.TrimSpace(g)
 	}
 
 	// Extract backstory
-	if b, ok := attrs["crewai.agent.backstory"].(string); ok {
+	if b, ok := GetString(attrs, "crewai.agent.backstory"); ok {
 		backstory = strings.TrimSpace(b)
 	}
 
@@ -0,0 +1,557 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"fmt"
+	"time"
+)
+
+// This file holds a typed builder for the subset of the OpenSearch query DSL used by the
+// service. Each type renders its JSON form through Source, so queries are composed from typed
+// clauses rather than nested maps.
+
+// Query is a clause of the OpenSearch query DSL
+type Query interface {
+	Source() map[string]interface{}
+}
+
+// Aggregation is an OpenSearch aggregation
+type Aggregation interface {
+	Source() map[string]interface{}
+}
+
+// Sort orders
+const (
+	SortAsc  = "asc"
+	SortDesc = "desc"
+)
+
+// TermQuery matches documents whose field holds exactly the value
+type TermQuery struct {
+	field string
+	value interface{}
+}
+
+// Term returns a query matching documents whose field holds exactly the value
+func Term(field string, value interface{}) *TermQuery {
+	return &TermQuery{field: field, value: value}
+}
+
+func (q *TermQuery) Source() map[string]interface{} {
+	return map[string]interface{}{
+		"term": map[string]interface{}{q.field: q.value},
+	}
+}
+
+// TermsQuery matches documents whose field holds exactly one of the values
+type TermsQuery struct {
+	field  string
+	values []string
+}
+
+// Terms returns a query matching documents whose field holds exactly one of the values
+func Terms(field string, values []string) *TermsQuery {
+	return &TermsQuery{field: field, values: values}
+}
+
+func (q *TermsQuery) Source() map[string]interface{} {
+	return map[string]interface{}{
+		"terms": map[string]interface{}{q.field: q.values},
+	}
+}
+
+// RangeQuery matches documents whose field lies within bounds. Unset bounds are left open.
+type RangeQuery struct {
+	field  string
+	bounds map[string]interface{}
+}
+
+// Range returns a query on the bounds of a field, set through Gt, Gte, Lt and Lte
+func Range(field string) *RangeQuery {
+	return &RangeQuery{field: field, bounds: map[string]interface{}{}}
+}
+
+func (q *RangeQuery) Gt(value interface{}) *RangeQuery {
+	q.bounds["gt"] = value
+	return q
+}
+
+func (q *RangeQuery) Gte(value interface{}) *RangeQuery {
+	q.bounds["gte"] = value
+	return q
+}
+
+func (q *RangeQuery) Lt(value interface{}) *RangeQuery {
+	q.bounds["lt"] = value
+	return q
+}
+
+func (q *RangeQuery) Lte(value interface{}) *RangeQuery {
+	q.bounds["lte"] = value
+	return q
+}
+
+func (q *RangeQuery) Source() map[string]interface{} {
+	return map[string]interface{}{
+		"range": map[string]interface{}{q.field: q.bounds},
+	}
+}
+
+// ExistsQuery matches documents that hold a value in a field
+type ExistsQuery struct {
+	field string
+}
+
+// Exists returns a query matching documents that hold a value in a field
+func Exists(field string) *ExistsQuery {
+	return &ExistsQuery{field: field}
+}
+
+func (q *ExistsQuery) Source() map[string]interface{} {
+	return map[string]interface{}{
+		"exists": map[string]interface{}{"field": q.field},
+	}
+}
+
+// MatchQuery matches documents whose analyzed field matches the query
+type MatchQuery struct {
+	field   string
+	query   interface{}
+	lenient bool
+}
+
+// Match returns a full text query on a field
+func Match(field string, query interface{}) *MatchQuery {
+	return &MatchQuery{field: field, query: query}
+}
+
+// Lenient ignores values that cannot be converted to the type of the field instead of failing
+func (q *MatchQuery) Lenient() *MatchQuery {
+	q.lenient = true
+	return q
+}
+
+func (q *MatchQuery) Source() map[string]interface{} {
+	var body interface{} = q.query
+	if q.lenient {
+		body = map[string]interface{}{
+			"query":   q.query,
+			"lenient": true,
+		}
+	}
+	return map[string]interface{}{
+		"match": map[string]interface{}{q.field: body},
+	}
+}
+
+// MatchPhraseQuery matches documents whose field holds the value as a whole phrase
+type MatchPhraseQuery struct {
+	field string
+	value string
+}
+
+// MatchPhrase returns a query matching the value as a whole phrase, whether the field is mapped
+// as keyword or as text
+func MatchPhrase(field, value string) *MatchPhraseQuery {
+	return &MatchPhraseQuery{field: field, value: value}
+}
+
+func (q *MatchPhraseQuery) Source() map[string]interface{} {
+	return map[string]interface{}{
+		"match_phrase": map[string]interface{}{q.field: q.value},
+	}
+}
+
+// BoolQuery combines clauses. Empty occurrences are left out, and a bool query without clauses
+// matches all documents.
+type BoolQuery struct {
+	must               []Query
+	filter             []Query
+	should             []Query
+	mustNot            []Query
+	minimumShouldMatch int
+}
+
+// Bool returns an empty bool query
+func Bool() *BoolQuery {
+	return &BoolQuery{}
+}
+
+// Must adds clauses that all have to match and contribute to the score
+func (q *BoolQuery) Must(queries ...Query) *BoolQuery {
+	q.must = append(q.must, queries...)
+	return q
+}
+
+// Filter adds clauses that all have to match without contributing to the score
+func (q *BoolQuery) Filter(queries ...Query) *BoolQuery {
+	q.filter = append(q.filter, queries...)
+	return q
+}
+
+// Should adds optional clauses, of which MinimumShouldMatch have to match
+func (q *BoolQuery) Should(queries ...Query) *BoolQuery {
+	q.should = append(q.should, queries...)
+	return q
+}
+
+// MustNot adds clauses that must not match
+func (q *BoolQuery) MustNot(queries ...Query) *BoolQuery {
+	q.mustNot = append(q.mustNot, queries...)
+	return q
+}
+
+func (q *BoolQuery) MinimumShouldMatch(n int) *BoolQuery {
+	q.minimumShouldMatch = n
+	return q
+}
+
+func (q *BoolQuery) Source() map[string]interface{} {
+	body := map[string]interface{}{}
+	for occurrence, queries := range map[string][]Query{
+		"must":     q.must,
+		"filter":   q.filter,
+		"should":   q.should,
+		"must_not": q.mustNot,
+	} {
+		if len(queries) > 0 {
+			body[occurrence] = querySources(queries)
+		}
+	}
+	if q.minimumShouldMatch > 0 {
+		body["minimum_should_match"] = q.minimumShouldMatch
+	}
+	return map[string]interface{}{"bool": body}
+}
+
+func querySources(queries []Query) []map[string]interface{} {
+	sources := make([]map[string]interface{}, 0, len(queries))
+	for _, query := range queries {
+		sources = append(sources, query.Source())
+	}
+	return sources
+}
+
+// TermsAggregation buckets documents by the values of a field
+type TermsAggregation struct {
+	field   string
+	size    int
+	order   map[string]interface{}
+	subAggs map[string]Aggregation
+}
+
+// TermsAgg returns an aggregation with a bucket for each of the size most frequent values of a field
+func TermsAgg(field string, size int) *TermsAggregation {
+	return &TermsAggregation{field: field, size: size}
+}
+
+// SubAggregation adds an aggregation computed within each bucket
+func (a *TermsAggregation) SubAggregation(name string, agg Aggregation) *TermsAggregation {
+	if a.subAggs == nil {
+		a.subAggs = map[string]Aggregation{}
+	}
+	a.subAggs[name] = agg
+	return a
+}
+
+// Order orders the buckets by the value of a sub-aggregation, or by _count or _key
+func (a *TermsAggregation) Order(by, order string) *TermsAggregation {
+	a.order = map[string]interface{}{by: order}
+	return a
+}
+
+func (a *TermsAggregation) Source() map[string]interface{} {
+	terms := map[string]interface{}{
+		"field": a.field,
+		"size":  a.size,
+	}
+	if a.order != nil {
+		terms["order"] = a.order
+	}
+	source := map[string]interface{}{
+		"terms": terms,
+	}
+	if len(a.subAggs) > 0 {
+		source["aggs"] = aggregationSources(a.subAggs)
+	}
+	return source
+}
+
+// DateHistogramAggregation buckets documents by fixed intervals of a date field
+type DateHistogramAggregation struct {
+	field    string
+	interval time.Duration
+	min, max time.Time
+	subAggs  map[string]Aggregation
+}
+
+// DateHistogram returns an aggregation with a bucket for each interval of a date field between
+// min and max, including empty buckets. Buckets are aligned to multiples of the interval since
+// the Unix epoch.
+func DateHistogram(field string, interval time.Duration, min, max time.Time) *DateHistogramAggregation {
+	return &DateHistogramAggregation{field: field, interval: interval, min: min, max: max}
+}
+
+// SubAggregation adds an aggregation computed within each bucket
+func (a *DateHistogramAggregation) SubAggregation(name string, agg Aggregation) *DateHistogramAggregation {
+	if a.subAggs == nil {
+		a.subAggs = map[string]Aggregation{}
+	}
+	a.subAggs[name] = agg
+	return a
+}
+
+func (a *DateHistogramAggregation) Source() map[string]interface{} {
+	source := map[string]interface{}{
+		"date_histogram": map[string]interface{}{
+			"field":          a.field,
+			"fixed_interval": fmt.Sprintf("%ds", int64(a.interval/time.Second)),
+			"min_doc_count":  0,
+			"extended_bounds": map[string]interface{}{
+				"min": a.min.UnixMilli(),
+				"max": a.max.UnixMilli(),
+			},
+		},
+	}
+	if len(a.subAggs) > 0 {
+		source["aggs"] = aggregationSources(a.subAggs)
+	}
+	return source
+}
+
+// CompositeAggregation pages through a bucket for each combination of the values of its sources,
+// in the order of their keys
+type CompositeAggregation struct {
+	size    int
+	sources []map[string]interface{}
+	after   map[string]interface{}
+}
+
+// Composite returns an aggregation returning up to size buckets per page
+func Composite(size int) *CompositeAggregation {
+	return &CompositeAggregation{size: size}
+}
+
+// TermsSource adds a source bucketing by the values of a field, keyed by name
+func (a *CompositeAggregation) TermsSource(name, field string) *CompositeAggregation {
+	a.sources = append(a.sources, map[string]interface{}{
+		name: map[string]interface{}{"terms": map[string]interface{}{"field": field}},
+	})
+	return a
+}
+
+// After continues after the bucket with the given key, which is the after_key of the previous page
+func (a *CompositeAggregation) After(key map[string]interface{}) *CompositeAggregation {
+	a.after = key
+	return a
+}
+
+func (a *CompositeAggregation) Source() map[string]interface{} {
+	composite := map[string]interface{}{
+		"size":    a.size,
+		"sources": a.sources,
+	}
+	if len(a.after) > 0 {
+		composite["after"] = a.after
+	}
+	return map[string]interface{}{"composite": composite}
+}
+
+// FilterAggregation counts the documents matching a query
+type FilterAggregation struct {
+	query Query
+}
+
+// FilterAgg returns an aggregation of the documents matching a query
+func FilterAgg(query Query) *FilterAggregation {
+	return &FilterAggregation{query: query}
+}
+
+func (a *FilterAggregation) Source() map[string]interface{} {
+	return map[string]interface{}{"filter": a.query.Source()}
+}
+
+// PercentilesAggregation approximates percentiles of the values of a field
+type PercentilesAggregation struct {
+	field    string
+	percents []float64
+}
+
+// Percentiles returns an aggregation of the given percentiles of a field
+func Percentiles(field string, percents ...float64) *PercentilesAggregation {
+	return &PercentilesAggregation{field: field, percents: percents}
+}
+
+func (a *PercentilesAggregation) Source() map[string]interface{} {
+	return map[string]interface{}{
+		"percentiles": map[string]interface{}{
+			"field":    a.field,
+			"percents": a.percents,
+		},
+	}
+}
+
+// MetricAggregation computes a single value from the values of a field
+type MetricAggregation struct {
+	kind  string
+	field string
+}
+
+// Cardinality returns an aggregation approximating the number of distinct values of a field
+func Cardinality(field string) *MetricAggregation {
+	return &MetricAggregation{kind: "cardinality", field: field}
+}
+
+// ValueCount returns an aggregation of the number of values of a field
+func ValueCount(field string) *MetricAggregation {
+	return &MetricAggregation{kind: "value_count", field: field}
+}
+
+// Min returns an aggregation of the lowest value of a field
+func Min(field string) *MetricAggregation {
+	return &MetricAggregation{kind: "min", field: field}
+}
+
+// Max returns an aggregation of the highest value of a field
+func Max(field string) *MetricAggregation {
+	return &MetricAggregation{kind: "max", field: field}
+}
+
+// Sum returns an aggregation of the sum of the values of a field
+func Sum(field string) *MetricAggregation {
+	return &MetricAggregation{kind: "sum", field: field}
+}
+
+func (a *MetricAggregation) Source() map[string]interface{} {
+	return map[string]interface{}{
+		a.kind: map[string]interface{}{"field": a.field},
+	}
+}
+
+func aggregationSources(aggs map[string]Aggregation) map[string]interface{} {
+	sources := make(map[string]interface{}, len(aggs))
+	for name, agg := range aggs {
+		sources[name] = agg.Source()
+	}
+	return sources
+}
+
+// SearchSource is the body of a search request
+type SearchSource struct {
+	query       Query
+	size        *int
+	from        *int
+	sort        []map[string]interface{}
+	searchAfter []interface{}
+	aggs        map[string]Aggregation
+	pitID       string
+	keepAlive   string
+	// trackTotalHits counts all matching documents instead of stopping at 10000
+	trackTotalHits bool
+}
+
+// NewSearch returns an empty search body, which matches all documents
+func NewSearch() *SearchSource {
+	return &SearchSource{}
+}
+
+func (s *SearchSource) Query(query Query) *SearchSource {
+	s.query = query
+	return s
+}
+
+// Size sets the number of hits to return; 0 returns aggregations only
+func (s *SearchSource) Size(size int) *SearchSource {
+	s.size = &size
+	return s
+}
+
+func (s *SearchSource) From(from int) *SearchSource {
+	s.from = &from
+	return s
+}
+
+// Sort adds a sort on a field in SortAsc or SortDesc order. Sorts apply in the order they are added.
+func (s *SearchSource) Sort(field, order string) *SearchSource {
+	s.sort = append(s.sort, map[string]interface{}{
+		field: map[string]interface{}{"order": order},
+	})
+	return s
+}
+
+// SearchAfter continues a sorted search after the hit with the given sort values, which are the
+// sort values of the last hit of the previous page
+func (s *SearchSource) SearchAfter(values ...interface{}) *SearchSource {
+	s.searchAfter = values
+	return s
+}
+
+// PointInTime searches the point in time with the given ID instead of indices, extending its
+// lifetime by keepAlive
+func (s *SearchSource) PointInTime(id, keepAlive string) *SearchSource {
+	s.pitID = id
+	s.keepAlive = keepAlive
+	return s
+}
+
+// TrackTotalHits counts all matching documents in the total of the hits, which is otherwise a
+// lower bound once it reaches 10000
+func (s *SearchSource) TrackTotalHits() *SearchSource {
+	s.trackTotalHits = true
+	return s
+}
+
+func (s *SearchSource) Aggregation(name string, agg Aggregation) *SearchSource {
+	if s.aggs == nil {
+		s.aggs = map[string]Aggregation{}
+	}
+	s.aggs[name] = agg
+	return s
+}
+
+func (s *SearchSource) Source() map[string]interface{} {
+	source := map[string]interface{}{}
+	if s.query != nil {
+		source["query"] = s.query.Source()
+	}
+	if s.size != nil {
+		source["size"] = *s.size
+	}
+	if s.from != nil {
+		source["from"] = *s.from
+	}
+	if len(s.sort) > 0 {
+		source["sort"] = s.sort
+	}
+	if len(s.searchAfter) > 0 {
+		source["search_after"] = s.searchAfter
+	}
+	if len(s.aggs) > 0 {
+		source["aggs"] = aggregationSources(s.aggs)
+	}
+	if s.trackTotalHits {
+		source["track_total_hits"] = true
+	}
+	if s.pitID != "" {
+		source["pit"] = map[string]interface{}{
+			"id":         s.pitID,
+			"keep_alive": s.keepAlive,
+		}
+	}
+	return source
+}
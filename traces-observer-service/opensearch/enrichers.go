@@ -0,0 +1,134 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/config"
+)
+
+// SpanEnricher derives information from the fields of a span parsed from OpenSearch, typically
+// into its AmpAttributes. Enrichers run in order, each seeing the changes of the ones before it.
+type SpanEnricher interface {
+	Enrich(span *Span)
+}
+
+// SpanEnricherFunc adapts a function to a SpanEnricher
+type SpanEnricherFunc func(span *Span)
+
+// Enrich calls f(span)
+func (f SpanEnricherFunc) Enrich(span *Span) {
+	f(span)
+}
+
+// spanEnrichers is the pipeline run by parseSpan: the semantic span type first, as the other
+// enrichers depend on it, then the attributes of each span type and the error status
+var spanEnrichers = []SpanEnricher{
+	SpanEnricherFunc(enrichSpanType),
+	spanTypeEnricher(SpanTypeLLM, func(ampAttrs *AmpAttributes, span *Span) {
+		populateLLMAttributes(ampAttrs, span.Attributes)
+	}),
+	spanTypeEnricher(SpanTypeTool, func(ampAttrs *AmpAttributes, span *Span) {
+		populateToolAttributes(ampAttrs, span.Attributes, span.Status)
+	}),
+	spanTypeEnricher(SpanTypeEmbedding, func(ampAttrs *AmpAttributes, span *Span) {
+		populateEmbeddingAttributes(ampAttrs, span.Attributes)
+	}),
+	spanTypeEnricher(SpanTypeRetriever, func(ampAttrs *AmpAttributes, span *Span) {
+		populateRetrieverAttributes(ampAttrs, span.Attributes)
+	}),
+	spanTypeEnricher(SpanTypeAgent, func(ampAttrs *AmpAttributes, span *Span) {
+		// Check if this is a CrewAI workflow span and delegate to CrewAI processor
+		if IsCrewAISpan(span.Attributes) {
+			PopulateCrewAIAgentAttributes(ampAttrs, span.Attributes)
+		} else {
+			populateAgentAttributes(ampAttrs, span.Attributes)
+		}
+	}),
+	spanTypeEnricher(SpanTypeCrewAITask, func(ampAttrs *AmpAttributes, span *Span) {
+		populateCrewAITaskAttributes(ampAttrs, span.Attributes)
+	}),
+	spanTypeEnricher(SpanTypeChain, func(ampAttrs *AmpAttributes, span *Span) {
+		populateChainAttributes(ampAttrs, span.Attributes)
+	}),
+	SpanEnricherFunc(enrichSpanStatus),
+}
+
+// RegisterSpanEnricher appends an enricher to the pipeline run on every parsed span, after the
+// built-in enrichers, so that it sees their AmpAttributes. Deployments use it to derive their own
+// attributes, for example into AmpAttributes.Extensions. It is not safe for concurrent use and must
+// be called at startup, before spans are parsed.
+func RegisterSpanEnricher(enricher SpanEnricher) {
+	spanEnrichers = append(spanEnrichers, enricher)
+}
+
+// enrichSpan runs the enricher pipeline on a span
+func enrichSpan(span *Span) {
+	for _, enricher := range spanEnrichers {
+		enricher.Enrich(span)
+	}
+}
+
+// enrichSpanType sets the AmpAttributes of a span to its semantic span type
+func enrichSpanType(span *Span) {
+	span.AmpAttributes = &AmpAttributes{
+		Kind: string(DetermineSpanType(*span)),
+	}
+}
+
+// spanTypeEnricher returns an enricher populating the AmpAttributes of the spans of one type that
+// have attributes
+func spanTypeEnricher(spanType SpanType, populate func(ampAttrs *AmpAttributes, span *Span)) SpanEnricher {
+	return SpanEnricherFunc(func(span *Span) {
+		if span.AmpAttributes == nil || span.AmpAttributes.Kind != string(spanType) || span.Attributes == nil {
+			return
+		}
+		populate(span.AmpAttributes, span)
+	})
+}
+
+// enrichSpanStatus extracts the error status of a span of any type
+func enrichSpanStatus(span *Span) {
+	if span.AmpAttributes == nil {
+		return
+	}
+	span.AmpAttributes.Status = extractSpanStatus(span.Attributes, span.Status)
+}
+
+// NewCostEnricher returns an enricher setting the estimated cost of LLM and embedding calls with a
+// known model price. Prices are read from the table for each span, so reloaded prices apply at once.
+func NewCostEnricher(priceTable *config.ModelPriceTable) SpanEnricher {
+	return SpanEnricherFunc(func(span *Span) {
+		if span.AmpAttributes == nil {
+			return
+		}
+		pricing := priceTable.Prices()
+		switch data := span.AmpAttributes.Data.(type) {
+		case LLMData:
+			if price := findModelPrice(pricing, data.Model, data.Vendor); price != nil && data.TokenUsage != nil {
+				cost := estimateCost(data.TokenUsage, price)
+				data.EstimatedCost = &cost
+				span.AmpAttributes.Data = data
+			}
+		case EmbeddingData:
+			if price := findModelPrice(pricing, data.Model, data.Vendor); price != nil && data.TokenUsage != nil {
+				cost := estimateCost(data.TokenUsage, price)
+				data.EstimatedCost = &cost
+				span.AmpAttributes.Data = data
+			}
+		}
+	})
+}
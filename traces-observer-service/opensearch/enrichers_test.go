@@ -0,0 +1,79 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"testing"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/config"
+)
+
+func llmSource() map[string]interface{} {
+	return map[string]interface{}{
+		"traceId": "t1",
+		"spanId":  "s1",
+		"name":    "chat gpt-4o",
+		"attributes": map[string]interface{}{
+			"gen_ai.operation.name":      "chat",
+			"gen_ai.system":              "openai",
+			"gen_ai.request.model":       "gpt-4o-2024-08-06",
+			"gen_ai.usage.input_tokens":  float64(1000),
+			"gen_ai.usage.output_tokens": float64(500),
+			"gen_ai.prompt.0.role":       "user",
+			"gen_ai.prompt.0.content":    "Hi",
+		},
+	}
+}
+
+func TestParseSpanEnrichers(t *testing.T) {
+	span := parseSpan(llmSource())
+	if span.AmpAttributes == nil || span.AmpAttributes.Kind != string(SpanTypeLLM) {
+		t.Fatalf("expected an LLM span, got %+v", span.AmpAttributes)
+	}
+	data, ok := span.AmpAttributes.Data.(LLMData)
+	if !ok || data.Model != "gpt-4o-2024-08-06" || data.TokenUsage == nil {
+		t.Fatalf("expected LLM data with token usage, got %+v", span.AmpAttributes.Data)
+	}
+	if data.EstimatedCost != nil {
+		t.Errorf("expected no cost without model prices, got %v", *data.EstimatedCost)
+	}
+	if span.AmpAttributes.Status == nil || span.AmpAttributes.Status.Error {
+		t.Errorf("expected a successful status, got %+v", span.AmpAttributes.Status)
+	}
+}
+
+func TestRegisterSpanEnricher(t *testing.T) {
+	defaults := spanEnrichers
+	t.Cleanup(func() { spanEnrichers = defaults })
+
+	RegisterSpanEnricher(NewCostEnricher(config.NewModelPriceTable([]config.ModelPrice{
+		{Model: "gpt-4o", InputCostPerMillionTokens: 2.5, OutputCostPerMillionTokens: 10},
+	})))
+	RegisterSpanEnricher(SpanEnricherFunc(func(span *Span) {
+		// Registered enrichers see the AmpAttributes of the built-in ones
+		span.AmpAttributes.Extensions = map[string]interface{}{"kind": span.AmpAttributes.Kind}
+	}))
+
+	span := parseSpan(llmSource())
+	data := span.AmpAttributes.Data.(LLMData)
+	if data.EstimatedCost == nil || *data.EstimatedCost != 0.0075 {
+		t.Errorf("expected an estimated cost of 0.0075, got %v", data.EstimatedCost)
+	}
+	if span.AmpAttributes.Extensions["kind"] != string(SpanTypeLLM) {
+		t.Errorf("expected the custom enricher to run, got %+v", span.AmpAttributes.Extensions)
+	}
+}
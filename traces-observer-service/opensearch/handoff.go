@@ -0,0 +1,157 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"sort"
+	"strings"
+)
+
+const (
+	// HandOffProtocolA2A marks calls made with the Agent2Agent protocol
+	HandOffProtocolA2A = "a2a"
+	// HandOffProtocolHTTP marks plain HTTP calls with trace context propagation
+	HandOffProtocolHTTP = "http"
+)
+
+// CorrelateHandOffs detects calls between agent components in the spans of a trace.
+// A hand-off is a span whose parent span belongs to a different component. Hand-offs are
+// returned in chronological order.
+//
+// The spans are changed in place, so that a trace returned with them shows where each agent was
+// called: the called span gets the hand-off in AmpAttributes.HandOff, and is given AmpAttributes
+// of its determined kind if it was not parsed with ParseSpans. Pass a copy to keep spans unchanged.
+func CorrelateHandOffs(spans []Span) []HandOff {
+	spansByID := make(map[string]*Span, len(spans))
+	for i := range spans {
+		spansByID[spans[i].SpanID] = &spans[i]
+	}
+
+	handOffs := []HandOff{}
+	for i := range spans {
+		target := &spans[i]
+		if target.ParentSpanID == "" {
+			continue
+		}
+		source, ok := spansByID[target.ParentSpanID]
+		if !ok || source.Service == target.Service {
+			continue
+		}
+
+		handOff := HandOff{
+			SourceComponentUid: source.Service,
+			SourceAgent:        ComponentName(*source),
+			SourceSpanID:       source.SpanID,
+			TargetComponentUid: target.Service,
+			TargetAgent:        ComponentName(*target),
+			TargetSpanID:       target.SpanID,
+			Protocol:           detectHandOffProtocol(source.Attributes, target.Attributes),
+		}
+		if target.AmpAttributes == nil {
+			target.AmpAttributes = &AmpAttributes{Kind: string(DetermineSpanType(*target))}
+		}
+		target.AmpAttributes.HandOff = &handOff
+		handOffs = append(handOffs, handOff)
+	}
+
+	sort.SliceStable(handOffs, func(i, j int) bool {
+		return spansByID[handOffs[i].TargetSpanID].StartTime.Before(spansByID[handOffs[j].TargetSpanID].StartTime)
+	})
+	return handOffs
+}
+
+// SummarizeTraceAgents lists the components that emitted spans of a trace, in order of their first span
+func SummarizeTraceAgents(spans []Span) []TraceAgent {
+	agents := []TraceAgent{}
+	indexByComponent := make(map[string]int)
+
+	ordered := make([]int, len(spans))
+	for i := range spans {
+		ordered[i] = i
+	}
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return spans[ordered[i]].StartTime.Before(spans[ordered[j]].StartTime)
+	})
+
+	for _, i := range ordered {
+		span := spans[i]
+		index, ok := indexByComponent[span.Service]
+		if !ok {
+			index = len(agents)
+			indexByComponent[span.Service] = index
+			agents = append(agents, TraceAgent{ComponentUid: span.Service, AgentName: ComponentName(span)})
+		}
+		agents[index].SpanCount++
+	}
+	return agents
+}
+
+// FindEntrySpan returns the first span of a component's part of a trace whose parent is not among
+// the given spans, or nil. This is the local root of an agent that was called by another agent.
+func FindEntrySpan(spans []Span) *Span {
+	spanIDs := make(map[string]bool, len(spans))
+	for _, span := range spans {
+		spanIDs[span.SpanID] = true
+	}
+
+	var entry *Span
+	for i := range spans {
+		if spans[i].ParentSpanID == "" || spanIDs[spans[i].ParentSpanID] {
+			continue
+		}
+		if entry == nil || spans[i].StartTime.Before(entry.StartTime) {
+			entry = &spans[i]
+		}
+	}
+	return entry
+}
+
+// ComponentName returns the name of the component that emitted the span, if known
+func ComponentName(span Span) string {
+	if name, ok := span.Resource["openchoreo.dev/component"].(string); ok && name != "" {
+		return name
+	}
+	if name, ok := span.Resource["service.name"].(string); ok {
+		return name
+	}
+	return ""
+}
+
+// detectHandOffProtocol infers the protocol of a hand-off from the attributes of the calling and called spans
+func detectHandOffProtocol(sourceAttrs, targetAttrs map[string]interface{}) string {
+	isHTTP := false
+	for _, attrs := range []map[string]interface{}{sourceAttrs, targetAttrs} {
+		for key, value := range attrs {
+			if strings.HasPrefix(key, "a2a.") {
+				return HandOffProtocolA2A
+			}
+			// A2A is JSON-RPC with message/* and tasks/* methods
+			if key == "rpc.method" || key == "jsonrpc.method" {
+				if method, ok := value.(string); ok && (strings.HasPrefix(method, "message/") || strings.HasPrefix(method, "tasks/")) {
+					return HandOffProtocolA2A
+				}
+			}
+			if strings.HasPrefix(key, "http.") || key == "url.full" || key == "url.path" {
+				isHTTP = true
+			}
+		}
+	}
+	if isHTTP {
+		return HandOffProtocolHTTP
+	}
+	return ""
+}
@@ -0,0 +1,197 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCorrelateHandOffs(t *testing.T) {
+	start := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	span := func(id, parentID, service string, second int, attrs map[string]interface{}) Span {
+		return Span{
+			SpanID:       id,
+			ParentSpanID: parentID,
+			Service:      service,
+			StartTime:    start.Add(time.Duration(second) * time.Second),
+			Attributes:   attrs,
+			Resource:     map[string]interface{}{"openchoreo.dev/component": service + "-name"},
+		}
+	}
+
+	spans := []Span{
+		span("root", "", "planner", 0, nil),
+		// The researcher is called later than the writer, but listed first
+		span("research", "call-research", "researcher", 5, map[string]interface{}{"rpc.method": "message/send"}),
+		span("call-research", "root", "planner", 4, nil),
+		span("write", "call-write", "writer", 2, map[string]interface{}{"http.route": "/invoke"}),
+		span("call-write", "root", "planner", 1, map[string]interface{}{"http.request.method": "POST"}),
+		span("research-llm", "research", "researcher", 6, nil),
+		// The parent of this span was not read, so its caller is not known
+		span("orphan", "missing", "reviewer", 3, nil),
+	}
+	spans[2].AmpAttributes = &AmpAttributes{Kind: string(SpanTypeTool)}
+
+	handOffs := CorrelateHandOffs(spans)
+	if len(handOffs) != 2 {
+		t.Fatalf("expected 2 hand-offs, got %+v", handOffs)
+	}
+
+	t.Run("hand-offs are ordered by the start of the called span", func(t *testing.T) {
+		if handOffs[0].TargetSpanID != "write" || handOffs[1].TargetSpanID != "research" {
+			t.Errorf("expected the writer then the researcher, got %+v", handOffs)
+		}
+	})
+
+	t.Run("a hand-off is a span whose parent is in another component", func(t *testing.T) {
+		want := HandOff{
+			SourceComponentUid: "planner",
+			SourceAgent:        "planner-name",
+			SourceSpanID:       "call-research",
+			TargetComponentUid: "researcher",
+			TargetAgent:        "researcher-name",
+			TargetSpanID:       "research",
+			Protocol:           HandOffProtocolA2A,
+		}
+		if handOffs[1] != want {
+			t.Errorf("expected %+v, got %+v", want, handOffs[1])
+		}
+		if handOffs[0].Protocol != HandOffProtocolHTTP {
+			t.Errorf("expected an http hand-off to the writer, got %q", handOffs[0].Protocol)
+		}
+	})
+
+	t.Run("called spans are tagged in place", func(t *testing.T) {
+		research := spans[1]
+		if research.AmpAttributes == nil || research.AmpAttributes.HandOff == nil || *research.AmpAttributes.HandOff != handOffs[1] {
+			t.Fatalf("expected the researcher span to carry its hand-off, got %+v", research.AmpAttributes)
+		}
+		if research.AmpAttributes.Kind != string(DetermineSpanType(research)) {
+			t.Errorf("expected attributes created with the span kind, got %q", research.AmpAttributes.Kind)
+		}
+		for _, i := range []int{0, 2, 4, 5, 6} {
+			if spans[i].AmpAttributes != nil && spans[i].AmpAttributes.HandOff != nil {
+				t.Errorf("expected span %s not to be tagged", spans[i].SpanID)
+			}
+		}
+		if spans[2].AmpAttributes.Kind != string(SpanTypeTool) {
+			t.Errorf("expected existing attributes to be kept, got %+v", spans[2].AmpAttributes)
+		}
+	})
+}
+
+func TestCorrelateHandOffsWithinOneComponent(t *testing.T) {
+	spans := []Span{
+		{SpanID: "root", Service: "agent"},
+		{SpanID: "child", ParentSpanID: "root", Service: "agent"},
+	}
+	if handOffs := CorrelateHandOffs(spans); len(handOffs) != 0 {
+		t.Errorf("expected no hand-offs, got %+v", handOffs)
+	}
+	if spans[1].AmpAttributes != nil {
+		t.Errorf("expected spans without hand-offs to be left alone, got %+v", spans[1].AmpAttributes)
+	}
+}
+
+func TestDetectHandOffProtocol(t *testing.T) {
+	tests := []struct {
+		name           string
+		source, target map[string]interface{}
+		want           string
+	}{
+		{"a2a attributes on the caller", map[string]interface{}{"a2a.task.id": "t1", "http.method": "POST"}, nil, HandOffProtocolA2A},
+		{"a2a attributes on the called span", nil, map[string]interface{}{"a2a.agent.name": "researcher"}, HandOffProtocolA2A},
+		{"json-rpc message method", map[string]interface{}{"jsonrpc.method": "message/stream"}, nil, HandOffProtocolA2A},
+		{"rpc tasks method", nil, map[string]interface{}{"rpc.method": "tasks/get"}, HandOffProtocolA2A},
+		{"other rpc method over http", map[string]interface{}{"rpc.method": "tools/call", "url.full": "http://mcp/rpc"}, nil, HandOffProtocolHTTP},
+		{"http attributes", map[string]interface{}{"http.request.method": "POST"}, nil, HandOffProtocolHTTP},
+		{"url path", nil, map[string]interface{}{"url.path": "/invoke"}, HandOffProtocolHTTP},
+		{"no transport attributes", map[string]interface{}{"gen_ai.system": "openai"}, nil, ""},
+		{"no attributes", nil, nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := detectHandOffProtocol(tt.source, tt.target); got != tt.want {
+				t.Errorf("detectHandOffProtocol() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSummarizeTraceAgents(t *testing.T) {
+	start := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	spans := []Span{
+		{SpanID: "b1", Service: "researcher", StartTime: start.Add(3 * time.Second), Resource: map[string]interface{}{"service.name": "research-svc"}},
+		{SpanID: "a1", Service: "planner", StartTime: start, Resource: map[string]interface{}{"openchoreo.dev/component": "planner"}},
+		{SpanID: "b2", Service: "researcher", StartTime: start.Add(4 * time.Second)},
+		{SpanID: "a2", Service: "planner", StartTime: start.Add(time.Second)},
+		{SpanID: "a3", Service: "planner", StartTime: start.Add(5 * time.Second)},
+	}
+
+	agents := SummarizeTraceAgents(spans)
+	want := []TraceAgent{
+		{ComponentUid: "planner", AgentName: "planner", SpanCount: 3},
+		{ComponentUid: "researcher", AgentName: "research-svc", SpanCount: 2},
+	}
+	if len(agents) != len(want) {
+		t.Fatalf("expected %d agents, got %+v", len(want), agents)
+	}
+	for i := range want {
+		if agents[i] != want[i] {
+			t.Errorf("agent %d: expected %+v, got %+v", i, want[i], agents[i])
+		}
+	}
+	if agents := SummarizeTraceAgents(nil); agents == nil || len(agents) != 0 {
+		t.Errorf("expected no agents without spans, got %#v", agents)
+	}
+}
+
+func TestFindEntrySpan(t *testing.T) {
+	start := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("the earliest span called from outside the component", func(t *testing.T) {
+		spans := []Span{
+			{SpanID: "late", ParentSpanID: "caller-2", StartTime: start.Add(2 * time.Second)},
+			{SpanID: "entry", ParentSpanID: "caller-1", StartTime: start.Add(time.Second)},
+			{SpanID: "child", ParentSpanID: "entry", StartTime: start},
+		}
+		entry := FindEntrySpan(spans)
+		if entry == nil || entry.SpanID != "entry" {
+			t.Fatalf("expected the entry span, got %+v", entry)
+		}
+		if entry != &spans[1] {
+			t.Error("expected a pointer into the given spans")
+		}
+	})
+
+	t.Run("root spans are not entry spans", func(t *testing.T) {
+		spans := []Span{
+			{SpanID: "root", StartTime: start},
+			{SpanID: "child", ParentSpanID: "root", StartTime: start.Add(time.Second)},
+		}
+		if entry := FindEntrySpan(spans); entry != nil {
+			t.Errorf("expected no entry span, got %+v", entry)
+		}
+	})
+
+	t.Run("no spans", func(t *testing.T) {
+		if entry := FindEntrySpan(nil); entry != nil {
+			t.Errorf("expected no entry span, got %+v", entry)
+		}
+	})
+}
@@ -0,0 +1,284 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"time"
+)
+
+// Issues group the error spans of agent runs by fingerprint, the error type of the span together
+// with the tool it called and its component, much like an error tracker groups exceptions. The
+// issue grouping job adds the error spans that ended since its previous run to the issue document
+// of their fingerprint, which keeps the first and last time the error was seen, the number of traces
+// it occurred in and the most recent of them. Issues are kept in a single index, so that they
+// outlive the retention of the span indices.
+const (
+	// IssueIndex is the index issues are stored in, which is also the name of its index template
+	IssueIndex = "otel-trace-issues"
+	// MaxIssueTraceIDs is the number of the most recent traces of an issue that are kept
+	MaxIssueTraceIDs = 20
+	// issueTimeLayout has a fixed width, so that the times of issues compare as strings in the
+	// update script
+	issueTimeLayout    = "2006-01-02T15:04:05.000Z"
+	issueLastSeenField = "lastSeen"
+)
+
+// IssueStatus is the triage status of an issue
+type IssueStatus string
+
+const (
+	IssueStatusOpen         IssueStatus = "open"
+	IssueStatusAcknowledged IssueStatus = "acknowledged"
+	// IssueStatusResolved issues are opened again when the error occurs after they were resolved
+	IssueStatusResolved IssueStatus = "resolved"
+)
+
+// ParseIssueStatus returns the issue status named by value
+func ParseIssueStatus(value string) (IssueStatus, bool) {
+	switch status := IssueStatus(value); status {
+	case IssueStatusOpen, IssueStatusAcknowledged, IssueStatusResolved:
+		return status, true
+	default:
+		return "", false
+	}
+}
+
+// Issue is a group of error traces with the same fingerprint
+type Issue struct {
+	// ID is the fingerprint of the issue
+	ID           string `json:"id"`
+	ComponentUid string `json:"componentUid"`
+	// ProjectUid is the project of the component, which callers restricted to projects are filtered on
+	ProjectUid string `json:"projectUid,omitempty"`
+	ErrorType  string `json:"errorType,omitempty"`
+	ToolName   string `json:"toolName,omitempty"`
+	// SpanName is the name of the span of the most recent occurrence
+	SpanName string      `json:"spanName"`
+	Status   IssueStatus `json:"status"`
+	// Occurrences is the number of traces the error occurred in
+	Occurrences int    `json:"occurrences"`
+	FirstSeen   string `json:"firstSeen"`
+	LastSeen    string `json:"lastSeen"`
+	// RecentTraceIDs are the most recent traces the error occurred in, newest first
+	RecentTraceIDs  []string `json:"recentTraceIds"`
+	StatusUpdatedAt string   `json:"statusUpdatedAt,omitempty"`
+	// StatusUpdatedBy is the subject of the token the status was last changed with; it is empty when
+	// the status was set by the issue grouping job
+	StatusUpdatedBy string `json:"statusUpdatedBy,omitempty"`
+}
+
+// IssueQueryParams holds the parameters of an issue list
+type IssueQueryParams struct {
+	ComponentUid string      // Optional
+	Status       IssueStatus // Optional
+	Limit        int
+	Offset       int
+}
+
+// IssueListResponse represents a page of issues, most recently seen first
+type IssueListResponse struct {
+	Issues     []Issue `json:"issues"`
+	TotalCount int     `json:"totalCount"`
+}
+
+// IssueFingerprint returns the fingerprint of the errors of a component with an error type, raised
+// while calling a tool when toolName is set
+func IssueFingerprint(componentUid, errorType, toolName string) string {
+	hash := sha256.New()
+	for _, part := range []string{componentUid, errorType, toolName} {
+		hash.Write([]byte(part))
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil))[:32]
+}
+
+// GroupIssueOccurrences groups error spans into the occurrences of their issues, ordered by ID.
+// An issue occurs once per trace, however many of its spans failed in the same way.
+func GroupIssueOccurrences(spans []Span) []Issue {
+	issues := make(map[string]*Issue)
+	traceTimes := make(map[string]map[string]string)
+	for _, span := range spans {
+		amp := span.AmpAttributes
+		if amp == nil || amp.Status == nil || !amp.Status.Error {
+			continue
+		}
+		var toolName string
+		if data, ok := amp.Data.(ToolData); ok {
+			toolName = data.Name
+		}
+		seen := span.StartTime.UTC().Format(issueTimeLayout)
+
+		id := IssueFingerprint(span.Service, amp.Status.ErrorType, toolName)
+		issue, ok := issues[id]
+		if !ok {
+			issue = &Issue{
+				ID:           id,
+				ComponentUid: span.Service,
+				ErrorType:    amp.Status.ErrorType,
+				ToolName:     toolName,
+				Status:       IssueStatusOpen,
+				FirstSeen:    seen,
+			}
+			issue.ProjectUid, _ = GetString(span.Resource, "openchoreo.dev/project-uid")
+			issues[id] = issue
+			traceTimes[id] = make(map[string]string)
+		}
+		if seen < issue.FirstSeen {
+			issue.FirstSeen = seen
+		}
+		if seen >= issue.LastSeen {
+			issue.LastSeen = seen
+			issue.SpanName = span.Name
+		}
+		if seen > traceTimes[id][span.TraceID] {
+			traceTimes[id][span.TraceID] = seen
+		}
+	}
+
+	grouped := make([]Issue, 0, len(issues))
+	for id, issue := range issues {
+		times := traceTimes[id]
+		issue.Occurrences = len(times)
+		issue.RecentTraceIDs = make([]string, 0, len(times))
+		for traceID := range times {
+			issue.RecentTraceIDs = append(issue.RecentTraceIDs, traceID)
+		}
+		sort.Slice(issue.RecentTraceIDs, func(i, j int) bool {
+			a, b := issue.RecentTraceIDs[i], issue.RecentTraceIDs[j]
+			if times[a] != times[b] {
+				return times[a] > times[b]
+			}
+			return a < b
+		})
+		if len(issue.RecentTraceIDs) > MaxIssueTraceIDs {
+			issue.RecentTraceIDs = issue.RecentTraceIDs[:MaxIssueTraceIDs]
+		}
+		grouped = append(grouped, *issue)
+	}
+	sort.Slice(grouped, func(i, j int) bool { return grouped[i].ID < grouped[j].ID })
+	return grouped
+}
+
+// issueUpdateScript adds the occurrences of an issue to its document. Resolved issues are opened
+// again when the error occurred after they were resolved.
+const issueUpdateScript = `
+def issue = ctx._source;
+issue.occurrences += params.occurrences;
+if (params.firstSeen.compareTo(issue.firstSeen) < 0) { issue.firstSeen = params.firstSeen; }
+if (params.lastSeen.compareTo(issue.lastSeen) >= 0) { issue.lastSeen = params.lastSeen; issue.spanName = params.spanName; }
+List traceIds = new ArrayList(params.traceIds);
+for (def traceId : issue.recentTraceIds) {
+  if (traceIds.size() >= params.maxTraceIds) { break; }
+  if (!traceIds.contains(traceId)) { traceIds.add(traceId); }
+}
+issue.recentTraceIds = traceIds;
+if (issue.status == 'resolved' && (issue.statusUpdatedAt == null || params.lastSeen.compareTo(issue.statusUpdatedAt) > 0)) {
+  issue.status = 'open';
+  issue.statusUpdatedAt = params.now;
+  issue.statusUpdatedBy = '';
+}
+`
+
+// IssueUpdate returns the bulk update that adds the occurrences of an issue to its document, or
+// creates the document when the issue is new
+func IssueUpdate(occurrences Issue, now time.Time) BulkUpdate {
+	return BulkUpdate{
+		Index:  IssueIndex,
+		ID:     occurrences.ID,
+		Script: issueUpdateScript,
+		Params: map[string]interface{}{
+			"occurrences": occurrences.Occurrences,
+			"firstSeen":   occurrences.FirstSeen,
+			"lastSeen":    occurrences.LastSeen,
+			"spanName":    occurrences.SpanName,
+			"traceIds":    occurrences.RecentTraceIDs,
+			"maxTraceIds": MaxIssueTraceIDs,
+			"now":         FormatIssueTime(now),
+		},
+		Upsert: occurrences,
+	}
+}
+
+// FormatIssueTime formats a time the way the times of issues are stored
+func FormatIssueTime(t time.Time) string {
+	return t.UTC().Format(issueTimeLayout)
+}
+
+// IssueIndexTemplateSource returns the index template of the issue index
+func IssueIndexTemplateSource() map[string]interface{} {
+	keyword := map[string]interface{}{"type": "keyword"}
+	date := map[string]interface{}{"type": "date"}
+	return map[string]interface{}{
+		"index_patterns": []string{IssueIndex},
+		"template": map[string]interface{}{
+			"mappings": map[string]interface{}{
+				"dynamic": false,
+				"properties": map[string]interface{}{
+					"id":              keyword,
+					"componentUid":    keyword,
+					"projectUid":      keyword,
+					"errorType":       keyword,
+					"toolName":        keyword,
+					"status":          keyword,
+					"occurrences":     map[string]interface{}{"type": "long"},
+					"firstSeen":       date,
+					"lastSeen":        date,
+					"statusUpdatedAt": date,
+				},
+			},
+		},
+	}
+}
+
+// BuildIssueSpansQuery builds the query matching the error spans that ended in [start, end). The
+// error status of the spans is decided when they are parsed; the query selects the candidates.
+func BuildIssueSpansQuery(start, end time.Time) Query {
+	return Bool().
+		Must(Range(endTimeField).Gte(start.Format(time.RFC3339Nano)).Lt(end.Format(time.RFC3339Nano))).
+		Must(Bool().Should(errorStatusQuery(), Exists("attributes.error.type")).MinimumShouldMatch(1))
+}
+
+// BuildIssuesQuery builds a page of issues, most recently seen first
+func BuildIssuesQuery(params IssueQueryParams) *SearchSource {
+	query := Bool()
+	if params.ComponentUid != "" {
+		query.Filter(Term("componentUid", params.ComponentUid))
+	}
+	if params.Status != "" {
+		query.Filter(Term("status", string(params.Status)))
+	}
+	return NewSearch().
+		Query(query).
+		Size(params.Limit).
+		From(params.Offset).
+		Sort(issueLastSeenField, SortDesc).
+		Sort("id", SortAsc).
+		TrackTotalHits()
+}
+
+// BuildIssueQuery builds a search for an issue
+func BuildIssueQuery(issueID string) *SearchSource {
+	return NewSearch().Size(1).Query(Term("id", issueID))
+}
+
+// ParseIssues reads the issues of a search response
+func ParseIssues(response *SearchResponse) ([]Issue, error) {
+	return decodeDocuments[Issue](response)
+}
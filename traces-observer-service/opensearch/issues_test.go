@@ -0,0 +1,125 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"testing"
+	"time"
+)
+
+func errorSpan(traceID, component, errorType, tool string, start time.Time) Span {
+	return Span{
+		TraceID:   traceID,
+		Name:      "execute_tool " + tool,
+		Service:   component,
+		StartTime: start,
+		Resource:  map[string]interface{}{"openchoreo.dev/project-uid": "p1"},
+		AmpAttributes: &AmpAttributes{
+			Kind:   string(SpanTypeTool),
+			Status: &SpanStatus{Error: true, ErrorType: errorType},
+			Data:   ToolData{Name: tool},
+		},
+	}
+}
+
+func TestGroupIssueOccurrences(t *testing.T) {
+	start := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	succeeded := errorSpan("t9", "agent-a", "", "search", start)
+	succeeded.AmpAttributes.Status = &SpanStatus{Error: false}
+	spans := []Span{
+		errorSpan("t1", "agent-a", "TimeoutError", "search", start),
+		errorSpan("t1", "agent-a", "TimeoutError", "search", start.Add(time.Second)),
+		errorSpan("t2", "agent-a", "TimeoutError", "search", start.Add(time.Minute)),
+		errorSpan("t2", "agent-a", "TimeoutError", "fetch", start.Add(time.Minute)),
+		errorSpan("t3", "agent-b", "TimeoutError", "search", start),
+		succeeded,
+	}
+
+	issues := GroupIssueOccurrences(spans)
+	if len(issues) != 3 {
+		t.Fatalf("expected 3 issues, got %d: %+v", len(issues), issues)
+	}
+	var search *Issue
+	for i := range issues {
+		if issues[i].ID == IssueFingerprint("agent-a", "TimeoutError", "search") {
+			search = &issues[i]
+		}
+	}
+	if search == nil {
+		t.Fatalf("no issue for the search timeouts of agent-a: %+v", issues)
+	}
+	if search.Occurrences != 2 {
+		t.Errorf("expected one occurrence per trace, got %d", search.Occurrences)
+	}
+	if search.FirstSeen != "2026-01-01T10:00:00.000Z" || search.LastSeen != "2026-01-01T10:01:00.000Z" {
+		t.Errorf("unexpected first and last seen: %s, %s", search.FirstSeen, search.LastSeen)
+	}
+	if len(search.RecentTraceIDs) != 2 || search.RecentTraceIDs[0] != "t2" || search.RecentTraceIDs[1] != "t1" {
+		t.Errorf("expected the most recent trace first, got %v", search.RecentTraceIDs)
+	}
+	if search.Status != IssueStatusOpen || search.ProjectUid != "p1" || search.ToolName != "search" {
+		t.Errorf("unexpected issue: %+v", search)
+	}
+}
+
+func TestIssueFingerprint(t *testing.T) {
+	if IssueFingerprint("agent-a", "TimeoutError", "search") != IssueFingerprint("agent-a", "TimeoutError", "search") {
+		t.Error("expected the same fingerprint for the same error")
+	}
+	// The parts are separated, so that they cannot run into each other
+	if IssueFingerprint("agent-a", "Error", "x") == IssueFingerprint("agent-a", "Errorx", "") {
+		t.Error("expected different fingerprints for different errors")
+	}
+}
+
+func TestBuildIssueQueries(t *testing.T) {
+	start := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	requireJSON(t, `{"bool":{"must":[
+		{"range":{"endTime":{"gte":"2026-01-01T10:00:00Z","lt":"2026-01-01T11:00:00Z"}}},
+		{"bool":{"should":[
+			{"bool":{"should":[
+				{"match":{"status.code":{"query":"2","lenient":true}}},
+				{"match":{"status.code":{"query":"Error","lenient":true}}},
+				{"match":{"status.code":{"query":"ERROR","lenient":true}}},
+				{"match":{"status.code":{"query":"error","lenient":true}}}
+			],"minimum_should_match":1}},
+			{"exists":{"field":"attributes.error.type"}}
+		],"minimum_should_match":1}}
+	]}}`, BuildIssueSpansQuery(start, start.Add(time.Hour)).Source())
+
+	requireJSON(t, `{
+		"query":{"bool":{"filter":[{"term":{"componentUid":"agent-a"}},{"term":{"status":"open"}}]}},
+		"size":50,
+		"from":10,
+		"sort":[{"lastSeen":{"order":"desc"}},{"id":{"order":"asc"}}],
+		"track_total_hits":true
+	}`, BuildIssuesQuery(IssueQueryParams{ComponentUid: "agent-a", Status: IssueStatusOpen, Limit: 50, Offset: 10}).Source())
+}
+
+func TestIssueUpdate(t *testing.T) {
+	issue := Issue{ID: "abc", Occurrences: 2, FirstSeen: "2026-01-01T10:00:00.000Z", LastSeen: "2026-01-01T10:01:00.000Z", RecentTraceIDs: []string{"t2", "t1"}}
+	update := IssueUpdate(issue, time.Date(2026, 1, 1, 10, 5, 0, 0, time.UTC))
+	if update.Index != IssueIndex || update.ID != "abc" {
+		t.Errorf("unexpected target: %s/%s", update.Index, update.ID)
+	}
+	if update.Params["occurrences"] != 2 || update.Params["now"] != "2026-01-01T10:05:00.000Z" {
+		t.Errorf("unexpected params: %v", update.Params)
+	}
+	if upsert, ok := update.Upsert.(Issue); !ok || upsert.ID != "abc" {
+		t.Errorf("expected the issue to be created from its occurrences, got %+v", update.Upsert)
+	}
+}
@@ -0,0 +1,253 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// JaegerTrace is a trace in the JSON format of the Jaeger query API
+type JaegerTrace struct {
+	TraceID   string                   `json:"traceID"`
+	Spans     []JaegerSpan             `json:"spans"`
+	Processes map[string]JaegerProcess `json:"processes"`
+	Warnings  []string                 `json:"warnings"`
+}
+
+// JaegerSpan is a span in the JSON format of the Jaeger query API
+type JaegerSpan struct {
+	TraceID       string            `json:"traceID"`
+	SpanID        string            `json:"spanID"`
+	OperationName string            `json:"operationName"`
+	References    []JaegerReference `json:"references"`
+	StartTime     int64             `json:"startTime"` // Microseconds since the epoch
+	Duration      int64             `json:"duration"`  // Microseconds
+	Tags          []JaegerKeyValue  `json:"tags"`
+	Logs          []JaegerLog       `json:"logs"`
+	ProcessID     string            `json:"processID"`
+	Warnings      []string          `json:"warnings"`
+}
+
+// JaegerReference links a span to its parent
+type JaegerReference struct {
+	RefType string `json:"refType"`
+	TraceID string `json:"traceID"`
+	SpanID  string `json:"spanID"`
+}
+
+// JaegerKeyValue is a typed tag
+type JaegerKeyValue struct {
+	Key   string      `json:"key"`
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+// JaegerLog is a timestamped span event. Span events are not stored with the spans, so traces
+// carry no logs.
+type JaegerLog struct {
+	Timestamp int64            `json:"timestamp"`
+	Fields    []JaegerKeyValue `json:"fields"`
+}
+
+// JaegerProcess is the service that emitted spans
+type JaegerProcess struct {
+	ServiceName string           `json:"serviceName"`
+	Tags        []JaegerKeyValue `json:"tags"`
+}
+
+// JaegerOperation is an operation of a service
+type JaegerOperation struct {
+	Name     string `json:"name"`
+	SpanKind string `json:"spanKind"`
+}
+
+// ToJaegerTraces groups spans by trace and converts them to Jaeger traces, keeping the order in
+// which the traces first appear. The component UID of a span is its Jaeger service, and its
+// resource attributes are the tags of the process.
+func ToJaegerTraces(spans []Span) []JaegerTrace {
+	var traceIDs []string
+	byTrace := make(map[string][]Span)
+	for _, span := range spans {
+		if _, ok := byTrace[span.TraceID]; !ok {
+			traceIDs = append(traceIDs, span.TraceID)
+		}
+		byTrace[span.TraceID] = append(byTrace[span.TraceID], span)
+	}
+
+	traces := make([]JaegerTrace, 0, len(traceIDs))
+	for _, traceID := range traceIDs {
+		traces = append(traces, toJaegerTrace(traceID, byTrace[traceID]))
+	}
+	return traces
+}
+
+func toJaegerTrace(traceID string, spans []Span) JaegerTrace {
+	trace := JaegerTrace{
+		TraceID:   traceID,
+		Spans:     make([]JaegerSpan, 0, len(spans)),
+		Processes: make(map[string]JaegerProcess),
+	}
+	processIDs := make(map[string]string)
+
+	for _, span := range spans {
+		processID, ok := processIDs[span.Service]
+		if !ok {
+			processID = fmt.Sprintf("p%d", len(processIDs)+1)
+			processIDs[span.Service] = processID
+			trace.Processes[processID] = JaegerProcess{
+				ServiceName: span.Service,
+				Tags:        jaegerTags(span.Resource),
+			}
+		}
+
+		references := []JaegerReference{}
+		if span.ParentSpanID != "" {
+			references = append(references, JaegerReference{RefType: "CHILD_OF", TraceID: span.TraceID, SpanID: span.ParentSpanID})
+		}
+
+		tags := jaegerTags(span.Attributes)
+		if kind := JaegerSpanKind(span.Kind); kind != "" {
+			tags = append(tags, JaegerKeyValue{Key: "span.kind", Type: "string", Value: kind})
+		}
+		if span.AmpAttributes != nil && span.AmpAttributes.Status != nil && span.AmpAttributes.Status.Error {
+			tags = append(tags, JaegerKeyValue{Key: "error", Type: "bool", Value: true})
+		}
+
+		trace.Spans = append(trace.Spans, JaegerSpan{
+			TraceID:       span.TraceID,
+			SpanID:        span.SpanID,
+			OperationName: span.Name,
+			References:    references,
+			StartTime:     span.StartTime.UnixMicro(),
+			Duration:      span.DurationInNanos / 1000,
+			Tags:          tags,
+			Logs:          []JaegerLog{},
+			ProcessID:     processID,
+		})
+	}
+	return trace
+}
+
+// jaegerTags converts attributes to tags sorted by key. Values that are neither strings, booleans
+// nor numbers are encoded as JSON strings.
+func jaegerTags(attributes map[string]interface{}) []JaegerKeyValue {
+	tags := make([]JaegerKeyValue, 0, len(attributes))
+	for key, value := range attributes {
+		tag := JaegerKeyValue{Key: key}
+		switch v := value.(type) {
+		case string:
+			tag.Type, tag.Value = "string", v
+		case bool:
+			tag.Type, tag.Value = "bool", v
+		case float64:
+			if v == math.Trunc(v) && math.Abs(v) < 1<<53 {
+				tag.Type, tag.Value = "int64", int64(v)
+			} else {
+				tag.Type, tag.Value = "float64", v
+			}
+		default:
+			encoded, err := json.Marshal(v)
+			if err != nil {
+				continue
+			}
+			tag.Type, tag.Value = "string", string(encoded)
+		}
+		tags = append(tags, tag)
+	}
+	sort.Slice(tags, func(i, j int) bool { return tags[i].Key < tags[j].Key })
+	return tags
+}
+
+// JaegerSpanKind converts an OpenTelemetry span kind such as SPAN_KIND_SERVER to the Jaeger form
+// such as server. The internal kind has no Jaeger form and converts to an empty string.
+func JaegerSpanKind(kind string) string {
+	kind = strings.ToLower(strings.TrimPrefix(kind, "SPAN_KIND_"))
+	switch kind {
+	case "server", "client", "producer", "consumer":
+		return kind
+	default:
+		return ""
+	}
+}
+
+// CollectJaegerOperations returns the distinct operations of spans sorted by name, optionally
+// restricted to a Jaeger span kind
+func CollectJaegerOperations(spans []Span, spanKind string) []JaegerOperation {
+	seen := make(map[JaegerOperation]bool)
+	operations := []JaegerOperation{}
+	for _, span := range spans {
+		operation := JaegerOperation{Name: span.Name, SpanKind: JaegerSpanKind(span.Kind)}
+		if operation.Name == "" || seen[operation] || (spanKind != "" && operation.SpanKind != spanKind) {
+			continue
+		}
+		seen[operation] = true
+		operations = append(operations, operation)
+	}
+	sort.Slice(operations, func(i, j int) bool {
+		if operations[i].Name != operations[j].Name {
+			return operations[i].Name < operations[j].Name
+		}
+		return operations[i].SpanKind < operations[j].SpanKind
+	})
+	return operations
+}
+
+// ParseJaegerServices returns the component UIDs of the aggregation built by BuildServiceListQuery,
+// sorted
+func ParseJaegerServices(aggregations json.RawMessage) ([]string, error) {
+	services := []string{}
+	if len(aggregations) == 0 {
+		return services, nil
+	}
+
+	var aggs struct {
+		Services struct {
+			Buckets []struct {
+				Key string `json:"key"`
+			} `json:"buckets"`
+		} `json:"services"`
+	}
+	if err := json.Unmarshal(aggregations, &aggs); err != nil {
+		return nil, fmt.Errorf("failed to decode aggregations: %w", err)
+	}
+	for _, bucket := range aggs.Services.Buckets {
+		services = append(services, bucket.Key)
+	}
+	sort.Strings(services)
+	return services, nil
+}
+
+// CollectTraceIDs returns the distinct trace IDs of spans in the order they first appear, up to limit
+func CollectTraceIDs(spans []Span, limit int) []string {
+	seen := make(map[string]bool)
+	traceIDs := []string{}
+	for _, span := range spans {
+		if len(traceIDs) >= limit {
+			break
+		}
+		if span.TraceID == "" || seen[span.TraceID] {
+			continue
+		}
+		seen[span.TraceID] = true
+		traceIDs = append(traceIDs, span.TraceID)
+	}
+	return traceIDs
+}
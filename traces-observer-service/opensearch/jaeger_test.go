@@ -0,0 +1,110 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"testing"
+	"time"
+)
+
+func TestToJaegerTraces(t *testing.T) {
+	start := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	spans := []Span{
+		{
+			TraceID:         "t1",
+			SpanID:          "root",
+			Name:            "invoke_agent",
+			Kind:            "SPAN_KIND_SERVER",
+			Service:         "agent-a",
+			StartTime:       start,
+			DurationInNanos: 1500000,
+			Resource:        map[string]interface{}{"service.name": "a"},
+			Attributes:      map[string]interface{}{"tokens": float64(12), "ratio": 0.5, "stream": true, "list": []interface{}{"x"}},
+			AmpAttributes:   &AmpAttributes{Status: &SpanStatus{Error: true}},
+		},
+		{TraceID: "t2", SpanID: "other", Service: "agent-a", StartTime: start},
+		{TraceID: "t1", SpanID: "child", ParentSpanID: "root", Name: "call", Kind: "SPAN_KIND_INTERNAL", Service: "agent-b", StartTime: start},
+	}
+
+	traces := ToJaegerTraces(spans)
+	if len(traces) != 2 || traces[0].TraceID != "t1" || traces[1].TraceID != "t2" {
+		t.Fatalf("traces not grouped in order: %+v", traces)
+	}
+
+	trace := traces[0]
+	if len(trace.Spans) != 2 || len(trace.Processes) != 2 {
+		t.Fatalf("got %d spans and %d processes, want 2 and 2", len(trace.Spans), len(trace.Processes))
+	}
+	root, child := trace.Spans[0], trace.Spans[1]
+	if root.ProcessID != "p1" || child.ProcessID != "p2" || trace.Processes["p2"].ServiceName != "agent-b" {
+		t.Errorf("unexpected processes: %+v", trace.Processes)
+	}
+	if root.StartTime != start.UnixMicro() || root.Duration != 1500 {
+		t.Errorf("got start %d and duration %d", root.StartTime, root.Duration)
+	}
+	if len(root.References) != 0 || len(child.References) != 1 || child.References[0].SpanID != "root" || child.References[0].RefType != "CHILD_OF" {
+		t.Errorf("unexpected references: %+v, %+v", root.References, child.References)
+	}
+
+	want := []JaegerKeyValue{
+		{Key: "list", Type: "string", Value: `["x"]`},
+		{Key: "ratio", Type: "float64", Value: 0.5},
+		{Key: "stream", Type: "bool", Value: true},
+		{Key: "tokens", Type: "int64", Value: int64(12)},
+		{Key: "span.kind", Type: "string", Value: "server"},
+		{Key: "error", Type: "bool", Value: true},
+	}
+	if len(root.Tags) != len(want) {
+		t.Fatalf("got tags %+v, want %+v", root.Tags, want)
+	}
+	for i := range want {
+		if root.Tags[i] != want[i] {
+			t.Errorf("tag %d = %+v, want %+v", i, root.Tags[i], want[i])
+		}
+	}
+	for _, tag := range child.Tags {
+		if tag.Key == "span.kind" {
+			t.Errorf("internal span has a span.kind tag")
+		}
+	}
+}
+
+func TestCollectJaegerOperations(t *testing.T) {
+	spans := []Span{
+		{Name: "chat", Kind: "SPAN_KIND_CLIENT"},
+		{Name: "agent", Kind: "SPAN_KIND_SERVER"},
+		{Name: "chat", Kind: "SPAN_KIND_CLIENT"},
+		{Name: "tool", Kind: "SPAN_KIND_INTERNAL"},
+	}
+
+	all := CollectJaegerOperations(spans, "")
+	if len(all) != 3 || all[0].Name != "agent" || all[1].Name != "chat" || all[2].Name != "tool" {
+		t.Errorf("got %+v", all)
+	}
+	clients := CollectJaegerOperations(spans, "client")
+	if len(clients) != 1 || clients[0] != (JaegerOperation{Name: "chat", SpanKind: "client"}) {
+		t.Errorf("got %+v", clients)
+	}
+}
+
+func TestCollectTraceIDs(t *testing.T) {
+	spans := []Span{{TraceID: "a"}, {TraceID: "b"}, {TraceID: "a"}, {TraceID: "c"}}
+	got := CollectTraceIDs(spans, 2)
+	if len(got) != 2 || got[0] != "a" || got[1] != "b" {
+		t.Errorf("got %v", got)
+	}
+}
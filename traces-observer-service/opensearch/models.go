@@ -0,0 +1,193 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/config"
+)
+
+// usageAccumulator collects the calls of a model or provider while building usage figures
+type usageAccumulator struct {
+	durations    []int64
+	errorCount   int
+	inputTokens  int
+	outputTokens int
+	totalTokens  int
+	cost         float64
+	priced       bool
+}
+
+// AggregateModelUsage breaks down LLM and embedding calls by model and provider.
+// Spans must have been parsed with ParseSpans so that AmpAttributes are populated.
+func AggregateModelUsage(spans []Span, pricing []config.ModelPrice) ([]ModelUsage, []ProviderUsage) {
+	type modelKey struct{ model, provider string }
+	byModel := make(map[modelKey]*usageAccumulator)
+	byProvider := make(map[string]*usageAccumulator)
+
+	for _, span := range spans {
+		if span.AmpAttributes == nil {
+			continue
+		}
+
+		var model, provider string
+		var tokens *LLMTokenUsage
+		switch data := span.AmpAttributes.Data.(type) {
+		case LLMData:
+			model, provider, tokens = data.Model, data.Vendor, data.TokenUsage
+		case EmbeddingData:
+			model, provider, tokens = data.Model, data.Vendor, data.TokenUsage
+		default:
+			continue
+		}
+		if model == "" {
+			continue
+		}
+
+		failed := span.AmpAttributes.Status != nil && span.AmpAttributes.Status.Error
+		price := findModelPrice(pricing, model, provider)
+
+		key := modelKey{model: model, provider: provider}
+		if byModel[key] == nil {
+			byModel[key] = &usageAccumulator{}
+		}
+		byModel[key].add(span.DurationInNanos, failed, tokens, price)
+
+		if provider != "" {
+			if byProvider[provider] == nil {
+				byProvider[provider] = &usageAccumulator{}
+			}
+			byProvider[provider].add(span.DurationInNanos, failed, tokens, price)
+		}
+	}
+
+	models := make([]ModelUsage, 0, len(byModel))
+	for key, acc := range byModel {
+		models = append(models, ModelUsage{Model: key.model, Provider: key.provider, UsageStats: acc.stats()})
+	}
+	sort.Slice(models, func(i, j int) bool {
+		if models[i].RequestCount != models[j].RequestCount {
+			return models[i].RequestCount > models[j].RequestCount
+		}
+		if models[i].Model != models[j].Model {
+			return models[i].Model < models[j].Model
+		}
+		return models[i].Provider < models[j].Provider
+	})
+
+	providers := make([]ProviderUsage, 0, len(byProvider))
+	for provider, acc := range byProvider {
+		providers = append(providers, ProviderUsage{Provider: provider, UsageStats: acc.stats()})
+	}
+	sort.Slice(providers, func(i, j int) bool {
+		if providers[i].RequestCount != providers[j].RequestCount {
+			return providers[i].RequestCount > providers[j].RequestCount
+		}
+		return providers[i].Provider < providers[j].Provider
+	})
+
+	return models, providers
+}
+
+// add records a single call
+func (a *usageAccumulator) add(durationInNanos int64, failed bool, tokens *LLMTokenUsage, price *config.ModelPrice) {
+	a.durations = append(a.durations, durationInNanos)
+	if failed {
+		a.errorCount++
+	}
+	if tokens == nil {
+		return
+	}
+	a.inputTokens += tokens.InputTokens
+	a.outputTokens += tokens.OutputTokens
+	a.totalTokens += tokens.TotalTokens
+	if price != nil {
+		a.cost += estimateCost(tokens, price)
+		a.priced = true
+	}
+}
+
+// stats computes the figures of the recorded calls
+func (a *usageAccumulator) stats() UsageStats {
+	stats := UsageStats{
+		RequestCount: len(a.durations),
+		ErrorCount:   a.errorCount,
+		InputTokens:  a.inputTokens,
+		OutputTokens: a.outputTokens,
+		TotalTokens:  a.totalTokens,
+	}
+	if a.priced {
+		cost := a.cost
+		stats.EstimatedCost = &cost
+	}
+	if len(a.durations) == 0 {
+		return stats
+	}
+
+	stats.ErrorRate = float64(a.errorCount) / float64(len(a.durations))
+
+	sorted := append([]int64(nil), a.durations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	var total int64
+	for _, d := range sorted {
+		total += d
+	}
+	stats.AvgLatencyMs = nanosToMillis(float64(total) / float64(len(sorted)))
+	stats.P50LatencyMs = nanosToMillis(float64(percentile(sorted, 0.50)))
+	stats.P95LatencyMs = nanosToMillis(float64(percentile(sorted, 0.95)))
+	return stats
+}
+
+// percentile returns the nearest-rank percentile of sorted values
+func percentile(sorted []int64, p float64) int64 {
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	return sorted[rank]
+}
+
+func nanosToMillis(nanos float64) float64 {
+	return math.Round(nanos/1e4) / 100
+}
+
+// estimateCost returns the cost of the tokens of a call at the given price
+func estimateCost(tokens *LLMTokenUsage, price *config.ModelPrice) float64 {
+	return float64(tokens.InputTokens)*price.InputCostPerMillionTokens/1e6 +
+		float64(tokens.OutputTokens)*price.OutputCostPerMillionTokens/1e6
+}
+
+// findModelPrice returns the price of a model, preferring an exact model match over the longest prefix match
+func findModelPrice(pricing []config.ModelPrice, model, provider string) *config.ModelPrice {
+	var best *config.ModelPrice
+	for i := range pricing {
+		price := &pricing[i]
+		if price.Provider != "" && !strings.EqualFold(price.Provider, provider) {
+			continue
+		}
+		if price.Model == model {
+			return price
+		}
+		if strings.HasPrefix(model, price.Model) && (best == nil || len(price.Model) > len(best.Model)) {
+			best = price
+		}
+	}
+	return best
+}
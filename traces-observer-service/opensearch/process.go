@@ -17,20 +17,25 @@
 package opensearch
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
+	"strconv"
 	"strings"
 	"time"
 )
 
-// ParseSpans converts OpenSearch response to Span structs
-func ParseSpans(response *SearchResponse) []Span {
+// ParseSpans converts OpenSearch response to Span structs, with their content redacted or removed
+// according to the content visibility of the context
+func ParseSpans(ctx context.Context, response *SearchResponse) []Span {
 	spans := make([]Span, 0, len(response.Hits.Hits))
+	visibility := ContentVisibilityFromContext(ctx)
 
 	for _, hit := range response.Hits.Hits {
 		span := parseSpan(hit.Source)
+		applyContentVisibility(&span, visibility)
 		spans = append(spans, span)
 	}
 
@@ -42,25 +47,25 @@ func parseSpan(source map[string]interface{}) Span {
 	span := Span{}
 
 	// Try standard OTEL fields first
-	if traceID, ok := source["traceId"].(string); ok {
+	if traceID, ok := GetString(source, "traceId"); ok {
 		span.TraceID = traceID
 	}
-	if spanID, ok := source["spanId"].(string); ok {
+	if spanID, ok := GetString(source, "spanId"); ok {
 		span.SpanID = spanID
 	}
-	if parentSpanID, ok := source["parentSpanId"].(string); ok {
+	if parentSpanID, ok := GetString(source, "parentSpanId"); ok {
 		span.ParentSpanID = parentSpanID
 	}
-	if name, ok := source["name"].(string); ok {
+	if name, ok := GetString(source, "name"); ok {
 		span.Name = name
 	}
-	if kind, ok := source["kind"].(string); ok {
+	if kind, ok := GetString(source, "kind"); ok {
 		span.Kind = kind
 	}
 
 	// Extract component UID from resource
 	if resource, ok := source["resource"].(map[string]interface{}); ok {
-		if componentUid, ok := resource["openchoreo.dev/component-uid"].(string); ok {
+		if componentUid, ok := GetString(resource, "openchoreo.dev/component-uid"); ok {
 			span.Service = componentUid
 		}
 
@@ -69,19 +74,19 @@ func parseSpan(source map[string]interface{}) Span {
 	}
 
 	// Parse timestamps
-	if startTime, ok := source["startTime"].(string); ok {
+	if startTime, ok := GetString(source, "startTime"); ok {
 		if t, err := time.Parse(time.RFC3339Nano, startTime); err == nil {
 			span.StartTime = t
 		}
 	}
-	if endTime, ok := source["endTime"].(string); ok {
+	if endTime, ok := GetString(source, "endTime"); ok {
 		if t, err := time.Parse(time.RFC3339Nano, endTime); err == nil {
 			span.EndTime = t
 		}
 	}
 
 	// Parse duration - try durationInNanos field first
-	if duration, ok := source["durationInNanos"].(float64); ok {
+	if duration, ok := GetFloat(source, "durationInNanos"); ok {
 		span.DurationInNanos = int64(duration)
 	} else if !span.StartTime.IsZero() && !span.EndTime.IsZero() {
 		// Fallback: calculate duration from timestamps if durationInNanos not present
@@ -90,10 +95,8 @@ func parseSpan(source map[string]interface{}) Span {
 
 	// Parse status
 	if status, ok := source["status"].(map[string]interface{}); ok {
-		if code, ok := status["code"].(string); ok {
+		if code, ok := GetString(status, "code"); ok {
 			span.Status = code
-		} else if code, ok := status["code"].(float64); ok {
-			span.Status = fmt.Sprintf("%d", int(code))
 		}
 	}
 
@@ -102,42 +105,8 @@ func parseSpan(source map[string]interface{}) Span {
 		span.Attributes = attributes
 	}
 
-	// Determine and add the semantic span type to AmpAttributes
-	spanType := DetermineSpanType(span)
-
-	ampAttrs := &AmpAttributes{
-		Kind: string(spanType),
-	}
-
-	// Populate span-type-specific attributes
-	if span.Attributes != nil {
-		switch spanType {
-		case SpanTypeLLM:
-			populateLLMAttributes(ampAttrs, span.Attributes)
-		case SpanTypeTool:
-			populateToolAttributes(ampAttrs, span.Attributes, span.Status)
-		case SpanTypeEmbedding:
-			populateEmbeddingAttributes(ampAttrs, span.Attributes)
-		case SpanTypeRetriever:
-			populateRetrieverAttributes(ampAttrs, span.Attributes)
-		case SpanTypeAgent:
-			// Check if this is a CrewAI workflow span and delegate to CrewAI processor
-			if IsCrewAISpan(span.Attributes) {
-				PopulateCrewAIAgentAttributes(ampAttrs, span.Attributes)
-			} else {
-				populateAgentAttributes(ampAttrs, span.Attributes)
-			}
-		case SpanTypeCrewAITask:
-			populateCrewAITaskAttributes(ampAttrs, span.Attributes)
-		case SpanTypeChain:
-			populateChainAttributes(ampAttrs, span.Attributes)
-		}
-
-	}
-
-	// Extract error status for all span types
-	ampAttrs.Status = extractSpanStatus(span.Attributes, span.Status)
-	span.AmpAttributes = ampAttrs
+	// Derive the AMP attributes, such as the semantic span type and its input and output
+	enrichSpan(&span)
 
 	return span
 }
@@ -154,27 +123,27 @@ func populateLLMAttributes(ampAttrs *AmpAttributes, attrs map[string]interface{}
 	}
 
 	// Extract model information
-	if responseModel, ok := attrs["gen_ai.response.model"].(string); ok {
-		llmData.Model = responseModel
-	} else if requestModel, ok := attrs["gen_ai.request.model"].(string); ok {
-		llmData.Model = requestModel
+	if model, ok := GetString(attrs, "gen_ai.response.model", "gen_ai.request.model"); ok {
+		llmData.Model = model
 	}
 
 	// Extract vendor (gen_ai.system)
-	if vendor, ok := attrs["gen_ai.system"].(string); ok {
+	if vendor, ok := GetString(attrs, "gen_ai.system"); ok {
 		llmData.Vendor = vendor
 	}
 
 	// Extract temperature
-	if tempRaw, ok := attrs["gen_ai.request.temperature"]; ok {
-		if temp, tempOk := extractFloatValue(tempRaw); tempOk {
-			llmData.Temperature = &temp
-		}
+	if temp, ok := GetFloat(attrs, "gen_ai.request.temperature"); ok {
+		llmData.Temperature = &temp
 	}
 
 	// Extract token usage
 	llmData.TokenUsage = extractTokenUsageFromAttributes(attrs)
 
+	// Hash the prompt before any content is redacted, so that analytics work at every content visibility
+	messages, _ := ampAttrs.Input.([]PromptMessage)
+	llmData.SystemPromptHash, llmData.InputHash, llmData.PromptHash = hashPrompt(extractAgentSystemPrompt(attrs), messages)
+
 	ampAttrs.Data = llmData
 }
 
@@ -203,14 +172,12 @@ func populateEmbeddingAttributes(ampAttrs *AmpAttributes, attrs map[string]inter
 	embeddingData := EmbeddingData{}
 
 	// Extract model information
-	if responseModel, ok := attrs["gen_ai.response.model"].(string); ok {
-		embeddingData.Model = responseModel
-	} else if requestModel, ok := attrs["gen_ai.request.model"].(string); ok {
-		embeddingData.Model = requestModel
+	if model, ok := GetString(attrs, "gen_ai.response.model", "gen_ai.request.model"); ok {
+		embeddingData.Model = model
 	}
 
 	// Extract vendor (gen_ai.system)
-	if vendor, ok := attrs["gen_ai.system"].(string); ok {
+	if vendor, ok := GetString(attrs, "gen_ai.system"); ok {
 		embeddingData.Vendor = vendor
 	}
 
@@ -225,13 +192,13 @@ func populateRetrieverAttributes(ampAttrs *AmpAttributes, attrs map[string]inter
 	retrieverData := RetrieverData{}
 
 	// Extract vector DB system
-	if dbSystem, ok := attrs["db.system"].(string); ok {
+	if dbSystem, ok := GetString(attrs, "db.system"); ok {
 		retrieverData.VectorDB = dbSystem
 	}
 
 	// Extract top_k parameter
-	if topK, ok := attrs["db.vector.query.top_k"].(float64); ok {
-		retrieverData.TopK = int(topK)
+	if topK, ok := GetInt(attrs, "db.vector.query.top_k"); ok {
+		retrieverData.TopK = topK
 	}
 
 	ampAttrs.Data = retrieverData
@@ -240,23 +207,23 @@ func populateRetrieverAttributes(ampAttrs *AmpAttributes, attrs map[string]inter
 // populateAgentAttributes extracts and populates agent-specific attributes
 func populateAgentAttributes(ampAttrs *AmpAttributes, attrs map[string]interface{}) {
 	// For Otel agent spans, we could also check gen_ai.input.message and gen_ai.output.message
-	if input, ok := attrs["gen_ai.input.messages"].(string); ok {
+	if input, ok := GetString(attrs, "gen_ai.input.messages"); ok {
 		ampAttrs.Input = input
 	}
 
-	if output, ok := attrs["gen_ai.output.messages"].(string); ok {
+	if output, ok := GetString(attrs, "gen_ai.output.messages"); ok {
 		ampAttrs.Output = output
 	}
 
 	// For standard agent spans, use traceloop.entity attributes
 	// Otel attributes take precedence
 	if ampAttrs.Input == nil {
-		if input, ok := attrs["traceloop.entity.input"].(string); ok {
+		if input, ok := GetString(attrs, "traceloop.entity.input"); ok {
 			ampAttrs.Input = input
 		}
 	}
 	if ampAttrs.Output == nil {
-		if output, ok := attrs["traceloop.entity.output"].(string); ok {
+		if output, ok := GetString(attrs, "traceloop.entity.output"); ok {
 			ampAttrs.Output = output
 		}
 	}
@@ -264,7 +231,7 @@ func populateAgentAttributes(ampAttrs *AmpAttributes, attrs map[string]interface
 	agentData := AgentData{}
 
 	// Extract agent name from gen_ai.agent.name
-	if name, ok := attrs["gen_ai.agent.name"].(string); ok {
+	if name, ok := GetString(attrs, "gen_ai.agent.name"); ok {
 		agentData.Name = name
 	}
 
@@ -272,17 +239,17 @@ func populateAgentAttributes(ampAttrs *AmpAttributes, attrs map[string]interface
 	agentData.Tools = extractAgentTools(attrs)
 
 	// Extract model from gen_ai.request.model
-	if model, ok := attrs["gen_ai.request.model"].(string); ok {
+	if model, ok := GetString(attrs, "gen_ai.request.model"); ok {
 		agentData.Model = model
 	}
 
 	// Extract framework from gen_ai.system
-	if framework, ok := attrs["gen_ai.system"].(string); ok {
+	if framework, ok := GetString(attrs, "gen_ai.system"); ok {
 		agentData.Framework = framework
 	}
 
 	// Extract conversation ID if present
-	if convID, ok := attrs["gen_ai.conversation.id"].(string); ok {
+	if convID, ok := GetString(attrs, "gen_ai.conversation.id"); ok {
 		agentData.ConversationID = convID
 	}
 
@@ -314,7 +281,7 @@ func populateCrewAITaskAttributes(ampAttrs *AmpAttributes, attrs map[string]inte
 	ampAttrs.Input = nil
 
 	// Extract output from traceloop.entity.output
-	if output, ok := attrs["traceloop.entity.output"].(string); ok {
+	if output, ok := GetString(attrs, "traceloop.entity.output"); ok {
 		ampAttrs.Output = output
 	}
 
@@ -322,17 +289,17 @@ func populateCrewAITaskAttributes(ampAttrs *AmpAttributes, attrs map[string]inte
 	taskData := CrewAITaskData{}
 
 	// Extract task name from crewai.task.name
-	if name, ok := attrs["crewai.task.name"].(string); ok {
+	if name, ok := GetString(attrs, "crewai.task.name"); ok {
 		taskData.Name = name
 	}
 
 	// Extract task description from crewai.task.description
-	if description, ok := attrs["crewai.task.description"].(string); ok {
+	if description, ok := GetString(attrs, "crewai.task.description"); ok {
 		taskData.Description = description
 	}
 
 	// Extract task tools from crewai.task.tools
-	if toolsJSON, ok := attrs["crewai.task.tools"].(string); ok && toolsJSON != "" {
+	if toolsJSON, ok := GetString(attrs, "crewai.task.tools"); ok && toolsJSON != "" {
 		taskData.Tools = parseToolsJSON(toolsJSON)
 	}
 
@@ -382,7 +349,7 @@ func parseToolsJSON(toolsJSON string) []ToolDefinition {
 // - JSON array of tool objects: [{"name": "tool1", "description": "...", "parameters": "..."}]
 // Returns array of ToolDefinition objects
 func extractAgentTools(attrs map[string]interface{}) []ToolDefinition {
-	toolsJSON, ok := attrs["gen_ai.agent.tools"].(string)
+	toolsJSON, ok := GetString(attrs, "gen_ai.agent.tools")
 	if !ok || toolsJSON == "" {
 		return nil
 	}
@@ -396,7 +363,7 @@ func extractAgentSystemPrompt(attrs map[string]interface{}) string {
 
 	// First check gen_ai.system_instructions (OTEL format)
 	// Can be a JSON array of instruction parts
-	if systemInstructions, ok := attrs["gen_ai.system_instructions"].(string); ok && systemInstructions != "" {
+	if systemInstructions, ok := GetString(attrs, "gen_ai.system_instructions"); ok && systemInstructions != "" {
 		// Try to parse as JSON array first
 		var instructions []map[string]interface{}
 		if err := json.Unmarshal([]byte(systemInstructions), &instructions); err == nil {
@@ -418,14 +385,14 @@ func extractAgentSystemPrompt(attrs map[string]interface{}) string {
 	}
 
 	// Check gen_ai.prompt.0.content with role=system (Traceloop format)
-	if role, ok := attrs["gen_ai.prompt.0.role"].(string); ok && role == "system" {
-		if content, ok := attrs["gen_ai.prompt.0.content"].(string); ok {
+	if role, ok := GetString(attrs, "gen_ai.prompt.0.role"); ok && role == "system" {
+		if content, ok := GetString(attrs, "gen_ai.prompt.0.content"); ok {
 			return content
 		}
 	}
 
 	// Check for a dedicated system_prompt attribute if it exists
-	if systemPrompt, ok := attrs["system_prompt"].(string); ok {
+	if systemPrompt, ok := GetString(attrs, "system_prompt"); ok {
 		return systemPrompt
 	}
 
@@ -439,21 +406,21 @@ func extractSpanStatus(attrs map[string]interface{}, spanStatus string) *SpanSta
 	}
 
 	if attrs != nil {
-		if errorType, ok := attrs["error.type"].(string); ok {
+		if errorType, ok := GetString(attrs, "error.type"); ok {
 			status.Error = true
 			status.ErrorType = errorType
 			return status
 		}
 
-		if toolStatus, ok := attrs["gen_ai.tool.status"].(string); ok && isErrorStatus(toolStatus) {
+		if toolStatus, ok := GetString(attrs, "gen_ai.tool.status"); ok && isErrorStatus(toolStatus) {
 			status.Error = true
 			status.ErrorType = "ToolExecutionError"
 			return status
 		}
 
-		if httpStatus, ok := attrs["http.status_code"].(float64); ok && int(httpStatus) >= 400 {
+		if httpStatus, ok := GetInt(attrs, "http.status_code"); ok && httpStatus >= 400 {
 			status.Error = true
-			status.ErrorType = fmt.Sprintf("%d", int(httpStatus))
+			status.ErrorType = strconv.Itoa(httpStatus)
 			return status
 		}
 	}
@@ -466,79 +433,13 @@ func extractSpanStatus(attrs map[string]interface{}, spanStatus string) *SpanSta
 	return status
 }
 
-// extractIntValue extracts an integer value from an interface{} that could be int, float64, or string
-func extractIntValue(value interface{}) (int, bool) {
-	switch v := value.(type) {
-	case int:
-		return v, true
-	case int64:
-		return int(v), true
-	case float64:
-		return int(v), true
-	case string:
-		// Try to parse string as integer
-		var parsed int
-		if _, err := fmt.Sscanf(v, "%d", &parsed); err == nil {
-			return parsed, true
-		}
-		return 0, false
-	default:
-		return 0, false
-	}
-}
-
-// extractFloatValue extracts a float64 value from an interface{} that could be int, float64, or string
-func extractFloatValue(value interface{}) (float64, bool) {
-	switch v := value.(type) {
-	case float64:
-		return v, true
-	case float32:
-		return float64(v), true
-	case int:
-		return float64(v), true
-	case int64:
-		return float64(v), true
-	case string:
-		// Try to parse string as float
-		var parsed float64
-		if _, err := fmt.Sscanf(v, "%f", &parsed); err == nil {
-			return parsed, true
-		}
-		return 0, false
-	default:
-		return 0, false
-	}
-}
-
 // extractTokenUsageFromAttributes extracts token usage from span attributes
 // Supports both standard gen_ai.usage.* and legacy prompt_tokens/completion_tokens attributes
 // Handles int, float64, and string types for token values
 func extractTokenUsageFromAttributes(attrs map[string]interface{}) *LLMTokenUsage {
-	var inputTokensRaw, outputTokensRaw, cacheReadTokensRaw interface{}
-
-	// Extract input tokens (gen_ai.usage.input_tokens or gen_ai.usage.prompt_tokens)
-	if val, ok := attrs["gen_ai.usage.input_tokens"]; ok {
-		inputTokensRaw = val
-	} else if val, ok := attrs["gen_ai.usage.prompt_tokens"]; ok {
-		inputTokensRaw = val
-	}
-
-	// Extract output tokens (gen_ai.usage.output_tokens or gen_ai.usage.completion_tokens)
-	if val, ok := attrs["gen_ai.usage.output_tokens"]; ok {
-		outputTokensRaw = val
-	} else if val, ok := attrs["gen_ai.usage.completion_tokens"]; ok {
-		outputTokensRaw = val
-	}
-
-	// Extract cache read tokens
-	if val, ok := attrs["gen_ai.usage.cache_read_input_tokens"]; ok {
-		cacheReadTokensRaw = val
-	}
-
-	// Convert all raw values to integers
-	inputTokens, inputOk := extractIntValue(inputTokensRaw)
-	outputTokens, outputOk := extractIntValue(outputTokensRaw)
-	cacheReadTokens, cacheOk := extractIntValue(cacheReadTokensRaw)
+	inputTokens, inputOk := GetInt(attrs, "gen_ai.usage.input_tokens", "gen_ai.usage.prompt_tokens")
+	outputTokens, outputOk := GetInt(attrs, "gen_ai.usage.output_tokens", "gen_ai.usage.completion_tokens")
+	cacheReadTokens, cacheOk := GetInt(attrs, "gen_ai.usage.cache_read_input_tokens")
 
 	// Only return token usage if we found some tokens
 	if (inputOk && inputTokens > 0) || (outputOk && outputTokens > 0) {
@@ -765,24 +666,52 @@ func ExtractRootSpanInputOutput(rootSpan *Span) (input interface{}, output inter
 	return extractSpanInputOutput(rootSpan.Attributes)
 }
 
+// ExtractTraceInputOutput extracts the input and output of a trace from its root span, or from
+// the entry span of an agent called by another agent. Returns nil when neither is among the spans.
+func ExtractTraceInputOutput(spans []Span) (input interface{}, output interface{}) {
+	var rootSpan *Span
+	for i := range spans {
+		if spans[i].ParentSpanID == "" {
+			rootSpan = &spans[i]
+			break
+		}
+	}
+	if rootSpan == nil {
+		rootSpan = FindEntrySpan(spans)
+	}
+	if rootSpan == nil {
+		return nil, nil
+	}
+	if IsCrewAISpan(rootSpan.Attributes) {
+		return ExtractCrewAIRootSpanInputOutput(rootSpan)
+	}
+	return ExtractRootSpanInputOutput(rootSpan)
+}
+
 // ExtractPromptMessages extracts and orders prompt messages from LLM span attributes
 // Handles two formats:
 // 1. OTEL format: gen_ai.input.messages (JSON array)
 // 2. Traceloop format: gen_ai.prompt.{index}.{field}
 func ExtractPromptMessages(attrs map[string]interface{}) []PromptMessage {
-	// First, try OTEL format (gen_ai.input.messages)
-	if messagesJSON, ok := attrs["gen_ai.input.messages"].(string); ok && messagesJSON != "" {
-		slog.Debug("ExtractPromptMessages: Found OTEL format input messages, parsing")
+	return extractMessages(attrs, "gen_ai.input.messages", "gen_ai.prompt.")
+}
+
+// extractMessages extracts LLM messages from the OTEL format attribute otelKey, falling back to the
+// Traceloop format attributes starting with traceloopPrefix
+func extractMessages(attrs map[string]interface{}, otelKey, traceloopPrefix string) []PromptMessage {
+	if messagesJSON, ok := attrs[otelKey].(string); ok && messagesJSON != "" {
+		slog.Debug("extractMessages: Found OTEL format messages, parsing",
+			"attribute", otelKey,
+			"messageLength", len(messagesJSON))
 		messages := parseOTELMessages(messagesJSON)
 		if len(messages) > 0 {
 			return messages
 		}
-		slog.Warn("ExtractPromptMessages: OTEL format parsing returned no messages, falling back to Traceloop format")
+		slog.Warn("extractMessages: OTEL format parsing returned no messages, falling back to Traceloop format",
+			"attribute", otelKey)
 	}
 
-	// Fallback to Traceloop format (gen_ai.prompt.*)
-	slog.Debug("ExtractPromptMessages: Using Traceloop format extraction")
-	return extractTraceloopPromptMessages(attrs)
+	return extractTraceloopMessages(attrs, traceloopPrefix)
 }
 
 // RecursiveJSONParser recursively parses a potentially deeply stringified JSON string
@@ -951,124 +880,111 @@ func parseOTELMessages(jsonString string) []PromptMessage {
 	return messages
 }
 
-// extractTraceloopPromptMessages extracts prompt messages in Traceloop format
-// Format: gen_ai.prompt.{index}.{field}
-func extractTraceloopPromptMessages(attrs map[string]interface{}) []PromptMessage {
-	// Map to store messages by index
-	messageMap := make(map[int]*PromptMessage)
-	// Map to store tool calls for each message: messageIndex -> toolCallIndex -> ToolCall
-	toolCallsMap := make(map[int]map[int]*ToolCall)
-	maxIndex := -1
-
-	// Iterate through attributes to find prompt messages
+// extractTraceloopMessages extracts messages in Traceloop format in a single pass over the attributes
+// Format: {prefix}{index}.{field} or {prefix}{index}.tool_calls.{tool_index}.{field}
+func extractTraceloopMessages(attrs map[string]interface{}, prefix string) []PromptMessage {
+	// Messages and their tool calls are collected in slices indexed by their indices, grown to the
+	// highest index seen
+	var messages []PromptMessage
 	for key, value := range attrs {
-		// Check if it's a gen_ai.prompt.* attribute
-		if strings.HasPrefix(key, "gen_ai.prompt.") {
-			// Parse the index and field name
-			// Format: gen_ai.prompt.{index}.{field} or gen_ai.prompt.{index}.tool_calls.{tool_index}.{field}
-			parts := strings.Split(key, ".")
-			if len(parts) >= 4 {
-				// Extract message index
-				var msgIndex int
-				if _, err := fmt.Sscanf(parts[2], "%d", &msgIndex); err == nil {
-					// Initialize message if not exists
-					if messageMap[msgIndex] == nil {
-						messageMap[msgIndex] = &PromptMessage{}
-					}
-
-					// Update max index
-					if msgIndex > maxIndex {
-						maxIndex = msgIndex
-					}
-
-					fieldName := parts[3]
-
-					// Handle regular fields
-					if fieldName == "role" {
-						if role, ok := value.(string); ok {
-							messageMap[msgIndex].Role = role
-						}
-					} else if fieldName == "content" {
-						if content, ok := value.(string); ok {
-							// Only set content if it's not empty or just empty quotes
-							if content != "" && content != "\"\"" {
-								messageMap[msgIndex].Content = content
-							}
-						}
-					} else if fieldName == "tool_calls" && len(parts) >= 6 {
-						// Handle tool calls: gen_ai.prompt.{msgIndex}.tool_calls.{toolIndex}.{field}
-						var toolIndex int
-						if _, err := fmt.Sscanf(parts[4], "%d", &toolIndex); err == nil {
-							toolField := parts[5]
-
-							// Initialize tool calls map for this message if needed
-							if toolCallsMap[msgIndex] == nil {
-								toolCallsMap[msgIndex] = make(map[int]*ToolCall)
-							}
-
-							// Initialize tool call if not exists
-							if toolCallsMap[msgIndex][toolIndex] == nil {
-								toolCallsMap[msgIndex][toolIndex] = &ToolCall{}
-							}
-
-							// Set the appropriate tool call field
-							switch toolField {
-							case "id":
-								if id, ok := value.(string); ok {
-									toolCallsMap[msgIndex][toolIndex].ID = id
-								}
-							case "name":
-								if name, ok := value.(string); ok {
-									toolCallsMap[msgIndex][toolIndex].Name = name
-								}
-							case "arguments":
-								if args, ok := value.(string); ok {
-									toolCallsMap[msgIndex][toolIndex].Arguments = args
-								}
-							}
-						}
-					}
+		rest, ok := strings.CutPrefix(key, prefix)
+		if !ok {
+			continue
+		}
+		indexPart, rest, ok := strings.Cut(rest, ".")
+		if !ok {
+			continue
+		}
+		msgIndex, ok := parseAttributeIndex(indexPart)
+		if !ok {
+			continue
+		}
+		messages = growTo(messages, msgIndex+1)
+		msg := &messages[msgIndex]
+
+		field, rest, _ := strings.Cut(rest, ".")
+		switch field {
+		case "role":
+			if role, ok := value.(string); ok {
+				msg.Role = role
+			}
+		case "content":
+			// Only set content if it's not empty or just empty quotes
+			if content, ok := value.(string); ok && content != "" && content != "\"\"" {
+				msg.Content = content
+			}
+		case "tool_calls":
+			toolIndexPart, rest, ok := strings.Cut(rest, ".")
+			if !ok {
+				continue
+			}
+			toolIndex, ok := parseAttributeIndex(toolIndexPart)
+			if !ok {
+				continue
+			}
+			msg.ToolCalls = growTo(msg.ToolCalls, toolIndex+1)
+			toolCall := &msg.ToolCalls[toolIndex]
+
+			toolField, _, _ := strings.Cut(rest, ".")
+			if str, ok := value.(string); ok {
+				switch toolField {
+				case "id":
+					toolCall.ID = str
+				case "name":
+					toolCall.Name = str
+				case "arguments":
+					toolCall.Arguments = str
 				}
 			}
 		}
 	}
 
-	// Attach tool calls to their respective messages
-	for msgIndex, toolCalls := range toolCallsMap {
-		if msg := messageMap[msgIndex]; msg != nil {
-			// Find max tool call index
-			maxToolIndex := -1
-			for toolIndex := range toolCalls {
-				if toolIndex > maxToolIndex {
-					maxToolIndex = toolIndex
-				}
-			}
-
-			// Convert tool calls map to ordered slice
-			if maxToolIndex >= 0 {
-				msg.ToolCalls = make([]ToolCall, 0, maxToolIndex+1)
-				for i := 0; i <= maxToolIndex; i++ {
-					if tc := toolCalls[i]; tc != nil && tc.Name != "" {
-						msg.ToolCalls = append(msg.ToolCalls, *tc)
-					}
-				}
+	// Drop the messages without a role and the tool calls without a name, keeping the index order
+	result := messages[:0]
+	for _, msg := range messages {
+		if msg.Role == "" {
+			continue
+		}
+		toolCalls := msg.ToolCalls[:0]
+		for _, toolCall := range msg.ToolCalls {
+			if toolCall.Name != "" {
+				toolCalls = append(toolCalls, toolCall)
 			}
 		}
+		msg.ToolCalls = toolCalls
+		result = append(result, msg)
 	}
+	return result
+}
 
-	// Convert map to ordered slice
-	if maxIndex < 0 {
-		return nil
-	}
+// maxTraceloopIndex bounds the message and tool call indices read from Traceloop attributes, as the
+// messages are collected in slices sized by their highest index
+const maxTraceloopIndex = 10000
 
-	messages := make([]PromptMessage, 0, maxIndex+1)
-	for i := 0; i <= maxIndex; i++ {
-		if msg := messageMap[i]; msg != nil && msg.Role != "" {
-			messages = append(messages, *msg)
+// parseAttributeIndex parses an index segment of an attribute key, accepting only decimal digits
+func parseAttributeIndex(s string) (int, bool) {
+	if s == "" {
+		return 0, false
+	}
+	index := 0
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return 0, false
+		}
+		index = index*10 + int(s[i]-'0')
+		if index > maxTraceloopIndex {
+			return 0, false
 		}
 	}
+	return index, true
+}
 
-	return messages
+// growTo extends s with zero values to a length of at least n
+func growTo[T any](s []T, n int) []T {
+	if n <= len(s) {
+		return s
+	}
+	return append(s, make([]T, n-len(s))...)
 }
 
 // ExtractCompletionMessages extracts and orders completion/output messages from LLM span attributes
@@ -1076,143 +992,7 @@ func extractTraceloopPromptMessages(attrs map[string]interface{}) []PromptMessag
 // 1. OTEL format: gen_ai.output.messages (JSON array)
 // 2. Traceloop format: gen_ai.completion.{index}.{field}
 func ExtractCompletionMessages(attrs map[string]interface{}) []PromptMessage {
-	// First, try OTEL format (gen_ai.output.messages)
-	if messagesJSON, ok := attrs["gen_ai.output.messages"].(string); ok && messagesJSON != "" {
-		slog.Debug("ExtractCompletionMessages: Found OTEL format output messages, parsing",
-			"messageLength", len(messagesJSON))
-		messages := parseOTELMessages(messagesJSON)
-
-		if len(messages) > 0 {
-			slog.Debug("ExtractCompletionMessages: Successfully parsed OTEL format messages",
-				"messageCount", len(messages))
-			return messages
-		}
-		slog.Warn("ExtractCompletionMessages: OTEL format parsing returned no messages, falling back to Traceloop format")
-	}
-
-	// Fallback to Traceloop format (gen_ai.completion.*)
-	slog.Debug("ExtractCompletionMessages: Using Traceloop format extraction")
-	return extractTraceloopCompletionMessages(attrs)
-}
-
-// extractTraceloopCompletionMessages extracts completion messages in Traceloop format
-// Format: gen_ai.completion.{index}.{field}
-func extractTraceloopCompletionMessages(attrs map[string]interface{}) []PromptMessage {
-	// Map to store messages by index
-	messageMap := make(map[int]*PromptMessage)
-	// Map to store tool calls for each message: messageIndex -> toolCallIndex -> ToolCall
-	toolCallsMap := make(map[int]map[int]*ToolCall)
-	maxIndex := -1
-
-	// Iterate through attributes to find completion messages
-	for key, value := range attrs {
-		// Check if it's a gen_ai.completion.* attribute
-		if strings.HasPrefix(key, "gen_ai.completion.") {
-			// Parse the index and field name
-			// Format: gen_ai.completion.{index}.{field} or gen_ai.completion.{index}.tool_calls.{tool_index}.{field}
-			parts := strings.Split(key, ".")
-			if len(parts) >= 4 {
-				// Extract message index
-				var msgIndex int
-				if _, err := fmt.Sscanf(parts[2], "%d", &msgIndex); err == nil {
-					// Initialize message if not exists
-					if messageMap[msgIndex] == nil {
-						messageMap[msgIndex] = &PromptMessage{}
-					}
-
-					// Update max index
-					if msgIndex > maxIndex {
-						maxIndex = msgIndex
-					}
-
-					fieldName := parts[3]
-
-					// Handle regular fields
-					if fieldName == "role" {
-						if role, ok := value.(string); ok {
-							messageMap[msgIndex].Role = role
-						}
-					} else if fieldName == "content" {
-						if content, ok := value.(string); ok {
-							// Only set content if it's not empty or just empty quotes
-							if content != "" && content != "\"\"" {
-								messageMap[msgIndex].Content = content
-							}
-						}
-					} else if fieldName == "tool_calls" && len(parts) >= 6 {
-						// Handle tool calls: gen_ai.completion.{msgIndex}.tool_calls.{toolIndex}.{field}
-						var toolIndex int
-						if _, err := fmt.Sscanf(parts[4], "%d", &toolIndex); err == nil {
-							toolField := parts[5]
-
-							// Initialize tool calls map for this message if needed
-							if toolCallsMap[msgIndex] == nil {
-								toolCallsMap[msgIndex] = make(map[int]*ToolCall)
-							}
-
-							// Initialize tool call if not exists
-							if toolCallsMap[msgIndex][toolIndex] == nil {
-								toolCallsMap[msgIndex][toolIndex] = &ToolCall{}
-							}
-
-							// Set the appropriate tool call field
-							switch toolField {
-							case "id":
-								if id, ok := value.(string); ok {
-									toolCallsMap[msgIndex][toolIndex].ID = id
-								}
-							case "name":
-								if name, ok := value.(string); ok {
-									toolCallsMap[msgIndex][toolIndex].Name = name
-								}
-							case "arguments":
-								if args, ok := value.(string); ok {
-									toolCallsMap[msgIndex][toolIndex].Arguments = args
-								}
-							}
-						}
-					}
-				}
-			}
-		}
-	}
-
-	// Attach tool calls to their respective messages
-	for msgIndex, toolCalls := range toolCallsMap {
-		if msg := messageMap[msgIndex]; msg != nil {
-			// Find max tool call index
-			maxToolIndex := -1
-			for toolIndex := range toolCalls {
-				if toolIndex > maxToolIndex {
-					maxToolIndex = toolIndex
-				}
-			}
-
-			// Convert tool calls map to ordered slice
-			if maxToolIndex >= 0 {
-				msg.ToolCalls = make([]ToolCall, 0, maxToolIndex+1)
-				for i := 0; i <= maxToolIndex; i++ {
-					if tc := toolCalls[i]; tc != nil && tc.Name != "" {
-						msg.ToolCalls = append(msg.ToolCalls, *tc)
-					}
-				}
-			}
-		}
-	}
-
-	// Convert map to ordered slice
-	if maxIndex < 0 {
-		return nil
-	}
-
-	messages := make([]PromptMessage, 0, maxIndex+1)
-	for i := 0; i <= maxIndex; i++ {
-		if msg := messageMap[i]; msg != nil && msg.Role != "" {
-			messages = append(messages, *msg)
-		}
-	}
-
-	return messages
+	return extractMessages(attrs, "gen_ai.output.messages", "gen_ai.completion.")
 }
 
 // ExtractToolDefinitions extracts tool/function definitions from LLM span attributes
@@ -1223,9 +1003,9 @@ func extractTraceloopCompletionMessages(attrs map[string]interface{}) []PromptMe
 func ExtractToolDefinitions(attrs map[string]interface{}) []ToolDefinition {
 	// First, try OTEL format (gen_ai.tool.definitions)
 	var toolsJSON string
-	if tools, ok := attrs["gen_ai.input.tools"].(string); ok && tools != "" {
+	if tools, ok := GetString(attrs, "gen_ai.input.tools"); ok && tools != "" {
 		toolsJSON = tools
-	} else if tools, ok := attrs["gen_ai.tool.definitions"].(string); ok && tools != "" {
+	} else if tools, ok := GetString(attrs, "gen_ai.tool.definitions"); ok && tools != "" {
 		toolsJSON = tools
 	}
 	if toolsJSON != "" {
@@ -1367,20 +1147,11 @@ func ExtractToolExecutionDetails(attrs map[string]interface{}, spanStatus string
 	var name, input, output, status string
 
 	// Extract tool name - prioritize traceloop.entity.name
-	if entityName, ok := attrs["traceloop.entity.name"].(string); ok {
-		name = entityName
-	} else if toolName, ok := attrs["tool.name"].(string); ok {
-		name = toolName
-	} else if toolID, ok := attrs["tool_name"].(string); ok { // crewai legacy
-		name = toolID
-	} else if funcName, ok := attrs["function.name"].(string); ok {
-		name = funcName
-	} else if genAIName, ok := attrs["gen_ai.tool.name"].(string); ok {
-		name = genAIName
-	}
+	// tool_name is the legacy CrewAI attribute
+	name, _ = GetString(attrs, "traceloop.entity.name", "tool.name", "tool_name", "function.name", "gen_ai.tool.name")
 
 	// Extract tool input - prioritize traceloop.entity.input with "inputs" extraction
-	if traceloopInput, ok := attrs["traceloop.entity.input"].(string); ok && traceloopInput != "" {
+	if traceloopInput, ok := GetString(attrs, "traceloop.entity.input"); ok && traceloopInput != "" {
 		// Try to parse as JSON and extract "inputs" field
 		var inputMap map[string]interface{}
 		if err := json.Unmarshal([]byte(traceloopInput), &inputMap); err == nil {
@@ -1397,32 +1168,18 @@ func ExtractToolExecutionDetails(attrs map[string]interface{}, spanStatus string
 		} else {
 			input = traceloopInput // Not valid JSON, use as-is
 		}
-	} else if toolInput, ok := attrs["tool.input"].(string); ok {
-		input = toolInput
-	} else if toolArgs, ok := attrs["tool.arguments"].(string); ok {
-		input = toolArgs
-	} else if funcArgs, ok := attrs["function.arguments"].(string); ok {
-		input = funcArgs
-	} else if genAIArgs, ok := attrs["gen_ai.tool.arguments"].(string); ok { // OTEL standard
-		input = genAIArgs
+	} else {
+		// gen_ai.tool.arguments is the OTEL standard
+		input, _ = GetString(attrs, "tool.input", "tool.arguments", "function.arguments", "gen_ai.tool.arguments")
 	}
 
 	// Extract tool output - prioritize traceloop.entity.output
-	if entityOutput, ok := attrs["traceloop.entity.output"].(string); ok {
-		output = entityOutput
-	} else if toolOutput, ok := attrs["tool.output"].(string); ok {
-		output = toolOutput
-	} else if toolResult, ok := attrs["tool.result"].(string); ok {
-		output = toolResult
-	} else if funcResult, ok := attrs["function.result"].(string); ok {
-		output = funcResult
-	} else if genAIOutput, ok := attrs["gen_ai.tool.output"].(string); ok { // OTEL standard
-		output = genAIOutput
-	}
+	// gen_ai.tool.output is the OTEL standard
+	output, _ = GetString(attrs, "traceloop.entity.output", "tool.output", "tool.result", "function.result", "gen_ai.tool.output")
 
 	// Determine status
 	// First check if there's an explicit tool status attribute
-	if toolStatus, ok := attrs["tool.status"].(string); ok {
+	if toolStatus, ok := GetString(attrs, "tool.status"); ok {
 		status = toolStatus
 	} else {
 		// Fall back to span status
@@ -1495,7 +1252,7 @@ func DetermineSpanType(span Span) SpanType {
 	}
 
 	// First, check if Traceloop has already set the span kind
-	if traceloopKind, ok := span.Attributes["traceloop.span.kind"].(string); ok {
+	if traceloopKind, ok := GetString(span.Attributes, "traceloop.span.kind"); ok {
 		switch traceloopKind {
 		case "llm":
 			return SpanTypeLLM
@@ -1597,7 +1354,7 @@ func determineSpanTypeFromName(name string) SpanType {
 
 func hasLLMAttributes(attrs map[string]interface{}) bool {
 	// Check for gen_ai.operation.name (as requested)
-	if opName, ok := attrs["gen_ai.operation.name"].(string); ok {
+	if opName, ok := GetString(attrs, "gen_ai.operation.name"); ok {
 		if opName == "chat" || opName == "completion" || opName == "text_completion" {
 			return true
 		}
@@ -1616,7 +1373,7 @@ func hasLLMAttributes(attrs map[string]interface{}) bool {
 	}
 
 	// Traceloop / Legacy compatibility (excluding embeddings)
-	if reqType, ok := attrs["llm.request.type"].(string); ok {
+	if reqType, ok := GetString(attrs, "llm.request.type"); ok {
 		return reqType != "embedding"
 	}
 
@@ -1626,19 +1383,19 @@ func hasLLMAttributes(attrs map[string]interface{}) bool {
 // hasEmbeddingAttributes checks if span has embedding generation attributes
 func hasEmbeddingAttributes(attrs map[string]interface{}) bool {
 	// Check for gen_ai.operation.name = embedding
-	if opName, ok := attrs["gen_ai.operation.name"].(string); ok {
+	if opName, ok := GetString(attrs, "gen_ai.operation.name"); ok {
 		if opName == "embedding" || opName == "embeddings" {
 			return true
 		}
 	}
 
 	// Check for embedding-specific attributes
-	if _, ok := attrs["gen_ai.embedding.dimension"].(float64); ok {
+	if _, ok := GetInt(attrs, "gen_ai.embedding.dimension"); ok {
 		return true
 	}
 
 	// Traceloop specific
-	if reqType, ok := attrs["llm.request.type"].(string); ok {
+	if reqType, ok := GetString(attrs, "llm.request.type"); ok {
 		if reqType == "embedding" {
 			return true
 		}
@@ -1650,22 +1407,22 @@ func hasEmbeddingAttributes(attrs map[string]interface{}) bool {
 // hasToolAttributes checks if span has tool/function call attributes
 func hasToolAttributes(attrs map[string]interface{}) bool {
 	// Check for tool call attributes
-	if _, ok := attrs["gen_ai.tool.name"].(string); ok {
+	if _, ok := GetString(attrs, "gen_ai.tool.name"); ok {
 		return true
 	}
 
 	// Check for function call attributes
-	if _, ok := attrs["function.name"].(string); ok {
+	if _, ok := GetString(attrs, "function.name"); ok {
 		return true
 	}
 
 	// Traceloop specific: tool.* namespace
-	if _, ok := attrs["tool.name"].(string); ok {
+	if _, ok := GetString(attrs, "tool.name"); ok {
 		return true
 	}
 
 	// CrewAI specific: function.* namespace
-	if _, ok := attrs["tool_name"].(string); ok {
+	if _, ok := GetString(attrs, "tool_name"); ok {
 		return true
 	}
 
@@ -1687,7 +1444,7 @@ func hasRetrieverAttributes(attrs map[string]interface{}) bool {
 	}
 
 	// Check for vector database system
-	if dbSystem, ok := attrs["db.system"].(string); ok {
+	if dbSystem, ok := GetString(attrs, "db.system"); ok {
 		vectorDBs := []string{"pinecone", "weaviate", "qdrant", "milvus", "chroma", "chromadb"}
 		for _, vdb := range vectorDBs {
 			if dbSystem == vdb {
@@ -1697,7 +1454,7 @@ func hasRetrieverAttributes(attrs map[string]interface{}) bool {
 	}
 
 	// Check for retrieval-specific operations
-	if opName, ok := attrs["db.operation"].(string); ok {
+	if opName, ok := GetString(attrs, "db.operation"); ok {
 		if opName == "query" || opName == "search" || opName == "retrieve" {
 			return true
 		}
@@ -1709,19 +1466,19 @@ func hasRetrieverAttributes(attrs map[string]interface{}) bool {
 // hasRerankAttributes checks if span has reranking attributes
 func hasRerankAttributes(attrs map[string]interface{}) bool {
 	// Check for rerank operation
-	if opName, ok := attrs["gen_ai.operation.name"].(string); ok {
+	if opName, ok := GetString(attrs, "gen_ai.operation.name"); ok {
 		if opName == "rerank" || opName == "reranking" {
 			return true
 		}
 	}
 
 	// Traceloop specific
-	if _, ok := attrs["rerank.model"].(string); ok {
+	if _, ok := GetString(attrs, "rerank.model"); ok {
 		return true
 	}
 
 	// Check for reranker model names
-	if model, ok := attrs["gen_ai.request.model"].(string); ok {
+	if model, ok := GetString(attrs, "gen_ai.request.model"); ok {
 		// Common reranker models - check if model name contains these patterns
 		if strings.Contains(model, "rerank-english") || strings.Contains(model, "rerank-multilingual") {
 			return true
@@ -1733,14 +1490,8 @@ func hasRerankAttributes(attrs map[string]interface{}) bool {
 
 // hasAgentAttributes checks if span has agent orchestration attributes
 func hasAgentAttributes(attrs map[string]interface{}) bool {
-	val, ok := attrs["gen_ai.agent.name"]
-	if !ok || val == nil {
-		return false
-	}
-
-	// Type assert to string and check length
-	strVal, isString := val.(string)
-	return isString && len(strVal) > 0
+	name, ok := GetString(attrs, "gen_ai.agent.name")
+	return ok && name != ""
 }
 
 // hasCrewAITaskAttributes checks if span has CrewAI task attributes
@@ -1748,7 +1499,7 @@ func hasCrewAITaskAttributes(attrs map[string]interface{}) bool {
 	// Check if any attribute starts with "crewai.task"
 	for key := range attrs {
 		if strings.HasPrefix(key, "crewai.task") {
-			if kind, ok := attrs["traceloop.span.kind"].(string); ok {
+			if kind, ok := GetString(attrs, "traceloop.span.kind"); ok {
 				if strings.ToLower(kind) == "task" {
 					return true
 				}
@@ -1762,7 +1513,7 @@ func hasCrewAITaskAttributes(attrs map[string]interface{}) bool {
 // hasTaskAttributes checks if span has task/workflow attributes
 func hasTaskAttributes(attrs map[string]interface{}, spanName string) bool {
 	// Check traceloop.span.kind attribute
-	if kind, ok := attrs["traceloop.span.kind"].(string); ok {
+	if kind, ok := GetString(attrs, "traceloop.span.kind"); ok {
 		kindLower := strings.ToLower(kind)
 		if kindLower == "task" || kindLower == "workflow" {
 			return true
@@ -1782,7 +1533,7 @@ func hasTaskAttributes(attrs map[string]interface{}, spanName string) bool {
 	}
 
 	// Check for workflow-related attributes as fallback
-	if _, ok := attrs["workflow.name"].(string); ok {
+	if _, ok := GetString(attrs, "workflow.name"); ok {
 		return true
 	}
 
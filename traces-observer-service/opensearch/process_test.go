@@ -0,0 +1,123 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestExtractPromptMessages(t *testing.T) {
+	t.Run("traceloop format", func(t *testing.T) {
+		messages := ExtractPromptMessages(map[string]interface{}{
+			"gen_ai.prompt.2.role":                       "user",
+			"gen_ai.prompt.2.content":                    "Thanks!",
+			"gen_ai.prompt.0.role":                       "system",
+			"gen_ai.prompt.0.content":                    "You are helpful.",
+			"gen_ai.prompt.1.role":                       "assistant",
+			"gen_ai.prompt.1.content":                    `""`,
+			"gen_ai.prompt.1.tool_calls.1.name":          "search",
+			"gen_ai.prompt.1.tool_calls.1.id":            "c2",
+			"gen_ai.prompt.1.tool_calls.0.name":          "weather",
+			"gen_ai.prompt.1.tool_calls.0.arguments":     `{"city":"Colombo"}`,
+			"gen_ai.prompt.1.tool_calls.2.id":            "unnamed",
+			"gen_ai.prompt.4.content":                    "no role",
+			"gen_ai.prompt.x.role":                       "user",
+			"gen_ai.completion.0.role":                   "assistant",
+			"gen_ai.request.model":                       "gpt-4o",
+			"gen_ai.prompt.99999999999999999999.role":    "user",
+			"gen_ai.prompt.3.tool_calls.notanindex.name": "ignored",
+		})
+		want := []PromptMessage{
+			{Role: "system", Content: "You are helpful."},
+			{Role: "assistant", ToolCalls: []ToolCall{
+				{Name: "weather", Arguments: `{"city":"Colombo"}`},
+				{ID: "c2", Name: "search"},
+			}},
+			{Role: "user", Content: "Thanks!"},
+		}
+		if !reflect.DeepEqual(messages, want) {
+			t.Errorf("got %+v, want %+v", messages, want)
+		}
+	})
+
+	t.Run("OTEL format takes precedence", func(t *testing.T) {
+		messages := ExtractPromptMessages(map[string]interface{}{
+			"gen_ai.input.messages": `[{"role":"user","content":"Hi"}]`,
+			"gen_ai.prompt.0.role":  "system",
+		})
+		want := []PromptMessage{{Role: "user", Content: "Hi"}}
+		if !reflect.DeepEqual(messages, want) {
+			t.Errorf("got %+v, want %+v", messages, want)
+		}
+	})
+
+	t.Run("no messages", func(t *testing.T) {
+		if messages := ExtractPromptMessages(map[string]interface{}{"gen_ai.request.model": "gpt-4o"}); messages != nil {
+			t.Errorf("expected no messages, got %+v", messages)
+		}
+	})
+}
+
+func TestExtractCompletionMessages(t *testing.T) {
+	messages := ExtractCompletionMessages(map[string]interface{}{
+		"gen_ai.completion.0.role":    "assistant",
+		"gen_ai.completion.0.content": "It is 30C.",
+		"gen_ai.prompt.0.role":        "user",
+	})
+	want := []PromptMessage{{Role: "assistant", Content: "It is 30C."}}
+	if !reflect.DeepEqual(messages, want) {
+		t.Errorf("got %+v, want %+v", messages, want)
+	}
+}
+
+// traceloopAttributes builds the attributes of a span with the given number of prompt and
+// completion messages in Traceloop format, each with a tool call
+func traceloopAttributes(messages int) map[string]interface{} {
+	attrs := map[string]interface{}{"gen_ai.request.model": "gpt-4o"}
+	for _, prefix := range []string{"gen_ai.prompt.", "gen_ai.completion."} {
+		for i := 0; i < messages; i++ {
+			attrs[fmt.Sprintf("%s%d.role", prefix, i)] = "assistant"
+			attrs[fmt.Sprintf("%s%d.content", prefix, i)] = "message content"
+			attrs[fmt.Sprintf("%s%d.tool_calls.0.id", prefix, i)] = "call"
+			attrs[fmt.Sprintf("%s%d.tool_calls.0.name", prefix, i)] = "tool"
+			attrs[fmt.Sprintf("%s%d.tool_calls.0.arguments", prefix, i)] = "{}"
+		}
+	}
+	return attrs
+}
+
+func BenchmarkExtractPromptMessages(b *testing.B) {
+	for _, messages := range []int{10, 500} {
+		attrs := traceloopAttributes(messages)
+		b.Run(fmt.Sprintf("%d messages", messages), func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				ExtractPromptMessages(attrs)
+			}
+		})
+	}
+}
+
+func BenchmarkExtractCompletionMessages(b *testing.B) {
+	attrs := traceloopAttributes(500)
+	b.ReportAllocs()
+	for b.Loop() {
+		ExtractCompletionMessages(attrs)
+	}
+}
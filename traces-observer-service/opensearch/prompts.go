@@ -0,0 +1,215 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"sort"
+	"strings"
+)
+
+// hashPrompt returns the hashes of the system prompt, the last user message and the whole prompt of
+// an LLM call, each empty when there is nothing to hash. The system prompt is taken from the system
+// messages, or from systemInstructions when the call recorded it separately. Text is normalized
+// before hashing, so prompts that only differ in case or whitespace share a hash.
+func hashPrompt(systemInstructions string, messages []PromptMessage) (systemPromptHash, inputHash, promptHash string) {
+	var system []string
+	var input string
+	prompt := sha256.New()
+	for _, message := range messages {
+		switch message.Role {
+		case "system":
+			system = append(system, message.Content)
+		case "user":
+			input = message.Content
+		}
+		writeHashPart(prompt, message.Role, message.Content)
+		for _, call := range message.ToolCalls {
+			writeHashPart(prompt, call.Name, call.Arguments)
+		}
+	}
+	if len(system) == 0 && systemInstructions != "" {
+		system = append(system, systemInstructions)
+		writeHashPart(prompt, "system", systemInstructions)
+	}
+
+	if text := strings.Join(system, "\n"); normalizePrompt(text) != "" {
+		systemPromptHash = contentHash(text)
+	}
+	if normalizePrompt(input) != "" {
+		inputHash = contentHash(input)
+	}
+	if len(messages) > 0 {
+		promptHash = hex.EncodeToString(prompt.Sum(nil))[:promptHashLength]
+	}
+	return systemPromptHash, inputHash, promptHash
+}
+
+// promptHashLength is the number of hex digits prompt hashes are cut to; 128 bits keep accidental
+// collisions out of reach
+const promptHashLength = 32
+
+func contentHash(text string) string {
+	sum := sha256.Sum256([]byte(normalizePrompt(text)))
+	return hex.EncodeToString(sum[:])[:promptHashLength]
+}
+
+// writeHashPart adds the normalized parts to a hash, each terminated so that moving text from one
+// part to the next changes the hash
+func writeHashPart(h hash.Hash, parts ...string) {
+	for _, part := range parts {
+		h.Write([]byte(normalizePrompt(part)))
+		h.Write([]byte{0})
+	}
+}
+
+func normalizePrompt(text string) string {
+	return strings.ToLower(strings.Join(strings.Fields(text), " "))
+}
+
+// promptStats accumulates the calls that carried the same prompt
+type promptStats struct {
+	frequency PromptFrequency
+	agents    map[string]bool
+}
+
+func (p *promptStats) add(span Span) {
+	p.frequency.Count++
+	p.agents[span.Service] = true
+	if p.frequency.FirstSeen.IsZero() || span.StartTime.Before(p.frequency.FirstSeen) {
+		p.frequency.FirstSeen = span.StartTime
+	}
+	if span.StartTime.After(p.frequency.LastSeen) {
+		p.frequency.LastSeen = span.StartTime
+	}
+}
+
+// cacheStats accumulates the successful calls of an identical prompt to a model
+type cacheStats struct {
+	count        int
+	inputTokens  int
+	outputTokens int
+}
+
+// AggregatePromptAnalytics counts how often LLM calls carried the same prompts, ranking the limit
+// most frequent user inputs, system prompts and repeated prompts. Spans must have been parsed with
+// ParseSpans so that the prompt hashes are set.
+func AggregatePromptAnalytics(spans []Span, limit int) *PromptAnalyticsResponse {
+	inputs := make(map[string]*promptStats)
+	systemPrompts := make(map[string]*promptStats)
+	type cacheKey struct{ promptHash, model string }
+	prompts := make(map[cacheKey]*cacheStats)
+	response := &PromptAnalyticsResponse{}
+
+	for _, span := range spans {
+		if span.AmpAttributes == nil {
+			continue
+		}
+		data, ok := span.AmpAttributes.Data.(LLMData)
+		if !ok {
+			continue
+		}
+		if data.InputHash != "" {
+			response.LLMCallCount++
+			statsFor(inputs, data.InputHash).add(span)
+		}
+		if data.SystemPromptHash != "" {
+			statsFor(systemPrompts, data.SystemPromptHash).add(span)
+		}
+		if data.PromptHash == "" || (span.AmpAttributes.Status != nil && span.AmpAttributes.Status.Error) {
+			continue
+		}
+		key := cacheKey{promptHash: data.PromptHash, model: data.Model}
+		if prompts[key] == nil {
+			prompts[key] = &cacheStats{}
+		}
+		stats := prompts[key]
+		stats.count++
+		if data.TokenUsage != nil {
+			stats.inputTokens += data.TokenUsage.InputTokens
+			stats.outputTokens += data.TokenUsage.OutputTokens
+		}
+	}
+
+	response.UniqueInputCount = len(inputs)
+	if response.LLMCallCount > 0 {
+		response.DuplicateInputRate = float64(response.LLMCallCount-response.UniqueInputCount) / float64(response.LLMCallCount)
+	}
+	response.TopInputs = topPrompts(inputs, limit)
+	response.TopSystemPrompts = topPrompts(systemPrompts, limit)
+
+	response.CacheOpportunities = []CacheOpportunity{}
+	for key, stats := range prompts {
+		if stats.count < 2 {
+			continue
+		}
+		repeated := stats.count - 1
+		response.RepeatedCallCount += repeated
+		// The tokens of the repeated calls are estimated from the average of all calls of the prompt
+		response.CacheOpportunities = append(response.CacheOpportunities, CacheOpportunity{
+			PromptHash:           key.promptHash,
+			Model:                key.model,
+			Count:                stats.count,
+			RepeatedCalls:        repeated,
+			RepeatedInputTokens:  stats.inputTokens * repeated / stats.count,
+			RepeatedOutputTokens: stats.outputTokens * repeated / stats.count,
+		})
+	}
+	sort.Slice(response.CacheOpportunities, func(i, j int) bool {
+		a, b := response.CacheOpportunities[i], response.CacheOpportunities[j]
+		if a.RepeatedInputTokens != b.RepeatedInputTokens {
+			return a.RepeatedInputTokens > b.RepeatedInputTokens
+		}
+		if a.RepeatedCalls != b.RepeatedCalls {
+			return a.RepeatedCalls > b.RepeatedCalls
+		}
+		return a.PromptHash < b.PromptHash
+	})
+	if len(response.CacheOpportunities) > limit {
+		response.CacheOpportunities = response.CacheOpportunities[:limit]
+	}
+	return response
+}
+
+func statsFor(prompts map[string]*promptStats, hash string) *promptStats {
+	if prompts[hash] == nil {
+		prompts[hash] = &promptStats{frequency: PromptFrequency{Hash: hash}, agents: make(map[string]bool)}
+	}
+	return prompts[hash]
+}
+
+// topPrompts returns the limit most frequent prompts
+func topPrompts(prompts map[string]*promptStats, limit int) []PromptFrequency {
+	top := make([]PromptFrequency, 0, len(prompts))
+	for _, stats := range prompts {
+		frequency := stats.frequency
+		frequency.AgentCount = len(stats.agents)
+		top = append(top, frequency)
+	}
+	sort.Slice(top, func(i, j int) bool {
+		if top[i].Count != top[j].Count {
+			return top[i].Count > top[j].Count
+		}
+		return top[i].Hash < top[j].Hash
+	})
+	if len(top) > limit {
+		top = top[:limit]
+	}
+	return top
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHashPrompt(t *testing.T) {
+	system, input, prompt := hashPrompt("", []PromptMessage{
+		{Role: "system", Content: "You are a support agent."},
+		{Role: "user", Content: "How do I reset my password?"},
+	})
+	if system == "" || input == "" || prompt == "" || len(input) != promptHashLength {
+		t.Fatalf("expected three hashes, got %q, %q and %q", system, input, prompt)
+	}
+
+	// Case and whitespace do not change the hashes
+	_, sameInput, samePrompt := hashPrompt("", []PromptMessage{
+		{Role: "system", Content: "You are a  support agent."},
+		{Role: "user", Content: "  how do I reset my PASSWORD?\n"},
+	})
+	if sameInput != input || samePrompt != prompt {
+		t.Errorf("expected normalized prompts to share hashes")
+	}
+
+	// System instructions recorded apart from the messages are hashed as the system prompt
+	instructions, otherInput, otherPrompt := hashPrompt("You are a support agent.", []PromptMessage{
+		{Role: "user", Content: "How do I close my account?"},
+	})
+	if instructions != system {
+		t.Errorf("expected the system instructions to hash like the system message, got %q and %q", instructions, system)
+	}
+	if otherInput == input || otherPrompt == prompt {
+		t.Errorf("expected a different input to change the input and prompt hashes")
+	}
+
+	if system, input, prompt := hashPrompt("", nil); system != "" || input != "" || prompt != "" {
+		t.Errorf("expected no hashes without a prompt, got %q, %q and %q", system, input, prompt)
+	}
+}
+
+func TestAggregatePromptAnalytics(t *testing.T) {
+	start := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	llmSpan := func(service, input string, minute int, failed bool) Span {
+		messages := []PromptMessage{{Role: "system", Content: "Be brief"}, {Role: "user", Content: input}}
+		data := LLMData{Model: "gpt-4o", TokenUsage: &LLMTokenUsage{InputTokens: 100, OutputTokens: 20}}
+		data.SystemPromptHash, data.InputHash, data.PromptHash = hashPrompt("", messages)
+		return Span{
+			Service:   service,
+			StartTime: start.Add(time.Duration(minute) * time.Minute),
+			AmpAttributes: &AmpAttributes{
+				Kind:   string(SpanTypeLLM),
+				Data:   data,
+				Status: &SpanStatus{Error: failed},
+			},
+		}
+	}
+
+	spans := []Span{
+		llmSpan("agent-a", "What are your opening hours?", 0, false),
+		llmSpan("agent-b", "what are your opening hours?", 5, false),
+		llmSpan("agent-a", "What are your opening hours?", 2, false),
+		llmSpan("agent-a", "Where is my order?", 1, false),
+		llmSpan("agent-a", "Where is my order?", 3, true),
+		{Service: "agent-a", AmpAttributes: &AmpAttributes{Kind: string(SpanTypeTool), Data: ToolData{Name: "lookup"}}},
+	}
+	analytics := AggregatePromptAnalytics(spans, 1)
+
+	if analytics.LLMCallCount != 5 || analytics.UniqueInputCount != 2 {
+		t.Fatalf("expected 5 calls with 2 unique inputs, got %d and %d", analytics.LLMCallCount, analytics.UniqueInputCount)
+	}
+	if analytics.DuplicateInputRate != 0.6 {
+		t.Errorf("expected a duplicate input rate of 0.6, got %v", analytics.DuplicateInputRate)
+	}
+
+	if len(analytics.TopInputs) != 1 {
+		t.Fatalf("expected the ranking to be limited to 1 input, got %d", len(analytics.TopInputs))
+	}
+	top := analytics.TopInputs[0]
+	if top.Count != 3 || top.AgentCount != 2 || !top.FirstSeen.Equal(start) || !top.LastSeen.Equal(start.Add(5*time.Minute)) {
+		t.Errorf("unexpected top input %+v", top)
+	}
+	if len(analytics.TopSystemPrompts) != 1 || analytics.TopSystemPrompts[0].Count != 5 {
+		t.Errorf("expected one system prompt sent 5 times, got %+v", analytics.TopSystemPrompts)
+	}
+
+	// The failed call is not a cache opportunity, so only the opening hours prompt repeats
+	if analytics.RepeatedCallCount != 2 || len(analytics.CacheOpportunities) != 1 {
+		t.Fatalf("expected 2 repeated calls of one prompt, got %d and %+v", analytics.RepeatedCallCount, analytics.CacheOpportunities)
+	}
+	opportunity := analytics.CacheOpportunities[0]
+	if opportunity.Model != "gpt-4o" || opportunity.Count != 3 || opportunity.RepeatedInputTokens != 200 || opportunity.RepeatedOutputTokens != 40 {
+		t.Errorf("unexpected cache opportunity %+v", opportunity)
+	}
+}
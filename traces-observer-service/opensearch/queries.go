@@ -17,13 +17,26 @@
 package opensearch
 
 import (
+	"encoding/json"
 	"fmt"
+	"sort"
 	"time"
 )
 
+// TracesIndexPattern matches all daily trace indices
+const TracesIndexPattern = spanIndexPrefix + "*"
+
+// spanIndexPrefix is the prefix of the daily indices spans are stored in
+const spanIndexPrefix = "otel-traces-"
+
 // GetIndicesForTimeRange generates index names for the given time range
 // Returns indices in format: otel-traces-YYYY-MM-DD
 func GetIndicesForTimeRange(startTime, endTime string) ([]string, error) {
+	return indicesForTimeRange(spanIndexPrefix, startTime, endTime)
+}
+
+// indicesForTimeRange returns the names of the daily indices with a prefix that cover a time range
+func indicesForTimeRange(prefix, startTime, endTime string) ([]string, error) {
 	if startTime == "" || endTime == "" {
 		return nil, fmt.Errorf("start time and end time are required")
 	}
@@ -53,7 +66,7 @@ func GetIndicesForTimeRange(startTime, endTime string) ([]string, error) {
 	endDay := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, end.Location())
 
 	for !currentDay.After(endDay) {
-		indexName := fmt.Sprintf("otel-traces-%04d-%02d-%02d", currentDay.Year(), currentDay.Month(), currentDay.Day())
+		indexName := dailyIndexName(prefix, currentDay)
 		if !indexMap[indexName] {
 			indices = append(indices, indexName)
 			indexMap[indexName] = true
@@ -64,39 +77,39 @@ func GetIndicesForTimeRange(startTime, endTime string) ([]string, error) {
 	return indices, nil
 }
 
-// BuildTraceQuery builds an OpenSearch query for traces
-func BuildTraceQuery(params TraceQueryParams) map[string]interface{} {
-	// Build the must conditions
-	mustConditions := []map[string]interface{}{}
+// dailyIndexName returns the name of the daily index with a prefix that holds a day
+func dailyIndexName(prefix string, day time.Time) string {
+	return fmt.Sprintf("%s%04d-%02d-%02d", prefix, day.Year(), day.Month(), day.Day())
+}
 
-	// Add component UID filter
-	if params.ComponentUid != "" {
-		mustConditions = append(mustConditions, map[string]interface{}{
-			"term": map[string]interface{}{
-				"resource.openchoreo.dev/component-uid": params.ComponentUid,
-			},
-		})
-	}
+// Span fields used in queries
+const (
+	componentUidField   = "resource.openchoreo.dev/component-uid"
+	environmentUidField = "resource.openchoreo.dev/environment-uid"
+	startTimeField      = "startTime"
+	traceIdField        = "traceId"
+)
 
-	// Add environment UID filter
-	if params.EnvironmentUid != "" {
-		mustConditions = append(mustConditions, map[string]interface{}{
-			"term": map[string]interface{}{
-				"resource.openchoreo.dev/environment-uid": params.EnvironmentUid,
-			},
-		})
+// resourceFilters returns the clauses restricting spans to a component and an environment; empty
+// values are not filtered on
+func resourceFilters(componentUid, environmentUid string) []Query {
+	var queries []Query
+	if componentUid != "" {
+		queries = append(queries, Term(componentUidField, componentUid))
 	}
+	if environmentUid != "" {
+		queries = append(queries, Term(environmentUidField, environmentUid))
+	}
+	return queries
+}
+
+// BuildTraceQuery builds an OpenSearch query for traces
+func BuildTraceQuery(params TraceQueryParams) *SearchSource {
+	query := Bool().Must(resourceFilters(params.ComponentUid, params.EnvironmentUid)...)
 
 	// Add time range filter
 	if params.StartTime != "" && params.EndTime != "" {
-		mustConditions = append(mustConditions, map[string]interface{}{
-			"range": map[string]interface{}{
-				"startTime": map[string]interface{}{
-					"gte": params.StartTime,
-					"lte": params.EndTime,
-				},
-			},
-		})
+		query.Must(Range(startTimeField).Gte(params.StartTime).Lte(params.EndTime))
 	}
 
 	// Set default limit if not provided
@@ -114,87 +127,240 @@ func BuildTraceQuery(params TraceQueryParams) map[string]interface{} {
 	// Set default sort order
 	sortOrder := params.SortOrder
 	if sortOrder == "" {
-		sortOrder = "desc"
-	}
-
-	// Build the complete query
-	query := map[string]interface{}{
-		"query": map[string]interface{}{
-			"bool": map[string]interface{}{
-				"must": mustConditions,
-			},
-		},
-		"size": limit,
-		"from": offset,
-		"sort": []map[string]interface{}{
-			{
-				"startTime": map[string]string{
-					"order": sortOrder,
-				},
-			},
-		},
+		sortOrder = SortDesc
 	}
 
-	return query
+	return NewSearch().Query(query).Size(limit).From(offset).Sort(startTimeField, sortOrder)
+}
+
+// traceOverviewFilters returns the clauses restricting spans to the component, environment and time
+// range of a trace listing
+func traceOverviewFilters(params TraceQueryParams) []Query {
+	queries := resourceFilters(params.ComponentUid, params.EnvironmentUid)
+	if params.StartTime != "" && params.EndTime != "" {
+		queries = append(queries, Range(startTimeField).Gte(params.StartTime).Lte(params.EndTime))
+	}
+	return queries
+}
+
+// BuildTraceOverviewPageQuery builds an aggregation of the IDs of the first size traces of a trace
+// listing, ordered by the start of their first span (newest first unless params.SortOrder is
+// SortAsc), with the approximate number of traces. The spans of the traces on a page are fetched
+// separately with BuildTraceOverviewSpansQuery, so that a page costs the same however many spans
+// the time range holds.
+func BuildTraceOverviewPageQuery(params TraceQueryParams, size int) *SearchSource {
+	sortOrder := params.SortOrder
+	if sortOrder == "" {
+		sortOrder = SortDesc
+	}
+
+	return NewSearch().
+		Size(0).
+		Query(Bool().Must(traceOverviewFilters(params)...)).
+		Aggregation("traces", TermsAgg(traceIdField, size).
+			Order("start", sortOrder).
+			SubAggregation("start", Min(startTimeField))).
+		Aggregation("total", Cardinality(traceIdField))
 }
 
-// BuildTraceByIdAndServiceQuery builds a query to get spans by both traceId and componentUid
-func BuildTraceByIdAndServiceQuery(params TraceByIdAndServiceParams) map[string]interface{} {
-	// Build the must conditions - traceId and resource filters must match
-	mustConditions := []map[string]interface{}{
-		{
-			"term": map[string]interface{}{
-				"traceId": params.TraceID,
-			},
-		},
+// ParseTraceOverviewPage reads the result of BuildTraceOverviewPageQuery: the trace IDs in page
+// order and the approximate number of traces
+func ParseTraceOverviewPage(aggregations json.RawMessage) ([]string, int, error) {
+	if len(aggregations) == 0 {
+		return []string{}, 0, nil
 	}
 
-	// Add component UID filter
-	if params.ComponentUid != "" {
-		mustConditions = append(mustConditions, map[string]interface{}{
-			"term": map[string]interface{}{
-				"resource.openchoreo.dev/component-uid": params.ComponentUid,
-			},
-		})
+	var aggs struct {
+		Traces struct {
+			Buckets []struct {
+				Key string `json:"key"`
+			} `json:"buckets"`
+		} `json:"traces"`
+		Total struct {
+			Value int `json:"value"`
+		} `json:"total"`
+	}
+	if err := json.Unmarshal(aggregations, &aggs); err != nil {
+		return nil, 0, fmt.Errorf("failed to decode aggregations: %w", err)
 	}
 
-	// Add environment UID filter
-	if params.EnvironmentUid != "" {
-		mustConditions = append(mustConditions, map[string]interface{}{
-			"term": map[string]interface{}{
-				"resource.openchoreo.dev/environment-uid": params.EnvironmentUid,
-			},
-		})
+	traceIDs := make([]string, 0, len(aggs.Traces.Buckets))
+	for _, bucket := range aggs.Traces.Buckets {
+		traceIDs = append(traceIDs, bucket.Key)
 	}
+	// The count is approximate, but never below the traces that were found
+	return traceIDs, max(aggs.Total.Value, len(traceIDs)), nil
+}
 
-	// Set default limit if not provided
-	limit := params.Limit
-	if limit == 0 {
-		limit = 10000 // Get all spans for the trace by default
+// BuildTraceOverviewSpansQuery builds the query matching the spans of the given traces of a trace listing
+func BuildTraceOverviewSpansQuery(params TraceQueryParams, traceIDs []string) Query {
+	return Bool().
+		Must(BuildTracesSpansQuery(traceIDs)).
+		Must(traceOverviewFilters(params)...)
+}
+
+// BuildTraceSpansQuery builds the query matching the spans of a trace, optionally restricted to a
+// component and an environment
+func BuildTraceSpansQuery(params TraceByIdAndServiceParams) Query {
+	// traceId and resource filters must match
+	return Bool().
+		Must(Term(traceIdField, params.TraceID)).
+		Must(resourceFilters(params.ComponentUid, params.EnvironmentUid)...)
+}
+
+// BuildModelUsageQuery builds a query for the spans of one or more components in an environment
+func BuildModelUsageQuery(params ModelUsageParams) *SearchSource {
+	query := Bool()
+
+	// Add component UIDs filter
+	if len(params.ComponentUids) > 0 {
+		query.Must(Terms(componentUidField, params.ComponentUids))
 	}
+	query.Must(resourceFilters("", params.EnvironmentUid)...)
 
-	// Set default sort order
-	sortOrder := params.SortOrder
-	if sortOrder == "" {
-		sortOrder = "asc"
-	}
-
-	// Build the complete query
-	query := map[string]interface{}{
-		"query": map[string]interface{}{
-			"bool": map[string]interface{}{
-				"must": mustConditions,
-			},
-		},
-		"size": limit,
-		"sort": []map[string]interface{}{
-			{
-				"startTime": map[string]string{
-					"order": sortOrder,
-				},
-			},
-		},
+	// Add time range filter
+	if params.StartTime != "" && params.EndTime != "" {
+		query.Must(Range(startTimeField).Gte(params.StartTime).Lte(params.EndTime))
+	}
+
+	// Newest spans first, so that a truncated result covers the most recent usage
+	return NewSearch().Query(query).Size(params.Limit).Sort(startTimeField, SortDesc)
+}
+
+// errorStatusQuery matches spans with an error status. Depending on the exporter the status
+// code is stored as the OTLP number or as a name, so the matches are lenient about the type.
+func errorStatusQuery() Query {
+	query := Bool().MinimumShouldMatch(1)
+	for _, code := range []string{"2", "Error", "ERROR", "error"} {
+		query.Should(Match("status.code", code).Lenient())
+	}
+	return query
+}
+
+// BuildSpanDeletionQuery builds a query for the spans of components that are past their retention
+// period. The daily indices are shared by all organizations, so old spans are deleted by query
+// rather than by dropping indices.
+func BuildSpanDeletionQuery(params SpanDeletionParams) Query {
+	query := Bool().Must(
+		Terms(componentUidField, params.ComponentUids),
+		Range(startTimeField).Lt(params.Before),
+	)
+
+	// Keep error spans that are still within their longer retention period
+	if params.ErrorsBefore != "" {
+		query.MustNot(Bool().Must(
+			errorStatusQuery(),
+			Range(startTimeField).Gte(params.ErrorsBefore),
+		))
 	}
 
 	return query
 }
+
+// BuildStorageUsageQuery builds an aggregation of the stored spans of the given components
+func BuildStorageUsageQuery(componentUids []string) *SearchSource {
+	return NewSearch().
+		Size(0).
+		Query(Terms(componentUidField, componentUids)).
+		Aggregation("components", TermsAgg(componentUidField, len(componentUids)).
+			SubAggregation("traces", Cardinality(traceIdField)).
+			SubAggregation("oldest", Min(startTimeField)).
+			SubAggregation("newest", Max(startTimeField)))
+}
+
+// BuildSpanErasureQuery builds a query for the spans of components whose attribute holds a
+// personal identifier. match_phrase matches the whole value whether the attribute is mapped as
+// keyword or as text.
+func BuildSpanErasureQuery(params SpanErasureParams) Query {
+	return Bool().Must(
+		Terms(componentUidField, params.ComponentUids),
+		MatchPhrase("attributes."+params.Attribute, params.Value),
+	)
+}
+
+// BuildJaegerSearchQuery builds the query matching the spans a Jaeger trace search selects traces by
+func BuildJaegerSearchQuery(params JaegerSearchParams) Query {
+	query := Bool().Must(
+		Term(componentUidField, params.ComponentUid),
+		Range(startTimeField).Gte(params.StartTime).Lte(params.EndTime),
+	)
+	if params.Operation != "" {
+		query.Must(Term("name", params.Operation))
+	}
+	if params.MinDuration > 0 || params.MaxDuration > 0 {
+		duration := Range("durationInNanos")
+		if params.MinDuration > 0 {
+			duration.Gte(params.MinDuration)
+		}
+		if params.MaxDuration > 0 {
+			duration.Lte(params.MaxDuration)
+		}
+		query.Must(duration)
+	}
+
+	// Sorted so that the query is stable
+	keys := make([]string, 0, len(params.Tags))
+	for key := range params.Tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		query.Must(MatchPhrase("attributes."+key, params.Tags[key]))
+	}
+	return query
+}
+
+// BuildServiceListQuery builds an aggregation of the component UIDs of spans in a time range
+func BuildServiceListQuery(startTime, endTime string, size int) *SearchSource {
+	return NewSearch().
+		Size(0).
+		Query(Range(startTimeField).Gte(startTime).Lte(endTime)).
+		Aggregation("services", TermsAgg(componentUidField, size))
+}
+
+// BuildRecentSpansQuery builds a query for the newest spans of a component in a time range
+func BuildRecentSpansQuery(componentUid, startTime, endTime string, size int) *SearchSource {
+	query := Bool().Must(
+		Term(componentUidField, componentUid),
+		Range(startTimeField).Gte(startTime).Lte(endTime),
+	)
+	return NewSearch().Query(query).Size(size).Sort(startTimeField, SortDesc)
+}
+
+// BuildSessionSpansQuery builds the query matching the spans that carry the ID of a chat session
+func BuildSessionSpansQuery(params SessionConversationParams) Query {
+	session := Bool().MinimumShouldMatch(1)
+	for _, attribute := range SessionIDAttributes {
+		session.Should(MatchPhrase("attributes."+attribute, params.SessionID))
+	}
+	return Bool().
+		Must(session, Range(startTimeField).Gte(params.StartTime).Lte(params.EndTime)).
+		Must(resourceFilters(params.ComponentUid, params.EnvironmentUid)...)
+}
+
+// BuildSessionTracesSpansQuery builds the query matching the spans of the traces of a session,
+// optionally restricted to a component
+func BuildSessionTracesSpansQuery(traceIDs []string, componentUid string) Query {
+	return Bool().
+		Must(BuildTracesSpansQuery(traceIDs)).
+		Must(resourceFilters(componentUid, "")...)
+}
+
+// BuildTracesSpansQuery builds the query matching the spans of several traces
+func BuildTracesSpansQuery(traceIDs []string) Query {
+	return Terms(traceIdField, traceIDs)
+}
+
+// BuildTraceTimeRangeQuery builds an aggregation of the time range of a trace, or of one of its
+// spans when spanID is given
+func BuildTraceTimeRangeQuery(params TraceByIdAndServiceParams, spanID string) *SearchSource {
+	query := Bool().Must(BuildTraceSpansQuery(params))
+	if spanID != "" {
+		query.Must(Term("spanId", spanID))
+	}
+	return NewSearch().
+		Size(0).
+		Query(query).
+		Aggregation("start", Min(startTimeField)).
+		Aggregation("end", Max("endTime"))
+}
@@ -0,0 +1,469 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+// requireJSON fails the test unless the source renders to the same JSON as expected
+func requireJSON(t *testing.T, expected string, source map[string]interface{}) {
+	t.Helper()
+	actual, err := json.Marshal(source)
+	if err != nil {
+		t.Fatalf("failed to marshal source: %v", err)
+	}
+	var want, got interface{}
+	if err := json.Unmarshal([]byte(expected), &want); err != nil {
+		t.Fatalf("invalid expected JSON: %v", err)
+	}
+	if err := json.Unmarshal(actual, &got); err != nil {
+		t.Fatalf("invalid actual JSON: %v", err)
+	}
+	wantJSON, _ := json.Marshal(want)
+	gotJSON, _ := json.Marshal(got)
+	if string(wantJSON) != string(gotJSON) {
+		t.Errorf("unexpected query\nwant: %s\ngot:  %s", wantJSON, gotJSON)
+	}
+}
+
+func TestQueryDSL(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   map[string]interface{}
+		expected string
+	}{
+		{
+			name:     "term",
+			source:   Term("traceId", "abc").Source(),
+			expected: `{"term":{"traceId":"abc"}}`,
+		},
+		{
+			name:     "terms",
+			source:   Terms("kind", []string{"a", "b"}).Source(),
+			expected: `{"terms":{"kind":["a","b"]}}`,
+		},
+		{
+			name:     "range with open upper bound",
+			source:   Range("startTime").Gt("2025-01-01T00:00:00Z").Source(),
+			expected: `{"range":{"startTime":{"gt":"2025-01-01T00:00:00Z"}}}`,
+		},
+		{
+			name:     "lenient match",
+			source:   Match("status.code", "2").Lenient().Source(),
+			expected: `{"match":{"status.code":{"query":"2","lenient":true}}}`,
+		},
+		{
+			name:     "match",
+			source:   Match("name", "chat").Source(),
+			expected: `{"match":{"name":"chat"}}`,
+		},
+		{
+			name:     "empty bool",
+			source:   Bool().Source(),
+			expected: `{"bool":{}}`,
+		},
+		{
+			name: "bool with every occurrence",
+			source: Bool().
+				Must(Term("a", 1)).
+				Filter(Range("n").Gte(1).Lte(5)).
+				Should(Term("b", 2), Term("c", 3)).
+				MinimumShouldMatch(1).
+				MustNot(MatchPhrase("d", "x y")).
+				Source(),
+			expected: `{"bool":{
+				"must":[{"term":{"a":1}}],
+				"filter":[{"range":{"n":{"gte":1,"lte":5}}}],
+				"should":[{"term":{"b":2}},{"term":{"c":3}}],
+				"minimum_should_match":1,
+				"must_not":[{"match_phrase":{"d":"x y"}}]
+			}}`,
+		},
+		{
+			name: "search with sort, search_after and point in time",
+			source: NewSearch().
+				Query(Term("traceId", "abc")).
+				Size(500).
+				Sort("startTime", SortAsc).
+				Sort("spanId", SortAsc).
+				SearchAfter("2025-01-01T00:00:00Z", "span-1").
+				PointInTime("pit-1", "1m").
+				Source(),
+			expected: `{
+				"query":{"term":{"traceId":"abc"}},
+				"size":500,
+				"sort":[{"startTime":{"order":"asc"}},{"spanId":{"order":"asc"}}],
+				"search_after":["2025-01-01T00:00:00Z","span-1"],
+				"pit":{"id":"pit-1","keep_alive":"1m"}
+			}`,
+		},
+		{
+			name: "search with nested aggregations",
+			source: NewSearch().
+				Size(0).
+				Aggregation("models", TermsAgg("attributes.gen_ai.request.model", 10).
+					SubAggregation("tokens", Sum("attributes.gen_ai.usage.input_tokens"))).
+				Source(),
+			expected: `{
+				"size":0,
+				"aggs":{"models":{
+					"terms":{"field":"attributes.gen_ai.request.model","size":10},
+					"aggs":{"tokens":{"sum":{"field":"attributes.gen_ai.usage.input_tokens"}}}
+				}}
+			}`,
+		},
+		{
+			name: "composite aggregation continuing after a key",
+			source: Composite(100).
+				TermsSource("traceId", "traceId").
+				After(map[string]interface{}{"traceId": "t1"}).
+				Source(),
+			expected: `{"composite":{
+				"size":100,
+				"sources":[{"traceId":{"terms":{"field":"traceId"}}}],
+				"after":{"traceId":"t1"}
+			}}`,
+		},
+		{
+			name:     "search tracking total hits",
+			source:   NewSearch().Size(10).TrackTotalHits().Source(),
+			expected: `{"size":10,"track_total_hits":true}`,
+		},
+		{
+			name: "terms aggregation ordered by a sub-aggregation",
+			source: TermsAgg("traceId", 5).
+				Order("start", SortDesc).
+				SubAggregation("start", Min("startTime")).
+				Source(),
+			expected: `{
+				"terms":{"field":"traceId","size":5,"order":{"start":"desc"}},
+				"aggs":{"start":{"min":{"field":"startTime"}}}
+			}`,
+		},
+		{
+			name: "search with date histogram, filter and percentiles aggregations",
+			source: NewSearch().
+				Size(0).
+				Aggregation("buckets", DateHistogram("startTime", time.Hour,
+					time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)).
+					SubAggregation("good", FilterAgg(Range("durationInNanos").Lte(1000)))).
+				Aggregation("latency", Percentiles("durationInNanos", 95)).
+				Source(),
+			expected: `{
+				"size":0,
+				"aggs":{
+					"buckets":{
+						"date_histogram":{"field":"startTime","fixed_interval":"3600s","min_doc_count":0,
+							"extended_bounds":{"min":1735689600000,"max":1735776000000}},
+						"aggs":{"good":{"filter":{"range":{"durationInNanos":{"lte":1000}}}}}
+					},
+					"latency":{"percentiles":{"field":"durationInNanos","percents":[95]}}
+				}
+			}`,
+		},
+		{
+			name:     "empty search",
+			source:   NewSearch().Source(),
+			expected: `{}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireJSON(t, tt.expected, tt.source)
+		})
+	}
+}
+
+func TestBuildTraceQuery(t *testing.T) {
+	t.Run("filters and paginates", func(t *testing.T) {
+		query := BuildTraceQuery(TraceQueryParams{
+			ComponentUid:   "comp-1",
+			EnvironmentUid: "env-1",
+			StartTime:      "2025-01-01T00:00:00Z",
+			EndTime:        "2025-01-02T00:00:00Z",
+			Limit:          20,
+			Offset:         40,
+			SortOrder:      SortAsc,
+		})
+		requireJSON(t, `{
+			"query":{"bool":{"must":[
+				{"term":{"resource.openchoreo.dev/component-uid":"comp-1"}},
+				{"term":{"resource.openchoreo.dev/environment-uid":"env-1"}},
+				{"range":{"startTime":{"gte":"2025-01-01T00:00:00Z","lte":"2025-01-02T00:00:00Z"}}}
+			]}},
+			"size":20,
+			"from":40,
+			"sort":[{"startTime":{"order":"asc"}}]
+		}`, query.Source())
+	})
+
+	t.Run("applies defaults", func(t *testing.T) {
+		query := BuildTraceQuery(TraceQueryParams{Offset: -1})
+		requireJSON(t, `{
+			"query":{"bool":{}},
+			"size":100,
+			"from":0,
+			"sort":[{"startTime":{"order":"desc"}}]
+		}`, query.Source())
+	})
+}
+
+func TestBuildTraceSpansQuery(t *testing.T) {
+	query := BuildTraceSpansQuery(TraceByIdAndServiceParams{
+		TraceID:      "trace-1",
+		ComponentUid: "comp-1",
+	})
+	requireJSON(t, `{"bool":{"must":[
+		{"term":{"traceId":"trace-1"}},
+		{"term":{"resource.openchoreo.dev/component-uid":"comp-1"}}
+	]}}`, query.Source())
+}
+
+func TestBuildModelUsageQuery(t *testing.T) {
+	query := BuildModelUsageQuery(ModelUsageParams{
+		ComponentUids:  []string{"comp-1", "comp-2"},
+		EnvironmentUid: "env-1",
+		StartTime:      "2025-01-01T00:00:00Z",
+		EndTime:        "2025-01-02T00:00:00Z",
+		Limit:          5000,
+	})
+	requireJSON(t, `{
+		"query":{"bool":{"must":[
+			{"terms":{"resource.openchoreo.dev/component-uid":["comp-1","comp-2"]}},
+			{"term":{"resource.openchoreo.dev/environment-uid":"env-1"}},
+			{"range":{"startTime":{"gte":"2025-01-01T00:00:00Z","lte":"2025-01-02T00:00:00Z"}}}
+		]}},
+		"size":5000,
+		"sort":[{"startTime":{"order":"desc"}}]
+	}`, query.Source())
+}
+
+func TestBuildSpanDeletionQuery(t *testing.T) {
+	t.Run("deletes all spans before the cutoff", func(t *testing.T) {
+		query := BuildSpanDeletionQuery(SpanDeletionParams{
+			ComponentUids: []string{"comp-1"},
+			Before:        "2025-01-01T00:00:00Z",
+		})
+		requireJSON(t, `{"bool":{"must":[
+			{"terms":{"resource.openchoreo.dev/component-uid":["comp-1"]}},
+			{"range":{"startTime":{"lt":"2025-01-01T00:00:00Z"}}}
+		]}}`, query.Source())
+	})
+
+	t.Run("keeps recent error spans", func(t *testing.T) {
+		query := BuildSpanDeletionQuery(SpanDeletionParams{
+			ComponentUids: []string{"comp-1"},
+			Before:        "2025-01-01T00:00:00Z",
+			ErrorsBefore:  "2024-10-01T00:00:00Z",
+		})
+		requireJSON(t, `{"bool":{
+			"must":[
+				{"terms":{"resource.openchoreo.dev/component-uid":["comp-1"]}},
+				{"range":{"startTime":{"lt":"2025-01-01T00:00:00Z"}}}
+			],
+			"must_not":[{"bool":{"must":[
+				{"bool":{
+					"should":[
+						{"match":{"status.code":{"query":"2","lenient":true}}},
+						{"match":{"status.code":{"query":"Error","lenient":true}}},
+						{"match":{"status.code":{"query":"ERROR","lenient":true}}},
+						{"match":{"status.code":{"query":"error","lenient":true}}}
+					],
+					"minimum_should_match":1
+				}},
+				{"range":{"startTime":{"gte":"2024-10-01T00:00:00Z"}}}
+			]}}]
+		}}`, query.Source())
+	})
+}
+
+func TestBuildStorageUsageQuery(t *testing.T) {
+	query := BuildStorageUsageQuery([]string{"comp-1", "comp-2"})
+	requireJSON(t, `{
+		"size":0,
+		"query":{"terms":{"resource.openchoreo.dev/component-uid":["comp-1","comp-2"]}},
+		"aggs":{"components":{
+			"terms":{"field":"resource.openchoreo.dev/component-uid","size":2},
+			"aggs":{
+				"traces":{"cardinality":{"field":"traceId"}},
+				"oldest":{"min":{"field":"startTime"}},
+				"newest":{"max":{"field":"startTime"}}
+			}
+		}}
+	}`, query.Source())
+}
+
+func TestBuildSpanErasureQuery(t *testing.T) {
+	query := BuildSpanErasureQuery(SpanErasureParams{
+		ComponentUids: []string{"comp-1"},
+		Attribute:     "enduser.id",
+		Value:         "user-42",
+	})
+	requireJSON(t, `{"bool":{"must":[
+		{"terms":{"resource.openchoreo.dev/component-uid":["comp-1"]}},
+		{"match_phrase":{"attributes.enduser.id":"user-42"}}
+	]}}`, query.Source())
+}
+
+func TestBuildJaegerSearchQuery(t *testing.T) {
+	query := BuildJaegerSearchQuery(JaegerSearchParams{
+		ComponentUid: "comp-1",
+		Operation:    "chat",
+		Tags:         map[string]string{"user.id": "u1", "gen_ai.request.model": "gpt-4o"},
+		StartTime:    "2025-01-01T00:00:00Z",
+		EndTime:      "2025-01-02T00:00:00Z",
+		MinDuration:  1000,
+	})
+	requireJSON(t, `{"bool":{"must":[
+		{"term":{"resource.openchoreo.dev/component-uid":"comp-1"}},
+		{"range":{"startTime":{"gte":"2025-01-01T00:00:00Z","lte":"2025-01-02T00:00:00Z"}}},
+		{"term":{"name":"chat"}},
+		{"range":{"durationInNanos":{"gte":1000}}},
+		{"match_phrase":{"attributes.gen_ai.request.model":"gpt-4o"}},
+		{"match_phrase":{"attributes.user.id":"u1"}}
+	]}}`, query.Source())
+}
+
+func TestBuildSessionSpansQuery(t *testing.T) {
+	query := BuildSessionSpansQuery(SessionConversationParams{
+		SessionID:      "session-1",
+		EnvironmentUid: "env-1",
+		StartTime:      "2025-01-01T00:00:00Z",
+		EndTime:        "2025-01-02T00:00:00Z",
+	})
+	requireJSON(t, `{"bool":{"must":[
+		{"bool":{"should":[
+			{"match_phrase":{"attributes.session.id":"session-1"}},
+			{"match_phrase":{"attributes.gen_ai.conversation.id":"session-1"}},
+			{"match_phrase":{"attributes.traceloop.association.properties.session_id":"session-1"}},
+			{"match_phrase":{"attributes.langfuse.session.id":"session-1"}}
+		],"minimum_should_match":1}},
+		{"range":{"startTime":{"gte":"2025-01-01T00:00:00Z","lte":"2025-01-02T00:00:00Z"}}},
+		{"term":{"resource.openchoreo.dev/environment-uid":"env-1"}}
+	]}}`, query.Source())
+}
+
+func TestBuildSessionTracesSpansQuery(t *testing.T) {
+	requireJSON(t, `{"bool":{"must":[
+		{"terms":{"traceId":["t1","t2"]}},
+		{"term":{"resource.openchoreo.dev/component-uid":"comp-1"}}
+	]}}`, BuildSessionTracesSpansQuery([]string{"t1", "t2"}, "comp-1").Source())
+}
+
+func TestBuildTraceTimeRangeQuery(t *testing.T) {
+	search := BuildTraceTimeRangeQuery(TraceByIdAndServiceParams{
+		TraceID:        "trace-1",
+		ComponentUid:   "comp-1",
+		EnvironmentUid: "env-1",
+	}, "span-1")
+	requireJSON(t, `{
+		"query":{"bool":{"must":[
+			{"bool":{"must":[
+				{"term":{"traceId":"trace-1"}},
+				{"term":{"resource.openchoreo.dev/component-uid":"comp-1"}},
+				{"term":{"resource.openchoreo.dev/environment-uid":"env-1"}}
+			]}},
+			{"term":{"spanId":"span-1"}}
+		]}},
+		"size":0,
+		"aggs":{"end":{"max":{"field":"endTime"}},"start":{"min":{"field":"startTime"}}}
+	}`, search.Source())
+}
+
+func TestBuildTraceOverviewPageQuery(t *testing.T) {
+	search := BuildTraceOverviewPageQuery(TraceQueryParams{
+		ComponentUid:   "comp-1",
+		EnvironmentUid: "env-1",
+		StartTime:      "2025-01-01T00:00:00Z",
+		EndTime:        "2025-01-02T00:00:00Z",
+	}, 20)
+	requireJSON(t, `{
+		"query":{"bool":{"must":[
+			{"term":{"resource.openchoreo.dev/component-uid":"comp-1"}},
+			{"term":{"resource.openchoreo.dev/environment-uid":"env-1"}},
+			{"range":{"startTime":{"gte":"2025-01-01T00:00:00Z","lte":"2025-01-02T00:00:00Z"}}}
+		]}},
+		"size":0,
+		"aggs":{
+			"traces":{
+				"terms":{"field":"traceId","size":20,"order":{"start":"desc"}},
+				"aggs":{"start":{"min":{"field":"startTime"}}}
+			},
+			"total":{"cardinality":{"field":"traceId"}}
+		}
+	}`, search.Source())
+
+	ascending := BuildTraceOverviewPageQuery(TraceQueryParams{SortOrder: SortAsc}, 10).Source()
+	order := ascending["aggs"].(map[string]interface{})["traces"].(map[string]interface{})["terms"].(map[string]interface{})["order"]
+	if order.(map[string]interface{})["start"] != SortAsc {
+		t.Errorf("expected the oldest traces first, got order %v", order)
+	}
+}
+
+func TestParseTraceOverviewPage(t *testing.T) {
+	t.Run("trace IDs in bucket order", func(t *testing.T) {
+		traceIDs, total, err := ParseTraceOverviewPage(json.RawMessage(`{
+			"traces":{"buckets":[{"key":"t2","doc_count":3},{"key":"t1","doc_count":1}]},
+			"total":{"value":42}
+		}`))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(traceIDs) != 2 || traceIDs[0] != "t2" || traceIDs[1] != "t1" {
+			t.Errorf("unexpected trace IDs: %v", traceIDs)
+		}
+		if total != 42 {
+			t.Errorf("expected total 42, got %d", total)
+		}
+	})
+
+	t.Run("total is never below the traces found", func(t *testing.T) {
+		_, total, err := ParseTraceOverviewPage(json.RawMessage(`{
+			"traces":{"buckets":[{"key":"t1"},{"key":"t2"},{"key":"t3"}]},
+			"total":{"value":2}
+		}`))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if total != 3 {
+			t.Errorf("expected total 3, got %d", total)
+		}
+	})
+
+	t.Run("no aggregations", func(t *testing.T) {
+		traceIDs, total, err := ParseTraceOverviewPage(nil)
+		if err != nil || len(traceIDs) != 0 || total != 0 {
+			t.Errorf("expected an empty page, got %v, %d, %v", traceIDs, total, err)
+		}
+	})
+}
+
+func TestBuildTraceOverviewSpansQuery(t *testing.T) {
+	query := BuildTraceOverviewSpansQuery(TraceQueryParams{
+		ComponentUid:   "comp-1",
+		EnvironmentUid: "env-1",
+	}, []string{"t1", "t2"})
+	requireJSON(t, `{"bool":{"must":[
+		{"terms":{"traceId":["t1","t2"]}},
+		{"term":{"resource.openchoreo.dev/component-uid":"comp-1"}},
+		{"term":{"resource.openchoreo.dev/environment-uid":"env-1"}}
+	]}}`, query.Source())
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/middleware/logger"
+)
+
+// ErrUnavailable is returned without contacting OpenSearch while the circuit breaker is open
+var ErrUnavailable = errors.New("opensearch is unavailable")
+
+// retryOnStatus lists the transient statuses on which a request is retried. Other 5xx responses
+// are not retried but still count as failures for the circuit breaker.
+var retryOnStatus = []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout}
+
+// maxRetryBackoff caps the exponential backoff between retries
+const maxRetryBackoff = 5 * time.Second
+
+// retryBackoff returns an exponential backoff starting at base for the given retry attempt (1-based)
+func retryBackoff(base time.Duration) func(attempt int) time.Duration {
+	return func(attempt int) time.Duration {
+		backoff := base
+		for i := 1; i < attempt && backoff < maxRetryBackoff; i++ {
+			backoff *= 2
+		}
+		return min(backoff, maxRetryBackoff)
+	}
+}
+
+// circuitBreaker stops requests to OpenSearch after a number of consecutive failures and lets
+// them through again once the cooldown has passed. A failure after the cooldown opens it again.
+type circuitBreaker struct {
+	mu          sync.Mutex
+	threshold   int
+	cooldown    time.Duration
+	failures    int
+	openedUntil time.Time
+}
+
+func newCircuitBreaker(threshold int, cooldown time.Duration) *circuitBreaker {
+	return &circuitBreaker{threshold: threshold, cooldown: cooldown}
+}
+
+// allow reports whether a request may be sent
+func (b *circuitBreaker) allow(now time.Time) bool {
+	if b.threshold <= 0 {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return !now.Before(b.openedUntil)
+}
+
+// record counts the outcome of a request and opens the breaker once failures reach the threshold
+func (b *circuitBreaker) record(now time.Time, failed bool) {
+	if b.threshold <= 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !failed {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.failures >= b.threshold {
+		if now.After(b.openedUntil) {
+			slog.Warn("OpenSearch circuit breaker opened", "consecutiveFailures", b.failures, "cooldown", b.cooldown)
+		}
+		b.openedUntil = now.Add(b.cooldown)
+	}
+}
+
+// resilientTransport guards each request to OpenSearch, including retries, with the circuit
+// breaker and logs the ones slower than the slow query threshold
+type resilientTransport struct {
+	next               http.RoundTripper
+	breaker            *circuitBreaker
+	slowQueryThreshold time.Duration
+}
+
+func (t *resilientTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !t.breaker.allow(time.Now()) {
+		return nil, fmt.Errorf("%w: circuit breaker is open", ErrUnavailable)
+	}
+
+	start := time.Now()
+	res, err := t.next.RoundTrip(req)
+	duration := time.Since(start)
+
+	// Requests cancelled by the caller say nothing about the health of the cluster
+	if !errors.Is(err, context.Canceled) {
+		t.breaker.record(time.Now(), err != nil || res.StatusCode >= http.StatusInternalServerError)
+	}
+	if t.slowQueryThreshold > 0 && duration >= t.slowQueryThreshold {
+		status := 0
+		if res != nil {
+			status = res.StatusCode
+		}
+		// Request bodies may hold personal identifiers, so only the endpoint is logged
+		logger.GetLogger(req.Context()).Warn("Slow OpenSearch request", "method", req.Method, "path", req.URL.Path, "status", status, "duration", duration)
+	}
+	return res, err
+}
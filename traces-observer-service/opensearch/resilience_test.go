@@ -0,0 +1,225 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/opensearch-project/opensearch-go"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/config"
+)
+
+func TestCircuitBreakerTransitions(t *testing.T) {
+	const cooldown = 30 * time.Second
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	breaker := newCircuitBreaker(3, cooldown)
+
+	// Closed: failures below the threshold and successes in between keep it closed
+	breaker.record(now, true)
+	breaker.record(now, true)
+	breaker.record(now, false)
+	breaker.record(now, true)
+	breaker.record(now, true)
+	if !breaker.allow(now) {
+		t.Fatal("breaker opened before reaching the threshold of consecutive failures")
+	}
+
+	// Open: the third consecutive failure rejects requests until the cooldown has passed
+	breaker.record(now, true)
+	if breaker.allow(now) || breaker.allow(now.Add(cooldown-time.Second)) {
+		t.Fatal("breaker allowed a request during the cooldown")
+	}
+
+	// Half-open: one request is let through after the cooldown and its failure opens it again
+	halfOpen := now.Add(cooldown)
+	if !breaker.allow(halfOpen) {
+		t.Fatal("breaker rejected a request after the cooldown")
+	}
+	breaker.record(halfOpen, true)
+	if breaker.allow(halfOpen.Add(cooldown - time.Second)) {
+		t.Fatal("a failure after the cooldown did not open the breaker again")
+	}
+
+	// Closed: a success after the cooldown resets the consecutive failures
+	halfOpen = halfOpen.Add(cooldown)
+	if !breaker.allow(halfOpen) {
+		t.Fatal("breaker rejected a request after the second cooldown")
+	}
+	breaker.record(halfOpen, false)
+	breaker.record(halfOpen, true)
+	breaker.record(halfOpen, true)
+	if !breaker.allow(halfOpen) {
+		t.Fatal("breaker did not close after a successful request")
+	}
+}
+
+func TestCircuitBreakerDisabled(t *testing.T) {
+	now := time.Now()
+	breaker := newCircuitBreaker(0, time.Minute)
+	for range 10 {
+		breaker.record(now, true)
+	}
+	if !breaker.allow(now) {
+		t.Fatal("a breaker with threshold 0 rejected a request")
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	tests := []struct {
+		base    time.Duration
+		attempt int
+		want    time.Duration
+	}{
+		{100 * time.Millisecond, 1, 100 * time.Millisecond},
+		{100 * time.Millisecond, 2, 200 * time.Millisecond},
+		{100 * time.Millisecond, 3, 400 * time.Millisecond},
+		{100 * time.Millisecond, 6, 3200 * time.Millisecond},
+		{100 * time.Millisecond, 7, maxRetryBackoff},
+		{100 * time.Millisecond, 1000, maxRetryBackoff},
+		{3 * time.Second, 2, maxRetryBackoff},
+		{10 * time.Second, 1, maxRetryBackoff},
+	}
+	for _, tt := range tests {
+		if got := retryBackoff(tt.base)(tt.attempt); got != tt.want {
+			t.Errorf("retryBackoff(%v)(%d) = %v, want %v", tt.base, tt.attempt, got, tt.want)
+		}
+	}
+}
+
+// newStatusTestClient returns a client for an OpenSearch server answering every request but the
+// product check with status, and a counter of those requests
+func newStatusTestClient(t *testing.T, status int, cfg config.OpenSearchConfig) (*opensearch.Client, *atomic.Int32) {
+	t.Helper()
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/" {
+			_, _ = w.Write([]byte(`{"version":{"number":"2.11.0","distribution":"opensearch"}}`))
+			return
+		}
+		requests.Add(1)
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(server.Close)
+
+	cfg.Address = server.URL
+	client, err := opensearch.NewClient(newClientConfig(&cfg, http.DefaultTransport))
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	return client, &requests
+}
+
+func TestRetryableStatuses(t *testing.T) {
+	tests := []struct {
+		status    int
+		retryable bool
+	}{
+		{http.StatusOK, false},
+		{http.StatusBadRequest, false},
+		{http.StatusNotFound, false},
+		{http.StatusConflict, false},
+		{http.StatusTooManyRequests, true},
+		{http.StatusInternalServerError, false},
+		{http.StatusBadGateway, true},
+		{http.StatusServiceUnavailable, true},
+		{http.StatusGatewayTimeout, true},
+	}
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			client, requests := newStatusTestClient(t, tt.status, config.OpenSearchConfig{MaxRetries: 2, RetryBackoffMillis: 1})
+			res, err := client.Count()
+			if err != nil {
+				t.Fatalf("request failed: %v", err)
+			}
+			_ = res.Body.Close()
+
+			want := int32(1)
+			if tt.retryable {
+				want = 3
+			}
+			if got := requests.Load(); got != want {
+				t.Errorf("server received %d requests, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestResilientTransportOpensBreakerOnServerErrors(t *testing.T) {
+	tests := []struct {
+		status int
+		opens  bool
+	}{
+		{http.StatusNotFound, false},
+		{http.StatusTooManyRequests, false},
+		{http.StatusInternalServerError, true},
+		{http.StatusServiceUnavailable, true},
+	}
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			client, requests := newStatusTestClient(t, tt.status, config.OpenSearchConfig{CircuitBreakerThreshold: 1, CircuitBreakerCooldownSeconds: 60})
+			res, err := client.Count()
+			if err != nil {
+				t.Fatalf("first request failed: %v", err)
+			}
+			_ = res.Body.Close()
+
+			res, err = client.Count()
+			if tt.opens {
+				if !errors.Is(err, ErrUnavailable) {
+					t.Fatalf("second request error = %v, want ErrUnavailable", err)
+				}
+				if got := requests.Load(); got != 1 {
+					t.Errorf("server received %d requests while the breaker was open, want 1", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("second request failed: %v", err)
+			}
+			_ = res.Body.Close()
+		})
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+func TestResilientTransportIgnoresCancelledRequests(t *testing.T) {
+	transport := &resilientTransport{
+		next: roundTripperFunc(func(*http.Request) (*http.Response, error) {
+			return nil, context.Canceled
+		}),
+		breaker: newCircuitBreaker(1, time.Minute),
+	}
+	req := httptest.NewRequest(http.MethodGet, "http://opensearch:9200/", nil)
+	if _, err := transport.RoundTrip(req); !errors.Is(err, context.Canceled) {
+		t.Fatalf("RoundTrip error = %v, want context.Canceled", err)
+	}
+	if !transport.breaker.allow(time.Now()) {
+		t.Fatal("a request cancelled by the caller opened the breaker")
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/middleware/logger"
+)
+
+const (
+	// spanPageSize is the number of spans read per page when scanning all spans of a query
+	spanPageSize = 1000
+	// pointInTimeKeepAlive is how long a point in time is kept between two pages
+	pointInTimeKeepAlive = "1m"
+)
+
+// OpenPointInTime creates a point in time over the given indices, which keeps a consistent view
+// of them across the pages of a search. Indices that do not exist are ignored.
+func (c *Client) OpenPointInTime(ctx context.Context, indices []string, keepAlive string) (string, error) {
+	// Creating a point in time fails on a missing index, while a wildcard matching nothing is
+	// skipped, so each daily index is given as a wildcard
+	indices = withArchivedIndices(ctx, indices)
+	patterns := make([]string, 0, len(indices))
+	for _, index := range indices {
+		patterns = append(patterns, url.PathEscape(strings.TrimSuffix(index, "*")+"*"))
+	}
+	path := fmt.Sprintf("/%s/_search/point_in_time?keep_alive=%s", strings.Join(patterns, ","), url.QueryEscape(keepAlive))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, path, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create point in time request: %w", err)
+	}
+	res, err := c.client.Perform(req)
+	if err != nil {
+		return "", fmt.Errorf("create point in time request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= http.StatusBadRequest {
+		return "", fmt.Errorf("create point in time request failed with status: %s", res.Status)
+	}
+
+	var response struct {
+		PitID string `json:"pit_id"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return "", fmt.Errorf("failed to decode response: %w", err)
+	}
+	return response.PitID, nil
+}
+
+// ClosePointInTime releases a point in time before its keep alive expires
+func (c *Client) ClosePointInTime(ctx context.Context, pitID string) error {
+	body, err := json.Marshal(map[string]interface{}{"pit_id": []string{pitID}})
+	if err != nil {
+		return fmt.Errorf("failed to encode request: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, "/_search/point_in_time", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create delete point in time request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.client.Perform(req)
+	if err != nil {
+		return fmt.Errorf("delete point in time request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= http.StatusBadRequest && res.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("delete point in time request failed with status: %s", res.Status)
+	}
+	return nil
+}
+
+// SearchAllSpans returns the spans matching a query ordered by start time, up to limit spans, and
+// whether more spans matched than were returned. The first page is a plain search, which covers
+// almost every trace. Larger results are read page by page from a point in time with
+// search_after, so they are neither capped by the result window of the indices nor affected by
+// spans written in between. Each page is parsed into spans as it arrives, so memory use is
+// bounded by limit rather than by the size of the raw responses.
+func (c *Client) SearchAllSpans(ctx context.Context, indices []string, query Query, sortOrder string, limit int) ([]Span, bool, error) {
+	// spanId breaks ties between spans that started at the same time, so that no span is skipped
+	// or repeated between pages
+	page := func() *SearchSource {
+		return NewSearch().Query(query).Size(min(spanPageSize, limit)).Sort(startTimeField, sortOrder).Sort("spanId", sortOrder)
+	}
+
+	response, err := c.Search(ctx, indices, page())
+	if err != nil {
+		return nil, false, err
+	}
+	spans := ParseSpans(ctx, response)
+	if len(response.Hits.Hits) < min(spanPageSize, limit) {
+		return spans, false, nil
+	}
+	if len(spans) >= limit {
+		return spans, response.Hits.Total.Value > len(spans), nil
+	}
+
+	pitID, err := c.OpenPointInTime(ctx, indices, pointInTimeKeepAlive)
+	if err != nil {
+		return nil, false, err
+	}
+	defer func() {
+		// The point in time is released even when the request was cancelled
+		if err := c.ClosePointInTime(context.WithoutCancel(ctx), pitID); err != nil {
+			logger.GetLogger(ctx).Warn("Failed to close point in time", "error", err)
+		}
+	}()
+
+	after := response.Hits.Hits[len(response.Hits.Hits)-1].Sort
+	for len(spans) < limit {
+		pageSize := min(spanPageSize, limit-len(spans))
+		response, err := c.Search(ctx, nil, page().Size(pageSize).PointInTime(pitID, pointInTimeKeepAlive).SearchAfter(after...))
+		if err != nil {
+			return nil, false, err
+		}
+		if response.PitID != "" {
+			pitID = response.PitID
+		}
+		spans = append(spans, ParseSpans(ctx, response)...)
+		if len(response.Hits.Hits) < pageSize {
+			return spans, false, nil
+		}
+		after = response.Hits.Hits[len(response.Hits.Hits)-1].Sort
+	}
+
+	// The limit was reached on a full page, so check whether any span is left
+	response, err = c.Search(ctx, nil, page().Size(1).PointInTime(pitID, pointInTimeKeepAlive).SearchAfter(after...))
+	if err != nil {
+		return nil, false, err
+	}
+	return spans, len(response.Hits.Hits) > 0, nil
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/wso2/ai-agent-management-platform/traces-observer-service/config"
+)
+
+// fakeSpanStore serves the spans of a trace through the search and point in time APIs
+type fakeSpanStore struct {
+	mu        sync.Mutex
+	spanCount int
+	openPits  int
+	pitSearch int
+}
+
+func (f *fakeSpanStore) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch {
+	case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/_search/point_in_time"):
+		f.openPits++
+		_, _ = w.Write([]byte(`{"pit_id":"pit-1"}`))
+	case r.Method == http.MethodDelete && r.URL.Path == "/_search/point_in_time":
+		f.openPits--
+		_, _ = w.Write([]byte(`{"pits":[]}`))
+	case strings.HasSuffix(r.URL.Path, "/_search"):
+		var body struct {
+			Size        int           `json:"size"`
+			SearchAfter []interface{} `json:"search_after"`
+			Pit         *struct {
+				ID string `json:"id"`
+			} `json:"pit"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if body.Pit != nil {
+			f.pitSearch++
+		}
+		start := 0
+		if len(body.SearchAfter) == 2 {
+			// Spans are sorted by their index, which the fake uses as both sort values
+			start = int(body.SearchAfter[0].(float64)) + 1
+		}
+		hits := []map[string]interface{}{}
+		for i := start; i < f.spanCount && len(hits) < body.Size; i++ {
+			spanID := fmt.Sprintf("span-%05d", i)
+			hits = append(hits, map[string]interface{}{
+				"_source": map[string]interface{}{"traceId": "trace-1", "spanId": spanID},
+				"sort":    []interface{}{i, spanID},
+			})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"pit_id": "pit-1",
+			"hits": map[string]interface{}{
+				"total": map[string]interface{}{"value": min(f.spanCount, 10000)},
+				"hits":  hits,
+			},
+		})
+	default:
+		_, _ = w.Write([]byte(`{}`))
+	}
+}
+
+func newTestClient(t *testing.T, handler http.Handler) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := NewClient(&config.OpenSearchConfig{
+		Address:                server.URL,
+		Username:               "admin",
+		Password:               "admin",
+		RequestTimeoutSeconds:  5,
+		MaxIdleConnsPerHost:    2,
+		IdleConnTimeoutSeconds: 5,
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	return client
+}
+
+func TestSearchAllSpans(t *testing.T) {
+	tests := []struct {
+		name          string
+		spanCount     int
+		limit         int
+		wantSpans     int
+		wantTruncated bool
+		wantPitSearch bool
+	}{
+		{name: "trace within one page", spanCount: 42, limit: 100000, wantSpans: 42},
+		{name: "trace within the limit on one page", spanCount: 100, limit: 100, wantSpans: 100},
+		{name: "trace over the limit on one page", spanCount: 150, limit: 100, wantSpans: 100, wantTruncated: true},
+		{name: "trace across pages", spanCount: 12345, limit: 100000, wantSpans: 12345, wantPitSearch: true},
+		{name: "trace ending on a page boundary", spanCount: 3000, limit: 100000, wantSpans: 3000, wantPitSearch: true},
+		{name: "trace over the limit across pages", spanCount: 2500, limit: 2200, wantSpans: 2200, wantTruncated: true, wantPitSearch: true},
+		{name: "trace at the limit across pages", spanCount: 2200, limit: 2200, wantSpans: 2200, wantPitSearch: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &fakeSpanStore{spanCount: tt.spanCount}
+			client := newTestClient(t, store)
+
+			spans, truncated, err := client.SearchAllSpans(context.Background(), []string{"otel-traces-2025-01-01"},
+				BuildTraceSpansQuery(TraceByIdAndServiceParams{TraceID: "trace-1"}), SortAsc, tt.limit)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(spans) != tt.wantSpans {
+				t.Errorf("got %d spans, want %d", len(spans), tt.wantSpans)
+			}
+			if truncated != tt.wantTruncated {
+				t.Errorf("got truncated %v, want %v", truncated, tt.wantTruncated)
+			}
+			for i, span := range spans {
+				if want := fmt.Sprintf("span-%05d", i); span.SpanID != want {
+					t.Fatalf("span %d is %s, want %s", i, span.SpanID, want)
+				}
+			}
+			if (store.pitSearch > 0) != tt.wantPitSearch {
+				t.Errorf("got %d point in time searches, want any: %v", store.pitSearch, tt.wantPitSearch)
+			}
+			if store.openPits != 0 {
+				t.Errorf("%d points in time were left open", store.openPits)
+			}
+		})
+	}
+}
@@ -0,0 +1,271 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+)
+
+// SLO indicators
+const (
+	SLOIndicatorLatency      = "latency"
+	SLOIndicatorAvailability = "availability"
+)
+
+// SLO statuses
+const (
+	SLOStatusMet      = "met"
+	SLOStatusAtRisk   = "at_risk"
+	SLOStatusBreached = "breached"
+	SLOStatusNoData   = "no_data"
+)
+
+// SLOBurnRateWindows are the trailing windows burn rates are reported for, when shorter than
+// the objective window
+var SLOBurnRateWindows = []time.Duration{time.Hour, 6 * time.Hour, 24 * time.Hour}
+
+// sloAtRiskBudgetRemaining is the fraction of the error budget below which a met objective is at risk
+const sloAtRiskBudgetRemaining = 0.25
+
+// sloBucket holds the traces of one interval
+type sloBucket struct {
+	time  time.Time
+	total int64
+	good  int64
+}
+
+// BuildSLOQuery builds an aggregation of the traces of a component in the window of an objective,
+// counting all and good traces per interval. Traces are represented by their root spans.
+func BuildSLOQuery(params SLOParams) *SearchSource {
+	start := params.End.Add(-params.Window)
+	query := Bool().
+		Must(
+			Term("parentSpanId", ""),
+			Range(startTimeField).Gte(start.UTC().Format(time.RFC3339Nano)).Lte(params.End.UTC().Format(time.RFC3339Nano)),
+		).
+		Must(resourceFilters(params.ComponentUid, params.EnvironmentUid)...)
+
+	search := NewSearch().
+		Size(0).
+		Query(query).
+		Aggregation("buckets", DateHistogram(startTimeField, params.Interval, start, params.End).
+			SubAggregation("good", FilterAgg(sloGoodQuery(params.Objective))))
+	if params.Objective.Indicator == SLOIndicatorLatency {
+		search.Aggregation("latency", Percentiles("durationInNanos", params.Objective.Target))
+	}
+	return search
+}
+
+// sloGoodQuery matches the root spans of traces that meet an objective
+func sloGoodQuery(objective SLOObjective) Query {
+	if objective.Indicator == SLOIndicatorLatency {
+		return Range("durationInNanos").Lte(int64(objective.ThresholdMs * float64(time.Millisecond)))
+	}
+	return Bool().MustNot(errorStatusQuery())
+}
+
+// parseSLOAggregations reads the result of BuildSLOQuery: the buckets in time order, and the
+// latency in milliseconds at the target percentile for the latency indicator
+func parseSLOAggregations(aggregations json.RawMessage) ([]sloBucket, *float64, error) {
+	if len(aggregations) == 0 {
+		return []sloBucket{}, nil, nil
+	}
+
+	var aggs struct {
+		Buckets struct {
+			Buckets []struct {
+				Key      int64 `json:"key"`
+				DocCount int64 `json:"doc_count"`
+				Good     struct {
+					DocCount int64 `json:"doc_count"`
+				} `json:"good"`
+			} `json:"buckets"`
+		} `json:"buckets"`
+		Latency *struct {
+			Values map[string]*float64 `json:"values"`
+		} `json:"latency"`
+	}
+	if err := json.Unmarshal(aggregations, &aggs); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode aggregations: %w", err)
+	}
+
+	buckets := make([]sloBucket, 0, len(aggs.Buckets.Buckets))
+	for _, bucket := range aggs.Buckets.Buckets {
+		buckets = append(buckets, sloBucket{
+			time:  time.UnixMilli(bucket.Key).UTC(),
+			total: bucket.DocCount,
+			good:  bucket.Good.DocCount,
+		})
+	}
+
+	var latency *float64
+	if aggs.Latency != nil {
+		// A single percentile is requested
+		for _, value := range aggs.Latency.Values {
+			if value != nil {
+				millis := nanosToMillis(*value)
+				latency = &millis
+			}
+		}
+	}
+	return buckets, latency, nil
+}
+
+// ParseSLOStatus computes the compliance with an objective from the result of BuildSLOQuery
+func ParseSLOStatus(params SLOParams, aggregations json.RawMessage) (*SLOStatusResponse, error) {
+	buckets, latency, err := parseSLOAggregations(aggregations)
+	if err != nil {
+		return nil, err
+	}
+	return computeSLOStatus(params, buckets, latency), nil
+}
+
+// ParseSLOHistory computes the compliance with an objective per interval from the result of BuildSLOQuery
+func ParseSLOHistory(params SLOParams, aggregations json.RawMessage) (*SLOHistoryResponse, error) {
+	buckets, _, err := parseSLOAggregations(aggregations)
+	if err != nil {
+		return nil, err
+	}
+	return computeSLOHistory(params, buckets), nil
+}
+
+func computeSLOStatus(params SLOParams, buckets []sloBucket, latency *float64) *SLOStatusResponse {
+	objective := params.Objective
+	var total, good int64
+	for _, bucket := range buckets {
+		total += bucket.total
+		good += bucket.good
+	}
+
+	status := &SLOStatusResponse{
+		Objective:   objective,
+		WindowStart: params.End.Add(-params.Window).UTC(),
+		WindowEnd:   params.End.UTC(),
+		TotalCount:  total,
+		GoodCount:   good,
+		Compliance:  compliance(total, good),
+		ErrorBudget: SLOErrorBudget{
+			Allowed:   roundSLOValue(allowedBadRatio(objective) * float64(total)),
+			Consumed:  total - good,
+			Remaining: budgetRemaining(objective, total, good),
+		},
+		BurnRates: []SLOBurnRate{},
+	}
+
+	if objective.Indicator == SLOIndicatorLatency {
+		status.ObservedValue = latency
+	} else if total > 0 {
+		errorRate := roundSLOValue(float64(total-good) * 100 / float64(total))
+		status.ObservedValue = &errorRate
+	}
+
+	for _, window := range SLOBurnRateWindows {
+		if window >= params.Window {
+			continue
+		}
+		var windowTotal, windowGood int64
+		for _, bucket := range buckets {
+			if !bucket.time.Before(params.End.Add(-window)) {
+				windowTotal += bucket.total
+				windowGood += bucket.good
+			}
+		}
+		burnRate := SLOBurnRate{Window: formatSLOWindow(window)}
+		if windowTotal > 0 {
+			rate := roundSLOValue(float64(windowTotal-windowGood) / float64(windowTotal) / allowedBadRatio(objective))
+			burnRate.BurnRate = &rate
+		}
+		status.BurnRates = append(status.BurnRates, burnRate)
+	}
+
+	switch {
+	case total == 0:
+		status.Status = SLOStatusNoData
+	case status.ErrorBudget.Remaining < 0:
+		status.Status = SLOStatusBreached
+	case status.ErrorBudget.Remaining < sloAtRiskBudgetRemaining:
+		status.Status = SLOStatusAtRisk
+	default:
+		status.Status = SLOStatusMet
+	}
+	return status
+}
+
+func computeSLOHistory(params SLOParams, buckets []sloBucket) *SLOHistoryResponse {
+	history := &SLOHistoryResponse{
+		Objective:   params.Objective,
+		WindowStart: params.End.Add(-params.Window).UTC(),
+		WindowEnd:   params.End.UTC(),
+		Interval:    formatSLOWindow(params.Interval),
+		Points:      make([]SLOHistoryPoint, 0, len(buckets)),
+	}
+
+	var total, good int64
+	for _, bucket := range buckets {
+		total += bucket.total
+		good += bucket.good
+		history.Points = append(history.Points, SLOHistoryPoint{
+			Time:            bucket.time,
+			TotalCount:      bucket.total,
+			GoodCount:       bucket.good,
+			Compliance:      compliance(bucket.total, bucket.good),
+			BudgetRemaining: budgetRemaining(params.Objective, total, good),
+		})
+	}
+	return history
+}
+
+// allowedBadRatio is the fraction of traces that may be bad under an objective
+func allowedBadRatio(objective SLOObjective) float64 {
+	return 1 - objective.Target/100
+}
+
+// compliance returns the percentage of good traces, or nil without traces
+func compliance(total, good int64) *float64 {
+	if total == 0 {
+		return nil
+	}
+	value := roundSLOValue(float64(good) * 100 / float64(total))
+	return &value
+}
+
+// budgetRemaining returns the fraction of the error budget of an objective left after the given traces
+func budgetRemaining(objective SLOObjective, total, good int64) float64 {
+	allowed := allowedBadRatio(objective) * float64(total)
+	if allowed <= 0 {
+		return 1
+	}
+	return roundSLOValue(1 - float64(total-good)/allowed)
+}
+
+func roundSLOValue(value float64) float64 {
+	return math.Round(value*10000) / 10000
+}
+
+// formatSLOWindow renders a window as hours or days, e.g. 6h or 28d
+func formatSLOWindow(window time.Duration) string {
+	if window%(24*time.Hour) == 0 {
+		return fmt.Sprintf("%dd", window/(24*time.Hour))
+	}
+	if window%time.Hour == 0 {
+		return fmt.Sprintf("%dh", window/time.Hour)
+	}
+	return window.String()
+}
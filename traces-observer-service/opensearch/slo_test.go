@@ -0,0 +1,213 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestBuildSLOQuery(t *testing.T) {
+	end := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	search := BuildSLOQuery(SLOParams{
+		ComponentUid:   "comp-1",
+		EnvironmentUid: "env-1",
+		Objective:      SLOObjective{Indicator: SLOIndicatorLatency, ThresholdMs: 3000, Target: 95},
+		Window:         24 * time.Hour,
+		Interval:       time.Hour,
+		End:            end,
+	})
+	requireJSON(t, `{
+		"query":{"bool":{"must":[
+			{"term":{"parentSpanId":""}},
+			{"range":{"startTime":{"gte":"2025-01-01T00:00:00Z","lte":"2025-01-02T00:00:00Z"}}},
+			{"term":{"resource.openchoreo.dev/component-uid":"comp-1"}},
+			{"term":{"resource.openchoreo.dev/environment-uid":"env-1"}}
+		]}},
+		"size":0,
+		"aggs":{
+			"buckets":{
+				"date_histogram":{"field":"startTime","fixed_interval":"3600s","min_doc_count":0,
+					"extended_bounds":{"min":1735689600000,"max":1735776000000}},
+				"aggs":{"good":{"filter":{"range":{"durationInNanos":{"lte":3000000000}}}}}
+			},
+			"latency":{"percentiles":{"field":"durationInNanos","percents":[95]}}
+		}
+	}`, search.Source())
+
+	availability := BuildSLOQuery(SLOParams{
+		ComponentUid: "comp-1",
+		Objective:    SLOObjective{Indicator: SLOIndicatorAvailability, Target: 99},
+		Window:       24 * time.Hour,
+		Interval:     time.Hour,
+		End:          end,
+	}).Source()
+	aggs := availability["aggs"].(map[string]interface{})
+	if _, ok := aggs["latency"]; ok {
+		t.Errorf("availability objective requested latency percentiles: %v", aggs)
+	}
+	requireJSON(t, `{"filter":{"bool":{"must_not":[{"bool":{"should":[
+		{"match":{"status.code":{"query":"2","lenient":true}}},
+		{"match":{"status.code":{"query":"Error","lenient":true}}},
+		{"match":{"status.code":{"query":"ERROR","lenient":true}}},
+		{"match":{"status.code":{"query":"error","lenient":true}}}
+	],"minimum_should_match":1}}]}}}`,
+		aggs["buckets"].(map[string]interface{})["aggs"].(map[string]interface{})["good"].(map[string]interface{}))
+}
+
+// sloAggregations renders date histogram buckets of hourly traces ending at end
+func sloAggregations(t *testing.T, end time.Time, counts [][2]int64, latencyNanos *float64) json.RawMessage {
+	t.Helper()
+	type bucket struct {
+		Key      int64 `json:"key"`
+		DocCount int64 `json:"doc_count"`
+		Good     struct {
+			DocCount int64 `json:"doc_count"`
+		} `json:"good"`
+	}
+	buckets := make([]bucket, len(counts))
+	for i, count := range counts {
+		buckets[i].Key = end.Add(-time.Duration(len(counts)-i) * time.Hour).UnixMilli()
+		buckets[i].DocCount = count[0]
+		buckets[i].Good.DocCount = count[1]
+	}
+	aggs := map[string]interface{}{"buckets": map[string]interface{}{"buckets": buckets}}
+	if latencyNanos != nil {
+		aggs["latency"] = map[string]interface{}{"values": map[string]interface{}{"95.0": *latencyNanos}}
+	}
+	data, err := json.Marshal(aggs)
+	if err != nil {
+		t.Fatalf("failed to marshal aggregations: %v", err)
+	}
+	return data
+}
+
+func TestParseSLOStatus(t *testing.T) {
+	end := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	params := SLOParams{
+		Objective: SLOObjective{Indicator: SLOIndicatorAvailability, Target: 99},
+		Window:    7 * 24 * time.Hour,
+		Interval:  time.Hour,
+		End:       end,
+	}
+
+	// 1000 traces with 5 errors, 4 of them in the last hour
+	counts := make([][2]int64, 10)
+	for i := range counts {
+		counts[i] = [2]int64{100, 100}
+	}
+	counts[0] = [2]int64{100, 99}
+	counts[9] = [2]int64{100, 96}
+
+	status, err := ParseSLOStatus(params, sloAggregations(t, end, counts, nil))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if status.TotalCount != 1000 || status.GoodCount != 995 || *status.Compliance != 99.5 {
+		t.Errorf("got %d total, %d good and %v compliance", status.TotalCount, status.GoodCount, *status.Compliance)
+	}
+	if *status.ObservedValue != 0.5 {
+		t.Errorf("got error rate %v, want 0.5", *status.ObservedValue)
+	}
+	if status.ErrorBudget.Allowed != 10 || status.ErrorBudget.Consumed != 5 || status.ErrorBudget.Remaining != 0.5 {
+		t.Errorf("unexpected error budget: %+v", status.ErrorBudget)
+	}
+	if status.Status != SLOStatusMet {
+		t.Errorf("got status %s, want %s", status.Status, SLOStatusMet)
+	}
+	if len(status.BurnRates) != 3 || status.BurnRates[0].Window != "1h" || *status.BurnRates[0].BurnRate != 4 ||
+		status.BurnRates[2].Window != "1d" || *status.BurnRates[2].BurnRate != 0.5 {
+		t.Errorf("unexpected burn rates: %+v", status.BurnRates)
+	}
+
+	// Three more errors use up 80% of the budget
+	counts[5] = [2]int64{100, 97}
+	status, _ = ParseSLOStatus(params, sloAggregations(t, end, counts, nil))
+	if status.Status != SLOStatusAtRisk || status.ErrorBudget.Remaining != 0.2 {
+		t.Errorf("got %s with %v remaining, want at_risk with 0.2", status.Status, status.ErrorBudget.Remaining)
+	}
+
+	counts[5] = [2]int64{100, 90}
+	status, _ = ParseSLOStatus(params, sloAggregations(t, end, counts, nil))
+	if status.Status != SLOStatusBreached || status.ErrorBudget.Remaining >= 0 {
+		t.Errorf("got %s with %v remaining, want breached", status.Status, status.ErrorBudget.Remaining)
+	}
+
+	status, _ = ParseSLOStatus(params, sloAggregations(t, end, [][2]int64{{0, 0}}, nil))
+	if status.Status != SLOStatusNoData || status.Compliance != nil || status.ObservedValue != nil || status.ErrorBudget.Remaining != 1 {
+		t.Errorf("unexpected status without traces: %+v", status)
+	}
+	if status.BurnRates[0].BurnRate != nil {
+		t.Errorf("got a burn rate without traces: %v", *status.BurnRates[0].BurnRate)
+	}
+}
+
+func TestParseSLOStatusLatency(t *testing.T) {
+	end := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	params := SLOParams{
+		Objective: SLOObjective{Indicator: SLOIndicatorLatency, ThresholdMs: 3000, Target: 95},
+		Window:    24 * time.Hour,
+		Interval:  time.Hour,
+		End:       end,
+	}
+	latency := float64(2500 * time.Millisecond)
+
+	status, err := ParseSLOStatus(params, sloAggregations(t, end, [][2]int64{{100, 97}}, &latency))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if status.ObservedValue == nil || *status.ObservedValue != 2500 {
+		t.Errorf("got observed latency %v, want 2500", status.ObservedValue)
+	}
+	// Burn rates are only reported for windows shorter than the objective window
+	if len(status.BurnRates) != 2 || status.BurnRates[1].Window != "6h" {
+		t.Errorf("unexpected burn rates: %+v", status.BurnRates)
+	}
+	if status.Status != SLOStatusMet || status.ErrorBudget.Remaining != 0.4 {
+		t.Errorf("got %s with %v remaining", status.Status, status.ErrorBudget.Remaining)
+	}
+}
+
+func TestParseSLOHistory(t *testing.T) {
+	end := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	params := SLOParams{
+		Objective: SLOObjective{Indicator: SLOIndicatorAvailability, Target: 90},
+		Window:    3 * time.Hour,
+		Interval:  time.Hour,
+		End:       end,
+	}
+
+	history, err := ParseSLOHistory(params, sloAggregations(t, end, [][2]int64{{10, 10}, {0, 0}, {10, 8}}, nil))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if history.Interval != "1h" || len(history.Points) != 3 || !history.WindowStart.Equal(end.Add(-3*time.Hour)) {
+		t.Fatalf("unexpected history: %+v", history)
+	}
+	first, empty, last := history.Points[0], history.Points[1], history.Points[2]
+	if *first.Compliance != 100 || first.BudgetRemaining != 1 {
+		t.Errorf("unexpected first point: %+v", first)
+	}
+	if empty.Compliance != nil || empty.BudgetRemaining != 1 {
+		t.Errorf("unexpected empty point: %+v", empty)
+	}
+	// 2 bad traces of 20, with 2 allowed
+	if *last.Compliance != 80 || last.BudgetRemaining != 0 || !last.Time.Equal(end.Add(-time.Hour)) {
+		t.Errorf("unexpected last point: %+v", last)
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// storageUsageAggregations is the aggregation result of BuildStorageUsageQuery
+type storageUsageAggregations struct {
+	Components struct {
+		Buckets []struct {
+			Key      string `json:"key"`
+			DocCount int64  `json:"doc_count"`
+			Traces   struct {
+				Value int64 `json:"value"`
+			} `json:"traces"`
+			Oldest struct {
+				Value *float64 `json:"value"`
+			} `json:"oldest"`
+			Newest struct {
+				Value *float64 `json:"value"`
+			} `json:"newest"`
+		} `json:"buckets"`
+	} `json:"components"`
+}
+
+// ParseStorageUsage reads the per component usage from the aggregations of a storage usage query.
+// Spans do not record their own size, so the size of a component is estimated from its share of
+// the documents in the trace indices.
+func ParseStorageUsage(aggregations json.RawMessage, totalDocs int64, totalSizeBytes int64) ([]ComponentStorageUsage, error) {
+	usage := []ComponentStorageUsage{}
+	if len(aggregations) == 0 {
+		return usage, nil
+	}
+
+	var aggs storageUsageAggregations
+	if err := json.Unmarshal(aggregations, &aggs); err != nil {
+		return nil, fmt.Errorf("failed to decode aggregations: %w", err)
+	}
+
+	for _, bucket := range aggs.Components.Buckets {
+		component := ComponentStorageUsage{
+			ComponentUid:   bucket.Key,
+			SpanCount:      bucket.DocCount,
+			TraceCount:     bucket.Traces.Value,
+			OldestSpanTime: epochMillisToTime(bucket.Oldest.Value),
+			NewestSpanTime: epochMillisToTime(bucket.Newest.Value),
+		}
+		if totalDocs > 0 {
+			component.EstimatedSizeBytes = int64(float64(totalSizeBytes) * float64(bucket.DocCount) / float64(totalDocs))
+		}
+		usage = append(usage, component)
+	}
+	return usage, nil
+}
+
+// epochMillisToTime converts the value of a min or max aggregation on a date field
+func epochMillisToTime(value *float64) *time.Time {
+	if value == nil {
+		return nil
+	}
+	t := time.UnixMilli(int64(*value)).UTC()
+	return &t
+}
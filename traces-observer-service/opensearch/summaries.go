@@ -0,0 +1,165 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Trace summaries are documents written by the trace summary rollup, one per trace of a component,
+// into daily indices of the start of the trace. The traces list reads them instead of aggregating
+// spans when the rollup is enabled.
+const (
+	// TraceSummaryIndexTemplate is the name of the index template of the summary indices
+	TraceSummaryIndexTemplate = "otel-trace-summaries"
+	traceSummaryIndexPrefix   = "otel-trace-summaries-"
+	endTimeField              = "endTime"
+)
+
+// TraceSummary is the summary document of the trace of a component
+type TraceSummary struct {
+	TraceOverview
+	ComponentUid   string `json:"componentUid"`
+	EnvironmentUid string `json:"environmentUid,omitempty"`
+	// ProjectUid is the project of the component, which callers restricted to projects are filtered on
+	ProjectUid string `json:"projectUid,omitempty"`
+	// EstimatedCost is the cost of the LLM and embedding calls with a known model price
+	EstimatedCost *float64 `json:"estimatedCost,omitempty"`
+}
+
+// TraceSummaryIndex returns the summary index a trace starting at the given time is written to
+func TraceSummaryIndex(startTime time.Time) string {
+	return dailyIndexName(traceSummaryIndexPrefix, startTime.UTC())
+}
+
+// GetTraceSummaryIndicesForTimeRange returns the summary indices of the traces starting in a time range
+func GetTraceSummaryIndicesForTimeRange(startTime, endTime string) ([]string, error) {
+	return indicesForTimeRange(traceSummaryIndexPrefix, startTime, endTime)
+}
+
+// TraceSummaryIndexTemplateSource returns the index template of the summary indices. Only the fields
+// that summaries are filtered and sorted on are mapped; the others, such as the input and output of
+// the root span whose type varies, are kept in the source without being indexed.
+func TraceSummaryIndexTemplateSource() map[string]interface{} {
+	keyword := map[string]interface{}{"type": "keyword"}
+	return map[string]interface{}{
+		"index_patterns": []string{traceSummaryIndexPrefix + "*"},
+		"template": map[string]interface{}{
+			"mappings": map[string]interface{}{
+				"dynamic": false,
+				"properties": map[string]interface{}{
+					"traceId":         keyword,
+					"componentUid":    keyword,
+					"environmentUid":  keyword,
+					"projectUid":      keyword,
+					"rootSpanName":    keyword,
+					"rootSpanKind":    keyword,
+					"startTime":       map[string]interface{}{"type": "date"},
+					"endTime":         map[string]interface{}{"type": "date"},
+					"durationInNanos": map[string]interface{}{"type": "long"},
+					"spanCount":       map[string]interface{}{"type": "integer"},
+					"estimatedCost":   map[string]interface{}{"type": "double"},
+				},
+			},
+		},
+	}
+}
+
+// BuildEndedTracesQuery builds a page of the IDs of the traces with spans ending in [start, end),
+// continuing after the after key of the previous page
+func BuildEndedTracesQuery(start, end time.Time, size int, after map[string]interface{}) *SearchSource {
+	return NewSearch().
+		Size(0).
+		Query(Range(endTimeField).Gte(start.Format(time.RFC3339Nano)).Lt(end.Format(time.RFC3339Nano))).
+		Aggregation("traces", Composite(size).TermsSource("traceId", traceIdField).After(after))
+}
+
+// ParseEndedTraces reads a page of BuildEndedTracesQuery: the trace IDs and the key to continue
+// after, which is nil on the last page
+func ParseEndedTraces(aggregations json.RawMessage) ([]string, map[string]interface{}, error) {
+	if len(aggregations) == 0 {
+		return nil, nil, nil
+	}
+
+	var aggs struct {
+		Traces struct {
+			AfterKey map[string]interface{} `json:"after_key"`
+			Buckets  []struct {
+				Key struct {
+					TraceID string `json:"traceId"`
+				} `json:"key"`
+			} `json:"buckets"`
+		} `json:"traces"`
+	}
+	if err := json.Unmarshal(aggregations, &aggs); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode aggregations: %w", err)
+	}
+
+	traceIDs := make([]string, 0, len(aggs.Traces.Buckets))
+	for _, bucket := range aggs.Traces.Buckets {
+		traceIDs = append(traceIDs, bucket.Key.TraceID)
+	}
+	if len(traceIDs) == 0 {
+		return traceIDs, nil, nil
+	}
+	return traceIDs, aggs.Traces.AfterKey, nil
+}
+
+// BuildTraceSummariesQuery builds the query of a page of the traces list from the summary indices
+func BuildTraceSummariesQuery(params TraceQueryParams) *SearchSource {
+	var filters []Query
+	if params.ComponentUid != "" {
+		filters = append(filters, Term("componentUid", params.ComponentUid))
+	}
+	if params.EnvironmentUid != "" {
+		filters = append(filters, Term("environmentUid", params.EnvironmentUid))
+	}
+	if params.StartTime != "" && params.EndTime != "" {
+		filters = append(filters, Range(startTimeField).Gte(params.StartTime).Lte(params.EndTime))
+	}
+
+	sortOrder := params.SortOrder
+	if sortOrder == "" {
+		sortOrder = SortDesc
+	}
+
+	return NewSearch().
+		Query(Bool().Filter(filters...)).
+		Size(params.Limit).
+		From(params.Offset).
+		Sort(startTimeField, sortOrder).
+		TrackTotalHits()
+}
+
+// ParseTraceSummaries reads the trace overviews of the summaries returned by BuildTraceSummariesQuery
+func ParseTraceSummaries(response *SearchResponse) ([]TraceOverview, error) {
+	overviews := make([]TraceOverview, 0, len(response.Hits.Hits))
+	for _, hit := range response.Hits.Hits {
+		data, err := json.Marshal(hit.Source)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode trace summary: %w", err)
+		}
+		var summary TraceSummary
+		if err := json.Unmarshal(data, &summary); err != nil {
+			return nil, fmt.Errorf("failed to decode trace summary: %w", err)
+		}
+		overviews = append(overviews, summary.TraceOverview)
+	}
+	return overviews, nil
+}
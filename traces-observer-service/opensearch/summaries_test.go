@@ -0,0 +1,134 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestTraceSummaryIndices(t *testing.T) {
+	start := time.Date(2025, 1, 1, 23, 30, 0, 0, time.FixedZone("IST", 5*3600+1800))
+	if index := TraceSummaryIndex(start); index != "otel-trace-summaries-2025-01-01" {
+		t.Errorf("expected the index of the UTC day, got %s", index)
+	}
+
+	indices, err := GetTraceSummaryIndicesForTimeRange("2025-01-01T10:00:00Z", "2025-01-02T10:00:00Z")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(indices) != 2 || indices[0] != "otel-trace-summaries-2025-01-01" || indices[1] != "otel-trace-summaries-2025-01-02" {
+		t.Errorf("unexpected indices: %v", indices)
+	}
+}
+
+func TestBuildEndedTracesQuery(t *testing.T) {
+	start := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	search := BuildEndedTracesQuery(start, start.Add(time.Minute), 500, map[string]interface{}{"traceId": "t1"})
+	requireJSON(t, `{
+		"query":{"range":{"endTime":{"gte":"2025-01-01T10:00:00Z","lt":"2025-01-01T10:01:00Z"}}},
+		"size":0,
+		"aggs":{"traces":{"composite":{
+			"size":500,
+			"sources":[{"traceId":{"terms":{"field":"traceId"}}}],
+			"after":{"traceId":"t1"}
+		}}}
+	}`, search.Source())
+}
+
+func TestParseEndedTraces(t *testing.T) {
+	traceIDs, after, err := ParseEndedTraces(json.RawMessage(`{"traces":{
+		"after_key":{"traceId":"t2"},
+		"buckets":[{"key":{"traceId":"t1"},"doc_count":3},{"key":{"traceId":"t2"},"doc_count":1}]
+	}}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(traceIDs) != 2 || traceIDs[0] != "t1" || traceIDs[1] != "t2" {
+		t.Errorf("unexpected trace IDs: %v", traceIDs)
+	}
+	if after["traceId"] != "t2" {
+		t.Errorf("unexpected after key: %v", after)
+	}
+
+	traceIDs, after, err = ParseEndedTraces(json.RawMessage(`{"traces":{"buckets":[]}}`))
+	if err != nil || len(traceIDs) != 0 || after != nil {
+		t.Errorf("expected the last page, got %v, %v, %v", traceIDs, after, err)
+	}
+}
+
+func TestBuildTraceSummariesQuery(t *testing.T) {
+	search := BuildTraceSummariesQuery(TraceQueryParams{
+		ComponentUid:   "comp-1",
+		EnvironmentUid: "env-1",
+		StartTime:      "2025-01-01T00:00:00Z",
+		EndTime:        "2025-01-02T00:00:00Z",
+		Limit:          10,
+		Offset:         20,
+	})
+	requireJSON(t, `{
+		"query":{"bool":{"filter":[
+			{"term":{"componentUid":"comp-1"}},
+			{"term":{"environmentUid":"env-1"}},
+			{"range":{"startTime":{"gte":"2025-01-01T00:00:00Z","lte":"2025-01-02T00:00:00Z"}}}
+		]}},
+		"size":10,
+		"from":20,
+		"sort":[{"startTime":{"order":"desc"}}],
+		"track_total_hits":true
+	}`, search.Source())
+}
+
+func TestParseTraceSummaries(t *testing.T) {
+	var response SearchResponse
+	if err := json.Unmarshal([]byte(`{"hits":{"total":{"value":1},"hits":[{"_source":{
+		"traceId":"t1",
+		"rootSpanId":"s1",
+		"rootSpanName":"invoke_agent",
+		"rootSpanKind":"agent",
+		"startTime":"2025-01-01T10:00:00Z",
+		"endTime":"2025-01-01T10:00:02Z",
+		"durationInNanos":2000000000,
+		"spanCount":4,
+		"tokenUsage":{"inputTokens":100,"outputTokens":20,"totalTokens":120},
+		"status":{"errorCount":0},
+		"input":{"question":"What is the weather?"},
+		"componentUid":"comp-1",
+		"estimatedCost":0.0012
+	}}]}}`), &response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	overviews, err := ParseTraceSummaries(&response)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(overviews) != 1 {
+		t.Fatalf("expected 1 trace, got %d", len(overviews))
+	}
+	overview := overviews[0]
+	if overview.TraceID != "t1" || overview.SpanCount != 4 || overview.DurationInNanos != 2000000000 {
+		t.Errorf("unexpected trace: %+v", overview)
+	}
+	if overview.TokenUsage == nil || overview.TokenUsage.TotalTokens != 120 {
+		t.Errorf("unexpected token usage: %+v", overview.TokenUsage)
+	}
+	if input, ok := overview.Input.(map[string]interface{}); !ok || input["question"] != "What is the weather?" {
+		t.Errorf("unexpected input: %+v", overview.Input)
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// ParseTimeRange reads the time range from the aggregations of BuildTraceTimeRangeQuery. Both
+// times are nil when no span matched.
+func ParseTimeRange(aggregations json.RawMessage) (start *time.Time, end *time.Time, err error) {
+	if len(aggregations) == 0 {
+		return nil, nil, nil
+	}
+
+	var aggs struct {
+		Start struct {
+			Value *float64 `json:"value"`
+		} `json:"start"`
+		End struct {
+			Value *float64 `json:"value"`
+		} `json:"end"`
+	}
+	if err := json.Unmarshal(aggregations, &aggs); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode aggregations: %w", err)
+	}
+	return epochMillisToTime(aggs.Start.Value), epochMillisToTime(aggs.End.Value), nil
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"sort"
+	"time"
+)
+
+// Time series metrics. Trace metrics are computed from root spans, token metrics from LLM and
+// embedding spans.
+const (
+	MetricTraceCount     = "traces.count"
+	MetricTraceErrors    = "traces.errors"
+	MetricTraceErrorRate = "traces.error_rate"
+	MetricLatencyAvg     = "traces.latency.avg"
+	MetricLatencyP95     = "traces.latency.p95"
+	MetricLLMCalls       = "llm.calls"
+	MetricInputTokens    = "tokens.input"
+	MetricOutputTokens   = "tokens.output"
+	MetricTotalTokens    = "tokens.total"
+)
+
+// TimeSeriesMetrics lists the metrics AggregateTimeSeries supports, with a description of each
+var TimeSeriesMetrics = []struct {
+	Name        string
+	Description string
+}{
+	{MetricTraceCount, "Traces started"},
+	{MetricTraceErrors, "Traces that ended in an error"},
+	{MetricTraceErrorRate, "Percentage of traces that ended in an error"},
+	{MetricLatencyAvg, "Average trace latency in milliseconds"},
+	{MetricLatencyP95, "95th percentile trace latency in milliseconds"},
+	{MetricLLMCalls, "LLM and embedding calls"},
+	{MetricInputTokens, "Input tokens of LLM and embedding calls"},
+	{MetricOutputTokens, "Output tokens of LLM and embedding calls"},
+	{MetricTotalTokens, "Total tokens of LLM and embedding calls"},
+}
+
+// IsTimeSeriesMetric reports whether AggregateTimeSeries supports a metric
+func IsTimeSeriesMetric(name string) bool {
+	for _, metric := range TimeSeriesMetrics {
+		if metric.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// TimeSeriesPoint is the value of a metric in the interval starting at Time. Value is nil for
+// intervals in which an average or percentile has nothing to be computed from.
+type TimeSeriesPoint struct {
+	Time  time.Time
+	Value *float64
+}
+
+// timeSeriesBucket collects the spans of one interval
+type timeSeriesBucket struct {
+	traces       int
+	errors       int
+	durations    []int64
+	llmCalls     int
+	inputTokens  int
+	outputTokens int
+}
+
+// AggregateTimeSeries computes a metric for each interval between from and to. Spans must have
+// been parsed with ParseSpans so that AmpAttributes are populated.
+func AggregateTimeSeries(spans []Span, metric string, from, to time.Time, interval time.Duration) []TimeSeriesPoint {
+	if interval <= 0 || !to.After(from) {
+		return []TimeSeriesPoint{}
+	}
+	start := from.Truncate(interval)
+	buckets := make([]timeSeriesBucket, int(to.Sub(start)/interval)+1)
+
+	for _, span := range spans {
+		if span.StartTime.Before(start) || span.StartTime.After(to) {
+			continue
+		}
+		bucket := &buckets[int(span.StartTime.Sub(start)/interval)]
+
+		if span.ParentSpanID == "" {
+			bucket.traces++
+			bucket.durations = append(bucket.durations, span.DurationInNanos)
+			if span.AmpAttributes != nil && span.AmpAttributes.Status != nil && span.AmpAttributes.Status.Error {
+				bucket.errors++
+			}
+		}
+
+		if span.AmpAttributes == nil {
+			continue
+		}
+		var tokens *LLMTokenUsage
+		switch data := span.AmpAttributes.Data.(type) {
+		case LLMData:
+			tokens = data.TokenUsage
+		case EmbeddingData:
+			tokens = data.TokenUsage
+		default:
+			continue
+		}
+		bucket.llmCalls++
+		if tokens != nil {
+			bucket.inputTokens += tokens.InputTokens
+			bucket.outputTokens += tokens.OutputTokens
+		}
+	}
+
+	points := make([]TimeSeriesPoint, 0, len(buckets))
+	for i := range buckets {
+		points = append(points, TimeSeriesPoint{
+			Time:  start.Add(time.Duration(i) * interval),
+			Value: buckets[i].value(metric),
+		})
+	}
+	return points
+}
+
+func (b *timeSeriesBucket) value(metric string) *float64 {
+	var value float64
+	switch metric {
+	case MetricTraceCount:
+		value = float64(b.traces)
+	case MetricTraceErrors:
+		value = float64(b.errors)
+	case MetricTraceErrorRate:
+		if b.traces == 0 {
+			return nil
+		}
+		value = float64(b.errors) * 100 / float64(b.traces)
+	case MetricLatencyAvg, MetricLatencyP95:
+		if len(b.durations) == 0 {
+			return nil
+		}
+		sorted := append([]int64(nil), b.durations...)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+		if metric == MetricLatencyP95 {
+			value = nanosToMillis(float64(percentile(sorted, 0.95)))
+		} else {
+			var total int64
+			for _, d := range sorted {
+				total += d
+			}
+			value = nanosToMillis(float64(total) / float64(len(sorted)))
+		}
+	case MetricLLMCalls:
+		value = float64(b.llmCalls)
+	case MetricInputTokens:
+		value = float64(b.inputTokens)
+	case MetricOutputTokens:
+		value = float64(b.outputTokens)
+	case MetricTotalTokens:
+		value = float64(b.inputTokens + b.outputTokens)
+	default:
+		return nil
+	}
+	return &value
+}
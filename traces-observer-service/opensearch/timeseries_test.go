@@ -0,0 +1,107 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAggregateTimeSeries(t *testing.T) {
+	from := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	to := from.Add(3 * time.Minute)
+
+	rootSpan := func(offset time.Duration, durationMs int64, failed bool) Span {
+		return Span{
+			SpanID:          "root",
+			StartTime:       from.Add(offset),
+			DurationInNanos: durationMs * int64(time.Millisecond),
+			AmpAttributes:   &AmpAttributes{Status: &SpanStatus{Error: failed}},
+		}
+	}
+	llmSpan := func(offset time.Duration, input, output int) Span {
+		return Span{
+			SpanID:       "llm",
+			ParentSpanID: "root",
+			StartTime:    from.Add(offset),
+			AmpAttributes: &AmpAttributes{Data: LLMData{
+				Model:      "gpt-4o",
+				TokenUsage: &LLMTokenUsage{InputTokens: input, OutputTokens: output},
+			}},
+		}
+	}
+	spans := []Span{
+		rootSpan(10*time.Second, 100, false),
+		rootSpan(20*time.Second, 300, true),
+		llmSpan(15*time.Second, 50, 20),
+		llmSpan(70*time.Second, 10, 5),
+		rootSpan(130*time.Second, 200, false),
+		// Outside of the range
+		rootSpan(-time.Minute, 100, true),
+		rootSpan(5*time.Minute, 100, true),
+	}
+
+	values := func(metric string) []*float64 {
+		points := AggregateTimeSeries(spans, metric, from, to, time.Minute)
+		result := make([]*float64, 0, len(points))
+		for i, point := range points {
+			if want := from.Add(time.Duration(i) * time.Minute); !point.Time.Equal(want) {
+				t.Fatalf("point %d is at %s, want %s", i, point.Time, want)
+			}
+			result = append(result, point.Value)
+		}
+		return result
+	}
+	ptr := func(v float64) *float64 { return &v }
+
+	tests := []struct {
+		metric string
+		want   []*float64
+	}{
+		{MetricTraceCount, []*float64{ptr(2), ptr(0), ptr(1), ptr(0)}},
+		{MetricTraceErrors, []*float64{ptr(1), ptr(0), ptr(0), ptr(0)}},
+		{MetricTraceErrorRate, []*float64{ptr(50), nil, ptr(0), nil}},
+		{MetricLatencyAvg, []*float64{ptr(200), nil, ptr(200), nil}},
+		{MetricLatencyP95, []*float64{ptr(300), nil, ptr(200), nil}},
+		{MetricLLMCalls, []*float64{ptr(1), ptr(1), ptr(0), ptr(0)}},
+		{MetricInputTokens, []*float64{ptr(50), ptr(10), ptr(0), ptr(0)}},
+		{MetricOutputTokens, []*float64{ptr(20), ptr(5), ptr(0), ptr(0)}},
+		{MetricTotalTokens, []*float64{ptr(70), ptr(15), ptr(0), ptr(0)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.metric, func(t *testing.T) {
+			got := values(tt.metric)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d points, want %d", len(got), len(tt.want))
+			}
+			for i := range got {
+				switch {
+				case got[i] == nil && tt.want[i] == nil:
+				case got[i] == nil || tt.want[i] == nil || *got[i] != *tt.want[i]:
+					t.Errorf("point %d: got %v, want %v", i, deref(got[i]), deref(tt.want[i]))
+				}
+			}
+		})
+	}
+}
+
+func deref(value *float64) interface{} {
+	if value == nil {
+		return nil
+	}
+	return *value
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"sort"
+	"time"
+)
+
+// toolStats accumulates observations of a single tool while building the catalog
+type toolStats struct {
+	summary  ToolSummary
+	lastSeen time.Time
+}
+
+// agentTools accumulates the tools of a single agent component
+type agentTools struct {
+	agentName string
+	tools     map[string]*toolStats
+}
+
+// AggregateToolCatalog builds a per-agent tool inventory from parsed spans.
+// Tools are declared by LLM, agent and CrewAI task spans and invoked by tool spans;
+// spans must have been parsed with ParseSpans so that AmpAttributes are populated.
+func AggregateToolCatalog(spans []Span) []AgentToolCatalog {
+	agents := make(map[string]*agentTools)
+
+	for _, span := range spans {
+		if span.AmpAttributes == nil {
+			continue
+		}
+
+		switch data := span.AmpAttributes.Data.(type) {
+		case ToolData:
+			if data.Name == "" {
+				continue
+			}
+			stats := agentFor(agents, span.Service).tool(data.Name, span.StartTime)
+			stats.summary.InvocationCount++
+			if span.AmpAttributes.Status != nil && span.AmpAttributes.Status.Error {
+				stats.summary.ErrorCount++
+			}
+		case LLMData:
+			agentFor(agents, span.Service).declare(data.Tools, span.StartTime)
+		case AgentData:
+			a := agentFor(agents, span.Service)
+			if a.agentName == "" {
+				a.agentName = data.Name
+			}
+			a.declare(data.Tools, span.StartTime)
+		case CrewAITaskData:
+			agentFor(agents, span.Service).declare(data.Tools, span.StartTime)
+		}
+	}
+
+	catalog := make([]AgentToolCatalog, 0, len(agents))
+	for componentUid, agent := range agents {
+		entry := AgentToolCatalog{
+			ComponentUid: componentUid,
+			AgentName:    agent.agentName,
+			Tools:        make([]ToolSummary, 0, len(agent.tools)),
+		}
+		for _, stats := range agent.tools {
+			summary := stats.summary
+			if summary.InvocationCount > 0 {
+				rate := float64(summary.InvocationCount-summary.ErrorCount) / float64(summary.InvocationCount)
+				summary.SuccessRate = &rate
+			}
+			summary.LastSeen = stats.lastSeen.Format(time.RFC3339Nano)
+			entry.Tools = append(entry.Tools, summary)
+		}
+		sort.Slice(entry.Tools, func(i, j int) bool {
+			return entry.Tools[i].Name < entry.Tools[j].Name
+		})
+		catalog = append(catalog, entry)
+	}
+	sort.Slice(catalog, func(i, j int) bool {
+		return catalog[i].ComponentUid < catalog[j].ComponentUid
+	})
+
+	return catalog
+}
+
+// agentFor returns the tools of the agent component, creating them on first sight
+func agentFor(agents map[string]*agentTools, componentUid string) *agentTools {
+	agent, ok := agents[componentUid]
+	if !ok {
+		agent = &agentTools{tools: make(map[string]*toolStats)}
+		agents[componentUid] = agent
+	}
+	return agent
+}
+
+// tool returns the stats of the named tool, creating them on first sight
+func (a *agentTools) tool(name string, seenAt time.Time) *toolStats {
+	stats, ok := a.tools[name]
+	if !ok {
+		stats = &toolStats{summary: ToolSummary{Name: name}}
+		a.tools[name] = stats
+	}
+	if seenAt.After(stats.lastSeen) {
+		stats.lastSeen = seenAt
+	}
+	return stats
+}
+
+// declare records tool definitions offered to an LLM, agent or task
+func (a *agentTools) declare(definitions []ToolDefinition, seenAt time.Time) {
+	for _, definition := range definitions {
+		if definition.Name == "" {
+			continue
+		}
+		stats := a.tool(definition.Name, seenAt)
+		stats.summary.Declared = true
+		if stats.summary.Description == "" {
+			stats.summary.Description = definition.Description
+		}
+	}
+}
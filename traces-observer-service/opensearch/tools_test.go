@@ -0,0 +1,121 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAggregateToolCatalog(t *testing.T) {
+	start := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	at := func(minute int) time.Time { return start.Add(time.Duration(minute) * time.Minute) }
+	toolSpan := func(service, name string, minute int, failed bool) Span {
+		return Span{
+			Service:   service,
+			StartTime: at(minute),
+			AmpAttributes: &AmpAttributes{
+				Kind:   string(SpanTypeTool),
+				Data:   ToolData{Name: name},
+				Status: &SpanStatus{Error: failed},
+			},
+		}
+	}
+	weather := ToolDefinition{Name: "get_weather", Description: "Returns the current weather of a city"}
+	search := ToolDefinition{Name: "search", Description: "Searches the web"}
+
+	spans := []Span{
+		toolSpan("agent-b", "get_weather", 1, false),
+		toolSpan("agent-b", "get_weather", 4, true),
+		toolSpan("agent-b", "get_weather", 2, false),
+		toolSpan("agent-b", "get_weather", 3, false),
+		toolSpan("agent-b", "undeclared", 5, false),
+		toolSpan("agent-b", "", 6, false),
+		{Service: "agent-b", StartTime: at(0), AmpAttributes: &AmpAttributes{Kind: string(SpanTypeLLM), Data: LLMData{Tools: []ToolDefinition{weather}}}},
+		{Service: "agent-a", StartTime: at(7), AmpAttributes: &AmpAttributes{Kind: string(SpanTypeAgent), Data: AgentData{Name: "planner", Tools: []ToolDefinition{search, {Name: ""}}}}},
+		{Service: "agent-a", StartTime: at(8), AmpAttributes: &AmpAttributes{Kind: string(SpanTypeAgent), Data: AgentData{Name: "other-name"}}},
+		{Service: "agent-c", StartTime: at(9), AmpAttributes: &AmpAttributes{Kind: string(SpanTypeCrewAITask), Data: CrewAITaskData{Tools: []ToolDefinition{{Name: "search"}}}}},
+		{Service: "agent-c", StartTime: at(10)},
+	}
+
+	catalog := AggregateToolCatalog(spans)
+	if len(catalog) != 3 {
+		t.Fatalf("expected 3 agents, got %+v", catalog)
+	}
+	if catalog[0].ComponentUid != "agent-a" || catalog[1].ComponentUid != "agent-b" || catalog[2].ComponentUid != "agent-c" {
+		t.Fatalf("expected agents ordered by component UID, got %+v", catalog)
+	}
+
+	t.Run("agents are named by their first agent span", func(t *testing.T) {
+		if catalog[0].AgentName != "planner" {
+			t.Errorf("expected agent name planner, got %q", catalog[0].AgentName)
+		}
+		if catalog[1].AgentName != "" {
+			t.Errorf("expected no agent name without agent spans, got %q", catalog[1].AgentName)
+		}
+	})
+
+	t.Run("declared tools that were never invoked have no success rate", func(t *testing.T) {
+		tools := catalog[0].Tools
+		if len(tools) != 1 || tools[0].Name != "search" {
+			t.Fatalf("expected only the named declared tool, got %+v", tools)
+		}
+		if !tools[0].Declared || tools[0].Description != "Searches the web" || tools[0].InvocationCount != 0 || tools[0].SuccessRate != nil {
+			t.Errorf("unexpected summary %+v", tools[0])
+		}
+		if tools[0].LastSeen != at(7).Format(time.RFC3339Nano) {
+			t.Errorf("expected last seen at the declaring span, got %s", tools[0].LastSeen)
+		}
+	})
+
+	t.Run("invocations are counted with their errors and latest start", func(t *testing.T) {
+		tools := catalog[1].Tools
+		if len(tools) != 2 || tools[0].Name != "get_weather" || tools[1].Name != "undeclared" {
+			t.Fatalf("expected tools ordered by name without unnamed ones, got %+v", tools)
+		}
+		weatherSummary := tools[0]
+		if !weatherSummary.Declared || weatherSummary.Description != weather.Description {
+			t.Errorf("expected get_weather to be declared with its description, got %+v", weatherSummary)
+		}
+		if weatherSummary.InvocationCount != 4 || weatherSummary.ErrorCount != 1 {
+			t.Errorf("expected 4 invocations and 1 error, got %+v", weatherSummary)
+		}
+		if weatherSummary.SuccessRate == nil || *weatherSummary.SuccessRate != 0.75 {
+			t.Errorf("expected a success rate of 0.75, got %v", weatherSummary.SuccessRate)
+		}
+		if weatherSummary.LastSeen != at(4).Format(time.RFC3339Nano) {
+			t.Errorf("expected last seen at the latest invocation, got %s", weatherSummary.LastSeen)
+		}
+		if tools[1].Declared || tools[1].InvocationCount != 1 {
+			t.Errorf("expected an undeclared tool invoked once, got %+v", tools[1])
+		}
+	})
+
+	t.Run("tools of CrewAI tasks are declared", func(t *testing.T) {
+		tools := catalog[2].Tools
+		if len(tools) != 1 || tools[0].Name != "search" || !tools[0].Declared {
+			t.Errorf("expected the task tool to be declared, got %+v", tools)
+		}
+	})
+}
+
+func TestAggregateToolCatalogWithoutSpans(t *testing.T) {
+	catalog := AggregateToolCatalog(nil)
+	if catalog == nil || len(catalog) != 0 {
+		t.Errorf("expected an empty catalog, got %#v", catalog)
+	}
+}
@@ -16,7 +16,10 @@
 
 package opensearch
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 // TraceQueryParams holds parameters for trace queries
 type TraceQueryParams struct {
@@ -29,6 +32,21 @@ type TraceQueryParams struct {
 	SortOrder      string
 }
 
+// FederatedTraceParams holds parameters for querying a trace across all agent components
+type FederatedTraceParams struct {
+	TraceID        string
+	EnvironmentUid string
+}
+
+// SessionConversationParams holds parameters for reconstructing the conversation of a chat session
+type SessionConversationParams struct {
+	SessionID      string
+	ComponentUid   string // Optional
+	EnvironmentUid string // Optional
+	StartTime      string
+	EndTime        string
+}
+
 // TraceByIdAndServiceParams holds parameters for querying by both traceId and componentUid
 type TraceByIdAndServiceParams struct {
 	TraceID        string
@@ -38,6 +56,23 @@ type TraceByIdAndServiceParams struct {
 	Limit          int
 }
 
+// ToolCatalogParams holds parameters for tool catalog queries
+type ToolCatalogParams struct {
+	ComponentUid   string // Optional; all agents of the environment are included when empty
+	EnvironmentUid string
+	StartTime      string
+	EndTime        string
+}
+
+// ModelUsageParams holds parameters for model usage queries
+type ModelUsageParams struct {
+	ComponentUids  []string // Optional; all components of the environment are included when empty
+	EnvironmentUid string
+	StartTime      string
+	EndTime        string
+	Limit          int
+}
+
 // Span represents a single trace span
 type Span struct {
 	TraceID         string                 `json:"traceId"`
@@ -62,6 +97,21 @@ type AmpAttributes struct {
 	Output interface{} `json:"output,omitempty"` // Output data (type varies by kind)
 	Status *SpanStatus `json:"status,omitempty"` // Execution status with error information
 	Data   interface{} `json:"data,omitempty"`   // Kind-specific data: *LLMData, *ToolData, *EmbeddingData, *RetrieverData, etc.
+	// HandOff is set on the first span of an agent that was called by another agent
+	HandOff *HandOff `json:"handOff,omitempty"`
+	// Extensions holds the attributes derived by enrichers registered with RegisterSpanEnricher
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
+
+// HandOff describes a call from one agent component to another within a trace
+type HandOff struct {
+	SourceComponentUid string `json:"sourceComponentUid"`
+	SourceAgent        string `json:"sourceAgent,omitempty"` // Component name of the calling agent
+	SourceSpanID       string `json:"sourceSpanId"`          // Span of the calling agent that made the call
+	TargetComponentUid string `json:"targetComponentUid"`
+	TargetAgent        string `json:"targetAgent,omitempty"` // Component name of the called agent
+	TargetSpanID       string `json:"targetSpanId"`          // First span of the called agent
+	Protocol           string `json:"protocol,omitempty"`    // a2a or http, when it can be determined
 }
 
 // LLMData contains LLM-specific span information
@@ -71,6 +121,13 @@ type LLMData struct {
 	Vendor      string           `json:"vendor,omitempty"`      // LLM vendor/provider (gen_ai.system)
 	Temperature *float64         `json:"temperature,omitempty"` // Temperature parameter
 	TokenUsage  *LLMTokenUsage   `json:"tokenUsage,omitempty"`  // Token usage details
+	// EstimatedCost is the cost from the configured model prices (nil if no price is known)
+	EstimatedCost *float64 `json:"estimatedCost,omitempty"`
+	// Hashes of the normalized system prompt, the last user message and the whole prompt, which
+	// let identical prompts be counted without reading their content
+	SystemPromptHash string `json:"systemPromptHash,omitempty"`
+	InputHash        string `json:"inputHash,omitempty"`
+	PromptHash       string `json:"promptHash,omitempty"`
 }
 
 // ToolData contains tool execution span information
@@ -84,6 +141,8 @@ type EmbeddingData struct {
 	Model      string         `json:"model,omitempty"`      // Embedding model name
 	Vendor     string         `json:"vendor,omitempty"`     // Embedding vendor/provider (gen_ai.system)
 	TokenUsage *LLMTokenUsage `json:"tokenUsage,omitempty"` // Token usage details
+	// EstimatedCost is the cost from the configured model prices (nil if no price is known)
+	EstimatedCost *float64 `json:"estimatedCost,omitempty"`
 }
 
 // RetrieverData contains vector database retrieval span information
@@ -152,6 +211,49 @@ type TraceResponse struct {
 	TotalCount int          `json:"totalCount"`
 	TokenUsage *TokenUsage  `json:"tokenUsage,omitempty"` // Aggregated token usage from GenAI spans
 	Status     *TraceStatus `json:"status,omitempty"`     // Trace status including error information
+	Truncated  bool         `json:"truncated,omitempty"`  // True when the trace has more spans than the limit
+	Input      interface{}  `json:"input,omitempty"`      // Input from the root span (nil if not found)
+	Output     interface{}  `json:"output,omitempty"`     // Output from the root span (nil if not found)
+	// Annotations are the notes users attached to the trace and its spans, oldest first
+	Annotations []Annotation `json:"annotations,omitempty"`
+}
+
+// TraceAgent summarizes the participation of an agent component in a federated trace
+type TraceAgent struct {
+	ComponentUid string `json:"componentUid"`
+	AgentName    string `json:"agentName,omitempty"`
+	SpanCount    int    `json:"spanCount"`
+}
+
+// FederatedTraceResponse represents a trace stitched across all agent components that took part in it
+type FederatedTraceResponse struct {
+	TraceID    string       `json:"traceId"`
+	Spans      []Span       `json:"spans"`
+	TotalCount int          `json:"totalCount"`
+	Agents     []TraceAgent `json:"agents"`   // Participating agents in order of their first span
+	HandOffs   []HandOff    `json:"handOffs"` // Calls between agents in chronological order
+	TokenUsage *TokenUsage  `json:"tokenUsage,omitempty"`
+	Status     *TraceStatus `json:"status,omitempty"`
+	Truncated  bool         `json:"truncated,omitempty"` // True when the trace has more spans than can be returned
+}
+
+// ConversationResponse represents the conversation of a chat session, reconstructed from its traces
+type ConversationResponse struct {
+	SessionID  string                `json:"sessionId"`
+	TraceIDs   []string              `json:"traceIds"` // Traces of the session in chronological order
+	Messages   []ConversationMessage `json:"messages"`
+	TotalCount int                   `json:"totalCount"`
+	Truncated  bool                  `json:"truncated,omitempty"` // True when the session has more spans than can be read
+}
+
+// ConversationMessage is a message of a session conversation, with the span it was recorded in
+type ConversationMessage struct {
+	Role      string     `json:"role"`
+	Content   string     `json:"content,omitempty"`
+	ToolCalls []ToolCall `json:"toolCalls,omitempty"`
+	TraceID   string     `json:"traceId"`
+	SpanID    string     `json:"spanId"`
+	Timestamp time.Time  `json:"timestamp"`
 }
 
 // TraceDetailResponse represents detailed information for a single trace
@@ -177,6 +279,8 @@ type TraceOverview struct {
 	Status          *TraceStatus `json:"status,omitempty"`     // Trace status including error information
 	Input           interface{}  `json:"input,omitempty"`      // Input from root span (nil if not found)
 	Output          interface{}  `json:"output,omitempty"`     // Output from root span (nil if not found)
+	// RemoteParentSpanID is set when the trace of this component was started by a call from another agent
+	RemoteParentSpanID string `json:"remoteParentSpanId,omitempty"`
 }
 
 // TraceStatus represents the status of a trace
@@ -237,6 +341,359 @@ type TraceExportResponse struct {
 	TotalCount int         `json:"totalCount"`
 }
 
+// ToolSummary describes a single tool of an agent as observed in its spans
+type ToolSummary struct {
+	Name            string   `json:"name"`
+	Description     string   `json:"description,omitempty"`
+	Declared        bool     `json:"declared"`              // Whether the tool was offered to an LLM, agent or task
+	InvocationCount int      `json:"invocationCount"`       // Number of tool execution spans
+	ErrorCount      int      `json:"errorCount"`            // Number of failed tool executions
+	SuccessRate     *float64 `json:"successRate,omitempty"` // Fraction of successful invocations (nil if never invoked)
+	LastSeen        string   `json:"lastSeen"`              // Start time of the latest span that declared or invoked the tool
+}
+
+// AgentToolCatalog lists the tools of a single agent component
+type AgentToolCatalog struct {
+	ComponentUid string        `json:"componentUid"`
+	AgentName    string        `json:"agentName,omitempty"` // Agent name reported by the framework, if any
+	Tools        []ToolSummary `json:"tools"`
+}
+
+// ToolCatalogResponse represents the response for tool catalog queries
+type ToolCatalogResponse struct {
+	Agents     []AgentToolCatalog `json:"agents"`
+	TotalCount int                `json:"totalCount"`
+	SpanCount  int                `json:"spanCount"` // Number of spans the catalog was derived from
+	Truncated  bool               `json:"truncated"` // Whether the span limit was reached, so older spans were left out
+}
+
+// UsageStats holds request, latency, error and cost figures of LLM and embedding calls
+type UsageStats struct {
+	RequestCount  int      `json:"requestCount"`
+	ErrorCount    int      `json:"errorCount"`
+	ErrorRate     float64  `json:"errorRate"`               // Fraction of failed requests
+	AvgLatencyMs  float64  `json:"avgLatencyMs"`            // Mean span duration in milliseconds
+	P50LatencyMs  float64  `json:"p50LatencyMs"`            // Median span duration in milliseconds
+	P95LatencyMs  float64  `json:"p95LatencyMs"`            // 95th percentile span duration in milliseconds
+	InputTokens   int      `json:"inputTokens"`             // Total input tokens
+	OutputTokens  int      `json:"outputTokens"`            // Total output tokens
+	TotalTokens   int      `json:"totalTokens"`             // Total tokens
+	EstimatedCost *float64 `json:"estimatedCost,omitempty"` // Cost from the configured model prices (nil if no price is known)
+}
+
+// ModelUsage holds the usage of a single model of a provider
+type ModelUsage struct {
+	Model    string `json:"model"`
+	Provider string `json:"provider,omitempty"` // LLM vendor/provider (gen_ai.system)
+	UsageStats
+}
+
+// ProviderUsage holds the usage of all models of a provider
+type ProviderUsage struct {
+	Provider string `json:"provider"`
+	UsageStats
+}
+
+// ModelUsageResponse represents the response for model usage queries
+type ModelUsageResponse struct {
+	Models    []ModelUsage    `json:"models"`    // Ordered by request count, highest first
+	Providers []ProviderUsage `json:"providers"` // Ordered by request count, highest first
+	SpanCount int             `json:"spanCount"` // Number of spans the figures were derived from
+	Truncated bool            `json:"truncated"` // Whether the span limit was reached, so older spans were left out
+}
+
+// PromptAnalyticsParams holds parameters for prompt analytics queries
+type PromptAnalyticsParams struct {
+	ComponentUids  []string // Optional; all components of the environment are included when empty
+	EnvironmentUid string
+	StartTime      string
+	EndTime        string
+	Limit          int // Number of prompts listed in each ranking
+}
+
+// PromptFrequency is how often LLM calls carried the same system prompt or user input
+type PromptFrequency struct {
+	Hash       string    `json:"hash"`
+	Count      int       `json:"count"`
+	AgentCount int       `json:"agentCount"` // Number of agent components that sent it
+	FirstSeen  time.Time `json:"firstSeen"`
+	LastSeen   time.Time `json:"lastSeen"`
+}
+
+// CacheOpportunity is a prompt that was sent to the same model more than once, so that all calls
+// after the first could have been answered from a cache
+type CacheOpportunity struct {
+	PromptHash          string `json:"promptHash"`
+	Model               string `json:"model"`
+	Count               int    `json:"count"`
+	RepeatedCalls       int    `json:"repeatedCalls"`
+	RepeatedInputTokens int    `json:"repeatedInputTokens"` // Input tokens spent on the repeated calls
+	// RepeatedOutputTokens are the output tokens spent on the repeated calls
+	RepeatedOutputTokens int `json:"repeatedOutputTokens"`
+}
+
+// PromptAnalyticsResponse represents the response for prompt analytics queries. Prompts are
+// identified by hashes computed when spans are parsed, so no content is returned.
+type PromptAnalyticsResponse struct {
+	LLMCallCount     int `json:"llmCallCount"`     // LLM calls with a user input
+	UniqueInputCount int `json:"uniqueInputCount"` // Distinct user inputs among them
+	// DuplicateInputRate is the share of LLM calls whose user input an earlier call already carried
+	DuplicateInputRate float64           `json:"duplicateInputRate"`
+	TopInputs          []PromptFrequency `json:"topInputs"`        // Ordered by count, highest first
+	TopSystemPrompts   []PromptFrequency `json:"topSystemPrompts"` // Ordered by count, highest first
+	// RepeatedCallCount is the number of successful LLM calls that repeated an identical earlier prompt to the same model
+	RepeatedCallCount  int                `json:"repeatedCallCount"`
+	CacheOpportunities []CacheOpportunity `json:"cacheOpportunities"` // Ordered by repeated input tokens, highest first
+	SpanCount          int                `json:"spanCount"`          // Number of spans the figures were derived from
+	Truncated          bool               `json:"truncated"`          // Whether the span limit was reached, so older spans were left out
+}
+
+// TimeSeriesParams holds parameters for time series queries
+type TimeSeriesParams struct {
+	ComponentUids  []string // Optional; all components of the environment are included when empty
+	EnvironmentUid string
+	From           time.Time
+	To             time.Time
+	Interval       time.Duration
+	Metrics        []string
+}
+
+// TimeSeries holds the values of a metric over time
+type TimeSeries struct {
+	Metric string
+	Points []TimeSeriesPoint
+}
+
+// TimeSeriesResponse represents the response for time series queries
+type TimeSeriesResponse struct {
+	Series    []TimeSeries
+	SpanCount int  // Number of spans the series were derived from
+	Truncated bool // Whether the span limit was reached, so older spans were left out
+}
+
+// SpanDeletionParams selects the spans of components that are past their retention period
+type SpanDeletionParams struct {
+	ComponentUids []string
+	// Before deletes spans that started before this time (RFC3339)
+	Before string
+	// ErrorsBefore keeps error spans that started after this time (RFC3339) although they are
+	// older than Before; empty deletes error spans like any other span
+	ErrorsBefore string
+}
+
+// SpanDeletionResponse represents the response of a span deletion request
+type SpanDeletionResponse struct {
+	TaskID string `json:"taskId"` // OpenSearch task performing the deletion
+}
+
+// SpanErasureParams selects the spans of components that carry a personal identifier
+type SpanErasureParams struct {
+	ComponentUids []string
+	// Attribute is the span attribute holding the identifier, e.g. enduser.id
+	Attribute string
+	Value     string
+}
+
+// BulkDocument is a document written with Client.BulkIndex
+type BulkDocument struct {
+	Index  string
+	ID     string
+	Source interface{}
+}
+
+// BulkUpdate is a document changed by a painless script with Client.BulkUpdate, which is created
+// from Upsert when it does not exist
+type BulkUpdate struct {
+	Index  string
+	ID     string
+	Script string
+	Params map[string]interface{}
+	Upsert interface{}
+}
+
+// TaskStatus represents the progress of a background OpenSearch task
+type TaskStatus struct {
+	TaskID    string `json:"taskId"`
+	Completed bool   `json:"completed"`
+	Total     int64  `json:"total"`           // Documents matched by the task
+	Deleted   int64  `json:"deleted"`         // Documents deleted so far
+	Failures  int    `json:"failures"`        // Documents that could not be deleted
+	Error     string `json:"error,omitempty"` // Reason the task failed, if it did
+}
+
+// AttributeStatsParams holds parameters for discovering the span attributes of a component
+type AttributeStatsParams struct {
+	ComponentUid   string
+	EnvironmentUid string // Optional
+	StartTime      string
+	EndTime        string
+	Prefix         string // Optional; only attribute keys starting with it
+	Examples       int    // Number of example values per attribute
+}
+
+// FieldCapability is the capability of a field for one of its mapping types
+type FieldCapability struct {
+	Type         string `json:"type"`
+	Searchable   bool   `json:"searchable"`
+	Aggregatable bool   `json:"aggregatable"`
+}
+
+// AttributeField is a span attribute key and the field its values are aggregated on
+type AttributeField struct {
+	Key   string // Attribute key, without the attributes prefix
+	Field string // Aggregatable field, which is a keyword subfield for text attributes
+	Type  string // Mapping type of the attribute
+}
+
+// AttributeStats describes the values of a span attribute key
+type AttributeStats struct {
+	Key         string           `json:"key"`
+	Type        string           `json:"type"`
+	Count       int64            `json:"count"`       // Number of values recorded for the attribute
+	Cardinality int64            `json:"cardinality"` // Approximate number of distinct values
+	Examples    []AttributeValue `json:"examples"`    // Most frequent values
+}
+
+// AttributeValue is a value of a span attribute and the number of times it was recorded
+type AttributeValue struct {
+	Value string `json:"value"`
+	Count int64  `json:"count"`
+}
+
+// AttributeStatsResponse represents the response for attribute discovery queries
+type AttributeStatsResponse struct {
+	ComponentUid string           `json:"componentUid"`
+	Attributes   []AttributeStats `json:"attributes"` // Ordered by count, highest first
+	TotalCount   int              `json:"totalCount"`
+	Truncated    bool             `json:"truncated,omitempty"` // True when more attribute keys matched than were aggregated
+}
+
+// SLOObjective is a service level objective of a component: the percentage of traces, Target,
+// that have to be good. A trace is good when it completes within ThresholdMs for the latency
+// indicator, and when it does not end in an error for the availability indicator.
+type SLOObjective struct {
+	Indicator   string  `json:"indicator"`
+	ThresholdMs float64 `json:"thresholdMs,omitempty"`
+	Target      float64 `json:"target"`
+}
+
+// SLOParams holds parameters for computing the compliance of a component with an objective
+type SLOParams struct {
+	ComponentUid   string
+	EnvironmentUid string
+	Objective      SLOObjective
+	Window         time.Duration // Rolling window ending at End
+	Interval       time.Duration // Bucket size
+	End            time.Time
+}
+
+// SLOErrorBudget is the number of bad traces an objective allows within its window
+type SLOErrorBudget struct {
+	Allowed   float64 `json:"allowed"`   // Bad traces allowed by the target
+	Consumed  int64   `json:"consumed"`  // Bad traces
+	Remaining float64 `json:"remaining"` // Fraction of the budget left; negative once exceeded
+}
+
+// SLOBurnRate is the rate at which the error budget was consumed over a trailing window; 1 uses
+// up exactly the budget by the end of the objective window
+type SLOBurnRate struct {
+	Window   string   `json:"window"`
+	BurnRate *float64 `json:"burnRate"` // Nil when there were no traces in the window
+}
+
+// SLOStatusResponse represents the compliance of a component with an objective over its window
+type SLOStatusResponse struct {
+	Objective     SLOObjective   `json:"objective"`
+	WindowStart   time.Time      `json:"windowStart"`
+	WindowEnd     time.Time      `json:"windowEnd"`
+	TotalCount    int64          `json:"totalCount"`
+	GoodCount     int64          `json:"goodCount"`
+	Compliance    *float64       `json:"compliance"`    // Percentage of good traces; nil without traces
+	ObservedValue *float64       `json:"observedValue"` // Latency (ms) at the target percentile, or error rate (%)
+	ErrorBudget   SLOErrorBudget `json:"errorBudget"`
+	BurnRates     []SLOBurnRate  `json:"burnRates"`
+	Status        string         `json:"status"` // met, at_risk, breached or no_data
+}
+
+// SLOHistoryPoint is the compliance of the traces of one interval, with the error budget left
+// at its end
+type SLOHistoryPoint struct {
+	Time            time.Time `json:"time"`
+	TotalCount      int64     `json:"totalCount"`
+	GoodCount       int64     `json:"goodCount"`
+	Compliance      *float64  `json:"compliance"`
+	BudgetRemaining float64   `json:"budgetRemaining"` // Over the window up to the end of the interval
+}
+
+// SLOHistoryResponse represents the compliance of a component with an objective over time
+type SLOHistoryResponse struct {
+	Objective   SLOObjective      `json:"objective"`
+	WindowStart time.Time         `json:"windowStart"`
+	WindowEnd   time.Time         `json:"windowEnd"`
+	Interval    string            `json:"interval"`
+	Points      []SLOHistoryPoint `json:"points"`
+}
+
+// ComponentStorageUsage holds the stored span data of a component
+type ComponentStorageUsage struct {
+	ComponentUid       string     `json:"componentUid"`
+	SpanCount          int64      `json:"spanCount"`
+	TraceCount         int64      `json:"traceCount"`               // Approximate number of distinct traces
+	OldestSpanTime     *time.Time `json:"oldestSpanTime,omitempty"` // Start time of the oldest stored span
+	NewestSpanTime     *time.Time `json:"newestSpanTime,omitempty"` // Start time of the newest stored span
+	EstimatedSizeBytes int64      `json:"estimatedSizeBytes"`       // Share of the trace indices' size by span count
+}
+
+// StorageUsageResponse represents the response for storage usage queries
+type StorageUsageResponse struct {
+	Components     []ComponentStorageUsage `json:"components"`     // Ordered by span count, highest first
+	TotalSpanCount int64                   `json:"totalSpanCount"` // Spans of all components in the trace indices
+	TotalSizeBytes int64                   `json:"totalSizeBytes"` // Primary store size of the trace indices
+}
+
+// IndexSize holds the document count and primary store size of an index
+type IndexSize struct {
+	Index     string `json:"index"`
+	DocCount  int64  `json:"docCount"`
+	SizeBytes int64  `json:"sizeBytes"`
+}
+
+// VolumeParams holds parameters for ingest volume queries
+type VolumeParams struct {
+	ComponentUids  []string // Optional; the components with the most spans are included when empty
+	EnvironmentUid string   // Optional
+	StartTime      time.Time
+	EndTime        time.Time
+}
+
+// DailyVolume holds the spans ingested on a UTC day
+type DailyVolume struct {
+	Date               string `json:"date"` // YYYY-MM-DD
+	SpanCount          int64  `json:"spanCount"`
+	TraceCount         int64  `json:"traceCount"`         // Approximate number of distinct traces
+	EstimatedSizeBytes int64  `json:"estimatedSizeBytes"` // Share of the day's index size by span count
+}
+
+// ComponentVolume holds the spans ingested for a component within a time range
+type ComponentVolume struct {
+	ComponentUid       string        `json:"componentUid"`
+	SpanCount          int64         `json:"spanCount"`
+	TraceCount         int64         `json:"traceCount"` // Approximate number of distinct traces
+	EstimatedSizeBytes int64         `json:"estimatedSizeBytes"`
+	Daily              []DailyVolume `json:"daily"` // One entry per day of the time range, oldest first
+}
+
+// VolumeResponse represents the response for ingest volume queries
+type VolumeResponse struct {
+	StartTime      time.Time         `json:"startTime"`
+	EndTime        time.Time         `json:"endTime"`
+	Components     []ComponentVolume `json:"components"` // Ordered by span count, highest first
+	SpanCount      int64             `json:"spanCount"`  // Spans of all matching components in the time range
+	TraceCount     int64             `json:"traceCount"` // Approximate number of distinct traces
+	Indices        []IndexSize       `json:"indices"`    // Daily trace indices of the time range
+	TotalSizeBytes int64             `json:"totalSizeBytes"`
+}
+
 // SearchResponse represents OpenSearch search response
 type SearchResponse struct {
 	Hits struct {
@@ -245,6 +702,25 @@ type SearchResponse struct {
 		} `json:"total"`
 		Hits []struct {
 			Source map[string]interface{} `json:"_source"`
+			// Sort holds the sort values of the hit, used to continue with search_after
+			Sort []interface{} `json:"sort,omitempty"`
 		} `json:"hits"`
 	} `json:"hits"`
+	// Aggregations holds the raw aggregation results, if the query requested any
+	Aggregations json.RawMessage `json:"aggregations,omitempty"`
+	// PitID is the point in time to use for the next page of a point in time search
+	PitID string `json:"pit_id,omitempty"`
+}
+
+// JaegerSearchParams holds the criteria of a Jaeger trace search
+type JaegerSearchParams struct {
+	ComponentUid string // Jaeger service
+	Operation    string // Span name; any span of the trace may match
+	// Tags are span attributes that must all be present on the matching span
+	Tags        map[string]string
+	StartTime   string // RFC3339
+	EndTime     string // RFC3339
+	MinDuration int64  // Nanoseconds; zero does not filter
+	MaxDuration int64  // Nanoseconds; zero does not filter
+	Limit       int    // Maximum number of traces
 }
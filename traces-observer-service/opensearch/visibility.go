@@ -0,0 +1,190 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"context"
+	"strings"
+)
+
+// ContentVisibility controls how much of the prompts, completions, tool arguments and other content
+// recorded on spans is returned. Timings, token usage, models and statuses are always returned.
+type ContentVisibility string
+
+const (
+	// ContentVisibilityFull returns content as it was recorded
+	ContentVisibilityFull ContentVisibility = "full"
+	// ContentVisibilityRedacted replaces content with a placeholder, keeping message roles and tool
+	// names so that the shape of a conversation can still be followed
+	ContentVisibilityRedacted ContentVisibility = "redacted"
+	// ContentVisibilityMetadataOnly leaves content out altogether
+	ContentVisibilityMetadataOnly ContentVisibility = "metadata-only"
+)
+
+// RedactedContent replaces content when spans are read with ContentVisibilityRedacted
+const RedactedContent = "[REDACTED]"
+
+// ParseContentVisibility returns the visibility named by value
+func ParseContentVisibility(value string) (ContentVisibility, bool) {
+	switch visibility := ContentVisibility(value); visibility {
+	case ContentVisibilityFull, ContentVisibilityRedacted, ContentVisibilityMetadataOnly:
+		return visibility, true
+	default:
+		return "", false
+	}
+}
+
+// RestrictContentVisibility returns the visibility of the two that returns less content
+func RestrictContentVisibility(a, b ContentVisibility) ContentVisibility {
+	if contentVisibilityRank(b) > contentVisibilityRank(a) {
+		return b
+	}
+	return a
+}
+
+func contentVisibilityRank(visibility ContentVisibility) int {
+	switch visibility {
+	case ContentVisibilityMetadataOnly:
+		return 2
+	case ContentVisibilityRedacted:
+		return 1
+	default:
+		return 0
+	}
+}
+
+type contentVisibilityKey struct{}
+
+// WithContentVisibility returns a context whose spans are parsed with the given visibility
+func WithContentVisibility(ctx context.Context, visibility ContentVisibility) context.Context {
+	return context.WithValue(ctx, contentVisibilityKey{}, visibility)
+}
+
+// ContentVisibilityFromContext returns the visibility spans are parsed with, which is full unless
+// the context was created with WithContentVisibility
+func ContentVisibilityFromContext(ctx context.Context) ContentVisibility {
+	if visibility, ok := ctx.Value(contentVisibilityKey{}).(ContentVisibility); ok {
+		return visibility
+	}
+	return ContentVisibilityFull
+}
+
+// contentAttributes are the span attributes that hold content, which the input and output of spans
+// and traces are extracted from
+var contentAttributes = map[string]bool{
+	"gen_ai.input.messages":      true,
+	"gen_ai.output.messages":     true,
+	"gen_ai.system_instructions": true,
+	"gen_ai.tool.arguments":      true,
+	"gen_ai.tool.output":         true,
+	"traceloop.entity.input":     true,
+	"traceloop.entity.output":    true,
+	"tool.input":                 true,
+	"tool.arguments":             true,
+	"tool.output":                true,
+	"tool.result":                true,
+	"function.arguments":         true,
+	"function.result":            true,
+	"llm.tool_calls":             true,
+	"system_prompt":              true,
+	"crewai.agent.goal":          true,
+	"crewai.agent.backstory":     true,
+	"crewai.task.description":    true,
+	"crewai.crew.result":         true,
+	"crewai.crew.tasks_output":   true,
+}
+
+// contentAttributePrefixes are the prefixes of indexed content attributes, such as gen_ai.prompt.0.content
+var contentAttributePrefixes = []string{"gen_ai.prompt.", "gen_ai.completion.", "db.query.result."}
+
+func isContentAttribute(key string) bool {
+	if contentAttributes[key] {
+		return true
+	}
+	for _, prefix := range contentAttributePrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// applyContentVisibility redacts or removes the content of a parsed span. The content attributes
+// are changed too, so that the input and output later extracted from them for traces and
+// conversations are redacted in the same way.
+func applyContentVisibility(span *Span, visibility ContentVisibility) {
+	if visibility == ContentVisibilityFull || visibility == "" {
+		return
+	}
+	for key := range span.Attributes {
+		if !isContentAttribute(key) {
+			continue
+		}
+		if visibility == ContentVisibilityMetadataOnly {
+			delete(span.Attributes, key)
+		} else {
+			span.Attributes[key] = RedactedContent
+		}
+	}
+
+	amp := span.AmpAttributes
+	if amp == nil {
+		return
+	}
+	if visibility == ContentVisibilityMetadataOnly {
+		amp.Input, amp.Output = nil, nil
+	} else {
+		amp.Input, amp.Output = redactContent(amp.Input), redactContent(amp.Output)
+	}
+	switch data := amp.Data.(type) {
+	case AgentData:
+		data.SystemPrompt = redactString(data.SystemPrompt, visibility)
+		amp.Data = data
+	case CrewAITaskData:
+		data.Description = redactString(data.Description, visibility)
+		amp.Data = data
+	}
+}
+
+// redactContent replaces the content of an input or output, keeping the roles of messages and
+// the names of the tools they call
+func redactContent(value interface{}) interface{} {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []PromptMessage:
+		messages := make([]PromptMessage, len(v))
+		for i, message := range v {
+			messages[i] = PromptMessage{Role: message.Role, Content: redactString(message.Content, ContentVisibilityRedacted)}
+			for _, call := range message.ToolCalls {
+				messages[i].ToolCalls = append(messages[i].ToolCalls, ToolCall{ID: call.ID, Name: call.Name, Arguments: RedactedContent})
+			}
+		}
+		return messages
+	case string:
+		return redactString(v, ContentVisibilityRedacted)
+	default:
+		return RedactedContent
+	}
+}
+
+func redactString(value string, visibility ContentVisibility) string {
+	if value == "" || visibility == ContentVisibilityMetadataOnly {
+		return ""
+	}
+	return RedactedContent
+}
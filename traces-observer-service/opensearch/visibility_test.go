@@ -0,0 +1,133 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func parseWithVisibility(visibility ContentVisibility, source map[string]interface{}) Span {
+	response := &SearchResponse{}
+	response.Hits.Hits = make([]struct {
+		Source map[string]interface{} `json:"_source"`
+		Sort   []interface{}          `json:"sort,omitempty"`
+	}, 1)
+	response.Hits.Hits[0].Source = source
+	return ParseSpans(WithContentVisibility(context.Background(), visibility), response)[0]
+}
+
+func TestParseSpansContentVisibility(t *testing.T) {
+	t.Run("Full visibility returns content", func(t *testing.T) {
+		span := parseWithVisibility(ContentVisibilityFull, llmSource())
+		want := []PromptMessage{{Role: "user", Content: "Hi"}}
+		if !reflect.DeepEqual(span.AmpAttributes.Input, want) {
+			t.Errorf("expected input %+v, got %+v", want, span.AmpAttributes.Input)
+		}
+	})
+
+	t.Run("Redacted visibility keeps roles and token usage", func(t *testing.T) {
+		span := parseWithVisibility(ContentVisibilityRedacted, llmSource())
+		want := []PromptMessage{{Role: "user", Content: RedactedContent}}
+		if !reflect.DeepEqual(span.AmpAttributes.Input, want) {
+			t.Errorf("expected input %+v, got %+v", want, span.AmpAttributes.Input)
+		}
+		if span.Attributes["gen_ai.prompt.0.content"] != RedactedContent {
+			t.Errorf("expected the content attribute to be redacted, got %v", span.Attributes["gen_ai.prompt.0.content"])
+		}
+		data := span.AmpAttributes.Data.(LLMData)
+		if data.TokenUsage == nil || data.TokenUsage.InputTokens != 1000 || data.Model != "gpt-4o-2024-08-06" {
+			t.Errorf("expected the model and token usage to be kept, got %+v", data)
+		}
+		if data.InputHash != contentHash("Hi") {
+			t.Errorf("expected the input hash of the original content, got %q", data.InputHash)
+		}
+	})
+
+	t.Run("Metadata only visibility removes content", func(t *testing.T) {
+		source := llmSource()
+		source["attributes"].(map[string]interface{})["traceloop.entity.output"] = `{"outputs":"Hello"}`
+		span := parseWithVisibility(ContentVisibilityMetadataOnly, source)
+		if span.AmpAttributes.Input != nil || span.AmpAttributes.Output != nil {
+			t.Errorf("expected no input or output, got %+v and %+v", span.AmpAttributes.Input, span.AmpAttributes.Output)
+		}
+		for _, key := range []string{"gen_ai.prompt.0.role", "gen_ai.prompt.0.content", "traceloop.entity.output"} {
+			if _, ok := span.Attributes[key]; ok {
+				t.Errorf("expected attribute %s to be removed", key)
+			}
+		}
+		if span.Attributes["gen_ai.usage.input_tokens"] != float64(1000) {
+			t.Errorf("expected token usage attributes to be kept, got %+v", span.Attributes)
+		}
+		if input, output := ExtractRootSpanInputOutput(&span); input != nil || output != nil {
+			t.Errorf("expected no trace input or output, got %v and %v", input, output)
+		}
+	})
+
+	t.Run("Agent system prompts are redacted", func(t *testing.T) {
+		span := parseWithVisibility(ContentVisibilityRedacted, map[string]interface{}{
+			"traceId": "t1",
+			"spanId":  "s1",
+			"name":    "invoke_agent",
+			"attributes": map[string]interface{}{
+				"gen_ai.operation.name":      "invoke_agent",
+				"gen_ai.agent.name":          "support",
+				"gen_ai.system_instructions": "You are a support agent",
+			},
+		})
+		data, ok := span.AmpAttributes.Data.(AgentData)
+		if !ok || data.Name != "support" || data.SystemPrompt != RedactedContent {
+			t.Errorf("expected a redacted system prompt, got %+v", span.AmpAttributes.Data)
+		}
+	})
+}
+
+func TestParseContentVisibility(t *testing.T) {
+	for value, want := range map[string]ContentVisibility{
+		"full":          ContentVisibilityFull,
+		"redacted":      ContentVisibilityRedacted,
+		"metadata-only": ContentVisibilityMetadataOnly,
+	} {
+		if got, ok := ParseContentVisibility(value); !ok || got != want {
+			t.Errorf("ParseContentVisibility(%q) = %q, %v", value, got, ok)
+		}
+	}
+	for _, value := range []string{"", "none"} {
+		if _, ok := ParseContentVisibility(value); ok {
+			t.Errorf("expected %q to be rejected", value)
+		}
+	}
+}
+
+func TestRestrictContentVisibility(t *testing.T) {
+	tests := []struct {
+		allowed, requested, want ContentVisibility
+	}{
+		{ContentVisibilityFull, ContentVisibilityRedacted, ContentVisibilityRedacted},
+		{ContentVisibilityFull, ContentVisibilityMetadataOnly, ContentVisibilityMetadataOnly},
+		{ContentVisibilityRedacted, ContentVisibilityFull, ContentVisibilityRedacted},
+		{ContentVisibilityRedacted, ContentVisibilityMetadataOnly, ContentVisibilityMetadataOnly},
+		{ContentVisibilityMetadataOnly, ContentVisibilityFull, ContentVisibilityMetadataOnly},
+		{ContentVisibilityMetadataOnly, ContentVisibilityRedacted, ContentVisibilityMetadataOnly},
+	}
+	for _, tt := range tests {
+		if got := RestrictContentVisibility(tt.allowed, tt.requested); got != tt.want {
+			t.Errorf("RestrictContentVisibility(%q, %q) = %q, want %q", tt.allowed, tt.requested, got, tt.want)
+		}
+	}
+}
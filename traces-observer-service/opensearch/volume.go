@@ -0,0 +1,142 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// volumeComponentLimit is the number of components with the most spans reported when no
+// components are requested
+const volumeComponentLimit = 100
+
+// volumeDay is the interval ingest volume is reported in, matching the daily trace indices
+const volumeDay = 24 * time.Hour
+
+// BuildVolumeQuery builds an aggregation of the spans ingested in a time range, counting spans and
+// traces per component and per component and day
+func BuildVolumeQuery(params VolumeParams) *SearchSource {
+	query := Bool()
+	if len(params.ComponentUids) > 0 {
+		query.Must(Terms(componentUidField, params.ComponentUids))
+	}
+	query.Must(resourceFilters("", params.EnvironmentUid)...)
+	query.Must(Range(startTimeField).
+		Gte(params.StartTime.UTC().Format(time.RFC3339Nano)).
+		Lte(params.EndTime.UTC().Format(time.RFC3339Nano)))
+
+	size := volumeComponentLimit
+	if len(params.ComponentUids) > 0 {
+		size = len(params.ComponentUids)
+	}
+
+	return NewSearch().
+		Size(0).
+		TrackTotalHits().
+		Query(query).
+		Aggregation("traces", Cardinality(traceIdField)).
+		Aggregation("components", TermsAgg(componentUidField, size).
+			SubAggregation("traces", Cardinality(traceIdField)).
+			SubAggregation("daily", DateHistogram(startTimeField, volumeDay, params.StartTime, params.EndTime).
+				SubAggregation("traces", Cardinality(traceIdField))))
+}
+
+// volumeAggregations is the aggregation result of BuildVolumeQuery
+type volumeAggregations struct {
+	Traces struct {
+		Value int64 `json:"value"`
+	} `json:"traces"`
+	Components struct {
+		Buckets []struct {
+			Key      string `json:"key"`
+			DocCount int64  `json:"doc_count"`
+			Traces   struct {
+				Value int64 `json:"value"`
+			} `json:"traces"`
+			Daily struct {
+				Buckets []struct {
+					Key      int64 `json:"key"`
+					DocCount int64 `json:"doc_count"`
+					Traces   struct {
+						Value int64 `json:"value"`
+					} `json:"traces"`
+				} `json:"buckets"`
+			} `json:"daily"`
+		} `json:"buckets"`
+	} `json:"components"`
+}
+
+// ParseVolume reads the ingest volume from the aggregations of a volume query. indexSizes are the
+// sizes of the trace indices; those of the days in the time range are reported. Spans do not record
+// their own size, so the size of a component on a day is estimated from its share of the documents
+// in the index of that day.
+func ParseVolume(params VolumeParams, spanCount int64, aggregations json.RawMessage, indexSizes []IndexSize) (*VolumeResponse, error) {
+	var aggs volumeAggregations
+	if len(aggregations) > 0 {
+		if err := json.Unmarshal(aggregations, &aggs); err != nil {
+			return nil, fmt.Errorf("failed to decode aggregations: %w", err)
+		}
+	}
+
+	sizes := make(map[string]IndexSize, len(indexSizes))
+	for _, size := range indexSizes {
+		sizes[size.Index] = size
+	}
+
+	volume := &VolumeResponse{
+		StartTime:  params.StartTime.UTC(),
+		EndTime:    params.EndTime.UTC(),
+		Components: []ComponentVolume{},
+		SpanCount:  spanCount,
+		TraceCount: aggs.Traces.Value,
+		Indices:    []IndexSize{},
+	}
+
+	start := params.StartTime.UTC().Truncate(volumeDay)
+	for day := start; !day.After(params.EndTime.UTC()); day = day.Add(volumeDay) {
+		if size, ok := sizes[dailyIndexName(spanIndexPrefix, day)]; ok {
+			volume.Indices = append(volume.Indices, size)
+			volume.TotalSizeBytes += size.SizeBytes
+		}
+	}
+
+	for _, bucket := range aggs.Components.Buckets {
+		component := ComponentVolume{
+			ComponentUid: bucket.Key,
+			SpanCount:    bucket.DocCount,
+			TraceCount:   bucket.Traces.Value,
+			Daily:        make([]DailyVolume, 0, len(bucket.Daily.Buckets)),
+		}
+		for _, dayBucket := range bucket.Daily.Buckets {
+			day := time.UnixMilli(dayBucket.Key).UTC()
+			daily := DailyVolume{
+				Date:       day.Format(time.DateOnly),
+				SpanCount:  dayBucket.DocCount,
+				TraceCount: dayBucket.Traces.Value,
+			}
+			if size := sizes[dailyIndexName(spanIndexPrefix, day)]; size.DocCount > 0 {
+				daily.EstimatedSizeBytes = int64(float64(size.SizeBytes) * float64(dayBucket.DocCount) / float64(size.DocCount))
+			}
+			component.EstimatedSizeBytes += daily.EstimatedSizeBytes
+			component.Daily = append(component.Daily, daily)
+		}
+		volume.Components = append(volume.Components, component)
+	}
+	return volume, nil
+}
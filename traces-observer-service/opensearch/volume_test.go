@@ -0,0 +1,117 @@
+// Copyright (c) 2026, WSO2 LLC. (https://www.wso2.com).
+//
+// WSO2 LLC. licenses this file to you under the Apache License,
+// Version 2.0 (the "License"); you may not use this file except
+// in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package opensearch
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBuildVolumeQuery(t *testing.T) {
+	params := VolumeParams{
+		ComponentUids:  []string{"agent-a", "agent-b"},
+		EnvironmentUid: "env-1",
+		StartTime:      time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndTime:        time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC),
+	}
+	requireJSON(t, `{
+		"query":{"bool":{"must":[
+			{"terms":{"resource.openchoreo.dev/component-uid":["agent-a","agent-b"]}},
+			{"term":{"resource.openchoreo.dev/environment-uid":"env-1"}},
+			{"range":{"startTime":{"gte":"2025-01-01T00:00:00Z","lte":"2025-01-03T00:00:00Z"}}}
+		]}},
+		"size":0,
+		"track_total_hits":true,
+		"aggs":{
+			"traces":{"cardinality":{"field":"traceId"}},
+			"components":{
+				"terms":{"field":"resource.openchoreo.dev/component-uid","size":2},
+				"aggs":{
+					"traces":{"cardinality":{"field":"traceId"}},
+					"daily":{
+						"date_histogram":{"field":"startTime","fixed_interval":"86400s","min_doc_count":0,
+							"extended_bounds":{"min":1735689600000,"max":1735862400000}},
+						"aggs":{"traces":{"cardinality":{"field":"traceId"}}}
+					}
+				}
+			}
+		}
+	}`, BuildVolumeQuery(params).Source())
+
+	// Without components, the components with the most spans are reported
+	params.ComponentUids = nil
+	terms := BuildVolumeQuery(params).Source()["aggs"].(map[string]interface{})["components"].(map[string]interface{})["terms"]
+	requireJSON(t, `{"field":"resource.openchoreo.dev/component-uid","size":100}`, terms.(map[string]interface{}))
+}
+
+func TestParseVolume(t *testing.T) {
+	params := VolumeParams{
+		StartTime: time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2025, 1, 2, 12, 0, 0, 0, time.UTC),
+	}
+	indexSizes := []IndexSize{
+		{Index: "otel-traces-2024-12-31", DocCount: 50, SizeBytes: 5000},
+		{Index: "otel-traces-2025-01-01", DocCount: 100, SizeBytes: 20000},
+		{Index: "otel-traces-2025-01-02", DocCount: 40, SizeBytes: 4000},
+	}
+	aggregations := []byte(`{
+		"traces":{"value":12},
+		"components":{"buckets":[{
+			"key":"agent-a","doc_count":70,"traces":{"value":9},
+			"daily":{"buckets":[
+				{"key":1735689600000,"doc_count":60,"traces":{"value":8}},
+				{"key":1735776000000,"doc_count":10,"traces":{"value":1}}
+			]}
+		}]}
+	}`)
+
+	volume, err := ParseVolume(params, 80, aggregations, indexSizes)
+	if err != nil {
+		t.Fatalf("ParseVolume returned error: %v", err)
+	}
+
+	want := &VolumeResponse{
+		StartTime: params.StartTime,
+		EndTime:   params.EndTime,
+		Components: []ComponentVolume{{
+			ComponentUid:       "agent-a",
+			SpanCount:          70,
+			TraceCount:         9,
+			EstimatedSizeBytes: 13000,
+			Daily: []DailyVolume{
+				{Date: "2025-01-01", SpanCount: 60, TraceCount: 8, EstimatedSizeBytes: 12000},
+				{Date: "2025-01-02", SpanCount: 10, TraceCount: 1, EstimatedSizeBytes: 1000},
+			},
+		}},
+		SpanCount:      80,
+		TraceCount:     12,
+		Indices:        indexSizes[1:],
+		TotalSizeBytes: 24000,
+	}
+	if !reflect.DeepEqual(volume, want) {
+		t.Errorf("ParseVolume() = %+v, want %+v", volume, want)
+	}
+
+	empty, err := ParseVolume(params, 0, nil, nil)
+	if err != nil {
+		t.Fatalf("ParseVolume returned error for empty aggregations: %v", err)
+	}
+	if len(empty.Components) != 0 || len(empty.Indices) != 0 || empty.Components == nil || empty.Indices == nil {
+		t.Errorf("expected empty, non-nil components and indices, got %+v", empty)
+	}
+}